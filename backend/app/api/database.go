@@ -0,0 +1,35 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/sys/config"
+
+	_ "github.com/hay-kot/homebox/backend/pkgs/cgofreesqlite"
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+// openDatabase opens an ent client for the configured storage driver.
+func openDatabase(cfg config.Storage) (*ent.Client, error) {
+	switch cfg.Driver {
+	case config.DriverSqlite3:
+		return ent.Open(dialect.SQLite, cfg.SqliteURL)
+	case config.DriverPostgres:
+		if cfg.PostgresURL == "" {
+			return nil, fmt.Errorf("postgres url is required when using the %q driver", config.DriverPostgres)
+		}
+
+		db, err := sql.Open("pgx", cfg.PostgresURL)
+		if err != nil {
+			return nil, err
+		}
+
+		return ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db))), nil
+	default:
+		return nil, fmt.Errorf("unsupported database driver %q", cfg.Driver)
+	}
+}
@@ -45,12 +45,6 @@ func WithDemoStatus(demoStatus bool) func(*V1Controller) {
 	}
 }
 
-func WithRegistration(allowRegistration bool) func(*V1Controller) {
-	return func(ctrl *V1Controller) {
-		ctrl.allowRegistration = allowRegistration
-	}
-}
-
 func WithSecureCookies(secure bool) func(*V1Controller) {
 	return func(ctrl *V1Controller) {
 		ctrl.cookieSecure = secure
@@ -58,13 +52,12 @@ func WithSecureCookies(secure bool) func(*V1Controller) {
 }
 
 type V1Controller struct {
-	cookieSecure      bool
-	repo              *repo.AllRepos
-	svc               *services.AllServices
-	maxUploadSize     int64
-	isDemo            bool
-	allowRegistration bool
-	bus               *eventbus.EventBus
+	cookieSecure  bool
+	repo          *repo.AllRepos
+	svc           *services.AllServices
+	maxUploadSize int64
+	isDemo        bool
+	bus           *eventbus.EventBus
 }
 
 type (
@@ -77,13 +70,14 @@ type (
 	}
 
 	APISummary struct {
-		Healthy           bool     `json:"health"`
-		Versions          []string `json:"versions"`
-		Title             string   `json:"title"`
-		Message           string   `json:"message"`
-		Build             Build    `json:"build"`
-		Demo              bool     `json:"demo"`
-		AllowRegistration bool     `json:"allowRegistration"`
+		Healthy           bool                    `json:"health"`
+		Versions          []string                `json:"versions"`
+		Title             string                  `json:"title"`
+		Message           string                  `json:"message"`
+		Build             Build                   `json:"build"`
+		Demo              bool                    `json:"demo"`
+		AllowRegistration bool                    `json:"allowRegistration"`
+		Backup            services.SnapshotStatus `json:"backup"`
 	}
 )
 
@@ -95,10 +89,9 @@ func BaseURLFunc(prefix string) func(s string) string {
 
 func NewControllerV1(svc *services.AllServices, repos *repo.AllRepos, bus *eventbus.EventBus, options ...func(*V1Controller)) *V1Controller {
 	ctrl := &V1Controller{
-		repo:              repos,
-		svc:               svc,
-		allowRegistration: true,
-		bus:               bus,
+		repo: repos,
+		svc:  svc,
+		bus:  bus,
 	}
 
 	for _, opt := range options {
@@ -123,7 +116,8 @@ func (ctrl *V1Controller) HandleBase(ready ReadyFunc, build Build) errchain.Hand
 			Message:           "Track, Manage, and Organize your Things",
 			Build:             build,
 			Demo:              ctrl.isDemo,
-			AllowRegistration: ctrl.allowRegistration,
+			AllowRegistration: ctrl.svc.Admin.RegistrationAllowed(),
+			Backup:            ctrl.svc.Snapshots.Status(),
 		})
 	}
 }
@@ -0,0 +1,205 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+	"github.com/hay-kot/homebox/backend/internal/web/adapters"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/hay-kot/httpkit/server"
+	"github.com/rs/zerolog/log"
+)
+
+// HandleAdminGroupsGetAll godoc
+//
+//	@Summary     Get All Groups
+//	@Description Lists all groups of the instance with their number of members, items, locations,
+//	@Description labels and attachments. Only superusers can access the admin endpoints.
+//	@Tags        Admin
+//	@Produce     json
+//	@Success     200 {object} []repo.GroupUsage
+//	@Router      /v1/admin/groups [GET]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleAdminGroupsGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.GroupUsage, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.svc.Admin.GetGroups(auth)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleAdminGroupRestore godoc
+//
+//	@Summary     Restore Group
+//	@Description Restores a backup archive downloaded from /v1/groups/backup as a new group owned
+//	@Description by the superuser. All entities get new IDs. Members of the backup are added when an
+//	@Description account with their email exists, the others are returned as missing members.
+//	@Tags        Admin
+//	@Produce     json
+//	@Param       file formData file     true "Backup archive"
+//	@Success     201  {object} repo.GroupRestore
+//	@Failure     422  {object} validate.ErrorResponse
+//	@Router      /v1/admin/groups/restore [POST]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleAdminGroupRestore() errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		err := r.ParseMultipartForm(ctrl.maxUploadSize << 20)
+		if err != nil {
+			log.Err(err).Msg("failed to parse multipart form")
+			return validate.NewRequestError(errors.New("failed to parse multipart form"), http.StatusBadRequest)
+		}
+
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			if errors.Is(err, http.ErrMissingFile) {
+				return validate.NewFieldErrors(validate.NewFieldError("file", "file is required"))
+			}
+			log.Err(err).Msg("failed to get file from form")
+			return validate.NewRequestError(err, http.StatusInternalServerError)
+		}
+		defer func() { _ = file.Close() }()
+
+		auth := services.NewContext(r.Context())
+
+		out, err := ctrl.svc.Admin.RestoreGroup(auth, file, header.Size)
+		if err != nil {
+			return err
+		}
+
+		return server.JSON(w, http.StatusCreated, out)
+	}
+}
+
+// HandleAdminUsersGetAll godoc
+//
+//	@Summary     Get All Users
+//	@Description Lists all users of the instance with their groups, sessions and last activity.
+//	@Tags        Admin
+//	@Produce     json
+//	@Success     200 {object} []repo.UserUsage
+//	@Router      /v1/admin/users [GET]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleAdminUsersGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.UserUsage, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.svc.Admin.GetUsers(auth)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleAdminUserDisable godoc
+//
+//	@Summary     Disable User
+//	@Description Disables the account and logs the user out. Disabled users can not login and
+//	@Description their API keys are rejected.
+//	@Tags        Admin
+//	@Param       id path string true "User ID"
+//	@Success     204
+//	@Router      /v1/admin/users/{id}/disable [POST]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleAdminUserDisable() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		return nil, ctrl.svc.Admin.SetUserDisabled(auth, ID, true)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
+
+// HandleAdminUserEnable godoc
+//
+//	@Summary  Enable User
+//	@Tags     Admin
+//	@Param    id path string true "User ID"
+//	@Success  204
+//	@Router   /v1/admin/users/{id}/enable [POST]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleAdminUserEnable() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		return nil, ctrl.svc.Admin.SetUserDisabled(auth, ID, false)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
+
+// HandleAdminUserDelete godoc
+//
+//	@Summary  Delete User
+//	@Tags     Admin
+//	@Param    id path string true "User ID"
+//	@Success  204
+//	@Router   /v1/admin/users/{id} [DELETE]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleAdminUserDelete() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		return nil, ctrl.svc.Admin.DeleteUser(auth, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
+
+// HandleAdminUserResetPassword godoc
+//
+//	@Summary     Force Password Reset
+//	@Description Replaces the password of the user with a random one, logs them out and revokes their
+//	@Description API keys. A password reset link is mailed to the user, or returned when no mailer is
+//	@Description configured.
+//	@Tags        Admin
+//	@Produce     json
+//	@Param       id  path     string true "User ID"
+//	@Success     200 {object} services.AdminPasswordReset
+//	@Router      /v1/admin/users/{id}/reset-password [POST]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleAdminUserResetPassword() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (services.AdminPasswordReset, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.svc.Admin.ResetUserPassword(auth, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleAdminRegistrationGet godoc
+//
+//	@Summary  Get Registration Setting
+//	@Tags     Admin
+//	@Produce  json
+//	@Success  200 {object} services.Registration
+//	@Router   /v1/admin/registration [GET]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleAdminRegistrationGet() errchain.HandlerFunc {
+	fn := func(r *http.Request) (services.Registration, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.svc.Admin.GetRegistration(auth)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleAdminRegistrationUpdate godoc
+//
+//	@Summary     Update Registration Setting
+//	@Description Allows or disallows new users to register without an invitation. The setting
+//	@Description is reset to HBOX_OPTIONS_ALLOW_REGISTRATION when the server restarts.
+//	@Tags        Admin
+//	@Produce     json
+//	@Param       payload body     services.Registration true "Registration Setting"
+//	@Success     200     {object} services.Registration
+//	@Router      /v1/admin/registration [PUT]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleAdminRegistrationUpdate() errchain.HandlerFunc {
+	fn := func(r *http.Request, body services.Registration) (services.Registration, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.svc.Admin.SetRegistration(auth, body)
+	}
+
+	return adapters.Action(fn, http.StatusOK)
+}
@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/web/adapters"
+	"github.com/hay-kot/httpkit/errchain"
+)
+
+// HandleAPIKeysGetAll godoc
+//
+//	@Summary  Get API Keys
+//	@Tags     User
+//	@Produce  json
+//	@Success  200 {object} []repo.APIKeyOut
+//	@Router   /v1/users/self/api-keys [GET]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleAPIKeysGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.APIKeyOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.AuthTokens.GetAPIKeys(auth, auth.UID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleAPIKeyCreate godoc
+//
+//	@Summary     Create API Key
+//	@Description Creates a long-lived API key limited to a single scope. The token is only
+//	@Description returned once and cannot be retrieved again.
+//	@Tags        User
+//	@Produce     json
+//	@Param       payload body     repo.APIKeyCreate true "API Key Data"
+//	@Success     201     {object} services.APIKeyDetail
+//	@Router      /v1/users/self/api-keys [POST]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleAPIKeyCreate() errchain.HandlerFunc {
+	fn := func(r *http.Request, in repo.APIKeyCreate) (services.APIKeyDetail, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.svc.User.CreateAPIKey(auth, in)
+	}
+
+	return adapters.Action(fn, http.StatusCreated)
+}
+
+// HandleAPIKeyRevoke godoc
+//
+//	@Summary  Revoke API Key
+//	@Tags     User
+//	@Param    id path string true "API Key ID"
+//	@Success  204
+//	@Router   /v1/users/self/api-keys/{id} [DELETE]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleAPIKeyRevoke() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		return nil, ctrl.svc.User.RevokeAPIKey(auth, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
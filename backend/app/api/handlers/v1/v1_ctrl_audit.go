@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/web/adapters"
+	"github.com/hay-kot/httpkit/errchain"
+)
+
+// HandleAuditLogGet godoc
+//
+//	@Summary     Query Audit Log
+//	@Description Returns the changes made to the items, locations, labels and attachments of the group, newest first.
+//	@Tags        Audit
+//	@Produce     json
+//	@Param       page       query    int    false "page number"
+//	@Param       pageSize   query    int    false "entries per page"
+//	@Param       action     query    string false "action"      Enums(create, update, delete, restore, purge)
+//	@Param       entityType query    string false "entity type" Enums(item, location, label, attachment)
+//	@Param       entityId   query    string false "entity ID"
+//	@Param       itemId     query    string false "item ID"
+//	@Param       userId     query    string false "user ID"
+//	@Param       since      query    string false "RFC 3339 timestamp"
+//	@Param       until      query    string false "RFC 3339 timestamp"
+//	@Success     200        {object} repo.PaginationResult[repo.AuditEntryOut]{}
+//	@Router      /v1/audit [GET]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleAuditLogGet() errchain.HandlerFunc {
+	fn := func(r *http.Request, q repo.AuditQuery) (repo.PaginationResult[repo.AuditEntryOut], error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Audit.QueryByGroup(auth, auth.GID, q)
+	}
+
+	return adapters.Query(fn, http.StatusOK)
+}
+
+// HandleItemHistoryGet godoc
+//
+//	@Summary     Get Item History
+//	@Description Returns the changes made to the item and its attachments, newest first.
+//	@Tags        Items
+//	@Produce     json
+//	@Param       id       path     string true  "Item ID"
+//	@Param       page     query    int    false "page number"
+//	@Param       pageSize query    int    false "entries per page"
+//	@Success     200      {object} repo.PaginationResult[repo.AuditEntryOut]{}
+//	@Router      /v1/items/{id}/history [GET]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleItemHistoryGet() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, q repo.AuditQuery) (repo.PaginationResult[repo.AuditEntryOut], error) {
+		auth := services.NewContext(r.Context())
+
+		q.ItemID = ID
+		return ctrl.repo.Audit.QueryByGroup(auth, auth.GID, q)
+	}
+
+	return adapters.QueryID("id", fn, http.StatusOK)
+}
@@ -3,12 +3,15 @@ package v1
 import (
 	"errors"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hay-kot/homebox/backend/internal/core/services"
 	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+	"github.com/hay-kot/homebox/backend/internal/web/adapters"
+	"github.com/hay-kot/homebox/backend/pkgs/ratelimit"
 	"github.com/hay-kot/httpkit/errchain"
 	"github.com/hay-kot/httpkit/server"
 	"github.com/rs/zerolog/log"
@@ -25,6 +28,10 @@ type (
 		Token           string    `json:"token"`
 		ExpiresAt       time.Time `json:"expiresAt"`
 		AttachmentToken string    `json:"attachmentToken"`
+		// TwoFactorRequired is set instead of a token for users with two-factor authentication,
+		// the login is completed with the TwoFactorToken at /v1/users/login/2fa.
+		TwoFactorRequired bool   `json:"twoFactorRequired,omitempty"`
+		TwoFactorToken    string `json:"twoFactorToken,omitempty"`
 	}
 
 	LoginForm struct {
@@ -32,6 +39,12 @@ type (
 		Password     string `json:"password"`
 		StayLoggedIn bool   `json:"stayLoggedIn"`
 	}
+
+	TwoFactorLoginForm struct {
+		Token        string `json:"token"        validate:"required"`
+		Code         string `json:"code"         validate:"required"`
+		StayLoggedIn bool   `json:"stayLoggedIn"`
+	}
 )
 
 type CookieContents struct {
@@ -77,6 +90,24 @@ type AuthProvider interface {
 	Authenticate(w http.ResponseWriter, r *http.Request) (services.UserAuthTokenDetail, error)
 }
 
+// RedirectAuthProvider is an AuthProvider that authenticates users through an external
+// identity provider, such as OpenID Connect. The user is redirected to the identity
+// provider and returns to a callback route where Authenticate is called.
+type RedirectAuthProvider interface {
+	AuthProvider
+	// BeginAuth starts the login flow and returns the URL the user is redirected to.
+	BeginAuth(w http.ResponseWriter, r *http.Request) (string, error)
+}
+
+// ChallengeAuthProvider is an AuthProvider that authenticates users by a challenge signed
+// on the client, such as a WebAuthn passkey. The challenge is requested with BeginAuth and
+// the signed response is sent to the login route.
+type ChallengeAuthProvider interface {
+	AuthProvider
+	// BeginAuth starts the login and returns the challenge passed to the client.
+	BeginAuth(w http.ResponseWriter, r *http.Request) (any, error)
+}
+
 // HandleAuthLogin godoc
 //
 //	@Summary User Login
@@ -89,6 +120,7 @@ type AuthProvider interface {
 //	@Param   provider    query    string   false "auth provider"
 //	@Produce json
 //	@Success 200 {object} TokenResponse
+//	@Failure 429 {object} validate.ErrorResponse "too many failed logins"
 //	@Router  /v1/users/login [POST]
 func (ctrl *V1Controller) HandleAuthLogin(ps ...AuthProvider) errchain.HandlerFunc {
 	if len(ps) == 0 {
@@ -116,6 +148,29 @@ func (ctrl *V1Controller) HandleAuthLogin(ps ...AuthProvider) errchain.HandlerFu
 
 		newToken, err := p.Authenticate(w, r)
 		if err != nil {
+			if errors.Is(err, services.ErrorEmailNotVerified) || errors.Is(err, services.ErrorUserDisabled) {
+				return validate.NewRequestError(err, http.StatusForbidden)
+			}
+
+			if errors.Is(err, services.ErrorInvalidLogin) || errors.Is(err, services.ErrorInvalidPasskey) || errors.Is(err, services.ErrorInvalidToken) {
+				return validate.NewUnauthorizedError()
+			}
+
+			var limited *ratelimit.LimitError
+			if errors.As(err, &limited) {
+				w.Header().Set("Retry-After", strconv.Itoa(limited.RetryAfterSeconds()))
+				return validate.NewRequestError(err, http.StatusTooManyRequests)
+			}
+
+			var challenge *services.TwoFactorChallenge
+			if errors.As(err, &challenge) {
+				return server.JSON(w, http.StatusOK, TokenResponse{
+					ExpiresAt:         challenge.ExpiresAt,
+					TwoFactorRequired: true,
+					TwoFactorToken:    challenge.Token,
+				})
+			}
+
 			log.Err(err).Msg("failed to authenticate")
 			return server.JSON(w, http.StatusInternalServerError, err.Error())
 		}
@@ -129,6 +184,124 @@ func (ctrl *V1Controller) HandleAuthLogin(ps ...AuthProvider) errchain.HandlerFu
 	}
 }
 
+// HandleAuthLoginTwoFactor godoc
+//
+//	@Summary     User Login Two-Factor
+//	@Description Completes the login of a user with two-factor authentication using the token returned
+//	@Description by the login and a TOTP or recovery code.
+//	@Tags        Authentication
+//	@Param       payload body TwoFactorLoginForm true "Two-Factor Login Data"
+//	@Produce     json
+//	@Success     200 {object} TokenResponse
+//	@Router      /v1/users/login/2fa [POST]
+func (ctrl *V1Controller) HandleAuthLoginTwoFactor() errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		form, err := adapters.DecodeBody[TwoFactorLoginForm](r)
+		if err != nil {
+			return err
+		}
+
+		newToken, err := ctrl.svc.User.LoginTwoFactor(r.Context(), form.Token, form.Code, form.StayLoggedIn)
+		if err != nil {
+			if errors.Is(err, services.ErrorInvalidToken) || errors.Is(err, services.ErrorInvalidTwoFactorCode) {
+				return validate.NewUnauthorizedError()
+			}
+			if errors.Is(err, services.ErrorUserDisabled) {
+				return validate.NewRequestError(err, http.StatusForbidden)
+			}
+			return err
+		}
+
+		ctrl.setCookies(w, noPort(r.Host), newToken.Raw, newToken.ExpiresAt, true)
+		return server.JSON(w, http.StatusOK, TokenResponse{
+			Token:           "Bearer " + newToken.Raw,
+			ExpiresAt:       newToken.ExpiresAt,
+			AttachmentToken: newToken.AttachmentToken,
+		})
+	}
+}
+
+// HandleAuthRedirect godoc
+//
+//	@Summary     External Provider Login
+//	@Description Redirects the user to the identity provider of an external auth provider.
+//	@Tags        Authentication
+//	@Param       provider     path  string true  "auth provider" example(oidc)
+//	@Param       token        query string false "group invitation token"
+//	@Param       stayLoggedIn query bool   false "extended session"
+//	@Success     302
+//	@Router      /v1/users/login/{provider} [GET]
+func (ctrl *V1Controller) HandleAuthRedirect(p RedirectAuthProvider) errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		authURL, err := p.BeginAuth(w, r)
+		if err != nil {
+			log.Err(err).Str("provider", p.Name()).Msg("failed to start external login")
+			return validate.NewRequestError(err, http.StatusInternalServerError)
+		}
+
+		http.Redirect(w, r, authURL, http.StatusFound)
+		return nil
+	}
+}
+
+// HandleAuthCallback godoc
+//
+//	@Summary     External Provider Callback
+//	@Description Completes the login of an external auth provider, sets the session cookies
+//	@Description and redirects the user to the application. Users with two-factor authentication
+//	@Description enabled are redirected without a session, the token to complete the login with
+//	@Description /v1/users/login/2fa is passed in the "twoFactorToken" parameter of the URL fragment.
+//	@Tags        Authentication
+//	@Param       provider path  string true  "auth provider" example(oidc)
+//	@Param       code     query string false "authorization code"
+//	@Param       state    query string false "state"
+//	@Success     302
+//	@Router      /v1/users/login/{provider}/callback [GET]
+func (ctrl *V1Controller) HandleAuthCallback(p RedirectAuthProvider) errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		newToken, err := p.Authenticate(w, r)
+		if err != nil {
+			// The token is passed in the fragment so that it is not sent to the server or
+			// logged with the URL.
+			var challenge *services.TwoFactorChallenge
+			if errors.As(err, &challenge) {
+				http.Redirect(w, r, "/#"+url.Values{"twoFactorToken": {challenge.Token}}.Encode(), http.StatusFound)
+				return nil
+			}
+
+			log.Err(err).Str("provider", p.Name()).Msg("failed to authenticate")
+			return validate.NewUnauthorizedError()
+		}
+
+		ctrl.setCookies(w, noPort(r.Host), newToken.Raw, newToken.ExpiresAt, true)
+		http.Redirect(w, r, "/home", http.StatusFound)
+		return nil
+	}
+}
+
+// HandleAuthChallenge godoc
+//
+//	@Summary     Passkey Login Challenge
+//	@Description Starts a passkey login and returns the options for navigator.credentials.get(). The
+//	@Description signed response is sent to /v1/users/login with the "webauthn" provider.
+//	@Tags        Authentication
+//	@Param       provider     path  string true  "auth provider" example(webauthn)
+//	@Param       stayLoggedIn query bool   false "extended session"
+//	@Produce     json
+//	@Success     200 {object} protocol.CredentialAssertion
+//	@Router      /v1/users/login/{provider}/begin [POST]
+func (ctrl *V1Controller) HandleAuthChallenge(p ChallengeAuthProvider) errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		challenge, err := p.BeginAuth(w, r)
+		if err != nil {
+			log.Err(err).Str("provider", p.Name()).Msg("failed to start login")
+			return validate.NewRequestError(err, http.StatusInternalServerError)
+		}
+
+		return server.JSON(w, http.StatusOK, challenge)
+	}
+}
+
 // HandleAuthLogout godoc
 //
 //	@Summary  User Logout
@@ -1,9 +1,11 @@
 package v1
 
 import (
+	"fmt"
 	"net/http"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/core/services"
 	"github.com/hay-kot/homebox/backend/internal/data/repo"
 	"github.com/hay-kot/homebox/backend/internal/sys/validate"
@@ -95,3 +97,139 @@ func (ctrl *V1Controller) HandleGroupInvitationsCreate() errchain.HandlerFunc {
 
 	return adapters.Action(fn, http.StatusCreated)
 }
+
+// HandleGroupMemberRoleUpdate godoc
+//
+//	@Summary     Update Group Member Role
+//	@Description Changes the role of a member of the group. Only group owners can change roles, the
+//	@Description role of another owner cannot be changed.
+//	@Tags        Group
+//	@Produce     json
+//	@Param       id      path     string                    true "User ID"
+//	@Param       payload body     services.MemberRoleUpdate true "Role Data"
+//	@Success     200     {object} repo.UserOut
+//	@Router      /v1/groups/members/{id}/role [Put]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleGroupMemberRoleUpdate() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, body services.MemberRoleUpdate) (repo.UserOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.svc.Group.UpdateMemberRole(auth, ID, body)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
+
+// HandleGroupMembersGetAll godoc
+//
+//	@Summary     Get Group Members
+//	@Description Lists the members of the group with their roles. Only group owners can list members.
+//	@Tags        Group
+//	@Produce     json
+//	@Success     200 {object} []repo.UserOut
+//	@Router      /v1/groups/members [Get]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleGroupMembersGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.UserOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.svc.Group.GetMembers(auth)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleGroupMemberRemove godoc
+//
+//	@Summary     Remove Group Member
+//	@Description Removes a member from the group, the account of the member is kept. Owners and
+//	@Description members that do not belong to another group cannot be removed. Only group owners
+//	@Description can remove members.
+//	@Tags        Group
+//	@Param       id path string true "User ID"
+//	@Success     204
+//	@Router      /v1/groups/members/{id} [Delete]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleGroupMemberRemove() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		return nil, ctrl.svc.Group.RemoveMember(auth, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
+
+// HandleGroupOwnershipTransfer godoc
+//
+//	@Summary     Transfer Group Ownership
+//	@Description Makes the member the owner of the group, the current owner becomes an editor.
+//	@Tags        Group
+//	@Produce     json
+//	@Param       id  path     string true "User ID"
+//	@Success     200 {object} repo.UserOut
+//	@Router      /v1/groups/members/{id}/transfer-ownership [Post]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleGroupOwnershipTransfer() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.UserOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.svc.Group.TransferOwnership(auth, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleGroupInvitationsGetAll godoc
+//
+//	@Summary     Get Group Invitations
+//	@Description Lists the invitations of the group that are not expired or used up.
+//	@Tags        Group
+//	@Produce     json
+//	@Success     200 {object} []repo.GroupInvitation
+//	@Router      /v1/groups/invitations [Get]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleGroupInvitationsGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.GroupInvitation, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.svc.Group.GetInvitations(auth)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleGroupInvitationRevoke godoc
+//
+//	@Summary  Revoke Group Invitation
+//	@Tags     Group
+//	@Param    id path string true "Invitation ID"
+//	@Success  204
+//	@Router   /v1/groups/invitations/{id} [Delete]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleGroupInvitationRevoke() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		return nil, ctrl.svc.Group.RevokeInvitation(auth, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
+
+// HandleGroupBackup godoc
+//
+//	@Summary     Backup Group
+//	@Description Downloads a zip archive with everything in the group, including the attachment
+//	@Description files. Of the notifiers only those of the acting user are included. The archive
+//	@Description can be restored by a superuser with /v1/admin/groups/restore.
+//	@Tags        Group
+//	@Produce     application/zip
+//	@Success     200 {file} file
+//	@Router      /v1/groups/backup [Get]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleGroupBackup() errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		auth := services.NewContext(r.Context())
+
+		name := fmt.Sprintf("homebox-backup-%s.zip", time.Now().Format("2006-01-02"))
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", "attachment;filename="+name)
+
+		return ctrl.svc.Group.Backup(auth, w)
+	}
+}
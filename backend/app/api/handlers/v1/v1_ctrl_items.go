@@ -19,20 +19,23 @@ import (
 
 // HandleItemsGetAll godoc
 //
-//	@Summary  Query All Items
-//	@Tags     Items
-//	@Produce  json
-//	@Param    q         query    string   false "search string"
-//	@Param    page      query    int      false "page number"
-//	@Param    pageSize  query    int      false "items per page"
-//	@Param    labels    query    []string false "label Ids"    collectionFormat(multi)
-//	@Param    locations query    []string false "location Ids" collectionFormat(multi)
-//	@Param    parentIds query    []string false "parent Ids"   collectionFormat(multi)
-//	@Success  200       {object} repo.PaginationResult[repo.ItemSummary]{}
-//	@Router   /v1/items [GET]
-//	@Security Bearer
+//	@Summary     Query All Items
+//	@Description The filter parameter accepts a list of terms that must all match, for example
+//	@Description `price>100 label:electronics warranty<2027-01-01 field:color=red -archived`.
+//	@Tags        Items
+//	@Produce     json
+//	@Param       q         query    string   false "search string"
+//	@Param       filter    query    string   false "filter expression"
+//	@Param       page      query    int      false "page number"
+//	@Param       pageSize  query    int      false "items per page"
+//	@Param       labels    query    []string false "label Ids"    collectionFormat(multi)
+//	@Param       locations query    []string false "location Ids" collectionFormat(multi)
+//	@Param       parentIds query    []string false "parent Ids"   collectionFormat(multi)
+//	@Success     200       {object} repo.PaginationResult[repo.ItemSummary]{}
+//	@Router      /v1/items [GET]
+//	@Security    Bearer
 func (ctrl *V1Controller) HandleItemsGetAll() errchain.HandlerFunc {
-	extractQuery := func(r *http.Request) repo.ItemQuery {
+	extractQuery := func(r *http.Request) (repo.ItemQuery, error) {
 		params := r.URL.Query()
 
 		filterFieldItems := func(raw []string) []repo.FieldQuery {
@@ -73,13 +76,24 @@ func (ctrl *V1Controller) HandleItemsGetAll() errchain.HandlerFunc {
 			}
 		}
 
-		return v
+		filter, err := repo.ParseItemFilter(params.Get("filter"))
+		if err != nil {
+			return v, validate.NewFieldErrors(validate.NewFieldError("filter", err.Error()))
+		}
+
+		v.Filter = filter
+		return v, nil
 	}
 
 	return func(w http.ResponseWriter, r *http.Request) error {
 		ctx := services.NewContext(r.Context())
 
-		items, err := ctrl.repo.Items.QueryByGroup(ctx, ctx.GID, extractQuery(r))
+		q, err := extractQuery(r)
+		if err != nil {
+			return err
+		}
+
+		items, err := ctrl.repo.Items.QueryByGroup(ctx, ctx.GID, q)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
 				return server.JSON(w, http.StatusOK, repo.PaginationResult[repo.ItemSummary]{
@@ -173,23 +187,49 @@ func (ctrl *V1Controller) HandleItemGet() errchain.HandlerFunc {
 
 // HandleItemDelete godocs
 //
-//	@Summary  Delete Item
-//	@Tags     Items
-//	@Produce  json
-//	@Param    id path string true "Item ID"
-//	@Success  204
-//	@Router   /v1/items/{id} [DELETE]
-//	@Security Bearer
+//	@Summary     Delete Item
+//	@Description Moves the item to the trash, it can be restored until the trash is purged.
+//	@Tags        Items
+//	@Produce     json
+//	@Param       id path string true "Item ID"
+//	@Success     204
+//	@Router      /v1/items/{id} [DELETE]
+//	@Security    Bearer
 func (ctrl *V1Controller) HandleItemDelete() errchain.HandlerFunc {
 	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
 		auth := services.NewContext(r.Context())
-		err := ctrl.repo.Items.DeleteByGroup(auth, auth.GID, ID)
+		err := ctrl.svc.Items.Delete(auth, ID)
 		return nil, err
 	}
 
 	return adapters.CommandID("id", fn, http.StatusNoContent)
 }
 
+// HandleItemRestore godocs
+//
+//	@Summary     Restore Item
+//	@Description Moves the item out of the trash. Fails with 409 if the item's location is in the trash.
+//	@Tags        Items
+//	@Produce     json
+//	@Param       id  path     string true "Item ID"
+//	@Success     200 {object} repo.ItemOut
+//	@Router      /v1/items/{id}/restore [POST]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleItemRestore() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.ItemOut, error) {
+		auth := services.NewContext(r.Context())
+
+		item, err := ctrl.svc.Items.Restore(auth, ID)
+		if errors.Is(err, repo.ErrLocationTrashed) {
+			return repo.ItemOut{}, validate.NewRequestError(err, http.StatusConflict)
+		}
+
+		return item, err
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
 // HandleItemUpdate godocs
 //
 //	@Summary  Update Item
@@ -205,7 +245,7 @@ func (ctrl *V1Controller) HandleItemUpdate() errchain.HandlerFunc {
 		auth := services.NewContext(r.Context())
 
 		body.ID = ID
-		return ctrl.repo.Items.UpdateByGroup(auth, auth.GID, body)
+		return ctrl.svc.Items.Update(auth, body)
 	}
 
 	return adapters.ActionID("id", fn, http.StatusOK)
@@ -226,12 +266,7 @@ func (ctrl *V1Controller) HandleItemPatch() errchain.HandlerFunc {
 		auth := services.NewContext(r.Context())
 
 		body.ID = ID
-		err := ctrl.repo.Items.Patch(auth, auth.GID, ID, body)
-		if err != nil {
-			return repo.ItemOut{}, err
-		}
-
-		return ctrl.repo.Items.GetOneByGroup(auth, auth.GID, ID)
+		return ctrl.svc.Items.Patch(auth, ID, body)
 	}
 
 	return adapters.ActionID("id", fn, http.StatusOK)
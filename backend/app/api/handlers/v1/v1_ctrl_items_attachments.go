@@ -2,14 +2,18 @@ package v1
 
 import (
 	"errors"
+	"io"
+	"mime"
 	"net/http"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/hay-kot/homebox/backend/internal/core/services"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
 	"github.com/hay-kot/homebox/backend/internal/data/repo"
 	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+	"github.com/hay-kot/homebox/backend/pkgs/blobstore"
 	"github.com/hay-kot/httpkit/errchain"
 	"github.com/hay-kot/httpkit/server"
 	"github.com/rs/zerolog/log"
@@ -157,18 +161,38 @@ func (ctrl *V1Controller) handleItemAttachmentsHandler(w http.ResponseWriter, r
 	ctx := services.NewContext(r.Context())
 	switch r.Method {
 	case http.MethodGet:
-		doc, err := ctrl.svc.Items.AttachmentPath(r.Context(), attachmentID)
+		doc, err := ctrl.svc.Items.AttachmentOpen(r.Context(), attachmentID)
 		if err != nil {
-			log.Err(err).Msg("failed to get attachment path")
+			if errors.Is(err, blobstore.ErrNotFound) {
+				return validate.NewRequestError(err, http.StatusNotFound)
+			}
+
+			log.Err(err).Msg("failed to open attachment")
 			return validate.NewRequestError(err, http.StatusInternalServerError)
 		}
 
-		http.ServeFile(w, r, doc.Path)
-		return nil
+		if doc.URL != "" {
+			http.Redirect(w, r, doc.URL, http.StatusFound)
+			return nil
+		}
+		defer func() { _ = doc.Content.Close() }()
+
+		// Files on disk support range requests, other blob stores are streamed.
+		if rs, ok := doc.Content.(io.ReadSeeker); ok {
+			http.ServeContent(w, r, doc.Title, time.Time{}, rs)
+			return nil
+		}
+
+		if ct := mime.TypeByExtension(filepath.Ext(doc.Title)); ct != "" {
+			w.Header().Set("Content-Type", ct)
+		}
+
+		_, err = io.Copy(w, doc.Content)
+		return err
 
 	// Delete Attachment Handler
 	case http.MethodDelete:
-		err = ctrl.svc.Items.AttachmentDelete(r.Context(), ctx.GID, ID, attachmentID)
+		err = ctrl.svc.Items.AttachmentDelete(ctx, ID, attachmentID)
 		if err != nil {
 			log.Err(err).Msg("failed to delete attachment")
 			return validate.NewRequestError(err, http.StatusInternalServerError)
@@ -1,11 +1,13 @@
 package v1
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/core/services"
 	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/sys/validate"
 	"github.com/hay-kot/homebox/backend/internal/web/adapters"
 	"github.com/hay-kot/httpkit/errchain"
 )
@@ -58,7 +60,7 @@ func (ctrl *V1Controller) HandleLocationGetAll() errchain.HandlerFunc {
 func (ctrl *V1Controller) HandleLocationCreate() errchain.HandlerFunc {
 	fn := func(r *http.Request, createData repo.LocationCreate) (repo.LocationOut, error) {
 		auth := services.NewContext(r.Context())
-		return ctrl.repo.Locations.Create(auth, auth.GID, createData)
+		return ctrl.svc.Locations.Create(auth, createData)
 	}
 
 	return adapters.Action(fn, http.StatusCreated)
@@ -66,23 +68,50 @@ func (ctrl *V1Controller) HandleLocationCreate() errchain.HandlerFunc {
 
 // HandleLocationDelete godoc
 //
-//	@Summary  Delete Location
-//	@Tags     Locations
-//	@Produce  json
-//	@Param    id path string true "Location ID"
-//	@Success  204
-//	@Router   /v1/locations/{id} [DELETE]
-//	@Security Bearer
+//	@Summary     Delete Location
+//	@Description Moves the location, its child locations and their items to the trash.
+//	@Tags        Locations
+//	@Produce     json
+//	@Param       id path string true "Location ID"
+//	@Success     204
+//	@Router      /v1/locations/{id} [DELETE]
+//	@Security    Bearer
 func (ctrl *V1Controller) HandleLocationDelete() errchain.HandlerFunc {
 	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
 		auth := services.NewContext(r.Context())
-		err := ctrl.repo.Locations.DeleteByGroup(auth, auth.GID, ID)
+		err := ctrl.svc.Locations.Delete(auth, ID)
 		return nil, err
 	}
 
 	return adapters.CommandID("id", fn, http.StatusNoContent)
 }
 
+// HandleLocationRestore godoc
+//
+//	@Summary     Restore Location
+//	@Description Moves the location out of the trash along with the child locations and items deleted with it.
+//	@Description Fails with 409 if the parent location is in the trash.
+//	@Tags        Locations
+//	@Produce     json
+//	@Param       id  path     string true "Location ID"
+//	@Success     200 {object} repo.LocationOut
+//	@Router      /v1/locations/{id}/restore [POST]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleLocationRestore() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.LocationOut, error) {
+		auth := services.NewContext(r.Context())
+
+		loc, err := ctrl.svc.Locations.Restore(auth, ID)
+		if errors.Is(err, repo.ErrLocationTrashed) {
+			return repo.LocationOut{}, validate.NewRequestError(err, http.StatusConflict)
+		}
+
+		return loc, err
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
 // HandleLocationGet godoc
 //
 //	@Summary  Get Location
@@ -115,7 +144,7 @@ func (ctrl *V1Controller) HandleLocationUpdate() errchain.HandlerFunc {
 	fn := func(r *http.Request, ID uuid.UUID, body repo.LocationUpdate) (repo.LocationOut, error) {
 		auth := services.NewContext(r.Context())
 		body.ID = ID
-		return ctrl.repo.Locations.UpdateByGroup(auth, auth.GID, ID, body)
+		return ctrl.svc.Locations.Update(auth, body)
 	}
 
 	return adapters.ActionID("id", fn, http.StatusOK)
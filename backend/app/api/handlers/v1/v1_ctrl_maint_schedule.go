@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/web/adapters"
+	"github.com/hay-kot/httpkit/errchain"
+)
+
+// HandleMaintenanceSchedulesGet godoc
+//
+//	@Summary  Get Maintenance Schedules
+//	@Tags     Maintenance
+//	@Produce  json
+//	@Param    id  path     string true "Item ID"
+//	@Success  200 {object} []repo.MaintenanceSchedule
+//	@Router   /v1/items/{id}/maintenance/schedules [GET]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleMaintenanceSchedulesGet() errchain.HandlerFunc {
+	fn := func(r *http.Request, itemID uuid.UUID) ([]repo.MaintenanceSchedule, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.MaintSchedules.GetAll(auth, auth.GID, itemID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleMaintenanceScheduleCreate godoc
+//
+//	@Summary     Create Maintenance Schedule
+//	@Description Creates a recurring schedule and its first scheduled entry. The rule is either an
+//	@Description RRULE such as `FREQ=MONTHLY;INTERVAL=3` or plain text such as `every 90 days`, the next
+//	@Description entry is created when the previous one is completed and is counted from the completion date.
+//	@Tags        Maintenance
+//	@Produce     json
+//	@Param       id      path     string                         true "Item ID"
+//	@Param       payload body     repo.MaintenanceScheduleCreate true "Schedule Data"
+//	@Success     201     {object} repo.MaintenanceSchedule
+//	@Router      /v1/items/{id}/maintenance/schedules [POST]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleMaintenanceScheduleCreate() errchain.HandlerFunc {
+	fn := func(r *http.Request, itemID uuid.UUID, body repo.MaintenanceScheduleCreate) (repo.MaintenanceSchedule, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.MaintSchedules.Create(auth, auth.GID, itemID, body)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusCreated)
+}
+
+// HandleMaintenanceScheduleUpdate godoc
+//
+//	@Summary  Update Maintenance Schedule
+//	@Tags     Maintenance
+//	@Produce  json
+//	@Param    id          path     string                         true "Item ID"
+//	@Param    schedule_id path     string                         true "Schedule ID"
+//	@Param    payload     body     repo.MaintenanceScheduleUpdate true "Schedule Data"
+//	@Success  200         {object} repo.MaintenanceSchedule
+//	@Router   /v1/items/{id}/maintenance/schedules/{schedule_id} [PUT]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleMaintenanceScheduleUpdate() errchain.HandlerFunc {
+	fn := func(r *http.Request, scheduleID uuid.UUID, body repo.MaintenanceScheduleUpdate) (repo.MaintenanceSchedule, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.MaintSchedules.UpdateByGroup(auth, auth.GID, scheduleID, body)
+	}
+
+	return adapters.ActionID("schedule_id", fn, http.StatusOK)
+}
+
+// HandleMaintenanceScheduleDelete godoc
+//
+//	@Summary  Delete Maintenance Schedule
+//	@Tags     Maintenance
+//	@Produce  json
+//	@Param    id          path string true "Item ID"
+//	@Param    schedule_id path string true "Schedule ID"
+//	@Success  204
+//	@Router   /v1/items/{id}/maintenance/schedules/{schedule_id} [DELETE]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleMaintenanceScheduleDelete() errchain.HandlerFunc {
+	fn := func(r *http.Request, scheduleID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		err := ctrl.repo.MaintSchedules.DeleteByGroup(auth, auth.GID, scheduleID)
+		return nil, err
+	}
+
+	return adapters.CommandID("schedule_id", fn, http.StatusNoContent)
+}
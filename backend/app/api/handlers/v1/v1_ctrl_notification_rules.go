@@ -0,0 +1,124 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/web/adapters"
+	"github.com/hay-kot/httpkit/errchain"
+)
+
+// HandleNotificationRulesGetAll godoc
+//
+//	@Summary  Get Notification Rules
+//	@Tags     Notifications
+//	@Produce  json
+//	@Success  200 {object} []repo.NotificationRuleOut
+//	@Router   /v1/notifications/rules [GET]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleNotificationRulesGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.NotificationRuleOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.NotificationRules.GetAll(auth, auth.GID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleNotificationRuleCreate godoc
+//
+//	@Summary     Create Notification Rule
+//	@Description A rule sends a reminder before a date of the items in the group, the warranty expiry,
+//	@Description the due date of scheduled maintenance or a custom field holding a date. Each lead time is
+//	@Description sent once per date.
+//	@Tags        Notifications
+//	@Produce     json
+//	@Param       payload body     repo.NotificationRuleCreate true "Rule Data"
+//	@Success     201     {object} repo.NotificationRuleOut
+//	@Router      /v1/notifications/rules [POST]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleNotificationRuleCreate() errchain.HandlerFunc {
+	fn := func(r *http.Request, data repo.NotificationRuleCreate) (repo.NotificationRuleOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.NotificationRules.Create(auth, auth.GID, data)
+	}
+
+	return adapters.Action(fn, http.StatusCreated)
+}
+
+// HandleNotificationRuleGet godoc
+//
+//	@Summary  Get Notification Rule
+//	@Tags     Notifications
+//	@Produce  json
+//	@Param    id  path     string true "Rule ID"
+//	@Success  200 {object} repo.NotificationRuleOut
+//	@Router   /v1/notifications/rules/{id} [GET]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleNotificationRuleGet() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.NotificationRuleOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.NotificationRules.GetOneByGroup(auth, auth.GID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleNotificationRuleUpdate godoc
+//
+//	@Summary  Update Notification Rule
+//	@Tags     Notifications
+//	@Produce  json
+//	@Param    id      path     string                      true "Rule ID"
+//	@Param    payload body     repo.NotificationRuleUpdate true "Rule Data"
+//	@Success  200     {object} repo.NotificationRuleOut
+//	@Router   /v1/notifications/rules/{id} [PUT]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleNotificationRuleUpdate() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, data repo.NotificationRuleUpdate) (repo.NotificationRuleOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.NotificationRules.UpdateByGroup(auth, auth.GID, ID, data)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
+
+// HandleNotificationRuleDelete godoc
+//
+//	@Summary  Delete Notification Rule
+//	@Tags     Notifications
+//	@Produce  json
+//	@Param    id path string true "Rule ID"
+//	@Success  204
+//	@Router   /v1/notifications/rules/{id} [DELETE]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleNotificationRuleDelete() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		err := ctrl.repo.NotificationRules.DeleteByGroup(auth, auth.GID, ID)
+		return nil, err
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
+
+// HandleNotificationRemindersGet godoc
+//
+//	@Summary  Get Sent Reminders
+//	@Tags     Notifications
+//	@Produce  json
+//	@Param    page     query    int false "page number"
+//	@Param    pageSize query    int false "reminders per page"
+//	@Success  200      {object} repo.PaginationResult[repo.NotificationReminderOut]{}
+//	@Router   /v1/notifications/reminders [GET]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleNotificationRemindersGet() errchain.HandlerFunc {
+	fn := func(r *http.Request, q repo.NotificationReminderQuery) (repo.PaginationResult[repo.NotificationReminderOut], error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.NotificationRules.GetReminders(auth, auth.GID, q)
+	}
+
+	return adapters.Query(fn, http.StatusOK)
+}
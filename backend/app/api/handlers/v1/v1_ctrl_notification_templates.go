@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/core/services/notifications"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+	"github.com/hay-kot/homebox/backend/internal/web/adapters"
+	"github.com/hay-kot/httpkit/errchain"
+)
+
+// validateTemplate reports a template that fails to render as a validation error of the
+// body field.
+func validateTemplate(body string) error {
+	err := notifications.Validate(body)
+	if err != nil {
+		return validate.NewFieldErrors(validate.NewFieldError("body", err.Error()))
+	}
+	return nil
+}
+
+// HandleNotificationTemplatesGetAll godoc
+//
+//	@Summary  Get Notification Templates
+//	@Tags     Notifications
+//	@Produce  json
+//	@Success  200 {object} []repo.NotificationTemplateOut
+//	@Router   /v1/notifications/templates [GET]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleNotificationTemplatesGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.NotificationTemplateOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.NotificationTemplates.GetAll(auth, auth.GID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleNotificationTemplateCreate godoc
+//
+//	@Summary     Create Notification Template
+//	@Description Templates use the Go text/template syntax. A template with a notifier replaces the
+//	@Description template of the group for that notifier, the built-in template is used otherwise.
+//	@Description Fails with 409 if the type already has a template for the notifier. Requires the owner role.
+//	@Tags        Notifications
+//	@Produce     json
+//	@Param       payload body     repo.NotificationTemplateCreate true "Template Data"
+//	@Success     201     {object} repo.NotificationTemplateOut
+//	@Router      /v1/notifications/templates [POST]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleNotificationTemplateCreate() errchain.HandlerFunc {
+	fn := func(r *http.Request, data repo.NotificationTemplateCreate) (repo.NotificationTemplateOut, error) {
+		if err := validateTemplate(data.Body); err != nil {
+			return repo.NotificationTemplateOut{}, err
+		}
+
+		auth := services.NewContext(r.Context())
+
+		out, err := ctrl.repo.NotificationTemplates.Create(auth, auth.GID, data)
+		if errors.Is(err, repo.ErrNotificationTemplateExists) {
+			return repo.NotificationTemplateOut{}, validate.NewRequestError(err, http.StatusConflict)
+		}
+
+		return out, err
+	}
+
+	return adapters.Action(fn, http.StatusCreated)
+}
+
+// HandleNotificationTemplateUpdate godoc
+//
+//	@Summary  Update Notification Template
+//	@Tags     Notifications
+//	@Produce  json
+//	@Param    id      path     string                          true "Template ID"
+//	@Param    payload body     repo.NotificationTemplateUpdate true "Template Data"
+//	@Success  200     {object} repo.NotificationTemplateOut
+//	@Router   /v1/notifications/templates/{id} [PUT]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleNotificationTemplateUpdate() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, data repo.NotificationTemplateUpdate) (repo.NotificationTemplateOut, error) {
+		if err := validateTemplate(data.Body); err != nil {
+			return repo.NotificationTemplateOut{}, err
+		}
+
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.NotificationTemplates.UpdateByGroup(auth, auth.GID, ID, data)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
+
+// HandleNotificationTemplateDelete godoc
+//
+//	@Summary  Delete Notification Template
+//	@Tags     Notifications
+//	@Produce  json
+//	@Param    id path string true "Template ID"
+//	@Success  204
+//	@Router   /v1/notifications/templates/{id} [DELETE]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleNotificationTemplateDelete() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		err := ctrl.repo.NotificationTemplates.DeleteByGroup(auth, auth.GID, ID)
+		return nil, err
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
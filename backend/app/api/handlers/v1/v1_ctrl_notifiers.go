@@ -6,6 +6,7 @@ import (
 	"github.com/containrrr/shoutrrr"
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/core/services/notifications"
 	"github.com/hay-kot/homebox/backend/internal/data/repo"
 	"github.com/hay-kot/homebox/backend/internal/web/adapters"
 	"github.com/hay-kot/httpkit/errchain"
@@ -81,25 +82,109 @@ func (ctrl *V1Controller) HandleUpdateNotifier() errchain.HandlerFunc {
 	return adapters.ActionID("id", fn, http.StatusOK)
 }
 
+// HandleGetNotifierDeliveries godoc
+//
+//	@Summary     Get Notifier Deliveries
+//	@Description Lists every attempt to send a notification to the notifier, latest first.
+//	@Tags        Notifiers
+//	@Produce     json
+//	@Param       id       path     string true  "Notifier ID"
+//	@Param       page     query    int    false "page number"
+//	@Param       pageSize query    int    false "items per page"
+//	@Success     200      {object} repo.PaginationResult[repo.NotifierDeliveryOut]{}
+//	@Router      /v1/notifiers/{id}/deliveries [GET]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleGetNotifierDeliveries() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, q repo.NotifierDeliveryQuery) (repo.PaginationResult[repo.NotifierDeliveryOut], error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Notifiers.GetDeliveries(auth, auth.UID, ID, q)
+	}
+
+	return adapters.QueryID("id", fn, http.StatusOK)
+}
+
 // HandlerNotifierTest godoc
 //
-//	@Summary  Test Notifier
-//	@Tags     Notifiers
-//	@Produce  json
-//	@Param    id path string true "Notifier ID"
-//	@Param url query string true "URL"
-//	@Success  204
-//	@Router   /v1/notifiers/test [POST]
-//	@Security Bearer
+//	@Summary     Test Notifier
+//	@Description Sends a test message to the URL. When a type is given the message is a preview
+//	@Description of that notification type, see the preview endpoint.
+//	@Tags        Notifiers
+//	@Produce     json
+//	@Param       payload body NotifierTestBody true "Test Data"
+//	@Success     204
+//	@Router      /v1/notifiers/test [POST]
+//	@Security    Bearer
 func (ctrl *V1Controller) HandlerNotifierTest() errchain.HandlerFunc {
-	type body struct {
-		URL string `json:"url" validate:"required"`
-	}
+	fn := func(r *http.Request, q NotifierTestBody) (any, error) {
+		msg := "Test message from Homebox"
+
+		if q.Type != "" {
+			preview, err := ctrl.notifierPreview(r, NotifierPreviewBody{
+				Type:       q.Type,
+				NotifierID: q.NotifierID,
+				Body:       q.Body,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			msg = preview.Message
+		}
 
-	fn := func(r *http.Request, q body) (any, error) {
-		err := shoutrrr.Send(q.URL, "Test message from Homebox")
+		err := shoutrrr.Send(q.URL, msg)
 		return nil, err
 	}
 
 	return adapters.Action(fn, http.StatusOK)
 }
+
+type (
+	NotifierTestBody struct {
+		URL        string    `json:"url"        validate:"required"`
+		Type       string    `json:"type"       validate:"omitempty,oneof=maintenance reminder digest"`
+		NotifierID uuid.UUID `json:"notifierId"`
+		Body       string    `json:"body"       validate:"max=5000"`
+	}
+
+	NotifierPreviewBody struct {
+		Type       string    `json:"type"       validate:"required,oneof=maintenance reminder digest"`
+		NotifierID uuid.UUID `json:"notifierId"`
+		Body       string    `json:"body"       validate:"max=5000"`
+	}
+
+	NotifierPreview struct {
+		Message string `json:"message"`
+	}
+)
+
+// HandleNotifierPreview godoc
+//
+//	@Summary     Preview Notification
+//	@Description Renders a notification type with sample data. Without a body the template used
+//	@Description for the notifier is rendered, or the template of the group when no notifier is given.
+//	@Tags        Notifiers
+//	@Produce     json
+//	@Param       payload body     NotifierPreviewBody true "Preview Data"
+//	@Success     200     {object} NotifierPreview
+//	@Router      /v1/notifiers/preview [POST]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleNotifierPreview() errchain.HandlerFunc {
+	return adapters.Action(ctrl.notifierPreview, http.StatusOK)
+}
+
+func (ctrl *V1Controller) notifierPreview(r *http.Request, q NotifierPreviewBody) (NotifierPreview, error) {
+	if q.Body != "" {
+		if err := validateTemplate(q.Body); err != nil {
+			return NotifierPreview{}, err
+		}
+	}
+
+	auth := services.NewContext(r.Context())
+
+	msg, err := ctrl.svc.BackgroundService.Preview(auth, auth.GID, q.NotifierID, notifications.Type(q.Type), q.Body)
+	if err != nil {
+		return NotifierPreview{}, err
+	}
+
+	return NotifierPreview{Message: msg}, nil
+}
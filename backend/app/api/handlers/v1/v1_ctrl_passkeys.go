@@ -0,0 +1,138 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+	"github.com/hay-kot/homebox/backend/internal/web/adapters"
+	"github.com/hay-kot/httpkit/errchain"
+)
+
+// PasskeyRegistration completes the registration of a passkey, the credential is the
+// response of navigator.credentials.create().
+type PasskeyRegistration struct {
+	Name       string          `json:"name"       validate:"required,max=255"`
+	Credential json.RawMessage `json:"credential" validate:"required" swaggertype:"object"`
+}
+
+// passkeyError maps the errors of the passkey settings to request errors.
+func passkeyError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrorPasskeysDisabled):
+		return validate.NewRequestError(err, http.StatusServiceUnavailable)
+	case errors.Is(err, services.ErrorInvalidToken):
+		return validate.NewRequestError(errors.New("passkey registration has not been started or has expired"), http.StatusConflict)
+	case errors.Is(err, services.ErrorInvalidPasskey):
+		return validate.NewFieldErrors(validate.NewFieldError("credential", err.Error()))
+	}
+	return err
+}
+
+// HandlePasskeyRegistrationBegin godoc
+//
+//	@Summary     Start Passkey Registration
+//	@Description Returns the options for navigator.credentials.create() to register a new passkey.
+//	@Tags        User
+//	@Produce     json
+//	@Success     200 {object} protocol.CredentialCreation
+//	@Router      /v1/users/self/passkeys/register [POST]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandlePasskeyRegistrationBegin() errchain.HandlerFunc {
+	fn := func(r *http.Request) (*protocol.CredentialCreation, error) {
+		if ctrl.isDemo {
+			return nil, validate.NewRequestError(nil, http.StatusForbidden)
+		}
+
+		options, err := ctrl.svc.User.BeginPasskeyRegistration(services.NewContext(r.Context()))
+		if err != nil {
+			return nil, passkeyError(err)
+		}
+
+		return options, nil
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandlePasskeyRegistrationFinish godoc
+//
+//	@Summary     Finish Passkey Registration
+//	@Description Verifies the response of navigator.credentials.create() and stores the passkey.
+//	@Tags        User
+//	@Produce     json
+//	@Param       payload body     PasskeyRegistration true "Passkey Data"
+//	@Success     201     {object} repo.WebAuthnCredentialOut
+//	@Router      /v1/users/self/passkeys [POST]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandlePasskeyRegistrationFinish() errchain.HandlerFunc {
+	fn := func(r *http.Request, in PasskeyRegistration) (repo.WebAuthnCredentialOut, error) {
+		auth := services.NewContext(r.Context())
+
+		out, err := ctrl.svc.User.FinishPasskeyRegistration(auth, in.Name, bytes.NewReader(in.Credential))
+		if err != nil {
+			return repo.WebAuthnCredentialOut{}, passkeyError(err)
+		}
+
+		return out, nil
+	}
+
+	return adapters.Action(fn, http.StatusCreated)
+}
+
+// HandlePasskeysGetAll godoc
+//
+//	@Summary  Get Passkeys
+//	@Tags     User
+//	@Produce  json
+//	@Success  200 {object} []repo.WebAuthnCredentialOut
+//	@Router   /v1/users/self/passkeys [GET]
+//	@Security Bearer
+func (ctrl *V1Controller) HandlePasskeysGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.WebAuthnCredentialOut, error) {
+		return ctrl.svc.User.GetPasskeys(services.NewContext(r.Context()))
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandlePasskeyUpdate godoc
+//
+//	@Summary  Rename Passkey
+//	@Tags     User
+//	@Produce  json
+//	@Param    id      path     string                        true "Passkey ID"
+//	@Param    payload body     repo.WebAuthnCredentialUpdate true "Passkey Data"
+//	@Success  200     {object} repo.WebAuthnCredentialOut
+//	@Router   /v1/users/self/passkeys/{id} [PUT]
+//	@Security Bearer
+func (ctrl *V1Controller) HandlePasskeyUpdate() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, in repo.WebAuthnCredentialUpdate) (repo.WebAuthnCredentialOut, error) {
+		return ctrl.svc.User.RenamePasskey(services.NewContext(r.Context()), ID, in)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
+
+// HandlePasskeyDelete godoc
+//
+//	@Summary     Revoke Passkey
+//	@Description Deletes a passkey of the user, it can no longer be used to login.
+//	@Tags        User
+//	@Param       id path string true "Passkey ID"
+//	@Success     204
+//	@Router      /v1/users/self/passkeys/{id} [DELETE]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandlePasskeyDelete() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		return nil, ctrl.svc.User.DeletePasskey(services.NewContext(r.Context()), ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+	"github.com/hay-kot/homebox/backend/internal/web/adapters"
+	"github.com/hay-kot/httpkit/errchain"
+)
+
+// savedSearchError reports an invalid filter expression as a validation error of the
+// query.filter field.
+func savedSearchError(err error) error {
+	var ferr *repo.ItemFilterError
+	if errors.As(err, &ferr) {
+		return validate.NewFieldErrors(validate.NewFieldError("query.filter", ferr.Error()))
+	}
+	return err
+}
+
+// HandleSavedSearchesGetAll godoc
+//
+//	@Summary  Get All Saved Searches
+//	@Tags     Saved Searches
+//	@Produce  json
+//	@Param    withCount query    bool false "include the number of matching items"
+//	@Success  200       {object} []repo.SavedSearchOut
+//	@Router   /v1/saved-searches [GET]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleSavedSearchesGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request, q repo.SavedSearchListQuery) ([]repo.SavedSearchOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.SavedSearches.GetAll(auth, auth.GID, q.WithCount)
+	}
+
+	return adapters.Query(fn, http.StatusOK)
+}
+
+// HandleSavedSearchCreate godoc
+//
+//	@Summary  Create Saved Search
+//	@Tags     Saved Searches
+//	@Produce  json
+//	@Param    payload body     repo.SavedSearchCreate true "Saved Search Data"
+//	@Success  201     {object} repo.SavedSearchOut
+//	@Router   /v1/saved-searches [POST]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleSavedSearchCreate() errchain.HandlerFunc {
+	fn := func(r *http.Request, data repo.SavedSearchCreate) (repo.SavedSearchOut, error) {
+		auth := services.NewContext(r.Context())
+		out, err := ctrl.repo.SavedSearches.Create(auth, auth.GID, data)
+		return out, savedSearchError(err)
+	}
+
+	return adapters.Action(fn, http.StatusCreated)
+}
+
+// HandleSavedSearchGet godoc
+//
+//	@Summary  Get Saved Search
+//	@Tags     Saved Searches
+//	@Produce  json
+//	@Param    id  path     string true "Saved Search ID"
+//	@Success  200 {object} repo.SavedSearchOut
+//	@Router   /v1/saved-searches/{id} [GET]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleSavedSearchGet() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.SavedSearchOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.SavedSearches.GetOneByGroup(auth, auth.GID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleSavedSearchUpdate godoc
+//
+//	@Summary  Update Saved Search
+//	@Tags     Saved Searches
+//	@Produce  json
+//	@Param    id      path     string                 true "Saved Search ID"
+//	@Param    payload body     repo.SavedSearchUpdate true "Saved Search Data"
+//	@Success  200     {object} repo.SavedSearchOut
+//	@Router   /v1/saved-searches/{id} [PUT]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleSavedSearchUpdate() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, data repo.SavedSearchUpdate) (repo.SavedSearchOut, error) {
+		auth := services.NewContext(r.Context())
+		data.ID = ID
+		out, err := ctrl.repo.SavedSearches.UpdateByGroup(auth, auth.GID, data)
+		return out, savedSearchError(err)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
+
+// HandleSavedSearchDelete godoc
+//
+//	@Summary  Delete Saved Search
+//	@Tags     Saved Searches
+//	@Produce  json
+//	@Param    id path string true "Saved Search ID"
+//	@Success  204
+//	@Router   /v1/saved-searches/{id} [DELETE]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleSavedSearchDelete() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		err := ctrl.repo.SavedSearches.DeleteByGroup(auth, auth.GID, ID)
+		return nil, err
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
+
+// HandleSavedSearchItems godoc
+//
+//	@Summary     Evaluate Saved Search
+//	@Description Runs the saved query and returns the matching items in the saved sort order.
+//	@Tags        Saved Searches
+//	@Produce     json
+//	@Param       id       path     string true  "Saved Search ID"
+//	@Param       page     query    int    false "page number"
+//	@Param       pageSize query    int    false "items per page"
+//	@Success     200      {object} repo.PaginationResult[repo.ItemSummary]{}
+//	@Router      /v1/saved-searches/{id}/items [GET]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleSavedSearchItems() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, q repo.SavedSearchItemsQuery) (repo.PaginationResult[repo.ItemSummary], error) {
+		auth := services.NewContext(r.Context())
+		res, err := ctrl.repo.SavedSearches.Evaluate(auth, auth.GID, ID, q.Page, q.PageSize)
+		return res, savedSearchError(err)
+	}
+
+	return adapters.QueryID("id", fn, http.StatusOK)
+}
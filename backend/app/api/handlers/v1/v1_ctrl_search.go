@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/web/adapters"
+	"github.com/hay-kot/httpkit/errchain"
+)
+
+// HandleSearch godoc
+//
+//	@Summary     Search Items
+//	@Description Full-text search over the items of the group including their custom fields, labels, location
+//	@Description and maintenance entries. Results are ranked by relevance, the highlights are escaped as HTML
+//	@Description and matched words are wrapped in <mark> tags.
+//	@Tags        Search
+//	@Produce     json
+//	@Param       q        query    string true  "search query"
+//	@Param       page     query    int    false "page number"
+//	@Param       pageSize query    int    false "results per page"
+//	@Success     200      {object} repo.PaginationResult[repo.SearchResult]{}
+//	@Router      /v1/search [GET]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleSearch() errchain.HandlerFunc {
+	fn := func(r *http.Request, q repo.SearchQuery) (repo.PaginationResult[repo.SearchResult], error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Search.Search(auth, auth.GID, q)
+	}
+
+	return adapters.Query(fn, http.StatusOK)
+}
@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/web/adapters"
+	"github.com/hay-kot/httpkit/errchain"
+)
+
+// SessionsRevoked is the number of sessions that were logged out.
+type SessionsRevoked struct {
+	Revoked int `json:"revoked"`
+}
+
+// HandleSessionsGetAll godoc
+//
+//	@Summary     Get Sessions
+//	@Description Lists the active sessions of the user with the address and user agent of their
+//	@Description last request.
+//	@Tags        User
+//	@Produce     json
+//	@Success     200 {object} []repo.UserSessionOut
+//	@Router      /v1/users/self/sessions [GET]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleSessionsGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.UserSessionOut, error) {
+		return ctrl.svc.User.GetSessions(services.NewContext(r.Context()))
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleSessionsRevokeOthers godoc
+//
+//	@Summary     Revoke Other Sessions
+//	@Description Logs the user out of all sessions except the current one, API keys are kept.
+//	@Tags        User
+//	@Produce     json
+//	@Success     200 {object} SessionsRevoked
+//	@Router      /v1/users/self/sessions [DELETE]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleSessionsRevokeOthers() errchain.HandlerFunc {
+	fn := func(r *http.Request) (SessionsRevoked, error) {
+		n, err := ctrl.svc.User.RevokeOtherSessions(services.NewContext(r.Context()))
+		return SessionsRevoked{Revoked: n}, err
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleSessionRevoke godoc
+//
+//	@Summary  Revoke Session
+//	@Tags     User
+//	@Param    id path string true "Session ID"
+//	@Success  204
+//	@Router   /v1/users/self/sessions/{id} [DELETE]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleSessionRevoke() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		return nil, ctrl.svc.User.RevokeSession(services.NewContext(r.Context()), ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
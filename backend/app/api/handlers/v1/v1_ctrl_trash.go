@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/web/adapters"
+	"github.com/hay-kot/httpkit/errchain"
+)
+
+type Trash struct {
+	Items     []repo.TrashItem     `json:"items"`
+	Locations []repo.TrashLocation `json:"locations"`
+}
+
+// HandleTrashGet godoc
+//
+//	@Summary     Get Trash
+//	@Description Lists the deleted items and locations of the group that have not been purged yet.
+//	@Tags        Trash
+//	@Produce     json
+//	@Success     200 {object} Trash
+//	@Router      /v1/trash [GET]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleTrashGet() errchain.HandlerFunc {
+	fn := func(r *http.Request) (Trash, error) {
+		auth := services.NewContext(r.Context())
+
+		items, err := ctrl.repo.Items.GetTrash(auth, auth.GID)
+		if err != nil {
+			return Trash{}, err
+		}
+
+		locations, err := ctrl.repo.Locations.GetTrash(auth, auth.GID)
+		if err != nil {
+			return Trash{}, err
+		}
+
+		return Trash{Items: items, Locations: locations}, nil
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
@@ -0,0 +1,162 @@
+package v1
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+	"github.com/hay-kot/homebox/backend/internal/web/adapters"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/yeqown/go-qrcode/v2"
+	"github.com/yeqown/go-qrcode/writer/standard"
+)
+
+type (
+	TwoFactorEnable struct {
+		Code string `json:"code" validate:"required"`
+	}
+
+	// TwoFactorConfirm re-authenticates the user before two-factor settings are changed,
+	// the code is either a TOTP or a recovery code.
+	TwoFactorConfirm struct {
+		Password string `json:"password" validate:"required"`
+		Code     string `json:"code"     validate:"required"`
+	}
+)
+
+// twoFactorError maps the errors of the two-factor settings to request errors.
+func twoFactorError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrorTwoFactorEnabled),
+		errors.Is(err, services.ErrorTwoFactorNotEnabled),
+		errors.Is(err, services.ErrorTwoFactorNotStarted):
+		return validate.NewRequestError(err, http.StatusConflict)
+	case errors.Is(err, services.ErrorInvalidTwoFactorCode):
+		return validate.NewFieldErrors(validate.NewFieldError("code", err.Error()))
+	case errors.Is(err, services.ErrorInvalidLogin):
+		return validate.NewFieldErrors(validate.NewFieldError("password", "incorrect password"))
+	}
+	return err
+}
+
+// HandleTwoFactorSetup godoc
+//
+//	@Summary     Start Two-Factor Setup
+//	@Description Generates a new TOTP secret for the user, two-factor authentication is enabled once
+//	@Description a code of the secret is confirmed.
+//	@Tags        User
+//	@Produce     json
+//	@Success     200 {object} services.TwoFactorSetup
+//	@Router      /v1/users/self/2fa/setup [POST]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleTwoFactorSetup() errchain.HandlerFunc {
+	fn := func(r *http.Request) (services.TwoFactorSetup, error) {
+		if ctrl.isDemo {
+			return services.TwoFactorSetup{}, validate.NewRequestError(nil, http.StatusForbidden)
+		}
+
+		setup, err := ctrl.svc.User.SetupTwoFactor(services.NewContext(r.Context()))
+		return setup, twoFactorError(err)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleTwoFactorSetupQRCode godoc
+//
+//	@Summary  Get Two-Factor Setup QR Code
+//	@Tags     User
+//	@Produce  png
+//	@Success  200 {string} string "image/png"
+//	@Router   /v1/users/self/2fa/setup/qrcode [GET]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleTwoFactorSetupQRCode() errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		url, err := ctrl.svc.User.TwoFactorSetupURL(services.NewContext(r.Context()))
+		if err != nil {
+			return twoFactorError(err)
+		}
+
+		qrc, err := qrcode.New(url)
+		if err != nil {
+			return err
+		}
+
+		toWriteCloser := struct {
+			io.Writer
+			io.Closer
+		}{
+			Writer: w,
+			Closer: io.NopCloser(nil),
+		}
+
+		qrwriter := standard.NewWithWriter(toWriteCloser, standard.WithBuiltinImageEncoder(standard.PNG_FORMAT))
+
+		// The image contains the secret and must not be cached
+		w.Header().Set("Content-Type", "image/png")
+		w.Header().Set("Cache-Control", "no-store")
+		return qrc.Save(qrwriter)
+	}
+}
+
+// HandleTwoFactorEnable godoc
+//
+//	@Summary     Enable Two-Factor Authentication
+//	@Description Confirms the setup with a code of the new secret and returns the recovery codes,
+//	@Description they are only shown once.
+//	@Tags        User
+//	@Produce     json
+//	@Param       payload body     TwoFactorEnable true "TOTP Code"
+//	@Success     200     {object} services.TwoFactorRecoveryCodes
+//	@Router      /v1/users/self/2fa/enable [POST]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleTwoFactorEnable() errchain.HandlerFunc {
+	fn := func(r *http.Request, body TwoFactorEnable) (services.TwoFactorRecoveryCodes, error) {
+		if ctrl.isDemo {
+			return services.TwoFactorRecoveryCodes{}, validate.NewRequestError(nil, http.StatusForbidden)
+		}
+
+		codes, err := ctrl.svc.User.EnableTwoFactor(services.NewContext(r.Context()), body.Code)
+		return codes, twoFactorError(err)
+	}
+
+	return adapters.Action(fn, http.StatusOK)
+}
+
+// HandleTwoFactorDisable godoc
+//
+//	@Summary  Disable Two-Factor Authentication
+//	@Tags     User
+//	@Param    payload body TwoFactorConfirm true "Password and Code"
+//	@Success  204
+//	@Router   /v1/users/self/2fa/disable [POST]
+//	@Security Bearer
+func (ctrl *V1Controller) HandleTwoFactorDisable() errchain.HandlerFunc {
+	fn := func(r *http.Request, body TwoFactorConfirm) (any, error) {
+		err := ctrl.svc.User.DisableTwoFactor(services.NewContext(r.Context()), body.Password, body.Code)
+		return nil, twoFactorError(err)
+	}
+
+	return adapters.Action(fn, http.StatusNoContent)
+}
+
+// HandleTwoFactorRecoveryCodes godoc
+//
+//	@Summary     Regenerate Recovery Codes
+//	@Description Replaces the recovery codes of the user, the previous codes can no longer be used.
+//	@Tags        User
+//	@Produce     json
+//	@Param       payload body     TwoFactorConfirm true "Password and Code"
+//	@Success     200     {object} services.TwoFactorRecoveryCodes
+//	@Router      /v1/users/self/2fa/recovery-codes [POST]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleTwoFactorRecoveryCodes() errchain.HandlerFunc {
+	fn := func(r *http.Request, body TwoFactorConfirm) (services.TwoFactorRecoveryCodes, error) {
+		codes, err := ctrl.svc.User.RegenerateRecoveryCodes(services.NewContext(r.Context()), body.Password, body.Code)
+		return codes, twoFactorError(err)
+	}
+
+	return adapters.Action(fn, http.StatusOK)
+}
@@ -30,7 +30,7 @@ func (ctrl *V1Controller) HandleUserRegistration() errchain.HandlerFunc {
 			return validate.NewRequestError(err, http.StatusInternalServerError)
 		}
 
-		if !ctrl.allowRegistration && regData.GroupToken == "" {
+		if !ctrl.svc.Admin.RegistrationAllowed() && regData.GroupToken == "" {
 			return validate.NewRequestError(fmt.Errorf("user registration disabled"), http.StatusForbidden)
 		}
 
@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+	"github.com/hay-kot/homebox/backend/internal/web/adapters"
+	"github.com/hay-kot/httpkit/errchain"
+)
+
+// GroupJoin is the invitation token of a group to join.
+type GroupJoin struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// HandleUserGroupsGetAll godoc
+//
+//	@Summary     Get User Groups
+//	@Description Lists the groups the user is a member of with their role, the group the session
+//	@Description is acting in is marked as active.
+//	@Tags        User
+//	@Produce     json
+//	@Success     200 {object} []repo.GroupMembershipOut
+//	@Router      /v1/users/self/groups [GET]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleUserGroupsGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.GroupMembershipOut, error) {
+		return ctrl.svc.User.GetGroups(services.NewContext(r.Context()))
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleUserGroupJoin godoc
+//
+//	@Summary     Join Group
+//	@Description Adds the user to the group of an invitation as an editor.
+//	@Tags        User
+//	@Produce     json
+//	@Param       payload body     GroupJoin true "Invitation"
+//	@Success     201     {object} repo.GroupMembershipOut
+//	@Router      /v1/users/self/groups [POST]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleUserGroupJoin() errchain.HandlerFunc {
+	fn := func(r *http.Request, body GroupJoin) (repo.GroupMembershipOut, error) {
+		membership, err := ctrl.svc.User.JoinGroup(services.NewContext(r.Context()), body.Token)
+		if errors.Is(err, services.ErrorInvalidToken) {
+			return repo.GroupMembershipOut{}, validate.NewFieldErrors(
+				validate.NewFieldError("token", "invalid or expired invitation"),
+			)
+		}
+
+		return membership, err
+	}
+
+	return adapters.Action(fn, http.StatusCreated)
+}
+
+// HandleUserGroupSwitch godoc
+//
+//	@Summary     Switch Group
+//	@Description Changes the group the session is acting in, the user stays logged in.
+//	@Tags        User
+//	@Produce     json
+//	@Param       id  path     string true "Group ID"
+//	@Success     200 {object} Wrapped{item=repo.UserOut}
+//	@Router      /v1/users/self/groups/{id}/switch [POST]
+//	@Security    Bearer
+func (ctrl *V1Controller) HandleUserGroupSwitch() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (Wrapped, error) {
+		usr, err := ctrl.svc.User.SwitchGroup(services.NewContext(r.Context()), ID)
+		return Wrap(usr), err
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
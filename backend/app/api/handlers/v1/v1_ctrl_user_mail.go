@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+	"github.com/hay-kot/homebox/backend/internal/web/adapters"
+	"github.com/hay-kot/httpkit/errchain"
+)
+
+type (
+	EmailRequest struct {
+		Email string `json:"email" validate:"required,email"`
+	}
+
+	PasswordReset struct {
+		Token    string `json:"token"    validate:"required"`
+		Password string `json:"password" validate:"required,max=255"`
+	}
+
+	EmailVerification struct {
+		Token string `json:"token" validate:"required"`
+	}
+)
+
+// mailError maps the errors of the password reset and email verification flows to
+// request errors.
+func mailError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrorMailerNotConfigured):
+		return validate.NewRequestError(err, http.StatusServiceUnavailable)
+	case errors.Is(err, services.ErrorInvalidToken):
+		return validate.NewRequestError(err, http.StatusBadRequest)
+	}
+	return err
+}
+
+// HandleUserForgotPassword godoc
+//
+//	@Summary Request Password Reset
+//	@Tags    User
+//	@Param   payload body EmailRequest true "Email Address"
+//	@Success 204
+//	@Router  /v1/users/forgot-password [POST]
+func (ctrl *V1Controller) HandleUserForgotPassword() errchain.HandlerFunc {
+	fn := func(r *http.Request, body EmailRequest) (any, error) {
+		if ctrl.isDemo {
+			return nil, validate.NewRequestError(nil, http.StatusForbidden)
+		}
+
+		return nil, mailError(ctrl.svc.User.RequestPasswordReset(r.Context(), body.Email))
+	}
+
+	return adapters.Action(fn, http.StatusNoContent)
+}
+
+// HandleUserResetPassword godoc
+//
+//	@Summary Reset Password
+//	@Tags    User
+//	@Param   payload body PasswordReset true "Reset Token and new Password"
+//	@Success 204
+//	@Router  /v1/users/reset-password [POST]
+func (ctrl *V1Controller) HandleUserResetPassword() errchain.HandlerFunc {
+	fn := func(r *http.Request, body PasswordReset) (any, error) {
+		if ctrl.isDemo {
+			return nil, validate.NewRequestError(nil, http.StatusForbidden)
+		}
+
+		return nil, mailError(ctrl.svc.User.ResetPassword(r.Context(), body.Token, body.Password))
+	}
+
+	return adapters.Action(fn, http.StatusNoContent)
+}
+
+// HandleUserVerifyEmail godoc
+//
+//	@Summary Verify Email Address
+//	@Tags    User
+//	@Param   payload body EmailVerification true "Verification Token"
+//	@Success 204
+//	@Router  /v1/users/verify-email [POST]
+func (ctrl *V1Controller) HandleUserVerifyEmail() errchain.HandlerFunc {
+	fn := func(r *http.Request, body EmailVerification) (any, error) {
+		return nil, mailError(ctrl.svc.User.VerifyEmail(r.Context(), body.Token))
+	}
+
+	return adapters.Action(fn, http.StatusNoContent)
+}
+
+// HandleUserResendVerification godoc
+//
+//	@Summary Resend Verification Email
+//	@Tags    User
+//	@Param   payload body EmailRequest true "Email Address"
+//	@Success 204
+//	@Router  /v1/users/verify-email/resend [POST]
+func (ctrl *V1Controller) HandleUserResendVerification() errchain.HandlerFunc {
+	fn := func(r *http.Request, body EmailRequest) (any, error) {
+		return nil, mailError(ctrl.svc.User.SendVerification(r.Context(), body.Email))
+	}
+
+	return adapters.Action(fn, http.StatusNoContent)
+}
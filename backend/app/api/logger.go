@@ -22,3 +22,9 @@ func (a *app) setupLogger() {
 		zerolog.SetGlobalLevel(level)
 	}
 }
+
+// securityLogger returns the logger for security events such as login lockouts, its
+// entries can be filtered by the "log" field.
+func securityLogger() zerolog.Logger {
+	return log.With().Str("log", "security").Logger()
+}
@@ -27,8 +27,6 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/rs/zerolog/pkgerrors"
-
-	_ "github.com/hay-kot/homebox/backend/pkgs/cgofreesqlite"
 )
 
 var (
@@ -80,13 +78,12 @@ func run(cfg *config.Config) error {
 		log.Fatal().Err(err).Msg("failed to create data directory")
 	}
 
-	c, err := ent.Open("sqlite3", cfg.Storage.SqliteURL)
+	c, err := openDatabase(cfg.Storage)
 	if err != nil {
 		log.Fatal().
 			Err(err).
-			Str("driver", "sqlite").
-			Str("url", cfg.Storage.SqliteURL).
-			Msg("failed opening connection to sqlite")
+			Str("driver", cfg.Storage.Driver).
+			Msg("failed opening connection to database")
 	}
 	defer func(c *ent.Client) {
 		err := c.Close()
@@ -97,7 +94,7 @@ func run(cfg *config.Config) error {
 
 	temp := filepath.Join(os.TempDir(), "migrations")
 
-	err = migrations.Write(temp)
+	err = migrations.Write(temp, cfg.Storage.Driver)
 	if err != nil {
 		return err
 	}
@@ -113,12 +110,11 @@ func run(cfg *config.Config) error {
 		schema.WithDropIndex(true),
 	}
 
-	err = c.Schema.Create(context.Background(), options...)
+	err = migrations.Upgrade(context.Background(), c, options...)
 	if err != nil {
 		log.Fatal().
 			Err(err).
-			Str("driver", "sqlite").
-			Str("url", cfg.Storage.SqliteURL).
+			Str("driver", cfg.Storage.Driver).
 			Msg("failed creating schema resources")
 	}
 
@@ -128,6 +124,17 @@ func run(cfg *config.Config) error {
 		return err
 	}
 
+	// =========================================================================
+	// Run Commands
+
+	switch cmd := cfg.Args.Num(0); cmd {
+	case "":
+	case "migrate-documents":
+		return migrateDocuments(context.Background(), c, cfg.Storage, cfg.Args.Num(1), cfg.Args.Num(2))
+	default:
+		return fmt.Errorf("unknown command %q", cmd)
+	}
+
 	collectFuncs := []currencies.CollectorFunc{
 		currencies.CollectDefaults(),
 	}
@@ -157,22 +164,80 @@ func run(cfg *config.Config) error {
 
 	app.bus = eventbus.New()
 	app.db = c
-	app.repos = repo.New(c, app.bus, cfg.Storage.Data)
+
+	blobs, err := openBlobStore(cfg.Storage, cfg.Storage.Blob)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Str("blob", cfg.Storage.Blob).
+			Msg("failed to open blob storage")
+	}
+
+	app.repos = repo.New(
+		c,
+		app.bus,
+		cfg.Storage.Data,
+		repo.WithBlobStore(blobs),
+		repo.WithPresignedDownloads(cfg.Storage.S3.PresignExpiry),
+	)
+
+	indexed, err := app.repos.Search.Sync(context.Background())
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("failed to build search index")
+	}
+
+	if indexed > 0 {
+		log.Info().
+			Int("items", indexed).
+			Msg("rebuilt search index")
+	}
+
+	if cfg.Options.RequireEmailVerification && !app.mailer.Ready() {
+		log.Fatal().Msg("email verification requires a configured mailer")
+	}
+
+	if app.mailer.Ready() && cfg.Options.BaseURL == "" {
+		log.Warn().Msg("base url is not set, links in emails will not be absolute")
+	}
+
 	app.services = services.New(
 		app.repos,
 		services.WithAutoIncrementAssetID(cfg.Options.AutoIncrementAssetID),
+		services.WithBaseURL(cfg.Options.BaseURL),
 		services.WithCurrencies(currencies),
+		services.WithMailer(&app.mailer),
+		services.WithRequireEmailVerification(cfg.Options.RequireEmailVerification),
+		services.WithRegistration(cfg.Options.AllowRegistration),
+		services.WithSnapshots(services.SnapshotConfig{
+			Dir:        cfg.Backup.Dir,
+			DataDir:    cfg.Storage.Data,
+			KeepDaily:  cfg.Backup.KeepDaily,
+			KeepWeekly: cfg.Backup.KeepWeekly,
+		}),
 	)
 
+	if cfg.Backup.Dir != "" && !app.services.Snapshots.Enabled() {
+		log.Warn().
+			Str("driver", cfg.Storage.Driver).
+			Msg("backup directory is set but snapshots are only supported with sqlite3")
+	}
+
 	// =========================================================================
 	// Start Server
 
 	logger := log.With().Caller().Logger()
 
+	proxies, err := mid.ParseProxies(cfg.Web.TrustedProxies)
+	if err != nil {
+		return err
+	}
+
 	router := chi.NewMux()
 	router.Use(
 		middleware.RequestID,
-		middleware.RealIP,
+		mid.RealIP(proxies),
 		mid.Logger(logger),
 		middleware.Recoverer,
 		middleware.StripSlashes,
@@ -223,8 +288,31 @@ func run(cfg *config.Config) error {
 				Err(err).
 				Msg("failed to purge expired tokens")
 		}
+
+		_, err = app.repos.UserTokens.PurgeExpired(ctx)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Msg("failed to purge expired user tokens")
+		}
 	}))
 
+	if app.services.Snapshots.Enabled() {
+		runner.AddPlugin(NewTask("snapshot-database", cfg.Backup.Interval, func(ctx context.Context) {
+			path, err := app.services.Snapshots.Create(ctx)
+			if err != nil {
+				log.Error().
+					Err(err).
+					Msg("failed to create database snapshot")
+				return
+			}
+
+			log.Info().
+				Str("path", path).
+				Msg("created database snapshot")
+		}))
+	}
+
 	runner.AddPlugin(NewTask("purge-invitations", time.Duration(24)*time.Hour, func(ctx context.Context) {
 		_, err := app.repos.Groups.InvitationPurge(ctx)
 		if err != nil {
@@ -234,17 +322,35 @@ func run(cfg *config.Config) error {
 		}
 	}))
 
-	runner.AddPlugin(NewTask("send-notifications", time.Duration(1)*time.Hour, func(ctx context.Context) {
-		now := time.Now()
+	if cfg.Options.TrashRetention > 0 {
+		runner.AddPlugin(NewTask("purge-trash", time.Duration(24)*time.Hour, func(ctx context.Context) {
+			before := time.Now().Add(-cfg.Options.TrashRetention)
 
-		if now.Hour() == 8 {
-			fmt.Println("run notifiers")
-			err := app.services.BackgroundService.SendNotifiersToday(context.Background())
+			// Items first so items of trashed locations are purged with their own audit entries
+			_, err := app.repos.Items.PurgeTrash(ctx, before)
 			if err != nil {
 				log.Error().
 					Err(err).
-					Msg("failed to send notifiers")
+					Msg("failed to purge trashed items")
 			}
+
+			_, err = app.repos.Locations.PurgeTrash(ctx, before)
+			if err != nil {
+				log.Error().
+					Err(err).
+					Msg("failed to purge trashed locations")
+			}
+		}))
+	}
+
+	// Groups are notified at their own local time, so the task checks for due
+	// notifications throughout the day.
+	runner.AddPlugin(NewTask("send-notifications", time.Duration(10)*time.Minute, func(ctx context.Context) {
+		err := app.services.BackgroundService.SendNotifiersToday(ctx)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Msg("failed to send notifiers")
 		}
 	}))
 
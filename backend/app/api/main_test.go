@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/core/services/reporting/eventbus"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/sys/config"
+	"github.com/hay-kot/homebox/backend/internal/web/mid"
+	"github.com/hay-kot/homebox/backend/pkgs/faker"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/rs/zerolog"
+)
+
+var (
+	fk = faker.NewFaker()
+
+	tApp    *app
+	tRouter http.Handler
+
+	tOwner      testMember
+	tEditor     testMember
+	tViewer     testMember
+	tOtherOwner testMember
+)
+
+func TestMain(m *testing.M) {
+	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		log.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+
+	err = client.Schema.Create(context.Background())
+	if err != nil {
+		log.Fatalf("failed creating schema resources: %v", err)
+	}
+
+	cfg := &config.Config{}
+	cfg.Web.MaxUploadSize = 10
+	cfg.Options.AllowRegistration = true
+
+	tApp = new(cfg)
+	tApp.db = client
+	tApp.bus = eventbus.New()
+	tApp.repos = repo.New(client, tApp.bus, os.TempDir()+"/homebox")
+	tApp.services = services.New(tApp.repos, services.WithBaseURL("https://homebox.example.com"))
+
+	go func() {
+		_ = tApp.bus.Run(context.Background())
+	}()
+
+	router := chi.NewMux()
+	router.Use(middleware.RequestID)
+	tApp.mountRoutes(router, errchain.New(mid.Errors(zerolog.Nop())), tApp.repos)
+	tRouter = router
+
+	tOwner, tEditor, tViewer, err = newTestGroup()
+	if err != nil {
+		log.Fatalf("failed creating test group: %v", err)
+	}
+
+	tOtherOwner, _, _, err = newTestGroup()
+	if err != nil {
+		log.Fatalf("failed creating test group: %v", err)
+	}
+
+	defer func() { _ = client.Close() }()
+
+	os.Exit(m.Run())
+}
@@ -5,13 +5,17 @@ import (
 	"errors"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 
 	v1 "github.com/hay-kot/homebox/backend/app/api/handlers/v1"
 	"github.com/hay-kot/homebox/backend/internal/core/services"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+	"github.com/hay-kot/homebox/backend/internal/web/mid"
+	"github.com/hay-kot/homebox/backend/pkgs/ratelimit"
 	"github.com/hay-kot/httpkit/errchain"
+	"github.com/rs/zerolog/log"
 )
 
 type tokenHasKey struct {
@@ -71,6 +75,69 @@ func (a *app) mwRoles(rm RoleMode, required ...string) errchain.Middleware {
 	}
 }
 
+// mwPermission is a middleware that validates the acting user's role within their group
+// grants the required permission. If it does not, a 403 Forbidden will be returned.
+//
+// WARNING: This middleware _MUST_ be called after mwAuthToken
+func (a *app) mwPermission(p services.Permission) errchain.Middleware {
+	return func(next errchain.Handler) errchain.Handler {
+		return errchain.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			if err := services.NewContext(r.Context()).Authorize(p); err != nil {
+				return err
+			}
+
+			return next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// mwSuperuser is a middleware that only allows superusers of the instance, other users
+// get a 403 Forbidden. It complements mwRoles, which checks the roles of the token and
+// not the user.
+//
+// WARNING: This middleware _MUST_ be called after mwAuthToken
+func (a *app) mwSuperuser(next errchain.Handler) errchain.Handler {
+	return errchain.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		if err := services.NewContext(r.Context()).AuthorizeSuperuser(); err != nil {
+			return err
+		}
+
+		return next.ServeHTTP(w, r)
+	})
+}
+
+// mwRateLimit is a middleware that limits the requests that change data with a token
+// bucket per user, or per IP address when no user is authenticated. Safe methods are not
+// limited. Requests over the limit get a 429 Too Many Requests with a Retry-After header.
+//
+// A nil limiter allows all requests.
+func (a *app) mwRateLimit(l *ratelimit.Limiter) errchain.Middleware {
+	return func(next errchain.Handler) errchain.Handler {
+		return errchain.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				return next.ServeHTTP(w, r)
+			}
+
+			key := "ip:" + mid.ClientIP(r)
+			if usr := services.UseUserCtx(r.Context()); usr != nil {
+				key = "user:" + usr.ID.String()
+			}
+
+			err := l.Allow(key)
+			if err != nil {
+				var limited *ratelimit.LimitError
+				if errors.As(err, &limited) {
+					w.Header().Set("Retry-After", strconv.Itoa(limited.RetryAfterSeconds()))
+				}
+				return validate.NewRequestError(err, http.StatusTooManyRequests)
+			}
+
+			return next.ServeHTTP(w, r)
+		})
+	}
+}
+
 type KeyFunc func(r *http.Request) (string, error)
 
 func getBearer(r *http.Request) (string, error) {
@@ -148,6 +215,12 @@ func (a *app) mwAuthToken(next errchain.Handler) errchain.Handler {
 			return err
 		}
 
+		// The request is served even if its metadata could not be recorded.
+		err = a.services.User.RecordTokenUse(r.Context(), requestToken, mid.ClientIP(r), r.UserAgent())
+		if err != nil {
+			log.Err(err).Msg("failed to record token use")
+		}
+
 		r = r.WithContext(services.SetUserCtx(r.Context(), &usr, requestToken))
 		return next.ServeHTTP(w, r)
 	})
@@ -1,18 +1,25 @@
 package providers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/web/mid"
+	"github.com/hay-kot/homebox/backend/pkgs/ratelimit"
 )
 
 type LocalProvider struct {
 	service *services.UserService
+	guard   *ratelimit.LoginGuard
 }
 
-func NewLocalProvider(service *services.UserService) *LocalProvider {
+// NewLocalProvider returns the password login provider, failed logins are throttled by
+// the guard. A nil guard disables the throttling.
+func NewLocalProvider(service *services.UserService, guard *ratelimit.LoginGuard) *LocalProvider {
 	return &LocalProvider{
 		service: service,
+		guard:   guard,
 	}
 }
 
@@ -20,11 +27,29 @@ func (p *LocalProvider) Name() string {
 	return "local"
 }
 
+// Authenticate checks the username and password of the login form. While the account or
+// the IP address of the client is throttled a *ratelimit.LimitError is returned before
+// the password is checked.
 func (p *LocalProvider) Authenticate(w http.ResponseWriter, r *http.Request) (services.UserAuthTokenDetail, error) {
 	loginForm, err := getLoginForm(r)
 	if err != nil {
 		return services.UserAuthTokenDetail{}, err
 	}
 
-	return p.service.Login(r.Context(), loginForm.Username, loginForm.Password, loginForm.StayLoggedIn)
+	ip := mid.ClientIP(r)
+
+	err = p.guard.Attempt(loginForm.Username, ip)
+	if err != nil {
+		return services.UserAuthTokenDetail{}, err
+	}
+
+	token, err := p.service.Login(r.Context(), loginForm.Username, loginForm.Password, loginForm.StayLoggedIn)
+
+	var challenge *services.TwoFactorChallenge
+	if err == nil || errors.As(err, &challenge) || errors.Is(err, services.ErrorEmailNotVerified) || errors.Is(err, services.ErrorUserDisabled) {
+		// The password was correct.
+		p.guard.Succeed(loginForm.Username, ip)
+	}
+
+	return token, err
 }
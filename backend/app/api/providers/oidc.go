@@ -0,0 +1,247 @@
+package providers
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/sys/config"
+	"github.com/hay-kot/homebox/backend/pkgs/hasher"
+	"golang.org/x/oauth2"
+)
+
+const (
+	cookieNameOIDCState = "hb.oidc.state"
+	oidcStateTTL        = 10 * time.Minute
+)
+
+var (
+	ErrOIDCStateMissing  = errors.New("oidc login state is missing or expired")
+	ErrOIDCStateMismatch = errors.New("oidc state does not match")
+	ErrOIDCNonceMismatch = errors.New("oidc nonce does not match")
+	ErrOIDCNoIDToken     = errors.New("oidc token response did not contain an id_token")
+	ErrOIDCEmailClaim    = errors.New("oidc id_token is missing a verified email claim")
+)
+
+// oidcState is stored in a short lived cookie between the redirect to the identity
+// provider and the callback. It holds the values needed to validate the callback
+// and complete the PKCE exchange.
+type oidcState struct {
+	State      string `json:"state"`
+	Nonce      string `json:"nonce"`
+	Verifier   string `json:"verifier"`
+	GroupToken string `json:"groupToken,omitempty"`
+	Remember   bool   `json:"remember,omitempty"`
+}
+
+// OIDCIdentity is the user information mapped from the claims of a verified ID token.
+type OIDCIdentity struct {
+	Subject string
+	Email   string
+	Name    string
+}
+
+// OIDCProvider authenticates users against an OpenID Connect identity provider using the
+// authorization code flow with PKCE. Users that do not exist yet are created just-in-time.
+type OIDCProvider struct {
+	service       *services.UserService
+	oauth         *oauth2.Config
+	verifier      *oidc.IDTokenVerifier
+	emailClaim    string
+	nameClaim     string
+	groupID       uuid.UUID
+	allowNewGroup func() bool
+	secure        bool
+}
+
+// NewOIDCProvider creates a provider using the discovery document of the configured issuer.
+// allowRegistration is asked on every login if a new group is created for users that sign
+// in without an invitation when no group is configured.
+func NewOIDCProvider(ctx context.Context, service *services.UserService, cfg config.OIDCConf, allowRegistration func() bool) (*OIDCProvider, error) {
+	if !cfg.Ready() {
+		return nil, errors.New("oidc issuer url, client id and redirect url are required")
+	}
+
+	var groupID uuid.UUID
+	if cfg.GroupID != "" {
+		var err error
+		groupID, err = uuid.Parse(cfg.GroupID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid oidc group id: %w", err)
+		}
+	}
+
+	provider, err := oidc.NewProvider(ctx, cfg.IssuerURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover oidc provider: %w", err)
+	}
+
+	return &OIDCProvider{
+		service: service,
+		oauth: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Endpoint:     provider.Endpoint(),
+			Scopes:       cfg.Scopes,
+		},
+		verifier:      provider.Verifier(&oidc.Config{ClientID: cfg.ClientID}),
+		emailClaim:    cfg.EmailClaim,
+		nameClaim:     cfg.NameClaim,
+		groupID:       groupID,
+		allowNewGroup: allowRegistration,
+		secure:        strings.HasPrefix(cfg.RedirectURL, "https://"),
+	}, nil
+}
+
+func (p *OIDCProvider) Name() string {
+	return "oidc"
+}
+
+// BeginAuth starts the login flow and returns the authorization URL of the identity provider
+// the user should be redirected to. The optional "token" query parameter is an invitation token
+// used to add new users to the invited group.
+func (p *OIDCProvider) BeginAuth(w http.ResponseWriter, r *http.Request) (string, error) {
+	st := oidcState{
+		State:      hasher.GenerateToken().Raw,
+		Nonce:      hasher.GenerateToken().Raw,
+		Verifier:   oauth2.GenerateVerifier(),
+		GroupToken: r.URL.Query().Get("token"),
+		Remember:   r.URL.Query().Get("stayLoggedIn") == "true",
+	}
+
+	b, err := json.Marshal(st)
+	if err != nil {
+		return "", err
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     cookieNameOIDCState,
+		Value:    base64.RawURLEncoding.EncodeToString(b),
+		Path:     "/",
+		MaxAge:   int(oidcStateTTL.Seconds()),
+		Secure:   p.secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return p.oauth.AuthCodeURL(st.State, oidc.Nonce(st.Nonce), oauth2.S256ChallengeOption(st.Verifier)), nil
+}
+
+// Authenticate completes the login flow from the callback request of the identity provider.
+func (p *OIDCProvider) Authenticate(w http.ResponseWriter, r *http.Request) (services.UserAuthTokenDetail, error) {
+	st, err := p.readState(w, r)
+	if err != nil {
+		return services.UserAuthTokenDetail{}, err
+	}
+
+	q := r.URL.Query()
+	if e := q.Get("error"); e != "" {
+		return services.UserAuthTokenDetail{}, fmt.Errorf("oidc provider returned an error: %s %s", e, q.Get("error_description"))
+	}
+
+	if q.Get("state") != st.State {
+		return services.UserAuthTokenDetail{}, ErrOIDCStateMismatch
+	}
+
+	ident, err := p.Exchange(r.Context(), q.Get("code"), st.Verifier, st.Nonce)
+	if err != nil {
+		return services.UserAuthTokenDetail{}, err
+	}
+
+	return p.service.LoginExternal(r.Context(), services.ExternalIdentity{
+		Email:         ident.Email,
+		Name:          ident.Name,
+		GroupToken:    st.GroupToken,
+		GroupID:       p.groupID,
+		AllowNewGroup: p.allowNewGroup(),
+	}, st.Remember)
+}
+
+// Exchange trades the authorization code for tokens and maps the claims of the verified
+// ID token to an OIDCIdentity.
+func (p *OIDCProvider) Exchange(ctx context.Context, code, verifier, nonce string) (OIDCIdentity, error) {
+	token, err := p.oauth.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return OIDCIdentity{}, fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+
+	raw, ok := token.Extra("id_token").(string)
+	if !ok {
+		return OIDCIdentity{}, ErrOIDCNoIDToken
+	}
+
+	idToken, err := p.verifier.Verify(ctx, raw)
+	if err != nil {
+		return OIDCIdentity{}, fmt.Errorf("failed to verify id_token: %w", err)
+	}
+
+	if idToken.Nonce != nonce {
+		return OIDCIdentity{}, ErrOIDCNonceMismatch
+	}
+
+	claims := map[string]any{}
+	if err := idToken.Claims(&claims); err != nil {
+		return OIDCIdentity{}, err
+	}
+
+	email, _ := claims[p.emailClaim].(string)
+	if email == "" {
+		return OIDCIdentity{}, ErrOIDCEmailClaim
+	}
+
+	// Users are matched by email, so only an address the identity provider reports
+	// as verified is trusted. A missing claim is treated as unverified.
+	if verified, _ := claims["email_verified"].(bool); !verified {
+		return OIDCIdentity{}, ErrOIDCEmailClaim
+	}
+
+	name, _ := claims[p.nameClaim].(string)
+	if name == "" {
+		name = email
+	}
+
+	return OIDCIdentity{
+		Subject: idToken.Subject,
+		Email:   email,
+		Name:    name,
+	}, nil
+}
+
+// readState reads and clears the state cookie set by BeginAuth.
+func (p *OIDCProvider) readState(w http.ResponseWriter, r *http.Request) (oidcState, error) {
+	cookie, err := r.Cookie(cookieNameOIDCState)
+	if err != nil {
+		return oidcState{}, ErrOIDCStateMissing
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     cookieNameOIDCState,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		Secure:   p.secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	b, err := base64.RawURLEncoding.DecodeString(cookie.Value)
+	if err != nil {
+		return oidcState{}, ErrOIDCStateMissing
+	}
+
+	var st oidcState
+	if err := json.Unmarshal(b, &st); err != nil {
+		return oidcState{}, ErrOIDCStateMissing
+	}
+
+	return st, nil
+}
@@ -0,0 +1,158 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hay-kot/homebox/backend/app/api/providers/oidctest"
+	"github.com/hay-kot/homebox/backend/internal/sys/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockClientID    = "homebox"
+	mockRedirectURL = "http://homebox.local/api/v1/users/login/oidc/callback"
+)
+
+func newMockIdP(t *testing.T) *oidctest.IdP {
+	t.Helper()
+
+	idp := oidctest.New(mockClientID)
+	t.Cleanup(idp.Close)
+
+	return idp
+}
+
+func newTestOIDCProvider(t *testing.T, idp *oidctest.IdP) *OIDCProvider {
+	t.Helper()
+
+	p, err := NewOIDCProvider(context.Background(), nil, config.OIDCConf{
+		IssuerURL:   idp.URL(),
+		ClientID:    mockClientID,
+		RedirectURL: mockRedirectURL,
+		Scopes:      []string{"openid", "profile", "email"},
+		EmailClaim:  "email",
+		NameClaim:   "name",
+	}, func() bool { return true })
+	require.NoError(t, err)
+
+	return p
+}
+
+// login runs BeginAuth and follows the authorization request to the mock identity provider,
+// returning the callback request the identity provider redirected to.
+func login(t *testing.T, p *OIDCProvider) *http.Request {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	authURL, err := p.BeginAuth(rec, httptest.NewRequest(http.MethodGet, "/api/v1/users/login/oidc?stayLoggedIn=true", nil))
+	require.NoError(t, err)
+
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+
+	resp, err := client.Get(authURL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	callback := httptest.NewRequest(http.MethodGet, resp.Header.Get("Location"), nil)
+	for _, c := range rec.Result().Cookies() {
+		callback.AddCookie(c)
+	}
+
+	return callback
+}
+
+func TestOIDCProvider_Exchange(t *testing.T) {
+	idp := newMockIdP(t)
+	p := newTestOIDCProvider(t, idp)
+
+	callback := login(t, p)
+
+	st, err := p.readState(httptest.NewRecorder(), callback)
+	require.NoError(t, err)
+	assert.True(t, st.Remember)
+	assert.Equal(t, st.State, callback.URL.Query().Get("state"))
+
+	ident, err := p.Exchange(context.Background(), callback.URL.Query().Get("code"), st.Verifier, st.Nonce)
+	require.NoError(t, err)
+
+	assert.Equal(t, "user-1", ident.Subject)
+	assert.Equal(t, "oidc-user@example.com", ident.Email)
+	assert.Equal(t, "OIDC User", ident.Name)
+}
+
+func TestOIDCProvider_Exchange_Errors(t *testing.T) {
+	idp := newMockIdP(t)
+	p := newTestOIDCProvider(t, idp)
+
+	t.Run("invalid verifier", func(t *testing.T) {
+		callback := login(t, p)
+		st, err := p.readState(httptest.NewRecorder(), callback)
+		require.NoError(t, err)
+
+		_, err = p.Exchange(context.Background(), callback.URL.Query().Get("code"), "not-the-verifier-not-the-verifier-00000000", st.Nonce)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid nonce", func(t *testing.T) {
+		callback := login(t, p)
+		st, err := p.readState(httptest.NewRecorder(), callback)
+		require.NoError(t, err)
+
+		_, err = p.Exchange(context.Background(), callback.URL.Query().Get("code"), st.Verifier, "other-nonce")
+		require.ErrorIs(t, err, ErrOIDCNonceMismatch)
+	})
+
+	t.Run("unverified email", func(t *testing.T) {
+		idp.SetClaim("email_verified", false)
+		defer idp.SetClaim("email_verified", true)
+
+		callback := login(t, p)
+		st, err := p.readState(httptest.NewRecorder(), callback)
+		require.NoError(t, err)
+
+		_, err = p.Exchange(context.Background(), callback.URL.Query().Get("code"), st.Verifier, st.Nonce)
+		require.ErrorIs(t, err, ErrOIDCEmailClaim)
+	})
+
+	t.Run("missing email_verified claim", func(t *testing.T) {
+		idp.SetClaim("email_verified", nil)
+		defer idp.SetClaim("email_verified", true)
+
+		callback := login(t, p)
+		st, err := p.readState(httptest.NewRecorder(), callback)
+		require.NoError(t, err)
+
+		_, err = p.Exchange(context.Background(), callback.URL.Query().Get("code"), st.Verifier, st.Nonce)
+		require.ErrorIs(t, err, ErrOIDCEmailClaim)
+	})
+}
+
+func TestOIDCProvider_Authenticate_State(t *testing.T) {
+	idp := newMockIdP(t)
+	p := newTestOIDCProvider(t, idp)
+
+	t.Run("missing state cookie", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/callback?code=abc&state=xyz", nil)
+
+		_, err := p.Authenticate(httptest.NewRecorder(), r)
+		require.ErrorIs(t, err, ErrOIDCStateMissing)
+	})
+
+	t.Run("state mismatch", func(t *testing.T) {
+		callback := login(t, p)
+
+		q := callback.URL.Query()
+		q.Set("state", "forged")
+		callback.URL.RawQuery = q.Encode()
+
+		_, err := p.Authenticate(httptest.NewRecorder(), callback)
+		require.ErrorIs(t, err, ErrOIDCStateMismatch)
+	})
+}
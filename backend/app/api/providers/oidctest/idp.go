@@ -0,0 +1,194 @@
+// Package oidctest provides a minimal OpenID Connect identity provider to test logins
+// through the OIDC provider without an external service.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+// IdP supports discovery, the authorization code flow with PKCE and RS256 signed ID
+// tokens. Every authorization request is approved immediately.
+type IdP struct {
+	clientID string
+	server   *httptest.Server
+	key      *rsa.PrivateKey
+
+	mu     sync.Mutex
+	claims map[string]any
+	codes  map[string]authRequest
+}
+
+type authRequest struct {
+	challenge string
+	nonce     string
+}
+
+// New starts an identity provider issuing ID tokens for the client. The claims of the
+// tokens default to a user with a verified email and can be changed with SetClaim.
+func New(clientID string) *IdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+
+	idp := &IdP{
+		clientID: clientID,
+		key:      key,
+		codes:    map[string]authRequest{},
+		claims: map[string]any{
+			"sub":            "user-1",
+			"email":          "oidc-user@example.com",
+			"email_verified": true,
+			"name":           "OIDC User",
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", idp.discovery)
+	mux.HandleFunc("/keys", idp.keys)
+	mux.HandleFunc("/authorize", idp.authorize)
+	mux.HandleFunc("/token", idp.token)
+
+	idp.server = httptest.NewServer(mux)
+
+	return idp
+}
+
+// URL returns the issuer URL of the identity provider.
+func (m *IdP) URL() string {
+	return m.server.URL
+}
+
+func (m *IdP) Close() {
+	m.server.Close()
+}
+
+// SetClaim sets a claim of the ID tokens issued from now on, a nil value removes it.
+func (m *IdP) SetClaim(k string, v any) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if v == nil {
+		delete(m.claims, k)
+		return
+	}
+	m.claims[k] = v
+}
+
+func (m *IdP) discovery(w http.ResponseWriter, _ *http.Request) {
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"issuer":                                m.server.URL,
+		"authorization_endpoint":                m.server.URL + "/authorize",
+		"token_endpoint":                        m.server.URL + "/token",
+		"jwks_uri":                              m.server.URL + "/keys",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (m *IdP) keys(w http.ResponseWriter, _ *http.Request) {
+	_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{
+			{Key: &m.key.PublicKey, KeyID: "test", Algorithm: string(jose.RS256), Use: "sig"},
+		},
+	})
+}
+
+// authorize immediately approves the request and redirects back with a code.
+func (m *IdP) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		http.Error(w, "pkce is required", http.StatusBadRequest)
+		return
+	}
+
+	code := "code-" + q.Get("state")
+
+	m.mu.Lock()
+	m.codes[code] = authRequest{challenge: q.Get("code_challenge"), nonce: q.Get("nonce")}
+	m.mu.Unlock()
+
+	redirect, _ := url.Parse(q.Get("redirect_uri"))
+	redirect.RawQuery = url.Values{"code": {code}, "state": {q.Get("state")}}.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (m *IdP) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	m.mu.Lock()
+	req, ok := m.codes[r.PostForm.Get("code")]
+	delete(m.codes, r.PostForm.Get("code"))
+	m.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != req.challenge {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+		return
+	}
+
+	claims := map[string]any{
+		"iss":   m.server.URL,
+		"aud":   m.clientID,
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"nonce": req.nonce,
+	}
+
+	m.mu.Lock()
+	for k, v := range m.claims {
+		claims[k] = v
+	}
+	m.mu.Unlock()
+
+	idToken, err := m.sign(claims)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"access_token": "access-token",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+func (m *IdP) sign(claims map[string]any) (string, error) {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: m.key},
+		(&jose.SignerOptions{}).WithHeader("kid", "test"),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	jws, err := signer.Sign(payload)
+	if err != nil {
+		return "", err
+	}
+
+	return jws.CompactSerialize()
+}
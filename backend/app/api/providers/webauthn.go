@@ -0,0 +1,71 @@
+package providers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+)
+
+const cookieNameWebAuthnLogin = "hb.webauthn.login"
+
+// WebAuthnProvider authenticates users with a passkey. The login is started with BeginAuth,
+// the browser signs the returned challenge with navigator.credentials.get() and posts the
+// result to the login route with the "webauthn" provider.
+type WebAuthnProvider struct {
+	service *services.UserService
+	secure  bool
+}
+
+func NewWebAuthnProvider(service *services.UserService, secure bool) *WebAuthnProvider {
+	return &WebAuthnProvider{
+		service: service,
+		secure:  secure,
+	}
+}
+
+func (p *WebAuthnProvider) Name() string {
+	return "webauthn"
+}
+
+// BeginAuth returns the options of a passkey login and stores the token identifying the
+// login in a short lived cookie.
+func (p *WebAuthnProvider) BeginAuth(w http.ResponseWriter, r *http.Request) (any, error) {
+	login, err := p.service.BeginPasskeyLogin(r.Context(), r.URL.Query().Get("stayLoggedIn") == "true")
+	if err != nil {
+		return nil, err
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     cookieNameWebAuthnLogin,
+		Value:    login.Token,
+		Path:     "/",
+		MaxAge:   int(time.Until(login.ExpiresAt).Seconds()),
+		Secure:   p.secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+
+	return login.Options, nil
+}
+
+// Authenticate verifies the signed challenge in the request body, the cookie set by
+// BeginAuth is cleared so that the login can not be attempted again.
+func (p *WebAuthnProvider) Authenticate(w http.ResponseWriter, r *http.Request) (services.UserAuthTokenDetail, error) {
+	cookie, err := r.Cookie(cookieNameWebAuthnLogin)
+	if err != nil {
+		return services.UserAuthTokenDetail{}, services.ErrorInvalidToken
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     cookieNameWebAuthnLogin,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		Secure:   p.secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+
+	return p.service.LoginPasskey(r.Context(), cookie.Value, r.Body)
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"time"
+
+	"github.com/hay-kot/homebox/backend/internal/sys/config"
+	"github.com/hay-kot/homebox/backend/pkgs/ratelimit"
+)
+
+// newLoginGuard returns the guard for password logins, lockouts are written to the
+// security log. Nil is returned when the login limits are disabled.
+func newLoginGuard(c config.LoginLimitConf) *ratelimit.LoginGuard {
+	if !c.Enabled {
+		return nil
+	}
+
+	policy := func(maxAttempts int) ratelimit.Policy {
+		return ratelimit.Policy{
+			FreeAttempts: c.FreeAttempts,
+			BaseDelay:    c.BaseDelay,
+			MaxDelay:     c.MaxDelay,
+			MaxAttempts:  maxAttempts,
+			Lockout:      c.LockoutDuration,
+			Window:       c.Window,
+		}
+	}
+
+	security := securityLogger()
+
+	return ratelimit.NewLoginGuard(policy(c.AccountAttempts), policy(c.IPAttempts), func(l ratelimit.Lockout) {
+		security.Warn().
+			Str("kind", string(l.Kind)).
+			Str("key", l.Key).
+			Int("failures", l.Failures).
+			Time("until", l.Until).
+			Dur("duration", time.Until(l.Until)).
+			Msg("login locked out after too many failed attempts")
+	})
+}
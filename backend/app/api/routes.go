@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"embed"
 	"errors"
 	"io"
@@ -8,15 +9,19 @@ import (
 	"net/http"
 	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/hay-kot/homebox/backend/app/api/handlers/debughandlers"
 	v1 "github.com/hay-kot/homebox/backend/app/api/handlers/v1"
 	"github.com/hay-kot/homebox/backend/app/api/providers"
 	_ "github.com/hay-kot/homebox/backend/app/api/static/docs"
+	"github.com/hay-kot/homebox/backend/internal/core/services"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authroles"
 	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/pkgs/ratelimit"
 	"github.com/hay-kot/httpkit/errchain"
+	"github.com/rs/zerolog/log"
 	httpSwagger "github.com/swaggo/http-swagger/v2" // http-swagger middleware
 )
 
@@ -54,7 +59,6 @@ func (a *app) mountRoutes(r *chi.Mux, chain *errchain.ErrChain, repos *repo.AllR
 		a.repos,
 		a.bus,
 		v1.WithMaxUploadSize(a.conf.Web.MaxUploadSize),
-		v1.WithRegistration(a.conf.Options.AllowRegistration),
 		v1.WithDemoStatus(a.conf.Demo), // Disable Password Change in Demo Mode
 	)
 
@@ -66,16 +70,94 @@ func (a *app) mountRoutes(r *chi.Mux, chain *errchain.ErrChain, repos *repo.AllR
 
 	r.Get(v1Base("/currencies"), chain.ToHandlerFunc(v1Ctrl.HandleCurrency()))
 
-	providers := []v1.AuthProvider{
-		providers.NewLocalProvider(a.services.User),
+	// Requests that change data are limited per user, or per IP address for the public
+	// routes below. Failed password logins are additionally throttled by the login guard.
+	apiLimiter := ratelimit.NewLimiter(a.conf.RateLimit.API.Rate, a.conf.RateLimit.API.Burst)
+
+	publicMW := []errchain.Middleware{
+		a.mwRateLimit(apiLimiter),
+	}
+
+	authProviders := []v1.AuthProvider{
+		providers.NewLocalProvider(a.services.User, newLoginGuard(a.conf.RateLimit.Login)),
+	}
+
+	if a.conf.OIDC.Enabled {
+		oidcProvider, err := providers.NewOIDCProvider(context.Background(), a.services.User, a.conf.OIDC, a.services.Admin.RegistrationAllowed)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to configure oidc provider")
+		}
+
+		authProviders = append(authProviders, oidcProvider)
+
+		r.Get(v1Base("/users/login/"+oidcProvider.Name()), chain.ToHandlerFunc(v1Ctrl.HandleAuthRedirect(oidcProvider)))
+		r.Get(v1Base("/users/login/"+oidcProvider.Name()+"/callback"), chain.ToHandlerFunc(v1Ctrl.HandleAuthCallback(oidcProvider)))
 	}
 
-	r.Post(v1Base("/users/register"), chain.ToHandlerFunc(v1Ctrl.HandleUserRegistration()))
-	r.Post(v1Base("/users/login"), chain.ToHandlerFunc(v1Ctrl.HandleAuthLogin(providers...)))
+	if a.services.User.PasskeysEnabled() {
+		webauthnProvider := providers.NewWebAuthnProvider(a.services.User, strings.HasPrefix(a.conf.Options.BaseURL, "https://"))
+
+		authProviders = append(authProviders, webauthnProvider)
+
+		r.Post(v1Base("/users/login/"+webauthnProvider.Name()+"/begin"), chain.ToHandlerFunc(v1Ctrl.HandleAuthChallenge(webauthnProvider), publicMW...))
+	}
+
+	r.Post(v1Base("/users/register"), chain.ToHandlerFunc(v1Ctrl.HandleUserRegistration(), publicMW...))
+	r.Post(v1Base("/users/login"), chain.ToHandlerFunc(v1Ctrl.HandleAuthLogin(authProviders...), publicMW...))
+	r.Post(v1Base("/users/login/2fa"), chain.ToHandlerFunc(v1Ctrl.HandleAuthLoginTwoFactor(), publicMW...))
+	r.Post(v1Base("/users/forgot-password"), chain.ToHandlerFunc(v1Ctrl.HandleUserForgotPassword(), publicMW...))
+	r.Post(v1Base("/users/reset-password"), chain.ToHandlerFunc(v1Ctrl.HandleUserResetPassword(), publicMW...))
+	r.Post(v1Base("/users/verify-email"), chain.ToHandlerFunc(v1Ctrl.HandleUserVerifyEmail(), publicMW...))
+	r.Post(v1Base("/users/verify-email/resend"), chain.ToHandlerFunc(v1Ctrl.HandleUserResendVerification(), publicMW...))
 
 	userMW := []errchain.Middleware{
 		a.mwAuthToken,
+		a.mwRateLimit(apiLimiter),
+		a.mwRoles(RoleModeOr, authroles.RoleUser.String()),
+	}
+
+	// Inventory endpoints are also available to API keys scoped to items, read-only keys
+	// are limited to the readMW routes. Mutations additionally require the user's role
+	// within the group to grant the permission.
+	readMW := []errchain.Middleware{
+		a.mwAuthToken,
+		a.mwRoles(RoleModeOr, authroles.RoleUser.String(), authroles.RoleItemsRead.String(), authroles.RoleItemsWrite.String()),
+	}
+
+	writeMW := []errchain.Middleware{
+		a.mwAuthToken,
+		a.mwRateLimit(apiLimiter),
+		a.mwRoles(RoleModeOr, authroles.RoleUser.String(), authroles.RoleItemsWrite.String()),
+		a.mwPermission(services.PermissionWrite),
+	}
+
+	maintenanceMW := []errchain.Middleware{
+		a.mwAuthToken,
+		a.mwRateLimit(apiLimiter),
+		a.mwRoles(RoleModeOr, authroles.RoleUser.String(), authroles.RoleItemsWrite.String()),
+		a.mwPermission(services.PermissionMaintenance),
+	}
+
+	actionMW := []errchain.Middleware{
+		a.mwAuthToken,
+		a.mwRateLimit(apiLimiter),
+		a.mwRoles(RoleModeOr, authroles.RoleUser.String()),
+		a.mwPermission(services.PermissionWrite),
+	}
+
+	manageMW := []errchain.Middleware{
+		a.mwAuthToken,
+		a.mwRateLimit(apiLimiter),
+		a.mwRoles(RoleModeOr, authroles.RoleUser.String()),
+		a.mwPermission(services.PermissionManage),
+	}
+
+	// The admin routes administer the whole instance and are only available to superusers.
+	adminMW := []errchain.Middleware{
+		a.mwAuthToken,
+		a.mwRateLimit(apiLimiter),
 		a.mwRoles(RoleModeOr, authroles.RoleUser.String()),
+		a.mwSuperuser,
 	}
 
 	r.Get(v1Base("/ws/events"), chain.ToHandlerFunc(v1Ctrl.HandleCacheWS(), userMW...))
@@ -86,69 +168,153 @@ func (a *app) mountRoutes(r *chi.Mux, chain *errchain.ErrChain, repos *repo.AllR
 	r.Get(v1Base("/users/refresh"), chain.ToHandlerFunc(v1Ctrl.HandleAuthRefresh(), userMW...))
 	r.Put(v1Base("/users/self/change-password"), chain.ToHandlerFunc(v1Ctrl.HandleUserSelfChangePassword(), userMW...))
 
-	r.Post(v1Base("/groups/invitations"), chain.ToHandlerFunc(v1Ctrl.HandleGroupInvitationsCreate(), userMW...))
-	r.Get(v1Base("/groups/statistics"), chain.ToHandlerFunc(v1Ctrl.HandleGroupStatistics(), userMW...))
-	r.Get(v1Base("/groups/statistics/purchase-price"), chain.ToHandlerFunc(v1Ctrl.HandleGroupStatisticsPriceOverTime(), userMW...))
-	r.Get(v1Base("/groups/statistics/locations"), chain.ToHandlerFunc(v1Ctrl.HandleGroupStatisticsLocations(), userMW...))
-	r.Get(v1Base("/groups/statistics/labels"), chain.ToHandlerFunc(v1Ctrl.HandleGroupStatisticsLabels(), userMW...))
+	r.Post(v1Base("/users/self/2fa/setup"), chain.ToHandlerFunc(v1Ctrl.HandleTwoFactorSetup(), userMW...))
+	r.Get(v1Base("/users/self/2fa/setup/qrcode"), chain.ToHandlerFunc(v1Ctrl.HandleTwoFactorSetupQRCode(), userMW...))
+	r.Post(v1Base("/users/self/2fa/enable"), chain.ToHandlerFunc(v1Ctrl.HandleTwoFactorEnable(), userMW...))
+	r.Post(v1Base("/users/self/2fa/disable"), chain.ToHandlerFunc(v1Ctrl.HandleTwoFactorDisable(), userMW...))
+	r.Post(v1Base("/users/self/2fa/recovery-codes"), chain.ToHandlerFunc(v1Ctrl.HandleTwoFactorRecoveryCodes(), userMW...))
+
+	r.Post(v1Base("/users/self/passkeys/register"), chain.ToHandlerFunc(v1Ctrl.HandlePasskeyRegistrationBegin(), userMW...))
+	r.Post(v1Base("/users/self/passkeys"), chain.ToHandlerFunc(v1Ctrl.HandlePasskeyRegistrationFinish(), userMW...))
+	r.Get(v1Base("/users/self/passkeys"), chain.ToHandlerFunc(v1Ctrl.HandlePasskeysGetAll(), userMW...))
+	r.Put(v1Base("/users/self/passkeys/{id}"), chain.ToHandlerFunc(v1Ctrl.HandlePasskeyUpdate(), userMW...))
+	r.Delete(v1Base("/users/self/passkeys/{id}"), chain.ToHandlerFunc(v1Ctrl.HandlePasskeyDelete(), userMW...))
+
+	r.Get(v1Base("/users/self/sessions"), chain.ToHandlerFunc(v1Ctrl.HandleSessionsGetAll(), userMW...))
+	r.Delete(v1Base("/users/self/sessions"), chain.ToHandlerFunc(v1Ctrl.HandleSessionsRevokeOthers(), userMW...))
+	r.Delete(v1Base("/users/self/sessions/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleSessionRevoke(), userMW...))
+
+	r.Get(v1Base("/users/self/groups"), chain.ToHandlerFunc(v1Ctrl.HandleUserGroupsGetAll(), userMW...))
+	r.Post(v1Base("/users/self/groups"), chain.ToHandlerFunc(v1Ctrl.HandleUserGroupJoin(), userMW...))
+	r.Post(v1Base("/users/self/groups/{id}/switch"), chain.ToHandlerFunc(v1Ctrl.HandleUserGroupSwitch(), userMW...))
+
+	r.Get(v1Base("/users/self/api-keys"), chain.ToHandlerFunc(v1Ctrl.HandleAPIKeysGetAll(), userMW...))
+	r.Post(v1Base("/users/self/api-keys"), chain.ToHandlerFunc(v1Ctrl.HandleAPIKeyCreate(), userMW...))
+	r.Delete(v1Base("/users/self/api-keys/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleAPIKeyRevoke(), userMW...))
+
+	r.Get(v1Base("/groups/backup"), chain.ToHandlerFunc(v1Ctrl.HandleGroupBackup(), manageMW...))
+	r.Get(v1Base("/groups/invitations"), chain.ToHandlerFunc(v1Ctrl.HandleGroupInvitationsGetAll(), manageMW...))
+	r.Post(v1Base("/groups/invitations"), chain.ToHandlerFunc(v1Ctrl.HandleGroupInvitationsCreate(), manageMW...))
+	r.Delete(v1Base("/groups/invitations/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleGroupInvitationRevoke(), manageMW...))
+	r.Get(v1Base("/groups/members"), chain.ToHandlerFunc(v1Ctrl.HandleGroupMembersGetAll(), manageMW...))
+	r.Delete(v1Base("/groups/members/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleGroupMemberRemove(), manageMW...))
+	r.Put(v1Base("/groups/members/{id}/role"), chain.ToHandlerFunc(v1Ctrl.HandleGroupMemberRoleUpdate(), manageMW...))
+	r.Post(v1Base("/groups/members/{id}/transfer-ownership"), chain.ToHandlerFunc(v1Ctrl.HandleGroupOwnershipTransfer(), manageMW...))
+	r.Get(v1Base("/groups/statistics"), chain.ToHandlerFunc(v1Ctrl.HandleGroupStatistics(), readMW...))
+	r.Get(v1Base("/groups/statistics/purchase-price"), chain.ToHandlerFunc(v1Ctrl.HandleGroupStatisticsPriceOverTime(), readMW...))
+	r.Get(v1Base("/groups/statistics/locations"), chain.ToHandlerFunc(v1Ctrl.HandleGroupStatisticsLocations(), readMW...))
+	r.Get(v1Base("/groups/statistics/labels"), chain.ToHandlerFunc(v1Ctrl.HandleGroupStatisticsLabels(), readMW...))
+
+	r.Get(v1Base("/admin/groups"), chain.ToHandlerFunc(v1Ctrl.HandleAdminGroupsGetAll(), adminMW...))
+	r.Post(v1Base("/admin/groups/restore"), chain.ToHandlerFunc(v1Ctrl.HandleAdminGroupRestore(), adminMW...))
+	r.Get(v1Base("/admin/users"), chain.ToHandlerFunc(v1Ctrl.HandleAdminUsersGetAll(), adminMW...))
+	r.Delete(v1Base("/admin/users/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleAdminUserDelete(), adminMW...))
+	r.Post(v1Base("/admin/users/{id}/disable"), chain.ToHandlerFunc(v1Ctrl.HandleAdminUserDisable(), adminMW...))
+	r.Post(v1Base("/admin/users/{id}/enable"), chain.ToHandlerFunc(v1Ctrl.HandleAdminUserEnable(), adminMW...))
+	r.Post(v1Base("/admin/users/{id}/reset-password"), chain.ToHandlerFunc(v1Ctrl.HandleAdminUserResetPassword(), adminMW...))
+	r.Get(v1Base("/admin/registration"), chain.ToHandlerFunc(v1Ctrl.HandleAdminRegistrationGet(), adminMW...))
+	r.Put(v1Base("/admin/registration"), chain.ToHandlerFunc(v1Ctrl.HandleAdminRegistrationUpdate(), adminMW...))
 
 	// TODO: I don't like /groups being the URL for users
 	r.Get(v1Base("/groups"), chain.ToHandlerFunc(v1Ctrl.HandleGroupGet(), userMW...))
-	r.Put(v1Base("/groups"), chain.ToHandlerFunc(v1Ctrl.HandleGroupUpdate(), userMW...))
-
-	r.Post(v1Base("/actions/ensure-asset-ids"), chain.ToHandlerFunc(v1Ctrl.HandleEnsureAssetID(), userMW...))
-	r.Post(v1Base("/actions/zero-item-time-fields"), chain.ToHandlerFunc(v1Ctrl.HandleItemDateZeroOut(), userMW...))
-	r.Post(v1Base("/actions/ensure-import-refs"), chain.ToHandlerFunc(v1Ctrl.HandleEnsureImportRefs(), userMW...))
-	r.Post(v1Base("/actions/set-primary-photos"), chain.ToHandlerFunc(v1Ctrl.HandleSetPrimaryPhotos(), userMW...))
-
-	r.Get(v1Base("/locations"), chain.ToHandlerFunc(v1Ctrl.HandleLocationGetAll(), userMW...))
-	r.Post(v1Base("/locations"), chain.ToHandlerFunc(v1Ctrl.HandleLocationCreate(), userMW...))
-	r.Get(v1Base("/locations/tree"), chain.ToHandlerFunc(v1Ctrl.HandleLocationTreeQuery(), userMW...))
-	r.Get(v1Base("/locations/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleLocationGet(), userMW...))
-	r.Put(v1Base("/locations/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleLocationUpdate(), userMW...))
-	r.Delete(v1Base("/locations/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleLocationDelete(), userMW...))
-
-	r.Get(v1Base("/labels"), chain.ToHandlerFunc(v1Ctrl.HandleLabelsGetAll(), userMW...))
-	r.Post(v1Base("/labels"), chain.ToHandlerFunc(v1Ctrl.HandleLabelsCreate(), userMW...))
-	r.Get(v1Base("/labels/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleLabelGet(), userMW...))
-	r.Put(v1Base("/labels/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleLabelUpdate(), userMW...))
-	r.Delete(v1Base("/labels/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleLabelDelete(), userMW...))
-
-	r.Get(v1Base("/items"), chain.ToHandlerFunc(v1Ctrl.HandleItemsGetAll(), userMW...))
-	r.Post(v1Base("/items"), chain.ToHandlerFunc(v1Ctrl.HandleItemsCreate(), userMW...))
-	r.Post(v1Base("/items/import"), chain.ToHandlerFunc(v1Ctrl.HandleItemsImport(), userMW...))
-	r.Get(v1Base("/items/export"), chain.ToHandlerFunc(v1Ctrl.HandleItemsExport(), userMW...))
-	r.Get(v1Base("/items/fields"), chain.ToHandlerFunc(v1Ctrl.HandleGetAllCustomFieldNames(), userMW...))
-	r.Get(v1Base("/items/fields/values"), chain.ToHandlerFunc(v1Ctrl.HandleGetAllCustomFieldValues(), userMW...))
-
-	r.Get(v1Base("/items/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleItemGet(), userMW...))
-	r.Get(v1Base("/items/{id}/path"), chain.ToHandlerFunc(v1Ctrl.HandleItemFullPath(), userMW...))
-	r.Put(v1Base("/items/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleItemUpdate(), userMW...))
-	r.Patch(v1Base("/items/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleItemPatch(), userMW...))
-	r.Delete(v1Base("/items/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleItemDelete(), userMW...))
-
-	r.Post(v1Base("/items/{id}/attachments"), chain.ToHandlerFunc(v1Ctrl.HandleItemAttachmentCreate(), userMW...))
-	r.Put(v1Base("/items/{id}/attachments/{attachment_id}"), chain.ToHandlerFunc(v1Ctrl.HandleItemAttachmentUpdate(), userMW...))
-	r.Delete(v1Base("/items/{id}/attachments/{attachment_id}"), chain.ToHandlerFunc(v1Ctrl.HandleItemAttachmentDelete(), userMW...))
-
-	r.Get(v1Base("/items/{id}/maintenance"), chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceLogGet(), userMW...))
-	r.Post(v1Base("/items/{id}/maintenance"), chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceEntryCreate(), userMW...))
-	r.Put(v1Base("/items/{id}/maintenance/{entry_id}"), chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceEntryUpdate(), userMW...))
-	r.Delete(v1Base("/items/{id}/maintenance/{entry_id}"), chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceEntryDelete(), userMW...))
-
-	r.Get(v1Base("/assets/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleAssetGet(), userMW...))
+	r.Put(v1Base("/groups"), chain.ToHandlerFunc(v1Ctrl.HandleGroupUpdate(), manageMW...))
+
+	r.Post(v1Base("/actions/ensure-asset-ids"), chain.ToHandlerFunc(v1Ctrl.HandleEnsureAssetID(), actionMW...))
+	r.Post(v1Base("/actions/zero-item-time-fields"), chain.ToHandlerFunc(v1Ctrl.HandleItemDateZeroOut(), actionMW...))
+	r.Post(v1Base("/actions/ensure-import-refs"), chain.ToHandlerFunc(v1Ctrl.HandleEnsureImportRefs(), actionMW...))
+	r.Post(v1Base("/actions/set-primary-photos"), chain.ToHandlerFunc(v1Ctrl.HandleSetPrimaryPhotos(), actionMW...))
+
+	r.Get(v1Base("/locations"), chain.ToHandlerFunc(v1Ctrl.HandleLocationGetAll(), readMW...))
+	r.Post(v1Base("/locations"), chain.ToHandlerFunc(v1Ctrl.HandleLocationCreate(), writeMW...))
+	r.Get(v1Base("/locations/tree"), chain.ToHandlerFunc(v1Ctrl.HandleLocationTreeQuery(), readMW...))
+	r.Get(v1Base("/locations/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleLocationGet(), readMW...))
+	r.Put(v1Base("/locations/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleLocationUpdate(), writeMW...))
+	r.Delete(v1Base("/locations/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleLocationDelete(), writeMW...))
+	r.Post(v1Base("/locations/{id}/restore"), chain.ToHandlerFunc(v1Ctrl.HandleLocationRestore(), writeMW...))
+
+	r.Get(v1Base("/labels"), chain.ToHandlerFunc(v1Ctrl.HandleLabelsGetAll(), readMW...))
+	r.Post(v1Base("/labels"), chain.ToHandlerFunc(v1Ctrl.HandleLabelsCreate(), writeMW...))
+	r.Get(v1Base("/labels/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleLabelGet(), readMW...))
+	r.Put(v1Base("/labels/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleLabelUpdate(), writeMW...))
+	r.Delete(v1Base("/labels/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleLabelDelete(), writeMW...))
+
+	r.Get(v1Base("/items"), chain.ToHandlerFunc(v1Ctrl.HandleItemsGetAll(), readMW...))
+	r.Post(v1Base("/items"), chain.ToHandlerFunc(v1Ctrl.HandleItemsCreate(), writeMW...))
+	r.Post(v1Base("/items/import"), chain.ToHandlerFunc(v1Ctrl.HandleItemsImport(), writeMW...))
+	r.Get(v1Base("/items/export"), chain.ToHandlerFunc(v1Ctrl.HandleItemsExport(), readMW...))
+	r.Get(v1Base("/items/fields"), chain.ToHandlerFunc(v1Ctrl.HandleGetAllCustomFieldNames(), readMW...))
+	r.Get(v1Base("/items/fields/values"), chain.ToHandlerFunc(v1Ctrl.HandleGetAllCustomFieldValues(), readMW...))
+
+	r.Get(v1Base("/items/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleItemGet(), readMW...))
+	r.Get(v1Base("/items/{id}/path"), chain.ToHandlerFunc(v1Ctrl.HandleItemFullPath(), readMW...))
+	r.Get(v1Base("/items/{id}/history"), chain.ToHandlerFunc(v1Ctrl.HandleItemHistoryGet(), readMW...))
+	r.Put(v1Base("/items/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleItemUpdate(), writeMW...))
+	r.Patch(v1Base("/items/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleItemPatch(), writeMW...))
+	r.Delete(v1Base("/items/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleItemDelete(), writeMW...))
+	r.Post(v1Base("/items/{id}/restore"), chain.ToHandlerFunc(v1Ctrl.HandleItemRestore(), writeMW...))
+
+	r.Post(v1Base("/items/{id}/attachments"), chain.ToHandlerFunc(v1Ctrl.HandleItemAttachmentCreate(), writeMW...))
+	r.Put(v1Base("/items/{id}/attachments/{attachment_id}"), chain.ToHandlerFunc(v1Ctrl.HandleItemAttachmentUpdate(), writeMW...))
+	r.Delete(v1Base("/items/{id}/attachments/{attachment_id}"), chain.ToHandlerFunc(v1Ctrl.HandleItemAttachmentDelete(), writeMW...))
+
+	r.Get(v1Base("/items/{id}/maintenance"), chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceLogGet(), readMW...))
+	r.Post(v1Base("/items/{id}/maintenance"), chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceEntryCreate(), maintenanceMW...))
+	r.Put(v1Base("/items/{id}/maintenance/{entry_id}"), chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceEntryUpdate(), writeMW...))
+	r.Delete(v1Base("/items/{id}/maintenance/{entry_id}"), chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceEntryDelete(), writeMW...))
+	r.Get(v1Base("/items/{id}/maintenance/schedules"), chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceSchedulesGet(), readMW...))
+	r.Post(v1Base("/items/{id}/maintenance/schedules"), chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceScheduleCreate(), writeMW...))
+	r.Put(v1Base("/items/{id}/maintenance/schedules/{schedule_id}"), chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceScheduleUpdate(), writeMW...))
+	r.Delete(v1Base("/items/{id}/maintenance/schedules/{schedule_id}"), chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceScheduleDelete(), writeMW...))
+
+	r.Get(v1Base("/assets/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleAssetGet(), readMW...))
+
+	r.Get(v1Base("/audit"), chain.ToHandlerFunc(v1Ctrl.HandleAuditLogGet(), readMW...))
+
+	r.Get(v1Base("/trash"), chain.ToHandlerFunc(v1Ctrl.HandleTrashGet(), readMW...))
+
+	r.Get(v1Base("/search"), chain.ToHandlerFunc(v1Ctrl.HandleSearch(), readMW...))
+
+	// Saved searches belong to the users of the group, API keys can only run them.
+	r.Get(v1Base("/saved-searches"), chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchesGetAll(), readMW...))
+	r.Post(v1Base("/saved-searches"), chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchCreate(), actionMW...))
+	r.Get(v1Base("/saved-searches/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchGet(), readMW...))
+	r.Put(v1Base("/saved-searches/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchUpdate(), actionMW...))
+	r.Delete(v1Base("/saved-searches/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchDelete(), actionMW...))
+	r.Get(v1Base("/saved-searches/{id}/items"), chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchItems(), readMW...))
 
 	// Notifiers
 	r.Get(v1Base("/notifiers"), chain.ToHandlerFunc(v1Ctrl.HandleGetUserNotifiers(), userMW...))
 	r.Post(v1Base("/notifiers"), chain.ToHandlerFunc(v1Ctrl.HandleCreateNotifier(), userMW...))
 	r.Put(v1Base("/notifiers/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleUpdateNotifier(), userMW...))
 	r.Delete(v1Base("/notifiers/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleDeleteNotifier(), userMW...))
+	r.Get(v1Base("/notifiers/{id}/deliveries"), chain.ToHandlerFunc(v1Ctrl.HandleGetNotifierDeliveries(), userMW...))
 	r.Post(v1Base("/notifiers/test"), chain.ToHandlerFunc(v1Ctrl.HandlerNotifierTest(), userMW...))
+	r.Post(v1Base("/notifiers/preview"), chain.ToHandlerFunc(v1Ctrl.HandleNotifierPreview(), userMW...))
+
+	// Rules decide the reminders sent to the whole group, they are not changed with API keys.
+	r.Get(v1Base("/notifications/rules"), chain.ToHandlerFunc(v1Ctrl.HandleNotificationRulesGetAll(), readMW...))
+	r.Post(v1Base("/notifications/rules"), chain.ToHandlerFunc(v1Ctrl.HandleNotificationRuleCreate(), actionMW...))
+	r.Get(v1Base("/notifications/rules/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleNotificationRuleGet(), readMW...))
+	r.Put(v1Base("/notifications/rules/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleNotificationRuleUpdate(), actionMW...))
+	r.Delete(v1Base("/notifications/rules/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleNotificationRuleDelete(), actionMW...))
+	r.Get(v1Base("/notifications/reminders"), chain.ToHandlerFunc(v1Ctrl.HandleNotificationRemindersGet(), readMW...))
+
+	// Templates shape every message sent to the notifiers of the group, only owners change them.
+	r.Get(v1Base("/notifications/templates"), chain.ToHandlerFunc(v1Ctrl.HandleNotificationTemplatesGetAll(), readMW...))
+	r.Post(v1Base("/notifications/templates"), chain.ToHandlerFunc(v1Ctrl.HandleNotificationTemplateCreate(), manageMW...))
+	r.Put(v1Base("/notifications/templates/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleNotificationTemplateUpdate(), manageMW...))
+	r.Delete(v1Base("/notifications/templates/{id}"), chain.ToHandlerFunc(v1Ctrl.HandleNotificationTemplateDelete(), manageMW...))
 
 	// Asset-Like endpoints
 	assetMW := []errchain.Middleware{
 		a.mwAuthToken,
-		a.mwRoles(RoleModeOr, authroles.RoleUser.String(), authroles.RoleAttachments.String()),
+		a.mwRoles(
+			RoleModeOr,
+			authroles.RoleUser.String(),
+			authroles.RoleAttachments.String(),
+			authroles.RoleItemsRead.String(),
+			authroles.RoleItemsWrite.String(),
+		),
 	}
 
 	r.Get(
@@ -161,7 +327,7 @@ func (a *app) mountRoutes(r *chi.Mux, chain *errchain.ErrChain, repos *repo.AllR
 	)
 
 	// Reporting Services
-	r.Get(v1Base("/reporting/bill-of-materials"), chain.ToHandlerFunc(v1Ctrl.HandleBillOfMaterialsExport(), userMW...))
+	r.Get(v1Base("/reporting/bill-of-materials"), chain.ToHandlerFunc(v1Ctrl.HandleBillOfMaterialsExport(), readMW...))
 
 	r.NotFound(chain.ToHandlerFunc(notFoundHandler()))
 }
@@ -0,0 +1,1632 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/google/uuid"
+	v1 "github.com/hay-kot/homebox/backend/app/api/handlers/v1"
+	"github.com/hay-kot/homebox/backend/app/api/providers/oidctest"
+	"github.com/hay-kot/homebox/backend/internal/core/services"
+	"github.com/hay-kot/homebox/backend/internal/core/services/passkeytest"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+	"github.com/hay-kot/homebox/backend/internal/sys/config"
+	"github.com/hay-kot/homebox/backend/internal/web/mid"
+	"github.com/hay-kot/homebox/backend/pkgs/hasher"
+	"github.com/hay-kot/homebox/backend/pkgs/totp"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testMember struct {
+	user  repo.UserOut
+	token string
+}
+
+// newTestGroup registers a new group and returns its owner along with members for
+// each of the other group roles.
+func newTestGroup() (owner, editor, viewer testMember, err error) {
+	ctx := context.Background()
+	password := fk.Str(10)
+
+	login := func(usr repo.UserOut) (testMember, error) {
+		token, err := tApp.services.User.Login(ctx, usr.Email, password, false)
+		return testMember{user: usr, token: token.Raw}, err
+	}
+
+	ownerOut, err := tApp.services.User.RegisterUser(ctx, services.UserRegistration{
+		Name:     fk.Str(10),
+		Email:    fk.Email(),
+		Password: password,
+	})
+	if err != nil {
+		return
+	}
+
+	hashed, err := hasher.HashPassword(password)
+	if err != nil {
+		return
+	}
+
+	member := func(role groupmembership.Role) (testMember, error) {
+		usr, err := tApp.repos.Users.Create(ctx, repo.UserCreate{
+			Name:     fk.Str(10),
+			Email:    fk.Email(),
+			Password: hashed,
+			GroupID:  ownerOut.GroupID,
+		})
+		if err != nil {
+			return testMember{}, err
+		}
+
+		err = tApp.repos.Memberships.UpdateRole(ctx, ownerOut.GroupID, usr.ID, role)
+		if err != nil {
+			return testMember{}, err
+		}
+
+		usr, err = tApp.repos.Users.GetOneID(ctx, usr.ID)
+		if err != nil {
+			return testMember{}, err
+		}
+
+		return login(usr)
+	}
+
+	if owner, err = login(ownerOut); err != nil {
+		return
+	}
+	if editor, err = member(groupmembership.RoleEditor); err != nil {
+		return
+	}
+	viewer, err = member(groupmembership.RoleViewer)
+	return
+}
+
+func useItem(t *testing.T) repo.ItemOut {
+	t.Helper()
+	ctx := context.Background()
+
+	location, err := tApp.repos.Locations.Create(ctx, tOwner.user.GroupID, repo.LocationCreate{Name: fk.Str(10)})
+	require.NoError(t, err)
+
+	item, err := tApp.repos.Items.Create(ctx, tOwner.user.GroupID, repo.ItemCreate{
+		Name:       fk.Str(10),
+		LocationID: location.ID,
+	})
+	require.NoError(t, err)
+
+	return item
+}
+
+func doRequest(t *testing.T, m testMember, method, path string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	return doRequestOn(t, tRouter, m, method, path, body)
+}
+
+func doRequestOn(t *testing.T, router http.Handler, m testMember, method, path string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&buf).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, path, &buf)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+m.token)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRoutes_ViewerCannotMutate(t *testing.T) {
+	item := useItem(t)
+
+	id := uuid.New().String()
+	routes := []struct {
+		method string
+		path   string
+	}{
+		{http.MethodPost, "/api/v1/items"},
+		{http.MethodPost, "/api/v1/items/import"},
+		{http.MethodPut, "/api/v1/items/" + item.ID.String()},
+		{http.MethodPatch, "/api/v1/items/" + item.ID.String()},
+		{http.MethodDelete, "/api/v1/items/" + item.ID.String()},
+		{http.MethodPost, "/api/v1/items/" + item.ID.String() + "/attachments"},
+		{http.MethodPut, "/api/v1/items/" + item.ID.String() + "/attachments/" + id},
+		{http.MethodDelete, "/api/v1/items/" + item.ID.String() + "/attachments/" + id},
+		{http.MethodPut, "/api/v1/items/" + item.ID.String() + "/maintenance/" + id},
+		{http.MethodDelete, "/api/v1/items/" + item.ID.String() + "/maintenance/" + id},
+		{http.MethodPost, "/api/v1/locations"},
+		{http.MethodPut, "/api/v1/locations/" + id},
+		{http.MethodDelete, "/api/v1/locations/" + id},
+		{http.MethodPost, "/api/v1/labels"},
+		{http.MethodPut, "/api/v1/labels/" + id},
+		{http.MethodDelete, "/api/v1/labels/" + id},
+		{http.MethodPost, "/api/v1/actions/ensure-asset-ids"},
+		{http.MethodPost, "/api/v1/actions/zero-item-time-fields"},
+		{http.MethodPost, "/api/v1/actions/ensure-import-refs"},
+		{http.MethodPost, "/api/v1/actions/set-primary-photos"},
+		{http.MethodPut, "/api/v1/groups"},
+		{http.MethodPost, "/api/v1/groups/invitations"},
+		{http.MethodPut, "/api/v1/groups/members/" + tOwner.user.ID.String() + "/role"},
+	}
+
+	for _, tt := range routes {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			rec := doRequest(t, tViewer, tt.method, tt.path, map[string]any{})
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		})
+	}
+
+	// The item must be untouched
+	_, err := tApp.repos.Items.GetOneByGroup(context.Background(), tOwner.user.GroupID, item.ID)
+	require.NoError(t, err)
+}
+
+func TestRoutes_ViewerCanReadAndAddMaintenance(t *testing.T) {
+	item := useItem(t)
+
+	rec := doRequest(t, tViewer, http.MethodGet, "/api/v1/items", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = doRequest(t, tViewer, http.MethodGet, "/api/v1/items/"+item.ID.String(), nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = doRequest(t, tViewer, http.MethodPost, "/api/v1/items/"+item.ID.String()+"/maintenance", map[string]any{
+		"name":          "Replaced filter",
+		"completedDate": "2024-01-01",
+	})
+	assert.Equal(t, http.StatusCreated, rec.Code)
+}
+
+func TestRoutes_EditorCannotManageGroup(t *testing.T) {
+	rec := doRequest(t, tEditor, http.MethodPost, "/api/v1/labels", map[string]any{"name": fk.Str(10)})
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	rec = doRequest(t, tEditor, http.MethodPut, "/api/v1/groups", map[string]any{"name": fk.Str(10), "currency": "usd"})
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = doRequest(t, tEditor, http.MethodPost, "/api/v1/groups/invitations", map[string]any{"uses": 1})
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = doRequest(t, tEditor, http.MethodPut, "/api/v1/groups/members/"+tViewer.user.ID.String()+"/role", map[string]any{"role": "editor"})
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = doRequest(t, tEditor, http.MethodPut, "/api/v1/groups/members/"+tOwner.user.ID.String()+"/role", map[string]any{"role": "viewer"})
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestRoutes_OwnerChangesRoles(t *testing.T) {
+	t.Cleanup(func() {
+		_ = tApp.repos.Memberships.UpdateRole(context.Background(), tOwner.user.GroupID, tViewer.user.ID, groupmembership.RoleViewer)
+	})
+
+	rec := doRequest(t, tOwner, http.MethodPut, "/api/v1/groups/members/"+tViewer.user.ID.String()+"/role", map[string]any{"role": "editor"})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var out repo.UserOut
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&out))
+	assert.Equal(t, "editor", out.Role)
+
+	// The promoted member can now mutate the inventory
+	rec = doRequest(t, tViewer, http.MethodPost, "/api/v1/labels", map[string]any{"name": fk.Str(10)})
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	// Owners cannot change their own role
+	rec = doRequest(t, tOwner, http.MethodPut, "/api/v1/groups/members/"+tOwner.user.ID.String()+"/role", map[string]any{"role": "viewer"})
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	// Invalid roles are rejected
+	rec = doRequest(t, tOwner, http.MethodPut, "/api/v1/groups/members/"+tViewer.user.ID.String()+"/role", map[string]any{"role": "admin"})
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	// Members of other groups cannot be changed
+	rec = doRequest(t, tOwner, http.MethodPut, "/api/v1/groups/members/"+tOtherOwner.user.ID.String()+"/role", map[string]any{"role": "viewer"})
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestRoutes_GroupMembers(t *testing.T) {
+	owner, editor, viewer, err := newTestGroup()
+	require.NoError(t, err)
+
+	rec := doRequest(t, editor, http.MethodGet, "/api/v1/groups/members", nil)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = doRequest(t, owner, http.MethodGet, "/api/v1/groups/members", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var members []repo.UserOut
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&members))
+	require.Len(t, members, 3)
+
+	// Owners cannot remove themselves
+	rec = doRequest(t, owner, http.MethodDelete, "/api/v1/groups/members/"+owner.user.ID.String(), nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	// Members of other groups cannot be removed
+	rec = doRequest(t, owner, http.MethodDelete, "/api/v1/groups/members/"+tViewer.user.ID.String(), nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// The viewer has no other group and cannot be removed
+	rec = doRequest(t, owner, http.MethodDelete, "/api/v1/groups/members/"+viewer.user.ID.String(), nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	_, err = tApp.repos.Memberships.Create(context.Background(), viewer.user.ID, tOtherOwner.user.GroupID, groupmembership.RoleViewer)
+	require.NoError(t, err)
+
+	rec = doRequest(t, owner, http.MethodDelete, "/api/v1/groups/members/"+viewer.user.ID.String(), nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	// The account of the viewer is kept with their other group
+	rec = doRequest(t, viewer, http.MethodGet, "/api/v1/users/self", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = doRequest(t, owner, http.MethodGet, "/api/v1/groups/members", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&members))
+	require.Len(t, members, 2)
+
+	// Another owner can neither be demoted nor removed
+	second, _, _, err := newTestGroup()
+	require.NoError(t, err)
+
+	_, err = tApp.repos.Memberships.Create(context.Background(), second.user.ID, owner.user.GroupID, groupmembership.RoleEditor)
+	require.NoError(t, err)
+
+	rec = doRequest(t, owner, http.MethodPut, "/api/v1/groups/members/"+second.user.ID.String()+"/role", map[string]any{"role": "owner"})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = doRequest(t, owner, http.MethodPut, "/api/v1/groups/members/"+second.user.ID.String()+"/role", map[string]any{"role": "viewer"})
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	rec = doRequest(t, owner, http.MethodDelete, "/api/v1/groups/members/"+second.user.ID.String(), nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	require.NoError(t, tApp.repos.Memberships.Remove(context.Background(), owner.user.GroupID, second.user.ID))
+
+	rec = doRequest(t, owner, http.MethodPost, "/api/v1/groups/members/"+editor.user.ID.String()+"/transfer-ownership", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var out repo.UserOut
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&out))
+	assert.True(t, out.IsOwner)
+
+	// The previous owner is an editor now
+	rec = doRequest(t, owner, http.MethodGet, "/api/v1/groups/members", nil)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = doRequest(t, editor, http.MethodGet, "/api/v1/groups/members", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&members))
+	require.Len(t, members, 2)
+	for _, m := range members {
+		assert.Equal(t, m.ID == editor.user.ID, m.IsOwner)
+	}
+}
+
+func TestRoutes_GroupInvitations(t *testing.T) {
+	owner, editor, _, err := newTestGroup()
+	require.NoError(t, err)
+
+	rec := doRequest(t, owner, http.MethodPost, "/api/v1/groups/invitations", v1.GroupInvitationCreate{Uses: 3})
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	rec = doRequest(t, editor, http.MethodGet, "/api/v1/groups/invitations", nil)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = doRequest(t, owner, http.MethodGet, "/api/v1/groups/invitations", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var invitations []repo.GroupInvitation
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&invitations))
+	require.Len(t, invitations, 1)
+	assert.Equal(t, 3, invitations[0].Uses)
+	assert.True(t, invitations[0].ExpiresAt.After(time.Now()))
+
+	path := "/api/v1/groups/invitations/" + invitations[0].ID.String()
+
+	// Invitations of other groups cannot be revoked
+	rec = doRequest(t, tOwner, http.MethodDelete, path, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = doRequest(t, owner, http.MethodDelete, path, nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = doRequest(t, owner, http.MethodGet, "/api/v1/groups/invitations", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&invitations))
+	assert.Empty(t, invitations)
+}
+
+func TestRoutes_ItemHistory(t *testing.T) {
+	item := useItem(t)
+
+	rec := doRequest(t, tEditor, http.MethodPut, "/api/v1/items/"+item.ID.String(), map[string]any{
+		"id":         item.ID,
+		"name":       "Updated",
+		"locationId": item.Location.ID,
+		"quantity":   item.Quantity,
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = doRequest(t, tViewer, http.MethodGet, "/api/v1/items/"+item.ID.String()+"/history", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var history repo.PaginationResult[repo.AuditEntryOut]
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&history))
+	require.Equal(t, 2, history.Total)
+	assert.Equal(t, "update", history.Items[0].Action)
+	require.NotNil(t, history.Items[0].User)
+	assert.Equal(t, tEditor.user.ID, history.Items[0].User.ID)
+
+	rec = doRequest(t, tViewer, http.MethodGet, "/api/v1/audit?entityType=item&userId="+tEditor.user.ID.String()+"&entityId="+item.ID.String(), nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var feed repo.PaginationResult[repo.AuditEntryOut]
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&feed))
+	require.Equal(t, 1, feed.Total)
+	assert.Equal(t, "Updated", feed.Items[0].EntityName)
+
+	rec = doRequest(t, tViewer, http.MethodGet, "/api/v1/audit?entityType=widget", nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestRoutes_Trash(t *testing.T) {
+	item := useItem(t)
+	itemPath := "/api/v1/items/" + item.ID.String()
+	locPath := "/api/v1/locations/" + item.Location.ID.String()
+
+	rec := doRequest(t, tEditor, http.MethodDelete, locPath, nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = doRequest(t, tViewer, http.MethodGet, itemPath, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = doRequest(t, tViewer, http.MethodGet, "/api/v1/trash", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var trash v1.Trash
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&trash))
+	require.Len(t, trash.Items, 1)
+	assert.Equal(t, item.ID, trash.Items[0].ID)
+	require.Len(t, trash.Locations, 1)
+
+	rec = doRequest(t, tViewer, http.MethodPost, locPath+"/restore", nil)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = doRequest(t, tEditor, http.MethodPost, itemPath+"/restore", nil)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	rec = doRequest(t, tEditor, http.MethodPost, locPath+"/restore", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = doRequest(t, tViewer, http.MethodGet, itemPath, nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestRoutes_Search(t *testing.T) {
+	item := useItem(t)
+
+	rec := doRequest(t, tViewer, http.MethodGet, "/api/v1/search?q="+item.Name, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var res repo.PaginationResult[repo.SearchResult]
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&res))
+	require.Equal(t, 1, res.Total)
+	assert.Equal(t, item.ID, res.Items[0].Item.ID)
+	require.NotEmpty(t, res.Items[0].Highlights)
+	assert.Equal(t, "name", res.Items[0].Highlights[0].Field)
+
+	rec = doRequest(t, tOtherOwner, http.MethodGet, "/api/v1/search?q="+item.Name, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&res))
+	assert.Equal(t, 0, res.Total)
+
+	rec = doRequest(t, tViewer, http.MethodGet, "/api/v1/search", nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestRoutes_ItemsFilter(t *testing.T) {
+	item := useItem(t)
+
+	rec := doRequest(t, tViewer, http.MethodGet, "/api/v1/items?filter="+url.QueryEscape("name="+item.Name+" quantity>=1"), nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var res repo.PaginationResult[repo.ItemSummary]
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&res))
+	require.Len(t, res.Items, 1)
+	assert.Equal(t, item.ID, res.Items[0].ID)
+
+	rec = doRequest(t, tViewer, http.MethodGet, "/api/v1/items?filter="+url.QueryEscape("price>abc"), nil)
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"fields":{"filter":`)
+}
+
+func TestRoutes_SavedSearches(t *testing.T) {
+	item := useItem(t)
+
+	create := repo.SavedSearchCreate{
+		Name:  "Saved " + item.Name,
+		Query: types.SavedQuery{Filter: "name=" + item.Name},
+	}
+
+	rec := doRequest(t, tViewer, http.MethodPost, "/api/v1/saved-searches", create)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = doRequest(t, tEditor, http.MethodPost, "/api/v1/saved-searches", create)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var saved repo.SavedSearchOut
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&saved))
+	assert.Equal(t, "name", saved.OrderBy)
+
+	rec = doRequest(t, tViewer, http.MethodGet, "/api/v1/saved-searches/"+saved.ID.String()+"/items", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var res repo.PaginationResult[repo.ItemSummary]
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&res))
+	require.Len(t, res.Items, 1)
+	assert.Equal(t, item.ID, res.Items[0].ID)
+
+	rec = doRequest(t, tViewer, http.MethodGet, "/api/v1/saved-searches?withCount=true", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var all []repo.SavedSearchOut
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&all))
+
+	var found bool
+	for _, s := range all {
+		if s.ID == saved.ID {
+			found = true
+			require.NotNil(t, s.ItemCount)
+			assert.Equal(t, 1, *s.ItemCount)
+		}
+	}
+	assert.True(t, found)
+
+	rec = doRequest(t, tEditor, http.MethodPut, "/api/v1/saved-searches/"+saved.ID.String(), repo.SavedSearchUpdate{
+		Name:  saved.Name,
+		Query: types.SavedQuery{Filter: "price>abc"},
+	})
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"fields":{"query.filter":`)
+
+	rec = doRequest(t, tOtherOwner, http.MethodGet, "/api/v1/saved-searches/"+saved.ID.String(), nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = doRequest(t, tEditor, http.MethodDelete, "/api/v1/saved-searches/"+saved.ID.String(), nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = doRequest(t, tViewer, http.MethodGet, "/api/v1/saved-searches/"+saved.ID.String()+"/items", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestRoutes_MaintenanceSchedules(t *testing.T) {
+	item := useItem(t)
+	base := "/api/v1/items/" + item.ID.String() + "/maintenance/schedules"
+
+	create := repo.MaintenanceScheduleCreate{Name: "Oil change", Rule: "every 3 months"}
+
+	rec := doRequest(t, tViewer, http.MethodPost, base, create)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = doRequest(t, tEditor, http.MethodPost, base, repo.MaintenanceScheduleCreate{Name: "Oil change", Rule: "every other week"})
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"fields":{"Rule":`)
+
+	rec = doRequest(t, tEditor, http.MethodPost, base, create)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var schedule repo.MaintenanceSchedule
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&schedule))
+	assert.Equal(t, "FREQ=MONTHLY;INTERVAL=3", schedule.Rule)
+	assert.False(t, schedule.NextDue.Time().IsZero())
+
+	rec = doRequest(t, tViewer, http.MethodGet, base, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var all []repo.MaintenanceSchedule
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&all))
+	require.Len(t, all, 1)
+
+	rec = doRequest(t, tOtherOwner, http.MethodDelete, base+"/"+schedule.ID.String(), nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = doRequest(t, tEditor, http.MethodDelete, base+"/"+schedule.ID.String(), nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestRoutes_NotificationRules(t *testing.T) {
+	create := repo.NotificationRuleCreate{Name: "Warranty", Kind: "warranty", LeadDays: []int{7, 30}, IsActive: true}
+
+	rec := doRequest(t, tViewer, http.MethodPost, "/api/v1/notifications/rules", create)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = doRequest(t, tEditor, http.MethodPost, "/api/v1/notifications/rules", repo.NotificationRuleCreate{
+		Name: "Calibration", Kind: "field", LeadDays: []int{7},
+	})
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"FieldName"`)
+
+	rec = doRequest(t, tEditor, http.MethodPost, "/api/v1/notifications/rules", create)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var rule repo.NotificationRuleOut
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&rule))
+	assert.Equal(t, []int{30, 7}, rule.LeadDays)
+
+	rec = doRequest(t, tViewer, http.MethodGet, "/api/v1/notifications/rules/"+rule.ID.String(), nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = doRequest(t, tOtherOwner, http.MethodGet, "/api/v1/notifications/rules/"+rule.ID.String(), nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = doRequest(t, tViewer, http.MethodGet, "/api/v1/notifications/reminders", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = doRequest(t, tEditor, http.MethodDelete, "/api/v1/notifications/rules/"+rule.ID.String(), nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestRoutes_NotificationTemplates(t *testing.T) {
+	rec := doRequest(t, tOwner, http.MethodPost, "/api/v1/notifiers", repo.NotifierCreate{
+		Name: "Chat", URL: "generic://example.com", IsActive: true,
+	})
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var notifier repo.NotifierOut
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&notifier))
+
+	create := repo.NotificationTemplateCreate{
+		Type: "maintenance",
+		Body: `{{ range .Maintenance }}{{ .Item.Name }} {{ .Item.URL }}{{ end }}`,
+	}
+
+	rec = doRequest(t, tViewer, http.MethodPost, "/api/v1/notifications/templates", create)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = doRequest(t, tEditor, http.MethodPost, "/api/v1/notifications/templates", create)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = doRequest(t, tOwner, http.MethodPost, "/api/v1/notifications/templates", repo.NotificationTemplateCreate{
+		Type: "maintenance", Body: "{{ .Unknown }}",
+	})
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"body"`)
+
+	rec = doRequest(t, tOwner, http.MethodPost, "/api/v1/notifications/templates", create)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var tmpl repo.NotificationTemplateOut
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&tmpl))
+
+	rec = doRequest(t, tOwner, http.MethodPost, "/api/v1/notifications/templates", create)
+	require.Equal(t, http.StatusConflict, rec.Code)
+
+	rec = doRequest(t, tOtherOwner, http.MethodPut, "/api/v1/notifications/templates/"+tmpl.ID.String(), repo.NotificationTemplateUpdate{Body: "mine"})
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	preview := func(body v1.NotifierPreviewBody) string {
+		t.Helper()
+		rec := doRequest(t, tOwner, http.MethodPost, "/api/v1/notifiers/preview", body)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var out v1.NotifierPreview
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&out))
+		return out.Message
+	}
+
+	// The group template is used by notifiers without a template of their own
+	msg := preview(v1.NotifierPreviewBody{Type: "maintenance", NotifierID: notifier.ID})
+	assert.Regexp(t, `^Lawn Mower https://homebox.example.com/item/[0-9a-f-]+$`, msg)
+
+	rec = doRequest(t, tOwner, http.MethodPost, "/api/v1/notifications/templates", repo.NotificationTemplateCreate{
+		Type: "maintenance", NotifierID: &notifier.ID, Body: "Due for {{ .Group }}",
+	})
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	msg = preview(v1.NotifierPreviewBody{Type: "maintenance", NotifierID: notifier.ID})
+	assert.True(t, strings.HasPrefix(msg, "Due for "))
+
+	// Types without a template use the built-in one
+	msg = preview(v1.NotifierPreviewBody{Type: "reminder", NotifierID: notifier.ID})
+	assert.Contains(t, msg, "Cordless Drill: Warranty expires in 30 days")
+
+	msg = preview(v1.NotifierPreviewBody{Type: "reminder", Body: "{{ len .Reminders }} reminders"})
+	assert.Equal(t, "1 reminders", msg)
+
+	rec = doRequest(t, tOwner, http.MethodPost, "/api/v1/notifiers/preview", v1.NotifierPreviewBody{Type: "reminder", Body: "{{ .Nope }}"})
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	rec = doRequest(t, tOwner, http.MethodPost, "/api/v1/notifiers/preview", v1.NotifierPreviewBody{Type: "summary"})
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	rec = doRequest(t, tViewer, http.MethodGet, "/api/v1/notifications/templates", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var all []repo.NotificationTemplateOut
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&all))
+	assert.Len(t, all, 2)
+
+	rec = doRequest(t, tEditor, http.MethodDelete, "/api/v1/notifications/templates/"+tmpl.ID.String(), nil)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = doRequest(t, tOwner, http.MethodDelete, "/api/v1/notifications/templates/"+tmpl.ID.String(), nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestRoutes_NotifierDeliveries(t *testing.T) {
+	rec := doRequest(t, tEditor, http.MethodPost, "/api/v1/notifiers", repo.NotifierCreate{
+		Name: "Chat", URL: "generic://example.com", IsActive: true,
+	})
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var notifier repo.NotifierOut
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&notifier))
+
+	err := tApp.repos.Notifiers.RecordDelivery(context.Background(), repo.NotifierDeliveryCreate{
+		NotifierID: notifier.ID, Type: "maintenance", Attempt: 1, Error: "timeout",
+	})
+	require.NoError(t, err)
+
+	rec = doRequest(t, tEditor, http.MethodGet, "/api/v1/notifiers/"+notifier.ID.String()+"/deliveries", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var res repo.PaginationResult[repo.NotifierDeliveryOut]
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&res))
+	require.Equal(t, 1, res.Total)
+	assert.Equal(t, "failed", res.Items[0].Status)
+	assert.Equal(t, "timeout", res.Items[0].Error)
+
+	// Notifiers belong to the user that created them
+	rec = doRequest(t, tOwner, http.MethodGet, "/api/v1/notifiers/"+notifier.ID.String()+"/deliveries", nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestRoutes_GroupNotificationSchedule(t *testing.T) {
+	rec := doRequest(t, tOtherOwner, http.MethodGet, "/api/v1/groups", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var group repo.Group
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&group))
+	assert.Equal(t, "08:00", group.NotifyTime)
+	assert.Equal(t, "none", group.Digest)
+
+	invalid := []map[string]any{
+		{"timezone": "Mars/Olympus_Mons"},
+		{"notifyTime": "25:00"},
+		{"digest": "hourly"},
+	}
+
+	for _, body := range invalid {
+		body["name"] = group.Name
+		body["currency"] = "usd"
+
+		rec = doRequest(t, tOtherOwner, http.MethodPut, "/api/v1/groups", body)
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code, body)
+	}
+
+	rec = doRequest(t, tOtherOwner, http.MethodPut, "/api/v1/groups", map[string]any{
+		"name":       group.Name,
+		"currency":   "usd",
+		"timezone":   "America/Chicago",
+		"notifyTime": "18:30",
+		"digest":     "daily",
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// Settings that are omitted are kept
+	rec = doRequest(t, tOtherOwner, http.MethodPut, "/api/v1/groups", map[string]any{"name": group.Name, "currency": "usd"})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&group))
+	assert.Equal(t, "America/Chicago", group.Timezone)
+	assert.Equal(t, "18:30", group.NotifyTime)
+	assert.Equal(t, "daily", group.Digest)
+}
+
+func TestRoutes_PasswordResetAndVerification(t *testing.T) {
+	public := testMember{}
+
+	// The test app has no mailer configured.
+	rec := doRequest(t, public, http.MethodPost, "/api/v1/users/forgot-password", map[string]any{"email": fk.Email()})
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	rec = doRequest(t, public, http.MethodPost, "/api/v1/users/verify-email/resend", map[string]any{"email": fk.Email()})
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	rec = doRequest(t, public, http.MethodPost, "/api/v1/users/forgot-password", map[string]any{"email": "not-an-email"})
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	rec = doRequest(t, public, http.MethodPost, "/api/v1/users/reset-password", map[string]any{"token": "invalid", "password": "password"})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = doRequest(t, public, http.MethodPost, "/api/v1/users/reset-password", map[string]any{"token": "invalid"})
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	rec = doRequest(t, public, http.MethodPost, "/api/v1/users/verify-email", map[string]any{"token": "invalid"})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestRoutes_TwoFactorLogin(t *testing.T) {
+	ctx := context.Background()
+
+	hashed, err := hasher.HashPassword("password")
+	require.NoError(t, err)
+
+	usr, err := tApp.repos.Users.Create(ctx, repo.UserCreate{
+		Name:     fk.Str(10),
+		Email:    fk.Email(),
+		Password: hashed,
+		GroupID:  tOwner.user.GroupID,
+	})
+	require.NoError(t, err)
+
+	login := func() v1.TokenResponse {
+		rec := doRequest(t, testMember{}, http.MethodPost, "/api/v1/users/login", map[string]any{
+			"username": usr.Email,
+			"password": "password",
+		})
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var out v1.TokenResponse
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&out))
+		return out
+	}
+
+	member := testMember{user: usr, token: strings.TrimPrefix(login().Token, "Bearer ")}
+
+	rec := doRequest(t, member, http.MethodGet, "/api/v1/users/self/2fa/setup/qrcode", nil)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	rec = doRequest(t, member, http.MethodPost, "/api/v1/users/self/2fa/setup", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var setup services.TwoFactorSetup
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&setup))
+
+	rec = doRequest(t, member, http.MethodGet, "/api/v1/users/self/2fa/setup/qrcode", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+
+	rec = doRequest(t, member, http.MethodPost, "/api/v1/users/self/2fa/enable", map[string]any{"code": "000000"})
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	now := totp.Step(time.Now())
+	code, err := totp.Code(setup.Secret, now)
+	require.NoError(t, err)
+
+	rec = doRequest(t, member, http.MethodPost, "/api/v1/users/self/2fa/enable", map[string]any{"code": code})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var recovery services.TwoFactorRecoveryCodes
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&recovery))
+	require.NotEmpty(t, recovery.RecoveryCodes)
+
+	// The password alone no longer issues a session.
+	challenge := login()
+	assert.Empty(t, challenge.Token)
+	assert.True(t, challenge.TwoFactorRequired)
+	require.NotEmpty(t, challenge.TwoFactorToken)
+
+	rec = doRequest(t, testMember{}, http.MethodPost, "/api/v1/users/login/2fa", map[string]any{
+		"token": challenge.TwoFactorToken,
+		"code":  "000000",
+	})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = doRequest(t, testMember{}, http.MethodPost, "/api/v1/users/login/2fa", map[string]any{
+		"token": challenge.TwoFactorToken,
+		"code":  recovery.RecoveryCodes[0],
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var session v1.TokenResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&session))
+	assert.NotEmpty(t, session.Token)
+	assert.NotEmpty(t, rec.Result().Cookies())
+
+	// Disabling requires the password and a code.
+	rec = doRequest(t, member, http.MethodPost, "/api/v1/users/self/2fa/disable", map[string]any{
+		"password": "wrong",
+		"code":     recovery.RecoveryCodes[1],
+	})
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	rec = doRequest(t, member, http.MethodPost, "/api/v1/users/self/2fa/disable", map[string]any{
+		"password": "password",
+		"code":     recovery.RecoveryCodes[1],
+	})
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	assert.NotEmpty(t, login().Token)
+}
+
+func TestRoutes_OIDCLoginTwoFactor(t *testing.T) {
+	ctx := context.Background()
+
+	idp := oidctest.New("homebox")
+	defer idp.Close()
+
+	cfg := *tApp.conf
+	cfg.OIDC = config.OIDCConf{
+		Enabled:     true,
+		IssuerURL:   idp.URL(),
+		ClientID:    "homebox",
+		RedirectURL: "https://homebox.example.com/api/v1/users/login/oidc/callback",
+		Scopes:      []string{"openid", "email"},
+		EmailClaim:  "email",
+		NameClaim:   "name",
+	}
+	router := newRouterWith(cfg)
+
+	hashed, err := hasher.HashPassword("password")
+	require.NoError(t, err)
+
+	usr, err := tApp.repos.Users.Create(ctx, repo.UserCreate{
+		Name:      fk.Str(10),
+		Email:     fk.Email(),
+		Password:  hashed,
+		GroupID:   tOwner.user.GroupID,
+		Activated: true,
+	})
+	require.NoError(t, err)
+	idp.SetClaim("email", usr.Email)
+
+	secret, err := totp.NewSecret()
+	require.NoError(t, err)
+	require.NoError(t, tApp.repos.Users.SetTwoFactorSecret(ctx, usr.ID, secret))
+	require.NoError(t, tApp.repos.Users.EnableTwoFactor(ctx, usr.ID, 0, nil))
+
+	// Start the login and follow the redirect of the identity provider back to the callback.
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/users/login/oidc", nil))
+	require.Equal(t, http.StatusFound, rec.Code)
+
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+
+	resp, err := client.Get(rec.Header().Get("Location"))
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	callback := httptest.NewRequest(http.MethodGet, resp.Header.Get("Location"), nil)
+	for _, c := range rec.Result().Cookies() {
+		callback.AddCookie(c)
+	}
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, callback)
+	require.Equal(t, http.StatusFound, rec.Code)
+
+	// No session is issued, the login is completed with a code.
+	for _, c := range rec.Result().Cookies() {
+		assert.NotEqual(t, "hb.auth.token", c.Name)
+	}
+
+	location, err := url.Parse(rec.Header().Get("Location"))
+	require.NoError(t, err)
+	assert.Equal(t, "/", location.Path)
+
+	fragment, err := url.ParseQuery(location.Fragment)
+	require.NoError(t, err)
+	require.NotEmpty(t, fragment.Get("twoFactorToken"))
+
+	rec = doRequestOn(t, router, testMember{}, http.MethodPost, "/api/v1/users/login/2fa", map[string]any{
+		"token": fragment.Get("twoFactorToken"),
+		"code":  "000000",
+	})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	require.NoError(t, err)
+
+	rec = doRequestOn(t, router, testMember{}, http.MethodPost, "/api/v1/users/login/2fa", map[string]any{
+		"token": fragment.Get("twoFactorToken"),
+		"code":  code,
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var session v1.TokenResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&session))
+	assert.NotEmpty(t, session.Token)
+}
+
+func TestRoutes_PasskeyLogin(t *testing.T) {
+	ctx := context.Background()
+
+	hashed, err := hasher.HashPassword("password")
+	require.NoError(t, err)
+
+	usr, err := tApp.repos.Users.Create(ctx, repo.UserCreate{
+		Name:      fk.Str(10),
+		Email:     fk.Email(),
+		Password:  hashed,
+		GroupID:   tOwner.user.GroupID,
+		Activated: true,
+	})
+	require.NoError(t, err)
+
+	token, err := tApp.services.User.Login(ctx, usr.Email, "password", false)
+	require.NoError(t, err)
+	member := testMember{user: usr, token: token.Raw}
+
+	// Register a passkey.
+	rec := doRequest(t, member, http.MethodPost, "/api/v1/users/self/passkeys/register", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var creation protocol.CredentialCreation
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&creation))
+
+	auth := passkeytest.New("https://homebox.example.com")
+	credential, err := auth.Create(creation)
+	require.NoError(t, err)
+
+	rec = doRequest(t, member, http.MethodPost, "/api/v1/users/self/passkeys", map[string]any{
+		"name":       "Laptop",
+		"credential": json.RawMessage(credential),
+	})
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var passkey repo.WebAuthnCredentialOut
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&passkey))
+
+	rec = doRequest(t, member, http.MethodPut, "/api/v1/users/self/passkeys/"+passkey.ID.String(), map[string]any{"name": "Phone"})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = doRequest(t, member, http.MethodGet, "/api/v1/users/self/passkeys", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var passkeys []repo.WebAuthnCredentialOut
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&passkeys))
+	require.Len(t, passkeys, 1)
+	assert.Equal(t, "Phone", passkeys[0].Name)
+
+	// Login with the passkey, the login is identified by the cookie set with the challenge.
+	login := func(withCookie bool) *httptest.ResponseRecorder {
+		rec := doRequest(t, testMember{}, http.MethodPost, "/api/v1/users/login/webauthn/begin", nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var assertion protocol.CredentialAssertion
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&assertion))
+
+		response, err := auth.Get(assertion)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/users/login?provider=webauthn", bytes.NewReader(response))
+		req.Header.Set("Content-Type", "application/json")
+		if withCookie {
+			for _, c := range rec.Result().Cookies() {
+				req.AddCookie(c)
+			}
+		}
+
+		out := httptest.NewRecorder()
+		tRouter.ServeHTTP(out, req)
+		return out
+	}
+
+	rec = login(false)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = login(true)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var session v1.TokenResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&session))
+	assert.NotEmpty(t, session.Token)
+	assert.NotEmpty(t, session.AttachmentToken)
+	assert.NotEmpty(t, rec.Result().Cookies())
+
+	rec = doRequest(t, testMember{token: strings.TrimPrefix(session.Token, "Bearer ")}, http.MethodGet, "/api/v1/users/self", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// A revoked passkey can no longer be used.
+	rec = doRequest(t, member, http.MethodDelete, "/api/v1/users/self/passkeys/"+passkey.ID.String(), nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = login(true)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestRoutes_Sessions(t *testing.T) {
+	ctx := context.Background()
+
+	hashed, err := hasher.HashPassword("password")
+	require.NoError(t, err)
+
+	usr, err := tApp.repos.Users.Create(ctx, repo.UserCreate{
+		Name:      fk.Str(10),
+		Email:     fk.Email(),
+		Password:  hashed,
+		GroupID:   tOwner.user.GroupID,
+		Activated: true,
+	})
+	require.NoError(t, err)
+
+	members := make([]testMember, 2)
+	for i := range members {
+		token, err := tApp.services.User.Login(ctx, usr.Email, "password", false)
+		require.NoError(t, err)
+		members[i] = testMember{user: usr, token: token.Raw}
+	}
+
+	rec := doRequest(t, members[0], http.MethodGet, "/api/v1/users/self/sessions", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var sessions []repo.UserSessionOut
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&sessions))
+	require.Len(t, sessions, 2)
+
+	var other repo.UserSessionOut
+	for _, s := range sessions {
+		if s.Current {
+			// The request listing the sessions is recorded by the auth middleware.
+			assert.Equal(t, "192.0.2.1", s.IPAddress)
+			assert.NotNil(t, s.LastUsedAt)
+		} else {
+			other = s
+		}
+	}
+	require.NotEqual(t, uuid.Nil, other.ID)
+
+	// Sessions of other users are not found.
+	rec = doRequest(t, tOwner, http.MethodDelete, "/api/v1/users/self/sessions/"+other.ID.String(), nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = doRequest(t, members[0], http.MethodDelete, "/api/v1/users/self/sessions", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var revoked v1.SessionsRevoked
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&revoked))
+	assert.Equal(t, 1, revoked.Revoked)
+
+	rec = doRequest(t, members[1], http.MethodGet, "/api/v1/users/self", nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = doRequest(t, members[0], http.MethodGet, "/api/v1/users/self", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestRoutes_UserGroups(t *testing.T) {
+	owner, _, _, err := newTestGroup()
+	require.NoError(t, err)
+
+	item := useItem(t)
+	itemPath := "/api/v1/items/" + item.ID.String()
+
+	rec := doRequest(t, owner, http.MethodGet, itemPath, nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = doRequest(t, tOwner, http.MethodPost, "/api/v1/groups/invitations", v1.GroupInvitationCreate{
+		Uses:      1,
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var invitation v1.GroupInvitation
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&invitation))
+
+	// An API key created before the switch keeps acting in the group it was created in.
+	rec = doRequest(t, owner, http.MethodPost, "/api/v1/users/self/api-keys", repo.APIKeyCreate{
+		Name:  fk.Str(10),
+		Scope: "items_read",
+	})
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var key services.APIKeyDetail
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&key))
+
+	rec = doRequest(t, owner, http.MethodPost, "/api/v1/users/self/groups", v1.GroupJoin{Token: invitation.Token})
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	rec = doRequest(t, owner, http.MethodGet, "/api/v1/users/self/groups", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var groups []repo.GroupMembershipOut
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&groups))
+	require.Len(t, groups, 2)
+
+	rec = doRequest(t, owner, http.MethodPost, "/api/v1/users/self/groups/"+tOwner.user.GroupID.String()+"/switch", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = doRequest(t, owner, http.MethodGet, "/api/v1/users/self", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var self v1.Wrapped
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&self))
+	assert.Equal(t, tOwner.user.GroupID.String(), self.Item.(map[string]any)["groupId"])
+	assert.Equal(t, "editor", self.Item.(map[string]any)["role"])
+
+	rec = doRequest(t, owner, http.MethodGet, itemPath, nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = doRequest(t, testMember{token: key.Token}, http.MethodGet, itemPath, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// Groups the user is not a member of can not be switched to.
+	rec = doRequest(t, owner, http.MethodPost, "/api/v1/users/self/groups/"+uuid.New().String()+"/switch", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestRoutes_APIKeyScopes(t *testing.T) {
+	owner, _, _, err := newTestGroup()
+	require.NoError(t, err)
+
+	keys := map[string]testMember{}
+	for _, scope := range []string{"items_read", "items_write", "attachments"} {
+		rec := doRequest(t, owner, http.MethodPost, "/api/v1/users/self/api-keys", repo.APIKeyCreate{
+			Name:  fk.Str(10),
+			Scope: scope,
+		})
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var key services.APIKeyDetail
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&key))
+		keys[scope] = testMember{token: key.Token}
+	}
+
+	ctx := context.Background()
+	location, err := tApp.repos.Locations.Create(ctx, owner.user.GroupID, repo.LocationCreate{Name: fk.Str(10)})
+	require.NoError(t, err)
+
+	item, err := tApp.repos.Items.Create(ctx, owner.user.GroupID, repo.ItemCreate{Name: fk.Str(10), LocationID: location.ID})
+	require.NoError(t, err)
+
+	doc, err := tApp.repos.Docs.Create(ctx, owner.user.GroupID, repo.DocumentCreate{
+		Title:   "manual.txt",
+		Content: strings.NewReader("manual"),
+	})
+	require.NoError(t, err)
+
+	attachment, err := tApp.repos.Attachments.Create(ctx, item.ID, doc.ID, "manual")
+	require.NoError(t, err)
+
+	attachmentPath := "/api/v1/items/" + item.ID.String() + "/attachments/" + attachment.ID.String()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   any
+		want   map[string]int
+	}{
+		{
+			name:   "read",
+			method: http.MethodGet,
+			path:   "/api/v1/labels",
+			want: map[string]int{
+				"items_read":  http.StatusOK,
+				"items_write": http.StatusOK,
+				"attachments": http.StatusForbidden,
+			},
+		},
+		{
+			name:   "write",
+			method: http.MethodPost,
+			path:   "/api/v1/labels",
+			body:   repo.LabelCreate{Name: fk.Str(10)},
+			want: map[string]int{
+				"items_read":  http.StatusForbidden,
+				"items_write": http.StatusCreated,
+				"attachments": http.StatusForbidden,
+			},
+		},
+		{
+			name:   "saved search",
+			method: http.MethodPost,
+			path:   "/api/v1/saved-searches",
+			body:   repo.SavedSearchCreate{Name: fk.Str(10)},
+			want: map[string]int{
+				"items_read":  http.StatusForbidden,
+				"items_write": http.StatusForbidden,
+				"attachments": http.StatusForbidden,
+			},
+		},
+		{
+			name:   "notification rule",
+			method: http.MethodPost,
+			path:   "/api/v1/notifications/rules",
+			body:   repo.NotificationRuleCreate{Name: fk.Str(10), Kind: "warranty", LeadDays: []int{7}},
+			want: map[string]int{
+				"items_read":  http.StatusForbidden,
+				"items_write": http.StatusForbidden,
+				"attachments": http.StatusForbidden,
+			},
+		},
+		{
+			name:   "notification template",
+			method: http.MethodPost,
+			path:   "/api/v1/notifications/templates",
+			body:   repo.NotificationTemplateCreate{Type: "reminder", Body: "{{ len .Reminders }}"},
+			want: map[string]int{
+				"items_read":  http.StatusForbidden,
+				"items_write": http.StatusForbidden,
+				"attachments": http.StatusForbidden,
+			},
+		},
+		{
+			name:   "attachment",
+			method: http.MethodGet,
+			path:   attachmentPath,
+			want: map[string]int{
+				"items_read":  http.StatusOK,
+				"items_write": http.StatusOK,
+				"attachments": http.StatusOK,
+			},
+		},
+		{
+			name:   "user",
+			method: http.MethodGet,
+			path:   "/api/v1/users/self/api-keys",
+			want: map[string]int{
+				"items_read":  http.StatusForbidden,
+				"items_write": http.StatusForbidden,
+				"attachments": http.StatusForbidden,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		for scope, want := range tt.want {
+			t.Run(tt.name+"/"+scope, func(t *testing.T) {
+				rec := doRequest(t, keys[scope], tt.method, tt.path, tt.body)
+				assert.Equal(t, want, rec.Code)
+			})
+		}
+	}
+
+	// Keys can not be created already expired.
+	expired := time.Now().Add(-time.Hour)
+	rec := doRequest(t, owner, http.MethodPost, "/api/v1/users/self/api-keys", repo.APIKeyCreate{
+		Name:      fk.Str(10),
+		Scope:     "items_read",
+		ExpiresAt: &expired,
+	})
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestRoutes_Admin(t *testing.T) {
+	ctx := context.Background()
+
+	hashed, err := hasher.HashPassword("password")
+	require.NoError(t, err)
+
+	newUser := func(superuser bool) testMember {
+		usr, err := tApp.repos.Users.Create(ctx, repo.UserCreate{
+			Name:        fk.Str(10),
+			Email:       fk.Email(),
+			Password:    hashed,
+			IsSuperuser: superuser,
+			GroupID:     tOwner.user.GroupID,
+			Activated:   true,
+		})
+		require.NoError(t, err)
+
+		token, err := tApp.services.User.Login(ctx, usr.Email, "password", false)
+		require.NoError(t, err)
+
+		return testMember{user: usr, token: token.Raw}
+	}
+
+	login := func(email, password string) *httptest.ResponseRecorder {
+		return doRequest(t, testMember{}, http.MethodPost, "/api/v1/users/login", map[string]any{
+			"username": email,
+			"password": password,
+		})
+	}
+
+	admin := newUser(true)
+	target := newUser(false)
+
+	// Group owners are not superusers
+	rec := doRequest(t, tOwner, http.MethodGet, "/api/v1/admin/users", nil)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = doRequest(t, admin, http.MethodGet, "/api/v1/admin/groups", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var groups []repo.GroupUsage
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&groups))
+
+	var group repo.GroupUsage
+	for _, g := range groups {
+		if g.ID == tOwner.user.GroupID {
+			group = g
+		}
+	}
+	assert.GreaterOrEqual(t, group.TotalUsers, 5)
+
+	rec = doRequest(t, admin, http.MethodGet, "/api/v1/admin/users", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var users []repo.UserUsage
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&users))
+
+	var found repo.UserUsage
+	for _, u := range users {
+		if u.ID == target.user.ID {
+			found = u
+		}
+	}
+	assert.Equal(t, 1, found.Sessions)
+	require.Len(t, found.Groups, 1)
+	assert.Equal(t, "editor", found.Groups[0].Role)
+
+	// Superusers can not lock themselves out
+	rec = doRequest(t, admin, http.MethodPost, "/api/v1/admin/users/"+admin.user.ID.String()+"/disable", nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	rec = doRequest(t, admin, http.MethodPost, "/api/v1/admin/users/"+target.user.ID.String()+"/disable", nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = doRequest(t, target, http.MethodGet, "/api/v1/users/self", nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = login(target.user.Email, "password")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = doRequest(t, admin, http.MethodPost, "/api/v1/admin/users/"+target.user.ID.String()+"/enable", nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = login(target.user.Email, "password")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var session v1.TokenResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&session))
+
+	rec = doRequest(t, testMember{token: strings.TrimPrefix(session.Token, "Bearer ")}, http.MethodPost, "/api/v1/users/self/api-keys", repo.APIKeyCreate{
+		Name:  fk.Str(10),
+		Scope: "items_read",
+	})
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var key services.APIKeyDetail
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&key))
+
+	// The test app has no mailer, the reset link is returned instead
+	rec = doRequest(t, admin, http.MethodPost, "/api/v1/admin/users/"+target.user.ID.String()+"/reset-password", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var reset services.AdminPasswordReset
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&reset))
+	assert.False(t, reset.Mailed)
+
+	// The account may be compromised, its API keys are revoked along with the sessions.
+	rec = doRequest(t, testMember{token: key.Token}, http.MethodGet, "/api/v1/items", nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	link, err := url.Parse(reset.URL)
+	require.NoError(t, err)
+
+	rec = login(target.user.Email, "password")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = doRequest(t, testMember{}, http.MethodPost, "/api/v1/users/reset-password", v1.PasswordReset{
+		Token:    link.Query().Get("token"),
+		Password: "new-password",
+	})
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = login(target.user.Email, "new-password")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = doRequest(t, admin, http.MethodDelete, "/api/v1/admin/users/"+target.user.ID.String(), nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = login(target.user.Email, "new-password")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// Registration is toggled at runtime
+	t.Cleanup(func() {
+		_ = doRequest(t, admin, http.MethodPut, "/api/v1/admin/registration", services.Registration{AllowRegistration: true})
+	})
+
+	rec = doRequest(t, admin, http.MethodPut, "/api/v1/admin/registration", services.Registration{AllowRegistration: false})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = doRequest(t, testMember{}, http.MethodGet, "/api/v1/status", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var status v1.APISummary
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&status))
+	assert.False(t, status.AllowRegistration)
+
+	rec = doRequest(t, testMember{}, http.MethodPost, "/api/v1/users/register", services.UserRegistration{
+		Name:     fk.Str(10),
+		Email:    fk.Email(),
+		Password: fk.Str(10),
+	})
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+// newLimitedRouter mounts the routes of the test app with the given rate limits.
+func newLimitedRouter(limits config.RateLimitConf) http.Handler {
+	cfg := *tApp.conf
+	cfg.RateLimit = limits
+
+	return newRouterWith(cfg)
+}
+
+// newRouterWith mounts the routes of the test app with another configuration.
+func newRouterWith(cfg config.Config) http.Handler {
+	a := *tApp
+	a.conf = &cfg
+
+	proxies, err := mid.ParseProxies(cfg.Web.TrustedProxies)
+	if err != nil {
+		panic(err)
+	}
+
+	router := chi.NewMux()
+	router.Use(middleware.RequestID, mid.RealIP(proxies))
+	a.mountRoutes(router, errchain.New(mid.Errors(zerolog.Nop())), a.repos)
+	return router
+}
+
+func TestRoutes_LoginLockout(t *testing.T) {
+	router := newLimitedRouter(config.RateLimitConf{
+		Login: config.LoginLimitConf{
+			Enabled:         true,
+			FreeAttempts:    10,
+			AccountAttempts: 2,
+			IPAttempts:      100,
+			LockoutDuration: time.Minute,
+			Window:          time.Hour,
+		},
+	})
+
+	hashed, err := hasher.HashPassword("password")
+	require.NoError(t, err)
+
+	users := make([]repo.UserOut, 2)
+	for i := range users {
+		users[i], err = tApp.repos.Users.Create(context.Background(), repo.UserCreate{
+			Name:      fk.Str(10),
+			Email:     fk.Email(),
+			Password:  hashed,
+			GroupID:   tOwner.user.GroupID,
+			Activated: true,
+		})
+		require.NoError(t, err)
+	}
+	usr := users[0]
+
+	login := func(email, password string) *httptest.ResponseRecorder {
+		return doRequestOn(t, router, testMember{}, http.MethodPost, "/api/v1/users/login", map[string]any{
+			"username": email,
+			"password": password,
+		})
+	}
+
+	// A successful login clears the failures of the account.
+	require.Equal(t, http.StatusUnauthorized, login(usr.Email, "wrong").Code)
+	require.Equal(t, http.StatusOK, login(usr.Email, "password").Code)
+
+	require.Equal(t, http.StatusUnauthorized, login(usr.Email, "wrong").Code)
+	require.Equal(t, http.StatusUnauthorized, login(usr.Email, "wrong").Code)
+
+	// The account is locked, even for the correct password.
+	rec := login(usr.Email, "password")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "60", rec.Header().Get("Retry-After"))
+
+	// Other accounts can still login from the same address.
+	require.Equal(t, http.StatusOK, login(users[1].Email, "password").Code)
+
+	// The default router has no limits.
+	rec = doRequest(t, testMember{}, http.MethodPost, "/api/v1/users/login", map[string]any{
+		"username": usr.Email,
+		"password": "password",
+	})
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestRoutes_LoginLockoutForwardedFor(t *testing.T) {
+	limits := config.RateLimitConf{
+		Login: config.LoginLimitConf{
+			Enabled:         true,
+			FreeAttempts:    10,
+			AccountAttempts: 100,
+			IPAttempts:      2,
+			LockoutDuration: time.Minute,
+			Window:          time.Hour,
+		},
+	}
+
+	login := func(router http.Handler, forwardedFor string) int {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/users/login", strings.NewReader(`{"username":"`+fk.Email()+`","password":"wrong"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// Forwarding headers of untrusted clients are ignored, rotating them does not
+	// reset the limit of the address.
+	router := newLimitedRouter(limits)
+	require.Equal(t, http.StatusUnauthorized, login(router, "198.51.100.1"))
+	require.Equal(t, http.StatusUnauthorized, login(router, "198.51.100.2"))
+	assert.Equal(t, http.StatusTooManyRequests, login(router, "198.51.100.3"))
+
+	// Behind a trusted proxy every client has its own limit, addresses the client
+	// prepends to the header are not used.
+	cfg := *tApp.conf
+	cfg.RateLimit = limits
+	cfg.Web.TrustedProxies = []string{"192.0.2.0/24"}
+
+	router = newRouterWith(cfg)
+	require.Equal(t, http.StatusUnauthorized, login(router, "203.0.113.9, 198.51.100.1"))
+	require.Equal(t, http.StatusUnauthorized, login(router, "203.0.113.8, 198.51.100.1"))
+	assert.Equal(t, http.StatusTooManyRequests, login(router, "203.0.113.7, 198.51.100.1"))
+	assert.Equal(t, http.StatusUnauthorized, login(router, "198.51.100.2"))
+}
+
+func TestRoutes_APIRateLimit(t *testing.T) {
+	router := newLimitedRouter(config.RateLimitConf{
+		API: config.APILimitConf{Rate: 0.01, Burst: 2},
+	})
+
+	create := func(m testMember) int {
+		return doRequestOn(t, router, m, http.MethodPost, "/api/v1/labels", repo.LabelCreate{Name: fk.Str(10)}).Code
+	}
+
+	require.Equal(t, http.StatusCreated, create(tOwner))
+	require.Equal(t, http.StatusCreated, create(tOwner))
+
+	rec := doRequestOn(t, router, tOwner, http.MethodPost, "/api/v1/labels", repo.LabelCreate{Name: fk.Str(10)})
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "100", rec.Header().Get("Retry-After"))
+
+	// Reads are not limited and every user has their own bucket.
+	assert.Equal(t, http.StatusOK, doRequestOn(t, router, tOwner, http.MethodGet, "/api/v1/labels", nil).Code)
+	assert.Equal(t, http.StatusCreated, create(tEditor))
+}
+
+func TestRoutes_GroupBackup(t *testing.T) {
+	owner, editor, _, err := newTestGroup()
+	require.NoError(t, err)
+
+	rec := doRequest(t, editor, http.MethodGet, "/api/v1/groups/backup", nil)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = doRequest(t, owner, http.MethodGet, "/api/v1/groups/backup", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/zip", rec.Header().Get("Content-Type"))
+
+	zr, err := zip.NewReader(bytes.NewReader(rec.Body.Bytes()), int64(rec.Body.Len()))
+	require.NoError(t, err)
+
+	f, err := zr.Open("manifest.json")
+	require.NoError(t, err)
+
+	var manifest struct {
+		Version int `json:"version"`
+	}
+	require.NoError(t, json.NewDecoder(f).Decode(&manifest))
+	assert.Equal(t, services.BackupVersion, manifest.Version)
+}
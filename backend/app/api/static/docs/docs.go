@@ -117,59 +117,75 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/assets/{id}": {
+        "/v1/admin/groups": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Lists all groups of the instance with their number of members, items, locations,\nlabels and attachments. Only superusers can access the admin endpoints.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
-                ],
-                "summary": "Get Item by Asset ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Asset ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Admin"
                 ],
+                "summary": "Get All Groups",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.PaginationResult-repo_ItemSummary"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.GroupUsage"
+                            }
                         }
                     }
                 }
             }
         },
-        "/v1/currency": {
-            "get": {
+        "/v1/admin/groups/restore": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Restores a backup archive downloaded from /v1/groups/backup as a new group owned\nby the superuser. All entities get new IDs. Members of the backup are added when an\naccount with their email exists, the others are returned as missing members.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Base"
+                    "Admin"
+                ],
+                "summary": "Restore Group",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Backup archive",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
                 ],
-                "summary": "Currency",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/currencies.Currency"
+                            "$ref": "#/definitions/repo.GroupRestore"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/validate.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/groups": {
+        "/v1/admin/registration": {
             "get": {
                 "security": [
                     {
@@ -180,14 +196,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
+                    "Admin"
                 ],
-                "summary": "Get Group",
+                "summary": "Get Registration Setting",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.Group"
+                            "$ref": "#/definitions/services.Registration"
                         }
                     }
                 }
@@ -198,21 +214,22 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
+                "description": "Allows or disallows new users to register without an invitation. The setting\nis reset to HBOX_OPTIONS_ALLOW_REGISTRATION when the server restarts.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
+                    "Admin"
                 ],
-                "summary": "Update Group",
+                "summary": "Update Registration Setting",
                 "parameters": [
                     {
-                        "description": "User Data",
+                        "description": "Registration Setting",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.GroupUpdate"
+                            "$ref": "#/definitions/services.Registration"
                         }
                     }
                 ],
@@ -220,126 +237,157 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.Group"
+                            "$ref": "#/definitions/services.Registration"
                         }
                     }
                 }
             }
         },
-        "/v1/groups/invitations": {
-            "post": {
+        "/v1/admin/users": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Lists all users of the instance with their groups, sessions and last activity.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
-                ],
-                "summary": "Create Group Invitation",
-                "parameters": [
-                    {
-                        "description": "User Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.GroupInvitationCreate"
-                        }
-                    }
+                    "Admin"
                 ],
+                "summary": "Get All Users",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.GroupInvitation"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.UserUsage"
+                            }
                         }
                     }
                 }
             }
         },
-        "/v1/groups/statistics": {
-            "get": {
+        "/v1/admin/users/{id}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Statistics"
+                    "Admin"
+                ],
+                "summary": "Delete User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get Group Statistics",
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/repo.GroupStatistics"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/v1/groups/statistics/labels": {
-            "get": {
+        "/v1/admin/users/{id}/disable": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "produces": [
-                    "application/json"
+                "description": "Disables the account and logs the user out. Disabled users can not login and\ntheir API keys are rejected.",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Disable User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/admin/users/{id}/enable": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
                 ],
                 "tags": [
-                    "Statistics"
+                    "Admin"
+                ],
+                "summary": "Enable User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get Label Statistics",
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.TotalsByOrganizer"
-                            }
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/v1/groups/statistics/locations": {
-            "get": {
+        "/v1/admin/users/{id}/reset-password": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Replaces the password of the user with a random one, logs them out and revokes their\nAPI keys. A password reset link is mailed to the user, or returned when no mailer is\nconfigured.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Statistics"
+                    "Admin"
+                ],
+                "summary": "Force Password Reset",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get Location Statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.TotalsByOrganizer"
-                            }
+                            "$ref": "#/definitions/services.AdminPasswordReset"
                         }
                     }
                 }
             }
         },
-        "/v1/groups/statistics/purchase-price": {
+        "/v1/assets/{id}": {
             "get": {
                 "security": [
                     {
@@ -350,54 +398,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Statistics"
+                    "Items"
                 ],
-                "summary": "Get Purchase Price Statistics",
+                "summary": "Get Item by Asset ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "start date",
-                        "name": "start",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "end date",
-                        "name": "end",
-                        "in": "query"
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.ValueOverTime"
+                            "$ref": "#/definitions/repo.PaginationResult-repo_ItemSummary"
                         }
                     }
                 }
             }
         },
-        "/v1/items": {
+        "/v1/audit": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Returns the changes made to the items, locations, labels and attachments of the group, newest first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Audit"
                 ],
-                "summary": "Query All Items",
+                "summary": "Query Audit Log",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "search string",
-                        "name": "q",
-                        "in": "query"
-                    },
                     {
                         "type": "integer",
                         "description": "page number",
@@ -406,38 +444,63 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "items per page",
+                        "description": "entries per page",
                         "name": "pageSize",
                         "in": "query"
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "label Ids",
-                        "name": "labels",
+                        "enum": [
+                            "create",
+                            "update",
+                            "delete",
+                            "restore",
+                            "purge"
+                        ],
+                        "type": "string",
+                        "description": "action",
+                        "name": "action",
                         "in": "query"
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "location Ids",
-                        "name": "locations",
+                        "enum": [
+                            "item",
+                            "location",
+                            "label",
+                            "attachment"
+                        ],
+                        "type": "string",
+                        "description": "entity type",
+                        "name": "entityType",
                         "in": "query"
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "parent Ids",
-                        "name": "parentIds",
+                        "type": "string",
+                        "description": "entity ID",
+                        "name": "entityId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "item ID",
+                        "name": "itemId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "user ID",
+                        "name": "userId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 timestamp",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 timestamp",
+                        "name": "until",
                         "in": "query"
                     }
                 ],
@@ -445,121 +508,140 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.PaginationResult-repo_ItemSummary"
+                            "$ref": "#/definitions/repo.PaginationResult-repo_AuditEntryOut"
                         }
                     }
                 }
-            },
-            "post": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
+            }
+        },
+        "/v1/currency": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
-                ],
-                "summary": "Create Item",
-                "parameters": [
-                    {
-                        "description": "Item Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/repo.ItemCreate"
-                        }
-                    }
+                    "Base"
                 ],
+                "summary": "Currency",
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemSummary"
+                            "$ref": "#/definitions/currencies.Currency"
                         }
                     }
                 }
             }
         },
-        "/v1/items/export": {
+        "/v1/groups": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Items"
+                    "Group"
                 ],
-                "summary": "Export Items",
+                "summary": "Get Group",
                 "responses": {
                     "200": {
-                        "description": "text/csv",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/repo.Group"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Group"
+                ],
+                "summary": "Update Group",
+                "parameters": [
+                    {
+                        "description": "User Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.GroupUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.Group"
                         }
                     }
                 }
             }
         },
-        "/v1/items/fields": {
+        "/v1/groups/backup": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Downloads a zip archive with everything in the group, including the attachment\nfiles. Of the notifiers only those of the acting user are included. The archive\ncan be restored by a superuser with /v1/admin/groups/restore.",
                 "produces": [
-                    "application/json"
+                    "application/zip"
                 ],
                 "tags": [
-                    "Items"
+                    "Group"
                 ],
-                "summary": "Get All Custom Field Names",
+                "summary": "Backup Group",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "type": "file"
                         }
                     }
                 }
             }
         },
-        "/v1/items/fields/values": {
+        "/v1/groups/invitations": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Lists the invitations of the group that are not expired or used up.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Group"
                 ],
-                "summary": "Get All Custom Field Values",
+                "summary": "Get Group Invitations",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "type": "string"
+                                "$ref": "#/definitions/repo.GroupInvitation"
                             }
                         }
                     }
                 }
-            }
-        },
-        "/v1/items/import": {
+            },
             "post": {
                 "security": [
                     {
@@ -570,114 +652,101 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Group"
                 ],
-                "summary": "Import Items",
+                "summary": "Create Group Invitation",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "Image to upload",
-                        "name": "csv",
-                        "in": "formData",
-                        "required": true
+                        "description": "User Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupInvitationCreate"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupInvitation"
+                        }
                     }
                 }
             }
         },
-        "/v1/items/{id}": {
-            "get": {
+        "/v1/groups/invitations/{id}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Items"
+                    "Group"
                 ],
-                "summary": "Get Item",
+                "summary": "Revoke Group Invitation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Item ID",
+                        "description": "Invitation ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/repo.ItemOut"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/groups/members": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Lists the members of the group with their roles. Only group owners can list members.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
-                ],
-                "summary": "Update Item",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Item Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/repo.ItemUpdate"
-                        }
-                    }
+                    "Group"
                 ],
+                "summary": "Get Group Members",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemOut"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.UserOut"
+                            }
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/groups/members/{id}": {
             "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Removes a member from the group, the account of the member is kept. Owners and\nmembers that do not belong to another group cannot be removed. Only group owners\ncan remove members.",
                 "tags": [
-                    "Items"
+                    "Group"
                 ],
-                "summary": "Delete Item",
+                "summary": "Remove Group Member",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Item ID",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -688,35 +757,38 @@ const docTemplate = `{
                         "description": "No Content"
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/v1/groups/members/{id}/role": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Changes the role of a member of the group. Only group owners can change roles, the\nrole of another owner cannot be changed.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Group"
                 ],
-                "summary": "Update Item",
+                "summary": "Update Group Member Role",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Item ID",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Item Data",
+                        "description": "Role Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemPatch"
+                            "$ref": "#/definitions/services.MemberRoleUpdate"
                         }
                     }
                 ],
@@ -724,73 +796,47 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemOut"
+                            "$ref": "#/definitions/repo.UserOut"
                         }
                     }
                 }
             }
         },
-        "/v1/items/{id}/attachments": {
+        "/v1/groups/members/{id}/transfer-ownership": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Makes the member the owner of the group, the current owner becomes an editor.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Items Attachments"
+                    "Group"
                 ],
-                "summary": "Create Item Attachment",
+                "summary": "Transfer Group Ownership",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Item ID",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "File attachment",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Type of file",
-                        "name": "type",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "name of the file including extension",
-                        "name": "name",
-                        "in": "formData",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemOut"
-                        }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/validate.ErrorResponse"
+                            "$ref": "#/definitions/repo.UserOut"
                         }
                     }
                 }
             }
         },
-        "/v1/items/{id}/attachments/{attachment_id}": {
+        "/v1/groups/statistics": {
             "get": {
                 "security": [
                     {
@@ -798,133 +844,190 @@ const docTemplate = `{
                     }
                 ],
                 "produces": [
-                    "application/octet-stream"
+                    "application/json"
                 ],
                 "tags": [
-                    "Items Attachments"
+                    "Statistics"
                 ],
-                "summary": "Get Item Attachment",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
+                "summary": "Get Group Statistics",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.GroupStatistics"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/groups/statistics/labels": {
+            "get": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "Attachment ID",
-                        "name": "attachment_id",
-                        "in": "path",
-                        "required": true
+                        "Bearer": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Statistics"
+                ],
+                "summary": "Get Label Statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.ItemAttachmentToken"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.TotalsByOrganizer"
+                            }
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/groups/statistics/locations": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "tags": [
-                    "Items Attachments"
+                "produces": [
+                    "application/json"
                 ],
-                "summary": "Update Item Attachment",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Attachment ID",
-                        "name": "attachment_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Attachment Update",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/repo.ItemAttachmentUpdate"
-                        }
-                    }
+                "tags": [
+                    "Statistics"
                 ],
+                "summary": "Get Location Statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.ItemOut"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.TotalsByOrganizer"
+                            }
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/groups/statistics/purchase-price": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Items Attachments"
+                    "Statistics"
                 ],
-                "summary": "Delete Item Attachment",
+                "summary": "Get Purchase Price Statistics",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "start date",
+                        "name": "start",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Attachment ID",
-                        "name": "attachment_id",
-                        "in": "path",
-                        "required": true
+                        "description": "end date",
+                        "name": "end",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.ValueOverTime"
+                        }
                     }
                 }
             }
         },
-        "/v1/items/{id}/maintenance": {
+        "/v1/items": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "The filter parameter accepts a list of terms that must all match, for example\n` + "`" + `price\u003e100 label:electronics warranty\u003c2027-01-01 field:color=red -archived` + "`" + `.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Maintenance"
+                    "Items"
+                ],
+                "summary": "Query All Items",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "search string",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter expression",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "label Ids",
+                        "name": "labels",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "location Ids",
+                        "name": "locations",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "parent Ids",
+                        "name": "parentIds",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get Maintenance Log",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.MaintenanceLog"
+                            "$ref": "#/definitions/repo.PaginationResult-repo_ItemSummary"
                         }
                     }
                 }
@@ -939,17 +1042,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Maintenance"
+                    "Items"
                 ],
-                "summary": "Create Maintenance Entry",
+                "summary": "Create Item",
                 "parameters": [
                     {
-                        "description": "Entry Data",
+                        "description": "Item Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.MaintenanceEntryCreate"
+                            "$ref": "#/definitions/repo.ItemCreate"
                         }
                     }
                 ],
@@ -957,67 +1060,34 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/repo.MaintenanceEntry"
+                            "$ref": "#/definitions/repo.ItemSummary"
                         }
                     }
                 }
             }
         },
-        "/v1/items/{id}/maintenance/{entry_id}": {
-            "put": {
+        "/v1/items/export": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Maintenance"
-                ],
-                "summary": "Update Maintenance Entry",
-                "parameters": [
-                    {
-                        "description": "Entry Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/repo.MaintenanceEntryUpdate"
-                        }
-                    }
+                    "Items"
                 ],
+                "summary": "Export Items",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "text/csv",
                         "schema": {
-                            "$ref": "#/definitions/repo.MaintenanceEntry"
+                            "type": "string"
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Maintenance"
-                ],
-                "summary": "Delete Maintenance Entry",
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    }
-                }
             }
         },
-        "/v1/items/{id}/path": {
+        "/v1/items/fields": {
             "get": {
                 "security": [
                     {
@@ -1030,30 +1100,21 @@ const docTemplate = `{
                 "tags": [
                     "Items"
                 ],
-                "summary": "Get the full path of an item",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "Get All Custom Field Names",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/repo.ItemPath"
+                                "type": "string"
                             }
                         }
                     }
                 }
             }
         },
-        "/v1/labels": {
+        "/v1/items/fields/values": {
             "get": {
                 "security": [
                     {
@@ -1064,21 +1125,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Labels"
+                    "Items"
                 ],
-                "summary": "Get All Labels",
+                "summary": "Get All Custom Field Values",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/repo.LabelOut"
+                                "type": "string"
                             }
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/items/import": {
             "post": {
                 "security": [
                     {
@@ -1089,31 +1152,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Labels"
+                    "Items"
                 ],
-                "summary": "Create Label",
+                "summary": "Import Items",
                 "parameters": [
                     {
-                        "description": "Label Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/repo.LabelCreate"
-                        }
+                        "type": "file",
+                        "description": "Image to upload",
+                        "name": "csv",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/repo.LabelSummary"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/v1/labels/{id}": {
+        "/v1/items/{id}": {
             "get": {
                 "security": [
                     {
@@ -1124,13 +1182,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Labels"
+                    "Items"
                 ],
-                "summary": "Get Label",
+                "summary": "Get Item",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Label ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1140,7 +1198,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.LabelOut"
+                            "$ref": "#/definitions/repo.ItemOut"
                         }
                     }
                 }
@@ -1155,23 +1213,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Labels"
+                    "Items"
                 ],
-                "summary": "Update Label",
+                "summary": "Update Item",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Label ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/repo.LabelOut"
+                    },
+                    {
+                        "description": "Item Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.ItemUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.ItemOut"
                         }
                     }
                 }
@@ -1182,17 +1249,18 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
+                "description": "Moves the item to the trash, it can be restored until the trash is purged.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Labels"
+                    "Items"
                 ],
-                "summary": "Delete Label",
+                "summary": "Delete Item",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Label ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1203,43 +1271,8 @@ const docTemplate = `{
                         "description": "No Content"
                     }
                 }
-            }
-        },
-        "/v1/locations": {
-            "get": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Locations"
-                ],
-                "summary": "Get All Locations",
-                "parameters": [
-                    {
-                        "type": "boolean",
-                        "description": "Filter locations with parents",
-                        "name": "filterChildren",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.LocationOutCount"
-                            }
-                        }
-                    }
-                }
             },
-            "post": {
+            "patch": {
                 "security": [
                     {
                         "Bearer": []
@@ -1249,17 +1282,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Locations"
+                    "Items"
                 ],
-                "summary": "Create Location",
+                "summary": "Update Item",
                 "parameters": [
                     {
-                        "description": "Location Data",
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Item Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.LocationCreate"
+                            "$ref": "#/definitions/repo.ItemPatch"
                         }
                     }
                 ],
@@ -1267,14 +1307,14 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.LocationSummary"
+                            "$ref": "#/definitions/repo.ItemOut"
                         }
                     }
                 }
             }
         },
-        "/v1/locations/tree": {
-            "get": {
+        "/v1/items/{id}/attachments": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
@@ -1284,31 +1324,56 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Locations"
+                    "Items Attachments"
                 ],
-                "summary": "Get Locations Tree",
+                "summary": "Create Item Attachment",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "include items in response tree",
-                        "name": "withItems",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "File attachment",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Type of file",
+                        "name": "type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "name of the file including extension",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.TreeItem"
-                            }
+                            "$ref": "#/definitions/repo.ItemOut"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/validate.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/locations/{id}": {
+        "/v1/items/{id}/attachments/{attachment_id}": {
             "get": {
                 "security": [
                     {
@@ -1316,26 +1381,33 @@ const docTemplate = `{
                     }
                 ],
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "Locations"
+                    "Items Attachments"
                 ],
-                "summary": "Get Location",
+                "summary": "Get Item Attachment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Location ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "attachment_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.LocationOut"
+                            "$ref": "#/definitions/v1.ItemAttachmentToken"
                         }
                     }
                 }
@@ -1346,28 +1418,32 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Locations"
+                    "Items Attachments"
                 ],
-                "summary": "Update Location",
+                "summary": "Update Item Attachment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Location ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Location Data",
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "attachment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Attachment Update",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.LocationUpdate"
+                            "$ref": "#/definitions/repo.ItemAttachmentUpdate"
                         }
                     }
                 ],
@@ -1375,7 +1451,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.LocationOut"
+                            "$ref": "#/definitions/repo.ItemOut"
                         }
                     }
                 }
@@ -1386,30 +1462,80 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
+                "tags": [
+                    "Items Attachments"
+                ],
+                "summary": "Delete Item Attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "attachment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/items/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Returns the changes made to the item and its attachments, newest first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Locations"
+                    "Items"
                 ],
-                "summary": "Delete Location",
+                "summary": "Get Item History",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Location ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "entries per page",
+                        "name": "pageSize",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PaginationResult-repo_AuditEntryOut"
+                        }
                     }
                 }
             }
         },
-        "/v1/notifiers": {
+        "/v1/items/{id}/maintenance": {
             "get": {
                 "security": [
                     {
@@ -1420,17 +1546,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifiers"
+                    "Maintenance"
                 ],
-                "summary": "Get Notifiers",
+                "summary": "Get Maintenance Log",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.NotifierOut"
-                            }
+                            "$ref": "#/definitions/repo.MaintenanceLog"
                         }
                     }
                 }
@@ -1445,32 +1568,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifiers"
+                    "Maintenance"
                 ],
-                "summary": "Create Notifier",
+                "summary": "Create Maintenance Entry",
                 "parameters": [
                     {
-                        "description": "Notifier Data",
+                        "description": "Entry Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.NotifierCreate"
+                            "$ref": "#/definitions/repo.MaintenanceEntryCreate"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/repo.NotifierOut"
+                            "$ref": "#/definitions/repo.MaintenanceEntry"
                         }
                     }
                 }
             }
         },
-        "/v1/notifiers/test": {
-            "post": {
+        "/v1/items/{id}/maintenance/schedules": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
@@ -1480,58 +1603,108 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifiers"
+                    "Maintenance"
                 ],
-                "summary": "Test Notifier",
+                "summary": "Get Maintenance Schedules",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Notifier ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.MaintenanceSchedule"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Creates a recurring schedule and its first scheduled entry. The rule is either an\nRRULE such as ` + "`" + `FREQ=MONTHLY;INTERVAL=3` + "`" + ` or plain text such as ` + "`" + `every 90 days` + "`" + `, the next\nentry is created when the previous one is completed and is counted from the completion date.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Maintenance"
+                ],
+                "summary": "Create Maintenance Schedule",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "URL",
-                        "name": "url",
-                        "in": "query",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Schedule Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.MaintenanceScheduleCreate"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.MaintenanceSchedule"
+                        }
                     }
                 }
             }
         },
-        "/v1/notifiers/{id}": {
+        "/v1/items/{id}/maintenance/schedules/{schedule_id}": {
             "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Notifiers"
+                    "Maintenance"
                 ],
-                "summary": "Update Notifier",
+                "summary": "Update Maintenance Schedule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Notifier ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Notifier Data",
+                        "type": "string",
+                        "description": "Schedule ID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Schedule Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.NotifierUpdate"
+                            "$ref": "#/definitions/repo.MaintenanceScheduleUpdate"
                         }
                     }
                 ],
@@ -1539,7 +1712,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.NotifierOut"
+                            "$ref": "#/definitions/repo.MaintenanceSchedule"
                         }
                     }
                 }
@@ -1550,17 +1723,27 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Notifiers"
+                    "Maintenance"
                 ],
-                "summary": "Delete a Notifier",
+                "summary": "Delete Maintenance Schedule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Notifier ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Schedule ID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1570,8 +1753,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/qrcode": {
-            "get": {
+        "/v1/items/{id}/maintenance/{entry_id}": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
@@ -1581,29 +1764,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Maintenance"
                 ],
-                "summary": "Create QR Code",
+                "summary": "Update Maintenance Entry",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "data to be encoded into qrcode",
-                        "name": "data",
-                        "in": "query"
+                        "description": "Entry Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.MaintenanceEntryUpdate"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "image/jpeg",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/repo.MaintenanceEntry"
                         }
                     }
                 }
-            }
-        },
-        "/v1/reporting/bill-of-materials": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
@@ -1613,186 +1797,147 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Reporting"
+                    "Maintenance"
                 ],
-                "summary": "Export Bill of Materials",
+                "summary": "Delete Maintenance Entry",
                 "responses": {
-                    "200": {
-                        "description": "text/csv",
-                        "schema": {
-                            "type": "string"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/v1/status": {
+        "/v1/items/{id}/path": {
             "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Base"
+                    "Items"
+                ],
+                "summary": "Get the full path of an item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Application Info",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.APISummary"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.ItemPath"
+                            }
                         }
                     }
                 }
             }
         },
-        "/v1/users/change-password": {
-            "put": {
+        "/v1/items/{id}/restore": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "tags": [
-                    "User"
-                ],
-                "summary": "Change Password",
-                "parameters": [
-                    {
-                        "description": "Password Payload",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.ChangePassword"
-                        }
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    }
-                }
-            }
-        },
-        "/v1/users/login": {
-            "post": {
-                "consumes": [
-                    "application/x-www-form-urlencoded",
-                    "application/json"
-                ],
+                "description": "Moves the item out of the trash. Fails with 409 if the item's location is in the trash.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Items"
                 ],
-                "summary": "User Login",
+                "summary": "Restore Item",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "admin@admin.com",
-                        "description": "string",
-                        "name": "username",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "example": "admin",
-                        "description": "string",
-                        "name": "password",
-                        "in": "formData"
-                    },
-                    {
-                        "description": "Login Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.LoginForm"
-                        }
-                    },
-                    {
-                        "type": "string",
-                        "description": "auth provider",
-                        "name": "provider",
-                        "in": "query"
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.TokenResponse"
+                            "$ref": "#/definitions/repo.ItemOut"
                         }
                     }
                 }
             }
         },
-        "/v1/users/logout": {
-            "post": {
+        "/v1/labels": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Authentication"
+                    "Labels"
                 ],
-                "summary": "User Logout",
+                "summary": "Get All Labels",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.LabelOut"
+                            }
+                        }
                     }
                 }
-            }
-        },
-        "/v1/users/refresh": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "handleAuthRefresh returns a handler that will issue a new token from an existing token.\nThis does not validate that the user still exists within the database.",
-                "tags": [
-                    "Authentication"
-                ],
-                "summary": "User Token Refresh",
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    }
-                }
-            }
-        },
-        "/v1/users/register": {
-            "post": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Labels"
                 ],
-                "summary": "Register New User",
+                "summary": "Create Label",
                 "parameters": [
                     {
-                        "description": "User Data",
+                        "description": "Label Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/services.UserRegistration"
+                            "$ref": "#/definitions/repo.LabelCreate"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    }
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LabelSummary"
+                        }
+                    }
                 }
             }
         },
-        "/v1/users/self": {
+        "/v1/labels/{id}": {
             "get": {
                 "security": [
                     {
@@ -1803,26 +1948,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Labels"
+                ],
+                "summary": "Get Label",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Label ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get User Self",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/v1.Wrapped"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "item": {
-                                            "$ref": "#/definitions/repo.UserOut"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/repo.LabelOut"
                         }
                     }
                 }
@@ -1837,37 +1979,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Labels"
                 ],
-                "summary": "Update Account",
+                "summary": "Update Label",
                 "parameters": [
                     {
-                        "description": "User Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/repo.UserUpdate"
-                        }
+                        "type": "string",
+                        "description": "Label ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/v1.Wrapped"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "item": {
-                                            "$ref": "#/definitions/repo.UserUpdate"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/repo.LabelOut"
                         }
                     }
                 }
@@ -1882,119 +2010,3519 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Labels"
+                ],
+                "summary": "Delete Label",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Label ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Delete Account",
                 "responses": {
                     "204": {
                         "description": "No Content"
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "currencies.Currency": {
-            "type": "object",
-            "properties": {
-                "code": {
-                    "type": "string"
-                },
-                "local": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "symbol": {
-                    "type": "string"
-                }
-            }
         },
-        "repo.DocumentOut": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "string"
-                },
-                "path": {
-                    "type": "string"
-                },
-                "title": {
-                    "type": "string"
+        "/v1/locations": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Get All Locations",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Filter locations with parents",
+                        "name": "filterChildren",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.LocationOutCount"
+                            }
+                        }
+                    }
                 }
-            }
-        },
-        "repo.Group": {
-            "type": "object",
-            "properties": {
-                "createdAt": {
-                    "type": "string"
-                },
-                "currency": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "updatedAt": {
-                    "type": "string"
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Create Location",
+                "parameters": [
+                    {
+                        "description": "Location Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.LocationCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LocationSummary"
+                        }
+                    }
                 }
             }
         },
-        "repo.GroupStatistics": {
-            "type": "object",
-            "properties": {
-                "totalItemPrice": {
-                    "type": "number"
-                },
-                "totalItems": {
-                    "type": "integer"
-                },
-                "totalLabels": {
-                    "type": "integer"
-                },
-                "totalLocations": {
-                    "type": "integer"
-                },
-                "totalUsers": {
-                    "type": "integer"
-                },
-                "totalWithWarranty": {
-                    "type": "integer"
+        "/v1/locations/tree": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Get Locations Tree",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "include items in response tree",
+                        "name": "withItems",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.TreeItem"
+                            }
+                        }
+                    }
                 }
             }
         },
-        "repo.GroupUpdate": {
-            "type": "object",
-            "properties": {
-                "currency": {
-                    "type": "string"
+        "/v1/locations/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Get Location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LocationOut"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Update Location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Location Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.LocationUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LocationOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Moves the location, its child locations and their items to the trash.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Delete Location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/locations/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Moves the location out of the trash along with the child locations and items deleted with it.\nFails with 409 if the parent location is in the trash.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Restore Location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.LocationOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/notifications/reminders": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Get Sent Reminders",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "reminders per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PaginationResult-repo_NotificationReminderOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/notifications/rules": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Get Notification Rules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.NotificationRuleOut"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "A rule sends a reminder before a date of the items in the group, the warranty expiry,\nthe due date of scheduled maintenance or a custom field holding a date. Each lead time is\nsent once per date.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Create Notification Rule",
+                "parameters": [
+                    {
+                        "description": "Rule Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotificationRuleCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotificationRuleOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/notifications/rules/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Get Notification Rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotificationRuleOut"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Update Notification Rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rule Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotificationRuleUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotificationRuleOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Delete Notification Rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/notifications/templates": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Get Notification Templates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.NotificationTemplateOut"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Templates use the Go text/template syntax. A template with a notifier replaces the\ntemplate of the group for that notifier, the built-in template is used otherwise.\nFails with 409 if the type already has a template for the notifier. Requires the owner role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Create Notification Template",
+                "parameters": [
+                    {
+                        "description": "Template Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotificationTemplateCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotificationTemplateOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/notifications/templates/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Update Notification Template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Template Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotificationTemplateUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotificationTemplateOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Delete Notification Template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/notifiers": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifiers"
+                ],
+                "summary": "Get Notifiers",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.NotifierOut"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifiers"
+                ],
+                "summary": "Create Notifier",
+                "parameters": [
+                    {
+                        "description": "Notifier Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotifierCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotifierOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/notifiers/preview": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Renders a notification type with sample data. Without a body the template used\nfor the notifier is rendered, or the template of the group when no notifier is given.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifiers"
+                ],
+                "summary": "Preview Notification",
+                "parameters": [
+                    {
+                        "description": "Preview Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.NotifierPreviewBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.NotifierPreview"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/notifiers/test": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Sends a test message to the URL. When a type is given the message is a preview\nof that notification type, see the preview endpoint.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifiers"
+                ],
+                "summary": "Test Notifier",
+                "parameters": [
+                    {
+                        "description": "Test Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.NotifierTestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/notifiers/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "Notifiers"
+                ],
+                "summary": "Update Notifier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notifier ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Notifier Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotifierUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotifierOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "Notifiers"
+                ],
+                "summary": "Delete a Notifier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notifier ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/notifiers/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lists every attempt to send a notification to the notifier, latest first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifiers"
+                ],
+                "summary": "Get Notifier Deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notifier ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PaginationResult-repo_NotifierDeliveryOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/qrcode": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Items"
+                ],
+                "summary": "Create QR Code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "data to be encoded into qrcode",
+                        "name": "data",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "image/jpeg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reporting/bill-of-materials": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reporting"
+                ],
+                "summary": "Export Bill of Materials",
+                "responses": {
+                    "200": {
+                        "description": "text/csv",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/saved-searches": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Get All Saved Searches",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "include the number of matching items",
+                        "name": "withCount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.SavedSearchOut"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Create Saved Search",
+                "parameters": [
+                    {
+                        "description": "Saved Search Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.SavedSearchCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.SavedSearchOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/saved-searches/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Get Saved Search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved Search ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.SavedSearchOut"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Update Saved Search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved Search ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Saved Search Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.SavedSearchUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.SavedSearchOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Delete Saved Search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved Search ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/saved-searches/{id}/items": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Runs the saved query and returns the matching items in the saved sort order.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Evaluate Saved Search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved Search ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PaginationResult-repo_ItemSummary"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/search": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Full-text search over the items of the group including their custom fields, labels, location\nand maintenance entries. Results are ranked by relevance, the highlights are escaped as HTML\nand matched words are wrapped in \u003cmark\u003e tags.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Search Items",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "results per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PaginationResult-repo_SearchResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/status": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Base"
+                ],
+                "summary": "Application Info",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.APISummary"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/trash": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lists the deleted items and locations of the group that have not been purged yet.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trash"
+                ],
+                "summary": "Get Trash",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Trash"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/change-password": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Change Password",
+                "parameters": [
+                    {
+                        "description": "Password Payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChangePassword"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/forgot-password": {
+            "post": {
+                "tags": [
+                    "User"
+                ],
+                "summary": "Request Password Reset",
+                "parameters": [
+                    {
+                        "description": "Email Address",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.EmailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/login": {
+            "post": {
+                "consumes": [
+                    "application/x-www-form-urlencoded",
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "User Login",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "admin@admin.com",
+                        "description": "string",
+                        "name": "username",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "example": "admin",
+                        "description": "string",
+                        "name": "password",
+                        "in": "formData"
+                    },
+                    {
+                        "description": "Login Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.LoginForm"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "auth provider",
+                        "name": "provider",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TokenResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "too many failed logins",
+                        "schema": {
+                            "$ref": "#/definitions/validate.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/login/2fa": {
+            "post": {
+                "description": "Completes the login of a user with two-factor authentication using the token returned\nby the login and a TOTP or recovery code.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "User Login Two-Factor",
+                "parameters": [
+                    {
+                        "description": "Two-Factor Login Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.TwoFactorLoginForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TokenResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/login/{provider}": {
+            "get": {
+                "description": "Redirects the user to the identity provider of an external auth provider.",
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "External Provider Login",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "oidc",
+                        "description": "auth provider",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "group invitation token",
+                        "name": "token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "extended session",
+                        "name": "stayLoggedIn",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    }
+                }
+            }
+        },
+        "/v1/users/login/{provider}/begin": {
+            "post": {
+                "description": "Starts a passkey login and returns the options for navigator.credentials.get(). The\nsigned response is sent to /v1/users/login with the \"webauthn\" provider.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Passkey Login Challenge",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "webauthn",
+                        "description": "auth provider",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "extended session",
+                        "name": "stayLoggedIn",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/protocol.CredentialAssertion"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/login/{provider}/callback": {
+            "get": {
+                "description": "Completes the login of an external auth provider, sets the session cookies\nand redirects the user to the application. Users with two-factor authentication\nenabled are redirected without a session, the token to complete the login with\n/v1/users/login/2fa is passed in the \"twoFactorToken\" parameter of the URL fragment.",
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "External Provider Callback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "oidc",
+                        "description": "auth provider",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "authorization code",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "state",
+                        "name": "state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    }
+                }
+            }
+        },
+        "/v1/users/logout": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "User Logout",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/refresh": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "handleAuthRefresh returns a handler that will issue a new token from an existing token.\nThis does not validate that the user still exists within the database.",
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "User Token Refresh",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/v1/users/register": {
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Register New User",
+                "parameters": [
+                    {
+                        "description": "User Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/services.UserRegistration"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/reset-password": {
+            "post": {
+                "tags": [
+                    "User"
+                ],
+                "summary": "Reset Password",
+                "parameters": [
+                    {
+                        "description": "Reset Token and new Password",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.PasswordReset"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/self": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get User Self",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/v1.Wrapped"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "item": {
+                                            "$ref": "#/definitions/repo.UserOut"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Update Account",
+                "parameters": [
+                    {
+                        "description": "User Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.UserUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/v1.Wrapped"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "item": {
+                                            "$ref": "#/definitions/repo.UserUpdate"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Delete Account",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/self/2fa/disable": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Disable Two-Factor Authentication",
+                "parameters": [
+                    {
+                        "description": "Password and Code",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.TwoFactorConfirm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/self/2fa/enable": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Confirms the setup with a code of the new secret and returns the recovery codes,\nthey are only shown once.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Enable Two-Factor Authentication",
+                "parameters": [
+                    {
+                        "description": "TOTP Code",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.TwoFactorEnable"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/services.TwoFactorRecoveryCodes"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/self/2fa/recovery-codes": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Replaces the recovery codes of the user, the previous codes can no longer be used.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Regenerate Recovery Codes",
+                "parameters": [
+                    {
+                        "description": "Password and Code",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.TwoFactorConfirm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/services.TwoFactorRecoveryCodes"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/self/2fa/setup": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Generates a new TOTP secret for the user, two-factor authentication is enabled once\na code of the secret is confirmed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Start Two-Factor Setup",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/services.TwoFactorSetup"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/self/2fa/setup/qrcode": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "image/png"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get Two-Factor Setup QR Code",
+                "responses": {
+                    "200": {
+                        "description": "image/png",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/self/api-keys": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get API Keys",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.APIKeyOut"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Creates a long-lived API key limited to a single scope. The token is only\nreturned once and cannot be retrieved again.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Create API Key",
+                "parameters": [
+                    {
+                        "description": "API Key Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.APIKeyCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/services.APIKeyDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/self/api-keys/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Revoke API Key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/self/groups": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lists the groups the user is a member of with their role, the group the session\nis acting in is marked as active.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get User Groups",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.GroupMembershipOut"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Adds the user to the group of an invitation as an editor.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Join Group",
+                "parameters": [
+                    {
+                        "description": "Invitation",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupJoin"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.GroupMembershipOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/self/groups/{id}/switch": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Changes the group the session is acting in, the user stays logged in.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Switch Group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/v1.Wrapped"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "item": {
+                                            "$ref": "#/definitions/repo.UserOut"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/self/passkeys": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get Passkeys",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.WebAuthnCredentialOut"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Verifies the response of navigator.credentials.create() and stores the passkey.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Finish Passkey Registration",
+                "parameters": [
+                    {
+                        "description": "Passkey Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.PasskeyRegistration"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.WebAuthnCredentialOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/self/passkeys/register": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Returns the options for navigator.credentials.create() to register a new passkey.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Start Passkey Registration",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/protocol.CredentialCreation"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/self/passkeys/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Rename Passkey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Passkey ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Passkey Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.WebAuthnCredentialUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.WebAuthnCredentialOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Deletes a passkey of the user, it can no longer be used to login.",
+                "tags": [
+                    "User"
+                ],
+                "summary": "Revoke Passkey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Passkey ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/self/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lists the active sessions of the user with the address and user agent of their\nlast request.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get Sessions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.UserSessionOut"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Logs the user out of all sessions except the current one, API keys are kept.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Revoke Other Sessions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SessionsRevoked"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/self/sessions/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Revoke Session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/verify-email": {
+            "post": {
+                "tags": [
+                    "User"
+                ],
+                "summary": "Verify Email Address",
+                "parameters": [
+                    {
+                        "description": "Verification Token",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.EmailVerification"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/verify-email/resend": {
+            "post": {
+                "tags": [
+                    "User"
+                ],
+                "summary": "Resend Verification Email",
+                "parameters": [
+                    {
+                        "description": "Email Address",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.EmailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "currencies.Currency": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "local": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "symbol": {
+                    "type": "string"
+                }
+            }
+        },
+        "protocol.AuthenticationExtensions": {
+            "type": "object",
+            "additionalProperties": true
+        },
+        "protocol.AuthenticatorAttachment": {
+            "type": "string",
+            "enum": [
+                "platform",
+                "cross-platform"
+            ],
+            "x-enum-varnames": [
+                "Platform",
+                "CrossPlatform"
+            ]
+        },
+        "protocol.AuthenticatorSelection": {
+            "type": "object",
+            "properties": {
+                "authenticatorAttachment": {
+                    "description": "AuthenticatorAttachment If this member is present, eligible authenticators are filtered to only\nauthenticators attached with the specified AuthenticatorAttachment enum.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/protocol.AuthenticatorAttachment"
+                        }
+                    ]
+                },
+                "requireResidentKey": {
+                    "description": "RequireResidentKey this member describes the Relying Party's requirements regarding resident\ncredentials. If the parameter is set to true, the authenticator MUST create a client-side-resident\npublic key credential source when creating a public key credential.",
+                    "type": "boolean"
+                },
+                "residentKey": {
+                    "description": "ResidentKey this member describes the Relying Party's requirements regarding resident\ncredentials per Webauthn Level 2.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/protocol.ResidentKeyRequirement"
+                        }
+                    ]
+                },
+                "userVerification": {
+                    "description": "UserVerification This member describes the Relying Party's requirements regarding user verification for\nthe create() operation. Eligible authenticators are filtered to only those capable of satisfying this\nrequirement.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/protocol.UserVerificationRequirement"
+                        }
+                    ]
+                }
+            }
+        },
+        "protocol.AuthenticatorTransport": {
+            "type": "string",
+            "enum": [
+                "usb",
+                "nfc",
+                "ble",
+                "hybrid",
+                "internal"
+            ],
+            "x-enum-varnames": [
+                "USB",
+                "NFC",
+                "BLE",
+                "Hybrid",
+                "Internal"
+            ]
+        },
+        "protocol.ConveyancePreference": {
+            "type": "string",
+            "enum": [
+                "none",
+                "indirect",
+                "direct",
+                "enterprise"
+            ],
+            "x-enum-varnames": [
+                "PreferNoAttestation",
+                "PreferIndirectAttestation",
+                "PreferDirectAttestation",
+                "PreferEnterpriseAttestation"
+            ]
+        },
+        "protocol.CredentialAssertion": {
+            "type": "object",
+            "properties": {
+                "publicKey": {
+                    "$ref": "#/definitions/protocol.PublicKeyCredentialRequestOptions"
+                }
+            }
+        },
+        "protocol.CredentialCreation": {
+            "type": "object",
+            "properties": {
+                "publicKey": {
+                    "$ref": "#/definitions/protocol.PublicKeyCredentialCreationOptions"
+                }
+            }
+        },
+        "protocol.CredentialDescriptor": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "CredentialID The ID of a credential to allow/disallow.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "transports": {
+                    "description": "The authenticator transports that can be used.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/protocol.AuthenticatorTransport"
+                    }
+                },
+                "type": {
+                    "description": "The valid credential types.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/protocol.CredentialType"
+                        }
+                    ]
+                }
+            }
+        },
+        "protocol.CredentialParameter": {
+            "type": "object",
+            "properties": {
+                "alg": {
+                    "$ref": "#/definitions/webauthncose.COSEAlgorithmIdentifier"
+                },
+                "type": {
+                    "$ref": "#/definitions/protocol.CredentialType"
+                }
+            }
+        },
+        "protocol.CredentialType": {
+            "type": "string",
+            "enum": [
+                "public-key"
+            ],
+            "x-enum-varnames": [
+                "PublicKeyCredentialType"
+            ]
+        },
+        "protocol.PublicKeyCredentialCreationOptions": {
+            "type": "object",
+            "properties": {
+                "attestation": {
+                    "$ref": "#/definitions/protocol.ConveyancePreference"
+                },
+                "authenticatorSelection": {
+                    "$ref": "#/definitions/protocol.AuthenticatorSelection"
+                },
+                "challenge": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "excludeCredentials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/protocol.CredentialDescriptor"
+                    }
+                },
+                "extensions": {
+                    "$ref": "#/definitions/protocol.AuthenticationExtensions"
+                },
+                "pubKeyCredParams": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/protocol.CredentialParameter"
+                    }
+                },
+                "rp": {
+                    "$ref": "#/definitions/protocol.RelyingPartyEntity"
+                },
+                "timeout": {
+                    "type": "integer"
+                },
+                "user": {
+                    "$ref": "#/definitions/protocol.UserEntity"
+                }
+            }
+        },
+        "protocol.PublicKeyCredentialRequestOptions": {
+            "type": "object",
+            "properties": {
+                "allowCredentials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/protocol.CredentialDescriptor"
+                    }
+                },
+                "challenge": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "extensions": {
+                    "$ref": "#/definitions/protocol.AuthenticationExtensions"
+                },
+                "rpId": {
+                    "type": "string"
+                },
+                "timeout": {
+                    "type": "integer"
+                },
+                "userVerification": {
+                    "$ref": "#/definitions/protocol.UserVerificationRequirement"
+                }
+            }
+        },
+        "protocol.RelyingPartyEntity": {
+            "type": "object",
+            "properties": {
+                "icon": {
+                    "description": "A serialized URL which resolves to an image associated with the entity. For example,\nthis could be a user’s avatar or a Relying Party's logo. This URL MUST be an a priori\nauthenticated URL. Authenticators MUST accept and store a 128-byte minimum length for\nan icon member’s value. Authenticators MAY ignore an icon member’s value if its length\nis greater than 128 bytes. The URL’s scheme MAY be \"data\" to avoid fetches of the URL,\nat the cost of needing more storage.\n\nDeprecated: this has been removed from the specification recommendations.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "A unique identifier for the Relying Party entity, which sets the RP ID.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "A human-palatable name for the entity. Its function depends on what the PublicKeyCredentialEntity represents:\n\nWhen inherited by PublicKeyCredentialRpEntity it is a human-palatable identifier for the Relying Party,\nintended only for display. For example, \"ACME Corporation\", \"Wonderful Widgets, Inc.\" or \"ОАО Примертех\".\n\nWhen inherited by PublicKeyCredentialUserEntity, it is a human-palatable identifier for a user account. It is\nintended only for display, i.e., aiding the user in determining the difference between user accounts with similar\ndisplayNames. For example, \"alexm\", \"alex.p.mueller@example.com\" or \"+14255551234\".",
+                    "type": "string"
+                }
+            }
+        },
+        "protocol.ResidentKeyRequirement": {
+            "type": "string",
+            "enum": [
+                "discouraged",
+                "preferred",
+                "required"
+            ],
+            "x-enum-varnames": [
+                "ResidentKeyRequirementDiscouraged",
+                "ResidentKeyRequirementPreferred",
+                "ResidentKeyRequirementRequired"
+            ]
+        },
+        "protocol.UserEntity": {
+            "type": "object",
+            "properties": {
+                "displayName": {
+                    "description": "A human-palatable name for the user account, intended only for display.\nFor example, \"Alex P. Müller\" or \"田中 倫\". The Relying Party SHOULD let\nthe user choose this, and SHOULD NOT restrict the choice more than necessary.",
+                    "type": "string"
+                },
+                "icon": {
+                    "description": "A serialized URL which resolves to an image associated with the entity. For example,\nthis could be a user’s avatar or a Relying Party's logo. This URL MUST be an a priori\nauthenticated URL. Authenticators MUST accept and store a 128-byte minimum length for\nan icon member’s value. Authenticators MAY ignore an icon member’s value if its length\nis greater than 128 bytes. The URL’s scheme MAY be \"data\" to avoid fetches of the URL,\nat the cost of needing more storage.\n\nDeprecated: this has been removed from the specification recommendations.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID is the user handle of the user account entity. To ensure secure operation,\nauthentication and authorization decisions MUST be made on the basis of this id\nmember, not the displayName nor name members. See Section 6.1 of\n[RFC8266](https://www.w3.org/TR/webauthn/#biblio-rfc8266)."
+                },
+                "name": {
+                    "description": "A human-palatable name for the entity. Its function depends on what the PublicKeyCredentialEntity represents:\n\nWhen inherited by PublicKeyCredentialRpEntity it is a human-palatable identifier for the Relying Party,\nintended only for display. For example, \"ACME Corporation\", \"Wonderful Widgets, Inc.\" or \"ОАО Примертех\".\n\nWhen inherited by PublicKeyCredentialUserEntity, it is a human-palatable identifier for a user account. It is\nintended only for display, i.e., aiding the user in determining the difference between user accounts with similar\ndisplayNames. For example, \"alexm\", \"alex.p.mueller@example.com\" or \"+14255551234\".",
+                    "type": "string"
+                }
+            }
+        },
+        "protocol.UserVerificationRequirement": {
+            "type": "string",
+            "enum": [
+                "required",
+                "preferred",
+                "discouraged"
+            ],
+            "x-enum-comments": {
+                "VerificationPreferred": "This is the default"
+            },
+            "x-enum-varnames": [
+                "VerificationRequired",
+                "VerificationPreferred",
+                "VerificationDiscouraged"
+            ]
+        },
+        "repo.APIKeyCreate": {
+            "type": "object",
+            "required": [
+                "name",
+                "scope"
+            ],
+            "properties": {
+                "expiresAt": {
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "scope": {
+                    "type": "string",
+                    "enum": [
+                        "items_read",
+                        "items_write",
+                        "attachments"
+                    ]
+                }
+            }
+        },
+        "repo.APIKeyOut": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scope": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.AuditEntryOut": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.FieldChange"
+                    }
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "entityId": {
+                    "type": "string"
+                },
+                "entityName": {
+                    "type": "string"
+                },
+                "entityType": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "itemId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "user": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.AuditUser"
+                        }
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
+                }
+            }
+        },
+        "repo.AuditUser": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.DocumentOut": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.Group": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "digest": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notifyTime": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.GroupInvitation": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "group": {
+                    "$ref": "#/definitions/repo.Group"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "uses": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repo.GroupMembershipOut": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "Active is set for the group the session making the request is acting in.",
+                    "type": "boolean"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.GroupRestore": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "$ref": "#/definitions/repo.Group"
+                },
+                "missingMembers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "repo.GroupStatistics": {
+            "type": "object",
+            "properties": {
+                "totalItemPrice": {
+                    "type": "number"
+                },
+                "totalItems": {
+                    "type": "integer"
+                },
+                "totalLabels": {
+                    "type": "integer"
+                },
+                "totalLocations": {
+                    "type": "integer"
+                },
+                "totalUsers": {
+                    "type": "integer"
+                },
+                "totalWithWarranty": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repo.GroupUpdate": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "digest": {
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "daily",
+                        "weekly"
+                    ],
+                    "x-nullable": true
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notifyTime": {
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "timezone": {
+                    "description": "Timezone, NotifyTime and Digest are left unchanged when omitted.",
+                    "type": "string",
+                    "x-nullable": true
+                }
+            }
+        },
+        "repo.GroupUsage": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "totalAttachments": {
+                    "type": "integer"
+                },
+                "totalItems": {
+                    "type": "integer"
+                },
+                "totalLabels": {
+                    "type": "integer"
+                },
+                "totalLocations": {
+                    "type": "integer"
+                },
+                "totalUsers": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repo.ItemAttachment": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "document": {
+                    "$ref": "#/definitions/repo.DocumentOut"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "primary": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.ItemAttachmentUpdate": {
+            "type": "object",
+            "properties": {
+                "primary": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.ItemCreate": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "labelIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "locationId": {
+                    "description": "Edges",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "parentId": {
+                    "type": "string",
+                    "x-nullable": true
+                }
+            }
+        },
+        "repo.ItemField": {
+            "type": "object",
+            "properties": {
+                "booleanValue": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "numberValue": {
+                    "type": "integer"
+                },
+                "textValue": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.ItemOut": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "type": "boolean"
+                },
+                "assetId": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.ItemAttachment"
+                    }
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.ItemField"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "imageId": {
+                    "type": "string"
+                },
+                "insured": {
+                    "type": "boolean"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.LabelSummary"
+                    }
+                },
+                "lifetimeWarranty": {
+                    "description": "Warranty",
+                    "type": "boolean"
+                },
+                "location": {
+                    "description": "Edges",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.LocationSummary"
+                        }
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "manufacturer": {
+                    "type": "string"
+                },
+                "modelNumber": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "Extras",
+                    "type": "string"
+                },
+                "parent": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.ItemSummary"
+                        }
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "purchaseFrom": {
+                    "type": "string"
+                },
+                "purchasePrice": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "purchaseTime": {
+                    "description": "Purchase",
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "serialNumber": {
+                    "type": "string"
+                },
+                "soldNotes": {
+                    "type": "string"
+                },
+                "soldPrice": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "soldTime": {
+                    "description": "Sold",
+                    "type": "string"
+                },
+                "soldTo": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "warrantyDetails": {
+                    "type": "string"
+                },
+                "warrantyExpires": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.ItemPatch": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                }
+            }
+        },
+        "repo.ItemPath": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/repo.ItemType"
+                }
+            }
+        },
+        "repo.ItemSummary": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "type": "boolean"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "imageId": {
+                    "type": "string"
+                },
+                "insured": {
+                    "type": "boolean"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.LabelSummary"
+                    }
+                },
+                "location": {
+                    "description": "Edges",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.LocationSummary"
+                        }
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "name": {
+                    "type": "string"
+                },
+                "purchasePrice": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.ItemType": {
+            "type": "string",
+            "enum": [
+                "location",
+                "item"
+            ],
+            "x-enum-varnames": [
+                "ItemTypeLocation",
+                "ItemTypeItem"
+            ]
+        },
+        "repo.ItemUpdate": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "type": "boolean"
+                },
+                "assetId": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.ItemField"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "insured": {
+                    "type": "boolean"
+                },
+                "labelIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "lifetimeWarranty": {
+                    "description": "Warranty",
+                    "type": "boolean"
+                },
+                "locationId": {
+                    "description": "Edges",
+                    "type": "string"
+                },
+                "manufacturer": {
+                    "type": "string"
+                },
+                "modelNumber": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "Extras",
+                    "type": "string"
+                },
+                "parentId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "purchaseFrom": {
+                    "type": "string"
+                },
+                "purchasePrice": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "purchaseTime": {
+                    "description": "Purchase",
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "serialNumber": {
+                    "description": "Identifications",
+                    "type": "string"
+                },
+                "soldNotes": {
+                    "type": "string"
+                },
+                "soldPrice": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "soldTime": {
+                    "description": "Sold",
+                    "type": "string"
+                },
+                "soldTo": {
+                    "type": "string"
+                },
+                "warrantyDetails": {
+                    "type": "string"
+                },
+                "warrantyExpires": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.LabelCreate": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "repo.LabelOut": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.LabelSummary": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.LocationCreate": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parentId": {
+                    "type": "string",
+                    "x-nullable": true
+                }
+            }
+        },
+        "repo.LocationOut": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.LocationSummary"
+                    }
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent": {
+                    "$ref": "#/definitions/repo.LocationSummary"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.LocationOutCount": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "itemCount": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.LocationSummary": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.LocationUpdate": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parentId": {
+                    "type": "string",
+                    "x-nullable": true
+                }
+            }
+        },
+        "repo.MaintenanceEntry": {
+            "type": "object",
+            "properties": {
+                "completedDate": {
+                    "type": "string"
+                },
+                "cost": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scheduleId": {
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "scheduledDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.MaintenanceEntryCreate": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "completedDate": {
+                    "type": "string"
+                },
+                "cost": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scheduledDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.MaintenanceEntryUpdate": {
+            "type": "object",
+            "properties": {
+                "completedDate": {
+                    "type": "string"
+                },
+                "cost": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scheduledDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.MaintenanceLog": {
+            "type": "object",
+            "properties": {
+                "costAverage": {
+                    "type": "number"
+                },
+                "costTotal": {
+                    "type": "number"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.MaintenanceEntry"
+                    }
                 },
-                "name": {
+                "itemId": {
                     "type": "string"
                 }
             }
         },
-        "repo.ItemAttachment": {
+        "repo.MaintenanceSchedule": {
             "type": "object",
             "properties": {
                 "createdAt": {
                     "type": "string"
                 },
-                "document": {
-                    "$ref": "#/definitions/repo.DocumentOut"
+                "description": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "primary": {
-                    "type": "boolean"
+                "itemId": {
+                    "type": "string"
                 },
-                "type": {
+                "lastCompleted": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nextDue": {
+                    "type": "string"
+                },
+                "notifyDaysBefore": {
+                    "type": "integer"
+                },
+                "rule": {
                     "type": "string"
                 },
                 "updatedAt": {
@@ -2002,427 +5530,651 @@ const docTemplate = `{
                 }
             }
         },
-        "repo.ItemAttachmentUpdate": {
+        "repo.MaintenanceScheduleCreate": {
             "type": "object",
+            "required": [
+                "name",
+                "rule"
+            ],
             "properties": {
-                "primary": {
-                    "type": "boolean"
+                "description": {
+                    "type": "string",
+                    "maxLength": 2500
                 },
-                "title": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "notifyDaysBefore": {
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": 0
+                },
+                "rule": {
                     "type": "string"
                 },
-                "type": {
+                "startDate": {
                     "type": "string"
                 }
             }
         },
-        "repo.ItemCreate": {
+        "repo.MaintenanceScheduleUpdate": {
             "type": "object",
             "required": [
-                "name"
+                "name",
+                "rule"
             ],
             "properties": {
                 "description": {
                     "type": "string",
-                    "maxLength": 1000
+                    "maxLength": 2500
                 },
-                "labelIds": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
                 },
-                "locationId": {
-                    "description": "Edges",
+                "nextDue": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "notifyDaysBefore": {
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": 0
                 },
-                "parentId": {
-                    "type": "string",
-                    "x-nullable": true
+                "rule": {
+                    "type": "string"
                 }
             }
         },
-        "repo.ItemField": {
+        "repo.NotificationReminderOut": {
             "type": "object",
             "properties": {
-                "booleanValue": {
-                    "type": "boolean"
+                "dueDate": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "name": {
+                "itemId": {
                     "type": "string"
                 },
-                "numberValue": {
+                "leadDays": {
                     "type": "integer"
                 },
-                "textValue": {
+                "ruleId": {
                     "type": "string"
                 },
-                "type": {
+                "sentAt": {
+                    "type": "string"
+                },
+                "target": {
+                    "type": "string"
+                },
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "repo.ItemOut": {
+        "repo.NotificationRuleCreate": {
             "type": "object",
+            "required": [
+                "kind",
+                "leadDays",
+                "name"
+            ],
             "properties": {
-                "archived": {
+                "fieldName": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "isActive": {
                     "type": "boolean"
                 },
-                "assetId": {
+                "kind": {
                     "type": "string",
-                    "example": "0"
+                    "enum": [
+                        "warranty",
+                        "maintenance",
+                        "field"
+                    ]
                 },
-                "attachments": {
+                "leadDays": {
                     "type": "array",
+                    "maxItems": 10,
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/repo.ItemAttachment"
+                        "type": "integer"
                     }
                 },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "repo.NotificationRuleOut": {
+            "type": "object",
+            "properties": {
                 "createdAt": {
                     "type": "string"
                 },
-                "description": {
+                "fieldName": {
                     "type": "string"
                 },
-                "fields": {
+                "id": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "leadDays": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repo.ItemField"
+                        "type": "integer"
                     }
                 },
-                "id": {
+                "name": {
                     "type": "string"
                 },
-                "imageId": {
+                "updatedAt": {
                     "type": "string"
+                }
+            }
+        },
+        "repo.NotificationRuleUpdate": {
+            "type": "object",
+            "required": [
+                "kind",
+                "leadDays",
+                "name"
+            ],
+            "properties": {
+                "fieldName": {
+                    "type": "string",
+                    "maxLength": 255
                 },
-                "insured": {
+                "isActive": {
                     "type": "boolean"
                 },
-                "labels": {
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "warranty",
+                        "maintenance",
+                        "field"
+                    ]
+                },
+                "leadDays": {
                     "type": "array",
+                    "maxItems": 10,
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/repo.LabelSummary"
+                        "type": "integer"
                     }
                 },
-                "lifetimeWarranty": {
-                    "description": "Warranty",
-                    "type": "boolean"
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "repo.NotificationTemplateCreate": {
+            "type": "object",
+            "required": [
+                "body",
+                "type"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 5000
                 },
-                "location": {
-                    "description": "Edges",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/repo.LocationSummary"
-                        }
-                    ],
-                    "x-nullable": true,
-                    "x-omitempty": true
+                "notifierId": {
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "manufacturer": {
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "maintenance",
+                        "reminder",
+                        "digest"
+                    ]
+                }
+            }
+        },
+        "repo.NotificationTemplateOut": {
+            "type": "object",
+            "properties": {
+                "body": {
                     "type": "string"
                 },
-                "modelNumber": {
+                "createdAt": {
                     "type": "string"
                 },
-                "name": {
+                "id": {
                     "type": "string"
                 },
-                "notes": {
-                    "description": "Extras",
-                    "type": "string"
+                "notifierId": {
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "parent": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/repo.ItemSummary"
-                        }
-                    ],
-                    "x-nullable": true,
-                    "x-omitempty": true
+                "type": {
+                    "type": "string"
                 },
-                "purchaseFrom": {
+                "updatedAt": {
                     "type": "string"
+                }
+            }
+        },
+        "repo.NotificationTemplateUpdate": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 5000
+                }
+            }
+        },
+        "repo.NotifierCreate": {
+            "type": "object",
+            "required": [
+                "name",
+                "url"
+            ],
+            "properties": {
+                "isActive": {
+                    "type": "boolean"
                 },
-                "purchasePrice": {
+                "name": {
                     "type": "string",
-                    "example": "0"
+                    "maxLength": 255,
+                    "minLength": 1
                 },
-                "purchaseTime": {
-                    "description": "Purchase",
+                "url": {
                     "type": "string"
-                },
-                "quantity": {
+                }
+            }
+        },
+        "repo.NotifierDeliveryOut": {
+            "type": "object",
+            "properties": {
+                "attempt": {
                     "type": "integer"
                 },
-                "serialNumber": {
-                    "type": "string"
-                },
-                "soldNotes": {
+                "createdAt": {
                     "type": "string"
                 },
-                "soldPrice": {
-                    "type": "string",
-                    "example": "0"
-                },
-                "soldTime": {
-                    "description": "Sold",
+                "error": {
                     "type": "string"
                 },
-                "soldTo": {
+                "id": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "notifierId": {
                     "type": "string"
                 },
-                "warrantyDetails": {
+                "status": {
                     "type": "string"
                 },
-                "warrantyExpires": {
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "repo.ItemPatch": {
+        "repo.NotifierOut": {
             "type": "object",
             "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "failureCount": {
+                    "type": "integer"
+                },
+                "groupId": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
-                "quantity": {
-                    "type": "integer",
-                    "x-nullable": true,
-                    "x-omitempty": true
+                "isActive": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
                 }
             }
         },
-        "repo.ItemPath": {
+        "repo.NotifierUpdate": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "id": {
-                    "type": "string"
+                "isActive": {
+                    "type": "boolean"
                 },
                 "name": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
                 },
-                "type": {
-                    "$ref": "#/definitions/repo.ItemType"
+                "url": {
+                    "type": "string",
+                    "x-nullable": true
                 }
             }
         },
-        "repo.ItemSummary": {
+        "repo.PaginationResult-repo_AuditEntryOut": {
             "type": "object",
             "properties": {
-                "archived": {
-                    "type": "boolean"
-                },
-                "createdAt": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.AuditEntryOut"
+                    }
                 },
-                "imageId": {
-                    "type": "string"
+                "page": {
+                    "type": "integer"
                 },
-                "insured": {
-                    "type": "boolean"
+                "pageSize": {
+                    "type": "integer"
                 },
-                "labels": {
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repo.PaginationResult-repo_ItemSummary": {
+            "type": "object",
+            "properties": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repo.LabelSummary"
+                        "$ref": "#/definitions/repo.ItemSummary"
                     }
                 },
-                "location": {
-                    "description": "Edges",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/repo.LocationSummary"
-                        }
-                    ],
-                    "x-nullable": true,
-                    "x-omitempty": true
-                },
-                "name": {
-                    "type": "string"
-                },
-                "purchasePrice": {
-                    "type": "string",
-                    "example": "0"
+                "page": {
+                    "type": "integer"
                 },
-                "quantity": {
+                "pageSize": {
                     "type": "integer"
                 },
-                "updatedAt": {
-                    "type": "string"
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "repo.ItemType": {
-            "type": "string",
-            "enum": [
-                "location",
-                "item"
-            ],
-            "x-enum-varnames": [
-                "ItemTypeLocation",
-                "ItemTypeItem"
-            ]
-        },
-        "repo.ItemUpdate": {
+        "repo.PaginationResult-repo_NotificationReminderOut": {
             "type": "object",
             "properties": {
-                "archived": {
-                    "type": "boolean"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.NotificationReminderOut"
+                    }
                 },
-                "assetId": {
-                    "type": "string"
+                "page": {
+                    "type": "integer"
                 },
-                "description": {
-                    "type": "string"
+                "pageSize": {
+                    "type": "integer"
                 },
-                "fields": {
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repo.PaginationResult-repo_NotifierDeliveryOut": {
+            "type": "object",
+            "properties": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repo.ItemField"
+                        "$ref": "#/definitions/repo.NotifierDeliveryOut"
                     }
                 },
-                "id": {
-                    "type": "string"
+                "page": {
+                    "type": "integer"
                 },
-                "insured": {
-                    "type": "boolean"
+                "pageSize": {
+                    "type": "integer"
                 },
-                "labelIds": {
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repo.PaginationResult-repo_SearchResult": {
+            "type": "object",
+            "properties": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/repo.SearchResult"
                     }
                 },
-                "lifetimeWarranty": {
-                    "description": "Warranty",
-                    "type": "boolean"
-                },
-                "locationId": {
-                    "description": "Edges",
-                    "type": "string"
+                "page": {
+                    "type": "integer"
                 },
-                "manufacturer": {
-                    "type": "string"
+                "pageSize": {
+                    "type": "integer"
                 },
-                "modelNumber": {
-                    "type": "string"
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repo.SavedSearchCreate": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
                 },
                 "name": {
-                    "type": "string"
-                },
-                "notes": {
-                    "description": "Extras",
-                    "type": "string"
-                },
-                "parentId": {
                     "type": "string",
-                    "x-nullable": true,
-                    "x-omitempty": true
-                },
-                "purchaseFrom": {
-                    "type": "string"
+                    "maxLength": 255,
+                    "minLength": 1
                 },
-                "purchasePrice": {
+                "orderBy": {
                     "type": "string",
-                    "example": "0"
-                },
-                "purchaseTime": {
-                    "description": "Purchase",
+                    "enum": [
+                        "name",
+                        "createdAt",
+                        "updatedAt"
+                    ]
+                },
+                "query": {
+                    "$ref": "#/definitions/types.SavedQuery"
+                }
+            }
+        },
+        "repo.SavedSearchOut": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
                     "type": "string"
                 },
-                "quantity": {
-                    "type": "integer"
-                },
-                "serialNumber": {
-                    "description": "Identifications",
+                "description": {
                     "type": "string"
                 },
-                "soldNotes": {
+                "id": {
                     "type": "string"
                 },
-                "soldPrice": {
-                    "type": "string",
-                    "example": "0"
+                "itemCount": {
+                    "description": "ItemCount is the number of items currently matching the query, it is\nonly set when requested.",
+                    "type": "integer",
+                    "x-nullable": true
                 },
-                "soldTime": {
-                    "description": "Sold",
+                "name": {
                     "type": "string"
                 },
-                "soldTo": {
+                "orderBy": {
                     "type": "string"
                 },
-                "warrantyDetails": {
-                    "type": "string"
+                "query": {
+                    "$ref": "#/definitions/types.SavedQuery"
                 },
-                "warrantyExpires": {
+                "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "repo.LabelCreate": {
+        "repo.SavedSearchUpdate": {
             "type": "object",
             "required": [
                 "name"
             ],
             "properties": {
-                "color": {
-                    "type": "string"
-                },
                 "description": {
                     "type": "string",
-                    "maxLength": 255
+                    "maxLength": 1000
+                },
+                "id": {
+                    "type": "string"
                 },
                 "name": {
                     "type": "string",
                     "maxLength": 255,
                     "minLength": 1
+                },
+                "orderBy": {
+                    "type": "string",
+                    "enum": [
+                        "name",
+                        "createdAt",
+                        "updatedAt"
+                    ]
+                },
+                "query": {
+                    "$ref": "#/definitions/types.SavedQuery"
                 }
             }
         },
-        "repo.LabelOut": {
+        "repo.SearchHighlight": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "snippet": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.SearchResult": {
+            "type": "object",
+            "properties": {
+                "highlights": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.SearchHighlight"
+                    }
+                },
+                "item": {
+                    "$ref": "#/definitions/repo.ItemSummary"
+                },
+                "rank": {
+                    "type": "number"
+                }
+            }
+        },
+        "repo.TotalsByOrganizer": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "repo.TrashItem": {
             "type": "object",
             "properties": {
+                "archived": {
+                    "type": "boolean"
+                },
                 "createdAt": {
                     "type": "string"
                 },
+                "deletedAt": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
+                "imageId": {
+                    "type": "string"
+                },
+                "insured": {
+                    "type": "boolean"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.LabelSummary"
+                    }
+                },
+                "location": {
+                    "description": "Edges",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.LocationSummary"
+                        }
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
                 "name": {
                     "type": "string"
                 },
+                "purchasePrice": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
                 "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "repo.LabelSummary": {
+        "repo.TrashLocation": {
             "type": "object",
             "properties": {
                 "createdAt": {
                     "type": "string"
                 },
+                "deletedAt": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
@@ -2437,407 +6189,394 @@ const docTemplate = `{
                 }
             }
         },
-        "repo.LocationCreate": {
-            "type": "object",
-            "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "parentId": {
-                    "type": "string",
-                    "x-nullable": true
-                }
-            }
-        },
-        "repo.LocationOut": {
+        "repo.TreeItem": {
             "type": "object",
             "properties": {
                 "children": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repo.LocationSummary"
+                        "$ref": "#/definitions/repo.TreeItem"
                     }
                 },
-                "createdAt": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
                 "id": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "parent": {
-                    "$ref": "#/definitions/repo.LocationSummary"
-                },
-                "updatedAt": {
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "repo.LocationOutCount": {
+        "repo.UserOut": {
             "type": "object",
             "properties": {
-                "createdAt": {
+                "disabled": {
+                    "description": "Disabled is true when a superuser disabled the account.",
+                    "type": "boolean"
+                },
+                "email": {
                     "type": "string"
                 },
-                "description": {
+                "emailVerified": {
+                    "description": "EmailVerified is true once the user verified their email address.",
+                    "type": "boolean"
+                },
+                "groupId": {
+                    "description": "GroupID is the active group of the user, Role and IsOwner describe the\nmembership of the user in it.",
+                    "type": "string"
+                },
+                "groupName": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "itemCount": {
-                    "type": "integer"
+                "isOwner": {
+                    "type": "boolean"
+                },
+                "isSuperuser": {
+                    "type": "boolean"
                 },
                 "name": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "role": {
                     "type": "string"
+                },
+                "twoFactorEnabled": {
+                    "description": "TwoFactorEnabled is true when the login requires a TOTP or recovery code.",
+                    "type": "boolean"
                 }
             }
         },
-        "repo.LocationSummary": {
+        "repo.UserSessionOut": {
             "type": "object",
             "properties": {
                 "createdAt": {
                     "type": "string"
                 },
-                "description": {
+                "current": {
+                    "type": "boolean"
+                },
+                "expiresAt": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "name": {
+                "ipAddress": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "lastUsedAt": {
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "userAgent": {
                     "type": "string"
                 }
             }
         },
-        "repo.LocationUpdate": {
+        "repo.UserUpdate": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "id": {
+                "email": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
-                },
-                "parentId": {
-                    "type": "string",
-                    "x-nullable": true
                 }
             }
         },
-        "repo.MaintenanceEntry": {
+        "repo.UserUsage": {
             "type": "object",
             "properties": {
-                "completedDate": {
+                "apiKeys": {
+                    "type": "integer"
+                },
+                "createdAt": {
                     "type": "string"
                 },
-                "cost": {
+                "disabledAt": {
                     "type": "string",
-                    "example": "0"
+                    "x-nullable": true
                 },
-                "description": {
+                "email": {
                     "type": "string"
                 },
+                "emailVerified": {
+                    "type": "boolean"
+                },
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.GroupMembershipOut"
+                    }
+                },
                 "id": {
                     "type": "string"
                 },
+                "isSuperuser": {
+                    "type": "boolean"
+                },
+                "lastActiveAt": {
+                    "type": "string",
+                    "x-nullable": true
+                },
                 "name": {
                     "type": "string"
                 },
-                "scheduledDate": {
-                    "type": "string"
+                "sessions": {
+                    "description": "Sessions is the number of active sessions, LastActiveAt the time of the last\nrequest made with any token of the user.",
+                    "type": "integer"
+                },
+                "twoFactorEnabled": {
+                    "type": "boolean"
                 }
             }
         },
-        "repo.MaintenanceEntryCreate": {
+        "repo.ValueOverTime": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "completedDate": {
+                "end": {
                     "type": "string"
                 },
-                "cost": {
-                    "type": "string",
-                    "example": "0"
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.ValueOverTimeEntry"
+                    }
                 },
-                "description": {
+                "start": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "valueAtEnd": {
+                    "type": "number"
                 },
-                "scheduledDate": {
-                    "type": "string"
+                "valueAtStart": {
+                    "type": "number"
                 }
             }
         },
-        "repo.MaintenanceEntryUpdate": {
+        "repo.ValueOverTimeEntry": {
             "type": "object",
             "properties": {
-                "completedDate": {
-                    "type": "string"
-                },
-                "cost": {
-                    "type": "string",
-                    "example": "0"
-                },
-                "description": {
+                "date": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "scheduledDate": {
-                    "type": "string"
+                "value": {
+                    "type": "number"
                 }
             }
         },
-        "repo.MaintenanceLog": {
+        "repo.WebAuthnCredentialOut": {
             "type": "object",
             "properties": {
-                "costAverage": {
-                    "type": "number"
+                "createdAt": {
+                    "type": "string"
                 },
-                "costTotal": {
-                    "type": "number"
+                "id": {
+                    "type": "string"
                 },
-                "entries": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.MaintenanceEntry"
-                    }
+                "lastUsedAt": {
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "itemId": {
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "repo.NotifierCreate": {
+        "repo.WebAuthnCredentialUpdate": {
             "type": "object",
             "required": [
-                "name",
-                "url"
+                "name"
             ],
             "properties": {
-                "isActive": {
-                    "type": "boolean"
-                },
                 "name": {
                     "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
-                },
-                "url": {
-                    "type": "string"
+                    "maxLength": 255
                 }
             }
         },
-        "repo.NotifierOut": {
+        "services.APIKeyDetail": {
             "type": "object",
             "properties": {
                 "createdAt": {
                     "type": "string"
                 },
-                "groupId": {
+                "expiresAt": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "isActive": {
-                    "type": "boolean"
-                },
                 "name": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "scope": {
                     "type": "string"
                 },
-                "userId": {
+                "token": {
                     "type": "string"
                 }
             }
         },
-        "repo.NotifierUpdate": {
+        "services.AdminPasswordReset": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "isActive": {
+                "mailed": {
                     "type": "boolean"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
-                },
                 "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "services.MemberRoleUpdate": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
                     "type": "string",
-                    "x-nullable": true
+                    "enum": [
+                        "viewer",
+                        "editor",
+                        "owner"
+                    ]
                 }
             }
         },
-        "repo.PaginationResult-repo_ItemSummary": {
+        "services.Registration": {
             "type": "object",
             "properties": {
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.ItemSummary"
-                    }
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
-                },
-                "total": {
-                    "type": "integer"
+                "allowRegistration": {
+                    "type": "boolean"
                 }
             }
         },
-        "repo.TotalsByOrganizer": {
+        "services.SnapshotStatus": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
+                "enabled": {
+                    "type": "boolean"
                 },
-                "name": {
-                    "type": "string"
+                "lastFailedAt": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "total": {
-                    "type": "number"
+                "lastSnapshotAt": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "snapshots": {
+                    "type": "integer"
                 }
             }
         },
-        "repo.TreeItem": {
+        "services.TwoFactorRecoveryCodes": {
             "type": "object",
             "properties": {
-                "children": {
+                "recoveryCodes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repo.TreeItem"
+                        "type": "string"
                     }
-                },
-                "id": {
-                    "type": "string"
-                },
-                "name": {
+                }
+            }
+        },
+        "services.TwoFactorSetup": {
+            "type": "object",
+            "properties": {
+                "secret": {
                     "type": "string"
                 },
-                "type": {
+                "url": {
+                    "description": "URL is the otpauth:// URL authenticator apps scan as a QR code.",
                     "type": "string"
                 }
             }
         },
-        "repo.UserOut": {
+        "services.UserRegistration": {
             "type": "object",
             "properties": {
                 "email": {
                     "type": "string"
                 },
-                "groupId": {
-                    "type": "string"
-                },
-                "groupName": {
+                "name": {
                     "type": "string"
                 },
-                "id": {
+                "password": {
                     "type": "string"
                 },
-                "isOwner": {
-                    "type": "boolean"
-                },
-                "isSuperuser": {
-                    "type": "boolean"
-                },
-                "name": {
+                "token": {
                     "type": "string"
                 }
             }
         },
-        "repo.UserUpdate": {
+        "types.FieldChange": {
             "type": "object",
             "properties": {
-                "email": {
+                "field": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
-                }
+                "from": {},
+                "to": {}
             }
         },
-        "repo.ValueOverTime": {
+        "types.SavedQuery": {
             "type": "object",
             "properties": {
-                "end": {
-                    "type": "string"
-                },
-                "entries": {
+                "fields": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repo.ValueOverTimeEntry"
+                        "$ref": "#/definitions/types.SavedQueryField"
                     }
                 },
-                "start": {
+                "filter": {
                     "type": "string"
                 },
-                "valueAtEnd": {
-                    "type": "number"
+                "includeArchived": {
+                    "type": "boolean"
                 },
-                "valueAtStart": {
-                    "type": "number"
-                }
-            }
-        },
-        "repo.ValueOverTimeEntry": {
-            "type": "object",
-            "properties": {
-                "date": {
-                    "type": "string"
+                "labelIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "locationIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "value": {
-                    "type": "number"
+                "parentIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "search": {
+                    "type": "string"
                 }
             }
         },
-        "services.UserRegistration": {
+        "types.SavedQueryField": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
-                },
                 "name": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string"
-                },
-                "token": {
+                "value": {
                     "type": "string"
                 }
             }
@@ -2848,6 +6587,9 @@ const docTemplate = `{
                 "allowRegistration": {
                     "type": "boolean"
                 },
+                "backup": {
+                    "$ref": "#/definitions/services.SnapshotStatus"
+                },
                 "build": {
                     "$ref": "#/definitions/v1.Build"
                 },
@@ -2904,6 +6646,28 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.EmailRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.EmailVerification": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
         "v1.GroupInvitation": {
             "type": "object",
             "properties": {
@@ -2934,6 +6698,17 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.GroupJoin": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
         "v1.ItemAttachmentToken": {
             "type": "object",
             "properties": {
@@ -2956,6 +6731,103 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.NotifierPreview": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.NotifierPreviewBody": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 5000
+                },
+                "notifierId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "maintenance",
+                        "reminder",
+                        "digest"
+                    ]
+                }
+            }
+        },
+        "v1.NotifierTestBody": {
+            "type": "object",
+            "required": [
+                "url"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 5000
+                },
+                "notifierId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "maintenance",
+                        "reminder",
+                        "digest"
+                    ]
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PasskeyRegistration": {
+            "type": "object",
+            "required": [
+                "credential",
+                "name"
+            ],
+            "properties": {
+                "credential": {
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "v1.PasswordReset": {
+            "type": "object",
+            "required": [
+                "password",
+                "token"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SessionsRevoked": {
+            "type": "object",
+            "properties": {
+                "revoked": {
+                    "type": "integer"
+                }
+            }
+        },
         "v1.TokenResponse": {
             "type": "object",
             "properties": {
@@ -2965,6 +6837,74 @@ const docTemplate = `{
                 "expiresAt": {
                     "type": "string"
                 },
+                "token": {
+                    "type": "string"
+                },
+                "twoFactorRequired": {
+                    "description": "TwoFactorRequired is set instead of a token for users with two-factor authentication,\nthe login is completed with the TwoFactorToken at /v1/users/login/2fa.",
+                    "type": "boolean"
+                },
+                "twoFactorToken": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.Trash": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.TrashItem"
+                    }
+                },
+                "locations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.TrashLocation"
+                    }
+                }
+            }
+        },
+        "v1.TwoFactorConfirm": {
+            "type": "object",
+            "required": [
+                "code",
+                "password"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.TwoFactorEnable": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.TwoFactorLoginForm": {
+            "type": "object",
+            "required": [
+                "code",
+                "token"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "stayLoggedIn": {
+                    "type": "boolean"
+                },
                 "token": {
                     "type": "string"
                 }
@@ -2986,6 +6926,37 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "webauthncose.COSEAlgorithmIdentifier": {
+            "type": "integer",
+            "enum": [
+                -7,
+                -35,
+                -36,
+                -65535,
+                -257,
+                -258,
+                -259,
+                -37,
+                -38,
+                -39,
+                -8,
+                -47
+            ],
+            "x-enum-varnames": [
+                "AlgES256",
+                "AlgES384",
+                "AlgES512",
+                "AlgRS1",
+                "AlgRS256",
+                "AlgRS384",
+                "AlgRS512",
+                "AlgPS256",
+                "AlgPS384",
+                "AlgPS512",
+                "AlgEdDSA",
+                "AlgES256K"
+            ]
         }
     },
     "securityDefinitions": {
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/sys/config"
+	"github.com/hay-kot/homebox/backend/pkgs/blobstore"
+	"github.com/rs/zerolog/log"
+)
+
+// openBlobStore returns the store for attachment files of the backend.
+func openBlobStore(cfg config.Storage, backend string) (blobstore.Store, error) {
+	switch backend {
+	case config.BlobLocal:
+		return blobstore.NewLocal(cfg.Data), nil
+	case config.BlobS3:
+		return blobstore.NewS3(blobstore.S3Config{
+			Endpoint:        cfg.S3.Endpoint,
+			Region:          cfg.S3.Region,
+			Bucket:          cfg.S3.Bucket,
+			AccessKeyID:     cfg.S3.AccessKeyID,
+			SecretAccessKey: cfg.S3.SecretAccessKey,
+			PathStyle:       cfg.S3.PathStyle,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported blob storage %q", backend)
+	}
+}
+
+// migrateDocuments copies the attachment files of all documents from one blob storage
+// backend to another. The files are not removed from the source, HBOX_STORAGE_BLOB has
+// to be switched to the target afterwards.
+func migrateDocuments(ctx context.Context, c *ent.Client, cfg config.Storage, from, to string) error {
+	if from == "" || to == "" || from == to {
+		return fmt.Errorf("usage: migrate-documents <%s|%s> <%s|%s>", config.BlobLocal, config.BlobS3, config.BlobLocal, config.BlobS3)
+	}
+
+	src, err := openBlobStore(cfg, from)
+	if err != nil {
+		return err
+	}
+
+	dst, err := openBlobStore(cfg, to)
+	if err != nil {
+		return err
+	}
+
+	repos := repo.New(c, nil, cfg.Data, repo.WithBlobStore(src))
+
+	out, err := repos.Docs.CopyAll(ctx, dst)
+	if err != nil {
+		return fmt.Errorf("copied %d documents: %w", out.Copied, err)
+	}
+
+	for _, key := range out.Missing {
+		log.Warn().
+			Str("key", key).
+			Msg("document file is missing, skipped")
+	}
+
+	log.Info().
+		Str("from", from).
+		Str("to", to).
+		Int("copied", out.Copied).
+		Int("missing", len(out.Missing)).
+		Msg("migrated documents")
+
+	return nil
+}
@@ -2,23 +2,39 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"flag"
 	"fmt"
 	"log"
-	"os"
+	"path/filepath"
 
 	"github.com/hay-kot/homebox/backend/internal/data/ent/migrate"
 
 	atlas "ariga.io/atlas/sql/migrate"
+	_ "ariga.io/atlas/sql/postgres"
 	_ "ariga.io/atlas/sql/sqlite"
 	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/schema"
+	_ "github.com/jackc/pgx/v5/stdlib"
 	_ "github.com/mattn/go-sqlite3"
 )
 
 func main() {
+	var (
+		driver = flag.String("dialect", dialect.SQLite, "database dialect to generate the migration for (sqlite3, postgres)")
+		devURL = flag.String("dev-url", "", "url of an empty development database, required for postgres")
+	)
+
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		log.Fatalln("migration name is required. Use: 'go run -mod=mod ./app/tools/migrations [-dialect postgres -dev-url <url>] <name>'")
+	}
+
 	ctx := context.Background()
 	// Create a local migration directory able to understand Atlas migration file format for replay.
-	dir, err := atlas.NewLocalDir("internal/data/migrations/migrations")
+	dir, err := atlas.NewLocalDir(filepath.Join("internal/data/migrations", *driver))
 	if err != nil {
 		log.Fatalf("failed creating atlas migration directory: %v", err)
 	}
@@ -26,17 +42,38 @@ func main() {
 	opts := []schema.MigrateOption{
 		schema.WithDir(dir),                         // provide migration directory
 		schema.WithMigrationMode(schema.ModeReplay), // provide migration mode
-		schema.WithDialect(dialect.SQLite),          // Ent dialect to use
+		schema.WithDialect(*driver),                 // Ent dialect to use
 		schema.WithFormatter(atlas.DefaultFormatter),
 		schema.WithDropIndex(true),
 		schema.WithDropColumn(true),
 	}
-	if len(os.Args) != 2 {
-		log.Fatalln("migration name is required. Use: 'go run -mod=mod ent/migrate/main.go <name>'")
+
+	switch *driver {
+	case dialect.SQLite:
+		err = migrate.NamedDiff(ctx, "sqlite://.data/homebox.migration.db?_fk=1", flag.Arg(0), opts...)
+	case dialect.Postgres:
+		if *devURL == "" {
+			log.Fatalln("a -dev-url pointing to an empty postgres database is required")
+		}
+
+		var db *sql.DB
+		db, err = sql.Open("pgx", *devURL)
+		if err != nil {
+			log.Fatalf("failed opening postgres connection: %v", err)
+		}
+		defer func() { _ = db.Close() }()
+
+		var m *schema.Atlas
+		m, err = schema.NewMigrate(entsql.OpenDB(dialect.Postgres, db), opts...)
+		if err != nil {
+			log.Fatalf("failed creating migrate engine: %v", err)
+		}
+
+		err = m.NamedDiff(ctx, flag.Arg(0), migrate.Tables...)
+	default:
+		log.Fatalf("unsupported dialect: %s", *driver)
 	}
 
-	// Generate migrations using Atlas support for MySQL (note the Ent dialect option passed above).
-	err = migrate.NamedDiff(ctx, "sqlite://.data/homebox.migration.db?_fk=1", os.Args[1], opts...)
 	if err != nil {
 		log.Fatalf("failed generating migration file: %v", err)
 	}
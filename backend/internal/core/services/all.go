@@ -2,15 +2,24 @@
 package services
 
 import (
+	"strings"
+	"time"
+
+	"github.com/containrrr/shoutrrr"
 	"github.com/hay-kot/homebox/backend/internal/core/currencies"
 	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/pkgs/mailer"
 )
 
 type AllServices struct {
 	User              *UserService
 	Group             *GroupService
+	Admin             *AdminService
 	Items             *ItemService
+	Locations         *LocationService
+	Labels            *LabelService
 	BackgroundService *BackgroundService
+	Snapshots         *SnapshotService
 	Currencies        *currencies.CurrencyRegistry
 }
 
@@ -19,6 +28,11 @@ type OptionsFunc func(*options)
 type options struct {
 	autoIncrementAssetID bool
 	currencies           []currencies.Currency
+	baseURL              string
+	mailer               *mailer.Mailer
+	requireVerification  bool
+	allowRegistration    bool
+	snapshots            SnapshotConfig
 }
 
 func WithAutoIncrementAssetID(v bool) func(*options) {
@@ -33,6 +47,43 @@ func WithCurrencies(v []currencies.Currency) func(*options) {
 	}
 }
 
+// WithBaseURL sets the public URL of the instance used for links in notifications.
+func WithBaseURL(v string) func(*options) {
+	return func(o *options) {
+		o.baseURL = strings.TrimRight(v, "/")
+	}
+}
+
+// WithMailer sets the mailer used to send password reset and verification mails.
+func WithMailer(v *mailer.Mailer) func(*options) {
+	return func(o *options) {
+		o.mailer = v
+	}
+}
+
+// WithRequireEmailVerification requires new users to verify their email address
+// before they can login.
+func WithRequireEmailVerification(v bool) func(*options) {
+	return func(o *options) {
+		o.requireVerification = v
+	}
+}
+
+// WithRegistration sets if new users can register without an invitation, superusers
+// can change it at runtime.
+func WithRegistration(v bool) func(*options) {
+	return func(o *options) {
+		o.allowRegistration = v
+	}
+}
+
+// WithSnapshots sets the directory and the retention of the database snapshots.
+func WithSnapshots(v SnapshotConfig) func(*options) {
+	return func(o *options) {
+		o.snapshots = v
+	}
+}
+
 func New(repos *repo.AllRepos, opts ...OptionsFunc) *AllServices {
 	if repos == nil {
 		panic("repos cannot be nil")
@@ -48,20 +99,42 @@ func New(repos *repo.AllRepos, opts ...OptionsFunc) *AllServices {
 	options := &options{
 		autoIncrementAssetID: true,
 		currencies:           defaultCurrencies,
+		allowRegistration:    true,
 	}
 
 	for _, opt := range opts {
 		opt(options)
 	}
 
+	user := &UserService{
+		repos:               repos,
+		mailer:              options.mailer,
+		baseURL:             options.baseURL,
+		requireVerification: options.requireVerification,
+		webauthn:            newWebAuthn(options.baseURL),
+		ceremonies:          newCeremonyStore(),
+	}
+
+	admin := &AdminService{repos: repos, user: user}
+	admin.registration.Store(options.allowRegistration)
+
 	return &AllServices{
-		User:  &UserService{repos},
+		User:  user,
 		Group: &GroupService{repos},
+		Admin: admin,
 		Items: &ItemService{
 			repo:                 repos,
 			autoIncrementAssetID: options.autoIncrementAssetID,
 		},
-		BackgroundService: &BackgroundService{repos},
-		Currencies:        currencies.NewCurrencyService(options.currencies),
+		Locations: &LocationService{repos},
+		Labels:    &LabelService{repos},
+		BackgroundService: &BackgroundService{
+			repos:   repos,
+			baseURL: options.baseURL,
+			send:    shoutrrr.Send,
+			backoff: 10 * time.Second,
+		},
+		Snapshots:  &SnapshotService{repos: repos, conf: options.snapshots},
+		Currencies: currencies.NewCurrencyService(options.currencies),
 	}
 }
@@ -22,7 +22,8 @@ type Context struct {
 	// UID is a unique identifier for the acting user.
 	UID uuid.UUID
 
-	// GID is a unique identifier for the acting users group.
+	// GID is the active group of the acting user. It is the group of the session, which
+	// can be switched to any group the user is a member of without logging in again.
 	GID uuid.UUID
 
 	// User is the acting user.
@@ -42,10 +43,14 @@ func NewContext(ctx context.Context) Context {
 }
 
 // SetUserCtx is a helper function that sets the ContextUser and ContextUserToken
-// values within the context of a web request (or any context).
+// values within the context of a web request (or any context). Changes made with
+// the returned context are attributed to the user in the audit log.
 func SetUserCtx(ctx context.Context, user *repo.UserOut, token string) context.Context {
 	ctx = context.WithValue(ctx, ContextUser, user)
 	ctx = context.WithValue(ctx, ContextUserToken, token)
+	if user != nil {
+		ctx = repo.WithActor(ctx, user.ID)
+	}
 	return ctx
 }
 
@@ -75,6 +75,7 @@ func TestMain(m *testing.M) {
 		Context: context.Background(),
 		GID:     tGroup.ID,
 		UID:     tUser.ID,
+		User:    &tUser,
 	}
 
 	os.Exit(m.Run())
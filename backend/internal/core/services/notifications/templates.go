@@ -0,0 +1,267 @@
+// Package notifications renders the messages sent to notifiers. Messages are built from
+// text/template templates that can be replaced by the user per notification type and
+// per notifier.
+package notifications
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// Type is the kind of notification a template renders.
+type Type string
+
+const (
+	// TypeMaintenance lists the maintenance due today and the upcoming maintenance.
+	TypeMaintenance Type = "maintenance"
+	// TypeReminder lists the pending reminders of the notification rules.
+	TypeReminder Type = "reminder"
+	// TypeDigest combines maintenance and reminders into a single daily or weekly
+	// message for groups that opted into a digest.
+	TypeDigest Type = "digest"
+)
+
+// Types returns all notification types.
+func Types() []Type {
+	return []Type{TypeMaintenance, TypeReminder, TypeDigest}
+}
+
+const (
+	// MaxOutputSize is the maximum size of a rendered message in bytes.
+	MaxOutputSize = 64 << 10
+	// RenderTimeout is the maximum time rendering a message may take.
+	RenderTimeout = 2 * time.Second
+)
+
+var (
+	// ErrUnknownType is returned for a notification type without a default template.
+	ErrUnknownType = errors.New("unknown notification type")
+	// ErrOutputTooLarge is returned for templates rendering more than MaxOutputSize bytes.
+	ErrOutputTooLarge = errors.New("notification template output is larger than 64 KiB")
+	// ErrRenderTimeout is returned for templates taking longer than RenderTimeout to render.
+	ErrRenderTimeout = errors.New("notification template took too long to render")
+)
+
+type (
+	Location struct {
+		ID   uuid.UUID
+		Name string
+		URL  string
+	}
+
+	Item struct {
+		ID       uuid.UUID
+		Name     string
+		URL      string
+		Location Location
+	}
+
+	Maintenance struct {
+		Name        string
+		Description string
+		DueDate     types.Date
+		Item        Item
+	}
+
+	Reminder struct {
+		Title    string
+		DueDate  types.Date
+		DaysLeft int
+		Item     Item
+	}
+
+	// Data is passed to every template. Maintenance templates use Maintenance and
+	// Upcoming, reminder templates use Reminders and digest templates use all of them.
+	// Digest is either daily or weekly for digests, a weekly digest lists the
+	// maintenance of the coming week.
+	Data struct {
+		Group       string
+		Date        types.Date
+		Digest      string
+		BaseURL     string
+		Maintenance []Maintenance
+		Upcoming    []Maintenance
+		Reminders   []Reminder
+	}
+)
+
+// NewItem returns the template data of an item, the links are only set when a base
+// URL is configured.
+func NewItem(baseURL string, id uuid.UUID, name string, locationID uuid.UUID, locationName string) Item {
+	it := Item{
+		ID:   id,
+		Name: name,
+		Location: Location{
+			ID:   locationID,
+			Name: locationName,
+		},
+	}
+
+	baseURL = strings.TrimRight(baseURL, "/")
+	if baseURL != "" {
+		it.URL = baseURL + "/item/" + id.String()
+		if locationID != uuid.Nil {
+			it.Location.URL = baseURL + "/location/" + locationID.String()
+		}
+	}
+
+	return it
+}
+
+const defaultMaintenance = `Homebox Maintenance for ({{ .Date }}):
+{{- range .Maintenance }}
+ - {{ .Name }}: {{ .Item.Name }}{{ with .Item.Location.Name }} ({{ . }}){{ end }}{{ with .Item.URL }} {{ . }}{{ end }}
+{{- end }}
+{{- if .Upcoming }}
+Upcoming:
+{{- range .Upcoming }}
+ - {{ .Name }}: {{ .Item.Name }} (due {{ .DueDate }}){{ with .Item.URL }} {{ . }}{{ end }}
+{{- end }}
+{{- end }}`
+
+const defaultReminder = `Homebox Reminders for ({{ .Date }}):
+{{- range .Reminders }}
+ - {{ .Item.Name }}: {{ .Title }} {{ daysLeft .DaysLeft }} ({{ .DueDate }}){{ with .Item.URL }} {{ . }}{{ end }}
+{{- end }}`
+
+const defaultDigest = `Homebox {{ if eq .Digest "weekly" }}Weekly{{ else }}Daily{{ end }} Digest for ({{ .Date }}):
+{{- if .Maintenance }}
+Maintenance:
+{{- range .Maintenance }}
+ - {{ .Name }}: {{ .Item.Name }} (due {{ .DueDate }}){{ with .Item.URL }} {{ . }}{{ end }}
+{{- end }}
+{{- end }}
+{{- if .Upcoming }}
+Upcoming:
+{{- range .Upcoming }}
+ - {{ .Name }}: {{ .Item.Name }} (due {{ .DueDate }}){{ with .Item.URL }} {{ . }}{{ end }}
+{{- end }}
+{{- end }}
+{{- if .Reminders }}
+Reminders:
+{{- range .Reminders }}
+ - {{ .Item.Name }}: {{ .Title }} {{ daysLeft .DaysLeft }} ({{ .DueDate }}){{ with .Item.URL }} {{ . }}{{ end }}
+{{- end }}
+{{- end }}`
+
+// Default returns the built-in template of the notification type.
+func Default(t Type) (string, error) {
+	switch t {
+	case TypeMaintenance:
+		return defaultMaintenance, nil
+	case TypeReminder:
+		return defaultReminder, nil
+	case TypeDigest:
+		return defaultDigest, nil
+	}
+
+	return "", ErrUnknownType
+}
+
+var funcs = template.FuncMap{
+	"daysLeft": DaysLeft,
+}
+
+// DaysLeft describes the number of days until a date, e.g. "tomorrow" or "in 3 days".
+func DaysLeft(days int) string {
+	switch days {
+	case 0:
+		return "today"
+	case 1:
+		return "tomorrow"
+	default:
+		return "in " + strconv.Itoa(days) + " days"
+	}
+}
+
+// limitedWriter fails writes past MaxOutputSize or the deadline, which aborts the execution
+// of the template writing to it.
+type limitedWriter struct {
+	buf      strings.Builder
+	deadline time.Time
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if time.Now().After(w.deadline) {
+		return 0, ErrRenderTimeout
+	}
+
+	if w.buf.Len()+len(p) > MaxOutputSize {
+		return 0, ErrOutputTooLarge
+	}
+
+	return w.buf.Write(p)
+}
+
+// Render executes the template body with the data. Templates are written by users, so the
+// output is limited to MaxOutputSize and rendering is abandoned after RenderTimeout. A
+// template still running at the deadline stops with its next write.
+func Render(body string, data Data) (string, error) {
+	tmpl, err := template.New("notification").
+		Funcs(funcs).
+		Option("missingkey=error").
+		Parse(body)
+	if err != nil {
+		return "", err
+	}
+
+	w := &limitedWriter{deadline: time.Now().Add(RenderTimeout)}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- tmpl.Execute(w, data)
+	}()
+
+	timer := time.NewTimer(RenderTimeout)
+	defer timer.Stop()
+
+	select {
+	case err = <-done:
+	case <-timer.C:
+		return "", ErrRenderTimeout
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(w.buf.String()), nil
+}
+
+// Validate renders the template body against sample data, so that templates referring
+// to unknown fields are rejected before they are used.
+func Validate(body string) error {
+	_, err := Render(body, Sample("Home", "https://homebox.example.com", types.DateFromString("2024-01-01")))
+	return err
+}
+
+// Sample returns data used to preview and validate templates.
+func Sample(group, baseURL string, date types.Date) Data {
+	garage := uuid.MustParse("7a2b5c1e-8a44-4c1e-9a0e-3d2f4a6b8c10")
+
+	mower := NewItem(baseURL, uuid.MustParse("0b6d6f3e-2f5a-4d8e-8c1a-5e7f9a1b2c30"), "Lawn Mower", garage, "Garage")
+	furnace := NewItem(baseURL, uuid.MustParse("4c8e0a2b-6d1f-4e3a-9b5c-7d9f1b3d5e40"), "Furnace", uuid.Nil, "")
+	drill := NewItem(baseURL, uuid.MustParse("9e1f3b5d-7a2c-4f6e-8d0b-1c3e5a7b9d50"), "Cordless Drill", garage, "Garage")
+
+	return Data{
+		Group:   group,
+		Date:    date,
+		Digest:  "daily",
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		Maintenance: []Maintenance{
+			{Name: "Sharpen blade", Description: "Sharpen and balance the blade", DueDate: date, Item: mower},
+		},
+		Upcoming: []Maintenance{
+			{Name: "Replace filter", DueDate: types.DateFromTime(date.Time().AddDate(0, 0, 7)), Item: furnace},
+		},
+		Reminders: []Reminder{
+			{Title: "Warranty expires", DueDate: types.DateFromTime(date.Time().AddDate(0, 0, 30)), DaysLeft: 30, Item: drill},
+		},
+	}
+}
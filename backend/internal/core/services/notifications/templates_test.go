@@ -0,0 +1,98 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender_Defaults(t *testing.T) {
+	data := Sample("Home", "https://homebox.example.com/", types.DateFromString("2024-03-01"))
+
+	body, err := Default(TypeMaintenance)
+	require.NoError(t, err)
+
+	msg, err := Render(body, data)
+	require.NoError(t, err)
+
+	want := "Homebox Maintenance for (2024-03-01):\n" +
+		" - Sharpen blade: Lawn Mower (Garage) https://homebox.example.com/item/0b6d6f3e-2f5a-4d8e-8c1a-5e7f9a1b2c30\n" +
+		"Upcoming:\n" +
+		" - Replace filter: Furnace (due 2024-03-08) https://homebox.example.com/item/4c8e0a2b-6d1f-4e3a-9b5c-7d9f1b3d5e40"
+	assert.Equal(t, want, msg)
+
+	body, err = Default(TypeReminder)
+	require.NoError(t, err)
+
+	msg, err = Render(body, data)
+	require.NoError(t, err)
+
+	want = "Homebox Reminders for (2024-03-01):\n" +
+		" - Cordless Drill: Warranty expires in 30 days (2024-03-31) https://homebox.example.com/item/9e1f3b5d-7a2c-4f6e-8d0b-1c3e5a7b9d50"
+	assert.Equal(t, want, msg)
+
+	_, err = Default("unknown")
+	require.ErrorIs(t, err, ErrUnknownType)
+}
+
+func TestRender_Custom(t *testing.T) {
+	data := Sample("Home", "", types.DateFromString("2024-03-01"))
+
+	msg, err := Render(`{{ .Group }}:{{ range .Maintenance }} {{ .Item.Name }}@{{ .Item.Location.Name }}[{{ .Item.URL }}]{{ end }}`, data)
+	require.NoError(t, err)
+	assert.Equal(t, "Home: Lawn Mower@Garage[]", msg)
+}
+
+func TestRender_Limits(t *testing.T) {
+	data := Sample("Home", "", types.DateFromString("2024-03-01"))
+
+	_, err := Render(`{{ range 100000000 }}{{ range 100000000 }}x{{ end }}{{ end }}`, data)
+	require.ErrorIs(t, err, ErrOutputTooLarge)
+
+	require.ErrorIs(t, Validate(`{{ range 100000000 }}{{ range 100000000 }}x{{ end }}{{ end }}`), ErrOutputTooLarge)
+
+	start := time.Now()
+	_, err = Render(`{{ range 100000000 }}{{ range 100000000 }}{{ "" }}{{ end }}{{ end }}`, data)
+	require.ErrorIs(t, err, ErrRenderTimeout)
+	assert.Less(t, time.Since(start), RenderTimeout+time.Second)
+}
+
+func TestNewItem(t *testing.T) {
+	id, loc := uuid.New(), uuid.New()
+
+	it := NewItem("https://hb.local/", id, "Drill", loc, "Garage")
+	assert.Equal(t, "https://hb.local/item/"+id.String(), it.URL)
+	assert.Equal(t, "https://hb.local/location/"+loc.String(), it.Location.URL)
+
+	it = NewItem("https://hb.local", id, "Drill", uuid.Nil, "")
+	assert.Empty(t, it.Location.URL)
+
+	it = NewItem("", id, "Drill", loc, "Garage")
+	assert.Empty(t, it.URL)
+	assert.Empty(t, it.Location.URL)
+}
+
+func TestValidate(t *testing.T) {
+	for _, typ := range Types() {
+		body, err := Default(typ)
+		require.NoError(t, err)
+		require.NoError(t, Validate(body))
+	}
+
+	require.NoError(t, Validate(`{{ range .Reminders }}{{ daysLeft .DaysLeft }}{{ end }}`))
+
+	require.Error(t, Validate(`{{ .Unknown }}`))
+	require.Error(t, Validate(`{{ range .Maintenance }}{{ .Item.Owner }}{{ end }}`))
+	require.Error(t, Validate(`{{ if .Date }}`))
+	require.Error(t, Validate(`{{ missing .Date }}`))
+}
+
+func TestDaysLeft(t *testing.T) {
+	assert.Equal(t, "today", DaysLeft(0))
+	assert.Equal(t, "tomorrow", DaysLeft(1))
+	assert.Equal(t, "in 14 days", DaysLeft(14))
+}
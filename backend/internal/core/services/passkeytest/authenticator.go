@@ -0,0 +1,171 @@
+// Package passkeytest provides a virtual WebAuthn authenticator to test passkey
+// registrations and logins without a browser.
+package passkeytest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+)
+
+const (
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+	flagAttestedData = 0x40
+)
+
+// Authenticator holds a single discoverable P-256 credential with "none" attestation.
+type Authenticator struct {
+	origin       string
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	userHandle   []byte
+	// Counter is the signature counter, it is incremented with every assertion.
+	Counter uint32
+}
+
+// New returns an authenticator that signs client data for the origin, e.g.
+// "https://homebox.example.com".
+func New(origin string) *Authenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	id := make([]byte, 32)
+	_, _ = rand.Read(id)
+
+	return &Authenticator{
+		origin:       origin,
+		key:          key,
+		credentialID: id,
+	}
+}
+
+// CredentialID returns the ID of the credential held by the authenticator.
+func (a *Authenticator) CredentialID() []byte {
+	return a.credentialID
+}
+
+// Create returns the JSON of the PublicKeyCredential navigator.credentials.create()
+// resolves to for the options.
+func (a *Authenticator) Create(options protocol.CredentialCreation) ([]byte, error) {
+	switch id := options.Response.User.ID.(type) {
+	case protocol.URLEncodedBase64:
+		a.userHandle = id
+	case []byte:
+		a.userHandle = id
+	case string:
+		b, err := base64.RawURLEncoding.DecodeString(id)
+		if err != nil {
+			return nil, err
+		}
+		a.userHandle = b
+	default:
+		return nil, errors.New("unsupported user id")
+	}
+
+	clientData, err := a.clientData(protocol.CreateCeremony, options.Response.Challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: a.key.X.FillBytes(make([]byte, 32)),
+		YCoord: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	authData := a.authData(options.Response.RelyingParty.ID, flagUserPresent|flagUserVerified|flagAttestedData)
+	authData = append(authData, make([]byte, 16)...) // AAGUID
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.credentialID)))
+	authData = append(authData, a.credentialID...)
+	authData = append(authData, publicKey...)
+
+	attestation, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]any{
+		"id":    base64.RawURLEncoding.EncodeToString(a.credentialID),
+		"rawId": base64.RawURLEncoding.EncodeToString(a.credentialID),
+		"type":  "public-key",
+		"response": map[string]string{
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(clientData),
+			"attestationObject": base64.RawURLEncoding.EncodeToString(attestation),
+		},
+	})
+}
+
+// Get returns the JSON of the PublicKeyCredential navigator.credentials.get() resolves
+// to for the options.
+func (a *Authenticator) Get(options protocol.CredentialAssertion) ([]byte, error) {
+	if a.userHandle == nil {
+		return nil, errors.New("no credential created")
+	}
+
+	clientData, err := a.clientData(protocol.AssertCeremony, options.Response.Challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	a.Counter++
+	authData := a.authData(options.Response.RelyingPartyID, flagUserPresent|flagUserVerified)
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(authData, clientDataHash[:]...))
+
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]any{
+		"id":    base64.RawURLEncoding.EncodeToString(a.credentialID),
+		"rawId": base64.RawURLEncoding.EncodeToString(a.credentialID),
+		"type":  "public-key",
+		"response": map[string]string{
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(clientData),
+			"authenticatorData": base64.RawURLEncoding.EncodeToString(authData),
+			"signature":         base64.RawURLEncoding.EncodeToString(signature),
+			"userHandle":        base64.RawURLEncoding.EncodeToString(a.userHandle),
+		},
+	})
+}
+
+func (a *Authenticator) clientData(ceremony protocol.CeremonyType, challenge protocol.URLEncodedBase64) ([]byte, error) {
+	return json.Marshal(map[string]string{
+		"type":      string(ceremony),
+		"challenge": base64.RawURLEncoding.EncodeToString(challenge),
+		"origin":    a.origin,
+	})
+}
+
+func (a *Authenticator) authData(rpID string, flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags)
+	return binary.BigEndian.AppendUint32(data, a.Counter)
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
+	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+)
+
+// Permission is an action a member can perform within their active group. Permissions are ordered,
+// a role that grants a permission also grants every permission below it.
+type Permission int
+
+const (
+	// PermissionRead allows viewing the group's inventory.
+	PermissionRead Permission = iota
+	// PermissionMaintenance allows adding maintenance entries to items.
+	PermissionMaintenance
+	// PermissionWrite allows creating, updating and deleting items, locations, labels and attachments.
+	PermissionWrite
+	// PermissionManage allows managing the group's settings, invitations and members.
+	PermissionManage
+)
+
+// rolePermissions maps a group role to the highest permission it grants.
+var rolePermissions = map[groupmembership.Role]Permission{
+	groupmembership.RoleViewer: PermissionMaintenance,
+	groupmembership.RoleEditor: PermissionWrite,
+	groupmembership.RoleOwner:  PermissionManage,
+}
+
+// Can reports whether the acting user's role in the active group grants the permission.
+func (c Context) Can(p Permission) bool {
+	if c.User == nil {
+		return false
+	}
+
+	granted, ok := rolePermissions[groupmembership.Role(c.User.Role)]
+	return ok && p <= granted
+}
+
+// Authorize returns a forbidden error when the acting user's group role does not grant
+// the permission.
+func (c Context) Authorize(p Permission) error {
+	if !c.Can(p) {
+		return validate.NewForbiddenError()
+	}
+
+	return nil
+}
+
+// AuthorizeSuperuser returns a forbidden error when the acting user is not a superuser of
+// the instance.
+func (c Context) AuthorizeSuperuser() error {
+	if c.User == nil || !c.User.IsSuperuser {
+		return validate.NewForbiddenError()
+	}
+
+	return nil
+}
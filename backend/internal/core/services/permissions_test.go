@@ -0,0 +1,84 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInventoryServices_RequireWrite(t *testing.T) {
+	location, err := tSvc.Locations.Create(tCtx, repo.LocationCreate{Name: fk.Str(10)})
+	require.NoError(t, err)
+
+	label, err := tSvc.Labels.Create(tCtx, repo.LabelCreate{Name: fk.Str(10)})
+	require.NoError(t, err)
+
+	item, err := tSvc.Items.Create(tCtx, repo.ItemCreate{Name: fk.Str(10), LocationID: location.ID})
+	require.NoError(t, err)
+
+	viewer := tUser
+	viewer.Role = groupmembership.RoleViewer.String()
+	ctx := tCtx
+	ctx.User = &viewer
+
+	calls := map[string]func() error{
+		"item update": func() error {
+			_, err := tSvc.Items.Update(ctx, repo.ItemUpdate{ID: item.ID, Name: "changed", LocationID: location.ID})
+			return err
+		},
+		"item patch": func() error {
+			_, err := tSvc.Items.Patch(ctx, item.ID, repo.ItemPatch{ID: item.ID})
+			return err
+		},
+		"item delete": func() error { return tSvc.Items.Delete(ctx, item.ID) },
+		"item restore": func() error {
+			_, err := tSvc.Items.Restore(ctx, item.ID)
+			return err
+		},
+		"location create": func() error {
+			_, err := tSvc.Locations.Create(ctx, repo.LocationCreate{Name: fk.Str(10)})
+			return err
+		},
+		"location update": func() error {
+			_, err := tSvc.Locations.Update(ctx, repo.LocationUpdate{ID: location.ID, Name: "changed"})
+			return err
+		},
+		"location delete": func() error { return tSvc.Locations.Delete(ctx, location.ID) },
+		"location restore": func() error {
+			_, err := tSvc.Locations.Restore(ctx, location.ID)
+			return err
+		},
+		"label create": func() error {
+			_, err := tSvc.Labels.Create(ctx, repo.LabelCreate{Name: fk.Str(10)})
+			return err
+		},
+		"label update": func() error {
+			_, err := tSvc.Labels.Update(ctx, repo.LabelUpdate{ID: label.ID, Name: "changed"})
+			return err
+		},
+		"label delete":      func() error { return tSvc.Labels.Delete(ctx, label.ID) },
+		"attachment delete": func() error { return tSvc.Items.AttachmentDelete(ctx, item.ID, item.ID) },
+	}
+
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			assert.True(t, validate.IsForbiddenError(call()))
+		})
+	}
+
+	// Nothing was changed by the viewer.
+	got, err := tRepos.Items.GetOneByGroup(tCtx, tGroup.ID, item.ID)
+	require.NoError(t, err)
+	assert.Equal(t, item.Name, got.Name)
+
+	_, err = tRepos.Locations.GetOneByGroup(tCtx, tGroup.ID, location.ID)
+	require.NoError(t, err)
+
+	gotLabel, err := tRepos.Labels.GetOneByGroup(tCtx, tGroup.ID, label.ID)
+	require.NoError(t, err)
+	assert.Equal(t, label.Name, gotLabel.Name)
+}
@@ -0,0 +1,187 @@
+package services
+
+import (
+	"sync/atomic"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/usertoken"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+	"github.com/hay-kot/homebox/backend/pkgs/hasher"
+	"github.com/rs/zerolog/log"
+)
+
+// AdminService administers the instance, all methods require a superuser.
+type AdminService struct {
+	repos *repo.AllRepos
+	user  *UserService
+	// registration is initialized from the configuration and can be changed at runtime,
+	// changes are lost when the server restarts.
+	registration atomic.Bool
+}
+
+type (
+	// Registration is the runtime setting for new users registering their own group.
+	Registration struct {
+		AllowRegistration bool `json:"allowRegistration"`
+	}
+
+	// AdminPasswordReset describes how the user receives the link to set a new password.
+	// The URL is only returned when no mailer is configured.
+	AdminPasswordReset struct {
+		Mailed bool   `json:"mailed"`
+		URL    string `json:"url,omitempty"`
+	}
+)
+
+// RegistrationAllowed reports if new users can register without an invitation.
+func (svc *AdminService) RegistrationAllowed() bool {
+	return svc.registration.Load()
+}
+
+func (svc *AdminService) GetRegistration(ctx Context) (Registration, error) {
+	if err := ctx.AuthorizeSuperuser(); err != nil {
+		return Registration{}, err
+	}
+
+	return Registration{AllowRegistration: svc.RegistrationAllowed()}, nil
+}
+
+// SetRegistration allows or disallows registrations until the server restarts.
+func (svc *AdminService) SetRegistration(ctx Context, data Registration) (Registration, error) {
+	if err := ctx.AuthorizeSuperuser(); err != nil {
+		return Registration{}, err
+	}
+
+	svc.registration.Store(data.AllowRegistration)
+
+	log.Info().
+		Str("user", ctx.UID.String()).
+		Bool("allow_registration", data.AllowRegistration).
+		Msg("registration setting changed")
+
+	return data, nil
+}
+
+// GetGroups returns all groups of the instance with their usage.
+func (svc *AdminService) GetGroups(ctx Context) ([]repo.GroupUsage, error) {
+	if err := ctx.AuthorizeSuperuser(); err != nil {
+		return nil, err
+	}
+
+	return svc.repos.Groups.GetAllUsage(ctx)
+}
+
+// GetUsers returns all accounts of the instance with their groups and activity.
+func (svc *AdminService) GetUsers(ctx Context) ([]repo.UserUsage, error) {
+	if err := ctx.AuthorizeSuperuser(); err != nil {
+		return nil, err
+	}
+
+	return svc.repos.Users.GetAllUsage(ctx)
+}
+
+// otherUser authorizes the superuser to act on the account of another user.
+func (svc *AdminService) otherUser(ctx Context, ID uuid.UUID) (repo.UserOut, error) {
+	if err := ctx.AuthorizeSuperuser(); err != nil {
+		return repo.UserOut{}, err
+	}
+
+	if ID == ctx.UID {
+		return repo.UserOut{}, validate.NewFieldErrors(
+			validate.NewFieldError("id", "cannot change your own account"),
+		)
+	}
+
+	return svc.repos.Users.GetOneID(ctx, ID)
+}
+
+// SetUserDisabled disables or enables the account of another user. Disabling logs the
+// user out, their API keys are rejected until the account is enabled again.
+func (svc *AdminService) SetUserDisabled(ctx Context, ID uuid.UUID, disabled bool) error {
+	if _, err := svc.otherUser(ctx, ID); err != nil {
+		return err
+	}
+
+	err := svc.repos.Users.SetDisabled(ctx, ID, disabled)
+	if err != nil {
+		return err
+	}
+
+	if disabled {
+		_, err = svc.repos.AuthTokens.DeleteSessions(ctx, ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Info().
+		Str("user", ctx.UID.String()).
+		Str("target", ID.String()).
+		Bool("disabled", disabled).
+		Msg("user account disabled state changed")
+
+	return nil
+}
+
+// DeleteUser deletes the account of another user.
+func (svc *AdminService) DeleteUser(ctx Context, ID uuid.UUID) error {
+	if _, err := svc.otherUser(ctx, ID); err != nil {
+		return err
+	}
+
+	log.Info().
+		Str("user", ctx.UID.String()).
+		Str("target", ID.String()).
+		Msg("user account deleted")
+
+	return svc.repos.Users.Delete(ctx, ID)
+}
+
+// ResetUserPassword replaces the password of another user with a random one, logs them
+// out and revokes their API keys, as the account may be compromised. A password reset
+// link is mailed to the user, without a mailer the link is returned so it can be passed
+// on.
+func (svc *AdminService) ResetUserPassword(ctx Context, ID uuid.UUID) (AdminPasswordReset, error) {
+	usr, err := svc.otherUser(ctx, ID)
+	if err != nil {
+		return AdminPasswordReset{}, err
+	}
+
+	hashed, err := hasher.HashPassword(hasher.GenerateToken().Raw)
+	if err != nil {
+		return AdminPasswordReset{}, err
+	}
+
+	err = svc.repos.Users.ChangePassword(ctx, ID, hashed)
+	if err != nil {
+		return AdminPasswordReset{}, err
+	}
+
+	_, err = svc.repos.AuthTokens.DeleteSessions(ctx, ID)
+	if err != nil {
+		return AdminPasswordReset{}, err
+	}
+
+	_, err = svc.repos.AuthTokens.DeleteAPIKeys(ctx, ID)
+	if err != nil {
+		return AdminPasswordReset{}, err
+	}
+
+	log.Info().
+		Str("user", ctx.UID.String()).
+		Str("target", ID.String()).
+		Msg("password reset forced")
+
+	if svc.user.mailerReady() {
+		err = svc.user.sendTokenMail(ctx, usr, usertoken.PurposePasswordReset)
+		return AdminPasswordReset{Mailed: err == nil}, err
+	}
+
+	link, err := svc.user.tokenLink(ctx, ID, usertoken.PurposePasswordReset)
+	if err != nil {
+		return AdminPasswordReset{}, err
+	}
+
+	return AdminPasswordReset{URL: link}, nil
+}
@@ -2,80 +2,343 @@ package services
 
 import (
 	"context"
-	"strings"
+	"errors"
 	"time"
 
-	"github.com/containrrr/shoutrrr"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/core/services/notifications"
 	"github.com/hay-kot/homebox/backend/internal/data/repo"
 	"github.com/hay-kot/homebox/backend/internal/data/types"
 	"github.com/rs/zerolog/log"
 )
 
+const (
+	// deliveryAttempts is the number of times a message is sent to a notifier before
+	// the delivery is given up.
+	deliveryAttempts = 3
+	// notifierMaxFailures is the number of deliveries that may fail in a row before the
+	// notifier is disabled.
+	notifierMaxFailures = 5
+)
+
 type BackgroundService struct {
-	repos *repo.AllRepos
+	repos   *repo.AllRepos
+	baseURL string
+
+	// send delivers a message to a notifier URL.
+	send func(url, message string) error
+	// backoff is the delay before the first retry of a failed delivery, it doubles
+	// with every further attempt.
+	backoff time.Duration
 }
 
+// SendNotifiersToday sends the notifications of every group that are due, see
+// SendNotifiersAt.
 func (svc *BackgroundService) SendNotifiersToday(ctx context.Context) error {
+	return svc.SendNotifiersAt(ctx, time.Now())
+}
+
+// SendNotifiersAt sends the notifications of every group whose notification time has
+// passed at the given time in the timezone of the group. Each group is notified at most
+// once per local day, or once per week for weekly digests. A group that fails does not
+// stop the remaining groups, all errors are returned together.
+func (svc *BackgroundService) SendNotifiersAt(ctx context.Context, now time.Time) error {
 	// Get All Groups
 	groups, err := svc.repos.Groups.GetAllGroups(ctx)
 	if err != nil {
 		return err
 	}
 
-	today := types.DateFromTime(time.Now())
-
+	var errs []error
 	for i := range groups {
 		group := groups[i]
 
-		entries, err := svc.repos.MaintEntry.GetScheduled(ctx, group.ID, today)
-		if err != nil {
-			return err
+		today, due := notificationsDue(group, now)
+		if !due {
+			continue
 		}
 
-		if len(entries) == 0 {
-			log.Debug().
-				Str("group_name", group.Name).
-				Str("group_id", group.ID.String()).
-				Msg("No scheduled maintenance for today")
-			continue
+		err := svc.sendGroupNotifications(ctx, group, today)
+		if err == nil {
+			err = svc.repos.Groups.GroupNotified(ctx, group.ID, today)
 		}
 
-		notifiers, err := svc.repos.Notifiers.GetByGroup(ctx, group.ID)
 		if err != nil {
-			return err
+			log.Error().
+				Err(err).
+				Str("group_id", group.ID.String()).
+				Msg("failed to send notifications for group")
+			errs = append(errs, err)
 		}
+	}
 
-		urls := make([]string, len(notifiers))
-		for i := range notifiers {
-			urls[i] = notifiers[i].URL
-		}
+	return errors.Join(errs...)
+}
+
+// notificationsDue returns the current date of the group and whether its notifications
+// are due at the given time.
+func notificationsDue(group repo.Group, now time.Time) (types.Date, bool) {
+	local := now.In(group.Location())
+	today := types.DateFromTime(local)
+
+	if group.NotifiedOn == today {
+		return today, false
+	}
+
+	at, err := time.Parse("15:04", group.NotifyTime)
+	if err != nil {
+		at = time.Date(0, 1, 1, 8, 0, 0, 0, time.UTC)
+	}
+
+	if local.Hour()*60+local.Minute() < at.Hour()*60+at.Minute() {
+		return today, false
+	}
+
+	if group.Digest == "weekly" && local.Weekday() != time.Monday {
+		return today, false
+	}
+
+	return today, true
+}
 
-		bldr := strings.Builder{}
+func (svc *BackgroundService) sendGroupNotifications(ctx context.Context, group repo.Group, today types.Date) error {
+	// A weekly digest lists the maintenance of the whole week, everything else only
+	// covers the current day.
+	until := types.DateFromTime(today.Time().AddDate(0, 0, 1))
+	if group.Digest == "weekly" {
+		until = types.DateFromTime(today.Time().AddDate(0, 0, 7))
+	}
+
+	entries, err := svc.repos.MaintEntry.GetScheduledBetween(ctx, group.ID, today, until)
+	if err != nil {
+		return err
+	}
+
+	upcoming, err := svc.repos.MaintEntry.GetReminders(ctx, group.ID, today)
+	if err != nil {
+		return err
+	}
+
+	reminders, err := svc.repos.NotificationRules.Pending(ctx, group.ID, today)
+	if err != nil {
+		return err
+	}
 
-		bldr.WriteString("Homebox Maintenance for (")
-		bldr.WriteString(today.String())
-		bldr.WriteString("):\n")
+	if len(entries) == 0 && len(upcoming) == 0 && len(reminders) == 0 {
+		log.Debug().
+			Str("group_name", group.Name).
+			Str("group_id", group.ID.String()).
+			Msg("No scheduled maintenance or reminders for today")
+		return nil
+	}
+
+	notifiers, err := svc.repos.Notifiers.GetActiveByGroup(ctx, group.ID)
+	if err != nil {
+		return err
+	}
+
+	data := notifications.Data{
+		Group:       group.Name,
+		Date:        today,
+		BaseURL:     svc.baseURL,
+		Maintenance: svc.maintenanceData(entries),
+		Upcoming:    svc.maintenanceData(upcoming),
+		Reminders:   svc.reminderData(reminders),
+	}
 
-		for i := range entries {
-			entry := entries[i]
-			bldr.WriteString(" - ")
-			bldr.WriteString(entry.Name)
-			bldr.WriteString("\n")
+	var kinds []notifications.Type
+	switch {
+	case group.Digest == "daily" || group.Digest == "weekly":
+		data.Digest = group.Digest
+		kinds = append(kinds, notifications.TypeDigest)
+	default:
+		if len(entries) > 0 || len(upcoming) > 0 {
+			kinds = append(kinds, notifications.TypeMaintenance)
 		}
+		if len(reminders) > 0 {
+			kinds = append(kinds, notifications.TypeReminder)
+		}
+	}
 
-		var sendErrs []error
-		for i := range urls {
-			err := shoutrrr.Send(urls[i], bldr.String())
+	remindersSent := false
 
+	// Errors of a notifier are logged and the other notifiers are still notified,
+	// returning would skip them and send the message again on the next run to the
+	// notifiers that already received it.
+	for i := range notifiers {
+		for _, typ := range kinds {
+			msg, err := svc.render(ctx, group.ID, notifiers[i].ID, typ, data)
 			if err != nil {
-				sendErrs = append(sendErrs, err)
+				log.Error().
+					Err(err).
+					Str("notifier_id", notifiers[i].ID.String()).
+					Str("type", string(typ)).
+					Msg("failed to render notification")
+				continue
 			}
-		}
 
-		if len(sendErrs) > 0 {
-			return sendErrs[0]
+			ok, err := svc.deliver(ctx, notifiers[i], typ, msg)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+
+				log.Error().
+					Err(err).
+					Str("notifier_id", notifiers[i].ID.String()).
+					Str("type", string(typ)).
+					Msg("failed to record notification delivery")
+			}
+
+			if ok && typ != notifications.TypeMaintenance {
+				remindersSent = true
+			}
 		}
 	}
 
+	// Reminders are only recorded once they reached at least one notifier, so
+	// they are retried on the next run otherwise.
+	if remindersSent && len(reminders) > 0 {
+		return svc.repos.NotificationRules.Record(ctx, group.ID, reminders)
+	}
+
 	return nil
 }
+
+// deliver sends the message to the notifier, retrying with an exponential backoff. Every
+// attempt is recorded in the delivery log of the notifier. The returned error is only
+// set when the result could not be stored, a failed delivery is reported as not ok.
+func (svc *BackgroundService) deliver(ctx context.Context, n repo.NotifierOut, typ notifications.Type, msg string) (bool, error) {
+	ok := false
+	delay := svc.backoff
+
+	for attempt := 1; attempt <= deliveryAttempts && !ok; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				return false, ctx.Err()
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+
+		errMsg := ""
+		if err := svc.send(n.URL, msg); err != nil {
+			errMsg = err.Error()
+
+			log.Warn().
+				Err(err).
+				Str("notifier_id", n.ID.String()).
+				Int("attempt", attempt).
+				Msg("failed to send notification")
+		} else {
+			ok = true
+		}
+
+		err := svc.repos.Notifiers.RecordDelivery(ctx, repo.NotifierDeliveryCreate{
+			NotifierID: n.ID,
+			Type:       string(typ),
+			Attempt:    attempt,
+			Error:      errMsg,
+		})
+		if err != nil {
+			return ok, err
+		}
+	}
+
+	updated, err := svc.repos.Notifiers.ReportDelivery(ctx, n.ID, ok, notifierMaxFailures)
+	if err != nil {
+		return ok, err
+	}
+
+	if n.IsActive && !updated.IsActive {
+		log.Warn().
+			Str("notifier_id", n.ID.String()).
+			Int("failures", updated.FailureCount).
+			Msg("notifier disabled after repeated failures")
+	}
+
+	return ok, nil
+}
+
+// Preview renders a notification with sample data. When body is empty the template
+// that would be used for the notifier, or for the group when no notifier is given,
+// is rendered instead.
+func (svc *BackgroundService) Preview(ctx context.Context, GID uuid.UUID, notifierID uuid.UUID, typ notifications.Type, body string) (string, error) {
+	group, err := svc.repos.Groups.GroupByID(ctx, GID)
+	if err != nil {
+		return "", err
+	}
+
+	data := notifications.Sample(group.Name, svc.baseURL, types.Today(group.Location()))
+	if group.Digest == "weekly" {
+		data.Digest = group.Digest
+	}
+
+	if body != "" {
+		return notifications.Render(body, data)
+	}
+
+	return svc.render(ctx, GID, notifierID, typ, data)
+}
+
+// render renders the template of the notifier for the notification type, a custom
+// template that cannot be loaded or fails to render falls back to the default template
+// so that the notification is still sent.
+func (svc *BackgroundService) render(ctx context.Context, GID, notifierID uuid.UUID, typ notifications.Type, data notifications.Data) (string, error) {
+	body, err := svc.repos.NotificationTemplates.Resolve(ctx, GID, notifierID, string(typ))
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("group_id", GID.String()).
+			Str("type", string(typ)).
+			Msg("failed to load notification template, using the default template")
+		body = ""
+	}
+
+	if body != "" {
+		msg, err := notifications.Render(body, data)
+		if err == nil {
+			return msg, nil
+		}
+
+		log.Warn().
+			Err(err).
+			Str("group_id", GID.String()).
+			Str("type", string(typ)).
+			Msg("failed to render notification template, using the default template")
+	}
+
+	body, err = notifications.Default(typ)
+	if err != nil {
+		return "", err
+	}
+
+	return notifications.Render(body, data)
+}
+
+func (svc *BackgroundService) maintenanceData(entries []repo.MaintenanceEntryWithDetails) []notifications.Maintenance {
+	out := make([]notifications.Maintenance, len(entries))
+	for i, e := range entries {
+		out[i] = notifications.Maintenance{
+			Name:        e.Name,
+			Description: e.Description,
+			DueDate:     e.ScheduledDate,
+			Item:        notifications.NewItem(svc.baseURL, e.ItemID, e.ItemName, e.LocationID, e.LocationName),
+		}
+	}
+	return out
+}
+
+func (svc *BackgroundService) reminderData(reminders []repo.NotificationReminder) []notifications.Reminder {
+	out := make([]notifications.Reminder, len(reminders))
+	for i, r := range reminders {
+		out[i] = notifications.Reminder{
+			Title:    r.Title,
+			DueDate:  r.DueDate,
+			DaysLeft: r.DaysLeft,
+			Item:     notifications.NewItem(svc.baseURL, r.ItemID, r.ItemName, r.LocationID, r.LocationName),
+		}
+	}
+	return out
+}
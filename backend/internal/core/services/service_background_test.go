@@ -0,0 +1,349 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackgroundService_Deliveries(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "notify-"+fk.Str(6))
+	require.NoError(t, err)
+
+	u, err := tRepos.Users.Create(ctx, repo.UserCreate{
+		Name:     fk.Str(10),
+		Email:    fk.Email(),
+		Password: fk.Str(10),
+		GroupID:  g.ID,
+	})
+	require.NoError(t, err)
+
+	loc, err := tRepos.Locations.Create(ctx, g.ID, repo.LocationCreate{Name: "Garage"})
+	require.NoError(t, err)
+
+	itm, err := tRepos.Items.Create(ctx, g.ID, repo.ItemCreate{Name: "Lawn Mower", LocationID: loc.ID})
+	require.NoError(t, err)
+
+	today := types.DateFromString("2024-03-01")
+
+	_, err = tRepos.MaintEntry.Create(ctx, itm.ID, repo.MaintenanceEntryCreate{
+		Name:          "Sharpen blade",
+		ScheduledDate: today,
+	})
+	require.NoError(t, err)
+
+	good, err := tRepos.Notifiers.Create(ctx, g.ID, u.ID, repo.NotifierCreate{Name: "Good", URL: "generic://good", IsActive: true})
+	require.NoError(t, err)
+
+	bad, err := tRepos.Notifiers.Create(ctx, g.ID, u.ID, repo.NotifierCreate{Name: "Bad", URL: "generic://fail", IsActive: true})
+	require.NoError(t, err)
+
+	var (
+		mu   sync.Mutex
+		sent []string
+	)
+
+	svc := &BackgroundService{
+		repos:   tRepos,
+		baseURL: "https://homebox.example.com",
+		backoff: time.Millisecond,
+		send: func(url, message string) error {
+			if strings.Contains(url, "fail") {
+				return errors.New("connection refused")
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			sent = append(sent, message)
+			return nil
+		},
+	}
+
+	deliveries := func(id uuid.UUID) repo.PaginationResult[repo.NotifierDeliveryOut] {
+		t.Helper()
+		res, err := tRepos.Notifiers.GetDeliveries(ctx, u.ID, id, repo.NotifierDeliveryQuery{})
+		require.NoError(t, err)
+		return res
+	}
+
+	// Failing notifiers don't affect the delivery to the others
+	require.NoError(t, svc.sendGroupNotifications(ctx, g, today))
+
+	require.Len(t, sent, 1)
+	assert.Contains(t, sent[0], "Sharpen blade: Lawn Mower (Garage) https://homebox.example.com/item/"+itm.ID.String())
+
+	res := deliveries(good.ID)
+	require.Equal(t, 1, res.Total)
+	assert.Equal(t, "success", res.Items[0].Status)
+	assert.Equal(t, "maintenance", res.Items[0].Type)
+
+	// Failed deliveries are retried and every attempt is recorded
+	res = deliveries(bad.ID)
+	require.Equal(t, deliveryAttempts, res.Total)
+	for _, d := range res.Items {
+		assert.Equal(t, "failed", d.Status)
+		assert.Equal(t, "connection refused", d.Error)
+	}
+
+	// The notifier is disabled once too many deliveries failed in a row
+	for i := 1; i < notifierMaxFailures; i++ {
+		require.NoError(t, svc.sendGroupNotifications(ctx, g, today))
+	}
+
+	notifiers, err := tRepos.Notifiers.GetByUser(ctx, u.ID)
+	require.NoError(t, err)
+	require.Len(t, notifiers, 2)
+
+	for _, n := range notifiers {
+		switch n.ID {
+		case good.ID:
+			assert.True(t, n.IsActive)
+			assert.Equal(t, 0, n.FailureCount)
+		case bad.ID:
+			assert.False(t, n.IsActive)
+			assert.Equal(t, notifierMaxFailures, n.FailureCount)
+		}
+	}
+
+	require.NoError(t, svc.sendGroupNotifications(ctx, g, today))
+	assert.Equal(t, notifierMaxFailures*deliveryAttempts, deliveries(bad.ID).Total)
+	assert.Len(t, sent, notifierMaxFailures+1)
+
+	// Enabling the notifier again resets the failures
+	updated, err := tRepos.Notifiers.Update(ctx, u.ID, bad.ID, repo.NotifierUpdate{Name: bad.Name, IsActive: true})
+	require.NoError(t, err)
+	assert.Equal(t, 0, updated.FailureCount)
+}
+
+func TestBackgroundService_NotifierErrors(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "notify-"+fk.Str(6))
+	require.NoError(t, err)
+
+	u, err := tRepos.Users.Create(ctx, repo.UserCreate{
+		Name:     fk.Str(10),
+		Email:    fk.Email(),
+		Password: fk.Str(10),
+		GroupID:  g.ID,
+	})
+	require.NoError(t, err)
+
+	loc, err := tRepos.Locations.Create(ctx, g.ID, repo.LocationCreate{Name: "Garage"})
+	require.NoError(t, err)
+
+	itm, err := tRepos.Items.Create(ctx, g.ID, repo.ItemCreate{Name: "Lawn Mower", LocationID: loc.ID})
+	require.NoError(t, err)
+
+	_, err = tRepos.MaintEntry.Create(ctx, itm.ID, repo.MaintenanceEntryCreate{
+		Name:          "Sharpen blade",
+		ScheduledDate: types.DateFromString("2030-01-07"),
+	})
+	require.NoError(t, err)
+
+	// Notifiers are notified in the order of their names.
+	deleted, err := tRepos.Notifiers.Create(ctx, g.ID, u.ID, repo.NotifierCreate{Name: "A", URL: "generic://deleted-" + g.ID.String(), IsActive: true})
+	require.NoError(t, err)
+
+	broken, err := tRepos.Notifiers.Create(ctx, g.ID, u.ID, repo.NotifierCreate{Name: "B", URL: "generic://broken-" + g.ID.String(), IsActive: true})
+	require.NoError(t, err)
+
+	_, err = tRepos.Notifiers.Create(ctx, g.ID, u.ID, repo.NotifierCreate{Name: "C", URL: "generic://ok-" + g.ID.String(), IsActive: true})
+	require.NoError(t, err)
+
+	// Passes validation against the sample data, but fails without reminders.
+	_, err = tRepos.NotificationTemplates.Create(ctx, g.ID, repo.NotificationTemplateCreate{
+		Type:       "maintenance",
+		NotifierID: &broken.ID,
+		Body:       "{{ (index .Reminders 0).Title }}",
+	})
+	require.NoError(t, err)
+
+	sent := map[string]string{}
+
+	svc := &BackgroundService{
+		repos: tRepos,
+		send: func(url, message string) error {
+			if !strings.HasSuffix(url, g.ID.String()) {
+				return nil
+			}
+
+			// The notifier is deleted while it is notified, storing the delivery fails.
+			if strings.HasPrefix(url, "generic://deleted") {
+				require.NoError(t, tRepos.Notifiers.Delete(ctx, u.ID, deleted.ID))
+			}
+
+			sent[url] = message
+			return nil
+		},
+	}
+
+	require.NoError(t, svc.SendNotifiersAt(ctx, time.Date(2030, 1, 7, 12, 0, 0, 0, time.UTC)))
+
+	require.Len(t, sent, 3)
+	for _, msg := range sent {
+		assert.Contains(t, msg, "Sharpen blade: Lawn Mower (Garage)")
+	}
+
+	// The group is not notified again on the same day.
+	g, err = tRepos.Groups.GroupByID(ctx, g.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.DateFromString("2030-01-07"), g.NotifiedOn)
+}
+
+func TestNotificationsDue(t *testing.T) {
+	// 2024-03-03 23:30 UTC is Monday 2024-03-04 08:30 in Tokyo
+	now := time.Date(2024, 3, 3, 23, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		group repo.Group
+		today string
+		due   bool
+	}{
+		{
+			name:  "after notify time",
+			group: repo.Group{Timezone: "Asia/Tokyo", NotifyTime: "08:00"},
+			today: "2024-03-04",
+			due:   true,
+		},
+		{
+			name:  "before notify time",
+			group: repo.Group{Timezone: "Asia/Tokyo", NotifyTime: "09:00"},
+			today: "2024-03-04",
+			due:   false,
+		},
+		{
+			name:  "already notified",
+			group: repo.Group{Timezone: "Asia/Tokyo", NotifyTime: "08:00", NotifiedOn: types.DateFromString("2024-03-04")},
+			today: "2024-03-04",
+			due:   false,
+		},
+		{
+			name:  "notified yesterday",
+			group: repo.Group{Timezone: "Asia/Tokyo", NotifyTime: "08:00", NotifiedOn: types.DateFromString("2024-03-03")},
+			today: "2024-03-04",
+			due:   true,
+		},
+		{
+			name:  "weekly digest on monday",
+			group: repo.Group{Timezone: "Asia/Tokyo", NotifyTime: "08:00", Digest: "weekly"},
+			today: "2024-03-04",
+			due:   true,
+		},
+		{
+			name:  "weekly digest on sunday",
+			group: repo.Group{Timezone: "UTC", NotifyTime: "08:00", Digest: "weekly"},
+			today: "2024-03-03",
+			due:   false,
+		},
+		{
+			name:  "other timezone",
+			group: repo.Group{Timezone: "America/New_York", NotifyTime: "08:00"},
+			today: "2024-03-03",
+			due:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			today, due := notificationsDue(tt.group, now)
+			assert.Equal(t, tt.today, today.String())
+			assert.Equal(t, tt.due, due)
+		})
+	}
+}
+
+func TestBackgroundService_SendNotifiersAt(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "digest-"+fk.Str(6))
+	require.NoError(t, err)
+
+	tz, at, digest := "Europe/Berlin", "07:30", "weekly"
+	g, err = tRepos.Groups.GroupUpdate(ctx, g.ID, repo.GroupUpdate{
+		Name:       g.Name,
+		Currency:   g.Currency,
+		Timezone:   &tz,
+		NotifyTime: &at,
+		Digest:     &digest,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/Berlin", g.Timezone)
+
+	u, err := tRepos.Users.Create(ctx, repo.UserCreate{
+		Name:     fk.Str(10),
+		Email:    fk.Email(),
+		Password: fk.Str(10),
+		GroupID:  g.ID,
+	})
+	require.NoError(t, err)
+
+	loc, err := tRepos.Locations.Create(ctx, g.ID, repo.LocationCreate{Name: "Basement"})
+	require.NoError(t, err)
+
+	itm, err := tRepos.Items.Create(ctx, g.ID, repo.ItemCreate{Name: "Furnace", LocationID: loc.ID})
+	require.NoError(t, err)
+
+	// Within the week of the digest
+	_, err = tRepos.MaintEntry.Create(ctx, itm.ID, repo.MaintenanceEntryCreate{
+		Name:          "Replace filter",
+		ScheduledDate: types.DateFromString("2030-01-10"),
+	})
+	require.NoError(t, err)
+
+	// After the week of the digest
+	_, err = tRepos.MaintEntry.Create(ctx, itm.ID, repo.MaintenanceEntryCreate{
+		Name:          "Clean burner",
+		ScheduledDate: types.DateFromString("2030-01-14"),
+	})
+	require.NoError(t, err)
+
+	_, err = tRepos.Notifiers.Create(ctx, g.ID, u.ID, repo.NotifierCreate{Name: "Chat", URL: "generic://digest", IsActive: true})
+	require.NoError(t, err)
+
+	var sent []string
+
+	svc := &BackgroundService{
+		repos: tRepos,
+		send: func(url, message string) error {
+			// Other groups of the test database may be notified as well
+			if url == "generic://digest" {
+				sent = append(sent, message)
+			}
+			return nil
+		},
+	}
+
+	// Monday 2030-01-07 07:00 in Berlin, before the notification time
+	require.NoError(t, svc.SendNotifiersAt(ctx, time.Date(2030, 1, 7, 6, 0, 0, 0, time.UTC)))
+	assert.Empty(t, sent)
+
+	// Monday 2030-01-07 07:45 in Berlin
+	require.NoError(t, svc.SendNotifiersAt(ctx, time.Date(2030, 1, 7, 6, 45, 0, 0, time.UTC)))
+	require.Len(t, sent, 1)
+	assert.Equal(t, "Homebox Weekly Digest for (2030-01-07):\nMaintenance:\n - Replace filter: Furnace (due 2030-01-10)", sent[0])
+
+	// Only once per day
+	require.NoError(t, svc.SendNotifiersAt(ctx, time.Date(2030, 1, 7, 12, 0, 0, 0, time.UTC)))
+	assert.Len(t, sent, 1)
+
+	// Weekly digests skip the rest of the week
+	require.NoError(t, svc.SendNotifiersAt(ctx, time.Date(2030, 1, 10, 12, 0, 0, 0, time.UTC)))
+	assert.Len(t, sent, 1)
+
+	require.NoError(t, svc.SendNotifiersAt(ctx, time.Date(2030, 1, 14, 12, 0, 0, 0, time.UTC)))
+	require.Len(t, sent, 2)
+	assert.Contains(t, sent[1], "Clean burner")
+}
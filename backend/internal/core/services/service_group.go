@@ -4,7 +4,10 @@ import (
 	"errors"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
 	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/sys/validate"
 	"github.com/hay-kot/homebox/backend/pkgs/hasher"
 )
 
@@ -12,7 +15,15 @@ type GroupService struct {
 	repos *repo.AllRepos
 }
 
+type MemberRoleUpdate struct {
+	Role string `json:"role" validate:"required,oneof=viewer editor owner"`
+}
+
 func (svc *GroupService) UpdateGroup(ctx Context, data repo.GroupUpdate) (repo.Group, error) {
+	if err := ctx.Authorize(PermissionManage); err != nil {
+		return repo.Group{}, err
+	}
+
 	if data.Name == "" {
 		data.Name = ctx.User.GroupName
 	}
@@ -25,6 +36,10 @@ func (svc *GroupService) UpdateGroup(ctx Context, data repo.GroupUpdate) (repo.G
 }
 
 func (svc *GroupService) NewInvitation(ctx Context, uses int, expiresAt time.Time) (string, error) {
+	if err := ctx.Authorize(PermissionManage); err != nil {
+		return "", err
+	}
+
 	token := hasher.GenerateToken()
 
 	_, err := svc.repos.Groups.InvitationCreate(ctx, ctx.GID, repo.GroupInvitationCreate{
@@ -38,3 +53,123 @@ func (svc *GroupService) NewInvitation(ctx Context, uses int, expiresAt time.Tim
 
 	return token.Raw, nil
 }
+
+// UpdateMemberRole changes the role of another member of the acting user's group. Only
+// owners can change roles, and owners cannot change their own role or the role of
+// another owner.
+func (svc *GroupService) UpdateMemberRole(ctx Context, memberID uuid.UUID, data MemberRoleUpdate) (repo.UserOut, error) {
+	if err := ctx.Authorize(PermissionManage); err != nil {
+		return repo.UserOut{}, err
+	}
+
+	if memberID == ctx.UID {
+		return repo.UserOut{}, validate.NewFieldErrors(
+			validate.NewFieldError("id", "cannot change your own role"),
+		)
+	}
+
+	member, err := svc.repos.Users.GetMember(ctx, ctx.GID, memberID)
+	if err != nil {
+		return repo.UserOut{}, err
+	}
+
+	if member.IsOwner {
+		return repo.UserOut{}, validate.NewFieldErrors(
+			validate.NewFieldError("id", "cannot change the role of another owner"),
+		)
+	}
+
+	err = svc.repos.Memberships.UpdateRole(ctx, ctx.GID, memberID, groupmembership.Role(data.Role))
+	if err != nil {
+		return repo.UserOut{}, err
+	}
+
+	return svc.repos.Users.GetMember(ctx, ctx.GID, memberID)
+}
+
+// GetMembers returns the members of the acting user's group with their roles.
+func (svc *GroupService) GetMembers(ctx Context) ([]repo.UserOut, error) {
+	if err := ctx.Authorize(PermissionManage); err != nil {
+		return nil, err
+	}
+
+	return svc.repos.Users.GetMembers(ctx, ctx.GID)
+}
+
+// RemoveMember removes another member from the acting user's group, their account is
+// never deleted. Owners cannot be removed, and neither can members that do not belong to
+// any other group since they would have no group left to use.
+func (svc *GroupService) RemoveMember(ctx Context, memberID uuid.UUID) error {
+	if err := ctx.Authorize(PermissionManage); err != nil {
+		return err
+	}
+
+	if memberID == ctx.UID {
+		return validate.NewFieldErrors(
+			validate.NewFieldError("id", "cannot remove yourself from the group"),
+		)
+	}
+
+	member, err := svc.repos.Users.GetMember(ctx, ctx.GID, memberID)
+	if err != nil {
+		return err
+	}
+
+	if member.IsOwner {
+		return validate.NewFieldErrors(
+			validate.NewFieldError("id", "cannot remove another owner"),
+		)
+	}
+
+	groups, err := svc.repos.Memberships.GetAll(ctx, memberID)
+	if err != nil {
+		return err
+	}
+
+	if len(groups) == 1 {
+		return validate.NewFieldErrors(
+			validate.NewFieldError("id", "cannot remove a member from their only group"),
+		)
+	}
+
+	return svc.repos.Memberships.Remove(ctx, ctx.GID, memberID)
+}
+
+// TransferOwnership makes another member the owner of the acting user's group, the acting
+// user becomes an editor. The new owner is returned.
+func (svc *GroupService) TransferOwnership(ctx Context, memberID uuid.UUID) (repo.UserOut, error) {
+	if err := ctx.Authorize(PermissionManage); err != nil {
+		return repo.UserOut{}, err
+	}
+
+	if memberID == ctx.UID {
+		return repo.UserOut{}, validate.NewFieldErrors(
+			validate.NewFieldError("id", "cannot transfer ownership to yourself"),
+		)
+	}
+
+	err := svc.repos.Memberships.TransferOwnership(ctx, ctx.GID, ctx.UID, memberID)
+	if err != nil {
+		return repo.UserOut{}, err
+	}
+
+	return svc.repos.Users.GetMember(ctx, ctx.GID, memberID)
+}
+
+// GetInvitations returns the invitations of the acting user's group that can still be used.
+func (svc *GroupService) GetInvitations(ctx Context) ([]repo.GroupInvitation, error) {
+	if err := ctx.Authorize(PermissionManage); err != nil {
+		return nil, err
+	}
+
+	return svc.repos.Groups.InvitationGetAll(ctx, ctx.GID)
+}
+
+// RevokeInvitation deletes an invitation of the acting user's group.
+func (svc *GroupService) RevokeInvitation(ctx Context, ID uuid.UUID) error {
+	if err := ctx.Authorize(PermissionManage); err != nil {
+		return err
+	}
+
+	return svc.repos.Groups.InvitationDelete(ctx, ctx.GID, ID)
+}
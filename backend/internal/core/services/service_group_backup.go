@@ -0,0 +1,231 @@
+package services
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationrule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationtemplate"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+	"github.com/rs/zerolog/log"
+)
+
+// BackupVersion is the version of the backup archive format, it is increased when the
+// format changes in a way older versions can not restore.
+const BackupVersion = 1
+
+// backupManifest is the manifest.json of a backup archive.
+type backupManifest struct {
+	Version    int              `json:"version"`
+	ExportedAt time.Time        `json:"exportedAt"`
+	Group      repo.BackupGroup `json:"group"`
+}
+
+// backupFiles returns the JSON files of a backup archive with the collection of the
+// backup they hold, the same list is used to write and read an archive.
+func backupFiles(b *repo.GroupBackup) []struct {
+	name string
+	v    any
+} {
+	return []struct {
+		name string
+		v    any
+	}{
+		{"members.json", &b.Members},
+		{"labels.json", &b.Labels},
+		{"locations.json", &b.Locations},
+		{"items.json", &b.Items},
+		{"documents.json", &b.Documents},
+		{"maintenance-schedules.json", &b.MaintenanceSchedules},
+		{"maintenance-entries.json", &b.MaintenanceEntries},
+		{"notifiers.json", &b.Notifiers},
+		{"notification-rules.json", &b.NotificationRules},
+		{"notification-templates.json", &b.NotificationTemplates},
+		{"notification-reminders.json", &b.NotificationReminders},
+		{"saved-searches.json", &b.SavedSearches},
+	}
+}
+
+// Backup writes a zip archive with everything in the group of the user to w. The archive
+// holds a manifest.json with the version of the format, a JSON file per collection and
+// the attachment files in the documents directory. Notifiers of other members are left
+// out, their URLs hold the credentials of those members.
+func (svc *GroupService) Backup(ctx Context, w io.Writer) error {
+	if err := ctx.Authorize(PermissionManage); err != nil {
+		return err
+	}
+
+	b, err := svc.repos.Backups.Export(ctx, ctx.GID, ctx.UID)
+	if err != nil {
+		return err
+	}
+
+	for i, d := range b.Documents {
+		b.Documents[i].File = path.Join("documents", d.ID.String()+filepath.Ext(d.Path))
+	}
+
+	zw := zip.NewWriter(w)
+
+	err = writeBackupJSON(zw, "manifest.json", backupManifest{
+		Version:    BackupVersion,
+		ExportedAt: time.Now(),
+		Group:      b.Group,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, f := range backupFiles(&b) {
+		err = writeBackupJSON(zw, f.name, f.v)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, d := range b.Documents {
+		err = svc.writeBackupFile(ctx, zw, d.File, d.Path)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Info().
+		Str("user", ctx.UID.String()).
+		Str("group", ctx.GID.String()).
+		Msg("group backup created")
+
+	return zw.Close()
+}
+
+func writeBackupJSON(zw *zip.Writer, name string, v any) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func (svc *GroupService) writeBackupFile(ctx context.Context, zw *zip.Writer, name, docPath string) error {
+	f, err := svc.repos.Docs.Open(ctx, docPath)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// RestoreGroup restores a backup archive created by GroupService.Backup as a new group
+// owned by the superuser. All entities get new IDs, members are added when an account
+// with their email address exists in the instance.
+func (svc *AdminService) RestoreGroup(ctx Context, r io.ReaderAt, size int64) (repo.GroupRestore, error) {
+	if err := ctx.AuthorizeSuperuser(); err != nil {
+		return repo.GroupRestore{}, err
+	}
+
+	invalid := func(msg string) error {
+		return validate.NewFieldErrors(validate.NewFieldError("file", msg))
+	}
+
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return repo.GroupRestore{}, invalid("not a backup archive")
+	}
+
+	var manifest backupManifest
+	err = readBackupJSON(zr, "manifest.json", &manifest)
+	if err != nil {
+		return repo.GroupRestore{}, invalid("not a backup archive")
+	}
+
+	if manifest.Version < 1 || manifest.Version > BackupVersion {
+		return repo.GroupRestore{}, invalid(fmt.Sprintf("unsupported backup version %d", manifest.Version))
+	}
+
+	b := repo.GroupBackup{Group: manifest.Group}
+	for _, f := range backupFiles(&b) {
+		err = readBackupJSON(zr, f.name, f.v)
+		if err != nil {
+			return repo.GroupRestore{}, invalid(fmt.Sprintf("missing or invalid %s", f.name))
+		}
+	}
+
+	if msg := validateBackup(b); msg != "" {
+		return repo.GroupRestore{}, invalid(msg)
+	}
+
+	out, err := svc.repos.Backups.Restore(ctx, b, ctx.UID, func(file string) (io.ReadCloser, error) {
+		f, err := zr.Open(file)
+		if err != nil {
+			return nil, invalid(fmt.Sprintf("missing document %s", file))
+		}
+		return f, nil
+	})
+	if err != nil {
+		return repo.GroupRestore{}, err
+	}
+
+	log.Info().
+		Str("user", ctx.UID.String()).
+		Str("group", out.Group.ID.String()).
+		Int("version", manifest.Version).
+		Msg("group backup restored")
+
+	return out, nil
+}
+
+func readBackupJSON(zr *zip.Reader, name string, v any) error {
+	f, err := zr.Open(name)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	return json.NewDecoder(f).Decode(v)
+}
+
+// validateBackup checks the values of the backup the database only accepts from a fixed
+// set and returns a description of the first invalid value.
+func validateBackup(b repo.GroupBackup) string {
+	if group.DigestValidator(group.Digest(b.Group.Digest)) != nil {
+		return fmt.Sprintf("invalid group digest %q", b.Group.Digest)
+	}
+
+	for _, m := range b.Members {
+		if groupmembership.RoleValidator(groupmembership.Role(m.Role)) != nil {
+			return fmt.Sprintf("invalid role %q of member %s", m.Role, m.Email)
+		}
+	}
+
+	for _, n := range b.NotificationRules {
+		if notificationrule.KindValidator(notificationrule.Kind(n.Kind)) != nil {
+			return fmt.Sprintf("invalid kind %q of notification rule %s", n.Kind, n.Name)
+		}
+	}
+
+	for _, n := range b.NotificationTemplates {
+		if notificationtemplate.TypeValidator(notificationtemplate.Type(n.Type)) != nil {
+			return fmt.Sprintf("invalid type %q of notification template", n.Type)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,179 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupService_BackupRestore(t *testing.T) {
+	src := useOtherGroup(t)
+
+	parent, err := tRepos.Locations.Create(src, src.GID, repo.LocationCreate{Name: "House"})
+	require.NoError(t, err)
+
+	room, err := tRepos.Locations.Create(src, src.GID, repo.LocationCreate{Name: "Garage", ParentID: parent.ID})
+	require.NoError(t, err)
+
+	lbl, err := tRepos.Labels.Create(src, src.GID, repo.LabelCreate{Name: "Tools"})
+	require.NoError(t, err)
+
+	box, err := tRepos.Items.Create(src, src.GID, repo.ItemCreate{Name: "Toolbox", LocationID: room.ID})
+	require.NoError(t, err)
+
+	drill, err := tRepos.Items.Create(src, src.GID, repo.ItemCreate{
+		Name:       "Drill",
+		LocationID: room.ID,
+		LabelIDs:   []uuid.UUID{lbl.ID},
+	})
+	require.NoError(t, err)
+
+	err = tClient.Item.UpdateOneID(drill.ID).SetParentID(box.ID).Exec(src)
+	require.NoError(t, err)
+
+	_, err = tSvc.Items.AttachmentAdd(src, drill.ID, "manual.txt", "manual", strings.NewReader("drill manual"))
+	require.NoError(t, err)
+
+	// Notifiers of other members are not part of the backup.
+	member, err := tRepos.Users.Create(src, repo.UserCreate{
+		Name:     fk.Str(10),
+		Email:    fk.Email(),
+		Password: fk.Str(10),
+		GroupID:  src.GID,
+	})
+	require.NoError(t, err)
+
+	_, err = tRepos.Notifiers.Create(src, src.GID, src.UID, repo.NotifierCreate{Name: "Own", URL: "generic://own.example.com"})
+	require.NoError(t, err)
+
+	other, err := tRepos.Notifiers.Create(src, src.GID, member.ID, repo.NotifierCreate{Name: "Other", URL: "generic://other.example.com?token=secret"})
+	require.NoError(t, err)
+
+	_, err = tRepos.NotificationTemplates.Create(src, src.GID, repo.NotificationTemplateCreate{
+		Type:       "reminder",
+		NotifierID: &other.ID,
+		Body:       "{{ .Group }}",
+	})
+	require.NoError(t, err)
+
+	// Only owners can download a backup.
+	var buf bytes.Buffer
+	err = tSvc.Group.Backup(tCtx, &buf)
+	require.Error(t, err)
+
+	err = tSvc.Group.Backup(src, &buf)
+	require.NoError(t, err)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	var archived repo.GroupBackup
+	require.NoError(t, readBackupJSON(zr, "notifiers.json", &archived.Notifiers))
+	require.NoError(t, readBackupJSON(zr, "notification-templates.json", &archived.NotificationTemplates))
+	require.Len(t, archived.Notifiers, 1)
+	assert.Equal(t, "Own", archived.Notifiers[0].Name)
+	assert.Empty(t, archived.NotificationTemplates)
+
+	admin := useOtherGroup(t)
+	admin.User.IsSuperuser = true
+
+	// Values the database does not accept are rejected as invalid archives.
+	tampered := replaceBackupFile(t, zr, "notification-rules.json", `[{"id":"`+uuid.NewString()+`","name":"Rule","kind":"unknown"}]`)
+	_, err = tSvc.Admin.RestoreGroup(admin, bytes.NewReader(tampered), int64(len(tampered)))
+	require.True(t, validate.IsFieldError(err))
+
+	// Only superusers can restore a backup.
+	_, err = tSvc.Admin.RestoreGroup(src, bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.Error(t, err)
+
+	_, err = tSvc.Admin.RestoreGroup(admin, strings.NewReader("not a zip"), 9)
+	require.True(t, validate.IsFieldError(err))
+
+	out, err := tSvc.Admin.RestoreGroup(admin, bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	assert.NotEqual(t, src.GID, out.Group.ID)
+	assert.Len(t, out.MissingMembers, 0)
+
+	// The owner of the backup is added to the restored group.
+	_, err = tRepos.Memberships.GetOne(context.Background(), src.UID, out.Group.ID)
+	require.NoError(t, err)
+
+	items, err := tRepos.Items.GetAll(context.Background(), out.Group.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	var restoredID uuid.UUID
+	for _, it := range items {
+		assert.NotEqual(t, box.ID, it.ID)
+		assert.NotEqual(t, drill.ID, it.ID)
+		if it.Name == "Drill" {
+			restoredID = it.ID
+		}
+	}
+
+	restored, err := tRepos.Items.GetOneByGroup(context.Background(), out.Group.ID, restoredID)
+	require.NoError(t, err)
+
+	require.NotNil(t, restored.Parent)
+	assert.Equal(t, "Toolbox", restored.Parent.Name)
+	assert.NotEqual(t, box.ID, restored.Parent.ID)
+
+	require.NotNil(t, restored.Location)
+	assert.Equal(t, "Garage", restored.Location.Name)
+
+	loc, err := tRepos.Locations.GetOneByGroup(context.Background(), out.Group.ID, restored.Location.ID)
+	require.NoError(t, err)
+	require.NotNil(t, loc.Parent)
+	assert.Equal(t, "House", loc.Parent.Name)
+
+	require.Len(t, restored.Labels, 1)
+	assert.Equal(t, "Tools", restored.Labels[0].Name)
+	assert.NotEqual(t, lbl.ID, restored.Labels[0].ID)
+
+	require.Len(t, restored.Attachments, 1)
+	f, err := tRepos.Docs.Open(context.Background(), restored.Attachments[0].Document.Path)
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+
+	bts, err := io.ReadAll(f)
+	require.NoError(t, err)
+	assert.Equal(t, "drill manual", string(bts))
+}
+
+// replaceBackupFile returns a copy of the archive with the content of the file replaced.
+func replaceBackupFile(t *testing.T, zr *zip.Reader, name, content string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	for _, f := range zr.File {
+		w, err := zw.Create(f.Name)
+		require.NoError(t, err)
+
+		if f.Name == name {
+			_, err = io.WriteString(w, content)
+			require.NoError(t, err)
+			continue
+		}
+
+		rc, err := f.Open()
+		require.NoError(t, err)
+
+		_, err = io.Copy(w, rc)
+		require.NoError(t, err)
+		_ = rc.Close()
+	}
+
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
@@ -26,6 +26,10 @@ type ItemService struct {
 }
 
 func (svc *ItemService) Create(ctx Context, item repo.ItemCreate) (repo.ItemOut, error) {
+	if err := ctx.Authorize(PermissionWrite); err != nil {
+		return repo.ItemOut{}, err
+	}
+
 	if svc.autoIncrementAssetID {
 		highest, err := svc.repo.Items.GetHighestAssetID(ctx, ctx.GID)
 		if err != nil {
@@ -38,6 +42,46 @@ func (svc *ItemService) Create(ctx Context, item repo.ItemCreate) (repo.ItemOut,
 	return svc.repo.Items.Create(ctx, ctx.GID, item)
 }
 
+func (svc *ItemService) Update(ctx Context, data repo.ItemUpdate) (repo.ItemOut, error) {
+	if err := ctx.Authorize(PermissionWrite); err != nil {
+		return repo.ItemOut{}, err
+	}
+
+	return svc.repo.Items.UpdateByGroup(ctx, ctx.GID, data)
+}
+
+// Patch updates the fields of the item that are set and returns the updated item.
+func (svc *ItemService) Patch(ctx Context, ID uuid.UUID, data repo.ItemPatch) (repo.ItemOut, error) {
+	if err := ctx.Authorize(PermissionWrite); err != nil {
+		return repo.ItemOut{}, err
+	}
+
+	err := svc.repo.Items.Patch(ctx, ctx.GID, ID, data)
+	if err != nil {
+		return repo.ItemOut{}, err
+	}
+
+	return svc.repo.Items.GetOneByGroup(ctx, ctx.GID, ID)
+}
+
+// Delete moves the item to the trash.
+func (svc *ItemService) Delete(ctx Context, ID uuid.UUID) error {
+	if err := ctx.Authorize(PermissionWrite); err != nil {
+		return err
+	}
+
+	return svc.repo.Items.DeleteByGroup(ctx, ctx.GID, ID)
+}
+
+// Restore moves the item out of the trash.
+func (svc *ItemService) Restore(ctx Context, ID uuid.UUID) (repo.ItemOut, error) {
+	if err := ctx.Authorize(PermissionWrite); err != nil {
+		return repo.ItemOut{}, err
+	}
+
+	return svc.repo.Items.Restore(ctx, ctx.GID, ID)
+}
+
 func (svc *ItemService) EnsureAssetID(ctx context.Context, GID uuid.UUID) (int, error) {
 	items, err := svc.repo.Items.GetAllZeroAssetID(ctx, GID)
 	if err != nil {
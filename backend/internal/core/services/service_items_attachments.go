@@ -3,25 +3,51 @@ package services
 import (
 	"context"
 	"io"
-	"os"
 
 	"github.com/google/uuid"
-	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
 	"github.com/hay-kot/homebox/backend/internal/data/repo"
 	"github.com/rs/zerolog/log"
 )
 
-func (svc *ItemService) AttachmentPath(ctx context.Context, attachmentID uuid.UUID) (*ent.Document, error) {
+// AttachmentDownload is the file of an attachment. Either URL is set to download the
+// file from the blob store directly, or Content holds the file and has to be closed.
+type AttachmentDownload struct {
+	Title   string
+	URL     string
+	Content io.ReadCloser
+}
+
+func (svc *ItemService) AttachmentOpen(ctx context.Context, attachmentID uuid.UUID) (AttachmentDownload, error) {
 	attachment, err := svc.repo.Attachments.Get(ctx, attachmentID)
 	if err != nil {
-		return nil, err
+		return AttachmentDownload{}, err
+	}
+
+	doc := attachment.Edges.Document
+
+	url, err := svc.repo.Docs.DownloadURL(ctx, doc.Path)
+	if err != nil {
+		return AttachmentDownload{}, err
+	}
+
+	if url != "" {
+		return AttachmentDownload{Title: doc.Title, URL: url}, nil
+	}
+
+	content, err := svc.repo.Docs.Open(ctx, doc.Path)
+	if err != nil {
+		return AttachmentDownload{}, err
 	}
 
-	return attachment.Edges.Document, nil
+	return AttachmentDownload{Title: doc.Title, Content: content}, nil
 }
 
 func (svc *ItemService) AttachmentUpdate(ctx Context, itemID uuid.UUID, data *repo.ItemAttachmentUpdate) (repo.ItemOut, error) {
+	if err := ctx.Authorize(PermissionWrite); err != nil {
+		return repo.ItemOut{}, err
+	}
+
 	// Update Attachment
 	attachment, err := svc.repo.Attachments.Update(ctx, data.ID, data)
 	if err != nil {
@@ -42,6 +68,10 @@ func (svc *ItemService) AttachmentUpdate(ctx Context, itemID uuid.UUID, data *re
 // Table and Items table. The file provided via the reader is stored on the file system based on the provided
 // relative path during construction of the service.
 func (svc *ItemService) AttachmentAdd(ctx Context, itemID uuid.UUID, filename string, attachmentType attachment.Type, file io.Reader) (repo.ItemOut, error) {
+	if err := ctx.Authorize(PermissionWrite); err != nil {
+		return repo.ItemOut{}, err
+	}
+
 	// Get the Item
 	_, err := svc.repo.Items.GetOneByGroup(ctx, ctx.GID, itemID)
 	if err != nil {
@@ -65,9 +95,13 @@ func (svc *ItemService) AttachmentAdd(ctx Context, itemID uuid.UUID, filename st
 	return svc.repo.Items.GetOneByGroup(ctx, ctx.GID, itemID)
 }
 
-func (svc *ItemService) AttachmentDelete(ctx context.Context, gid, itemID, attachmentID uuid.UUID) error {
+func (svc *ItemService) AttachmentDelete(ctx Context, itemID, attachmentID uuid.UUID) error {
+	if err := ctx.Authorize(PermissionWrite); err != nil {
+		return err
+	}
+
 	// Get the Item
-	_, err := svc.repo.Items.GetOneByGroup(ctx, gid, itemID)
+	_, err := svc.repo.Items.GetOneByGroup(ctx, ctx.GID, itemID)
 	if err != nil {
 		return err
 	}
@@ -84,7 +118,5 @@ func (svc *ItemService) AttachmentDelete(ctx context.Context, gid, itemID, attac
 	}
 
 	// Remove File
-	err = os.Remove(attachment.Edges.Document.Path)
-
-	return err
+	return svc.repo.Docs.RemoveFile(ctx, attachment.Edges.Document.Path)
 }
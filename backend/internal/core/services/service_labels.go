@@ -0,0 +1,36 @@
+package services
+
+import (
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+)
+
+// LabelService changes the labels of the active group, all methods require the write
+// permission.
+type LabelService struct {
+	repos *repo.AllRepos
+}
+
+func (svc *LabelService) Create(ctx Context, data repo.LabelCreate) (repo.LabelOut, error) {
+	if err := ctx.Authorize(PermissionWrite); err != nil {
+		return repo.LabelOut{}, err
+	}
+
+	return svc.repos.Labels.Create(ctx, ctx.GID, data)
+}
+
+func (svc *LabelService) Update(ctx Context, data repo.LabelUpdate) (repo.LabelOut, error) {
+	if err := ctx.Authorize(PermissionWrite); err != nil {
+		return repo.LabelOut{}, err
+	}
+
+	return svc.repos.Labels.UpdateByGroup(ctx, ctx.GID, data)
+}
+
+func (svc *LabelService) Delete(ctx Context, ID uuid.UUID) error {
+	if err := ctx.Authorize(PermissionWrite); err != nil {
+		return err
+	}
+
+	return svc.repos.Labels.DeleteByGroup(ctx, ctx.GID, ID)
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+)
+
+// LocationService changes the locations of the active group, all methods require the
+// write permission.
+type LocationService struct {
+	repos *repo.AllRepos
+}
+
+func (svc *LocationService) Create(ctx Context, data repo.LocationCreate) (repo.LocationOut, error) {
+	if err := ctx.Authorize(PermissionWrite); err != nil {
+		return repo.LocationOut{}, err
+	}
+
+	return svc.repos.Locations.Create(ctx, ctx.GID, data)
+}
+
+func (svc *LocationService) Update(ctx Context, data repo.LocationUpdate) (repo.LocationOut, error) {
+	if err := ctx.Authorize(PermissionWrite); err != nil {
+		return repo.LocationOut{}, err
+	}
+
+	return svc.repos.Locations.UpdateByGroup(ctx, ctx.GID, data.ID, data)
+}
+
+// Delete moves the location, its child locations and their items to the trash.
+func (svc *LocationService) Delete(ctx Context, ID uuid.UUID) error {
+	if err := ctx.Authorize(PermissionWrite); err != nil {
+		return err
+	}
+
+	return svc.repos.Locations.DeleteByGroup(ctx, ctx.GID, ID)
+}
+
+// Restore moves the location out of the trash along with everything deleted with it.
+func (svc *LocationService) Restore(ctx Context, ID uuid.UUID) (repo.LocationOut, error) {
+	if err := ctx.Authorize(PermissionWrite); err != nil {
+		return repo.LocationOut{}, err
+	}
+
+	return svc.repos.Locations.Restore(ctx, ctx.GID, ID)
+}
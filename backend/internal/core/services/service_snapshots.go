@@ -0,0 +1,331 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	snapshotPrefix = "homebox-"
+	snapshotLayout = "20060102-150405"
+)
+
+// SnapshotConfig configures the snapshots of the database and the attachment files.
+// Snapshots are disabled when Dir is empty.
+type SnapshotConfig struct {
+	// Dir is the directory the snapshots are written to, each snapshot is a directory.
+	Dir string
+	// DataDir is the storage directory holding the attachment files.
+	DataDir string
+	// KeepDaily is the number of days and KeepWeekly the number of weeks for which the
+	// newest snapshot is kept, other snapshots are removed.
+	KeepDaily  int
+	KeepWeekly int
+}
+
+// SnapshotService takes consistent snapshots of the SQLite database and the attachment
+// files while the server is running.
+type SnapshotService struct {
+	repos *repo.AllRepos
+	conf  SnapshotConfig
+
+	mu sync.Mutex
+	// failedAt is the time of the last snapshot when it failed.
+	failedAt *time.Time
+}
+
+// SnapshotStatus describes the snapshots in the snapshot directory, it is part of the
+// public status of the instance so it holds no paths or errors.
+type SnapshotStatus struct {
+	Enabled        bool       `json:"enabled"`
+	LastSnapshotAt *time.Time `json:"lastSnapshotAt,omitempty" extensions:"x-nullable,x-omitempty"`
+	LastFailedAt   *time.Time `json:"lastFailedAt,omitempty"   extensions:"x-nullable,x-omitempty"`
+	Snapshots      int        `json:"snapshots"`
+}
+
+// snapshot is a completed snapshot in the snapshot directory.
+type snapshot struct {
+	name string
+	at   time.Time
+}
+
+// Enabled reports if a snapshot directory is configured and the database supports
+// snapshots.
+func (svc *SnapshotService) Enabled() bool {
+	return svc.conf.Dir != "" && svc.repos.Snapshots.Supported()
+}
+
+// Status returns the time of the newest snapshot and of the last failed snapshot.
+func (svc *SnapshotService) Status() SnapshotStatus {
+	if !svc.Enabled() {
+		return SnapshotStatus{}
+	}
+
+	status := SnapshotStatus{Enabled: true}
+
+	snapshots, err := svc.list()
+	if err != nil {
+		log.Err(err).Msg("failed to list snapshots")
+	}
+
+	if len(snapshots) > 0 {
+		status.LastSnapshotAt = &snapshots[0].at
+		status.Snapshots = len(snapshots)
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	if svc.failedAt != nil && (status.LastSnapshotAt == nil || svc.failedAt.After(*status.LastSnapshotAt)) {
+		status.LastFailedAt = svc.failedAt
+	}
+
+	return status
+}
+
+// list returns the completed snapshots in the snapshot directory, newest first.
+func (svc *SnapshotService) list() ([]snapshot, error) {
+	entries, err := os.ReadDir(svc.conf.Dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	snapshots := make([]snapshot, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() || !strings.HasPrefix(e.Name(), snapshotPrefix) {
+			continue
+		}
+
+		at, err := time.Parse(snapshotLayout, strings.TrimPrefix(e.Name(), snapshotPrefix))
+		if err != nil {
+			continue
+		}
+
+		snapshots = append(snapshots, snapshot{name: e.Name(), at: at})
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].at.After(snapshots[j].at)
+	})
+
+	return snapshots, nil
+}
+
+// Create writes a snapshot of the database and the attachment files and removes the
+// snapshots that are not retained. The snapshot is written to a hidden directory that
+// is renamed once it is complete, so interrupted snapshots are never listed.
+func (svc *SnapshotService) Create(ctx context.Context) (string, error) {
+	path, err := svc.create(ctx, time.Now().UTC())
+
+	svc.mu.Lock()
+	if err != nil {
+		now := time.Now().UTC()
+		svc.failedAt = &now
+	} else {
+		svc.failedAt = nil
+	}
+	svc.mu.Unlock()
+
+	if err != nil {
+		return "", err
+	}
+
+	return path, svc.prune()
+}
+
+func (svc *SnapshotService) create(ctx context.Context, now time.Time) (string, error) {
+	if !svc.Enabled() {
+		return "", errors.New("snapshots are not enabled")
+	}
+
+	name := snapshotPrefix + now.Format(snapshotLayout)
+	path := filepath.Join(svc.conf.Dir, name)
+	temp := filepath.Join(svc.conf.Dir, "."+name)
+
+	// Leftovers of an interrupted snapshot are replaced.
+	err := os.RemoveAll(temp)
+	if err != nil {
+		return "", err
+	}
+
+	err = os.MkdirAll(temp, 0o755)
+	if err != nil {
+		return "", err
+	}
+
+	err = svc.write(ctx, temp)
+	if err != nil {
+		_ = os.RemoveAll(temp)
+		return "", err
+	}
+
+	err = os.Rename(temp, path)
+	if err != nil {
+		_ = os.RemoveAll(temp)
+		return "", err
+	}
+
+	return path, nil
+}
+
+// write writes the database to homebox.db and copies the directories of the storage
+// directory to data, the snapshot directory is skipped when it is within the storage
+// directory. Attachment files are never changed, they are hard linked when possible.
+func (svc *SnapshotService) write(ctx context.Context, dst string) error {
+	err := svc.repos.Snapshots.VacuumInto(ctx, filepath.Join(dst, "homebox.db"))
+	if err != nil {
+		return err
+	}
+
+	if svc.conf.DataDir == "" {
+		return nil
+	}
+
+	snapshotDir, err := filepath.Abs(svc.conf.Dir)
+	if err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(svc.conf.DataDir)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+
+		src, err := filepath.Abs(filepath.Join(svc.conf.DataDir, e.Name()))
+		if err != nil {
+			return err
+		}
+
+		if src == snapshotDir || strings.HasPrefix(snapshotDir, src+string(filepath.Separator)) {
+			continue
+		}
+
+		err = copyTree(src, filepath.Join(dst, "data", e.Name()))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func copyTree(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		switch {
+		case d.IsDir():
+			return os.MkdirAll(target, 0o755)
+		case !d.Type().IsRegular():
+			return nil
+		}
+
+		if os.Link(path, target) == nil {
+			return nil
+		}
+
+		return copyFile(path, target)
+	})
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(out, in)
+	if err != nil {
+		_ = out.Close()
+		return err
+	}
+
+	return out.Close()
+}
+
+// prune removes the snapshots that are not retained.
+func (svc *SnapshotService) prune() error {
+	snapshots, err := svc.list()
+	if err != nil {
+		return err
+	}
+
+	keep := retainSnapshots(snapshots, svc.conf.KeepDaily, svc.conf.KeepWeekly)
+
+	for _, s := range snapshots {
+		if _, ok := keep[s.name]; ok {
+			continue
+		}
+
+		err = os.RemoveAll(filepath.Join(svc.conf.Dir, s.name))
+		if err != nil {
+			return err
+		}
+
+		log.Debug().Str("snapshot", s.name).Msg("removed snapshot")
+	}
+
+	return nil
+}
+
+// retainSnapshots returns the names of the snapshots to keep: the newest snapshot of
+// each of the last daily days and weekly ISO weeks that have a snapshot. The newest
+// snapshot is always kept. The snapshots must be ordered newest first.
+func retainSnapshots(snapshots []snapshot, daily, weekly int) map[string]struct{} {
+	keep := map[string]struct{}{}
+	if len(snapshots) > 0 {
+		keep[snapshots[0].name] = struct{}{}
+	}
+
+	days := map[string]struct{}{}
+	weeks := map[[2]int]struct{}{}
+
+	for _, s := range snapshots {
+		day := s.at.Format("2006-01-02")
+		if _, ok := days[day]; !ok && len(days) < daily {
+			days[day] = struct{}{}
+			keep[s.name] = struct{}{}
+		}
+
+		year, week := s.at.ISOWeek()
+		if _, ok := weeks[[2]int{year, week}]; !ok && len(weeks) < weekly {
+			weeks[[2]int{year, week}] = struct{}{}
+			keep[s.name] = struct{}{}
+		}
+	}
+
+	return keep
+}
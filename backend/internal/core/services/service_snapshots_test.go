@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotService_Create(t *testing.T) {
+	data := t.TempDir()
+	dir := filepath.Join(data, "backups")
+
+	docs := filepath.Join(data, tGroup.ID.String(), "documents")
+	require.NoError(t, os.MkdirAll(docs, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(docs, "manual.txt"), []byte("manual"), 0o644))
+
+	svc := &SnapshotService{
+		repos: tRepos,
+		conf: SnapshotConfig{
+			Dir:        dir,
+			DataDir:    data,
+			KeepDaily:  2,
+			KeepWeekly: 0,
+		},
+	}
+
+	assert.Equal(t, SnapshotStatus{Enabled: true}, svc.Status())
+
+	// Older snapshots beyond the retention are removed after a snapshot.
+	for _, name := range []string{"homebox-20200101-000000", "homebox-20200102-000000", ".homebox-20200103-000000"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, name), 0o755))
+	}
+
+	path, err := svc.Create(context.Background())
+	require.NoError(t, err)
+
+	_, err = os.Stat(filepath.Join(path, "homebox.db"))
+	require.NoError(t, err)
+
+	bts, err := os.ReadFile(filepath.Join(path, "data", tGroup.ID.String(), "documents", "manual.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "manual", string(bts))
+
+	// The snapshot directory is not copied into the snapshot.
+	_, err = os.Stat(filepath.Join(path, "data", "backups"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	assert.ElementsMatch(t, []string{filepath.Base(path), "homebox-20200102-000000", ".homebox-20200103-000000"}, names)
+
+	status := svc.Status()
+	assert.True(t, status.Enabled)
+	assert.Equal(t, 2, status.Snapshots)
+	require.NotNil(t, status.LastSnapshotAt)
+	assert.WithinDuration(t, time.Now(), *status.LastSnapshotAt, time.Minute)
+	assert.Nil(t, status.LastFailedAt)
+}
+
+func TestRetainSnapshots(t *testing.T) {
+	at := func(s string) time.Time {
+		v, err := time.Parse(time.DateTime, s)
+		require.NoError(t, err)
+		return v
+	}
+
+	// Newest first, 2024-01-01 is a Monday.
+	snapshots := []snapshot{
+		{"a", at("2024-01-10 12:00:00")},
+		{"b", at("2024-01-10 06:00:00")},
+		{"c", at("2024-01-09 12:00:00")},
+		{"d", at("2024-01-08 12:00:00")},
+		{"e", at("2024-01-07 12:00:00")},
+		{"f", at("2024-01-03 12:00:00")},
+		{"g", at("2023-12-27 12:00:00")},
+	}
+
+	tests := []struct {
+		name   string
+		daily  int
+		weekly int
+		want   []string
+	}{
+		{"nothing retained keeps newest", 0, 0, []string{"a"}},
+		{"daily", 2, 0, []string{"a", "c"}},
+		{"weekly", 0, 3, []string{"a", "e", "g"}},
+		{"daily and weekly", 3, 2, []string{"a", "c", "d", "e"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keep := retainSnapshots(snapshots, tt.daily, tt.weekly)
+
+			got := make([]string, 0, len(keep))
+			for name := range keep {
+				got = append(got, name)
+			}
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
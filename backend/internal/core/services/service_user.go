@@ -5,10 +5,14 @@ import (
 	"errors"
 	"time"
 
+	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authroles"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/usertoken"
 	"github.com/hay-kot/homebox/backend/internal/data/repo"
 	"github.com/hay-kot/homebox/backend/pkgs/hasher"
+	"github.com/hay-kot/homebox/backend/pkgs/mailer"
 	"github.com/rs/zerolog/log"
 )
 
@@ -17,10 +21,20 @@ var (
 	ErrorInvalidLogin    = errors.New("invalid username or password")
 	ErrorInvalidToken    = errors.New("invalid token")
 	ErrorTokenIDMismatch = errors.New("token id mismatch")
+	ErrorNoGroupForUser  = errors.New("no group available for new user")
+	ErrorUserDisabled    = errors.New("user account is disabled")
 )
 
 type UserService struct {
-	repos *repo.AllRepos
+	repos   *repo.AllRepos
+	mailer  *mailer.Mailer
+	baseURL string
+	// requireVerification blocks the login of users until they verified their
+	// email address.
+	requireVerification bool
+	// webauthn is nil when passkeys are disabled because no base URL is configured.
+	webauthn   *webauthn.WebAuthn
+	ceremonies *ceremonyStore
 }
 
 type (
@@ -39,11 +53,42 @@ type (
 		Username string `json:"username"`
 		Password string `json:"password"`
 	}
+	// ExternalIdentity is a user that was authenticated by an external identity provider.
+	ExternalIdentity struct {
+		Email string
+		Name  string
+		// GroupToken is an optional invitation token, when set new users join the
+		// invited group.
+		GroupToken string
+		// GroupID is the group new users join when no invitation token is provided.
+		GroupID uuid.UUID
+		// AllowNewGroup allows a new group to be created for the user when neither
+		// a GroupToken or GroupID is provided.
+		AllowNewGroup bool
+	}
 )
 
 // RegisterUser creates a new user and group in the data with the provided data. It also bootstraps the user's group
-// with default Labels and Locations.
+// with default Labels and Locations. When email verification is required a verification mail is sent to the user,
+// otherwise the user is activated right away.
 func (svc *UserService) RegisterUser(ctx context.Context, data UserRegistration) (repo.UserOut, error) {
+	usr, err := svc.registerUser(ctx, data, !svc.requireVerification)
+	if err != nil {
+		return repo.UserOut{}, err
+	}
+
+	if !usr.EmailVerified {
+		// The user is created regardless, the mail can be requested again.
+		err = svc.sendTokenMail(ctx, usr, usertoken.PurposeEmailVerification)
+		if err != nil {
+			log.Err(err).Str("email", usr.Email).Msg("failed to send verification mail")
+		}
+	}
+
+	return usr, nil
+}
+
+func (svc *UserService) registerUser(ctx context.Context, data UserRegistration, activated bool) (repo.UserOut, error) {
 	log.Debug().
 		Str("name", data.Name).
 		Str("email", data.Email).
@@ -70,7 +115,7 @@ func (svc *UserService) RegisterUser(ctx context.Context, data UserRegistration)
 		}
 	default:
 		log.Debug().Msg("joining existing group")
-		token, err = svc.repos.Groups.InvitationGet(ctx, hasher.HashToken(data.GroupToken))
+		token, err = svc.invitation(ctx, data.GroupToken)
 		if err != nil {
 			log.Err(err).Msg("Failed to get invitation token")
 			return repo.UserOut{}, err
@@ -86,6 +131,7 @@ func (svc *UserService) RegisterUser(ctx context.Context, data UserRegistration)
 		IsSuperuser: false,
 		GroupID:     group.ID,
 		IsOwner:     creatingGroup,
+		Activated:   activated,
 	}
 
 	usr, err := svc.repos.Users.Create(ctx, usrCreate)
@@ -144,33 +190,44 @@ func (svc *UserService) UpdateSelf(ctx context.Context, ID uuid.UUID, data repo.
 // ============================================================================
 // User Authentication
 
+// createSessionToken issues a session for the user, ErrorUserDisabled is returned for
+// disabled users regardless of how they authenticated.
 func (svc *UserService) createSessionToken(ctx context.Context, userID uuid.UUID, extendedSession bool) (UserAuthTokenDetail, error) {
-	attachmentToken := hasher.GenerateToken()
+	usr, err := svc.repos.Users.GetOneID(ctx, userID)
+	if err != nil {
+		return UserAuthTokenDetail{}, err
+	}
+
+	if usr.Disabled {
+		return UserAuthTokenDetail{}, ErrorUserDisabled
+	}
 
 	expiresAt := time.Now().Add(oneWeek)
 	if extendedSession {
 		expiresAt = time.Now().Add(oneWeek * 4)
 	}
 
-	attachmentData := repo.UserAuthTokenCreate{
+	userToken := hasher.GenerateToken()
+	data := repo.UserAuthTokenCreate{
 		UserID:    userID,
-		TokenHash: attachmentToken.Hash,
+		TokenHash: userToken.Hash,
 		ExpiresAt: expiresAt,
 	}
 
-	_, err := svc.repos.AuthTokens.CreateToken(ctx, attachmentData, authroles.RoleAttachments)
+	created, err := svc.repos.AuthTokens.CreateToken(ctx, data, authroles.RoleUser)
 	if err != nil {
 		return UserAuthTokenDetail{}, err
 	}
 
-	userToken := hasher.GenerateToken()
-	data := repo.UserAuthTokenCreate{
+	attachmentToken := hasher.GenerateToken()
+	attachmentData := repo.UserAuthTokenCreate{
 		UserID:    userID,
-		TokenHash: userToken.Hash,
+		TokenHash: attachmentToken.Hash,
 		ExpiresAt: expiresAt,
+		SessionID: created.ID,
 	}
 
-	created, err := svc.repos.AuthTokens.CreateToken(ctx, data, authroles.RoleUser)
+	_, err = svc.repos.AuthTokens.CreateToken(ctx, attachmentData, authroles.RoleAttachments)
 	if err != nil {
 		return UserAuthTokenDetail{}, err
 	}
@@ -182,6 +239,8 @@ func (svc *UserService) createSessionToken(ctx context.Context, userID uuid.UUID
 	}, nil
 }
 
+// Login issues a session for the user with the email and password. For users with two-factor
+// authentication enabled no session is issued, a *TwoFactorChallenge error is returned instead.
 func (svc *UserService) Login(ctx context.Context, username, password string, extendedSession bool) (UserAuthTokenDetail, error) {
 	usr, err := svc.repos.Users.GetOneEmail(ctx, username)
 	if err != nil {
@@ -194,6 +253,74 @@ func (svc *UserService) Login(ctx context.Context, username, password string, ex
 		return UserAuthTokenDetail{}, ErrorInvalidLogin
 	}
 
+	if svc.requireVerification && !usr.EmailVerified {
+		return UserAuthTokenDetail{}, ErrorEmailNotVerified
+	}
+
+	if usr.TwoFactorEnabled {
+		return UserAuthTokenDetail{}, svc.twoFactorChallenge(ctx, usr.ID)
+	}
+
+	return svc.createSessionToken(ctx, usr.ID, extendedSession)
+}
+
+// LoginExternal issues a session for a user authenticated by an external identity provider. The
+// user is matched by email, existing users are added to the invited group. If no user exists one
+// is created just-in-time and added to the invited group, the configured group or a new group, in
+// that order. The address was verified by the provider, so users created here are activated right
+// away. Like Login, a *TwoFactorChallenge error is returned for users with two-factor authentication
+// enabled.
+func (svc *UserService) LoginExternal(ctx context.Context, ident ExternalIdentity, extendedSession bool) (UserAuthTokenDetail, error) {
+	usr, err := svc.repos.Users.GetOneEmail(ctx, ident.Email)
+	switch {
+	case err == nil:
+		// Existing users join the invited group, the login succeeds regardless.
+		if ident.GroupToken != "" {
+			_, err = svc.joinGroup(ctx, usr.ID, ident.GroupToken)
+			if err != nil {
+				log.Warn().Err(err).Str("email", usr.Email).Msg("failed to join invited group")
+			}
+		}
+
+		if usr.TwoFactorEnabled {
+			return UserAuthTokenDetail{}, svc.twoFactorChallenge(ctx, usr.ID)
+		}
+
+		return svc.createSessionToken(ctx, usr.ID, extendedSession)
+	case !ent.IsNotFound(err):
+		return UserAuthTokenDetail{}, err
+	}
+
+	// External users never login with a password, a random one is set
+	// so that the account cannot be accessed through the local provider.
+	password := hasher.GenerateToken().Raw
+
+	switch {
+	case ident.GroupToken != "" || (ident.GroupID == uuid.Nil && ident.AllowNewGroup):
+		usr, err = svc.registerUser(ctx, UserRegistration{
+			GroupToken: ident.GroupToken,
+			Name:       ident.Name,
+			Email:      ident.Email,
+			Password:   password,
+		}, true)
+	case ident.GroupID != uuid.Nil:
+		hashed, _ := hasher.HashPassword(password)
+		usr, err = svc.repos.Users.Create(ctx, repo.UserCreate{
+			Name:      ident.Name,
+			Email:     ident.Email,
+			Password:  hashed,
+			GroupID:   ident.GroupID,
+			Activated: true,
+		})
+	default:
+		return UserAuthTokenDetail{}, ErrorNoGroupForUser
+	}
+	if err != nil {
+		return UserAuthTokenDetail{}, err
+	}
+
+	log.Info().Str("email", usr.Email).Msg("created user from external identity")
+
 	return svc.createSessionToken(ctx, usr.ID, extendedSession)
 }
 
@@ -211,7 +338,18 @@ func (svc *UserService) RenewToken(ctx context.Context, token string) (UserAuthT
 		return UserAuthTokenDetail{}, ErrorInvalidToken
 	}
 
-	return svc.createSessionToken(ctx, dbToken.ID, false)
+	renewed, err := svc.createSessionToken(ctx, dbToken.ID, false)
+	if err != nil {
+		return UserAuthTokenDetail{}, err
+	}
+
+	// The renewed session stays in the active group.
+	err = svc.repos.AuthTokens.SetGroup(ctx, hasher.HashToken(renewed.Raw), dbToken.GroupID)
+	if err != nil {
+		return UserAuthTokenDetail{}, err
+	}
+
+	return renewed, nil
 }
 
 // DeleteSelf deletes the user that is currently logged based of the provided UUID
@@ -244,5 +382,12 @@ func (svc *UserService) ChangePassword(ctx Context, current string, new string)
 		return false
 	}
 
+	// Sessions that may have been opened with the old password are revoked, the
+	// session changing the password is kept.
+	_, err = svc.repos.AuthTokens.DeleteOtherSessions(ctx, ctx.UID, hasher.HashToken(UseTokenCtx(ctx)))
+	if err != nil {
+		log.Err(err).Msg("Failed to revoke sessions")
+	}
+
 	return true
 }
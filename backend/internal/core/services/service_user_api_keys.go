@@ -0,0 +1,69 @@
+package services
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/authroles"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+	"github.com/hay-kot/homebox/backend/pkgs/hasher"
+)
+
+// apiKeyDefaultLifetime is used when an API key is created without an expiry.
+var apiKeyDefaultLifetime = time.Hour * 24 * 365
+
+// APIKeyDetail is returned when an API key is created, it is the only time the
+// raw token is available.
+type APIKeyDetail struct {
+	repo.APIKeyOut
+	Token string `json:"token"`
+}
+
+// CreateAPIKey mints a long-lived, named token for the current user that is limited to
+// the requested scope. The key acts in the active group of the user, an expiry has to be
+// in the future.
+func (svc *UserService) CreateAPIKey(ctx Context, data repo.APIKeyCreate) (APIKeyDetail, error) {
+	expiresAt := time.Now().Add(apiKeyDefaultLifetime)
+	if data.ExpiresAt != nil {
+		if !data.ExpiresAt.After(time.Now()) {
+			return APIKeyDetail{}, validate.NewFieldErrors(
+				validate.NewFieldError("expiresAt", "must be in the future"),
+			)
+		}
+		expiresAt = *data.ExpiresAt
+	}
+
+	token := hasher.GenerateToken()
+	created, err := svc.repos.AuthTokens.CreateToken(ctx, repo.UserAuthTokenCreate{
+		UserID:    ctx.UID,
+		TokenHash: token.Hash,
+		ExpiresAt: expiresAt,
+		Name:      data.Name,
+		GroupID:   ctx.GID,
+	}, authroles.Role(data.Scope))
+	if err != nil {
+		return APIKeyDetail{}, err
+	}
+
+	return APIKeyDetail{
+		APIKeyOut: repo.APIKeyOut{
+			ID:        created.ID,
+			Name:      created.Name,
+			Scope:     data.Scope,
+			CreatedAt: created.CreatedAt,
+			ExpiresAt: created.ExpiresAt,
+		},
+		Token: token.Raw,
+	}, nil
+}
+
+// RevokeAPIKey deletes an API key of the current user.
+func (svc *UserService) RevokeAPIKey(ctx Context, ID uuid.UUID) error {
+	_, err := svc.repos.AuthTokens.GetAPIKey(ctx, ctx.UID, ID)
+	if err != nil {
+		return err
+	}
+
+	return svc.repos.AuthTokens.DeleteAPIKey(ctx, ctx.UID, ID)
+}
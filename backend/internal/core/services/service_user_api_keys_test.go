@@ -0,0 +1,38 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/hay-kot/homebox/backend/internal/data/ent/authroles"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_APIKeys(t *testing.T) {
+	key, err := tSvc.User.CreateAPIKey(tCtx, repo.APIKeyCreate{
+		Name:  "backup script",
+		Scope: authroles.RoleItemsWrite.String(),
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, key.Token)
+	assert.True(t, key.ExpiresAt.After(key.CreatedAt.Add(apiKeyDefaultLifetime/2)))
+
+	// The key authenticates as the user with only the requested scope
+	self, err := tSvc.User.GetSelf(tCtx, key.Token)
+	require.NoError(t, err)
+	assert.Equal(t, tUser.ID, self.ID)
+
+	roles, err := tRepos.AuthTokens.GetRoles(tCtx, key.Token)
+	require.NoError(t, err)
+	assert.True(t, roles.Contains(authroles.RoleItemsWrite.String()))
+	assert.False(t, roles.Contains(authroles.RoleUser.String()))
+
+	require.NoError(t, tSvc.User.RevokeAPIKey(tCtx, key.ID))
+
+	_, err = tSvc.User.GetSelf(tCtx, key.Token)
+	require.Error(t, err)
+
+	// Revoking twice reports the key as missing
+	require.Error(t, tSvc.User.RevokeAPIKey(tCtx, key.ID))
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+	"github.com/hay-kot/homebox/backend/pkgs/hasher"
+	"github.com/rs/zerolog/log"
+)
+
+// invitation returns the group invitation of the token. ErrorInvalidToken is returned
+// for unknown, expired and used up invitations.
+func (svc *UserService) invitation(ctx context.Context, token string) (repo.GroupInvitation, error) {
+	inv, err := svc.repos.Groups.InvitationGet(ctx, hasher.HashToken(token))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return repo.GroupInvitation{}, ErrorInvalidToken
+		}
+		return repo.GroupInvitation{}, err
+	}
+
+	if inv.Uses <= 0 || inv.ExpiresAt.Before(time.Now()) {
+		return repo.GroupInvitation{}, ErrorInvalidToken
+	}
+
+	return inv, nil
+}
+
+// GetGroups returns the groups the user is a member of, the active group is marked.
+func (svc *UserService) GetGroups(ctx Context) ([]repo.GroupMembershipOut, error) {
+	groups, err := svc.repos.Memberships.GetAll(ctx, ctx.UID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range groups {
+		groups[i].Active = groups[i].GroupID == ctx.GID
+	}
+
+	return groups, nil
+}
+
+// SwitchGroup changes the active group of the session of the request to another group
+// the user is a member of. The user is returned as a member of the new group.
+func (svc *UserService) SwitchGroup(ctx Context, GID uuid.UUID) (repo.UserOut, error) {
+	_, err := svc.repos.Memberships.GetOne(ctx, ctx.UID, GID)
+	if err != nil {
+		return repo.UserOut{}, err
+	}
+
+	err = svc.repos.AuthTokens.SetGroup(ctx, hasher.HashToken(UseTokenCtx(ctx)), GID)
+	if err != nil {
+		return repo.UserOut{}, err
+	}
+
+	return svc.repos.Users.GetMember(ctx, GID, ctx.UID)
+}
+
+// JoinGroup adds the user to the group of an invitation as an editor, the active group
+// is not changed.
+func (svc *UserService) JoinGroup(ctx Context, token string) (repo.GroupMembershipOut, error) {
+	return svc.joinGroup(ctx, ctx.UID, token)
+}
+
+func (svc *UserService) joinGroup(ctx context.Context, userID uuid.UUID, token string) (repo.GroupMembershipOut, error) {
+	inv, err := svc.invitation(ctx, token)
+	if err != nil {
+		return repo.GroupMembershipOut{}, err
+	}
+
+	_, err = svc.repos.Memberships.GetOne(ctx, userID, inv.Group.ID)
+	switch {
+	case err == nil:
+		return repo.GroupMembershipOut{}, validate.NewFieldErrors(
+			validate.NewFieldError("token", "already a member of the group"),
+		)
+	case !ent.IsNotFound(err):
+		return repo.GroupMembershipOut{}, err
+	}
+
+	membership, err := svc.repos.Memberships.Create(ctx, userID, inv.Group.ID, groupmembership.RoleEditor)
+	if err != nil {
+		return repo.GroupMembershipOut{}, err
+	}
+
+	err = svc.repos.Groups.InvitationUpdate(ctx, inv.ID, inv.Uses-1)
+	if err != nil {
+		return repo.GroupMembershipOut{}, err
+	}
+
+	log.Debug().Str("user", userID.String()).Str("group", inv.Group.ID.String()).Msg("user joined group")
+
+	return membership, nil
+}
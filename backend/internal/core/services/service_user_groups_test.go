@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/internal/sys/validate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// useOtherGroup creates a group with an owner and returns the context of the owner.
+func useOtherGroup(t *testing.T) Context {
+	t.Helper()
+
+	group, err := tRepos.Groups.GroupCreate(context.Background(), fk.Str(10))
+	require.NoError(t, err)
+
+	owner, err := tRepos.Users.Create(context.Background(), repo.UserCreate{
+		Name:     fk.Str(10),
+		Email:    fk.Email(),
+		Password: fk.Str(10),
+		GroupID:  group.ID,
+		IsOwner:  true,
+	})
+	require.NoError(t, err)
+
+	return NewContext(SetUserCtx(context.Background(), &owner, ""))
+}
+
+func TestUserService_JoinGroup(t *testing.T) {
+	ctx, _ := useSessions(t, 1)
+	other := useOtherGroup(t)
+
+	_, err := tSvc.User.JoinGroup(ctx, "not-a-token")
+	require.ErrorIs(t, err, ErrorInvalidToken)
+
+	expired, err := tSvc.Group.NewInvitation(other, 1, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+
+	_, err = tSvc.User.JoinGroup(ctx, expired)
+	require.ErrorIs(t, err, ErrorInvalidToken)
+
+	token, err := tSvc.Group.NewInvitation(other, 2, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	joined, err := tSvc.User.JoinGroup(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, other.GID, joined.GroupID)
+	assert.Equal(t, "editor", joined.Role)
+
+	// Joining twice is rejected without using up the invitation.
+	_, err = tSvc.User.JoinGroup(ctx, token)
+	require.True(t, validate.IsFieldError(err))
+
+	groups, err := tSvc.User.GetGroups(ctx)
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+
+	for _, g := range groups {
+		assert.Equal(t, g.GroupID == tGroup.ID, g.Active)
+	}
+}
+
+func TestUserService_SwitchGroup(t *testing.T) {
+	ctx, tokens := useSessions(t, 1)
+	other := useOtherGroup(t)
+
+	// Groups the user is not a member of can not be switched to.
+	_, err := tSvc.User.SwitchGroup(ctx, other.GID)
+	require.True(t, ent.IsNotFound(err))
+
+	token, err := tSvc.Group.NewInvitation(other, 1, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	_, err = tSvc.User.JoinGroup(ctx, token)
+	require.NoError(t, err)
+
+	usr, err := tSvc.User.SwitchGroup(ctx, other.GID)
+	require.NoError(t, err)
+	assert.Equal(t, other.GID, usr.GroupID)
+	assert.Equal(t, "editor", usr.Role)
+
+	// The session keeps acting in the new group.
+	self, err := tSvc.User.GetSelf(context.Background(), tokens[0].Raw)
+	require.NoError(t, err)
+	assert.Equal(t, other.GID, self.GroupID)
+
+	switched := NewContext(SetUserCtx(context.Background(), &self, tokens[0].Raw))
+	assert.True(t, switched.Can(PermissionWrite))
+	assert.False(t, switched.Can(PermissionManage))
+
+	// The role within the default group is unaffected.
+	usr, err = tSvc.User.SwitchGroup(switched, tGroup.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tGroup.ID, usr.GroupID)
+}
+
+func TestGroupService_RemoveMember(t *testing.T) {
+	ctx, tokens := useSessions(t, 1)
+	other := useOtherGroup(t)
+
+	token, err := tSvc.Group.NewInvitation(other, 1, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	_, err = tSvc.User.JoinGroup(ctx, token)
+	require.NoError(t, err)
+
+	usr := tUser
+	usr.IsOwner = true
+	usr.Role = "owner"
+	owner := NewContext(SetUserCtx(context.Background(), &usr, ""))
+
+	// The member is moved to their other group instead of being deleted, the session
+	// acting in the default group follows.
+	err = tSvc.Group.RemoveMember(owner, ctx.UID)
+	require.NoError(t, err)
+
+	self, err := tSvc.User.GetSelf(context.Background(), tokens[0].Raw)
+	require.NoError(t, err)
+	assert.Equal(t, other.GID, self.GroupID)
+
+	groups, err := tSvc.User.GetGroups(NewContext(SetUserCtx(context.Background(), &self, tokens[0].Raw)))
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.True(t, groups[0].Active)
+}
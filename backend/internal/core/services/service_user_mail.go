@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/usertoken"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/pkgs/hasher"
+	"github.com/hay-kot/homebox/backend/pkgs/mailer"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrorMailerNotConfigured = errors.New("mailer is not configured")
+	ErrorEmailNotVerified    = errors.New("email address is not verified")
+)
+
+// tokenMail describes the mail sent for a purpose of a user token.
+type tokenMail struct {
+	subject string
+	path    string
+	expiry  time.Duration
+	expires string
+	render  func(mailer.TemplateProps) (string, error)
+}
+
+var tokenMails = map[usertoken.Purpose]tokenMail{
+	usertoken.PurposePasswordReset: {
+		subject: "Reset your Homebox password",
+		path:    "/reset-password",
+		expiry:  time.Hour,
+		expires: "1 hour",
+		render:  mailer.RenderPasswordReset,
+	},
+	usertoken.PurposeEmailVerification: {
+		subject: "Verify your Homebox email address",
+		path:    "/verify-email",
+		expiry:  48 * time.Hour,
+		expires: "48 hours",
+		render:  mailer.RenderVerifyEmail,
+	},
+}
+
+func (svc *UserService) mailerReady() bool {
+	return svc.mailer != nil && svc.mailer.Ready()
+}
+
+// tokenLink issues a new token for the user and returns the link containing it, earlier
+// tokens with the same purpose are invalidated.
+func (svc *UserService) tokenLink(ctx context.Context, userID uuid.UUID, purpose usertoken.Purpose) (string, error) {
+	tm := tokenMails[purpose]
+	token := hasher.GenerateToken()
+
+	err := svc.repos.UserTokens.Create(ctx, repo.UserTokenCreate{
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: token.Hash,
+		ExpiresAt: time.Now().Add(tm.expiry),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return svc.baseURL + tm.path + "?token=" + url.QueryEscape(token.Raw), nil
+}
+
+// sendTokenMail mails a link containing a new token to the user, see tokenLink.
+func (svc *UserService) sendTokenMail(ctx context.Context, usr repo.UserOut, purpose usertoken.Purpose) error {
+	if !svc.mailerReady() {
+		return ErrorMailerNotConfigured
+	}
+
+	tm := tokenMails[purpose]
+
+	link, err := svc.tokenLink(ctx, usr.ID, purpose)
+	if err != nil {
+		return err
+	}
+
+	data := mailer.TemplateProps{
+		Defaults: mailer.TemplateDefaults{
+			CompanyName: "Homebox",
+			CompanyURL:  svc.baseURL,
+		},
+		Data: make(map[string]string),
+	}
+	data.Set("Name", usr.Name)
+	data.Set("URL", link)
+	data.Set("Expires", tm.expires)
+
+	body, err := tm.render(data)
+	if err != nil {
+		return err
+	}
+
+	msg := mailer.NewMessageBuilder().
+		SetSubject(tm.subject).
+		SetTo(usr.Name, usr.Email).
+		SetFrom("Homebox", svc.mailer.From).
+		SetBody(body).
+		Build()
+
+	return svc.mailer.Send(msg)
+}
+
+// RequestPasswordReset mails a password reset link to the user with the email address.
+// No error is returned for unknown addresses so that the response does not reveal
+// which addresses have an account.
+func (svc *UserService) RequestPasswordReset(ctx context.Context, email string) error {
+	if !svc.mailerReady() {
+		return ErrorMailerNotConfigured
+	}
+
+	usr, err := svc.repos.Users.GetOneEmail(ctx, email)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Debug().Str("email", email).Msg("password reset requested for unknown email")
+			return nil
+		}
+		return err
+	}
+
+	return svc.sendTokenMail(ctx, usr, usertoken.PurposePasswordReset)
+}
+
+// ResetPassword sets a new password for the user the reset token was issued to. The
+// token can only be used once, all sessions of the user are revoked and, as the user
+// received the mail, the email address is marked as verified.
+func (svc *UserService) ResetPassword(ctx context.Context, token, password string) error {
+	userID, err := svc.repos.UserTokens.Consume(ctx, usertoken.PurposePasswordReset, hasher.HashToken(token))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrorInvalidToken
+		}
+		return err
+	}
+
+	hashed, err := hasher.HashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	err = svc.repos.Users.ChangePassword(ctx, userID, hashed)
+	if err != nil {
+		return err
+	}
+
+	err = svc.repos.Users.Activate(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = svc.repos.AuthTokens.DeleteSessions(ctx, userID)
+	return err
+}
+
+// SendVerification mails an email verification link to the user with the email address.
+// Like RequestPasswordReset no error is returned for unknown or verified addresses.
+func (svc *UserService) SendVerification(ctx context.Context, email string) error {
+	if !svc.mailerReady() {
+		return ErrorMailerNotConfigured
+	}
+
+	usr, err := svc.repos.Users.GetOneEmail(ctx, email)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if usr.EmailVerified {
+		return nil
+	}
+
+	return svc.sendTokenMail(ctx, usr, usertoken.PurposeEmailVerification)
+}
+
+// VerifyEmail marks the email address of the user the verification token was issued to
+// as verified.
+func (svc *UserService) VerifyEmail(ctx context.Context, token string) error {
+	userID, err := svc.repos.UserTokens.Consume(ctx, usertoken.PurposeEmailVerification, hasher.HashToken(token))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrorInvalidToken
+		}
+		return err
+	}
+
+	return svc.repos.Users.Activate(ctx, userID)
+}
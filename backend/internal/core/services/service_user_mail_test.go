@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hay-kot/homebox/backend/internal/data/ent/usertoken"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/pkgs/hasher"
+	"github.com/hay-kot/homebox/backend/pkgs/mailer/mailertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var tokenLink = regexp.MustCompile(`href="https://homebox\.example\.com(/[a-z-]+)\?token=([^"]+)"`)
+
+func newMailUserService(t *testing.T, requireVerification bool) (*UserService, *mailertest.Server) {
+	t.Helper()
+
+	srv, err := mailertest.NewServer()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = srv.Close() })
+
+	svc := &UserService{
+		repos:               tRepos,
+		mailer:              srv.Mailer(),
+		baseURL:             "https://homebox.example.com",
+		requireVerification: requireVerification,
+	}
+
+	return svc, srv
+}
+
+// lastLink returns the path and token of the link in the last mail sent to the address.
+func lastLink(t *testing.T, srv *mailertest.Server, email string) (string, string) {
+	t.Helper()
+
+	messages := srv.Messages()
+	require.NotEmpty(t, messages)
+
+	msg := messages[len(messages)-1]
+	require.Equal(t, []string{email}, msg.To)
+
+	match := tokenLink.FindStringSubmatch(msg.Body)
+	require.NotNil(t, match, "mail contains no token link")
+
+	return match[1], match[2]
+}
+
+func TestUserService_EmailVerification(t *testing.T) {
+	ctx := context.Background()
+	svc, srv := newMailUserService(t, true)
+
+	email := fk.Email()
+	usr, err := svc.RegisterUser(ctx, UserRegistration{
+		Name:     fk.Str(10),
+		Email:    email,
+		Password: "password",
+	})
+	require.NoError(t, err)
+	assert.False(t, usr.EmailVerified)
+
+	path, first := lastLink(t, srv, email)
+	assert.Equal(t, "/verify-email", path)
+	assert.Equal(t, "Verify your Homebox email address", srv.Messages()[0].Subject)
+
+	_, err = svc.Login(ctx, email, "password", false)
+	require.ErrorIs(t, err, ErrorEmailNotVerified)
+
+	// A wrong password is reported as such, the verification state is not revealed.
+	_, err = svc.Login(ctx, email, "wrong", false)
+	require.ErrorIs(t, err, ErrorInvalidLogin)
+
+	// Resending invalidates the previous link.
+	require.NoError(t, svc.SendVerification(ctx, email))
+	_, second := lastLink(t, srv, email)
+	assert.NotEqual(t, first, second)
+	require.ErrorIs(t, svc.VerifyEmail(ctx, first), ErrorInvalidToken)
+
+	require.NoError(t, svc.VerifyEmail(ctx, second))
+	require.ErrorIs(t, svc.VerifyEmail(ctx, second), ErrorInvalidToken)
+
+	usr, err = tRepos.Users.GetOneEmail(ctx, email)
+	require.NoError(t, err)
+	assert.True(t, usr.EmailVerified)
+
+	_, err = svc.Login(ctx, email, "password", false)
+	require.NoError(t, err)
+
+	// Verified and unknown addresses are not mailed.
+	srv.Reset()
+	require.NoError(t, svc.SendVerification(ctx, email))
+	require.NoError(t, svc.SendVerification(ctx, fk.Email()))
+	assert.Empty(t, srv.Messages())
+}
+
+func TestUserService_RegisterWithoutVerification(t *testing.T) {
+	ctx := context.Background()
+	svc, srv := newMailUserService(t, false)
+
+	email := fk.Email()
+	usr, err := svc.RegisterUser(ctx, UserRegistration{
+		Name:     fk.Str(10),
+		Email:    email,
+		Password: "password",
+	})
+	require.NoError(t, err)
+	assert.True(t, usr.EmailVerified)
+	assert.Empty(t, srv.Messages())
+
+	_, err = svc.Login(ctx, email, "password", false)
+	require.NoError(t, err)
+}
+
+func TestUserService_PasswordReset(t *testing.T) {
+	ctx := context.Background()
+	svc, srv := newMailUserService(t, false)
+
+	email := fk.Email()
+	_, err := svc.RegisterUser(ctx, UserRegistration{
+		Name:     fk.Str(10),
+		Email:    email,
+		Password: "old-password",
+	})
+	require.NoError(t, err)
+
+	session, err := svc.Login(ctx, email, "old-password", false)
+	require.NoError(t, err)
+
+	// Unknown addresses are accepted without sending a mail.
+	require.NoError(t, svc.RequestPasswordReset(ctx, fk.Email()))
+	assert.Empty(t, srv.Messages())
+
+	require.NoError(t, svc.RequestPasswordReset(ctx, email))
+	path, token := lastLink(t, srv, email)
+	assert.Equal(t, "/reset-password", path)
+	assert.Equal(t, "Reset your Homebox password", srv.Messages()[0].Subject)
+
+	// A verification token cannot be used to reset a password.
+	require.ErrorIs(t, svc.VerifyEmail(ctx, token), ErrorInvalidToken)
+
+	require.NoError(t, svc.ResetPassword(ctx, token, "new-password"))
+	require.ErrorIs(t, svc.ResetPassword(ctx, token, "other-password"), ErrorInvalidToken)
+
+	_, err = svc.Login(ctx, email, "old-password", false)
+	require.ErrorIs(t, err, ErrorInvalidLogin)
+
+	_, err = svc.Login(ctx, email, "new-password", false)
+	require.NoError(t, err)
+
+	// Existing sessions are revoked.
+	_, err = svc.GetSelf(ctx, session.Raw)
+	require.Error(t, err)
+}
+
+func TestUserService_PasswordReset_Expired(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newMailUserService(t, false)
+
+	token := hasher.GenerateToken()
+	err := tRepos.UserTokens.Create(ctx, repo.UserTokenCreate{
+		UserID:    tUser.ID,
+		Purpose:   usertoken.PurposePasswordReset,
+		TokenHash: token.Hash,
+		ExpiresAt: time.Now().Add(-time.Minute),
+	})
+	require.NoError(t, err)
+
+	require.ErrorIs(t, svc.ResetPassword(ctx, token.Raw, "new-password"), ErrorInvalidToken)
+}
+
+func TestUserService_MailerNotConfigured(t *testing.T) {
+	ctx := context.Background()
+
+	require.ErrorIs(t, tSvc.User.RequestPasswordReset(ctx, tUser.Email), ErrorMailerNotConfigured)
+	require.ErrorIs(t, tSvc.User.SendVerification(ctx, tUser.Email), ErrorMailerNotConfigured)
+}
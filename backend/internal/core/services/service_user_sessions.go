@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/pkgs/hasher"
+)
+
+// sessionUseInterval is the precision of the last use of a session, requests made
+// within the interval from the same client are not recorded.
+const sessionUseInterval = time.Minute
+
+// RecordTokenUse stores the address and user agent of a request authenticated with the
+// token, they are shown in the session list of the user.
+func (svc *UserService) RecordTokenUse(ctx context.Context, token, ipAddress, userAgent string) error {
+	return svc.repos.AuthTokens.RecordUse(ctx, hasher.HashToken(token), ipAddress, userAgent, sessionUseInterval)
+}
+
+// GetSessions returns the active sessions of the user, the session of the request is
+// marked as current.
+func (svc *UserService) GetSessions(ctx Context) ([]repo.UserSessionOut, error) {
+	return svc.repos.AuthTokens.GetSessions(ctx, ctx.UID, hasher.HashToken(UseTokenCtx(ctx)))
+}
+
+// RevokeSession logs the user out of a session.
+func (svc *UserService) RevokeSession(ctx Context, ID uuid.UUID) error {
+	return svc.repos.AuthTokens.DeleteSession(ctx, ctx.UID, ID)
+}
+
+// RevokeOtherSessions logs the user out of all sessions except the session of the
+// request, API keys are kept.
+func (svc *UserService) RevokeOtherSessions(ctx Context) (int, error) {
+	return svc.repos.AuthTokens.DeleteOtherSessions(ctx, ctx.UID, hasher.HashToken(UseTokenCtx(ctx)))
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/pkgs/hasher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// useSessions creates a user with the password "password" and logs it in n times, it
+// returns the context of the first session and the tokens of all sessions.
+func useSessions(t *testing.T, n int) (Context, []UserAuthTokenDetail) {
+	t.Helper()
+
+	hashed, err := hasher.HashPassword("password")
+	require.NoError(t, err)
+
+	usr, err := tRepos.Users.Create(context.Background(), repo.UserCreate{
+		Name:      fk.Str(10),
+		Email:     fk.Email(),
+		Password:  hashed,
+		GroupID:   tGroup.ID,
+		Activated: true,
+	})
+	require.NoError(t, err)
+
+	tokens := make([]UserAuthTokenDetail, n)
+	for i := range tokens {
+		tokens[i], err = tSvc.User.Login(context.Background(), usr.Email, "password", false)
+		require.NoError(t, err)
+	}
+
+	ctx := NewContext(SetUserCtx(context.Background(), &usr, tokens[0].Raw))
+	return ctx, tokens
+}
+
+func TestUserService_Sessions(t *testing.T) {
+	ctx, tokens := useSessions(t, 3)
+
+	err := tSvc.User.RecordTokenUse(context.Background(), tokens[1].Raw, "192.0.2.1", "Firefox")
+	require.NoError(t, err)
+
+	sessions, err := tSvc.User.GetSessions(ctx)
+	require.NoError(t, err)
+	require.Len(t, sessions, 3)
+
+	var current, used repo.UserSessionOut
+	for _, s := range sessions {
+		if s.Current {
+			current = s
+		}
+		if s.UserAgent == "Firefox" {
+			used = s
+		}
+	}
+	require.NotEqual(t, current.ID, used.ID)
+	assert.Equal(t, "192.0.2.1", used.IPAddress)
+	assert.NotNil(t, used.LastUsedAt)
+
+	// Revoking a session also revokes its attachment token.
+	err = tSvc.User.RevokeSession(ctx, used.ID)
+	require.NoError(t, err)
+
+	_, err = tSvc.User.GetSelf(context.Background(), tokens[1].Raw)
+	require.Error(t, err)
+
+	_, err = tSvc.User.GetSelf(context.Background(), tokens[1].AttachmentToken)
+	require.Error(t, err)
+
+	// Sessions of other users can not be revoked.
+	err = tSvc.User.RevokeSession(tCtx, current.ID)
+	require.Error(t, err)
+
+	n, err := tSvc.User.RevokeOtherSessions(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	sessions, err = tSvc.User.GetSessions(ctx)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.True(t, sessions[0].Current)
+
+	_, err = tSvc.User.GetSelf(context.Background(), tokens[0].AttachmentToken)
+	require.NoError(t, err)
+}
+
+func TestUserService_ChangePasswordRevokesOtherSessions(t *testing.T) {
+	ctx, tokens := useSessions(t, 2)
+
+	ok := tSvc.User.ChangePassword(ctx, "password", "new-password")
+	require.True(t, ok)
+
+	_, err := tSvc.User.GetSelf(context.Background(), tokens[0].Raw)
+	require.NoError(t, err)
+
+	_, err = tSvc.User.GetSelf(context.Background(), tokens[1].Raw)
+	require.Error(t, err)
+}
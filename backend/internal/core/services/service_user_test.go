@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_LoginExternal_ExistingUser(t *testing.T) {
+	token, err := tSvc.User.LoginExternal(context.Background(), ExternalIdentity{
+		Email: tUser.Email,
+		Name:  tUser.Name,
+	}, false)
+	require.NoError(t, err)
+	assert.NotEmpty(t, token.Raw)
+
+	self, err := tSvc.User.GetSelf(context.Background(), token.Raw)
+	require.NoError(t, err)
+	assert.Equal(t, tUser.ID, self.ID)
+}
+
+func TestUserService_LoginExternal_ConfiguredGroup(t *testing.T) {
+	email := fk.Email()
+
+	token, err := tSvc.User.LoginExternal(context.Background(), ExternalIdentity{
+		Email:   email,
+		Name:    fk.Str(10),
+		GroupID: tGroup.ID,
+	}, true)
+	require.NoError(t, err)
+
+	self, err := tSvc.User.GetSelf(context.Background(), token.Raw)
+	require.NoError(t, err)
+	assert.Equal(t, email, self.Email)
+	assert.Equal(t, tGroup.ID, self.GroupID)
+	assert.False(t, self.IsOwner)
+
+	t.Cleanup(func() {
+		_ = tRepos.Users.Delete(context.Background(), self.ID)
+	})
+}
+
+func TestUserService_LoginExternal_NewGroup(t *testing.T) {
+	token, err := tSvc.User.LoginExternal(context.Background(), ExternalIdentity{
+		Email:         fk.Email(),
+		Name:          fk.Str(10),
+		AllowNewGroup: true,
+	}, false)
+	require.NoError(t, err)
+
+	self, err := tSvc.User.GetSelf(context.Background(), token.Raw)
+	require.NoError(t, err)
+	assert.NotEqual(t, tGroup.ID, self.GroupID)
+	assert.NotEqual(t, uuid.Nil, self.GroupID)
+	assert.True(t, self.IsOwner)
+}
+
+func TestUserService_LoginExternal_NoGroup(t *testing.T) {
+	_, err := tSvc.User.LoginExternal(context.Background(), ExternalIdentity{
+		Email: fk.Email(),
+		Name:  fk.Str(10),
+	}, false)
+	require.ErrorIs(t, err, ErrorNoGroupForUser)
+}
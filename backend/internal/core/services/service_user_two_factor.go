@@ -0,0 +1,321 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/usertoken"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/pkgs/hasher"
+	"github.com/hay-kot/homebox/backend/pkgs/totp"
+)
+
+const (
+	twoFactorIssuer = "Homebox"
+	// twoFactorExpiry is the time a user has to enter the code after the password.
+	twoFactorExpiry = 5 * time.Minute
+	// twoFactorMaxAttempts is the number of wrong codes after which the login has to be
+	// started again with the password.
+	twoFactorMaxAttempts = 5
+	recoveryCodeCount    = 10
+)
+
+var (
+	ErrorTwoFactorEnabled     = errors.New("two-factor authentication is already enabled")
+	ErrorTwoFactorNotEnabled  = errors.New("two-factor authentication is not enabled")
+	ErrorTwoFactorNotStarted  = errors.New("two-factor enrollment has not been started")
+	ErrorInvalidTwoFactorCode = errors.New("invalid two-factor code")
+)
+
+// TwoFactorChallenge is returned by Login when the user has two-factor authentication enabled.
+// The login is completed by passing the token and a code to LoginTwoFactor.
+type TwoFactorChallenge struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+func (c *TwoFactorChallenge) Error() string {
+	return "two-factor authentication required"
+}
+
+type (
+	TwoFactorSetup struct {
+		Secret string `json:"secret"`
+		// URL is the otpauth:// URL authenticator apps scan as a QR code.
+		URL string `json:"url"`
+	}
+
+	TwoFactorRecoveryCodes struct {
+		RecoveryCodes []string `json:"recoveryCodes"`
+	}
+)
+
+// twoFactorChallenge issues the token identifying a login waiting for its second factor.
+func (svc *UserService) twoFactorChallenge(ctx context.Context, userID uuid.UUID) error {
+	token := hasher.GenerateToken()
+	expiresAt := time.Now().Add(twoFactorExpiry)
+
+	err := svc.repos.UserTokens.Create(ctx, repo.UserTokenCreate{
+		UserID:    userID,
+		Purpose:   usertoken.PurposeTwoFactor,
+		TokenHash: token.Hash,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return &TwoFactorChallenge{
+		Token:     token.Raw,
+		ExpiresAt: expiresAt,
+	}
+}
+
+// LoginTwoFactor completes a login started with Login, the code is either a TOTP code or
+// one of the recovery codes of the user.
+func (svc *UserService) LoginTwoFactor(ctx context.Context, token, code string, extendedSession bool) (UserAuthTokenDetail, error) {
+	hash := hasher.HashToken(token)
+
+	challenge, err := svc.repos.UserTokens.Get(ctx, usertoken.PurposeTwoFactor, hash)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return UserAuthTokenDetail{}, ErrorInvalidToken
+		}
+		return UserAuthTokenDetail{}, err
+	}
+
+	ok, err := svc.verifyTwoFactor(ctx, challenge.UserID, code)
+	if err != nil {
+		return UserAuthTokenDetail{}, err
+	}
+
+	if !ok {
+		err = svc.repos.UserTokens.RecordFailure(ctx, challenge.ID, twoFactorMaxAttempts)
+		if err != nil {
+			return UserAuthTokenDetail{}, err
+		}
+		return UserAuthTokenDetail{}, ErrorInvalidTwoFactorCode
+	}
+
+	_, err = svc.repos.UserTokens.Consume(ctx, usertoken.PurposeTwoFactor, hash)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return UserAuthTokenDetail{}, ErrorInvalidToken
+		}
+		return UserAuthTokenDetail{}, err
+	}
+
+	return svc.createSessionToken(ctx, challenge.UserID, extendedSession)
+}
+
+// verifyTwoFactor checks a TOTP or recovery code of a user with two-factor authentication
+// enabled, an accepted code can not be used again.
+func (svc *UserService) verifyTwoFactor(ctx context.Context, userID uuid.UUID, code string) (bool, error) {
+	tf, err := svc.repos.Users.GetTwoFactor(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	if !tf.Enabled {
+		return false, nil
+	}
+
+	if step, ok := totp.Verify(tf.Secret, code, time.Now()); ok {
+		return svc.repos.Users.UseTOTPStep(ctx, userID, step)
+	}
+
+	return svc.repos.Users.UseRecoveryCode(ctx, userID, hashRecoveryCode(code))
+}
+
+// reauthenticate confirms the identity of a logged in user with their password and a
+// two-factor code before two-factor settings are changed.
+func (svc *UserService) reauthenticate(ctx Context, password, code string) error {
+	usr, err := svc.repos.Users.GetOneID(ctx, ctx.UID)
+	if err != nil {
+		return err
+	}
+
+	if !hasher.CheckPasswordHash(password, usr.PasswordHash) {
+		return ErrorInvalidLogin
+	}
+
+	if !usr.TwoFactorEnabled {
+		return ErrorTwoFactorNotEnabled
+	}
+
+	ok, err := svc.verifyTwoFactor(ctx, ctx.UID, code)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return ErrorInvalidTwoFactorCode
+	}
+
+	return nil
+}
+
+// SetupTwoFactor starts the enrollment of the user with a new secret, the enrollment is
+// completed by EnableTwoFactor with a code of the secret.
+func (svc *UserService) SetupTwoFactor(ctx Context) (TwoFactorSetup, error) {
+	usr, err := svc.repos.Users.GetOneID(ctx, ctx.UID)
+	if err != nil {
+		return TwoFactorSetup{}, err
+	}
+
+	if usr.TwoFactorEnabled {
+		return TwoFactorSetup{}, ErrorTwoFactorEnabled
+	}
+
+	secret, err := totp.NewSecret()
+	if err != nil {
+		return TwoFactorSetup{}, err
+	}
+
+	err = svc.repos.Users.SetTwoFactorSecret(ctx, ctx.UID, secret)
+	if err != nil {
+		return TwoFactorSetup{}, err
+	}
+
+	return TwoFactorSetup{
+		Secret: secret,
+		URL:    totp.URL(twoFactorIssuer, usr.Email, secret),
+	}, nil
+}
+
+// TwoFactorSetupURL returns the provisioning URL of the enrollment in progress.
+func (svc *UserService) TwoFactorSetupURL(ctx Context) (string, error) {
+	usr, err := svc.repos.Users.GetOneID(ctx, ctx.UID)
+	if err != nil {
+		return "", err
+	}
+
+	tf, err := svc.repos.Users.GetTwoFactor(ctx, ctx.UID)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case tf.Enabled:
+		return "", ErrorTwoFactorEnabled
+	case tf.Secret == "":
+		return "", ErrorTwoFactorNotStarted
+	}
+
+	return totp.URL(twoFactorIssuer, usr.Email, tf.Secret), nil
+}
+
+// EnableTwoFactor completes the enrollment when the code matches the new secret and returns
+// the recovery codes of the user, they are only shown once.
+func (svc *UserService) EnableTwoFactor(ctx Context, code string) (TwoFactorRecoveryCodes, error) {
+	tf, err := svc.repos.Users.GetTwoFactor(ctx, ctx.UID)
+	if err != nil {
+		return TwoFactorRecoveryCodes{}, err
+	}
+
+	switch {
+	case tf.Enabled:
+		return TwoFactorRecoveryCodes{}, ErrorTwoFactorEnabled
+	case tf.Secret == "":
+		return TwoFactorRecoveryCodes{}, ErrorTwoFactorNotStarted
+	}
+
+	step, ok := totp.Verify(tf.Secret, code, time.Now())
+	if !ok {
+		return TwoFactorRecoveryCodes{}, ErrorInvalidTwoFactorCode
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return TwoFactorRecoveryCodes{}, err
+	}
+
+	err = svc.repos.Users.EnableTwoFactor(ctx, ctx.UID, step, hashes)
+	if err != nil {
+		return TwoFactorRecoveryCodes{}, err
+	}
+
+	return TwoFactorRecoveryCodes{RecoveryCodes: codes}, nil
+}
+
+// DisableTwoFactor turns off two-factor authentication after confirming the password and
+// a code of the user.
+func (svc *UserService) DisableTwoFactor(ctx Context, password, code string) error {
+	err := svc.reauthenticate(ctx, password, code)
+	if err != nil {
+		return err
+	}
+
+	return svc.repos.Users.DisableTwoFactor(ctx, ctx.UID)
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the user after confirming the
+// password and a code of the user.
+func (svc *UserService) RegenerateRecoveryCodes(ctx Context, password, code string) (TwoFactorRecoveryCodes, error) {
+	err := svc.reauthenticate(ctx, password, code)
+	if err != nil {
+		return TwoFactorRecoveryCodes{}, err
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return TwoFactorRecoveryCodes{}, err
+	}
+
+	err = svc.repos.Users.SetRecoveryCodes(ctx, ctx.UID, hashes)
+	if err != nil {
+		return TwoFactorRecoveryCodes{}, err
+	}
+
+	return TwoFactorRecoveryCodes{RecoveryCodes: codes}, nil
+}
+
+// recoveryCodeAlphabet is the Crockford base32 alphabet, it has no ambiguous characters
+// and 32 characters map every random byte without bias.
+const recoveryCodeAlphabet = "0123456789abcdefghjkmnpqrstvwxyz"
+
+// newRecoveryCodes returns recovery codes formatted as xxxxx-xxxxx and their hashes.
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+
+	buf := make([]byte, 10)
+	for i := range codes {
+		_, err := rand.Read(buf)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		bldr := strings.Builder{}
+		for j, b := range buf {
+			if j == 5 {
+				bldr.WriteByte('-')
+			}
+			bldr.WriteByte(recoveryCodeAlphabet[b%32])
+		}
+
+		codes[i] = bldr.String()
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode hashes a recovery code ignoring case, whitespace and dashes.
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' || r == '\t' {
+			return -1
+		}
+		return r
+	}, code)
+
+	return hex.EncodeToString(hasher.HashToken(code))
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/pkgs/hasher"
+	"github.com/hay-kot/homebox/backend/pkgs/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// useTwoFactorUser creates a user with the password "password" and two-factor
+// authentication enabled, it returns the context of the user, the secret and the
+// recovery codes.
+func useTwoFactorUser(t *testing.T) (Context, string, []string) {
+	t.Helper()
+
+	hashed, err := hasher.HashPassword("password")
+	require.NoError(t, err)
+
+	usr, err := tRepos.Users.Create(context.Background(), repo.UserCreate{
+		Name:      fk.Str(10),
+		Email:     fk.Email(),
+		Password:  hashed,
+		GroupID:   tGroup.ID,
+		Activated: true,
+	})
+	require.NoError(t, err)
+
+	ctx := Context{
+		Context: context.Background(),
+		GID:     tGroup.ID,
+		UID:     usr.ID,
+		User:    &usr,
+	}
+
+	setup, err := tSvc.User.SetupTwoFactor(ctx)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(setup.URL, "otpauth://totp/Homebox:"))
+
+	url, err := tSvc.User.TwoFactorSetupURL(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, setup.URL, url)
+
+	_, err = tSvc.User.EnableTwoFactor(ctx, "000000")
+	require.ErrorIs(t, err, ErrorInvalidTwoFactorCode)
+
+	codes, err := tSvc.User.EnableTwoFactor(ctx, code(t, setup.Secret, 0))
+	require.NoError(t, err)
+	require.Len(t, codes.RecoveryCodes, recoveryCodeCount)
+
+	return ctx, setup.Secret, codes.RecoveryCodes
+}
+
+// code returns the TOTP code of the secret offset by a number of periods from now.
+func code(t *testing.T, secret string, periods int) string {
+	t.Helper()
+
+	c, err := totp.Code(secret, totp.Step(time.Now())+int64(periods))
+	require.NoError(t, err)
+	return c
+}
+
+func challenge(t *testing.T, email string) *TwoFactorChallenge {
+	t.Helper()
+
+	_, err := tSvc.User.Login(context.Background(), email, "password", false)
+
+	var c *TwoFactorChallenge
+	require.True(t, errors.As(err, &c), "expected a two-factor challenge, got %v", err)
+	return c
+}
+
+func TestUserService_TwoFactorLogin(t *testing.T) {
+	ctx, secret, recovery := useTwoFactorUser(t)
+	email := ctx.User.Email
+
+	usr, err := tRepos.Users.GetOneID(ctx, ctx.UID)
+	require.NoError(t, err)
+	assert.True(t, usr.TwoFactorEnabled)
+
+	_, err = tSvc.User.SetupTwoFactor(ctx)
+	require.ErrorIs(t, err, ErrorTwoFactorEnabled)
+
+	_, err = tSvc.User.Login(ctx, email, "wrong", false)
+	require.ErrorIs(t, err, ErrorInvalidLogin)
+
+	c := challenge(t, email)
+
+	// The code used to enable two-factor authentication can not be used again.
+	_, err = tSvc.User.LoginTwoFactor(ctx, c.Token, code(t, secret, 0), false)
+	require.ErrorIs(t, err, ErrorInvalidTwoFactorCode)
+
+	session, err := tSvc.User.LoginTwoFactor(ctx, c.Token, code(t, secret, 1), false)
+	require.NoError(t, err)
+	assert.NotEmpty(t, session.Raw)
+
+	self, err := tSvc.User.GetSelf(ctx, session.Raw)
+	require.NoError(t, err)
+	assert.Equal(t, ctx.UID, self.ID)
+
+	// The challenge is consumed by a successful login.
+	_, err = tSvc.User.LoginTwoFactor(ctx, c.Token, recovery[0], false)
+	require.ErrorIs(t, err, ErrorInvalidToken)
+
+	// Recovery codes are accepted once, case and dashes are ignored.
+	c = challenge(t, email)
+	_, err = tSvc.User.LoginTwoFactor(ctx, c.Token, strings.ToUpper(strings.ReplaceAll(recovery[0], "-", "")), false)
+	require.NoError(t, err)
+
+	c = challenge(t, email)
+	_, err = tSvc.User.LoginTwoFactor(ctx, c.Token, recovery[0], false)
+	require.ErrorIs(t, err, ErrorInvalidTwoFactorCode)
+}
+
+func TestUserService_TwoFactorLogin_MaxAttempts(t *testing.T) {
+	ctx, _, recovery := useTwoFactorUser(t)
+
+	c := challenge(t, ctx.User.Email)
+
+	for i := 0; i < twoFactorMaxAttempts; i++ {
+		_, err := tSvc.User.LoginTwoFactor(ctx, c.Token, "000000", false)
+		require.ErrorIs(t, err, ErrorInvalidTwoFactorCode)
+	}
+
+	_, err := tSvc.User.LoginTwoFactor(ctx, c.Token, recovery[0], false)
+	require.ErrorIs(t, err, ErrorInvalidToken)
+}
+
+func TestUserService_TwoFactorDisable(t *testing.T) {
+	ctx, secret, recovery := useTwoFactorUser(t)
+
+	err := tSvc.User.DisableTwoFactor(ctx, "wrong", code(t, secret, 1))
+	require.ErrorIs(t, err, ErrorInvalidLogin)
+
+	err = tSvc.User.DisableTwoFactor(ctx, "password", "000000")
+	require.ErrorIs(t, err, ErrorInvalidTwoFactorCode)
+
+	codes, err := tSvc.User.RegenerateRecoveryCodes(ctx, "password", recovery[0])
+	require.NoError(t, err)
+	require.Len(t, codes.RecoveryCodes, recoveryCodeCount)
+
+	// Previous recovery codes are replaced.
+	err = tSvc.User.DisableTwoFactor(ctx, "password", recovery[1])
+	require.ErrorIs(t, err, ErrorInvalidTwoFactorCode)
+
+	err = tSvc.User.DisableTwoFactor(ctx, "password", codes.RecoveryCodes[0])
+	require.NoError(t, err)
+
+	err = tSvc.User.DisableTwoFactor(ctx, "password", codes.RecoveryCodes[1])
+	require.ErrorIs(t, err, ErrorTwoFactorNotEnabled)
+
+	_, err = tSvc.User.TwoFactorSetupURL(ctx)
+	require.ErrorIs(t, err, ErrorTwoFactorNotStarted)
+
+	_, err = tSvc.User.Login(ctx, ctx.User.Email, "password", false)
+	require.NoError(t, err)
+}
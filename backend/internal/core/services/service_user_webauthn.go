@@ -0,0 +1,367 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/hay-kot/homebox/backend/pkgs/hasher"
+	"github.com/rs/zerolog/log"
+)
+
+// passkeyCeremonyTTL is the time a user has to complete a registration or login after it
+// was started.
+const passkeyCeremonyTTL = 5 * time.Minute
+
+var (
+	ErrorPasskeysDisabled = errors.New("passkeys require the base url of the instance to be configured")
+	ErrorInvalidPasskey   = errors.New("invalid passkey")
+)
+
+type (
+	// PasskeyLogin is the challenge of a passkey login. The options are passed to
+	// navigator.credentials.get() and the token identifies the login when the signed
+	// response is sent back.
+	PasskeyLogin struct {
+		Token     string
+		ExpiresAt time.Time
+		Options   *protocol.CredentialAssertion
+	}
+)
+
+// newWebAuthn configures the relying party from the public URL of the instance, passkeys
+// are bound to its host name. Nil is returned when no URL is configured.
+func newWebAuthn(baseURL string) *webauthn.WebAuthn {
+	if baseURL == "" {
+		return nil
+	}
+
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Hostname() == "" {
+		log.Warn().Str("url", baseURL).Msg("invalid base url, passkeys are disabled")
+		return nil
+	}
+
+	w, err := webauthn.New(&webauthn.Config{
+		RPID:          u.Hostname(),
+		RPDisplayName: "Homebox",
+		RPOrigins:     []string{u.Scheme + "://" + u.Host},
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementRequired,
+			UserVerification: protocol.VerificationRequired,
+		},
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to configure webauthn, passkeys are disabled")
+		return nil
+	}
+
+	return w
+}
+
+// ceremonyStore holds the state of passkey registrations and logins in progress. Every
+// ceremony can only be completed once.
+type ceremonyStore struct {
+	mu      sync.Mutex
+	entries map[string]ceremony
+}
+
+type ceremony struct {
+	session  webauthn.SessionData
+	remember bool
+	expires  time.Time
+}
+
+func newCeremonyStore() *ceremonyStore {
+	return &ceremonyStore{entries: map[string]ceremony{}}
+}
+
+func (s *ceremonyStore) put(key string, c ceremony) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for k, v := range s.entries {
+		if now.After(v.expires) {
+			delete(s.entries, k)
+		}
+	}
+
+	s.entries[key] = c
+}
+
+func (s *ceremonyStore) take(key string) (ceremony, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.entries[key]
+	delete(s.entries, key)
+
+	if !ok || time.Now().After(c.expires) {
+		return ceremony{}, false
+	}
+
+	return c, true
+}
+
+// passkeyUser adapts a user and its credentials to the webauthn.User interface. The
+// user handle stored on the authenticator is the ID of the user.
+type passkeyUser struct {
+	usr   repo.UserOut
+	creds []webauthn.Credential
+}
+
+func (u passkeyUser) WebAuthnID() []byte {
+	return u.usr.ID[:]
+}
+
+func (u passkeyUser) WebAuthnName() string {
+	return u.usr.Email
+}
+
+func (u passkeyUser) WebAuthnDisplayName() string {
+	return u.usr.Name
+}
+
+func (u passkeyUser) WebAuthnCredentials() []webauthn.Credential {
+	return u.creds
+}
+
+func (u passkeyUser) WebAuthnIcon() string {
+	return ""
+}
+
+func toWebAuthnCredential(c repo.WebAuthnCredential) webauthn.Credential {
+	transports := make([]protocol.AuthenticatorTransport, len(c.Key.Transports))
+	for i, t := range c.Key.Transports {
+		transports[i] = protocol.AuthenticatorTransport(t)
+	}
+
+	return webauthn.Credential{
+		ID:              c.Key.CredentialID,
+		PublicKey:       c.Key.PublicKey,
+		AttestationType: c.Key.AttestationType,
+		Transport:       transports,
+		Flags: webauthn.CredentialFlags{
+			BackupEligible: c.Key.BackupEligible,
+			BackupState:    c.Key.BackupState,
+		},
+		Authenticator: webauthn.Authenticator{
+			AAGUID:    c.Key.AAGUID,
+			SignCount: c.Key.SignCount,
+		},
+	}
+}
+
+func (svc *UserService) passkeyUser(ctx context.Context, userID uuid.UUID) (passkeyUser, error) {
+	usr, err := svc.repos.Users.GetOneID(ctx, userID)
+	if err != nil {
+		return passkeyUser{}, err
+	}
+
+	keys, err := svc.repos.WebAuthnCredentials.GetKeys(ctx, userID)
+	if err != nil {
+		return passkeyUser{}, err
+	}
+
+	creds := make([]webauthn.Credential, len(keys))
+	for i, k := range keys {
+		creds[i] = toWebAuthnCredential(k)
+	}
+
+	return passkeyUser{usr: usr, creds: creds}, nil
+}
+
+// PasskeysEnabled reports if users can register and login with passkeys.
+func (svc *UserService) PasskeysEnabled() bool {
+	return svc.webauthn != nil
+}
+
+// BeginPasskeyRegistration returns the options passed to navigator.credentials.create() to
+// register a new passkey for the user. Authenticators that already hold a passkey of the
+// user are excluded.
+func (svc *UserService) BeginPasskeyRegistration(ctx Context) (*protocol.CredentialCreation, error) {
+	if svc.webauthn == nil {
+		return nil, ErrorPasskeysDisabled
+	}
+
+	usr, err := svc.passkeyUser(ctx, ctx.UID)
+	if err != nil {
+		return nil, err
+	}
+
+	exclusions := make([]protocol.CredentialDescriptor, len(usr.creds))
+	for i, c := range usr.creds {
+		exclusions[i] = c.Descriptor()
+	}
+
+	options, session, err := svc.webauthn.BeginRegistration(usr, webauthn.WithExclusions(exclusions))
+	if err != nil {
+		return nil, err
+	}
+
+	svc.ceremonies.put("register:"+ctx.UID.String(), ceremony{
+		session: *session,
+		expires: time.Now().Add(passkeyCeremonyTTL),
+	})
+
+	return options, nil
+}
+
+// FinishPasskeyRegistration verifies the response of navigator.credentials.create() for the
+// registration started last by the user and stores the passkey under the given name.
+func (svc *UserService) FinishPasskeyRegistration(ctx Context, name string, response io.Reader) (repo.WebAuthnCredentialOut, error) {
+	if svc.webauthn == nil {
+		return repo.WebAuthnCredentialOut{}, ErrorPasskeysDisabled
+	}
+
+	c, ok := svc.ceremonies.take("register:" + ctx.UID.String())
+	if !ok {
+		return repo.WebAuthnCredentialOut{}, ErrorInvalidToken
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBody(response)
+	if err != nil {
+		return repo.WebAuthnCredentialOut{}, ErrorInvalidPasskey
+	}
+
+	usr, err := svc.passkeyUser(ctx, ctx.UID)
+	if err != nil {
+		return repo.WebAuthnCredentialOut{}, err
+	}
+
+	cred, err := svc.webauthn.CreateCredential(usr, c.session, parsed)
+	if err != nil {
+		log.Debug().Err(err).Msg("failed to verify passkey registration")
+		return repo.WebAuthnCredentialOut{}, ErrorInvalidPasskey
+	}
+
+	transports := make([]string, len(cred.Transport))
+	for i, t := range cred.Transport {
+		transports[i] = string(t)
+	}
+
+	return svc.repos.WebAuthnCredentials.Create(ctx, ctx.UID, repo.WebAuthnCredentialCreate{
+		Name: name,
+		Key: repo.WebAuthnKey{
+			CredentialID:    cred.ID,
+			PublicKey:       cred.PublicKey,
+			AttestationType: cred.AttestationType,
+			Transports:      transports,
+			AAGUID:          cred.Authenticator.AAGUID,
+			SignCount:       cred.Authenticator.SignCount,
+			BackupEligible:  cred.Flags.BackupEligible,
+			BackupState:     cred.Flags.BackupState,
+		},
+	})
+}
+
+// BeginPasskeyLogin starts a login with a discoverable passkey, the user is identified by
+// the passkey the authenticator picks.
+func (svc *UserService) BeginPasskeyLogin(ctx context.Context, extendedSession bool) (PasskeyLogin, error) {
+	if svc.webauthn == nil {
+		return PasskeyLogin{}, ErrorPasskeysDisabled
+	}
+
+	options, session, err := svc.webauthn.BeginDiscoverableLogin(
+		webauthn.WithUserVerification(protocol.VerificationRequired),
+	)
+	if err != nil {
+		return PasskeyLogin{}, err
+	}
+
+	token := hasher.GenerateToken()
+	expiresAt := time.Now().Add(passkeyCeremonyTTL)
+
+	svc.ceremonies.put("login:"+string(token.Hash), ceremony{
+		session:  *session,
+		remember: extendedSession,
+		expires:  expiresAt,
+	})
+
+	return PasskeyLogin{
+		Token:     token.Raw,
+		ExpiresAt: expiresAt,
+		Options:   options,
+	}, nil
+}
+
+// LoginPasskey completes a login started with BeginPasskeyLogin with the response of
+// navigator.credentials.get(). Passkeys verify the user on the authenticator, so no
+// two-factor code is asked for users with two-factor authentication enabled.
+func (svc *UserService) LoginPasskey(ctx context.Context, token string, response io.Reader) (UserAuthTokenDetail, error) {
+	if svc.webauthn == nil {
+		return UserAuthTokenDetail{}, ErrorPasskeysDisabled
+	}
+
+	c, ok := svc.ceremonies.take("login:" + string(hasher.HashToken(token)))
+	if !ok {
+		return UserAuthTokenDetail{}, ErrorInvalidToken
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBody(response)
+	if err != nil {
+		return UserAuthTokenDetail{}, ErrorInvalidPasskey
+	}
+
+	var (
+		usr passkeyUser
+		key repo.WebAuthnCredential
+	)
+
+	cred, err := svc.webauthn.ValidateDiscoverableLogin(func(rawID, userHandle []byte) (webauthn.User, error) {
+		found, err := svc.repos.WebAuthnCredentials.GetByCredentialID(ctx, rawID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(found.UserID[:], userHandle) {
+			return nil, ErrorInvalidPasskey
+		}
+
+		key = found
+		usr, err = svc.passkeyUser(ctx, found.UserID)
+		return usr, err
+	}, c.session, parsed)
+	if err != nil {
+		log.Debug().Err(err).Msg("failed to verify passkey login")
+		return UserAuthTokenDetail{}, ErrorInvalidPasskey
+	}
+
+	if cred.Authenticator.CloneWarning {
+		log.Warn().Str("user", key.UserID.String()).Msg("passkey signature counter did not increase, the authenticator may be cloned")
+		return UserAuthTokenDetail{}, ErrorInvalidPasskey
+	}
+
+	err = svc.repos.WebAuthnCredentials.RecordUse(ctx, key.ID, cred.Authenticator.SignCount, cred.Flags.BackupState)
+	if err != nil {
+		return UserAuthTokenDetail{}, err
+	}
+
+	if svc.requireVerification && !usr.usr.EmailVerified {
+		return UserAuthTokenDetail{}, ErrorEmailNotVerified
+	}
+
+	return svc.createSessionToken(ctx, key.UserID, c.remember)
+}
+
+func (svc *UserService) GetPasskeys(ctx Context) ([]repo.WebAuthnCredentialOut, error) {
+	return svc.repos.WebAuthnCredentials.GetAll(ctx, ctx.UID)
+}
+
+func (svc *UserService) RenamePasskey(ctx Context, ID uuid.UUID, data repo.WebAuthnCredentialUpdate) (repo.WebAuthnCredentialOut, error) {
+	return svc.repos.WebAuthnCredentials.Rename(ctx, ctx.UID, ID, data)
+}
+
+// DeletePasskey revokes a passkey of the user, it can no longer be used to login.
+func (svc *UserService) DeletePasskey(ctx Context, ID uuid.UUID) error {
+	return svc.repos.WebAuthnCredentials.Delete(ctx, ctx.UID, ID)
+}
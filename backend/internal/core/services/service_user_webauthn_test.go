@@ -0,0 +1,173 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hay-kot/homebox/backend/internal/core/services/passkeytest"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const passkeyOrigin = "https://homebox.example.com"
+
+// usePasskeyUser creates a user and registers a passkey on a new virtual authenticator.
+func usePasskeyUser(t *testing.T, svc *UserService) (Context, *passkeytest.Authenticator) {
+	t.Helper()
+
+	usr, err := tRepos.Users.Create(context.Background(), repo.UserCreate{
+		Name:      fk.Str(10),
+		Email:     fk.Email(),
+		Password:  fk.Str(10),
+		GroupID:   tGroup.ID,
+		Activated: true,
+	})
+	require.NoError(t, err)
+
+	ctx := Context{
+		Context: context.Background(),
+		GID:     tGroup.ID,
+		UID:     usr.ID,
+		User:    &usr,
+	}
+
+	auth := passkeytest.New(passkeyOrigin)
+
+	options, err := svc.BeginPasskeyRegistration(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "homebox.example.com", options.Response.RelyingParty.ID)
+
+	response, err := auth.Create(*options)
+	require.NoError(t, err)
+
+	cred, err := svc.FinishPasskeyRegistration(ctx, "Laptop", bytes.NewReader(response))
+	require.NoError(t, err)
+	assert.Equal(t, "Laptop", cred.Name)
+	assert.Nil(t, cred.LastUsedAt)
+
+	return ctx, auth
+}
+
+func loginPasskey(t *testing.T, svc *UserService, auth *passkeytest.Authenticator) (UserAuthTokenDetail, error) {
+	t.Helper()
+
+	login, err := svc.BeginPasskeyLogin(context.Background(), false)
+	require.NoError(t, err)
+
+	response, err := auth.Get(*login.Options)
+	require.NoError(t, err)
+
+	return svc.LoginPasskey(context.Background(), login.Token, bytes.NewReader(response))
+}
+
+func TestUserService_PasskeysDisabled(t *testing.T) {
+	assert.False(t, tSvc.User.PasskeysEnabled())
+
+	_, err := tSvc.User.BeginPasskeyLogin(context.Background(), false)
+	require.ErrorIs(t, err, ErrorPasskeysDisabled)
+
+	_, err = tSvc.User.BeginPasskeyRegistration(tCtx)
+	require.ErrorIs(t, err, ErrorPasskeysDisabled)
+}
+
+func TestUserService_PasskeyLogin(t *testing.T) {
+	svc := New(tRepos, WithBaseURL(passkeyOrigin)).User
+	require.True(t, svc.PasskeysEnabled())
+
+	ctx, auth := usePasskeyUser(t, svc)
+
+	token, err := loginPasskey(t, svc, auth)
+	require.NoError(t, err)
+
+	self, err := svc.GetSelf(context.Background(), token.Raw)
+	require.NoError(t, err)
+	assert.Equal(t, ctx.UID, self.ID)
+
+	creds, err := svc.GetPasskeys(ctx)
+	require.NoError(t, err)
+	require.Len(t, creds, 1)
+	assert.NotNil(t, creds[0].LastUsedAt)
+
+	// A login can only be completed once.
+	login, err := svc.BeginPasskeyLogin(context.Background(), false)
+	require.NoError(t, err)
+
+	response, err := auth.Get(*login.Options)
+	require.NoError(t, err)
+
+	_, err = svc.LoginPasskey(context.Background(), login.Token, bytes.NewReader(response))
+	require.NoError(t, err)
+
+	_, err = svc.LoginPasskey(context.Background(), login.Token, bytes.NewReader(response))
+	require.ErrorIs(t, err, ErrorInvalidToken)
+
+	// The response is bound to the challenge of its login.
+	login, err = svc.BeginPasskeyLogin(context.Background(), false)
+	require.NoError(t, err)
+
+	_, err = svc.LoginPasskey(context.Background(), login.Token, bytes.NewReader(response))
+	require.ErrorIs(t, err, ErrorInvalidPasskey)
+}
+
+func TestUserService_PasskeyLoginClonedAuthenticator(t *testing.T) {
+	svc := New(tRepos, WithBaseURL(passkeyOrigin)).User
+	_, auth := usePasskeyUser(t, svc)
+
+	_, err := loginPasskey(t, svc, auth)
+	require.NoError(t, err)
+
+	// A copy of the authenticator reuses a signature counter that was already seen.
+	auth.Counter = 0
+
+	_, err = loginPasskey(t, svc, auth)
+	require.ErrorIs(t, err, ErrorInvalidPasskey)
+}
+
+func TestUserService_PasskeyRegistrationWrongOrigin(t *testing.T) {
+	svc := New(tRepos, WithBaseURL(passkeyOrigin)).User
+
+	options, err := svc.BeginPasskeyRegistration(tCtx)
+	require.NoError(t, err)
+
+	response, err := passkeytest.New("https://evil.example.com").Create(*options)
+	require.NoError(t, err)
+
+	_, err = svc.FinishPasskeyRegistration(tCtx, "Phishing", bytes.NewReader(response))
+	require.ErrorIs(t, err, ErrorInvalidPasskey)
+
+	// The registration has to be started again.
+	response, err = passkeytest.New(passkeyOrigin).Create(*options)
+	require.NoError(t, err)
+
+	_, err = svc.FinishPasskeyRegistration(tCtx, "Laptop", bytes.NewReader(response))
+	require.ErrorIs(t, err, ErrorInvalidToken)
+}
+
+func TestUserService_PasskeyRenameAndRevoke(t *testing.T) {
+	svc := New(tRepos, WithBaseURL(passkeyOrigin)).User
+	ctx, auth := usePasskeyUser(t, svc)
+
+	creds, err := svc.GetPasskeys(ctx)
+	require.NoError(t, err)
+	require.Len(t, creds, 1)
+
+	renamed, err := svc.RenamePasskey(ctx, creds[0].ID, repo.WebAuthnCredentialUpdate{Name: "Phone"})
+	require.NoError(t, err)
+	assert.Equal(t, "Phone", renamed.Name)
+
+	// Passkeys of other users can not be changed.
+	_, err = svc.RenamePasskey(tCtx, creds[0].ID, repo.WebAuthnCredentialUpdate{Name: "Mine"})
+	assert.True(t, ent.IsNotFound(err))
+
+	err = svc.DeletePasskey(tCtx, creds[0].ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	err = svc.DeletePasskey(ctx, creds[0].ID)
+	require.NoError(t, err)
+
+	_, err = loginPasskey(t, svc, auth)
+	require.ErrorIs(t, err, ErrorInvalidPasskey)
+}
@@ -0,0 +1,272 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/auditentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// AuditEntry is the model entity for the AuditEntry schema.
+type AuditEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID uuid.UUID `json:"group_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action auditentry.Action `json:"action,omitempty"`
+	// EntityType holds the value of the "entity_type" field.
+	EntityType auditentry.EntityType `json:"entity_type,omitempty"`
+	// EntityID holds the value of the "entity_id" field.
+	EntityID uuid.UUID `json:"entity_id,omitempty"`
+	// EntityName holds the value of the "entity_name" field.
+	EntityName string `json:"entity_name,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID *uuid.UUID `json:"item_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *uuid.UUID `json:"user_id,omitempty"`
+	// Changes holds the value of the "changes" field.
+	Changes []types.FieldChange `json:"changes,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AuditEntryQuery when eager-loading is set.
+	Edges        AuditEntryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AuditEntryEdges holds the relations/edges for other nodes in the graph.
+type AuditEntryEdges struct {
+	// Group holds the value of the group edge.
+	Group *Group `json:"group,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AuditEntryEdges) GroupOrErr() (*Group, error) {
+	if e.loadedTypes[0] {
+		if e.Group == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: group.Label}
+		}
+		return e.Group, nil
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AuditEntryEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditentry.FieldItemID, auditentry.FieldUserID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case auditentry.FieldChanges:
+			values[i] = new([]byte)
+		case auditentry.FieldAction, auditentry.FieldEntityType, auditentry.FieldEntityName:
+			values[i] = new(sql.NullString)
+		case auditentry.FieldCreatedAt, auditentry.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case auditentry.FieldID, auditentry.FieldGroupID, auditentry.FieldEntityID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditEntry fields.
+func (ae *AuditEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditentry.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ae.ID = *value
+			}
+		case auditentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ae.CreatedAt = value.Time
+			}
+		case auditentry.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ae.UpdatedAt = value.Time
+			}
+		case auditentry.FieldGroupID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value != nil {
+				ae.GroupID = *value
+			}
+		case auditentry.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				ae.Action = auditentry.Action(value.String)
+			}
+		case auditentry.FieldEntityType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_type", values[i])
+			} else if value.Valid {
+				ae.EntityType = auditentry.EntityType(value.String)
+			}
+		case auditentry.FieldEntityID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value != nil {
+				ae.EntityID = *value
+			}
+		case auditentry.FieldEntityName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_name", values[i])
+			} else if value.Valid {
+				ae.EntityName = value.String
+			}
+		case auditentry.FieldItemID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				ae.ItemID = new(uuid.UUID)
+				*ae.ItemID = *value.S.(*uuid.UUID)
+			}
+		case auditentry.FieldUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ae.UserID = new(uuid.UUID)
+				*ae.UserID = *value.S.(*uuid.UUID)
+			}
+		case auditentry.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ae.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		default:
+			ae.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditEntry.
+// This includes values selected through modifiers, order, etc.
+func (ae *AuditEntry) Value(name string) (ent.Value, error) {
+	return ae.selectValues.Get(name)
+}
+
+// QueryGroup queries the "group" edge of the AuditEntry entity.
+func (ae *AuditEntry) QueryGroup() *GroupQuery {
+	return NewAuditEntryClient(ae.config).QueryGroup(ae)
+}
+
+// QueryUser queries the "user" edge of the AuditEntry entity.
+func (ae *AuditEntry) QueryUser() *UserQuery {
+	return NewAuditEntryClient(ae.config).QueryUser(ae)
+}
+
+// Update returns a builder for updating this AuditEntry.
+// Note that you need to call AuditEntry.Unwrap() before calling this method if this AuditEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ae *AuditEntry) Update() *AuditEntryUpdateOne {
+	return NewAuditEntryClient(ae.config).UpdateOne(ae)
+}
+
+// Unwrap unwraps the AuditEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ae *AuditEntry) Unwrap() *AuditEntry {
+	_tx, ok := ae.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditEntry is not a transactional entity")
+	}
+	ae.config.driver = _tx.drv
+	return ae
+}
+
+// String implements the fmt.Stringer.
+func (ae *AuditEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ae.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ae.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ae.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(fmt.Sprintf("%v", ae.GroupID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Action))
+	builder.WriteString(", ")
+	builder.WriteString("entity_type=")
+	builder.WriteString(fmt.Sprintf("%v", ae.EntityType))
+	builder.WriteString(", ")
+	builder.WriteString("entity_id=")
+	builder.WriteString(fmt.Sprintf("%v", ae.EntityID))
+	builder.WriteString(", ")
+	builder.WriteString("entity_name=")
+	builder.WriteString(ae.EntityName)
+	builder.WriteString(", ")
+	if v := ae.ItemID; v != nil {
+		builder.WriteString("item_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ae.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Changes))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditEntries is a parsable slice of AuditEntry.
+type AuditEntries []*AuditEntry
@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/auditentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// AuditEntryCreate is the builder for creating a AuditEntry entity.
+type AuditEntryCreate struct {
+	config
+	mutation *AuditEntryMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aec *AuditEntryCreate) SetCreatedAt(t time.Time) *AuditEntryCreate {
+	aec.mutation.SetCreatedAt(t)
+	return aec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableCreatedAt(t *time.Time) *AuditEntryCreate {
+	if t != nil {
+		aec.SetCreatedAt(*t)
+	}
+	return aec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aec *AuditEntryCreate) SetUpdatedAt(t time.Time) *AuditEntryCreate {
+	aec.mutation.SetUpdatedAt(t)
+	return aec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableUpdatedAt(t *time.Time) *AuditEntryCreate {
+	if t != nil {
+		aec.SetUpdatedAt(*t)
+	}
+	return aec
+}
+
+// SetGroupID sets the "group_id" field.
+func (aec *AuditEntryCreate) SetGroupID(u uuid.UUID) *AuditEntryCreate {
+	aec.mutation.SetGroupID(u)
+	return aec
+}
+
+// SetAction sets the "action" field.
+func (aec *AuditEntryCreate) SetAction(a auditentry.Action) *AuditEntryCreate {
+	aec.mutation.SetAction(a)
+	return aec
+}
+
+// SetEntityType sets the "entity_type" field.
+func (aec *AuditEntryCreate) SetEntityType(at auditentry.EntityType) *AuditEntryCreate {
+	aec.mutation.SetEntityType(at)
+	return aec
+}
+
+// SetEntityID sets the "entity_id" field.
+func (aec *AuditEntryCreate) SetEntityID(u uuid.UUID) *AuditEntryCreate {
+	aec.mutation.SetEntityID(u)
+	return aec
+}
+
+// SetEntityName sets the "entity_name" field.
+func (aec *AuditEntryCreate) SetEntityName(s string) *AuditEntryCreate {
+	aec.mutation.SetEntityName(s)
+	return aec
+}
+
+// SetNillableEntityName sets the "entity_name" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableEntityName(s *string) *AuditEntryCreate {
+	if s != nil {
+		aec.SetEntityName(*s)
+	}
+	return aec
+}
+
+// SetItemID sets the "item_id" field.
+func (aec *AuditEntryCreate) SetItemID(u uuid.UUID) *AuditEntryCreate {
+	aec.mutation.SetItemID(u)
+	return aec
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableItemID(u *uuid.UUID) *AuditEntryCreate {
+	if u != nil {
+		aec.SetItemID(*u)
+	}
+	return aec
+}
+
+// SetUserID sets the "user_id" field.
+func (aec *AuditEntryCreate) SetUserID(u uuid.UUID) *AuditEntryCreate {
+	aec.mutation.SetUserID(u)
+	return aec
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableUserID(u *uuid.UUID) *AuditEntryCreate {
+	if u != nil {
+		aec.SetUserID(*u)
+	}
+	return aec
+}
+
+// SetChanges sets the "changes" field.
+func (aec *AuditEntryCreate) SetChanges(tc []types.FieldChange) *AuditEntryCreate {
+	aec.mutation.SetChanges(tc)
+	return aec
+}
+
+// SetID sets the "id" field.
+func (aec *AuditEntryCreate) SetID(u uuid.UUID) *AuditEntryCreate {
+	aec.mutation.SetID(u)
+	return aec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableID(u *uuid.UUID) *AuditEntryCreate {
+	if u != nil {
+		aec.SetID(*u)
+	}
+	return aec
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (aec *AuditEntryCreate) SetGroup(g *Group) *AuditEntryCreate {
+	return aec.SetGroupID(g.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (aec *AuditEntryCreate) SetUser(u *User) *AuditEntryCreate {
+	return aec.SetUserID(u.ID)
+}
+
+// Mutation returns the AuditEntryMutation object of the builder.
+func (aec *AuditEntryCreate) Mutation() *AuditEntryMutation {
+	return aec.mutation
+}
+
+// Save creates the AuditEntry in the database.
+func (aec *AuditEntryCreate) Save(ctx context.Context) (*AuditEntry, error) {
+	aec.defaults()
+	return withHooks(ctx, aec.sqlSave, aec.mutation, aec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AuditEntryCreate) SaveX(ctx context.Context) *AuditEntry {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *AuditEntryCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *AuditEntryCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aec *AuditEntryCreate) defaults() {
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		v := auditentry.DefaultCreatedAt()
+		aec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := aec.mutation.UpdatedAt(); !ok {
+		v := auditentry.DefaultUpdatedAt()
+		aec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := aec.mutation.ID(); !ok {
+		v := auditentry.DefaultID()
+		aec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AuditEntryCreate) check() error {
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditEntry.created_at"`)}
+	}
+	if _, ok := aec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AuditEntry.updated_at"`)}
+	}
+	if _, ok := aec.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "AuditEntry.group_id"`)}
+	}
+	if _, ok := aec.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AuditEntry.action"`)}
+	}
+	if v, ok := aec.mutation.Action(); ok {
+		if err := auditentry.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.action": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.EntityType(); !ok {
+		return &ValidationError{Name: "entity_type", err: errors.New(`ent: missing required field "AuditEntry.entity_type"`)}
+	}
+	if v, ok := aec.mutation.EntityType(); ok {
+		if err := auditentry.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.entity_type": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.EntityID(); !ok {
+		return &ValidationError{Name: "entity_id", err: errors.New(`ent: missing required field "AuditEntry.entity_id"`)}
+	}
+	if v, ok := aec.mutation.EntityName(); ok {
+		if err := auditentry.EntityNameValidator(v); err != nil {
+			return &ValidationError{Name: "entity_name", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.entity_name": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "AuditEntry.group"`)}
+	}
+	return nil
+}
+
+func (aec *AuditEntryCreate) sqlSave(ctx context.Context) (*AuditEntry, error) {
+	if err := aec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	aec.mutation.id = &_node.ID
+	aec.mutation.done = true
+	return _node, nil
+}
+
+func (aec *AuditEntryCreate) createSpec() (*AuditEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditEntry{config: aec.config}
+		_spec = sqlgraph.NewCreateSpec(auditentry.Table, sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID))
+	)
+	if id, ok := aec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := aec.mutation.CreatedAt(); ok {
+		_spec.SetField(auditentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := aec.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditentry.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := aec.mutation.Action(); ok {
+		_spec.SetField(auditentry.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := aec.mutation.EntityType(); ok {
+		_spec.SetField(auditentry.FieldEntityType, field.TypeEnum, value)
+		_node.EntityType = value
+	}
+	if value, ok := aec.mutation.EntityID(); ok {
+		_spec.SetField(auditentry.FieldEntityID, field.TypeUUID, value)
+		_node.EntityID = value
+	}
+	if value, ok := aec.mutation.EntityName(); ok {
+		_spec.SetField(auditentry.FieldEntityName, field.TypeString, value)
+		_node.EntityName = value
+	}
+	if value, ok := aec.mutation.ItemID(); ok {
+		_spec.SetField(auditentry.FieldItemID, field.TypeUUID, value)
+		_node.ItemID = &value
+	}
+	if value, ok := aec.mutation.Changes(); ok {
+		_spec.SetField(auditentry.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if nodes := aec.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditentry.GroupTable,
+			Columns: []string{auditentry.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := aec.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditentry.UserTable,
+			Columns: []string{auditentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AuditEntryCreateBulk is the builder for creating many AuditEntry entities in bulk.
+type AuditEntryCreateBulk struct {
+	config
+	err      error
+	builders []*AuditEntryCreate
+}
+
+// Save creates the AuditEntry entities in the database.
+func (aecb *AuditEntryCreateBulk) Save(ctx context.Context) ([]*AuditEntry, error) {
+	if aecb.err != nil {
+		return nil, aecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AuditEntry, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AuditEntryCreateBulk) SaveX(ctx context.Context) []*AuditEntry {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *AuditEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *AuditEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
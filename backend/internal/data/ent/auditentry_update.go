@@ -0,0 +1,761 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/auditentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// AuditEntryUpdate is the builder for updating AuditEntry entities.
+type AuditEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditEntryMutation
+}
+
+// Where appends a list predicates to the AuditEntryUpdate builder.
+func (aeu *AuditEntryUpdate) Where(ps ...predicate.AuditEntry) *AuditEntryUpdate {
+	aeu.mutation.Where(ps...)
+	return aeu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aeu *AuditEntryUpdate) SetUpdatedAt(t time.Time) *AuditEntryUpdate {
+	aeu.mutation.SetUpdatedAt(t)
+	return aeu
+}
+
+// SetGroupID sets the "group_id" field.
+func (aeu *AuditEntryUpdate) SetGroupID(u uuid.UUID) *AuditEntryUpdate {
+	aeu.mutation.SetGroupID(u)
+	return aeu
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (aeu *AuditEntryUpdate) SetNillableGroupID(u *uuid.UUID) *AuditEntryUpdate {
+	if u != nil {
+		aeu.SetGroupID(*u)
+	}
+	return aeu
+}
+
+// SetAction sets the "action" field.
+func (aeu *AuditEntryUpdate) SetAction(a auditentry.Action) *AuditEntryUpdate {
+	aeu.mutation.SetAction(a)
+	return aeu
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (aeu *AuditEntryUpdate) SetNillableAction(a *auditentry.Action) *AuditEntryUpdate {
+	if a != nil {
+		aeu.SetAction(*a)
+	}
+	return aeu
+}
+
+// SetEntityType sets the "entity_type" field.
+func (aeu *AuditEntryUpdate) SetEntityType(at auditentry.EntityType) *AuditEntryUpdate {
+	aeu.mutation.SetEntityType(at)
+	return aeu
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (aeu *AuditEntryUpdate) SetNillableEntityType(at *auditentry.EntityType) *AuditEntryUpdate {
+	if at != nil {
+		aeu.SetEntityType(*at)
+	}
+	return aeu
+}
+
+// SetEntityID sets the "entity_id" field.
+func (aeu *AuditEntryUpdate) SetEntityID(u uuid.UUID) *AuditEntryUpdate {
+	aeu.mutation.SetEntityID(u)
+	return aeu
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (aeu *AuditEntryUpdate) SetNillableEntityID(u *uuid.UUID) *AuditEntryUpdate {
+	if u != nil {
+		aeu.SetEntityID(*u)
+	}
+	return aeu
+}
+
+// SetEntityName sets the "entity_name" field.
+func (aeu *AuditEntryUpdate) SetEntityName(s string) *AuditEntryUpdate {
+	aeu.mutation.SetEntityName(s)
+	return aeu
+}
+
+// SetNillableEntityName sets the "entity_name" field if the given value is not nil.
+func (aeu *AuditEntryUpdate) SetNillableEntityName(s *string) *AuditEntryUpdate {
+	if s != nil {
+		aeu.SetEntityName(*s)
+	}
+	return aeu
+}
+
+// ClearEntityName clears the value of the "entity_name" field.
+func (aeu *AuditEntryUpdate) ClearEntityName() *AuditEntryUpdate {
+	aeu.mutation.ClearEntityName()
+	return aeu
+}
+
+// SetItemID sets the "item_id" field.
+func (aeu *AuditEntryUpdate) SetItemID(u uuid.UUID) *AuditEntryUpdate {
+	aeu.mutation.SetItemID(u)
+	return aeu
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (aeu *AuditEntryUpdate) SetNillableItemID(u *uuid.UUID) *AuditEntryUpdate {
+	if u != nil {
+		aeu.SetItemID(*u)
+	}
+	return aeu
+}
+
+// ClearItemID clears the value of the "item_id" field.
+func (aeu *AuditEntryUpdate) ClearItemID() *AuditEntryUpdate {
+	aeu.mutation.ClearItemID()
+	return aeu
+}
+
+// SetUserID sets the "user_id" field.
+func (aeu *AuditEntryUpdate) SetUserID(u uuid.UUID) *AuditEntryUpdate {
+	aeu.mutation.SetUserID(u)
+	return aeu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aeu *AuditEntryUpdate) SetNillableUserID(u *uuid.UUID) *AuditEntryUpdate {
+	if u != nil {
+		aeu.SetUserID(*u)
+	}
+	return aeu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (aeu *AuditEntryUpdate) ClearUserID() *AuditEntryUpdate {
+	aeu.mutation.ClearUserID()
+	return aeu
+}
+
+// SetChanges sets the "changes" field.
+func (aeu *AuditEntryUpdate) SetChanges(tc []types.FieldChange) *AuditEntryUpdate {
+	aeu.mutation.SetChanges(tc)
+	return aeu
+}
+
+// AppendChanges appends tc to the "changes" field.
+func (aeu *AuditEntryUpdate) AppendChanges(tc []types.FieldChange) *AuditEntryUpdate {
+	aeu.mutation.AppendChanges(tc)
+	return aeu
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (aeu *AuditEntryUpdate) ClearChanges() *AuditEntryUpdate {
+	aeu.mutation.ClearChanges()
+	return aeu
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (aeu *AuditEntryUpdate) SetGroup(g *Group) *AuditEntryUpdate {
+	return aeu.SetGroupID(g.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (aeu *AuditEntryUpdate) SetUser(u *User) *AuditEntryUpdate {
+	return aeu.SetUserID(u.ID)
+}
+
+// Mutation returns the AuditEntryMutation object of the builder.
+func (aeu *AuditEntryUpdate) Mutation() *AuditEntryMutation {
+	return aeu.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (aeu *AuditEntryUpdate) ClearGroup() *AuditEntryUpdate {
+	aeu.mutation.ClearGroup()
+	return aeu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (aeu *AuditEntryUpdate) ClearUser() *AuditEntryUpdate {
+	aeu.mutation.ClearUser()
+	return aeu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aeu *AuditEntryUpdate) Save(ctx context.Context) (int, error) {
+	aeu.defaults()
+	return withHooks(ctx, aeu.sqlSave, aeu.mutation, aeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeu *AuditEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := aeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aeu *AuditEntryUpdate) Exec(ctx context.Context) error {
+	_, err := aeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeu *AuditEntryUpdate) ExecX(ctx context.Context) {
+	if err := aeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aeu *AuditEntryUpdate) defaults() {
+	if _, ok := aeu.mutation.UpdatedAt(); !ok {
+		v := auditentry.UpdateDefaultUpdatedAt()
+		aeu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aeu *AuditEntryUpdate) check() error {
+	if v, ok := aeu.mutation.Action(); ok {
+		if err := auditentry.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.action": %w`, err)}
+		}
+	}
+	if v, ok := aeu.mutation.EntityType(); ok {
+		if err := auditentry.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.entity_type": %w`, err)}
+		}
+	}
+	if v, ok := aeu.mutation.EntityName(); ok {
+		if err := auditentry.EntityNameValidator(v); err != nil {
+			return &ValidationError{Name: "entity_name", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.entity_name": %w`, err)}
+		}
+	}
+	if _, ok := aeu.mutation.GroupID(); aeu.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AuditEntry.group"`)
+	}
+	return nil
+}
+
+func (aeu *AuditEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aeu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditentry.Table, auditentry.Columns, sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID))
+	if ps := aeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeu.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditentry.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := aeu.mutation.Action(); ok {
+		_spec.SetField(auditentry.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := aeu.mutation.EntityType(); ok {
+		_spec.SetField(auditentry.FieldEntityType, field.TypeEnum, value)
+	}
+	if value, ok := aeu.mutation.EntityID(); ok {
+		_spec.SetField(auditentry.FieldEntityID, field.TypeUUID, value)
+	}
+	if value, ok := aeu.mutation.EntityName(); ok {
+		_spec.SetField(auditentry.FieldEntityName, field.TypeString, value)
+	}
+	if aeu.mutation.EntityNameCleared() {
+		_spec.ClearField(auditentry.FieldEntityName, field.TypeString)
+	}
+	if value, ok := aeu.mutation.ItemID(); ok {
+		_spec.SetField(auditentry.FieldItemID, field.TypeUUID, value)
+	}
+	if aeu.mutation.ItemIDCleared() {
+		_spec.ClearField(auditentry.FieldItemID, field.TypeUUID)
+	}
+	if value, ok := aeu.mutation.Changes(); ok {
+		_spec.SetField(auditentry.FieldChanges, field.TypeJSON, value)
+	}
+	if value, ok := aeu.mutation.AppendedChanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditentry.FieldChanges, value)
+		})
+	}
+	if aeu.mutation.ChangesCleared() {
+		_spec.ClearField(auditentry.FieldChanges, field.TypeJSON)
+	}
+	if aeu.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditentry.GroupTable,
+			Columns: []string{auditentry.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aeu.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditentry.GroupTable,
+			Columns: []string{auditentry.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aeu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditentry.UserTable,
+			Columns: []string{auditentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aeu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditentry.UserTable,
+			Columns: []string{auditentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aeu.mutation.done = true
+	return n, nil
+}
+
+// AuditEntryUpdateOne is the builder for updating a single AuditEntry entity.
+type AuditEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditEntryMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aeuo *AuditEntryUpdateOne) SetUpdatedAt(t time.Time) *AuditEntryUpdateOne {
+	aeuo.mutation.SetUpdatedAt(t)
+	return aeuo
+}
+
+// SetGroupID sets the "group_id" field.
+func (aeuo *AuditEntryUpdateOne) SetGroupID(u uuid.UUID) *AuditEntryUpdateOne {
+	aeuo.mutation.SetGroupID(u)
+	return aeuo
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (aeuo *AuditEntryUpdateOne) SetNillableGroupID(u *uuid.UUID) *AuditEntryUpdateOne {
+	if u != nil {
+		aeuo.SetGroupID(*u)
+	}
+	return aeuo
+}
+
+// SetAction sets the "action" field.
+func (aeuo *AuditEntryUpdateOne) SetAction(a auditentry.Action) *AuditEntryUpdateOne {
+	aeuo.mutation.SetAction(a)
+	return aeuo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (aeuo *AuditEntryUpdateOne) SetNillableAction(a *auditentry.Action) *AuditEntryUpdateOne {
+	if a != nil {
+		aeuo.SetAction(*a)
+	}
+	return aeuo
+}
+
+// SetEntityType sets the "entity_type" field.
+func (aeuo *AuditEntryUpdateOne) SetEntityType(at auditentry.EntityType) *AuditEntryUpdateOne {
+	aeuo.mutation.SetEntityType(at)
+	return aeuo
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (aeuo *AuditEntryUpdateOne) SetNillableEntityType(at *auditentry.EntityType) *AuditEntryUpdateOne {
+	if at != nil {
+		aeuo.SetEntityType(*at)
+	}
+	return aeuo
+}
+
+// SetEntityID sets the "entity_id" field.
+func (aeuo *AuditEntryUpdateOne) SetEntityID(u uuid.UUID) *AuditEntryUpdateOne {
+	aeuo.mutation.SetEntityID(u)
+	return aeuo
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (aeuo *AuditEntryUpdateOne) SetNillableEntityID(u *uuid.UUID) *AuditEntryUpdateOne {
+	if u != nil {
+		aeuo.SetEntityID(*u)
+	}
+	return aeuo
+}
+
+// SetEntityName sets the "entity_name" field.
+func (aeuo *AuditEntryUpdateOne) SetEntityName(s string) *AuditEntryUpdateOne {
+	aeuo.mutation.SetEntityName(s)
+	return aeuo
+}
+
+// SetNillableEntityName sets the "entity_name" field if the given value is not nil.
+func (aeuo *AuditEntryUpdateOne) SetNillableEntityName(s *string) *AuditEntryUpdateOne {
+	if s != nil {
+		aeuo.SetEntityName(*s)
+	}
+	return aeuo
+}
+
+// ClearEntityName clears the value of the "entity_name" field.
+func (aeuo *AuditEntryUpdateOne) ClearEntityName() *AuditEntryUpdateOne {
+	aeuo.mutation.ClearEntityName()
+	return aeuo
+}
+
+// SetItemID sets the "item_id" field.
+func (aeuo *AuditEntryUpdateOne) SetItemID(u uuid.UUID) *AuditEntryUpdateOne {
+	aeuo.mutation.SetItemID(u)
+	return aeuo
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (aeuo *AuditEntryUpdateOne) SetNillableItemID(u *uuid.UUID) *AuditEntryUpdateOne {
+	if u != nil {
+		aeuo.SetItemID(*u)
+	}
+	return aeuo
+}
+
+// ClearItemID clears the value of the "item_id" field.
+func (aeuo *AuditEntryUpdateOne) ClearItemID() *AuditEntryUpdateOne {
+	aeuo.mutation.ClearItemID()
+	return aeuo
+}
+
+// SetUserID sets the "user_id" field.
+func (aeuo *AuditEntryUpdateOne) SetUserID(u uuid.UUID) *AuditEntryUpdateOne {
+	aeuo.mutation.SetUserID(u)
+	return aeuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aeuo *AuditEntryUpdateOne) SetNillableUserID(u *uuid.UUID) *AuditEntryUpdateOne {
+	if u != nil {
+		aeuo.SetUserID(*u)
+	}
+	return aeuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (aeuo *AuditEntryUpdateOne) ClearUserID() *AuditEntryUpdateOne {
+	aeuo.mutation.ClearUserID()
+	return aeuo
+}
+
+// SetChanges sets the "changes" field.
+func (aeuo *AuditEntryUpdateOne) SetChanges(tc []types.FieldChange) *AuditEntryUpdateOne {
+	aeuo.mutation.SetChanges(tc)
+	return aeuo
+}
+
+// AppendChanges appends tc to the "changes" field.
+func (aeuo *AuditEntryUpdateOne) AppendChanges(tc []types.FieldChange) *AuditEntryUpdateOne {
+	aeuo.mutation.AppendChanges(tc)
+	return aeuo
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (aeuo *AuditEntryUpdateOne) ClearChanges() *AuditEntryUpdateOne {
+	aeuo.mutation.ClearChanges()
+	return aeuo
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (aeuo *AuditEntryUpdateOne) SetGroup(g *Group) *AuditEntryUpdateOne {
+	return aeuo.SetGroupID(g.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (aeuo *AuditEntryUpdateOne) SetUser(u *User) *AuditEntryUpdateOne {
+	return aeuo.SetUserID(u.ID)
+}
+
+// Mutation returns the AuditEntryMutation object of the builder.
+func (aeuo *AuditEntryUpdateOne) Mutation() *AuditEntryMutation {
+	return aeuo.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (aeuo *AuditEntryUpdateOne) ClearGroup() *AuditEntryUpdateOne {
+	aeuo.mutation.ClearGroup()
+	return aeuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (aeuo *AuditEntryUpdateOne) ClearUser() *AuditEntryUpdateOne {
+	aeuo.mutation.ClearUser()
+	return aeuo
+}
+
+// Where appends a list predicates to the AuditEntryUpdate builder.
+func (aeuo *AuditEntryUpdateOne) Where(ps ...predicate.AuditEntry) *AuditEntryUpdateOne {
+	aeuo.mutation.Where(ps...)
+	return aeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aeuo *AuditEntryUpdateOne) Select(field string, fields ...string) *AuditEntryUpdateOne {
+	aeuo.fields = append([]string{field}, fields...)
+	return aeuo
+}
+
+// Save executes the query and returns the updated AuditEntry entity.
+func (aeuo *AuditEntryUpdateOne) Save(ctx context.Context) (*AuditEntry, error) {
+	aeuo.defaults()
+	return withHooks(ctx, aeuo.sqlSave, aeuo.mutation, aeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeuo *AuditEntryUpdateOne) SaveX(ctx context.Context) *AuditEntry {
+	node, err := aeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aeuo *AuditEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := aeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeuo *AuditEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := aeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aeuo *AuditEntryUpdateOne) defaults() {
+	if _, ok := aeuo.mutation.UpdatedAt(); !ok {
+		v := auditentry.UpdateDefaultUpdatedAt()
+		aeuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aeuo *AuditEntryUpdateOne) check() error {
+	if v, ok := aeuo.mutation.Action(); ok {
+		if err := auditentry.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.action": %w`, err)}
+		}
+	}
+	if v, ok := aeuo.mutation.EntityType(); ok {
+		if err := auditentry.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.entity_type": %w`, err)}
+		}
+	}
+	if v, ok := aeuo.mutation.EntityName(); ok {
+		if err := auditentry.EntityNameValidator(v); err != nil {
+			return &ValidationError{Name: "entity_name", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.entity_name": %w`, err)}
+		}
+	}
+	if _, ok := aeuo.mutation.GroupID(); aeuo.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AuditEntry.group"`)
+	}
+	return nil
+}
+
+func (aeuo *AuditEntryUpdateOne) sqlSave(ctx context.Context) (_node *AuditEntry, err error) {
+	if err := aeuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditentry.Table, auditentry.Columns, sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID))
+	id, ok := aeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditentry.FieldID)
+		for _, f := range fields {
+			if !auditentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditentry.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := aeuo.mutation.Action(); ok {
+		_spec.SetField(auditentry.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := aeuo.mutation.EntityType(); ok {
+		_spec.SetField(auditentry.FieldEntityType, field.TypeEnum, value)
+	}
+	if value, ok := aeuo.mutation.EntityID(); ok {
+		_spec.SetField(auditentry.FieldEntityID, field.TypeUUID, value)
+	}
+	if value, ok := aeuo.mutation.EntityName(); ok {
+		_spec.SetField(auditentry.FieldEntityName, field.TypeString, value)
+	}
+	if aeuo.mutation.EntityNameCleared() {
+		_spec.ClearField(auditentry.FieldEntityName, field.TypeString)
+	}
+	if value, ok := aeuo.mutation.ItemID(); ok {
+		_spec.SetField(auditentry.FieldItemID, field.TypeUUID, value)
+	}
+	if aeuo.mutation.ItemIDCleared() {
+		_spec.ClearField(auditentry.FieldItemID, field.TypeUUID)
+	}
+	if value, ok := aeuo.mutation.Changes(); ok {
+		_spec.SetField(auditentry.FieldChanges, field.TypeJSON, value)
+	}
+	if value, ok := aeuo.mutation.AppendedChanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditentry.FieldChanges, value)
+		})
+	}
+	if aeuo.mutation.ChangesCleared() {
+		_spec.ClearField(auditentry.FieldChanges, field.TypeJSON)
+	}
+	if aeuo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditentry.GroupTable,
+			Columns: []string{auditentry.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aeuo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditentry.GroupTable,
+			Columns: []string{auditentry.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aeuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditentry.UserTable,
+			Columns: []string{auditentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aeuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditentry.UserTable,
+			Columns: []string{auditentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AuditEntry{config: aeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aeuo.mutation.done = true
+	return _node, nil
+}
@@ -67,6 +67,8 @@ const (
 	RoleAdmin       Role = "admin"
 	RoleUser        Role = "user"
 	RoleAttachments Role = "attachments"
+	RoleItemsRead   Role = "items_read"
+	RoleItemsWrite  Role = "items_write"
 )
 
 func (r Role) String() string {
@@ -76,7 +78,7 @@ func (r Role) String() string {
 // RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
 func RoleValidator(r Role) error {
 	switch r {
-	case RoleAdmin, RoleUser, RoleAttachments:
+	case RoleAdmin, RoleUser, RoleAttachments, RoleItemsRead, RoleItemsWrite:
 		return nil
 	default:
 		return fmt.Errorf("authroles: invalid enum value for role field: %q", r)
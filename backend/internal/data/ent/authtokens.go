@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authroles"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
 )
 
@@ -28,6 +29,18 @@ type AuthTokens struct {
 	Token []byte `json:"token,omitempty"`
 	// ExpiresAt holds the value of the "expires_at" field.
 	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// SessionID holds the value of the "session_id" field.
+	SessionID *uuid.UUID `json:"session_id,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// IPAddress holds the value of the "ip_address" field.
+	IPAddress string `json:"ip_address,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID *uuid.UUID `json:"group_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AuthTokensQuery when eager-loading is set.
 	Edges            AuthTokensEdges `json:"edges"`
@@ -39,11 +52,17 @@ type AuthTokens struct {
 type AuthTokensEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Session holds the value of the session edge.
+	Session *AuthTokens `json:"session,omitempty"`
+	// AttachmentTokens holds the value of the attachment_tokens edge.
+	AttachmentTokens []*AuthTokens `json:"attachment_tokens,omitempty"`
+	// Group holds the value of the group edge.
+	Group *Group `json:"group,omitempty"`
 	// Roles holds the value of the roles edge.
 	Roles *AuthRoles `json:"roles,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -59,10 +78,45 @@ func (e AuthTokensEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// SessionOrErr returns the Session value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AuthTokensEdges) SessionOrErr() (*AuthTokens, error) {
+	if e.loadedTypes[1] {
+		if e.Session == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: authtokens.Label}
+		}
+		return e.Session, nil
+	}
+	return nil, &NotLoadedError{edge: "session"}
+}
+
+// AttachmentTokensOrErr returns the AttachmentTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e AuthTokensEdges) AttachmentTokensOrErr() ([]*AuthTokens, error) {
+	if e.loadedTypes[2] {
+		return e.AttachmentTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "attachment_tokens"}
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AuthTokensEdges) GroupOrErr() (*Group, error) {
+	if e.loadedTypes[3] {
+		if e.Group == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: group.Label}
+		}
+		return e.Group, nil
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
 // RolesOrErr returns the Roles value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e AuthTokensEdges) RolesOrErr() (*AuthRoles, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[4] {
 		if e.Roles == nil {
 			// Edge was loaded but was not found.
 			return nil, &NotFoundError{label: authroles.Label}
@@ -77,9 +131,13 @@ func (*AuthTokens) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case authtokens.FieldSessionID, authtokens.FieldGroupID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case authtokens.FieldToken:
 			values[i] = new([]byte)
-		case authtokens.FieldCreatedAt, authtokens.FieldUpdatedAt, authtokens.FieldExpiresAt:
+		case authtokens.FieldName, authtokens.FieldIPAddress, authtokens.FieldUserAgent:
+			values[i] = new(sql.NullString)
+		case authtokens.FieldCreatedAt, authtokens.FieldUpdatedAt, authtokens.FieldExpiresAt, authtokens.FieldLastUsedAt:
 			values[i] = new(sql.NullTime)
 		case authtokens.FieldID:
 			values[i] = new(uuid.UUID)
@@ -130,6 +188,45 @@ func (at *AuthTokens) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				at.ExpiresAt = value.Time
 			}
+		case authtokens.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				at.Name = value.String
+			}
+		case authtokens.FieldSessionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value.Valid {
+				at.SessionID = new(uuid.UUID)
+				*at.SessionID = *value.S.(*uuid.UUID)
+			}
+		case authtokens.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				at.LastUsedAt = new(time.Time)
+				*at.LastUsedAt = value.Time
+			}
+		case authtokens.FieldIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_address", values[i])
+			} else if value.Valid {
+				at.IPAddress = value.String
+			}
+		case authtokens.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				at.UserAgent = value.String
+			}
+		case authtokens.FieldGroupID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				at.GroupID = new(uuid.UUID)
+				*at.GroupID = *value.S.(*uuid.UUID)
+			}
 		case authtokens.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_auth_tokens", values[i])
@@ -155,6 +252,21 @@ func (at *AuthTokens) QueryUser() *UserQuery {
 	return NewAuthTokensClient(at.config).QueryUser(at)
 }
 
+// QuerySession queries the "session" edge of the AuthTokens entity.
+func (at *AuthTokens) QuerySession() *AuthTokensQuery {
+	return NewAuthTokensClient(at.config).QuerySession(at)
+}
+
+// QueryAttachmentTokens queries the "attachment_tokens" edge of the AuthTokens entity.
+func (at *AuthTokens) QueryAttachmentTokens() *AuthTokensQuery {
+	return NewAuthTokensClient(at.config).QueryAttachmentTokens(at)
+}
+
+// QueryGroup queries the "group" edge of the AuthTokens entity.
+func (at *AuthTokens) QueryGroup() *GroupQuery {
+	return NewAuthTokensClient(at.config).QueryGroup(at)
+}
+
 // QueryRoles queries the "roles" edge of the AuthTokens entity.
 func (at *AuthTokens) QueryRoles() *AuthRolesQuery {
 	return NewAuthTokensClient(at.config).QueryRoles(at)
@@ -194,6 +306,30 @@ func (at *AuthTokens) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("expires_at=")
 	builder.WriteString(at.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(at.Name)
+	builder.WriteString(", ")
+	if v := at.SessionID; v != nil {
+		builder.WriteString("session_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := at.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("ip_address=")
+	builder.WriteString(at.IPAddress)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(at.UserAgent)
+	builder.WriteString(", ")
+	if v := at.GroupID; v != nil {
+		builder.WriteString("group_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
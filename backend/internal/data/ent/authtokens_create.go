@@ -13,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authroles"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
 )
 
@@ -71,6 +72,90 @@ func (atc *AuthTokensCreate) SetNillableExpiresAt(t *time.Time) *AuthTokensCreat
 	return atc
 }
 
+// SetName sets the "name" field.
+func (atc *AuthTokensCreate) SetName(s string) *AuthTokensCreate {
+	atc.mutation.SetName(s)
+	return atc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (atc *AuthTokensCreate) SetNillableName(s *string) *AuthTokensCreate {
+	if s != nil {
+		atc.SetName(*s)
+	}
+	return atc
+}
+
+// SetSessionID sets the "session_id" field.
+func (atc *AuthTokensCreate) SetSessionID(u uuid.UUID) *AuthTokensCreate {
+	atc.mutation.SetSessionID(u)
+	return atc
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (atc *AuthTokensCreate) SetNillableSessionID(u *uuid.UUID) *AuthTokensCreate {
+	if u != nil {
+		atc.SetSessionID(*u)
+	}
+	return atc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (atc *AuthTokensCreate) SetLastUsedAt(t time.Time) *AuthTokensCreate {
+	atc.mutation.SetLastUsedAt(t)
+	return atc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (atc *AuthTokensCreate) SetNillableLastUsedAt(t *time.Time) *AuthTokensCreate {
+	if t != nil {
+		atc.SetLastUsedAt(*t)
+	}
+	return atc
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (atc *AuthTokensCreate) SetIPAddress(s string) *AuthTokensCreate {
+	atc.mutation.SetIPAddress(s)
+	return atc
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (atc *AuthTokensCreate) SetNillableIPAddress(s *string) *AuthTokensCreate {
+	if s != nil {
+		atc.SetIPAddress(*s)
+	}
+	return atc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (atc *AuthTokensCreate) SetUserAgent(s string) *AuthTokensCreate {
+	atc.mutation.SetUserAgent(s)
+	return atc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (atc *AuthTokensCreate) SetNillableUserAgent(s *string) *AuthTokensCreate {
+	if s != nil {
+		atc.SetUserAgent(*s)
+	}
+	return atc
+}
+
+// SetGroupID sets the "group_id" field.
+func (atc *AuthTokensCreate) SetGroupID(u uuid.UUID) *AuthTokensCreate {
+	atc.mutation.SetGroupID(u)
+	return atc
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (atc *AuthTokensCreate) SetNillableGroupID(u *uuid.UUID) *AuthTokensCreate {
+	if u != nil {
+		atc.SetGroupID(*u)
+	}
+	return atc
+}
+
 // SetID sets the "id" field.
 func (atc *AuthTokensCreate) SetID(u uuid.UUID) *AuthTokensCreate {
 	atc.mutation.SetID(u)
@@ -104,6 +189,31 @@ func (atc *AuthTokensCreate) SetUser(u *User) *AuthTokensCreate {
 	return atc.SetUserID(u.ID)
 }
 
+// SetSession sets the "session" edge to the AuthTokens entity.
+func (atc *AuthTokensCreate) SetSession(a *AuthTokens) *AuthTokensCreate {
+	return atc.SetSessionID(a.ID)
+}
+
+// AddAttachmentTokenIDs adds the "attachment_tokens" edge to the AuthTokens entity by IDs.
+func (atc *AuthTokensCreate) AddAttachmentTokenIDs(ids ...uuid.UUID) *AuthTokensCreate {
+	atc.mutation.AddAttachmentTokenIDs(ids...)
+	return atc
+}
+
+// AddAttachmentTokens adds the "attachment_tokens" edges to the AuthTokens entity.
+func (atc *AuthTokensCreate) AddAttachmentTokens(a ...*AuthTokens) *AuthTokensCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return atc.AddAttachmentTokenIDs(ids...)
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (atc *AuthTokensCreate) SetGroup(g *Group) *AuthTokensCreate {
+	return atc.SetGroupID(g.ID)
+}
+
 // SetRolesID sets the "roles" edge to the AuthRoles entity by ID.
 func (atc *AuthTokensCreate) SetRolesID(id int) *AuthTokensCreate {
 	atc.mutation.SetRolesID(id)
@@ -190,6 +300,21 @@ func (atc *AuthTokensCreate) check() error {
 	if _, ok := atc.mutation.ExpiresAt(); !ok {
 		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "AuthTokens.expires_at"`)}
 	}
+	if v, ok := atc.mutation.Name(); ok {
+		if err := authtokens.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AuthTokens.name": %w`, err)}
+		}
+	}
+	if v, ok := atc.mutation.IPAddress(); ok {
+		if err := authtokens.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "AuthTokens.ip_address": %w`, err)}
+		}
+	}
+	if v, ok := atc.mutation.UserAgent(); ok {
+		if err := authtokens.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AuthTokens.user_agent": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -241,6 +366,22 @@ func (atc *AuthTokensCreate) createSpec() (*AuthTokens, *sqlgraph.CreateSpec) {
 		_spec.SetField(authtokens.FieldExpiresAt, field.TypeTime, value)
 		_node.ExpiresAt = value
 	}
+	if value, ok := atc.mutation.Name(); ok {
+		_spec.SetField(authtokens.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := atc.mutation.LastUsedAt(); ok {
+		_spec.SetField(authtokens.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := atc.mutation.IPAddress(); ok {
+		_spec.SetField(authtokens.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := atc.mutation.UserAgent(); ok {
+		_spec.SetField(authtokens.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
 	if nodes := atc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -258,6 +399,56 @@ func (atc *AuthTokensCreate) createSpec() (*AuthTokens, *sqlgraph.CreateSpec) {
 		_node.user_auth_tokens = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := atc.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authtokens.SessionTable,
+			Columns: []string{authtokens.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SessionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := atc.mutation.AttachmentTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   authtokens.AttachmentTokensTable,
+			Columns: []string{authtokens.AttachmentTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := atc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authtokens.GroupTable,
+			Columns: []string{authtokens.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := atc.mutation.RolesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -14,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authroles"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
 )
@@ -21,13 +22,16 @@ import (
 // AuthTokensQuery is the builder for querying AuthTokens entities.
 type AuthTokensQuery struct {
 	config
-	ctx        *QueryContext
-	order      []authtokens.OrderOption
-	inters     []Interceptor
-	predicates []predicate.AuthTokens
-	withUser   *UserQuery
-	withRoles  *AuthRolesQuery
-	withFKs    bool
+	ctx                  *QueryContext
+	order                []authtokens.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.AuthTokens
+	withUser             *UserQuery
+	withSession          *AuthTokensQuery
+	withAttachmentTokens *AuthTokensQuery
+	withGroup            *GroupQuery
+	withRoles            *AuthRolesQuery
+	withFKs              bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -86,6 +90,72 @@ func (atq *AuthTokensQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QuerySession chains the current query on the "session" edge.
+func (atq *AuthTokensQuery) QuerySession() *AuthTokensQuery {
+	query := (&AuthTokensClient{config: atq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := atq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := atq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(authtokens.Table, authtokens.FieldID, selector),
+			sqlgraph.To(authtokens.Table, authtokens.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, authtokens.SessionTable, authtokens.SessionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(atq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttachmentTokens chains the current query on the "attachment_tokens" edge.
+func (atq *AuthTokensQuery) QueryAttachmentTokens() *AuthTokensQuery {
+	query := (&AuthTokensClient{config: atq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := atq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := atq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(authtokens.Table, authtokens.FieldID, selector),
+			sqlgraph.To(authtokens.Table, authtokens.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, authtokens.AttachmentTokensTable, authtokens.AttachmentTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(atq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroup chains the current query on the "group" edge.
+func (atq *AuthTokensQuery) QueryGroup() *GroupQuery {
+	query := (&GroupClient{config: atq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := atq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := atq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(authtokens.Table, authtokens.FieldID, selector),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, authtokens.GroupTable, authtokens.GroupColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(atq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryRoles chains the current query on the "roles" edge.
 func (atq *AuthTokensQuery) QueryRoles() *AuthRolesQuery {
 	query := (&AuthRolesClient{config: atq.config}).Query()
@@ -295,13 +365,16 @@ func (atq *AuthTokensQuery) Clone() *AuthTokensQuery {
 		return nil
 	}
 	return &AuthTokensQuery{
-		config:     atq.config,
-		ctx:        atq.ctx.Clone(),
-		order:      append([]authtokens.OrderOption{}, atq.order...),
-		inters:     append([]Interceptor{}, atq.inters...),
-		predicates: append([]predicate.AuthTokens{}, atq.predicates...),
-		withUser:   atq.withUser.Clone(),
-		withRoles:  atq.withRoles.Clone(),
+		config:               atq.config,
+		ctx:                  atq.ctx.Clone(),
+		order:                append([]authtokens.OrderOption{}, atq.order...),
+		inters:               append([]Interceptor{}, atq.inters...),
+		predicates:           append([]predicate.AuthTokens{}, atq.predicates...),
+		withUser:             atq.withUser.Clone(),
+		withSession:          atq.withSession.Clone(),
+		withAttachmentTokens: atq.withAttachmentTokens.Clone(),
+		withGroup:            atq.withGroup.Clone(),
+		withRoles:            atq.withRoles.Clone(),
 		// clone intermediate query.
 		sql:  atq.sql.Clone(),
 		path: atq.path,
@@ -319,6 +392,39 @@ func (atq *AuthTokensQuery) WithUser(opts ...func(*UserQuery)) *AuthTokensQuery
 	return atq
 }
 
+// WithSession tells the query-builder to eager-load the nodes that are connected to
+// the "session" edge. The optional arguments are used to configure the query builder of the edge.
+func (atq *AuthTokensQuery) WithSession(opts ...func(*AuthTokensQuery)) *AuthTokensQuery {
+	query := (&AuthTokensClient{config: atq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	atq.withSession = query
+	return atq
+}
+
+// WithAttachmentTokens tells the query-builder to eager-load the nodes that are connected to
+// the "attachment_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (atq *AuthTokensQuery) WithAttachmentTokens(opts ...func(*AuthTokensQuery)) *AuthTokensQuery {
+	query := (&AuthTokensClient{config: atq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	atq.withAttachmentTokens = query
+	return atq
+}
+
+// WithGroup tells the query-builder to eager-load the nodes that are connected to
+// the "group" edge. The optional arguments are used to configure the query builder of the edge.
+func (atq *AuthTokensQuery) WithGroup(opts ...func(*GroupQuery)) *AuthTokensQuery {
+	query := (&GroupClient{config: atq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	atq.withGroup = query
+	return atq
+}
+
 // WithRoles tells the query-builder to eager-load the nodes that are connected to
 // the "roles" edge. The optional arguments are used to configure the query builder of the edge.
 func (atq *AuthTokensQuery) WithRoles(opts ...func(*AuthRolesQuery)) *AuthTokensQuery {
@@ -409,8 +515,11 @@ func (atq *AuthTokensQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		nodes       = []*AuthTokens{}
 		withFKs     = atq.withFKs
 		_spec       = atq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [5]bool{
 			atq.withUser != nil,
+			atq.withSession != nil,
+			atq.withAttachmentTokens != nil,
+			atq.withGroup != nil,
 			atq.withRoles != nil,
 		}
 	)
@@ -444,6 +553,25 @@ func (atq *AuthTokensQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := atq.withSession; query != nil {
+		if err := atq.loadSession(ctx, query, nodes, nil,
+			func(n *AuthTokens, e *AuthTokens) { n.Edges.Session = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := atq.withAttachmentTokens; query != nil {
+		if err := atq.loadAttachmentTokens(ctx, query, nodes,
+			func(n *AuthTokens) { n.Edges.AttachmentTokens = []*AuthTokens{} },
+			func(n *AuthTokens, e *AuthTokens) { n.Edges.AttachmentTokens = append(n.Edges.AttachmentTokens, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := atq.withGroup; query != nil {
+		if err := atq.loadGroup(ctx, query, nodes, nil,
+			func(n *AuthTokens, e *Group) { n.Edges.Group = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := atq.withRoles; query != nil {
 		if err := atq.loadRoles(ctx, query, nodes, nil,
 			func(n *AuthTokens, e *AuthRoles) { n.Edges.Roles = e }); err != nil {
@@ -485,6 +613,104 @@ func (atq *AuthTokensQuery) loadUser(ctx context.Context, query *UserQuery, node
 	}
 	return nil
 }
+func (atq *AuthTokensQuery) loadSession(ctx context.Context, query *AuthTokensQuery, nodes []*AuthTokens, init func(*AuthTokens), assign func(*AuthTokens, *AuthTokens)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*AuthTokens)
+	for i := range nodes {
+		if nodes[i].SessionID == nil {
+			continue
+		}
+		fk := *nodes[i].SessionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(authtokens.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "session_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (atq *AuthTokensQuery) loadAttachmentTokens(ctx context.Context, query *AuthTokensQuery, nodes []*AuthTokens, init func(*AuthTokens), assign func(*AuthTokens, *AuthTokens)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*AuthTokens)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(authtokens.FieldSessionID)
+	}
+	query.Where(predicate.AuthTokens(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(authtokens.AttachmentTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SessionID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "session_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "session_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (atq *AuthTokensQuery) loadGroup(ctx context.Context, query *GroupQuery, nodes []*AuthTokens, init func(*AuthTokens), assign func(*AuthTokens, *Group)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*AuthTokens)
+	for i := range nodes {
+		if nodes[i].GroupID == nil {
+			continue
+		}
+		fk := *nodes[i].GroupID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(group.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "group_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (atq *AuthTokensQuery) loadRoles(ctx context.Context, query *AuthRolesQuery, nodes []*AuthTokens, init func(*AuthTokens), assign func(*AuthTokens, *AuthRoles)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*AuthTokens)
@@ -539,6 +765,12 @@ func (atq *AuthTokensQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if atq.withSession != nil {
+			_spec.Node.AddColumnOnce(authtokens.FieldSessionID)
+		}
+		if atq.withGroup != nil {
+			_spec.Node.AddColumnOnce(authtokens.FieldGroupID)
+		}
 	}
 	if ps := atq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
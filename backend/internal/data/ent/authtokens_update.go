@@ -14,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authroles"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
 )
@@ -57,6 +58,126 @@ func (atu *AuthTokensUpdate) SetNillableExpiresAt(t *time.Time) *AuthTokensUpdat
 	return atu
 }
 
+// SetName sets the "name" field.
+func (atu *AuthTokensUpdate) SetName(s string) *AuthTokensUpdate {
+	atu.mutation.SetName(s)
+	return atu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (atu *AuthTokensUpdate) SetNillableName(s *string) *AuthTokensUpdate {
+	if s != nil {
+		atu.SetName(*s)
+	}
+	return atu
+}
+
+// ClearName clears the value of the "name" field.
+func (atu *AuthTokensUpdate) ClearName() *AuthTokensUpdate {
+	atu.mutation.ClearName()
+	return atu
+}
+
+// SetSessionID sets the "session_id" field.
+func (atu *AuthTokensUpdate) SetSessionID(u uuid.UUID) *AuthTokensUpdate {
+	atu.mutation.SetSessionID(u)
+	return atu
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (atu *AuthTokensUpdate) SetNillableSessionID(u *uuid.UUID) *AuthTokensUpdate {
+	if u != nil {
+		atu.SetSessionID(*u)
+	}
+	return atu
+}
+
+// ClearSessionID clears the value of the "session_id" field.
+func (atu *AuthTokensUpdate) ClearSessionID() *AuthTokensUpdate {
+	atu.mutation.ClearSessionID()
+	return atu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (atu *AuthTokensUpdate) SetLastUsedAt(t time.Time) *AuthTokensUpdate {
+	atu.mutation.SetLastUsedAt(t)
+	return atu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (atu *AuthTokensUpdate) SetNillableLastUsedAt(t *time.Time) *AuthTokensUpdate {
+	if t != nil {
+		atu.SetLastUsedAt(*t)
+	}
+	return atu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (atu *AuthTokensUpdate) ClearLastUsedAt() *AuthTokensUpdate {
+	atu.mutation.ClearLastUsedAt()
+	return atu
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (atu *AuthTokensUpdate) SetIPAddress(s string) *AuthTokensUpdate {
+	atu.mutation.SetIPAddress(s)
+	return atu
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (atu *AuthTokensUpdate) SetNillableIPAddress(s *string) *AuthTokensUpdate {
+	if s != nil {
+		atu.SetIPAddress(*s)
+	}
+	return atu
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (atu *AuthTokensUpdate) ClearIPAddress() *AuthTokensUpdate {
+	atu.mutation.ClearIPAddress()
+	return atu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (atu *AuthTokensUpdate) SetUserAgent(s string) *AuthTokensUpdate {
+	atu.mutation.SetUserAgent(s)
+	return atu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (atu *AuthTokensUpdate) SetNillableUserAgent(s *string) *AuthTokensUpdate {
+	if s != nil {
+		atu.SetUserAgent(*s)
+	}
+	return atu
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (atu *AuthTokensUpdate) ClearUserAgent() *AuthTokensUpdate {
+	atu.mutation.ClearUserAgent()
+	return atu
+}
+
+// SetGroupID sets the "group_id" field.
+func (atu *AuthTokensUpdate) SetGroupID(u uuid.UUID) *AuthTokensUpdate {
+	atu.mutation.SetGroupID(u)
+	return atu
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (atu *AuthTokensUpdate) SetNillableGroupID(u *uuid.UUID) *AuthTokensUpdate {
+	if u != nil {
+		atu.SetGroupID(*u)
+	}
+	return atu
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (atu *AuthTokensUpdate) ClearGroupID() *AuthTokensUpdate {
+	atu.mutation.ClearGroupID()
+	return atu
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (atu *AuthTokensUpdate) SetUserID(id uuid.UUID) *AuthTokensUpdate {
 	atu.mutation.SetUserID(id)
@@ -76,6 +197,31 @@ func (atu *AuthTokensUpdate) SetUser(u *User) *AuthTokensUpdate {
 	return atu.SetUserID(u.ID)
 }
 
+// SetSession sets the "session" edge to the AuthTokens entity.
+func (atu *AuthTokensUpdate) SetSession(a *AuthTokens) *AuthTokensUpdate {
+	return atu.SetSessionID(a.ID)
+}
+
+// AddAttachmentTokenIDs adds the "attachment_tokens" edge to the AuthTokens entity by IDs.
+func (atu *AuthTokensUpdate) AddAttachmentTokenIDs(ids ...uuid.UUID) *AuthTokensUpdate {
+	atu.mutation.AddAttachmentTokenIDs(ids...)
+	return atu
+}
+
+// AddAttachmentTokens adds the "attachment_tokens" edges to the AuthTokens entity.
+func (atu *AuthTokensUpdate) AddAttachmentTokens(a ...*AuthTokens) *AuthTokensUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return atu.AddAttachmentTokenIDs(ids...)
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (atu *AuthTokensUpdate) SetGroup(g *Group) *AuthTokensUpdate {
+	return atu.SetGroupID(g.ID)
+}
+
 // SetRolesID sets the "roles" edge to the AuthRoles entity by ID.
 func (atu *AuthTokensUpdate) SetRolesID(id int) *AuthTokensUpdate {
 	atu.mutation.SetRolesID(id)
@@ -106,6 +252,39 @@ func (atu *AuthTokensUpdate) ClearUser() *AuthTokensUpdate {
 	return atu
 }
 
+// ClearSession clears the "session" edge to the AuthTokens entity.
+func (atu *AuthTokensUpdate) ClearSession() *AuthTokensUpdate {
+	atu.mutation.ClearSession()
+	return atu
+}
+
+// ClearAttachmentTokens clears all "attachment_tokens" edges to the AuthTokens entity.
+func (atu *AuthTokensUpdate) ClearAttachmentTokens() *AuthTokensUpdate {
+	atu.mutation.ClearAttachmentTokens()
+	return atu
+}
+
+// RemoveAttachmentTokenIDs removes the "attachment_tokens" edge to AuthTokens entities by IDs.
+func (atu *AuthTokensUpdate) RemoveAttachmentTokenIDs(ids ...uuid.UUID) *AuthTokensUpdate {
+	atu.mutation.RemoveAttachmentTokenIDs(ids...)
+	return atu
+}
+
+// RemoveAttachmentTokens removes "attachment_tokens" edges to AuthTokens entities.
+func (atu *AuthTokensUpdate) RemoveAttachmentTokens(a ...*AuthTokens) *AuthTokensUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return atu.RemoveAttachmentTokenIDs(ids...)
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (atu *AuthTokensUpdate) ClearGroup() *AuthTokensUpdate {
+	atu.mutation.ClearGroup()
+	return atu
+}
+
 // ClearRoles clears the "roles" edge to the AuthRoles entity.
 func (atu *AuthTokensUpdate) ClearRoles() *AuthTokensUpdate {
 	atu.mutation.ClearRoles()
@@ -148,7 +327,30 @@ func (atu *AuthTokensUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (atu *AuthTokensUpdate) check() error {
+	if v, ok := atu.mutation.Name(); ok {
+		if err := authtokens.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AuthTokens.name": %w`, err)}
+		}
+	}
+	if v, ok := atu.mutation.IPAddress(); ok {
+		if err := authtokens.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "AuthTokens.ip_address": %w`, err)}
+		}
+	}
+	if v, ok := atu.mutation.UserAgent(); ok {
+		if err := authtokens.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AuthTokens.user_agent": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (atu *AuthTokensUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := atu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(authtokens.Table, authtokens.Columns, sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID))
 	if ps := atu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -166,6 +368,30 @@ func (atu *AuthTokensUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := atu.mutation.ExpiresAt(); ok {
 		_spec.SetField(authtokens.FieldExpiresAt, field.TypeTime, value)
 	}
+	if value, ok := atu.mutation.Name(); ok {
+		_spec.SetField(authtokens.FieldName, field.TypeString, value)
+	}
+	if atu.mutation.NameCleared() {
+		_spec.ClearField(authtokens.FieldName, field.TypeString)
+	}
+	if value, ok := atu.mutation.LastUsedAt(); ok {
+		_spec.SetField(authtokens.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if atu.mutation.LastUsedAtCleared() {
+		_spec.ClearField(authtokens.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := atu.mutation.IPAddress(); ok {
+		_spec.SetField(authtokens.FieldIPAddress, field.TypeString, value)
+	}
+	if atu.mutation.IPAddressCleared() {
+		_spec.ClearField(authtokens.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := atu.mutation.UserAgent(); ok {
+		_spec.SetField(authtokens.FieldUserAgent, field.TypeString, value)
+	}
+	if atu.mutation.UserAgentCleared() {
+		_spec.ClearField(authtokens.FieldUserAgent, field.TypeString)
+	}
 	if atu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -195,6 +421,109 @@ func (atu *AuthTokensUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if atu.mutation.SessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authtokens.SessionTable,
+			Columns: []string{authtokens.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atu.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authtokens.SessionTable,
+			Columns: []string{authtokens.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if atu.mutation.AttachmentTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   authtokens.AttachmentTokensTable,
+			Columns: []string{authtokens.AttachmentTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atu.mutation.RemovedAttachmentTokensIDs(); len(nodes) > 0 && !atu.mutation.AttachmentTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   authtokens.AttachmentTokensTable,
+			Columns: []string{authtokens.AttachmentTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atu.mutation.AttachmentTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   authtokens.AttachmentTokensTable,
+			Columns: []string{authtokens.AttachmentTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if atu.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authtokens.GroupTable,
+			Columns: []string{authtokens.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atu.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authtokens.GroupTable,
+			Columns: []string{authtokens.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if atu.mutation.RolesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -270,6 +599,126 @@ func (atuo *AuthTokensUpdateOne) SetNillableExpiresAt(t *time.Time) *AuthTokensU
 	return atuo
 }
 
+// SetName sets the "name" field.
+func (atuo *AuthTokensUpdateOne) SetName(s string) *AuthTokensUpdateOne {
+	atuo.mutation.SetName(s)
+	return atuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (atuo *AuthTokensUpdateOne) SetNillableName(s *string) *AuthTokensUpdateOne {
+	if s != nil {
+		atuo.SetName(*s)
+	}
+	return atuo
+}
+
+// ClearName clears the value of the "name" field.
+func (atuo *AuthTokensUpdateOne) ClearName() *AuthTokensUpdateOne {
+	atuo.mutation.ClearName()
+	return atuo
+}
+
+// SetSessionID sets the "session_id" field.
+func (atuo *AuthTokensUpdateOne) SetSessionID(u uuid.UUID) *AuthTokensUpdateOne {
+	atuo.mutation.SetSessionID(u)
+	return atuo
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (atuo *AuthTokensUpdateOne) SetNillableSessionID(u *uuid.UUID) *AuthTokensUpdateOne {
+	if u != nil {
+		atuo.SetSessionID(*u)
+	}
+	return atuo
+}
+
+// ClearSessionID clears the value of the "session_id" field.
+func (atuo *AuthTokensUpdateOne) ClearSessionID() *AuthTokensUpdateOne {
+	atuo.mutation.ClearSessionID()
+	return atuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (atuo *AuthTokensUpdateOne) SetLastUsedAt(t time.Time) *AuthTokensUpdateOne {
+	atuo.mutation.SetLastUsedAt(t)
+	return atuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (atuo *AuthTokensUpdateOne) SetNillableLastUsedAt(t *time.Time) *AuthTokensUpdateOne {
+	if t != nil {
+		atuo.SetLastUsedAt(*t)
+	}
+	return atuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (atuo *AuthTokensUpdateOne) ClearLastUsedAt() *AuthTokensUpdateOne {
+	atuo.mutation.ClearLastUsedAt()
+	return atuo
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (atuo *AuthTokensUpdateOne) SetIPAddress(s string) *AuthTokensUpdateOne {
+	atuo.mutation.SetIPAddress(s)
+	return atuo
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (atuo *AuthTokensUpdateOne) SetNillableIPAddress(s *string) *AuthTokensUpdateOne {
+	if s != nil {
+		atuo.SetIPAddress(*s)
+	}
+	return atuo
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (atuo *AuthTokensUpdateOne) ClearIPAddress() *AuthTokensUpdateOne {
+	atuo.mutation.ClearIPAddress()
+	return atuo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (atuo *AuthTokensUpdateOne) SetUserAgent(s string) *AuthTokensUpdateOne {
+	atuo.mutation.SetUserAgent(s)
+	return atuo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (atuo *AuthTokensUpdateOne) SetNillableUserAgent(s *string) *AuthTokensUpdateOne {
+	if s != nil {
+		atuo.SetUserAgent(*s)
+	}
+	return atuo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (atuo *AuthTokensUpdateOne) ClearUserAgent() *AuthTokensUpdateOne {
+	atuo.mutation.ClearUserAgent()
+	return atuo
+}
+
+// SetGroupID sets the "group_id" field.
+func (atuo *AuthTokensUpdateOne) SetGroupID(u uuid.UUID) *AuthTokensUpdateOne {
+	atuo.mutation.SetGroupID(u)
+	return atuo
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (atuo *AuthTokensUpdateOne) SetNillableGroupID(u *uuid.UUID) *AuthTokensUpdateOne {
+	if u != nil {
+		atuo.SetGroupID(*u)
+	}
+	return atuo
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (atuo *AuthTokensUpdateOne) ClearGroupID() *AuthTokensUpdateOne {
+	atuo.mutation.ClearGroupID()
+	return atuo
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (atuo *AuthTokensUpdateOne) SetUserID(id uuid.UUID) *AuthTokensUpdateOne {
 	atuo.mutation.SetUserID(id)
@@ -289,6 +738,31 @@ func (atuo *AuthTokensUpdateOne) SetUser(u *User) *AuthTokensUpdateOne {
 	return atuo.SetUserID(u.ID)
 }
 
+// SetSession sets the "session" edge to the AuthTokens entity.
+func (atuo *AuthTokensUpdateOne) SetSession(a *AuthTokens) *AuthTokensUpdateOne {
+	return atuo.SetSessionID(a.ID)
+}
+
+// AddAttachmentTokenIDs adds the "attachment_tokens" edge to the AuthTokens entity by IDs.
+func (atuo *AuthTokensUpdateOne) AddAttachmentTokenIDs(ids ...uuid.UUID) *AuthTokensUpdateOne {
+	atuo.mutation.AddAttachmentTokenIDs(ids...)
+	return atuo
+}
+
+// AddAttachmentTokens adds the "attachment_tokens" edges to the AuthTokens entity.
+func (atuo *AuthTokensUpdateOne) AddAttachmentTokens(a ...*AuthTokens) *AuthTokensUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return atuo.AddAttachmentTokenIDs(ids...)
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (atuo *AuthTokensUpdateOne) SetGroup(g *Group) *AuthTokensUpdateOne {
+	return atuo.SetGroupID(g.ID)
+}
+
 // SetRolesID sets the "roles" edge to the AuthRoles entity by ID.
 func (atuo *AuthTokensUpdateOne) SetRolesID(id int) *AuthTokensUpdateOne {
 	atuo.mutation.SetRolesID(id)
@@ -319,6 +793,39 @@ func (atuo *AuthTokensUpdateOne) ClearUser() *AuthTokensUpdateOne {
 	return atuo
 }
 
+// ClearSession clears the "session" edge to the AuthTokens entity.
+func (atuo *AuthTokensUpdateOne) ClearSession() *AuthTokensUpdateOne {
+	atuo.mutation.ClearSession()
+	return atuo
+}
+
+// ClearAttachmentTokens clears all "attachment_tokens" edges to the AuthTokens entity.
+func (atuo *AuthTokensUpdateOne) ClearAttachmentTokens() *AuthTokensUpdateOne {
+	atuo.mutation.ClearAttachmentTokens()
+	return atuo
+}
+
+// RemoveAttachmentTokenIDs removes the "attachment_tokens" edge to AuthTokens entities by IDs.
+func (atuo *AuthTokensUpdateOne) RemoveAttachmentTokenIDs(ids ...uuid.UUID) *AuthTokensUpdateOne {
+	atuo.mutation.RemoveAttachmentTokenIDs(ids...)
+	return atuo
+}
+
+// RemoveAttachmentTokens removes "attachment_tokens" edges to AuthTokens entities.
+func (atuo *AuthTokensUpdateOne) RemoveAttachmentTokens(a ...*AuthTokens) *AuthTokensUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return atuo.RemoveAttachmentTokenIDs(ids...)
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (atuo *AuthTokensUpdateOne) ClearGroup() *AuthTokensUpdateOne {
+	atuo.mutation.ClearGroup()
+	return atuo
+}
+
 // ClearRoles clears the "roles" edge to the AuthRoles entity.
 func (atuo *AuthTokensUpdateOne) ClearRoles() *AuthTokensUpdateOne {
 	atuo.mutation.ClearRoles()
@@ -374,7 +881,30 @@ func (atuo *AuthTokensUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (atuo *AuthTokensUpdateOne) check() error {
+	if v, ok := atuo.mutation.Name(); ok {
+		if err := authtokens.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AuthTokens.name": %w`, err)}
+		}
+	}
+	if v, ok := atuo.mutation.IPAddress(); ok {
+		if err := authtokens.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "AuthTokens.ip_address": %w`, err)}
+		}
+	}
+	if v, ok := atuo.mutation.UserAgent(); ok {
+		if err := authtokens.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AuthTokens.user_agent": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (atuo *AuthTokensUpdateOne) sqlSave(ctx context.Context) (_node *AuthTokens, err error) {
+	if err := atuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(authtokens.Table, authtokens.Columns, sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID))
 	id, ok := atuo.mutation.ID()
 	if !ok {
@@ -409,6 +939,30 @@ func (atuo *AuthTokensUpdateOne) sqlSave(ctx context.Context) (_node *AuthTokens
 	if value, ok := atuo.mutation.ExpiresAt(); ok {
 		_spec.SetField(authtokens.FieldExpiresAt, field.TypeTime, value)
 	}
+	if value, ok := atuo.mutation.Name(); ok {
+		_spec.SetField(authtokens.FieldName, field.TypeString, value)
+	}
+	if atuo.mutation.NameCleared() {
+		_spec.ClearField(authtokens.FieldName, field.TypeString)
+	}
+	if value, ok := atuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(authtokens.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if atuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(authtokens.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := atuo.mutation.IPAddress(); ok {
+		_spec.SetField(authtokens.FieldIPAddress, field.TypeString, value)
+	}
+	if atuo.mutation.IPAddressCleared() {
+		_spec.ClearField(authtokens.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := atuo.mutation.UserAgent(); ok {
+		_spec.SetField(authtokens.FieldUserAgent, field.TypeString, value)
+	}
+	if atuo.mutation.UserAgentCleared() {
+		_spec.ClearField(authtokens.FieldUserAgent, field.TypeString)
+	}
 	if atuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -438,6 +992,109 @@ func (atuo *AuthTokensUpdateOne) sqlSave(ctx context.Context) (_node *AuthTokens
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if atuo.mutation.SessionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authtokens.SessionTable,
+			Columns: []string{authtokens.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atuo.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authtokens.SessionTable,
+			Columns: []string{authtokens.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if atuo.mutation.AttachmentTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   authtokens.AttachmentTokensTable,
+			Columns: []string{authtokens.AttachmentTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atuo.mutation.RemovedAttachmentTokensIDs(); len(nodes) > 0 && !atuo.mutation.AttachmentTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   authtokens.AttachmentTokensTable,
+			Columns: []string{authtokens.AttachmentTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atuo.mutation.AttachmentTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   authtokens.AttachmentTokensTable,
+			Columns: []string{authtokens.AttachmentTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if atuo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authtokens.GroupTable,
+			Columns: []string{authtokens.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atuo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authtokens.GroupTable,
+			Columns: []string{authtokens.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if atuo.mutation.RolesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -13,18 +13,28 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/auditentry"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authroles"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/document"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/groupinvitationtoken"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/itemfield"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationreminder"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationrule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationtemplate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifierdelivery"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/usertoken"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/webauthncredential"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -86,18 +96,28 @@ func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			attachment.Table:           attachment.ValidColumn,
+			auditentry.Table:           auditentry.ValidColumn,
 			authroles.Table:            authroles.ValidColumn,
 			authtokens.Table:           authtokens.ValidColumn,
 			document.Table:             document.ValidColumn,
 			group.Table:                group.ValidColumn,
 			groupinvitationtoken.Table: groupinvitationtoken.ValidColumn,
+			groupmembership.Table:      groupmembership.ValidColumn,
 			item.Table:                 item.ValidColumn,
 			itemfield.Table:            itemfield.ValidColumn,
 			label.Table:                label.ValidColumn,
 			location.Table:             location.ValidColumn,
 			maintenanceentry.Table:     maintenanceentry.ValidColumn,
+			maintenanceschedule.Table:  maintenanceschedule.ValidColumn,
+			notificationreminder.Table: notificationreminder.ValidColumn,
+			notificationrule.Table:     notificationrule.ValidColumn,
+			notificationtemplate.Table: notificationtemplate.ValidColumn,
 			notifier.Table:             notifier.ValidColumn,
+			notifierdelivery.Table:     notifierdelivery.ValidColumn,
+			savedsearch.Table:          savedsearch.ValidColumn,
 			user.Table:                 user.ValidColumn,
+			usertoken.Table:            usertoken.ValidColumn,
+			webauthncredential.Table:   webauthncredential.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
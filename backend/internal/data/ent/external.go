@@ -11,3 +11,15 @@ import (
 func (c *Client) Sql() *sql.DB {
 	return c.driver.(*entsql.Driver).DB()
 }
+
+// Dialect returns the name of the SQL dialect used by the underlying driver.
+// This is used to select dialect specific queries for raw SQL statements.
+func (c *Client) Dialect() string {
+	return c.driver.Dialect()
+}
+
+// NewNotFoundError returns the not found error of the entity with the label, for
+// changes that detect a missing entity by the number of affected rows.
+func NewNotFoundError(label string) *NotFoundError {
+	return &NotFoundError{label: label}
+}
@@ -26,6 +26,14 @@ type Group struct {
 	Name string `json:"name,omitempty"`
 	// Currency holds the value of the "currency" field.
 	Currency string `json:"currency,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone,omitempty"`
+	// NotifyTime holds the value of the "notify_time" field.
+	NotifyTime string `json:"notify_time,omitempty"`
+	// Digest holds the value of the "digest" field.
+	Digest group.Digest `json:"digest,omitempty"`
+	// NotifiedOn holds the value of the "notified_on" field.
+	NotifiedOn *time.Time `json:"notified_on,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GroupQuery when eager-loading is set.
 	Edges        GroupEdges `json:"edges"`
@@ -36,6 +44,10 @@ type Group struct {
 type GroupEdges struct {
 	// Users holds the value of the users edge.
 	Users []*User `json:"users,omitempty"`
+	// Memberships holds the value of the memberships edge.
+	Memberships []*GroupMembership `json:"memberships,omitempty"`
+	// AuthTokens holds the value of the auth_tokens edge.
+	AuthTokens []*AuthTokens `json:"auth_tokens,omitempty"`
 	// Locations holds the value of the locations edge.
 	Locations []*Location `json:"locations,omitempty"`
 	// Items holds the value of the items edge.
@@ -48,9 +60,19 @@ type GroupEdges struct {
 	InvitationTokens []*GroupInvitationToken `json:"invitation_tokens,omitempty"`
 	// Notifiers holds the value of the notifiers edge.
 	Notifiers []*Notifier `json:"notifiers,omitempty"`
+	// AuditEntries holds the value of the audit_entries edge.
+	AuditEntries []*AuditEntry `json:"audit_entries,omitempty"`
+	// SavedSearches holds the value of the saved_searches edge.
+	SavedSearches []*SavedSearch `json:"saved_searches,omitempty"`
+	// NotificationRules holds the value of the notification_rules edge.
+	NotificationRules []*NotificationRule `json:"notification_rules,omitempty"`
+	// NotificationReminders holds the value of the notification_reminders edge.
+	NotificationReminders []*NotificationReminder `json:"notification_reminders,omitempty"`
+	// NotificationTemplates holds the value of the notification_templates edge.
+	NotificationTemplates []*NotificationTemplate `json:"notification_templates,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [14]bool
 }
 
 // UsersOrErr returns the Users value or an error if the edge
@@ -62,10 +84,28 @@ func (e GroupEdges) UsersOrErr() ([]*User, error) {
 	return nil, &NotLoadedError{edge: "users"}
 }
 
+// MembershipsOrErr returns the Memberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) MembershipsOrErr() ([]*GroupMembership, error) {
+	if e.loadedTypes[1] {
+		return e.Memberships, nil
+	}
+	return nil, &NotLoadedError{edge: "memberships"}
+}
+
+// AuthTokensOrErr returns the AuthTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) AuthTokensOrErr() ([]*AuthTokens, error) {
+	if e.loadedTypes[2] {
+		return e.AuthTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "auth_tokens"}
+}
+
 // LocationsOrErr returns the Locations value or an error if the edge
 // was not loaded in eager-loading.
 func (e GroupEdges) LocationsOrErr() ([]*Location, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[3] {
 		return e.Locations, nil
 	}
 	return nil, &NotLoadedError{edge: "locations"}
@@ -74,7 +114,7 @@ func (e GroupEdges) LocationsOrErr() ([]*Location, error) {
 // ItemsOrErr returns the Items value or an error if the edge
 // was not loaded in eager-loading.
 func (e GroupEdges) ItemsOrErr() ([]*Item, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[4] {
 		return e.Items, nil
 	}
 	return nil, &NotLoadedError{edge: "items"}
@@ -83,7 +123,7 @@ func (e GroupEdges) ItemsOrErr() ([]*Item, error) {
 // LabelsOrErr returns the Labels value or an error if the edge
 // was not loaded in eager-loading.
 func (e GroupEdges) LabelsOrErr() ([]*Label, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[5] {
 		return e.Labels, nil
 	}
 	return nil, &NotLoadedError{edge: "labels"}
@@ -92,7 +132,7 @@ func (e GroupEdges) LabelsOrErr() ([]*Label, error) {
 // DocumentsOrErr returns the Documents value or an error if the edge
 // was not loaded in eager-loading.
 func (e GroupEdges) DocumentsOrErr() ([]*Document, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[6] {
 		return e.Documents, nil
 	}
 	return nil, &NotLoadedError{edge: "documents"}
@@ -101,7 +141,7 @@ func (e GroupEdges) DocumentsOrErr() ([]*Document, error) {
 // InvitationTokensOrErr returns the InvitationTokens value or an error if the edge
 // was not loaded in eager-loading.
 func (e GroupEdges) InvitationTokensOrErr() ([]*GroupInvitationToken, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[7] {
 		return e.InvitationTokens, nil
 	}
 	return nil, &NotLoadedError{edge: "invitation_tokens"}
@@ -110,20 +150,65 @@ func (e GroupEdges) InvitationTokensOrErr() ([]*GroupInvitationToken, error) {
 // NotifiersOrErr returns the Notifiers value or an error if the edge
 // was not loaded in eager-loading.
 func (e GroupEdges) NotifiersOrErr() ([]*Notifier, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[8] {
 		return e.Notifiers, nil
 	}
 	return nil, &NotLoadedError{edge: "notifiers"}
 }
 
+// AuditEntriesOrErr returns the AuditEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) AuditEntriesOrErr() ([]*AuditEntry, error) {
+	if e.loadedTypes[9] {
+		return e.AuditEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "audit_entries"}
+}
+
+// SavedSearchesOrErr returns the SavedSearches value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) SavedSearchesOrErr() ([]*SavedSearch, error) {
+	if e.loadedTypes[10] {
+		return e.SavedSearches, nil
+	}
+	return nil, &NotLoadedError{edge: "saved_searches"}
+}
+
+// NotificationRulesOrErr returns the NotificationRules value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) NotificationRulesOrErr() ([]*NotificationRule, error) {
+	if e.loadedTypes[11] {
+		return e.NotificationRules, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_rules"}
+}
+
+// NotificationRemindersOrErr returns the NotificationReminders value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) NotificationRemindersOrErr() ([]*NotificationReminder, error) {
+	if e.loadedTypes[12] {
+		return e.NotificationReminders, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_reminders"}
+}
+
+// NotificationTemplatesOrErr returns the NotificationTemplates value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupEdges) NotificationTemplatesOrErr() ([]*NotificationTemplate, error) {
+	if e.loadedTypes[13] {
+		return e.NotificationTemplates, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_templates"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Group) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case group.FieldName, group.FieldCurrency:
+		case group.FieldName, group.FieldCurrency, group.FieldTimezone, group.FieldNotifyTime, group.FieldDigest:
 			values[i] = new(sql.NullString)
-		case group.FieldCreatedAt, group.FieldUpdatedAt:
+		case group.FieldCreatedAt, group.FieldUpdatedAt, group.FieldNotifiedOn:
 			values[i] = new(sql.NullTime)
 		case group.FieldID:
 			values[i] = new(uuid.UUID)
@@ -172,6 +257,31 @@ func (gr *Group) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				gr.Currency = value.String
 			}
+		case group.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				gr.Timezone = value.String
+			}
+		case group.FieldNotifyTime:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notify_time", values[i])
+			} else if value.Valid {
+				gr.NotifyTime = value.String
+			}
+		case group.FieldDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field digest", values[i])
+			} else if value.Valid {
+				gr.Digest = group.Digest(value.String)
+			}
+		case group.FieldNotifiedOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field notified_on", values[i])
+			} else if value.Valid {
+				gr.NotifiedOn = new(time.Time)
+				*gr.NotifiedOn = value.Time
+			}
 		default:
 			gr.selectValues.Set(columns[i], values[i])
 		}
@@ -190,6 +300,16 @@ func (gr *Group) QueryUsers() *UserQuery {
 	return NewGroupClient(gr.config).QueryUsers(gr)
 }
 
+// QueryMemberships queries the "memberships" edge of the Group entity.
+func (gr *Group) QueryMemberships() *GroupMembershipQuery {
+	return NewGroupClient(gr.config).QueryMemberships(gr)
+}
+
+// QueryAuthTokens queries the "auth_tokens" edge of the Group entity.
+func (gr *Group) QueryAuthTokens() *AuthTokensQuery {
+	return NewGroupClient(gr.config).QueryAuthTokens(gr)
+}
+
 // QueryLocations queries the "locations" edge of the Group entity.
 func (gr *Group) QueryLocations() *LocationQuery {
 	return NewGroupClient(gr.config).QueryLocations(gr)
@@ -220,6 +340,31 @@ func (gr *Group) QueryNotifiers() *NotifierQuery {
 	return NewGroupClient(gr.config).QueryNotifiers(gr)
 }
 
+// QueryAuditEntries queries the "audit_entries" edge of the Group entity.
+func (gr *Group) QueryAuditEntries() *AuditEntryQuery {
+	return NewGroupClient(gr.config).QueryAuditEntries(gr)
+}
+
+// QuerySavedSearches queries the "saved_searches" edge of the Group entity.
+func (gr *Group) QuerySavedSearches() *SavedSearchQuery {
+	return NewGroupClient(gr.config).QuerySavedSearches(gr)
+}
+
+// QueryNotificationRules queries the "notification_rules" edge of the Group entity.
+func (gr *Group) QueryNotificationRules() *NotificationRuleQuery {
+	return NewGroupClient(gr.config).QueryNotificationRules(gr)
+}
+
+// QueryNotificationReminders queries the "notification_reminders" edge of the Group entity.
+func (gr *Group) QueryNotificationReminders() *NotificationReminderQuery {
+	return NewGroupClient(gr.config).QueryNotificationReminders(gr)
+}
+
+// QueryNotificationTemplates queries the "notification_templates" edge of the Group entity.
+func (gr *Group) QueryNotificationTemplates() *NotificationTemplateQuery {
+	return NewGroupClient(gr.config).QueryNotificationTemplates(gr)
+}
+
 // Update returns a builder for updating this Group.
 // Note that you need to call Group.Unwrap() before calling this method if this Group
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -254,6 +399,20 @@ func (gr *Group) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("currency=")
 	builder.WriteString(gr.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(gr.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("notify_time=")
+	builder.WriteString(gr.NotifyTime)
+	builder.WriteString(", ")
+	builder.WriteString("digest=")
+	builder.WriteString(fmt.Sprintf("%v", gr.Digest))
+	builder.WriteString(", ")
+	if v := gr.NotifiedOn; v != nil {
+		builder.WriteString("notified_on=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -76,6 +76,21 @@ func Currency(v string) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldCurrency, v))
 }
 
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldTimezone, v))
+}
+
+// NotifyTime applies equality check predicate on the "notify_time" field. It's identical to NotifyTimeEQ.
+func NotifyTime(v string) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldNotifyTime, v))
+}
+
+// NotifiedOn applies equality check predicate on the "notified_on" field. It's identical to NotifiedOnEQ.
+func NotifiedOn(v time.Time) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldNotifiedOn, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldCreatedAt, v))
@@ -286,6 +301,216 @@ func CurrencyContainsFold(v string) predicate.Group {
 	return predicate.Group(sql.FieldContainsFold(FieldCurrency, v))
 }
 
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.Group {
+	return predicate.Group(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneIsNil applies the IsNil predicate on the "timezone" field.
+func TimezoneIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldTimezone))
+}
+
+// TimezoneNotNil applies the NotNil predicate on the "timezone" field.
+func TimezoneNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldTimezone))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// NotifyTimeEQ applies the EQ predicate on the "notify_time" field.
+func NotifyTimeEQ(v string) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldNotifyTime, v))
+}
+
+// NotifyTimeNEQ applies the NEQ predicate on the "notify_time" field.
+func NotifyTimeNEQ(v string) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldNotifyTime, v))
+}
+
+// NotifyTimeIn applies the In predicate on the "notify_time" field.
+func NotifyTimeIn(vs ...string) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldNotifyTime, vs...))
+}
+
+// NotifyTimeNotIn applies the NotIn predicate on the "notify_time" field.
+func NotifyTimeNotIn(vs ...string) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldNotifyTime, vs...))
+}
+
+// NotifyTimeGT applies the GT predicate on the "notify_time" field.
+func NotifyTimeGT(v string) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldNotifyTime, v))
+}
+
+// NotifyTimeGTE applies the GTE predicate on the "notify_time" field.
+func NotifyTimeGTE(v string) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldNotifyTime, v))
+}
+
+// NotifyTimeLT applies the LT predicate on the "notify_time" field.
+func NotifyTimeLT(v string) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldNotifyTime, v))
+}
+
+// NotifyTimeLTE applies the LTE predicate on the "notify_time" field.
+func NotifyTimeLTE(v string) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldNotifyTime, v))
+}
+
+// NotifyTimeContains applies the Contains predicate on the "notify_time" field.
+func NotifyTimeContains(v string) predicate.Group {
+	return predicate.Group(sql.FieldContains(FieldNotifyTime, v))
+}
+
+// NotifyTimeHasPrefix applies the HasPrefix predicate on the "notify_time" field.
+func NotifyTimeHasPrefix(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasPrefix(FieldNotifyTime, v))
+}
+
+// NotifyTimeHasSuffix applies the HasSuffix predicate on the "notify_time" field.
+func NotifyTimeHasSuffix(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasSuffix(FieldNotifyTime, v))
+}
+
+// NotifyTimeEqualFold applies the EqualFold predicate on the "notify_time" field.
+func NotifyTimeEqualFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldEqualFold(FieldNotifyTime, v))
+}
+
+// NotifyTimeContainsFold applies the ContainsFold predicate on the "notify_time" field.
+func NotifyTimeContainsFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldContainsFold(FieldNotifyTime, v))
+}
+
+// DigestEQ applies the EQ predicate on the "digest" field.
+func DigestEQ(v Digest) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldDigest, v))
+}
+
+// DigestNEQ applies the NEQ predicate on the "digest" field.
+func DigestNEQ(v Digest) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldDigest, v))
+}
+
+// DigestIn applies the In predicate on the "digest" field.
+func DigestIn(vs ...Digest) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldDigest, vs...))
+}
+
+// DigestNotIn applies the NotIn predicate on the "digest" field.
+func DigestNotIn(vs ...Digest) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldDigest, vs...))
+}
+
+// NotifiedOnEQ applies the EQ predicate on the "notified_on" field.
+func NotifiedOnEQ(v time.Time) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldNotifiedOn, v))
+}
+
+// NotifiedOnNEQ applies the NEQ predicate on the "notified_on" field.
+func NotifiedOnNEQ(v time.Time) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldNotifiedOn, v))
+}
+
+// NotifiedOnIn applies the In predicate on the "notified_on" field.
+func NotifiedOnIn(vs ...time.Time) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldNotifiedOn, vs...))
+}
+
+// NotifiedOnNotIn applies the NotIn predicate on the "notified_on" field.
+func NotifiedOnNotIn(vs ...time.Time) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldNotifiedOn, vs...))
+}
+
+// NotifiedOnGT applies the GT predicate on the "notified_on" field.
+func NotifiedOnGT(v time.Time) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldNotifiedOn, v))
+}
+
+// NotifiedOnGTE applies the GTE predicate on the "notified_on" field.
+func NotifiedOnGTE(v time.Time) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldNotifiedOn, v))
+}
+
+// NotifiedOnLT applies the LT predicate on the "notified_on" field.
+func NotifiedOnLT(v time.Time) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldNotifiedOn, v))
+}
+
+// NotifiedOnLTE applies the LTE predicate on the "notified_on" field.
+func NotifiedOnLTE(v time.Time) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldNotifiedOn, v))
+}
+
+// NotifiedOnIsNil applies the IsNil predicate on the "notified_on" field.
+func NotifiedOnIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldNotifiedOn))
+}
+
+// NotifiedOnNotNil applies the NotNil predicate on the "notified_on" field.
+func NotifiedOnNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldNotifiedOn))
+}
+
 // HasUsers applies the HasEdge predicate on the "users" edge.
 func HasUsers() predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -309,6 +534,52 @@ func HasUsersWith(preds ...predicate.User) predicate.Group {
 	})
 }
 
+// HasMemberships applies the HasEdge predicate on the "memberships" edge.
+func HasMemberships() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MembershipsTable, MembershipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMembershipsWith applies the HasEdge predicate on the "memberships" edge with a given conditions (other predicates).
+func HasMembershipsWith(preds ...predicate.GroupMembership) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newMembershipsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAuthTokens applies the HasEdge predicate on the "auth_tokens" edge.
+func HasAuthTokens() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AuthTokensTable, AuthTokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuthTokensWith applies the HasEdge predicate on the "auth_tokens" edge with a given conditions (other predicates).
+func HasAuthTokensWith(preds ...predicate.AuthTokens) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newAuthTokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasLocations applies the HasEdge predicate on the "locations" edge.
 func HasLocations() predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -447,6 +718,121 @@ func HasNotifiersWith(preds ...predicate.Notifier) predicate.Group {
 	})
 }
 
+// HasAuditEntries applies the HasEdge predicate on the "audit_entries" edge.
+func HasAuditEntries() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AuditEntriesTable, AuditEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuditEntriesWith applies the HasEdge predicate on the "audit_entries" edge with a given conditions (other predicates).
+func HasAuditEntriesWith(preds ...predicate.AuditEntry) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newAuditEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSavedSearches applies the HasEdge predicate on the "saved_searches" edge.
+func HasSavedSearches() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SavedSearchesTable, SavedSearchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedSearchesWith applies the HasEdge predicate on the "saved_searches" edge with a given conditions (other predicates).
+func HasSavedSearchesWith(preds ...predicate.SavedSearch) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newSavedSearchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotificationRules applies the HasEdge predicate on the "notification_rules" edge.
+func HasNotificationRules() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationRulesTable, NotificationRulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationRulesWith applies the HasEdge predicate on the "notification_rules" edge with a given conditions (other predicates).
+func HasNotificationRulesWith(preds ...predicate.NotificationRule) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newNotificationRulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotificationReminders applies the HasEdge predicate on the "notification_reminders" edge.
+func HasNotificationReminders() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationRemindersTable, NotificationRemindersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationRemindersWith applies the HasEdge predicate on the "notification_reminders" edge with a given conditions (other predicates).
+func HasNotificationRemindersWith(preds ...predicate.NotificationReminder) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newNotificationRemindersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotificationTemplates applies the HasEdge predicate on the "notification_templates" edge.
+func HasNotificationTemplates() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationTemplatesTable, NotificationTemplatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationTemplatesWith applies the HasEdge predicate on the "notification_templates" edge with a given conditions (other predicates).
+func HasNotificationTemplatesWith(preds ...predicate.NotificationTemplate) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newNotificationTemplatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Group) predicate.Group {
 	return predicate.Group(sql.AndPredicates(predicates...))
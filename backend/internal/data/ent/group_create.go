@@ -11,13 +11,20 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/auditentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/document"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/groupinvitationtoken"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationreminder"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationrule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationtemplate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
 )
 
@@ -76,6 +83,62 @@ func (gc *GroupCreate) SetNillableCurrency(s *string) *GroupCreate {
 	return gc
 }
 
+// SetTimezone sets the "timezone" field.
+func (gc *GroupCreate) SetTimezone(s string) *GroupCreate {
+	gc.mutation.SetTimezone(s)
+	return gc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (gc *GroupCreate) SetNillableTimezone(s *string) *GroupCreate {
+	if s != nil {
+		gc.SetTimezone(*s)
+	}
+	return gc
+}
+
+// SetNotifyTime sets the "notify_time" field.
+func (gc *GroupCreate) SetNotifyTime(s string) *GroupCreate {
+	gc.mutation.SetNotifyTime(s)
+	return gc
+}
+
+// SetNillableNotifyTime sets the "notify_time" field if the given value is not nil.
+func (gc *GroupCreate) SetNillableNotifyTime(s *string) *GroupCreate {
+	if s != nil {
+		gc.SetNotifyTime(*s)
+	}
+	return gc
+}
+
+// SetDigest sets the "digest" field.
+func (gc *GroupCreate) SetDigest(gr group.Digest) *GroupCreate {
+	gc.mutation.SetDigest(gr)
+	return gc
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (gc *GroupCreate) SetNillableDigest(gr *group.Digest) *GroupCreate {
+	if gr != nil {
+		gc.SetDigest(*gr)
+	}
+	return gc
+}
+
+// SetNotifiedOn sets the "notified_on" field.
+func (gc *GroupCreate) SetNotifiedOn(t time.Time) *GroupCreate {
+	gc.mutation.SetNotifiedOn(t)
+	return gc
+}
+
+// SetNillableNotifiedOn sets the "notified_on" field if the given value is not nil.
+func (gc *GroupCreate) SetNillableNotifiedOn(t *time.Time) *GroupCreate {
+	if t != nil {
+		gc.SetNotifiedOn(*t)
+	}
+	return gc
+}
+
 // SetID sets the "id" field.
 func (gc *GroupCreate) SetID(u uuid.UUID) *GroupCreate {
 	gc.mutation.SetID(u)
@@ -105,6 +168,36 @@ func (gc *GroupCreate) AddUsers(u ...*User) *GroupCreate {
 	return gc.AddUserIDs(ids...)
 }
 
+// AddMembershipIDs adds the "memberships" edge to the GroupMembership entity by IDs.
+func (gc *GroupCreate) AddMembershipIDs(ids ...uuid.UUID) *GroupCreate {
+	gc.mutation.AddMembershipIDs(ids...)
+	return gc
+}
+
+// AddMemberships adds the "memberships" edges to the GroupMembership entity.
+func (gc *GroupCreate) AddMemberships(g ...*GroupMembership) *GroupCreate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gc.AddMembershipIDs(ids...)
+}
+
+// AddAuthTokenIDs adds the "auth_tokens" edge to the AuthTokens entity by IDs.
+func (gc *GroupCreate) AddAuthTokenIDs(ids ...uuid.UUID) *GroupCreate {
+	gc.mutation.AddAuthTokenIDs(ids...)
+	return gc
+}
+
+// AddAuthTokens adds the "auth_tokens" edges to the AuthTokens entity.
+func (gc *GroupCreate) AddAuthTokens(a ...*AuthTokens) *GroupCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return gc.AddAuthTokenIDs(ids...)
+}
+
 // AddLocationIDs adds the "locations" edge to the Location entity by IDs.
 func (gc *GroupCreate) AddLocationIDs(ids ...uuid.UUID) *GroupCreate {
 	gc.mutation.AddLocationIDs(ids...)
@@ -195,6 +288,81 @@ func (gc *GroupCreate) AddNotifiers(n ...*Notifier) *GroupCreate {
 	return gc.AddNotifierIDs(ids...)
 }
 
+// AddAuditEntryIDs adds the "audit_entries" edge to the AuditEntry entity by IDs.
+func (gc *GroupCreate) AddAuditEntryIDs(ids ...uuid.UUID) *GroupCreate {
+	gc.mutation.AddAuditEntryIDs(ids...)
+	return gc
+}
+
+// AddAuditEntries adds the "audit_entries" edges to the AuditEntry entity.
+func (gc *GroupCreate) AddAuditEntries(a ...*AuditEntry) *GroupCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return gc.AddAuditEntryIDs(ids...)
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (gc *GroupCreate) AddSavedSearchIDs(ids ...uuid.UUID) *GroupCreate {
+	gc.mutation.AddSavedSearchIDs(ids...)
+	return gc
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (gc *GroupCreate) AddSavedSearches(s ...*SavedSearch) *GroupCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return gc.AddSavedSearchIDs(ids...)
+}
+
+// AddNotificationRuleIDs adds the "notification_rules" edge to the NotificationRule entity by IDs.
+func (gc *GroupCreate) AddNotificationRuleIDs(ids ...uuid.UUID) *GroupCreate {
+	gc.mutation.AddNotificationRuleIDs(ids...)
+	return gc
+}
+
+// AddNotificationRules adds the "notification_rules" edges to the NotificationRule entity.
+func (gc *GroupCreate) AddNotificationRules(n ...*NotificationRule) *GroupCreate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return gc.AddNotificationRuleIDs(ids...)
+}
+
+// AddNotificationReminderIDs adds the "notification_reminders" edge to the NotificationReminder entity by IDs.
+func (gc *GroupCreate) AddNotificationReminderIDs(ids ...uuid.UUID) *GroupCreate {
+	gc.mutation.AddNotificationReminderIDs(ids...)
+	return gc
+}
+
+// AddNotificationReminders adds the "notification_reminders" edges to the NotificationReminder entity.
+func (gc *GroupCreate) AddNotificationReminders(n ...*NotificationReminder) *GroupCreate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return gc.AddNotificationReminderIDs(ids...)
+}
+
+// AddNotificationTemplateIDs adds the "notification_templates" edge to the NotificationTemplate entity by IDs.
+func (gc *GroupCreate) AddNotificationTemplateIDs(ids ...uuid.UUID) *GroupCreate {
+	gc.mutation.AddNotificationTemplateIDs(ids...)
+	return gc
+}
+
+// AddNotificationTemplates adds the "notification_templates" edges to the NotificationTemplate entity.
+func (gc *GroupCreate) AddNotificationTemplates(n ...*NotificationTemplate) *GroupCreate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return gc.AddNotificationTemplateIDs(ids...)
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (gc *GroupCreate) Mutation() *GroupMutation {
 	return gc.mutation
@@ -242,6 +410,14 @@ func (gc *GroupCreate) defaults() {
 		v := group.DefaultCurrency
 		gc.mutation.SetCurrency(v)
 	}
+	if _, ok := gc.mutation.NotifyTime(); !ok {
+		v := group.DefaultNotifyTime
+		gc.mutation.SetNotifyTime(v)
+	}
+	if _, ok := gc.mutation.Digest(); !ok {
+		v := group.DefaultDigest
+		gc.mutation.SetDigest(v)
+	}
 	if _, ok := gc.mutation.ID(); !ok {
 		v := group.DefaultID()
 		gc.mutation.SetID(v)
@@ -267,6 +443,27 @@ func (gc *GroupCreate) check() error {
 	if _, ok := gc.mutation.Currency(); !ok {
 		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Group.currency"`)}
 	}
+	if v, ok := gc.mutation.Timezone(); ok {
+		if err := group.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Group.timezone": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.NotifyTime(); !ok {
+		return &ValidationError{Name: "notify_time", err: errors.New(`ent: missing required field "Group.notify_time"`)}
+	}
+	if v, ok := gc.mutation.NotifyTime(); ok {
+		if err := group.NotifyTimeValidator(v); err != nil {
+			return &ValidationError{Name: "notify_time", err: fmt.Errorf(`ent: validator failed for field "Group.notify_time": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.Digest(); !ok {
+		return &ValidationError{Name: "digest", err: errors.New(`ent: missing required field "Group.digest"`)}
+	}
+	if v, ok := gc.mutation.Digest(); ok {
+		if err := group.DigestValidator(v); err != nil {
+			return &ValidationError{Name: "digest", err: fmt.Errorf(`ent: validator failed for field "Group.digest": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -318,6 +515,22 @@ func (gc *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		_spec.SetField(group.FieldCurrency, field.TypeString, value)
 		_node.Currency = value
 	}
+	if value, ok := gc.mutation.Timezone(); ok {
+		_spec.SetField(group.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := gc.mutation.NotifyTime(); ok {
+		_spec.SetField(group.FieldNotifyTime, field.TypeString, value)
+		_node.NotifyTime = value
+	}
+	if value, ok := gc.mutation.Digest(); ok {
+		_spec.SetField(group.FieldDigest, field.TypeEnum, value)
+		_node.Digest = value
+	}
+	if value, ok := gc.mutation.NotifiedOn(); ok {
+		_spec.SetField(group.FieldNotifiedOn, field.TypeTime, value)
+		_node.NotifiedOn = &value
+	}
 	if nodes := gc.mutation.UsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -334,6 +547,38 @@ func (gc *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := gc.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.MembershipsTable,
+			Columns: []string{group.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.AuthTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuthTokensTable,
+			Columns: []string{group.AuthTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := gc.mutation.LocationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -430,6 +675,86 @@ func (gc *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := gc.mutation.AuditEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuditEntriesTable,
+			Columns: []string{group.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.NotificationRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationRulesTable,
+			Columns: []string{group.NotificationRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.NotificationRemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationRemindersTable,
+			Columns: []string{group.NotificationRemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.NotificationTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationTemplatesTable,
+			Columns: []string{group.NotificationTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -12,31 +12,45 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/auditentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/document"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/groupinvitationtoken"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationreminder"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationrule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationtemplate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
 )
 
 // GroupQuery is the builder for querying Group entities.
 type GroupQuery struct {
 	config
-	ctx                  *QueryContext
-	order                []group.OrderOption
-	inters               []Interceptor
-	predicates           []predicate.Group
-	withUsers            *UserQuery
-	withLocations        *LocationQuery
-	withItems            *ItemQuery
-	withLabels           *LabelQuery
-	withDocuments        *DocumentQuery
-	withInvitationTokens *GroupInvitationTokenQuery
-	withNotifiers        *NotifierQuery
+	ctx                       *QueryContext
+	order                     []group.OrderOption
+	inters                    []Interceptor
+	predicates                []predicate.Group
+	withUsers                 *UserQuery
+	withMemberships           *GroupMembershipQuery
+	withAuthTokens            *AuthTokensQuery
+	withLocations             *LocationQuery
+	withItems                 *ItemQuery
+	withLabels                *LabelQuery
+	withDocuments             *DocumentQuery
+	withInvitationTokens      *GroupInvitationTokenQuery
+	withNotifiers             *NotifierQuery
+	withAuditEntries          *AuditEntryQuery
+	withSavedSearches         *SavedSearchQuery
+	withNotificationRules     *NotificationRuleQuery
+	withNotificationReminders *NotificationReminderQuery
+	withNotificationTemplates *NotificationTemplateQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -95,6 +109,50 @@ func (gq *GroupQuery) QueryUsers() *UserQuery {
 	return query
 }
 
+// QueryMemberships chains the current query on the "memberships" edge.
+func (gq *GroupQuery) QueryMemberships() *GroupMembershipQuery {
+	query := (&GroupMembershipClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(groupmembership.Table, groupmembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.MembershipsTable, group.MembershipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAuthTokens chains the current query on the "auth_tokens" edge.
+func (gq *GroupQuery) QueryAuthTokens() *AuthTokensQuery {
+	query := (&AuthTokensClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(authtokens.Table, authtokens.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.AuthTokensTable, group.AuthTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryLocations chains the current query on the "locations" edge.
 func (gq *GroupQuery) QueryLocations() *LocationQuery {
 	query := (&LocationClient{config: gq.config}).Query()
@@ -227,6 +285,116 @@ func (gq *GroupQuery) QueryNotifiers() *NotifierQuery {
 	return query
 }
 
+// QueryAuditEntries chains the current query on the "audit_entries" edge.
+func (gq *GroupQuery) QueryAuditEntries() *AuditEntryQuery {
+	query := (&AuditEntryClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(auditentry.Table, auditentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.AuditEntriesTable, group.AuditEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySavedSearches chains the current query on the "saved_searches" edge.
+func (gq *GroupQuery) QuerySavedSearches() *SavedSearchQuery {
+	query := (&SavedSearchClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(savedsearch.Table, savedsearch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.SavedSearchesTable, group.SavedSearchesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotificationRules chains the current query on the "notification_rules" edge.
+func (gq *GroupQuery) QueryNotificationRules() *NotificationRuleQuery {
+	query := (&NotificationRuleClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(notificationrule.Table, notificationrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.NotificationRulesTable, group.NotificationRulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotificationReminders chains the current query on the "notification_reminders" edge.
+func (gq *GroupQuery) QueryNotificationReminders() *NotificationReminderQuery {
+	query := (&NotificationReminderClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(notificationreminder.Table, notificationreminder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.NotificationRemindersTable, group.NotificationRemindersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotificationTemplates chains the current query on the "notification_templates" edge.
+func (gq *GroupQuery) QueryNotificationTemplates() *NotificationTemplateQuery {
+	query := (&NotificationTemplateClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, selector),
+			sqlgraph.To(notificationtemplate.Table, notificationtemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.NotificationTemplatesTable, group.NotificationTemplatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Group entity from the query.
 // Returns a *NotFoundError when no Group was found.
 func (gq *GroupQuery) First(ctx context.Context) (*Group, error) {
@@ -414,18 +582,25 @@ func (gq *GroupQuery) Clone() *GroupQuery {
 		return nil
 	}
 	return &GroupQuery{
-		config:               gq.config,
-		ctx:                  gq.ctx.Clone(),
-		order:                append([]group.OrderOption{}, gq.order...),
-		inters:               append([]Interceptor{}, gq.inters...),
-		predicates:           append([]predicate.Group{}, gq.predicates...),
-		withUsers:            gq.withUsers.Clone(),
-		withLocations:        gq.withLocations.Clone(),
-		withItems:            gq.withItems.Clone(),
-		withLabels:           gq.withLabels.Clone(),
-		withDocuments:        gq.withDocuments.Clone(),
-		withInvitationTokens: gq.withInvitationTokens.Clone(),
-		withNotifiers:        gq.withNotifiers.Clone(),
+		config:                    gq.config,
+		ctx:                       gq.ctx.Clone(),
+		order:                     append([]group.OrderOption{}, gq.order...),
+		inters:                    append([]Interceptor{}, gq.inters...),
+		predicates:                append([]predicate.Group{}, gq.predicates...),
+		withUsers:                 gq.withUsers.Clone(),
+		withMemberships:           gq.withMemberships.Clone(),
+		withAuthTokens:            gq.withAuthTokens.Clone(),
+		withLocations:             gq.withLocations.Clone(),
+		withItems:                 gq.withItems.Clone(),
+		withLabels:                gq.withLabels.Clone(),
+		withDocuments:             gq.withDocuments.Clone(),
+		withInvitationTokens:      gq.withInvitationTokens.Clone(),
+		withNotifiers:             gq.withNotifiers.Clone(),
+		withAuditEntries:          gq.withAuditEntries.Clone(),
+		withSavedSearches:         gq.withSavedSearches.Clone(),
+		withNotificationRules:     gq.withNotificationRules.Clone(),
+		withNotificationReminders: gq.withNotificationReminders.Clone(),
+		withNotificationTemplates: gq.withNotificationTemplates.Clone(),
 		// clone intermediate query.
 		sql:  gq.sql.Clone(),
 		path: gq.path,
@@ -443,6 +618,28 @@ func (gq *GroupQuery) WithUsers(opts ...func(*UserQuery)) *GroupQuery {
 	return gq
 }
 
+// WithMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "memberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GroupQuery) WithMemberships(opts ...func(*GroupMembershipQuery)) *GroupQuery {
+	query := (&GroupMembershipClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withMemberships = query
+	return gq
+}
+
+// WithAuthTokens tells the query-builder to eager-load the nodes that are connected to
+// the "auth_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GroupQuery) WithAuthTokens(opts ...func(*AuthTokensQuery)) *GroupQuery {
+	query := (&AuthTokensClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withAuthTokens = query
+	return gq
+}
+
 // WithLocations tells the query-builder to eager-load the nodes that are connected to
 // the "locations" edge. The optional arguments are used to configure the query builder of the edge.
 func (gq *GroupQuery) WithLocations(opts ...func(*LocationQuery)) *GroupQuery {
@@ -509,6 +706,61 @@ func (gq *GroupQuery) WithNotifiers(opts ...func(*NotifierQuery)) *GroupQuery {
 	return gq
 }
 
+// WithAuditEntries tells the query-builder to eager-load the nodes that are connected to
+// the "audit_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GroupQuery) WithAuditEntries(opts ...func(*AuditEntryQuery)) *GroupQuery {
+	query := (&AuditEntryClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withAuditEntries = query
+	return gq
+}
+
+// WithSavedSearches tells the query-builder to eager-load the nodes that are connected to
+// the "saved_searches" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GroupQuery) WithSavedSearches(opts ...func(*SavedSearchQuery)) *GroupQuery {
+	query := (&SavedSearchClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withSavedSearches = query
+	return gq
+}
+
+// WithNotificationRules tells the query-builder to eager-load the nodes that are connected to
+// the "notification_rules" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GroupQuery) WithNotificationRules(opts ...func(*NotificationRuleQuery)) *GroupQuery {
+	query := (&NotificationRuleClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withNotificationRules = query
+	return gq
+}
+
+// WithNotificationReminders tells the query-builder to eager-load the nodes that are connected to
+// the "notification_reminders" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GroupQuery) WithNotificationReminders(opts ...func(*NotificationReminderQuery)) *GroupQuery {
+	query := (&NotificationReminderClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withNotificationReminders = query
+	return gq
+}
+
+// WithNotificationTemplates tells the query-builder to eager-load the nodes that are connected to
+// the "notification_templates" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GroupQuery) WithNotificationTemplates(opts ...func(*NotificationTemplateQuery)) *GroupQuery {
+	query := (&NotificationTemplateClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withNotificationTemplates = query
+	return gq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -587,14 +839,21 @@ func (gq *GroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Group,
 	var (
 		nodes       = []*Group{}
 		_spec       = gq.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [14]bool{
 			gq.withUsers != nil,
+			gq.withMemberships != nil,
+			gq.withAuthTokens != nil,
 			gq.withLocations != nil,
 			gq.withItems != nil,
 			gq.withLabels != nil,
 			gq.withDocuments != nil,
 			gq.withInvitationTokens != nil,
 			gq.withNotifiers != nil,
+			gq.withAuditEntries != nil,
+			gq.withSavedSearches != nil,
+			gq.withNotificationRules != nil,
+			gq.withNotificationReminders != nil,
+			gq.withNotificationTemplates != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -622,6 +881,20 @@ func (gq *GroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Group,
 			return nil, err
 		}
 	}
+	if query := gq.withMemberships; query != nil {
+		if err := gq.loadMemberships(ctx, query, nodes,
+			func(n *Group) { n.Edges.Memberships = []*GroupMembership{} },
+			func(n *Group, e *GroupMembership) { n.Edges.Memberships = append(n.Edges.Memberships, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withAuthTokens; query != nil {
+		if err := gq.loadAuthTokens(ctx, query, nodes,
+			func(n *Group) { n.Edges.AuthTokens = []*AuthTokens{} },
+			func(n *Group, e *AuthTokens) { n.Edges.AuthTokens = append(n.Edges.AuthTokens, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := gq.withLocations; query != nil {
 		if err := gq.loadLocations(ctx, query, nodes,
 			func(n *Group) { n.Edges.Locations = []*Location{} },
@@ -666,6 +939,45 @@ func (gq *GroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Group,
 			return nil, err
 		}
 	}
+	if query := gq.withAuditEntries; query != nil {
+		if err := gq.loadAuditEntries(ctx, query, nodes,
+			func(n *Group) { n.Edges.AuditEntries = []*AuditEntry{} },
+			func(n *Group, e *AuditEntry) { n.Edges.AuditEntries = append(n.Edges.AuditEntries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withSavedSearches; query != nil {
+		if err := gq.loadSavedSearches(ctx, query, nodes,
+			func(n *Group) { n.Edges.SavedSearches = []*SavedSearch{} },
+			func(n *Group, e *SavedSearch) { n.Edges.SavedSearches = append(n.Edges.SavedSearches, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withNotificationRules; query != nil {
+		if err := gq.loadNotificationRules(ctx, query, nodes,
+			func(n *Group) { n.Edges.NotificationRules = []*NotificationRule{} },
+			func(n *Group, e *NotificationRule) { n.Edges.NotificationRules = append(n.Edges.NotificationRules, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withNotificationReminders; query != nil {
+		if err := gq.loadNotificationReminders(ctx, query, nodes,
+			func(n *Group) { n.Edges.NotificationReminders = []*NotificationReminder{} },
+			func(n *Group, e *NotificationReminder) {
+				n.Edges.NotificationReminders = append(n.Edges.NotificationReminders, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withNotificationTemplates; query != nil {
+		if err := gq.loadNotificationTemplates(ctx, query, nodes,
+			func(n *Group) { n.Edges.NotificationTemplates = []*NotificationTemplate{} },
+			func(n *Group, e *NotificationTemplate) {
+				n.Edges.NotificationTemplates = append(n.Edges.NotificationTemplates, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -700,6 +1012,70 @@ func (gq *GroupQuery) loadUsers(ctx context.Context, query *UserQuery, nodes []*
 	}
 	return nil
 }
+func (gq *GroupQuery) loadMemberships(ctx context.Context, query *GroupMembershipQuery, nodes []*Group, init func(*Group), assign func(*Group, *GroupMembership)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(groupmembership.FieldGroupID)
+	}
+	query.Where(predicate.GroupMembership(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.MembershipsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GroupQuery) loadAuthTokens(ctx context.Context, query *AuthTokensQuery, nodes []*Group, init func(*Group), assign func(*Group, *AuthTokens)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(authtokens.FieldGroupID)
+	}
+	query.Where(predicate.AuthTokens(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.AuthTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "group_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (gq *GroupQuery) loadLocations(ctx context.Context, query *LocationQuery, nodes []*Group, init func(*Group), assign func(*Group, *Location)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Group)
@@ -885,6 +1261,157 @@ func (gq *GroupQuery) loadNotifiers(ctx context.Context, query *NotifierQuery, n
 	}
 	return nil
 }
+func (gq *GroupQuery) loadAuditEntries(ctx context.Context, query *AuditEntryQuery, nodes []*Group, init func(*Group), assign func(*Group, *AuditEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(auditentry.FieldGroupID)
+	}
+	query.Where(predicate.AuditEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.AuditEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GroupQuery) loadSavedSearches(ctx context.Context, query *SavedSearchQuery, nodes []*Group, init func(*Group), assign func(*Group, *SavedSearch)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SavedSearch(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.SavedSearchesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.group_saved_searches
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "group_saved_searches" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_saved_searches" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GroupQuery) loadNotificationRules(ctx context.Context, query *NotificationRuleQuery, nodes []*Group, init func(*Group), assign func(*Group, *NotificationRule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notificationrule.FieldGroupID)
+	}
+	query.Where(predicate.NotificationRule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.NotificationRulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GroupQuery) loadNotificationReminders(ctx context.Context, query *NotificationReminderQuery, nodes []*Group, init func(*Group), assign func(*Group, *NotificationReminder)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notificationreminder.FieldGroupID)
+	}
+	query.Where(predicate.NotificationReminder(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.NotificationRemindersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GroupQuery) loadNotificationTemplates(ctx context.Context, query *NotificationTemplateQuery, nodes []*Group, init func(*Group), assign func(*Group, *NotificationTemplate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Group)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notificationtemplate.FieldGroupID)
+	}
+	query.Where(predicate.NotificationTemplate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(group.NotificationTemplatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (gq *GroupQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := gq.querySpec()
@@ -12,14 +12,21 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/auditentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/document"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/groupinvitationtoken"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationreminder"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationrule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationtemplate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
 )
 
@@ -70,6 +77,74 @@ func (gu *GroupUpdate) SetNillableCurrency(s *string) *GroupUpdate {
 	return gu
 }
 
+// SetTimezone sets the "timezone" field.
+func (gu *GroupUpdate) SetTimezone(s string) *GroupUpdate {
+	gu.mutation.SetTimezone(s)
+	return gu
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableTimezone(s *string) *GroupUpdate {
+	if s != nil {
+		gu.SetTimezone(*s)
+	}
+	return gu
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (gu *GroupUpdate) ClearTimezone() *GroupUpdate {
+	gu.mutation.ClearTimezone()
+	return gu
+}
+
+// SetNotifyTime sets the "notify_time" field.
+func (gu *GroupUpdate) SetNotifyTime(s string) *GroupUpdate {
+	gu.mutation.SetNotifyTime(s)
+	return gu
+}
+
+// SetNillableNotifyTime sets the "notify_time" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableNotifyTime(s *string) *GroupUpdate {
+	if s != nil {
+		gu.SetNotifyTime(*s)
+	}
+	return gu
+}
+
+// SetDigest sets the "digest" field.
+func (gu *GroupUpdate) SetDigest(gr group.Digest) *GroupUpdate {
+	gu.mutation.SetDigest(gr)
+	return gu
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableDigest(gr *group.Digest) *GroupUpdate {
+	if gr != nil {
+		gu.SetDigest(*gr)
+	}
+	return gu
+}
+
+// SetNotifiedOn sets the "notified_on" field.
+func (gu *GroupUpdate) SetNotifiedOn(t time.Time) *GroupUpdate {
+	gu.mutation.SetNotifiedOn(t)
+	return gu
+}
+
+// SetNillableNotifiedOn sets the "notified_on" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableNotifiedOn(t *time.Time) *GroupUpdate {
+	if t != nil {
+		gu.SetNotifiedOn(*t)
+	}
+	return gu
+}
+
+// ClearNotifiedOn clears the value of the "notified_on" field.
+func (gu *GroupUpdate) ClearNotifiedOn() *GroupUpdate {
+	gu.mutation.ClearNotifiedOn()
+	return gu
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (gu *GroupUpdate) AddUserIDs(ids ...uuid.UUID) *GroupUpdate {
 	gu.mutation.AddUserIDs(ids...)
@@ -85,6 +160,36 @@ func (gu *GroupUpdate) AddUsers(u ...*User) *GroupUpdate {
 	return gu.AddUserIDs(ids...)
 }
 
+// AddMembershipIDs adds the "memberships" edge to the GroupMembership entity by IDs.
+func (gu *GroupUpdate) AddMembershipIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.AddMembershipIDs(ids...)
+	return gu
+}
+
+// AddMemberships adds the "memberships" edges to the GroupMembership entity.
+func (gu *GroupUpdate) AddMemberships(g ...*GroupMembership) *GroupUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gu.AddMembershipIDs(ids...)
+}
+
+// AddAuthTokenIDs adds the "auth_tokens" edge to the AuthTokens entity by IDs.
+func (gu *GroupUpdate) AddAuthTokenIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.AddAuthTokenIDs(ids...)
+	return gu
+}
+
+// AddAuthTokens adds the "auth_tokens" edges to the AuthTokens entity.
+func (gu *GroupUpdate) AddAuthTokens(a ...*AuthTokens) *GroupUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return gu.AddAuthTokenIDs(ids...)
+}
+
 // AddLocationIDs adds the "locations" edge to the Location entity by IDs.
 func (gu *GroupUpdate) AddLocationIDs(ids ...uuid.UUID) *GroupUpdate {
 	gu.mutation.AddLocationIDs(ids...)
@@ -175,6 +280,81 @@ func (gu *GroupUpdate) AddNotifiers(n ...*Notifier) *GroupUpdate {
 	return gu.AddNotifierIDs(ids...)
 }
 
+// AddAuditEntryIDs adds the "audit_entries" edge to the AuditEntry entity by IDs.
+func (gu *GroupUpdate) AddAuditEntryIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.AddAuditEntryIDs(ids...)
+	return gu
+}
+
+// AddAuditEntries adds the "audit_entries" edges to the AuditEntry entity.
+func (gu *GroupUpdate) AddAuditEntries(a ...*AuditEntry) *GroupUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return gu.AddAuditEntryIDs(ids...)
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (gu *GroupUpdate) AddSavedSearchIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.AddSavedSearchIDs(ids...)
+	return gu
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (gu *GroupUpdate) AddSavedSearches(s ...*SavedSearch) *GroupUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return gu.AddSavedSearchIDs(ids...)
+}
+
+// AddNotificationRuleIDs adds the "notification_rules" edge to the NotificationRule entity by IDs.
+func (gu *GroupUpdate) AddNotificationRuleIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.AddNotificationRuleIDs(ids...)
+	return gu
+}
+
+// AddNotificationRules adds the "notification_rules" edges to the NotificationRule entity.
+func (gu *GroupUpdate) AddNotificationRules(n ...*NotificationRule) *GroupUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return gu.AddNotificationRuleIDs(ids...)
+}
+
+// AddNotificationReminderIDs adds the "notification_reminders" edge to the NotificationReminder entity by IDs.
+func (gu *GroupUpdate) AddNotificationReminderIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.AddNotificationReminderIDs(ids...)
+	return gu
+}
+
+// AddNotificationReminders adds the "notification_reminders" edges to the NotificationReminder entity.
+func (gu *GroupUpdate) AddNotificationReminders(n ...*NotificationReminder) *GroupUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return gu.AddNotificationReminderIDs(ids...)
+}
+
+// AddNotificationTemplateIDs adds the "notification_templates" edge to the NotificationTemplate entity by IDs.
+func (gu *GroupUpdate) AddNotificationTemplateIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.AddNotificationTemplateIDs(ids...)
+	return gu
+}
+
+// AddNotificationTemplates adds the "notification_templates" edges to the NotificationTemplate entity.
+func (gu *GroupUpdate) AddNotificationTemplates(n ...*NotificationTemplate) *GroupUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return gu.AddNotificationTemplateIDs(ids...)
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (gu *GroupUpdate) Mutation() *GroupMutation {
 	return gu.mutation
@@ -201,6 +381,48 @@ func (gu *GroupUpdate) RemoveUsers(u ...*User) *GroupUpdate {
 	return gu.RemoveUserIDs(ids...)
 }
 
+// ClearMemberships clears all "memberships" edges to the GroupMembership entity.
+func (gu *GroupUpdate) ClearMemberships() *GroupUpdate {
+	gu.mutation.ClearMemberships()
+	return gu
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to GroupMembership entities by IDs.
+func (gu *GroupUpdate) RemoveMembershipIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.RemoveMembershipIDs(ids...)
+	return gu
+}
+
+// RemoveMemberships removes "memberships" edges to GroupMembership entities.
+func (gu *GroupUpdate) RemoveMemberships(g ...*GroupMembership) *GroupUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gu.RemoveMembershipIDs(ids...)
+}
+
+// ClearAuthTokens clears all "auth_tokens" edges to the AuthTokens entity.
+func (gu *GroupUpdate) ClearAuthTokens() *GroupUpdate {
+	gu.mutation.ClearAuthTokens()
+	return gu
+}
+
+// RemoveAuthTokenIDs removes the "auth_tokens" edge to AuthTokens entities by IDs.
+func (gu *GroupUpdate) RemoveAuthTokenIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.RemoveAuthTokenIDs(ids...)
+	return gu
+}
+
+// RemoveAuthTokens removes "auth_tokens" edges to AuthTokens entities.
+func (gu *GroupUpdate) RemoveAuthTokens(a ...*AuthTokens) *GroupUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return gu.RemoveAuthTokenIDs(ids...)
+}
+
 // ClearLocations clears all "locations" edges to the Location entity.
 func (gu *GroupUpdate) ClearLocations() *GroupUpdate {
 	gu.mutation.ClearLocations()
@@ -327,6 +549,111 @@ func (gu *GroupUpdate) RemoveNotifiers(n ...*Notifier) *GroupUpdate {
 	return gu.RemoveNotifierIDs(ids...)
 }
 
+// ClearAuditEntries clears all "audit_entries" edges to the AuditEntry entity.
+func (gu *GroupUpdate) ClearAuditEntries() *GroupUpdate {
+	gu.mutation.ClearAuditEntries()
+	return gu
+}
+
+// RemoveAuditEntryIDs removes the "audit_entries" edge to AuditEntry entities by IDs.
+func (gu *GroupUpdate) RemoveAuditEntryIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.RemoveAuditEntryIDs(ids...)
+	return gu
+}
+
+// RemoveAuditEntries removes "audit_entries" edges to AuditEntry entities.
+func (gu *GroupUpdate) RemoveAuditEntries(a ...*AuditEntry) *GroupUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return gu.RemoveAuditEntryIDs(ids...)
+}
+
+// ClearSavedSearches clears all "saved_searches" edges to the SavedSearch entity.
+func (gu *GroupUpdate) ClearSavedSearches() *GroupUpdate {
+	gu.mutation.ClearSavedSearches()
+	return gu
+}
+
+// RemoveSavedSearchIDs removes the "saved_searches" edge to SavedSearch entities by IDs.
+func (gu *GroupUpdate) RemoveSavedSearchIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.RemoveSavedSearchIDs(ids...)
+	return gu
+}
+
+// RemoveSavedSearches removes "saved_searches" edges to SavedSearch entities.
+func (gu *GroupUpdate) RemoveSavedSearches(s ...*SavedSearch) *GroupUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return gu.RemoveSavedSearchIDs(ids...)
+}
+
+// ClearNotificationRules clears all "notification_rules" edges to the NotificationRule entity.
+func (gu *GroupUpdate) ClearNotificationRules() *GroupUpdate {
+	gu.mutation.ClearNotificationRules()
+	return gu
+}
+
+// RemoveNotificationRuleIDs removes the "notification_rules" edge to NotificationRule entities by IDs.
+func (gu *GroupUpdate) RemoveNotificationRuleIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.RemoveNotificationRuleIDs(ids...)
+	return gu
+}
+
+// RemoveNotificationRules removes "notification_rules" edges to NotificationRule entities.
+func (gu *GroupUpdate) RemoveNotificationRules(n ...*NotificationRule) *GroupUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return gu.RemoveNotificationRuleIDs(ids...)
+}
+
+// ClearNotificationReminders clears all "notification_reminders" edges to the NotificationReminder entity.
+func (gu *GroupUpdate) ClearNotificationReminders() *GroupUpdate {
+	gu.mutation.ClearNotificationReminders()
+	return gu
+}
+
+// RemoveNotificationReminderIDs removes the "notification_reminders" edge to NotificationReminder entities by IDs.
+func (gu *GroupUpdate) RemoveNotificationReminderIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.RemoveNotificationReminderIDs(ids...)
+	return gu
+}
+
+// RemoveNotificationReminders removes "notification_reminders" edges to NotificationReminder entities.
+func (gu *GroupUpdate) RemoveNotificationReminders(n ...*NotificationReminder) *GroupUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return gu.RemoveNotificationReminderIDs(ids...)
+}
+
+// ClearNotificationTemplates clears all "notification_templates" edges to the NotificationTemplate entity.
+func (gu *GroupUpdate) ClearNotificationTemplates() *GroupUpdate {
+	gu.mutation.ClearNotificationTemplates()
+	return gu
+}
+
+// RemoveNotificationTemplateIDs removes the "notification_templates" edge to NotificationTemplate entities by IDs.
+func (gu *GroupUpdate) RemoveNotificationTemplateIDs(ids ...uuid.UUID) *GroupUpdate {
+	gu.mutation.RemoveNotificationTemplateIDs(ids...)
+	return gu
+}
+
+// RemoveNotificationTemplates removes "notification_templates" edges to NotificationTemplate entities.
+func (gu *GroupUpdate) RemoveNotificationTemplates(n ...*NotificationTemplate) *GroupUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return gu.RemoveNotificationTemplateIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (gu *GroupUpdate) Save(ctx context.Context) (int, error) {
 	gu.defaults()
@@ -370,6 +697,21 @@ func (gu *GroupUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
 		}
 	}
+	if v, ok := gu.mutation.Timezone(); ok {
+		if err := group.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Group.timezone": %w`, err)}
+		}
+	}
+	if v, ok := gu.mutation.NotifyTime(); ok {
+		if err := group.NotifyTimeValidator(v); err != nil {
+			return &ValidationError{Name: "notify_time", err: fmt.Errorf(`ent: validator failed for field "Group.notify_time": %w`, err)}
+		}
+	}
+	if v, ok := gu.mutation.Digest(); ok {
+		if err := group.DigestValidator(v); err != nil {
+			return &ValidationError{Name: "digest", err: fmt.Errorf(`ent: validator failed for field "Group.digest": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -394,6 +736,24 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := gu.mutation.Currency(); ok {
 		_spec.SetField(group.FieldCurrency, field.TypeString, value)
 	}
+	if value, ok := gu.mutation.Timezone(); ok {
+		_spec.SetField(group.FieldTimezone, field.TypeString, value)
+	}
+	if gu.mutation.TimezoneCleared() {
+		_spec.ClearField(group.FieldTimezone, field.TypeString)
+	}
+	if value, ok := gu.mutation.NotifyTime(); ok {
+		_spec.SetField(group.FieldNotifyTime, field.TypeString, value)
+	}
+	if value, ok := gu.mutation.Digest(); ok {
+		_spec.SetField(group.FieldDigest, field.TypeEnum, value)
+	}
+	if value, ok := gu.mutation.NotifiedOn(); ok {
+		_spec.SetField(group.FieldNotifiedOn, field.TypeTime, value)
+	}
+	if gu.mutation.NotifiedOnCleared() {
+		_spec.ClearField(group.FieldNotifiedOn, field.TypeTime)
+	}
 	if gu.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -439,6 +799,96 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if gu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.MembershipsTable,
+			Columns: []string{group.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !gu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.MembershipsTable,
+			Columns: []string{group.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.MembershipsTable,
+			Columns: []string{group.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.AuthTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuthTokensTable,
+			Columns: []string{group.AuthTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedAuthTokensIDs(); len(nodes) > 0 && !gu.mutation.AuthTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuthTokensTable,
+			Columns: []string{group.AuthTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.AuthTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuthTokensTable,
+			Columns: []string{group.AuthTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if gu.mutation.LocationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -709,13 +1159,238 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{group.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if gu.mutation.AuditEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuditEntriesTable,
+			Columns: []string{group.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedAuditEntriesIDs(); len(nodes) > 0 && !gu.mutation.AuditEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuditEntriesTable,
+			Columns: []string{group.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.AuditEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuditEntriesTable,
+			Columns: []string{group.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedSavedSearchesIDs(); len(nodes) > 0 && !gu.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.NotificationRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationRulesTable,
+			Columns: []string{group.NotificationRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedNotificationRulesIDs(); len(nodes) > 0 && !gu.mutation.NotificationRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationRulesTable,
+			Columns: []string{group.NotificationRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.NotificationRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationRulesTable,
+			Columns: []string{group.NotificationRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.NotificationRemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationRemindersTable,
+			Columns: []string{group.NotificationRemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedNotificationRemindersIDs(); len(nodes) > 0 && !gu.mutation.NotificationRemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationRemindersTable,
+			Columns: []string{group.NotificationRemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.NotificationRemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationRemindersTable,
+			Columns: []string{group.NotificationRemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.NotificationTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationTemplatesTable,
+			Columns: []string{group.NotificationTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedNotificationTemplatesIDs(); len(nodes) > 0 && !gu.mutation.NotificationTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationTemplatesTable,
+			Columns: []string{group.NotificationTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.NotificationTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationTemplatesTable,
+			Columns: []string{group.NotificationTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{group.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
 	}
 	gu.mutation.done = true
 	return n, nil
@@ -763,6 +1438,74 @@ func (guo *GroupUpdateOne) SetNillableCurrency(s *string) *GroupUpdateOne {
 	return guo
 }
 
+// SetTimezone sets the "timezone" field.
+func (guo *GroupUpdateOne) SetTimezone(s string) *GroupUpdateOne {
+	guo.mutation.SetTimezone(s)
+	return guo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableTimezone(s *string) *GroupUpdateOne {
+	if s != nil {
+		guo.SetTimezone(*s)
+	}
+	return guo
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (guo *GroupUpdateOne) ClearTimezone() *GroupUpdateOne {
+	guo.mutation.ClearTimezone()
+	return guo
+}
+
+// SetNotifyTime sets the "notify_time" field.
+func (guo *GroupUpdateOne) SetNotifyTime(s string) *GroupUpdateOne {
+	guo.mutation.SetNotifyTime(s)
+	return guo
+}
+
+// SetNillableNotifyTime sets the "notify_time" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableNotifyTime(s *string) *GroupUpdateOne {
+	if s != nil {
+		guo.SetNotifyTime(*s)
+	}
+	return guo
+}
+
+// SetDigest sets the "digest" field.
+func (guo *GroupUpdateOne) SetDigest(gr group.Digest) *GroupUpdateOne {
+	guo.mutation.SetDigest(gr)
+	return guo
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableDigest(gr *group.Digest) *GroupUpdateOne {
+	if gr != nil {
+		guo.SetDigest(*gr)
+	}
+	return guo
+}
+
+// SetNotifiedOn sets the "notified_on" field.
+func (guo *GroupUpdateOne) SetNotifiedOn(t time.Time) *GroupUpdateOne {
+	guo.mutation.SetNotifiedOn(t)
+	return guo
+}
+
+// SetNillableNotifiedOn sets the "notified_on" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableNotifiedOn(t *time.Time) *GroupUpdateOne {
+	if t != nil {
+		guo.SetNotifiedOn(*t)
+	}
+	return guo
+}
+
+// ClearNotifiedOn clears the value of the "notified_on" field.
+func (guo *GroupUpdateOne) ClearNotifiedOn() *GroupUpdateOne {
+	guo.mutation.ClearNotifiedOn()
+	return guo
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (guo *GroupUpdateOne) AddUserIDs(ids ...uuid.UUID) *GroupUpdateOne {
 	guo.mutation.AddUserIDs(ids...)
@@ -778,6 +1521,36 @@ func (guo *GroupUpdateOne) AddUsers(u ...*User) *GroupUpdateOne {
 	return guo.AddUserIDs(ids...)
 }
 
+// AddMembershipIDs adds the "memberships" edge to the GroupMembership entity by IDs.
+func (guo *GroupUpdateOne) AddMembershipIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.AddMembershipIDs(ids...)
+	return guo
+}
+
+// AddMemberships adds the "memberships" edges to the GroupMembership entity.
+func (guo *GroupUpdateOne) AddMemberships(g ...*GroupMembership) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return guo.AddMembershipIDs(ids...)
+}
+
+// AddAuthTokenIDs adds the "auth_tokens" edge to the AuthTokens entity by IDs.
+func (guo *GroupUpdateOne) AddAuthTokenIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.AddAuthTokenIDs(ids...)
+	return guo
+}
+
+// AddAuthTokens adds the "auth_tokens" edges to the AuthTokens entity.
+func (guo *GroupUpdateOne) AddAuthTokens(a ...*AuthTokens) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return guo.AddAuthTokenIDs(ids...)
+}
+
 // AddLocationIDs adds the "locations" edge to the Location entity by IDs.
 func (guo *GroupUpdateOne) AddLocationIDs(ids ...uuid.UUID) *GroupUpdateOne {
 	guo.mutation.AddLocationIDs(ids...)
@@ -868,6 +1641,81 @@ func (guo *GroupUpdateOne) AddNotifiers(n ...*Notifier) *GroupUpdateOne {
 	return guo.AddNotifierIDs(ids...)
 }
 
+// AddAuditEntryIDs adds the "audit_entries" edge to the AuditEntry entity by IDs.
+func (guo *GroupUpdateOne) AddAuditEntryIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.AddAuditEntryIDs(ids...)
+	return guo
+}
+
+// AddAuditEntries adds the "audit_entries" edges to the AuditEntry entity.
+func (guo *GroupUpdateOne) AddAuditEntries(a ...*AuditEntry) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return guo.AddAuditEntryIDs(ids...)
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (guo *GroupUpdateOne) AddSavedSearchIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.AddSavedSearchIDs(ids...)
+	return guo
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (guo *GroupUpdateOne) AddSavedSearches(s ...*SavedSearch) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return guo.AddSavedSearchIDs(ids...)
+}
+
+// AddNotificationRuleIDs adds the "notification_rules" edge to the NotificationRule entity by IDs.
+func (guo *GroupUpdateOne) AddNotificationRuleIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.AddNotificationRuleIDs(ids...)
+	return guo
+}
+
+// AddNotificationRules adds the "notification_rules" edges to the NotificationRule entity.
+func (guo *GroupUpdateOne) AddNotificationRules(n ...*NotificationRule) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return guo.AddNotificationRuleIDs(ids...)
+}
+
+// AddNotificationReminderIDs adds the "notification_reminders" edge to the NotificationReminder entity by IDs.
+func (guo *GroupUpdateOne) AddNotificationReminderIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.AddNotificationReminderIDs(ids...)
+	return guo
+}
+
+// AddNotificationReminders adds the "notification_reminders" edges to the NotificationReminder entity.
+func (guo *GroupUpdateOne) AddNotificationReminders(n ...*NotificationReminder) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return guo.AddNotificationReminderIDs(ids...)
+}
+
+// AddNotificationTemplateIDs adds the "notification_templates" edge to the NotificationTemplate entity by IDs.
+func (guo *GroupUpdateOne) AddNotificationTemplateIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.AddNotificationTemplateIDs(ids...)
+	return guo
+}
+
+// AddNotificationTemplates adds the "notification_templates" edges to the NotificationTemplate entity.
+func (guo *GroupUpdateOne) AddNotificationTemplates(n ...*NotificationTemplate) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return guo.AddNotificationTemplateIDs(ids...)
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (guo *GroupUpdateOne) Mutation() *GroupMutation {
 	return guo.mutation
@@ -894,6 +1742,48 @@ func (guo *GroupUpdateOne) RemoveUsers(u ...*User) *GroupUpdateOne {
 	return guo.RemoveUserIDs(ids...)
 }
 
+// ClearMemberships clears all "memberships" edges to the GroupMembership entity.
+func (guo *GroupUpdateOne) ClearMemberships() *GroupUpdateOne {
+	guo.mutation.ClearMemberships()
+	return guo
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to GroupMembership entities by IDs.
+func (guo *GroupUpdateOne) RemoveMembershipIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.RemoveMembershipIDs(ids...)
+	return guo
+}
+
+// RemoveMemberships removes "memberships" edges to GroupMembership entities.
+func (guo *GroupUpdateOne) RemoveMemberships(g ...*GroupMembership) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return guo.RemoveMembershipIDs(ids...)
+}
+
+// ClearAuthTokens clears all "auth_tokens" edges to the AuthTokens entity.
+func (guo *GroupUpdateOne) ClearAuthTokens() *GroupUpdateOne {
+	guo.mutation.ClearAuthTokens()
+	return guo
+}
+
+// RemoveAuthTokenIDs removes the "auth_tokens" edge to AuthTokens entities by IDs.
+func (guo *GroupUpdateOne) RemoveAuthTokenIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.RemoveAuthTokenIDs(ids...)
+	return guo
+}
+
+// RemoveAuthTokens removes "auth_tokens" edges to AuthTokens entities.
+func (guo *GroupUpdateOne) RemoveAuthTokens(a ...*AuthTokens) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return guo.RemoveAuthTokenIDs(ids...)
+}
+
 // ClearLocations clears all "locations" edges to the Location entity.
 func (guo *GroupUpdateOne) ClearLocations() *GroupUpdateOne {
 	guo.mutation.ClearLocations()
@@ -996,28 +1886,133 @@ func (guo *GroupUpdateOne) RemoveInvitationTokens(g ...*GroupInvitationToken) *G
 	for i := range g {
 		ids[i] = g[i].ID
 	}
-	return guo.RemoveInvitationTokenIDs(ids...)
+	return guo.RemoveInvitationTokenIDs(ids...)
+}
+
+// ClearNotifiers clears all "notifiers" edges to the Notifier entity.
+func (guo *GroupUpdateOne) ClearNotifiers() *GroupUpdateOne {
+	guo.mutation.ClearNotifiers()
+	return guo
+}
+
+// RemoveNotifierIDs removes the "notifiers" edge to Notifier entities by IDs.
+func (guo *GroupUpdateOne) RemoveNotifierIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.RemoveNotifierIDs(ids...)
+	return guo
+}
+
+// RemoveNotifiers removes "notifiers" edges to Notifier entities.
+func (guo *GroupUpdateOne) RemoveNotifiers(n ...*Notifier) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return guo.RemoveNotifierIDs(ids...)
+}
+
+// ClearAuditEntries clears all "audit_entries" edges to the AuditEntry entity.
+func (guo *GroupUpdateOne) ClearAuditEntries() *GroupUpdateOne {
+	guo.mutation.ClearAuditEntries()
+	return guo
+}
+
+// RemoveAuditEntryIDs removes the "audit_entries" edge to AuditEntry entities by IDs.
+func (guo *GroupUpdateOne) RemoveAuditEntryIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.RemoveAuditEntryIDs(ids...)
+	return guo
+}
+
+// RemoveAuditEntries removes "audit_entries" edges to AuditEntry entities.
+func (guo *GroupUpdateOne) RemoveAuditEntries(a ...*AuditEntry) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return guo.RemoveAuditEntryIDs(ids...)
+}
+
+// ClearSavedSearches clears all "saved_searches" edges to the SavedSearch entity.
+func (guo *GroupUpdateOne) ClearSavedSearches() *GroupUpdateOne {
+	guo.mutation.ClearSavedSearches()
+	return guo
+}
+
+// RemoveSavedSearchIDs removes the "saved_searches" edge to SavedSearch entities by IDs.
+func (guo *GroupUpdateOne) RemoveSavedSearchIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.RemoveSavedSearchIDs(ids...)
+	return guo
+}
+
+// RemoveSavedSearches removes "saved_searches" edges to SavedSearch entities.
+func (guo *GroupUpdateOne) RemoveSavedSearches(s ...*SavedSearch) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return guo.RemoveSavedSearchIDs(ids...)
+}
+
+// ClearNotificationRules clears all "notification_rules" edges to the NotificationRule entity.
+func (guo *GroupUpdateOne) ClearNotificationRules() *GroupUpdateOne {
+	guo.mutation.ClearNotificationRules()
+	return guo
+}
+
+// RemoveNotificationRuleIDs removes the "notification_rules" edge to NotificationRule entities by IDs.
+func (guo *GroupUpdateOne) RemoveNotificationRuleIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.RemoveNotificationRuleIDs(ids...)
+	return guo
+}
+
+// RemoveNotificationRules removes "notification_rules" edges to NotificationRule entities.
+func (guo *GroupUpdateOne) RemoveNotificationRules(n ...*NotificationRule) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return guo.RemoveNotificationRuleIDs(ids...)
+}
+
+// ClearNotificationReminders clears all "notification_reminders" edges to the NotificationReminder entity.
+func (guo *GroupUpdateOne) ClearNotificationReminders() *GroupUpdateOne {
+	guo.mutation.ClearNotificationReminders()
+	return guo
+}
+
+// RemoveNotificationReminderIDs removes the "notification_reminders" edge to NotificationReminder entities by IDs.
+func (guo *GroupUpdateOne) RemoveNotificationReminderIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.RemoveNotificationReminderIDs(ids...)
+	return guo
+}
+
+// RemoveNotificationReminders removes "notification_reminders" edges to NotificationReminder entities.
+func (guo *GroupUpdateOne) RemoveNotificationReminders(n ...*NotificationReminder) *GroupUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return guo.RemoveNotificationReminderIDs(ids...)
 }
 
-// ClearNotifiers clears all "notifiers" edges to the Notifier entity.
-func (guo *GroupUpdateOne) ClearNotifiers() *GroupUpdateOne {
-	guo.mutation.ClearNotifiers()
+// ClearNotificationTemplates clears all "notification_templates" edges to the NotificationTemplate entity.
+func (guo *GroupUpdateOne) ClearNotificationTemplates() *GroupUpdateOne {
+	guo.mutation.ClearNotificationTemplates()
 	return guo
 }
 
-// RemoveNotifierIDs removes the "notifiers" edge to Notifier entities by IDs.
-func (guo *GroupUpdateOne) RemoveNotifierIDs(ids ...uuid.UUID) *GroupUpdateOne {
-	guo.mutation.RemoveNotifierIDs(ids...)
+// RemoveNotificationTemplateIDs removes the "notification_templates" edge to NotificationTemplate entities by IDs.
+func (guo *GroupUpdateOne) RemoveNotificationTemplateIDs(ids ...uuid.UUID) *GroupUpdateOne {
+	guo.mutation.RemoveNotificationTemplateIDs(ids...)
 	return guo
 }
 
-// RemoveNotifiers removes "notifiers" edges to Notifier entities.
-func (guo *GroupUpdateOne) RemoveNotifiers(n ...*Notifier) *GroupUpdateOne {
+// RemoveNotificationTemplates removes "notification_templates" edges to NotificationTemplate entities.
+func (guo *GroupUpdateOne) RemoveNotificationTemplates(n ...*NotificationTemplate) *GroupUpdateOne {
 	ids := make([]uuid.UUID, len(n))
 	for i := range n {
 		ids[i] = n[i].ID
 	}
-	return guo.RemoveNotifierIDs(ids...)
+	return guo.RemoveNotificationTemplateIDs(ids...)
 }
 
 // Where appends a list predicates to the GroupUpdate builder.
@@ -1076,6 +2071,21 @@ func (guo *GroupUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
 		}
 	}
+	if v, ok := guo.mutation.Timezone(); ok {
+		if err := group.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Group.timezone": %w`, err)}
+		}
+	}
+	if v, ok := guo.mutation.NotifyTime(); ok {
+		if err := group.NotifyTimeValidator(v); err != nil {
+			return &ValidationError{Name: "notify_time", err: fmt.Errorf(`ent: validator failed for field "Group.notify_time": %w`, err)}
+		}
+	}
+	if v, ok := guo.mutation.Digest(); ok {
+		if err := group.DigestValidator(v); err != nil {
+			return &ValidationError{Name: "digest", err: fmt.Errorf(`ent: validator failed for field "Group.digest": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1117,6 +2127,24 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 	if value, ok := guo.mutation.Currency(); ok {
 		_spec.SetField(group.FieldCurrency, field.TypeString, value)
 	}
+	if value, ok := guo.mutation.Timezone(); ok {
+		_spec.SetField(group.FieldTimezone, field.TypeString, value)
+	}
+	if guo.mutation.TimezoneCleared() {
+		_spec.ClearField(group.FieldTimezone, field.TypeString)
+	}
+	if value, ok := guo.mutation.NotifyTime(); ok {
+		_spec.SetField(group.FieldNotifyTime, field.TypeString, value)
+	}
+	if value, ok := guo.mutation.Digest(); ok {
+		_spec.SetField(group.FieldDigest, field.TypeEnum, value)
+	}
+	if value, ok := guo.mutation.NotifiedOn(); ok {
+		_spec.SetField(group.FieldNotifiedOn, field.TypeTime, value)
+	}
+	if guo.mutation.NotifiedOnCleared() {
+		_spec.ClearField(group.FieldNotifiedOn, field.TypeTime)
+	}
 	if guo.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1162,6 +2190,96 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if guo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.MembershipsTable,
+			Columns: []string{group.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !guo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.MembershipsTable,
+			Columns: []string{group.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.MembershipsTable,
+			Columns: []string{group.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.AuthTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuthTokensTable,
+			Columns: []string{group.AuthTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedAuthTokensIDs(); len(nodes) > 0 && !guo.mutation.AuthTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuthTokensTable,
+			Columns: []string{group.AuthTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.AuthTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuthTokensTable,
+			Columns: []string{group.AuthTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authtokens.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if guo.mutation.LocationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1432,6 +2550,231 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if guo.mutation.AuditEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuditEntriesTable,
+			Columns: []string{group.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedAuditEntriesIDs(); len(nodes) > 0 && !guo.mutation.AuditEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuditEntriesTable,
+			Columns: []string{group.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.AuditEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.AuditEntriesTable,
+			Columns: []string{group.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedSavedSearchesIDs(); len(nodes) > 0 && !guo.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.SavedSearchesTable,
+			Columns: []string{group.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.NotificationRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationRulesTable,
+			Columns: []string{group.NotificationRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedNotificationRulesIDs(); len(nodes) > 0 && !guo.mutation.NotificationRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationRulesTable,
+			Columns: []string{group.NotificationRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.NotificationRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationRulesTable,
+			Columns: []string{group.NotificationRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.NotificationRemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationRemindersTable,
+			Columns: []string{group.NotificationRemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedNotificationRemindersIDs(); len(nodes) > 0 && !guo.mutation.NotificationRemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationRemindersTable,
+			Columns: []string{group.NotificationRemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.NotificationRemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationRemindersTable,
+			Columns: []string{group.NotificationRemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.NotificationTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationTemplatesTable,
+			Columns: []string{group.NotificationTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedNotificationTemplatesIDs(); len(nodes) > 0 && !guo.mutation.NotificationTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationTemplatesTable,
+			Columns: []string{group.NotificationTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.NotificationTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   group.NotificationTemplatesTable,
+			Columns: []string{group.NotificationTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Group{config: guo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
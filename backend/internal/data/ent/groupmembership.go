@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+)
+
+// GroupMembership is the model entity for the GroupMembership schema.
+type GroupMembership struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID uuid.UUID `json:"group_id,omitempty"`
+	// Role holds the value of the "role" field.
+	Role groupmembership.Role `json:"role,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GroupMembershipQuery when eager-loading is set.
+	Edges        GroupMembershipEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GroupMembershipEdges holds the relations/edges for other nodes in the graph.
+type GroupMembershipEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Group holds the value of the group edge.
+	Group *Group `json:"group,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupMembershipEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupMembershipEdges) GroupOrErr() (*Group, error) {
+	if e.loadedTypes[1] {
+		if e.Group == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: group.Label}
+		}
+		return e.Group, nil
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GroupMembership) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case groupmembership.FieldRole:
+			values[i] = new(sql.NullString)
+		case groupmembership.FieldCreatedAt, groupmembership.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case groupmembership.FieldID, groupmembership.FieldUserID, groupmembership.FieldGroupID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GroupMembership fields.
+func (gm *GroupMembership) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case groupmembership.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				gm.ID = *value
+			}
+		case groupmembership.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				gm.CreatedAt = value.Time
+			}
+		case groupmembership.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				gm.UpdatedAt = value.Time
+			}
+		case groupmembership.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				gm.UserID = *value
+			}
+		case groupmembership.FieldGroupID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value != nil {
+				gm.GroupID = *value
+			}
+		case groupmembership.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				gm.Role = groupmembership.Role(value.String)
+			}
+		default:
+			gm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GroupMembership.
+// This includes values selected through modifiers, order, etc.
+func (gm *GroupMembership) Value(name string) (ent.Value, error) {
+	return gm.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the GroupMembership entity.
+func (gm *GroupMembership) QueryUser() *UserQuery {
+	return NewGroupMembershipClient(gm.config).QueryUser(gm)
+}
+
+// QueryGroup queries the "group" edge of the GroupMembership entity.
+func (gm *GroupMembership) QueryGroup() *GroupQuery {
+	return NewGroupMembershipClient(gm.config).QueryGroup(gm)
+}
+
+// Update returns a builder for updating this GroupMembership.
+// Note that you need to call GroupMembership.Unwrap() before calling this method if this GroupMembership
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gm *GroupMembership) Update() *GroupMembershipUpdateOne {
+	return NewGroupMembershipClient(gm.config).UpdateOne(gm)
+}
+
+// Unwrap unwraps the GroupMembership entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gm *GroupMembership) Unwrap() *GroupMembership {
+	_tx, ok := gm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupMembership is not a transactional entity")
+	}
+	gm.config.driver = _tx.drv
+	return gm
+}
+
+// String implements the fmt.Stringer.
+func (gm *GroupMembership) String() string {
+	var builder strings.Builder
+	builder.WriteString("GroupMembership(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gm.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(gm.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(gm.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", gm.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(fmt.Sprintf("%v", gm.GroupID))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", gm.Role))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GroupMemberships is a parsable slice of GroupMembership.
+type GroupMemberships []*GroupMembership
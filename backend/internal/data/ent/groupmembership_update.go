@@ -0,0 +1,503 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+)
+
+// GroupMembershipUpdate is the builder for updating GroupMembership entities.
+type GroupMembershipUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GroupMembershipMutation
+}
+
+// Where appends a list predicates to the GroupMembershipUpdate builder.
+func (gmu *GroupMembershipUpdate) Where(ps ...predicate.GroupMembership) *GroupMembershipUpdate {
+	gmu.mutation.Where(ps...)
+	return gmu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gmu *GroupMembershipUpdate) SetUpdatedAt(t time.Time) *GroupMembershipUpdate {
+	gmu.mutation.SetUpdatedAt(t)
+	return gmu
+}
+
+// SetUserID sets the "user_id" field.
+func (gmu *GroupMembershipUpdate) SetUserID(u uuid.UUID) *GroupMembershipUpdate {
+	gmu.mutation.SetUserID(u)
+	return gmu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (gmu *GroupMembershipUpdate) SetNillableUserID(u *uuid.UUID) *GroupMembershipUpdate {
+	if u != nil {
+		gmu.SetUserID(*u)
+	}
+	return gmu
+}
+
+// SetGroupID sets the "group_id" field.
+func (gmu *GroupMembershipUpdate) SetGroupID(u uuid.UUID) *GroupMembershipUpdate {
+	gmu.mutation.SetGroupID(u)
+	return gmu
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (gmu *GroupMembershipUpdate) SetNillableGroupID(u *uuid.UUID) *GroupMembershipUpdate {
+	if u != nil {
+		gmu.SetGroupID(*u)
+	}
+	return gmu
+}
+
+// SetRole sets the "role" field.
+func (gmu *GroupMembershipUpdate) SetRole(gr groupmembership.Role) *GroupMembershipUpdate {
+	gmu.mutation.SetRole(gr)
+	return gmu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (gmu *GroupMembershipUpdate) SetNillableRole(gr *groupmembership.Role) *GroupMembershipUpdate {
+	if gr != nil {
+		gmu.SetRole(*gr)
+	}
+	return gmu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (gmu *GroupMembershipUpdate) SetUser(u *User) *GroupMembershipUpdate {
+	return gmu.SetUserID(u.ID)
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (gmu *GroupMembershipUpdate) SetGroup(g *Group) *GroupMembershipUpdate {
+	return gmu.SetGroupID(g.ID)
+}
+
+// Mutation returns the GroupMembershipMutation object of the builder.
+func (gmu *GroupMembershipUpdate) Mutation() *GroupMembershipMutation {
+	return gmu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (gmu *GroupMembershipUpdate) ClearUser() *GroupMembershipUpdate {
+	gmu.mutation.ClearUser()
+	return gmu
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (gmu *GroupMembershipUpdate) ClearGroup() *GroupMembershipUpdate {
+	gmu.mutation.ClearGroup()
+	return gmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gmu *GroupMembershipUpdate) Save(ctx context.Context) (int, error) {
+	gmu.defaults()
+	return withHooks(ctx, gmu.sqlSave, gmu.mutation, gmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gmu *GroupMembershipUpdate) SaveX(ctx context.Context) int {
+	affected, err := gmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gmu *GroupMembershipUpdate) Exec(ctx context.Context) error {
+	_, err := gmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmu *GroupMembershipUpdate) ExecX(ctx context.Context) {
+	if err := gmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gmu *GroupMembershipUpdate) defaults() {
+	if _, ok := gmu.mutation.UpdatedAt(); !ok {
+		v := groupmembership.UpdateDefaultUpdatedAt()
+		gmu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gmu *GroupMembershipUpdate) check() error {
+	if v, ok := gmu.mutation.Role(); ok {
+		if err := groupmembership.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "GroupMembership.role": %w`, err)}
+		}
+	}
+	if _, ok := gmu.mutation.UserID(); gmu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "GroupMembership.user"`)
+	}
+	if _, ok := gmu.mutation.GroupID(); gmu.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "GroupMembership.group"`)
+	}
+	return nil
+}
+
+func (gmu *GroupMembershipUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupmembership.Table, groupmembership.Columns, sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeUUID))
+	if ps := gmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gmu.mutation.UpdatedAt(); ok {
+		_spec.SetField(groupmembership.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := gmu.mutation.Role(); ok {
+		_spec.SetField(groupmembership.FieldRole, field.TypeEnum, value)
+	}
+	if gmu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupmembership.UserTable,
+			Columns: []string{groupmembership.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gmu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupmembership.UserTable,
+			Columns: []string{groupmembership.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gmu.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupmembership.GroupTable,
+			Columns: []string{groupmembership.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gmu.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupmembership.GroupTable,
+			Columns: []string{groupmembership.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupmembership.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gmu.mutation.done = true
+	return n, nil
+}
+
+// GroupMembershipUpdateOne is the builder for updating a single GroupMembership entity.
+type GroupMembershipUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GroupMembershipMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gmuo *GroupMembershipUpdateOne) SetUpdatedAt(t time.Time) *GroupMembershipUpdateOne {
+	gmuo.mutation.SetUpdatedAt(t)
+	return gmuo
+}
+
+// SetUserID sets the "user_id" field.
+func (gmuo *GroupMembershipUpdateOne) SetUserID(u uuid.UUID) *GroupMembershipUpdateOne {
+	gmuo.mutation.SetUserID(u)
+	return gmuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (gmuo *GroupMembershipUpdateOne) SetNillableUserID(u *uuid.UUID) *GroupMembershipUpdateOne {
+	if u != nil {
+		gmuo.SetUserID(*u)
+	}
+	return gmuo
+}
+
+// SetGroupID sets the "group_id" field.
+func (gmuo *GroupMembershipUpdateOne) SetGroupID(u uuid.UUID) *GroupMembershipUpdateOne {
+	gmuo.mutation.SetGroupID(u)
+	return gmuo
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (gmuo *GroupMembershipUpdateOne) SetNillableGroupID(u *uuid.UUID) *GroupMembershipUpdateOne {
+	if u != nil {
+		gmuo.SetGroupID(*u)
+	}
+	return gmuo
+}
+
+// SetRole sets the "role" field.
+func (gmuo *GroupMembershipUpdateOne) SetRole(gr groupmembership.Role) *GroupMembershipUpdateOne {
+	gmuo.mutation.SetRole(gr)
+	return gmuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (gmuo *GroupMembershipUpdateOne) SetNillableRole(gr *groupmembership.Role) *GroupMembershipUpdateOne {
+	if gr != nil {
+		gmuo.SetRole(*gr)
+	}
+	return gmuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (gmuo *GroupMembershipUpdateOne) SetUser(u *User) *GroupMembershipUpdateOne {
+	return gmuo.SetUserID(u.ID)
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (gmuo *GroupMembershipUpdateOne) SetGroup(g *Group) *GroupMembershipUpdateOne {
+	return gmuo.SetGroupID(g.ID)
+}
+
+// Mutation returns the GroupMembershipMutation object of the builder.
+func (gmuo *GroupMembershipUpdateOne) Mutation() *GroupMembershipMutation {
+	return gmuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (gmuo *GroupMembershipUpdateOne) ClearUser() *GroupMembershipUpdateOne {
+	gmuo.mutation.ClearUser()
+	return gmuo
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (gmuo *GroupMembershipUpdateOne) ClearGroup() *GroupMembershipUpdateOne {
+	gmuo.mutation.ClearGroup()
+	return gmuo
+}
+
+// Where appends a list predicates to the GroupMembershipUpdate builder.
+func (gmuo *GroupMembershipUpdateOne) Where(ps ...predicate.GroupMembership) *GroupMembershipUpdateOne {
+	gmuo.mutation.Where(ps...)
+	return gmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gmuo *GroupMembershipUpdateOne) Select(field string, fields ...string) *GroupMembershipUpdateOne {
+	gmuo.fields = append([]string{field}, fields...)
+	return gmuo
+}
+
+// Save executes the query and returns the updated GroupMembership entity.
+func (gmuo *GroupMembershipUpdateOne) Save(ctx context.Context) (*GroupMembership, error) {
+	gmuo.defaults()
+	return withHooks(ctx, gmuo.sqlSave, gmuo.mutation, gmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gmuo *GroupMembershipUpdateOne) SaveX(ctx context.Context) *GroupMembership {
+	node, err := gmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gmuo *GroupMembershipUpdateOne) Exec(ctx context.Context) error {
+	_, err := gmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmuo *GroupMembershipUpdateOne) ExecX(ctx context.Context) {
+	if err := gmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gmuo *GroupMembershipUpdateOne) defaults() {
+	if _, ok := gmuo.mutation.UpdatedAt(); !ok {
+		v := groupmembership.UpdateDefaultUpdatedAt()
+		gmuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gmuo *GroupMembershipUpdateOne) check() error {
+	if v, ok := gmuo.mutation.Role(); ok {
+		if err := groupmembership.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "GroupMembership.role": %w`, err)}
+		}
+	}
+	if _, ok := gmuo.mutation.UserID(); gmuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "GroupMembership.user"`)
+	}
+	if _, ok := gmuo.mutation.GroupID(); gmuo.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "GroupMembership.group"`)
+	}
+	return nil
+}
+
+func (gmuo *GroupMembershipUpdateOne) sqlSave(ctx context.Context) (_node *GroupMembership, err error) {
+	if err := gmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupmembership.Table, groupmembership.Columns, sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeUUID))
+	id, ok := gmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GroupMembership.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, groupmembership.FieldID)
+		for _, f := range fields {
+			if !groupmembership.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != groupmembership.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gmuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(groupmembership.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := gmuo.mutation.Role(); ok {
+		_spec.SetField(groupmembership.FieldRole, field.TypeEnum, value)
+	}
+	if gmuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupmembership.UserTable,
+			Columns: []string{groupmembership.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gmuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupmembership.UserTable,
+			Columns: []string{groupmembership.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gmuo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupmembership.GroupTable,
+			Columns: []string{groupmembership.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gmuo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupmembership.GroupTable,
+			Columns: []string{groupmembership.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GroupMembership{config: gmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupmembership.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gmuo.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,10 @@ func (a *Attachment) GetID() uuid.UUID {
 	return a.ID
 }
 
+func (ae *AuditEntry) GetID() uuid.UUID {
+	return ae.ID
+}
+
 func (ar *AuthRoles) GetID() int {
 	return ar.ID
 }
@@ -28,6 +32,10 @@ func (git *GroupInvitationToken) GetID() uuid.UUID {
 	return git.ID
 }
 
+func (gm *GroupMembership) GetID() uuid.UUID {
+	return gm.ID
+}
+
 func (i *Item) GetID() uuid.UUID {
 	return i.ID
 }
@@ -48,10 +56,42 @@ func (me *MaintenanceEntry) GetID() uuid.UUID {
 	return me.ID
 }
 
+func (ms *MaintenanceSchedule) GetID() uuid.UUID {
+	return ms.ID
+}
+
+func (nr *NotificationReminder) GetID() uuid.UUID {
+	return nr.ID
+}
+
+func (nr *NotificationRule) GetID() uuid.UUID {
+	return nr.ID
+}
+
+func (nt *NotificationTemplate) GetID() uuid.UUID {
+	return nt.ID
+}
+
 func (n *Notifier) GetID() uuid.UUID {
 	return n.ID
 }
 
+func (nd *NotifierDelivery) GetID() uuid.UUID {
+	return nd.ID
+}
+
+func (ss *SavedSearch) GetID() uuid.UUID {
+	return ss.ID
+}
+
 func (u *User) GetID() uuid.UUID {
 	return u.ID
 }
+
+func (ut *UserToken) GetID() uuid.UUID {
+	return ut.ID
+}
+
+func (wac *WebAuthnCredential) GetID() uuid.UUID {
+	return wac.ID
+}
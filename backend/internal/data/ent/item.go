@@ -28,6 +28,8 @@ type Item struct {
 	Name string `json:"name,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// ImportRef holds the value of the "import_ref" field.
 	ImportRef string `json:"import_ref,omitempty"`
 	// Notes holds the value of the "notes" field.
@@ -91,11 +93,13 @@ type ItemEdges struct {
 	Fields []*ItemField `json:"fields,omitempty"`
 	// MaintenanceEntries holds the value of the maintenance_entries edge.
 	MaintenanceEntries []*MaintenanceEntry `json:"maintenance_entries,omitempty"`
+	// MaintenanceSchedules holds the value of the maintenance_schedules edge.
+	MaintenanceSchedules []*MaintenanceSchedule `json:"maintenance_schedules,omitempty"`
 	// Attachments holds the value of the attachments edge.
 	Attachments []*Attachment `json:"attachments,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [8]bool
+	loadedTypes [9]bool
 }
 
 // GroupOrErr returns the Group value or an error if the edge
@@ -173,10 +177,19 @@ func (e ItemEdges) MaintenanceEntriesOrErr() ([]*MaintenanceEntry, error) {
 	return nil, &NotLoadedError{edge: "maintenance_entries"}
 }
 
+// MaintenanceSchedulesOrErr returns the MaintenanceSchedules value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) MaintenanceSchedulesOrErr() ([]*MaintenanceSchedule, error) {
+	if e.loadedTypes[7] {
+		return e.MaintenanceSchedules, nil
+	}
+	return nil, &NotLoadedError{edge: "maintenance_schedules"}
+}
+
 // AttachmentsOrErr returns the Attachments value or an error if the edge
 // was not loaded in eager-loading.
 func (e ItemEdges) AttachmentsOrErr() ([]*Attachment, error) {
-	if e.loadedTypes[7] {
+	if e.loadedTypes[8] {
 		return e.Attachments, nil
 	}
 	return nil, &NotLoadedError{edge: "attachments"}
@@ -195,7 +208,7 @@ func (*Item) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case item.FieldName, item.FieldDescription, item.FieldImportRef, item.FieldNotes, item.FieldSerialNumber, item.FieldModelNumber, item.FieldManufacturer, item.FieldWarrantyDetails, item.FieldPurchaseFrom, item.FieldSoldTo, item.FieldSoldNotes:
 			values[i] = new(sql.NullString)
-		case item.FieldCreatedAt, item.FieldUpdatedAt, item.FieldWarrantyExpires, item.FieldPurchaseTime, item.FieldSoldTime:
+		case item.FieldCreatedAt, item.FieldUpdatedAt, item.FieldDeletedAt, item.FieldWarrantyExpires, item.FieldPurchaseTime, item.FieldSoldTime:
 			values[i] = new(sql.NullTime)
 		case item.FieldID:
 			values[i] = new(uuid.UUID)
@@ -250,6 +263,13 @@ func (i *Item) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.Description = value.String
 			}
+		case item.FieldDeletedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[j])
+			} else if value.Valid {
+				i.DeletedAt = new(time.Time)
+				*i.DeletedAt = value.Time
+			}
 		case item.FieldImportRef:
 			if value, ok := values[j].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field import_ref", values[j])
@@ -433,6 +453,11 @@ func (i *Item) QueryMaintenanceEntries() *MaintenanceEntryQuery {
 	return NewItemClient(i.config).QueryMaintenanceEntries(i)
 }
 
+// QueryMaintenanceSchedules queries the "maintenance_schedules" edge of the Item entity.
+func (i *Item) QueryMaintenanceSchedules() *MaintenanceScheduleQuery {
+	return NewItemClient(i.config).QueryMaintenanceSchedules(i)
+}
+
 // QueryAttachments queries the "attachments" edge of the Item entity.
 func (i *Item) QueryAttachments() *AttachmentQuery {
 	return NewItemClient(i.config).QueryAttachments(i)
@@ -473,6 +498,11 @@ func (i *Item) String() string {
 	builder.WriteString("description=")
 	builder.WriteString(i.Description)
 	builder.WriteString(", ")
+	if v := i.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("import_ref=")
 	builder.WriteString(i.ImportRef)
 	builder.WriteString(", ")
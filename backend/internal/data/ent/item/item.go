@@ -23,6 +23,8 @@ const (
 	FieldName = "name"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldImportRef holds the string denoting the import_ref field in the database.
 	FieldImportRef = "import_ref"
 	// FieldNotes holds the string denoting the notes field in the database.
@@ -75,6 +77,8 @@ const (
 	EdgeFields = "fields"
 	// EdgeMaintenanceEntries holds the string denoting the maintenance_entries edge name in mutations.
 	EdgeMaintenanceEntries = "maintenance_entries"
+	// EdgeMaintenanceSchedules holds the string denoting the maintenance_schedules edge name in mutations.
+	EdgeMaintenanceSchedules = "maintenance_schedules"
 	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
 	EdgeAttachments = "attachments"
 	// Table holds the table name of the item in the database.
@@ -120,6 +124,13 @@ const (
 	MaintenanceEntriesInverseTable = "maintenance_entries"
 	// MaintenanceEntriesColumn is the table column denoting the maintenance_entries relation/edge.
 	MaintenanceEntriesColumn = "item_id"
+	// MaintenanceSchedulesTable is the table that holds the maintenance_schedules relation/edge.
+	MaintenanceSchedulesTable = "maintenance_schedules"
+	// MaintenanceSchedulesInverseTable is the table name for the MaintenanceSchedule entity.
+	// It exists in this package in order to avoid circular dependency with the "maintenanceschedule" package.
+	MaintenanceSchedulesInverseTable = "maintenance_schedules"
+	// MaintenanceSchedulesColumn is the table column denoting the maintenance_schedules relation/edge.
+	MaintenanceSchedulesColumn = "item_id"
 	// AttachmentsTable is the table that holds the attachments relation/edge.
 	AttachmentsTable = "attachments"
 	// AttachmentsInverseTable is the table name for the Attachment entity.
@@ -136,6 +147,7 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldName,
 	FieldDescription,
+	FieldDeletedAt,
 	FieldImportRef,
 	FieldNotes,
 	FieldQuantity,
@@ -257,6 +269,11 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByImportRef orders the results by the import_ref field.
 func ByImportRef(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldImportRef, opts...).ToFunc()
@@ -429,6 +446,20 @@ func ByMaintenanceEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOptio
 	}
 }
 
+// ByMaintenanceSchedulesCount orders the results by maintenance_schedules count.
+func ByMaintenanceSchedulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMaintenanceSchedulesStep(), opts...)
+	}
+}
+
+// ByMaintenanceSchedules orders the results by maintenance_schedules terms.
+func ByMaintenanceSchedules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMaintenanceSchedulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByAttachmentsCount orders the results by attachments count.
 func ByAttachmentsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -491,6 +522,13 @@ func newMaintenanceEntriesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, MaintenanceEntriesTable, MaintenanceEntriesColumn),
 	)
 }
+func newMaintenanceSchedulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MaintenanceSchedulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MaintenanceSchedulesTable, MaintenanceSchedulesColumn),
+	)
+}
 func newAttachmentsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -76,6 +76,11 @@ func Description(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldDescription, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // ImportRef applies equality check predicate on the "import_ref" field. It's identical to ImportRefEQ.
 func ImportRef(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldImportRef, v))
@@ -391,6 +396,56 @@ func DescriptionContainsFold(v string) predicate.Item {
 	return predicate.Item(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // ImportRefEQ applies the EQ predicate on the "import_ref" field.
 func ImportRefEQ(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldImportRef, v))
@@ -1567,6 +1622,29 @@ func HasMaintenanceEntriesWith(preds ...predicate.MaintenanceEntry) predicate.It
 	})
 }
 
+// HasMaintenanceSchedules applies the HasEdge predicate on the "maintenance_schedules" edge.
+func HasMaintenanceSchedules() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MaintenanceSchedulesTable, MaintenanceSchedulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMaintenanceSchedulesWith applies the HasEdge predicate on the "maintenance_schedules" edge with a given conditions (other predicates).
+func HasMaintenanceSchedulesWith(preds ...predicate.MaintenanceSchedule) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newMaintenanceSchedulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasAttachments applies the HasEdge predicate on the "attachments" edge.
 func HasAttachments() predicate.Item {
 	return predicate.Item(func(s *sql.Selector) {
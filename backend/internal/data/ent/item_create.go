@@ -18,6 +18,7 @@ import (
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
 )
 
 // ItemCreate is the builder for creating a Item entity.
@@ -75,6 +76,20 @@ func (ic *ItemCreate) SetNillableDescription(s *string) *ItemCreate {
 	return ic
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ic *ItemCreate) SetDeletedAt(t time.Time) *ItemCreate {
+	ic.mutation.SetDeletedAt(t)
+	return ic
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableDeletedAt(t *time.Time) *ItemCreate {
+	if t != nil {
+		ic.SetDeletedAt(*t)
+	}
+	return ic
+}
+
 // SetImportRef sets the "import_ref" field.
 func (ic *ItemCreate) SetImportRef(s string) *ItemCreate {
 	ic.mutation.SetImportRef(s)
@@ -464,6 +479,21 @@ func (ic *ItemCreate) AddMaintenanceEntries(m ...*MaintenanceEntry) *ItemCreate
 	return ic.AddMaintenanceEntryIDs(ids...)
 }
 
+// AddMaintenanceScheduleIDs adds the "maintenance_schedules" edge to the MaintenanceSchedule entity by IDs.
+func (ic *ItemCreate) AddMaintenanceScheduleIDs(ids ...uuid.UUID) *ItemCreate {
+	ic.mutation.AddMaintenanceScheduleIDs(ids...)
+	return ic
+}
+
+// AddMaintenanceSchedules adds the "maintenance_schedules" edges to the MaintenanceSchedule entity.
+func (ic *ItemCreate) AddMaintenanceSchedules(m ...*MaintenanceSchedule) *ItemCreate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ic.AddMaintenanceScheduleIDs(ids...)
+}
+
 // AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
 func (ic *ItemCreate) AddAttachmentIDs(ids ...uuid.UUID) *ItemCreate {
 	ic.mutation.AddAttachmentIDs(ids...)
@@ -687,6 +717,10 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_spec.SetField(item.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := ic.mutation.DeletedAt(); ok {
+		_spec.SetField(item.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := ic.mutation.ImportRef(); ok {
 		_spec.SetField(item.FieldImportRef, field.TypeString, value)
 		_node.ImportRef = value
@@ -878,6 +912,22 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ic.mutation.MaintenanceSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.MaintenanceSchedulesTable,
+			Columns: []string{item.MaintenanceSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := ic.mutation.AttachmentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
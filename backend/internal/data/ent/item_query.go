@@ -19,25 +19,27 @@ import (
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
 )
 
 // ItemQuery is the builder for querying Item entities.
 type ItemQuery struct {
 	config
-	ctx                    *QueryContext
-	order                  []item.OrderOption
-	inters                 []Interceptor
-	predicates             []predicate.Item
-	withGroup              *GroupQuery
-	withParent             *ItemQuery
-	withChildren           *ItemQuery
-	withLabel              *LabelQuery
-	withLocation           *LocationQuery
-	withFields             *ItemFieldQuery
-	withMaintenanceEntries *MaintenanceEntryQuery
-	withAttachments        *AttachmentQuery
-	withFKs                bool
+	ctx                      *QueryContext
+	order                    []item.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.Item
+	withGroup                *GroupQuery
+	withParent               *ItemQuery
+	withChildren             *ItemQuery
+	withLabel                *LabelQuery
+	withLocation             *LocationQuery
+	withFields               *ItemFieldQuery
+	withMaintenanceEntries   *MaintenanceEntryQuery
+	withMaintenanceSchedules *MaintenanceScheduleQuery
+	withAttachments          *AttachmentQuery
+	withFKs                  bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -228,6 +230,28 @@ func (iq *ItemQuery) QueryMaintenanceEntries() *MaintenanceEntryQuery {
 	return query
 }
 
+// QueryMaintenanceSchedules chains the current query on the "maintenance_schedules" edge.
+func (iq *ItemQuery) QueryMaintenanceSchedules() *MaintenanceScheduleQuery {
+	query := (&MaintenanceScheduleClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(maintenanceschedule.Table, maintenanceschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.MaintenanceSchedulesTable, item.MaintenanceSchedulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryAttachments chains the current query on the "attachments" edge.
 func (iq *ItemQuery) QueryAttachments() *AttachmentQuery {
 	query := (&AttachmentClient{config: iq.config}).Query()
@@ -437,19 +461,20 @@ func (iq *ItemQuery) Clone() *ItemQuery {
 		return nil
 	}
 	return &ItemQuery{
-		config:                 iq.config,
-		ctx:                    iq.ctx.Clone(),
-		order:                  append([]item.OrderOption{}, iq.order...),
-		inters:                 append([]Interceptor{}, iq.inters...),
-		predicates:             append([]predicate.Item{}, iq.predicates...),
-		withGroup:              iq.withGroup.Clone(),
-		withParent:             iq.withParent.Clone(),
-		withChildren:           iq.withChildren.Clone(),
-		withLabel:              iq.withLabel.Clone(),
-		withLocation:           iq.withLocation.Clone(),
-		withFields:             iq.withFields.Clone(),
-		withMaintenanceEntries: iq.withMaintenanceEntries.Clone(),
-		withAttachments:        iq.withAttachments.Clone(),
+		config:                   iq.config,
+		ctx:                      iq.ctx.Clone(),
+		order:                    append([]item.OrderOption{}, iq.order...),
+		inters:                   append([]Interceptor{}, iq.inters...),
+		predicates:               append([]predicate.Item{}, iq.predicates...),
+		withGroup:                iq.withGroup.Clone(),
+		withParent:               iq.withParent.Clone(),
+		withChildren:             iq.withChildren.Clone(),
+		withLabel:                iq.withLabel.Clone(),
+		withLocation:             iq.withLocation.Clone(),
+		withFields:               iq.withFields.Clone(),
+		withMaintenanceEntries:   iq.withMaintenanceEntries.Clone(),
+		withMaintenanceSchedules: iq.withMaintenanceSchedules.Clone(),
+		withAttachments:          iq.withAttachments.Clone(),
 		// clone intermediate query.
 		sql:  iq.sql.Clone(),
 		path: iq.path,
@@ -533,6 +558,17 @@ func (iq *ItemQuery) WithMaintenanceEntries(opts ...func(*MaintenanceEntryQuery)
 	return iq
 }
 
+// WithMaintenanceSchedules tells the query-builder to eager-load the nodes that are connected to
+// the "maintenance_schedules" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithMaintenanceSchedules(opts ...func(*MaintenanceScheduleQuery)) *ItemQuery {
+	query := (&MaintenanceScheduleClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withMaintenanceSchedules = query
+	return iq
+}
+
 // WithAttachments tells the query-builder to eager-load the nodes that are connected to
 // the "attachments" edge. The optional arguments are used to configure the query builder of the edge.
 func (iq *ItemQuery) WithAttachments(opts ...func(*AttachmentQuery)) *ItemQuery {
@@ -623,7 +659,7 @@ func (iq *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 		nodes       = []*Item{}
 		withFKs     = iq.withFKs
 		_spec       = iq.querySpec()
-		loadedTypes = [8]bool{
+		loadedTypes = [9]bool{
 			iq.withGroup != nil,
 			iq.withParent != nil,
 			iq.withChildren != nil,
@@ -631,6 +667,7 @@ func (iq *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 			iq.withLocation != nil,
 			iq.withFields != nil,
 			iq.withMaintenanceEntries != nil,
+			iq.withMaintenanceSchedules != nil,
 			iq.withAttachments != nil,
 		}
 	)
@@ -704,6 +741,15 @@ func (iq *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 			return nil, err
 		}
 	}
+	if query := iq.withMaintenanceSchedules; query != nil {
+		if err := iq.loadMaintenanceSchedules(ctx, query, nodes,
+			func(n *Item) { n.Edges.MaintenanceSchedules = []*MaintenanceSchedule{} },
+			func(n *Item, e *MaintenanceSchedule) {
+				n.Edges.MaintenanceSchedules = append(n.Edges.MaintenanceSchedules, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	if query := iq.withAttachments; query != nil {
 		if err := iq.loadAttachments(ctx, query, nodes,
 			func(n *Item) { n.Edges.Attachments = []*Attachment{} },
@@ -963,6 +1009,36 @@ func (iq *ItemQuery) loadMaintenanceEntries(ctx context.Context, query *Maintena
 	}
 	return nil
 }
+func (iq *ItemQuery) loadMaintenanceSchedules(ctx context.Context, query *MaintenanceScheduleQuery, nodes []*Item, init func(*Item), assign func(*Item, *MaintenanceSchedule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(maintenanceschedule.FieldItemID)
+	}
+	query.Where(predicate.MaintenanceSchedule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.MaintenanceSchedulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (iq *ItemQuery) loadAttachments(ctx context.Context, query *AttachmentQuery, nodes []*Item, init func(*Item), assign func(*Item, *Attachment)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Item)
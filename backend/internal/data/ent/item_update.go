@@ -19,6 +19,7 @@ import (
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
 )
 
@@ -75,6 +76,26 @@ func (iu *ItemUpdate) ClearDescription() *ItemUpdate {
 	return iu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (iu *ItemUpdate) SetDeletedAt(t time.Time) *ItemUpdate {
+	iu.mutation.SetDeletedAt(t)
+	return iu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableDeletedAt(t *time.Time) *ItemUpdate {
+	if t != nil {
+		iu.SetDeletedAt(*t)
+	}
+	return iu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iu *ItemUpdate) ClearDeletedAt() *ItemUpdate {
+	iu.mutation.ClearDeletedAt()
+	return iu
+}
+
 // SetImportRef sets the "import_ref" field.
 func (iu *ItemUpdate) SetImportRef(s string) *ItemUpdate {
 	iu.mutation.SetImportRef(s)
@@ -550,6 +571,21 @@ func (iu *ItemUpdate) AddMaintenanceEntries(m ...*MaintenanceEntry) *ItemUpdate
 	return iu.AddMaintenanceEntryIDs(ids...)
 }
 
+// AddMaintenanceScheduleIDs adds the "maintenance_schedules" edge to the MaintenanceSchedule entity by IDs.
+func (iu *ItemUpdate) AddMaintenanceScheduleIDs(ids ...uuid.UUID) *ItemUpdate {
+	iu.mutation.AddMaintenanceScheduleIDs(ids...)
+	return iu
+}
+
+// AddMaintenanceSchedules adds the "maintenance_schedules" edges to the MaintenanceSchedule entity.
+func (iu *ItemUpdate) AddMaintenanceSchedules(m ...*MaintenanceSchedule) *ItemUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return iu.AddMaintenanceScheduleIDs(ids...)
+}
+
 // AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
 func (iu *ItemUpdate) AddAttachmentIDs(ids ...uuid.UUID) *ItemUpdate {
 	iu.mutation.AddAttachmentIDs(ids...)
@@ -672,6 +708,27 @@ func (iu *ItemUpdate) RemoveMaintenanceEntries(m ...*MaintenanceEntry) *ItemUpda
 	return iu.RemoveMaintenanceEntryIDs(ids...)
 }
 
+// ClearMaintenanceSchedules clears all "maintenance_schedules" edges to the MaintenanceSchedule entity.
+func (iu *ItemUpdate) ClearMaintenanceSchedules() *ItemUpdate {
+	iu.mutation.ClearMaintenanceSchedules()
+	return iu
+}
+
+// RemoveMaintenanceScheduleIDs removes the "maintenance_schedules" edge to MaintenanceSchedule entities by IDs.
+func (iu *ItemUpdate) RemoveMaintenanceScheduleIDs(ids ...uuid.UUID) *ItemUpdate {
+	iu.mutation.RemoveMaintenanceScheduleIDs(ids...)
+	return iu
+}
+
+// RemoveMaintenanceSchedules removes "maintenance_schedules" edges to MaintenanceSchedule entities.
+func (iu *ItemUpdate) RemoveMaintenanceSchedules(m ...*MaintenanceSchedule) *ItemUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return iu.RemoveMaintenanceScheduleIDs(ids...)
+}
+
 // ClearAttachments clears all "attachments" edges to the Attachment entity.
 func (iu *ItemUpdate) ClearAttachments() *ItemUpdate {
 	iu.mutation.ClearAttachments()
@@ -806,6 +863,12 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if iu.mutation.DescriptionCleared() {
 		_spec.ClearField(item.FieldDescription, field.TypeString)
 	}
+	if value, ok := iu.mutation.DeletedAt(); ok {
+		_spec.SetField(item.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iu.mutation.DeletedAtCleared() {
+		_spec.ClearField(item.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := iu.mutation.ImportRef(); ok {
 		_spec.SetField(item.FieldImportRef, field.TypeString, value)
 	}
@@ -1178,6 +1241,51 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if iu.mutation.MaintenanceSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.MaintenanceSchedulesTable,
+			Columns: []string{item.MaintenanceSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedMaintenanceSchedulesIDs(); len(nodes) > 0 && !iu.mutation.MaintenanceSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.MaintenanceSchedulesTable,
+			Columns: []string{item.MaintenanceSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.MaintenanceSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.MaintenanceSchedulesTable,
+			Columns: []string{item.MaintenanceSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if iu.mutation.AttachmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1283,6 +1391,26 @@ func (iuo *ItemUpdateOne) ClearDescription() *ItemUpdateOne {
 	return iuo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (iuo *ItemUpdateOne) SetDeletedAt(t time.Time) *ItemUpdateOne {
+	iuo.mutation.SetDeletedAt(t)
+	return iuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableDeletedAt(t *time.Time) *ItemUpdateOne {
+	if t != nil {
+		iuo.SetDeletedAt(*t)
+	}
+	return iuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iuo *ItemUpdateOne) ClearDeletedAt() *ItemUpdateOne {
+	iuo.mutation.ClearDeletedAt()
+	return iuo
+}
+
 // SetImportRef sets the "import_ref" field.
 func (iuo *ItemUpdateOne) SetImportRef(s string) *ItemUpdateOne {
 	iuo.mutation.SetImportRef(s)
@@ -1758,6 +1886,21 @@ func (iuo *ItemUpdateOne) AddMaintenanceEntries(m ...*MaintenanceEntry) *ItemUpd
 	return iuo.AddMaintenanceEntryIDs(ids...)
 }
 
+// AddMaintenanceScheduleIDs adds the "maintenance_schedules" edge to the MaintenanceSchedule entity by IDs.
+func (iuo *ItemUpdateOne) AddMaintenanceScheduleIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.AddMaintenanceScheduleIDs(ids...)
+	return iuo
+}
+
+// AddMaintenanceSchedules adds the "maintenance_schedules" edges to the MaintenanceSchedule entity.
+func (iuo *ItemUpdateOne) AddMaintenanceSchedules(m ...*MaintenanceSchedule) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return iuo.AddMaintenanceScheduleIDs(ids...)
+}
+
 // AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
 func (iuo *ItemUpdateOne) AddAttachmentIDs(ids ...uuid.UUID) *ItemUpdateOne {
 	iuo.mutation.AddAttachmentIDs(ids...)
@@ -1880,6 +2023,27 @@ func (iuo *ItemUpdateOne) RemoveMaintenanceEntries(m ...*MaintenanceEntry) *Item
 	return iuo.RemoveMaintenanceEntryIDs(ids...)
 }
 
+// ClearMaintenanceSchedules clears all "maintenance_schedules" edges to the MaintenanceSchedule entity.
+func (iuo *ItemUpdateOne) ClearMaintenanceSchedules() *ItemUpdateOne {
+	iuo.mutation.ClearMaintenanceSchedules()
+	return iuo
+}
+
+// RemoveMaintenanceScheduleIDs removes the "maintenance_schedules" edge to MaintenanceSchedule entities by IDs.
+func (iuo *ItemUpdateOne) RemoveMaintenanceScheduleIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.RemoveMaintenanceScheduleIDs(ids...)
+	return iuo
+}
+
+// RemoveMaintenanceSchedules removes "maintenance_schedules" edges to MaintenanceSchedule entities.
+func (iuo *ItemUpdateOne) RemoveMaintenanceSchedules(m ...*MaintenanceSchedule) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return iuo.RemoveMaintenanceScheduleIDs(ids...)
+}
+
 // ClearAttachments clears all "attachments" edges to the Attachment entity.
 func (iuo *ItemUpdateOne) ClearAttachments() *ItemUpdateOne {
 	iuo.mutation.ClearAttachments()
@@ -2044,6 +2208,12 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 	if iuo.mutation.DescriptionCleared() {
 		_spec.ClearField(item.FieldDescription, field.TypeString)
 	}
+	if value, ok := iuo.mutation.DeletedAt(); ok {
+		_spec.SetField(item.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(item.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := iuo.mutation.ImportRef(); ok {
 		_spec.SetField(item.FieldImportRef, field.TypeString, value)
 	}
@@ -2416,6 +2586,51 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if iuo.mutation.MaintenanceSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.MaintenanceSchedulesTable,
+			Columns: []string{item.MaintenanceSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedMaintenanceSchedulesIDs(); len(nodes) > 0 && !iuo.mutation.MaintenanceSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.MaintenanceSchedulesTable,
+			Columns: []string{item.MaintenanceSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.MaintenanceSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.MaintenanceSchedulesTable,
+			Columns: []string{item.MaintenanceSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if iuo.mutation.AttachmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
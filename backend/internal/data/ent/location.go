@@ -27,6 +27,8 @@ type Location struct {
 	Name string `json:"name,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LocationQuery when eager-loading is set.
 	Edges             LocationEdges `json:"edges"`
@@ -101,7 +103,7 @@ func (*Location) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case location.FieldName, location.FieldDescription:
 			values[i] = new(sql.NullString)
-		case location.FieldCreatedAt, location.FieldUpdatedAt:
+		case location.FieldCreatedAt, location.FieldUpdatedAt, location.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case location.FieldID:
 			values[i] = new(uuid.UUID)
@@ -154,6 +156,13 @@ func (l *Location) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				l.Description = value.String
 			}
+		case location.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				l.DeletedAt = new(time.Time)
+				*l.DeletedAt = value.Time
+			}
 		case location.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field group_locations", values[i])
@@ -235,6 +244,11 @@ func (l *Location) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(l.Description)
+	builder.WriteString(", ")
+	if v := l.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -71,6 +71,20 @@ func (lc *LocationCreate) SetNillableDescription(s *string) *LocationCreate {
 	return lc
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (lc *LocationCreate) SetDeletedAt(t time.Time) *LocationCreate {
+	lc.mutation.SetDeletedAt(t)
+	return lc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (lc *LocationCreate) SetNillableDeletedAt(t *time.Time) *LocationCreate {
+	if t != nil {
+		lc.SetDeletedAt(*t)
+	}
+	return lc
+}
+
 // SetID sets the "id" field.
 func (lc *LocationCreate) SetID(u uuid.UUID) *LocationCreate {
 	lc.mutation.SetID(u)
@@ -269,6 +283,10 @@ func (lc *LocationCreate) createSpec() (*Location, *sqlgraph.CreateSpec) {
 		_spec.SetField(location.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := lc.mutation.DeletedAt(); ok {
+		_spec.SetField(location.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := lc.mutation.GroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
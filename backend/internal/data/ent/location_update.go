@@ -71,6 +71,26 @@ func (lu *LocationUpdate) ClearDescription() *LocationUpdate {
 	return lu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (lu *LocationUpdate) SetDeletedAt(t time.Time) *LocationUpdate {
+	lu.mutation.SetDeletedAt(t)
+	return lu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (lu *LocationUpdate) SetNillableDeletedAt(t *time.Time) *LocationUpdate {
+	if t != nil {
+		lu.SetDeletedAt(*t)
+	}
+	return lu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (lu *LocationUpdate) ClearDeletedAt() *LocationUpdate {
+	lu.mutation.ClearDeletedAt()
+	return lu
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (lu *LocationUpdate) SetGroupID(id uuid.UUID) *LocationUpdate {
 	lu.mutation.SetGroupID(id)
@@ -268,6 +288,12 @@ func (lu *LocationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if lu.mutation.DescriptionCleared() {
 		_spec.ClearField(location.FieldDescription, field.TypeString)
 	}
+	if value, ok := lu.mutation.DeletedAt(); ok {
+		_spec.SetField(location.FieldDeletedAt, field.TypeTime, value)
+	}
+	if lu.mutation.DeletedAtCleared() {
+		_spec.ClearField(location.FieldDeletedAt, field.TypeTime)
+	}
 	if lu.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -476,6 +502,26 @@ func (luo *LocationUpdateOne) ClearDescription() *LocationUpdateOne {
 	return luo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (luo *LocationUpdateOne) SetDeletedAt(t time.Time) *LocationUpdateOne {
+	luo.mutation.SetDeletedAt(t)
+	return luo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (luo *LocationUpdateOne) SetNillableDeletedAt(t *time.Time) *LocationUpdateOne {
+	if t != nil {
+		luo.SetDeletedAt(*t)
+	}
+	return luo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (luo *LocationUpdateOne) ClearDeletedAt() *LocationUpdateOne {
+	luo.mutation.ClearDeletedAt()
+	return luo
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (luo *LocationUpdateOne) SetGroupID(id uuid.UUID) *LocationUpdateOne {
 	luo.mutation.SetGroupID(id)
@@ -703,6 +749,12 @@ func (luo *LocationUpdateOne) sqlSave(ctx context.Context) (_node *Location, err
 	if luo.mutation.DescriptionCleared() {
 		_spec.ClearField(location.FieldDescription, field.TypeString)
 	}
+	if value, ok := luo.mutation.DeletedAt(); ok {
+		_spec.SetField(location.FieldDeletedAt, field.TypeTime, value)
+	}
+	if luo.mutation.DeletedAtCleared() {
+		_spec.ClearField(location.FieldDeletedAt, field.TypeTime)
+	}
 	if luo.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
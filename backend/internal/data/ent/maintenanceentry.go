@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
 )
 
 // MaintenanceEntry is the model entity for the MaintenanceEntry schema.
@@ -35,6 +36,8 @@ type MaintenanceEntry struct {
 	Description string `json:"description,omitempty"`
 	// Cost holds the value of the "cost" field.
 	Cost float64 `json:"cost,omitempty"`
+	// ScheduleID holds the value of the "schedule_id" field.
+	ScheduleID *uuid.UUID `json:"schedule_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MaintenanceEntryQuery when eager-loading is set.
 	Edges        MaintenanceEntryEdges `json:"edges"`
@@ -45,9 +48,11 @@ type MaintenanceEntry struct {
 type MaintenanceEntryEdges struct {
 	// Item holds the value of the item edge.
 	Item *Item `json:"item,omitempty"`
+	// Schedule holds the value of the schedule edge.
+	Schedule *MaintenanceSchedule `json:"schedule,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // ItemOrErr returns the Item value or an error if the edge
@@ -63,11 +68,26 @@ func (e MaintenanceEntryEdges) ItemOrErr() (*Item, error) {
 	return nil, &NotLoadedError{edge: "item"}
 }
 
+// ScheduleOrErr returns the Schedule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MaintenanceEntryEdges) ScheduleOrErr() (*MaintenanceSchedule, error) {
+	if e.loadedTypes[1] {
+		if e.Schedule == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: maintenanceschedule.Label}
+		}
+		return e.Schedule, nil
+	}
+	return nil, &NotLoadedError{edge: "schedule"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*MaintenanceEntry) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case maintenanceentry.FieldScheduleID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case maintenanceentry.FieldCost:
 			values[i] = new(sql.NullFloat64)
 		case maintenanceentry.FieldName, maintenanceentry.FieldDescription:
@@ -145,6 +165,13 @@ func (me *MaintenanceEntry) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				me.Cost = value.Float64
 			}
+		case maintenanceentry.FieldScheduleID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_id", values[i])
+			} else if value.Valid {
+				me.ScheduleID = new(uuid.UUID)
+				*me.ScheduleID = *value.S.(*uuid.UUID)
+			}
 		default:
 			me.selectValues.Set(columns[i], values[i])
 		}
@@ -163,6 +190,11 @@ func (me *MaintenanceEntry) QueryItem() *ItemQuery {
 	return NewMaintenanceEntryClient(me.config).QueryItem(me)
 }
 
+// QuerySchedule queries the "schedule" edge of the MaintenanceEntry entity.
+func (me *MaintenanceEntry) QuerySchedule() *MaintenanceScheduleQuery {
+	return NewMaintenanceEntryClient(me.config).QuerySchedule(me)
+}
+
 // Update returns a builder for updating this MaintenanceEntry.
 // Note that you need to call MaintenanceEntry.Unwrap() before calling this method if this MaintenanceEntry
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -209,6 +241,11 @@ func (me *MaintenanceEntry) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("cost=")
 	builder.WriteString(fmt.Sprintf("%v", me.Cost))
+	builder.WriteString(", ")
+	if v := me.ScheduleID; v != nil {
+		builder.WriteString("schedule_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
 )
 
 // MaintenanceEntryCreate is the builder for creating a MaintenanceEntry entity.
@@ -118,6 +119,20 @@ func (mec *MaintenanceEntryCreate) SetNillableCost(f *float64) *MaintenanceEntry
 	return mec
 }
 
+// SetScheduleID sets the "schedule_id" field.
+func (mec *MaintenanceEntryCreate) SetScheduleID(u uuid.UUID) *MaintenanceEntryCreate {
+	mec.mutation.SetScheduleID(u)
+	return mec
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (mec *MaintenanceEntryCreate) SetNillableScheduleID(u *uuid.UUID) *MaintenanceEntryCreate {
+	if u != nil {
+		mec.SetScheduleID(*u)
+	}
+	return mec
+}
+
 // SetID sets the "id" field.
 func (mec *MaintenanceEntryCreate) SetID(u uuid.UUID) *MaintenanceEntryCreate {
 	mec.mutation.SetID(u)
@@ -137,6 +152,11 @@ func (mec *MaintenanceEntryCreate) SetItem(i *Item) *MaintenanceEntryCreate {
 	return mec.SetItemID(i.ID)
 }
 
+// SetSchedule sets the "schedule" edge to the MaintenanceSchedule entity.
+func (mec *MaintenanceEntryCreate) SetSchedule(m *MaintenanceSchedule) *MaintenanceEntryCreate {
+	return mec.SetScheduleID(m.ID)
+}
+
 // Mutation returns the MaintenanceEntryMutation object of the builder.
 func (mec *MaintenanceEntryCreate) Mutation() *MaintenanceEntryMutation {
 	return mec.mutation
@@ -300,6 +320,23 @@ func (mec *MaintenanceEntryCreate) createSpec() (*MaintenanceEntry, *sqlgraph.Cr
 		_node.ItemID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := mec.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenanceentry.ScheduleTable,
+			Columns: []string{maintenanceentry.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ScheduleID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
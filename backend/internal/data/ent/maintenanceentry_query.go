@@ -13,17 +13,19 @@ import (
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
 )
 
 // MaintenanceEntryQuery is the builder for querying MaintenanceEntry entities.
 type MaintenanceEntryQuery struct {
 	config
-	ctx        *QueryContext
-	order      []maintenanceentry.OrderOption
-	inters     []Interceptor
-	predicates []predicate.MaintenanceEntry
-	withItem   *ItemQuery
+	ctx          *QueryContext
+	order        []maintenanceentry.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.MaintenanceEntry
+	withItem     *ItemQuery
+	withSchedule *MaintenanceScheduleQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +84,28 @@ func (meq *MaintenanceEntryQuery) QueryItem() *ItemQuery {
 	return query
 }
 
+// QuerySchedule chains the current query on the "schedule" edge.
+func (meq *MaintenanceEntryQuery) QuerySchedule() *MaintenanceScheduleQuery {
+	query := (&MaintenanceScheduleClient{config: meq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := meq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := meq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(maintenanceentry.Table, maintenanceentry.FieldID, selector),
+			sqlgraph.To(maintenanceschedule.Table, maintenanceschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, maintenanceentry.ScheduleTable, maintenanceentry.ScheduleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(meq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first MaintenanceEntry entity from the query.
 // Returns a *NotFoundError when no MaintenanceEntry was found.
 func (meq *MaintenanceEntryQuery) First(ctx context.Context) (*MaintenanceEntry, error) {
@@ -269,12 +293,13 @@ func (meq *MaintenanceEntryQuery) Clone() *MaintenanceEntryQuery {
 		return nil
 	}
 	return &MaintenanceEntryQuery{
-		config:     meq.config,
-		ctx:        meq.ctx.Clone(),
-		order:      append([]maintenanceentry.OrderOption{}, meq.order...),
-		inters:     append([]Interceptor{}, meq.inters...),
-		predicates: append([]predicate.MaintenanceEntry{}, meq.predicates...),
-		withItem:   meq.withItem.Clone(),
+		config:       meq.config,
+		ctx:          meq.ctx.Clone(),
+		order:        append([]maintenanceentry.OrderOption{}, meq.order...),
+		inters:       append([]Interceptor{}, meq.inters...),
+		predicates:   append([]predicate.MaintenanceEntry{}, meq.predicates...),
+		withItem:     meq.withItem.Clone(),
+		withSchedule: meq.withSchedule.Clone(),
 		// clone intermediate query.
 		sql:  meq.sql.Clone(),
 		path: meq.path,
@@ -292,6 +317,17 @@ func (meq *MaintenanceEntryQuery) WithItem(opts ...func(*ItemQuery)) *Maintenanc
 	return meq
 }
 
+// WithSchedule tells the query-builder to eager-load the nodes that are connected to
+// the "schedule" edge. The optional arguments are used to configure the query builder of the edge.
+func (meq *MaintenanceEntryQuery) WithSchedule(opts ...func(*MaintenanceScheduleQuery)) *MaintenanceEntryQuery {
+	query := (&MaintenanceScheduleClient{config: meq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	meq.withSchedule = query
+	return meq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -370,8 +406,9 @@ func (meq *MaintenanceEntryQuery) sqlAll(ctx context.Context, hooks ...queryHook
 	var (
 		nodes       = []*MaintenanceEntry{}
 		_spec       = meq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			meq.withItem != nil,
+			meq.withSchedule != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -398,6 +435,12 @@ func (meq *MaintenanceEntryQuery) sqlAll(ctx context.Context, hooks ...queryHook
 			return nil, err
 		}
 	}
+	if query := meq.withSchedule; query != nil {
+		if err := meq.loadSchedule(ctx, query, nodes, nil,
+			func(n *MaintenanceEntry, e *MaintenanceSchedule) { n.Edges.Schedule = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -430,6 +473,38 @@ func (meq *MaintenanceEntryQuery) loadItem(ctx context.Context, query *ItemQuery
 	}
 	return nil
 }
+func (meq *MaintenanceEntryQuery) loadSchedule(ctx context.Context, query *MaintenanceScheduleQuery, nodes []*MaintenanceEntry, init func(*MaintenanceEntry), assign func(*MaintenanceEntry, *MaintenanceSchedule)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*MaintenanceEntry)
+	for i := range nodes {
+		if nodes[i].ScheduleID == nil {
+			continue
+		}
+		fk := *nodes[i].ScheduleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(maintenanceschedule.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "schedule_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (meq *MaintenanceEntryQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := meq.querySpec()
@@ -459,6 +534,9 @@ func (meq *MaintenanceEntryQuery) querySpec() *sqlgraph.QuerySpec {
 		if meq.withItem != nil {
 			_spec.Node.AddColumnOnce(maintenanceentry.FieldItemID)
 		}
+		if meq.withSchedule != nil {
+			_spec.Node.AddColumnOnce(maintenanceentry.FieldScheduleID)
+		}
 	}
 	if ps := meq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -14,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
 )
 
@@ -145,11 +146,36 @@ func (meu *MaintenanceEntryUpdate) AddCost(f float64) *MaintenanceEntryUpdate {
 	return meu
 }
 
+// SetScheduleID sets the "schedule_id" field.
+func (meu *MaintenanceEntryUpdate) SetScheduleID(u uuid.UUID) *MaintenanceEntryUpdate {
+	meu.mutation.SetScheduleID(u)
+	return meu
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (meu *MaintenanceEntryUpdate) SetNillableScheduleID(u *uuid.UUID) *MaintenanceEntryUpdate {
+	if u != nil {
+		meu.SetScheduleID(*u)
+	}
+	return meu
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (meu *MaintenanceEntryUpdate) ClearScheduleID() *MaintenanceEntryUpdate {
+	meu.mutation.ClearScheduleID()
+	return meu
+}
+
 // SetItem sets the "item" edge to the Item entity.
 func (meu *MaintenanceEntryUpdate) SetItem(i *Item) *MaintenanceEntryUpdate {
 	return meu.SetItemID(i.ID)
 }
 
+// SetSchedule sets the "schedule" edge to the MaintenanceSchedule entity.
+func (meu *MaintenanceEntryUpdate) SetSchedule(m *MaintenanceSchedule) *MaintenanceEntryUpdate {
+	return meu.SetScheduleID(m.ID)
+}
+
 // Mutation returns the MaintenanceEntryMutation object of the builder.
 func (meu *MaintenanceEntryUpdate) Mutation() *MaintenanceEntryMutation {
 	return meu.mutation
@@ -161,6 +187,12 @@ func (meu *MaintenanceEntryUpdate) ClearItem() *MaintenanceEntryUpdate {
 	return meu
 }
 
+// ClearSchedule clears the "schedule" edge to the MaintenanceSchedule entity.
+func (meu *MaintenanceEntryUpdate) ClearSchedule() *MaintenanceEntryUpdate {
+	meu.mutation.ClearSchedule()
+	return meu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (meu *MaintenanceEntryUpdate) Save(ctx context.Context) (int, error) {
 	meu.defaults()
@@ -286,6 +318,35 @@ func (meu *MaintenanceEntryUpdate) sqlSave(ctx context.Context) (n int, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if meu.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenanceentry.ScheduleTable,
+			Columns: []string{maintenanceentry.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := meu.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenanceentry.ScheduleTable,
+			Columns: []string{maintenanceentry.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, meu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{maintenanceentry.Label}
@@ -421,11 +482,36 @@ func (meuo *MaintenanceEntryUpdateOne) AddCost(f float64) *MaintenanceEntryUpdat
 	return meuo
 }
 
+// SetScheduleID sets the "schedule_id" field.
+func (meuo *MaintenanceEntryUpdateOne) SetScheduleID(u uuid.UUID) *MaintenanceEntryUpdateOne {
+	meuo.mutation.SetScheduleID(u)
+	return meuo
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (meuo *MaintenanceEntryUpdateOne) SetNillableScheduleID(u *uuid.UUID) *MaintenanceEntryUpdateOne {
+	if u != nil {
+		meuo.SetScheduleID(*u)
+	}
+	return meuo
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (meuo *MaintenanceEntryUpdateOne) ClearScheduleID() *MaintenanceEntryUpdateOne {
+	meuo.mutation.ClearScheduleID()
+	return meuo
+}
+
 // SetItem sets the "item" edge to the Item entity.
 func (meuo *MaintenanceEntryUpdateOne) SetItem(i *Item) *MaintenanceEntryUpdateOne {
 	return meuo.SetItemID(i.ID)
 }
 
+// SetSchedule sets the "schedule" edge to the MaintenanceSchedule entity.
+func (meuo *MaintenanceEntryUpdateOne) SetSchedule(m *MaintenanceSchedule) *MaintenanceEntryUpdateOne {
+	return meuo.SetScheduleID(m.ID)
+}
+
 // Mutation returns the MaintenanceEntryMutation object of the builder.
 func (meuo *MaintenanceEntryUpdateOne) Mutation() *MaintenanceEntryMutation {
 	return meuo.mutation
@@ -437,6 +523,12 @@ func (meuo *MaintenanceEntryUpdateOne) ClearItem() *MaintenanceEntryUpdateOne {
 	return meuo
 }
 
+// ClearSchedule clears the "schedule" edge to the MaintenanceSchedule entity.
+func (meuo *MaintenanceEntryUpdateOne) ClearSchedule() *MaintenanceEntryUpdateOne {
+	meuo.mutation.ClearSchedule()
+	return meuo
+}
+
 // Where appends a list predicates to the MaintenanceEntryUpdate builder.
 func (meuo *MaintenanceEntryUpdateOne) Where(ps ...predicate.MaintenanceEntry) *MaintenanceEntryUpdateOne {
 	meuo.mutation.Where(ps...)
@@ -592,6 +684,35 @@ func (meuo *MaintenanceEntryUpdateOne) sqlSave(ctx context.Context) (_node *Main
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if meuo.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenanceentry.ScheduleTable,
+			Columns: []string{maintenanceentry.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := meuo.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenanceentry.ScheduleTable,
+			Columns: []string{maintenanceentry.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &MaintenanceEntry{config: meuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
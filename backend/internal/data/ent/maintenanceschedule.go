@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
+)
+
+// MaintenanceSchedule is the model entity for the MaintenanceSchedule schema.
+type MaintenanceSchedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID uuid.UUID `json:"item_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Rule holds the value of the "rule" field.
+	Rule string `json:"rule,omitempty"`
+	// NotifyDaysBefore holds the value of the "notify_days_before" field.
+	NotifyDaysBefore int `json:"notify_days_before,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MaintenanceScheduleQuery when eager-loading is set.
+	Edges        MaintenanceScheduleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MaintenanceScheduleEdges holds the relations/edges for other nodes in the graph.
+type MaintenanceScheduleEdges struct {
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// Entries holds the value of the entries edge.
+	Entries []*MaintenanceEntry `json:"entries,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MaintenanceScheduleEdges) ItemOrErr() (*Item, error) {
+	if e.loadedTypes[0] {
+		if e.Item == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: item.Label}
+		}
+		return e.Item, nil
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// EntriesOrErr returns the Entries value or an error if the edge
+// was not loaded in eager-loading.
+func (e MaintenanceScheduleEdges) EntriesOrErr() ([]*MaintenanceEntry, error) {
+	if e.loadedTypes[1] {
+		return e.Entries, nil
+	}
+	return nil, &NotLoadedError{edge: "entries"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MaintenanceSchedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case maintenanceschedule.FieldNotifyDaysBefore:
+			values[i] = new(sql.NullInt64)
+		case maintenanceschedule.FieldName, maintenanceschedule.FieldDescription, maintenanceschedule.FieldRule:
+			values[i] = new(sql.NullString)
+		case maintenanceschedule.FieldCreatedAt, maintenanceschedule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case maintenanceschedule.FieldID, maintenanceschedule.FieldItemID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MaintenanceSchedule fields.
+func (ms *MaintenanceSchedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case maintenanceschedule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ms.ID = *value
+			}
+		case maintenanceschedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ms.CreatedAt = value.Time
+			}
+		case maintenanceschedule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ms.UpdatedAt = value.Time
+			}
+		case maintenanceschedule.FieldItemID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value != nil {
+				ms.ItemID = *value
+			}
+		case maintenanceschedule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ms.Name = value.String
+			}
+		case maintenanceschedule.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				ms.Description = value.String
+			}
+		case maintenanceschedule.FieldRule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule", values[i])
+			} else if value.Valid {
+				ms.Rule = value.String
+			}
+		case maintenanceschedule.FieldNotifyDaysBefore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field notify_days_before", values[i])
+			} else if value.Valid {
+				ms.NotifyDaysBefore = int(value.Int64)
+			}
+		default:
+			ms.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MaintenanceSchedule.
+// This includes values selected through modifiers, order, etc.
+func (ms *MaintenanceSchedule) Value(name string) (ent.Value, error) {
+	return ms.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the MaintenanceSchedule entity.
+func (ms *MaintenanceSchedule) QueryItem() *ItemQuery {
+	return NewMaintenanceScheduleClient(ms.config).QueryItem(ms)
+}
+
+// QueryEntries queries the "entries" edge of the MaintenanceSchedule entity.
+func (ms *MaintenanceSchedule) QueryEntries() *MaintenanceEntryQuery {
+	return NewMaintenanceScheduleClient(ms.config).QueryEntries(ms)
+}
+
+// Update returns a builder for updating this MaintenanceSchedule.
+// Note that you need to call MaintenanceSchedule.Unwrap() before calling this method if this MaintenanceSchedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ms *MaintenanceSchedule) Update() *MaintenanceScheduleUpdateOne {
+	return NewMaintenanceScheduleClient(ms.config).UpdateOne(ms)
+}
+
+// Unwrap unwraps the MaintenanceSchedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ms *MaintenanceSchedule) Unwrap() *MaintenanceSchedule {
+	_tx, ok := ms.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MaintenanceSchedule is not a transactional entity")
+	}
+	ms.config.driver = _tx.drv
+	return ms
+}
+
+// String implements the fmt.Stringer.
+func (ms *MaintenanceSchedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("MaintenanceSchedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ms.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ms.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ms.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", ms.ItemID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ms.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(ms.Description)
+	builder.WriteString(", ")
+	builder.WriteString("rule=")
+	builder.WriteString(ms.Rule)
+	builder.WriteString(", ")
+	builder.WriteString("notify_days_before=")
+	builder.WriteString(fmt.Sprintf("%v", ms.NotifyDaysBefore))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MaintenanceSchedules is a parsable slice of MaintenanceSchedule.
+type MaintenanceSchedules []*MaintenanceSchedule
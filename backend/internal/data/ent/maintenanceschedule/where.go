@@ -0,0 +1,498 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maintenanceschedule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v uuid.UUID) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEQ(FieldItemID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEQ(FieldDescription, v))
+}
+
+// Rule applies equality check predicate on the "rule" field. It's identical to RuleEQ.
+func Rule(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEQ(FieldRule, v))
+}
+
+// NotifyDaysBefore applies equality check predicate on the "notify_days_before" field. It's identical to NotifyDaysBeforeEQ.
+func NotifyDaysBefore(v int) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEQ(FieldNotifyDaysBefore, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v uuid.UUID) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v uuid.UUID) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...uuid.UUID) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...uuid.UUID) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// RuleEQ applies the EQ predicate on the "rule" field.
+func RuleEQ(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEQ(FieldRule, v))
+}
+
+// RuleNEQ applies the NEQ predicate on the "rule" field.
+func RuleNEQ(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldNEQ(FieldRule, v))
+}
+
+// RuleIn applies the In predicate on the "rule" field.
+func RuleIn(vs ...string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldIn(FieldRule, vs...))
+}
+
+// RuleNotIn applies the NotIn predicate on the "rule" field.
+func RuleNotIn(vs ...string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldNotIn(FieldRule, vs...))
+}
+
+// RuleGT applies the GT predicate on the "rule" field.
+func RuleGT(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldGT(FieldRule, v))
+}
+
+// RuleGTE applies the GTE predicate on the "rule" field.
+func RuleGTE(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldGTE(FieldRule, v))
+}
+
+// RuleLT applies the LT predicate on the "rule" field.
+func RuleLT(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldLT(FieldRule, v))
+}
+
+// RuleLTE applies the LTE predicate on the "rule" field.
+func RuleLTE(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldLTE(FieldRule, v))
+}
+
+// RuleContains applies the Contains predicate on the "rule" field.
+func RuleContains(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldContains(FieldRule, v))
+}
+
+// RuleHasPrefix applies the HasPrefix predicate on the "rule" field.
+func RuleHasPrefix(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldHasPrefix(FieldRule, v))
+}
+
+// RuleHasSuffix applies the HasSuffix predicate on the "rule" field.
+func RuleHasSuffix(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldHasSuffix(FieldRule, v))
+}
+
+// RuleEqualFold applies the EqualFold predicate on the "rule" field.
+func RuleEqualFold(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEqualFold(FieldRule, v))
+}
+
+// RuleContainsFold applies the ContainsFold predicate on the "rule" field.
+func RuleContainsFold(v string) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldContainsFold(FieldRule, v))
+}
+
+// NotifyDaysBeforeEQ applies the EQ predicate on the "notify_days_before" field.
+func NotifyDaysBeforeEQ(v int) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldEQ(FieldNotifyDaysBefore, v))
+}
+
+// NotifyDaysBeforeNEQ applies the NEQ predicate on the "notify_days_before" field.
+func NotifyDaysBeforeNEQ(v int) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldNEQ(FieldNotifyDaysBefore, v))
+}
+
+// NotifyDaysBeforeIn applies the In predicate on the "notify_days_before" field.
+func NotifyDaysBeforeIn(vs ...int) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldIn(FieldNotifyDaysBefore, vs...))
+}
+
+// NotifyDaysBeforeNotIn applies the NotIn predicate on the "notify_days_before" field.
+func NotifyDaysBeforeNotIn(vs ...int) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldNotIn(FieldNotifyDaysBefore, vs...))
+}
+
+// NotifyDaysBeforeGT applies the GT predicate on the "notify_days_before" field.
+func NotifyDaysBeforeGT(v int) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldGT(FieldNotifyDaysBefore, v))
+}
+
+// NotifyDaysBeforeGTE applies the GTE predicate on the "notify_days_before" field.
+func NotifyDaysBeforeGTE(v int) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldGTE(FieldNotifyDaysBefore, v))
+}
+
+// NotifyDaysBeforeLT applies the LT predicate on the "notify_days_before" field.
+func NotifyDaysBeforeLT(v int) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldLT(FieldNotifyDaysBefore, v))
+}
+
+// NotifyDaysBeforeLTE applies the LTE predicate on the "notify_days_before" field.
+func NotifyDaysBeforeLTE(v int) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.FieldLTE(FieldNotifyDaysBefore, v))
+}
+
+// HasItem applies the HasEdge predicate on the "item" edge.
+func HasItem() predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ItemTable, ItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemWith applies the HasEdge predicate on the "item" edge with a given conditions (other predicates).
+func HasItemWith(preds ...predicate.Item) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(func(s *sql.Selector) {
+		step := newItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEntries applies the HasEdge predicate on the "entries" edge.
+func HasEntries() predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EntriesTable, EntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEntriesWith applies the HasEdge predicate on the "entries" edge with a given conditions (other predicates).
+func HasEntriesWith(preds ...predicate.MaintenanceEntry) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(func(s *sql.Selector) {
+		step := newEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MaintenanceSchedule) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MaintenanceSchedule) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MaintenanceSchedule) predicate.MaintenanceSchedule {
+	return predicate.MaintenanceSchedule(sql.NotPredicates(p))
+}
@@ -0,0 +1,407 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
+)
+
+// MaintenanceScheduleCreate is the builder for creating a MaintenanceSchedule entity.
+type MaintenanceScheduleCreate struct {
+	config
+	mutation *MaintenanceScheduleMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (msc *MaintenanceScheduleCreate) SetCreatedAt(t time.Time) *MaintenanceScheduleCreate {
+	msc.mutation.SetCreatedAt(t)
+	return msc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (msc *MaintenanceScheduleCreate) SetNillableCreatedAt(t *time.Time) *MaintenanceScheduleCreate {
+	if t != nil {
+		msc.SetCreatedAt(*t)
+	}
+	return msc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (msc *MaintenanceScheduleCreate) SetUpdatedAt(t time.Time) *MaintenanceScheduleCreate {
+	msc.mutation.SetUpdatedAt(t)
+	return msc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (msc *MaintenanceScheduleCreate) SetNillableUpdatedAt(t *time.Time) *MaintenanceScheduleCreate {
+	if t != nil {
+		msc.SetUpdatedAt(*t)
+	}
+	return msc
+}
+
+// SetItemID sets the "item_id" field.
+func (msc *MaintenanceScheduleCreate) SetItemID(u uuid.UUID) *MaintenanceScheduleCreate {
+	msc.mutation.SetItemID(u)
+	return msc
+}
+
+// SetName sets the "name" field.
+func (msc *MaintenanceScheduleCreate) SetName(s string) *MaintenanceScheduleCreate {
+	msc.mutation.SetName(s)
+	return msc
+}
+
+// SetDescription sets the "description" field.
+func (msc *MaintenanceScheduleCreate) SetDescription(s string) *MaintenanceScheduleCreate {
+	msc.mutation.SetDescription(s)
+	return msc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (msc *MaintenanceScheduleCreate) SetNillableDescription(s *string) *MaintenanceScheduleCreate {
+	if s != nil {
+		msc.SetDescription(*s)
+	}
+	return msc
+}
+
+// SetRule sets the "rule" field.
+func (msc *MaintenanceScheduleCreate) SetRule(s string) *MaintenanceScheduleCreate {
+	msc.mutation.SetRule(s)
+	return msc
+}
+
+// SetNotifyDaysBefore sets the "notify_days_before" field.
+func (msc *MaintenanceScheduleCreate) SetNotifyDaysBefore(i int) *MaintenanceScheduleCreate {
+	msc.mutation.SetNotifyDaysBefore(i)
+	return msc
+}
+
+// SetNillableNotifyDaysBefore sets the "notify_days_before" field if the given value is not nil.
+func (msc *MaintenanceScheduleCreate) SetNillableNotifyDaysBefore(i *int) *MaintenanceScheduleCreate {
+	if i != nil {
+		msc.SetNotifyDaysBefore(*i)
+	}
+	return msc
+}
+
+// SetID sets the "id" field.
+func (msc *MaintenanceScheduleCreate) SetID(u uuid.UUID) *MaintenanceScheduleCreate {
+	msc.mutation.SetID(u)
+	return msc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (msc *MaintenanceScheduleCreate) SetNillableID(u *uuid.UUID) *MaintenanceScheduleCreate {
+	if u != nil {
+		msc.SetID(*u)
+	}
+	return msc
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (msc *MaintenanceScheduleCreate) SetItem(i *Item) *MaintenanceScheduleCreate {
+	return msc.SetItemID(i.ID)
+}
+
+// AddEntryIDs adds the "entries" edge to the MaintenanceEntry entity by IDs.
+func (msc *MaintenanceScheduleCreate) AddEntryIDs(ids ...uuid.UUID) *MaintenanceScheduleCreate {
+	msc.mutation.AddEntryIDs(ids...)
+	return msc
+}
+
+// AddEntries adds the "entries" edges to the MaintenanceEntry entity.
+func (msc *MaintenanceScheduleCreate) AddEntries(m ...*MaintenanceEntry) *MaintenanceScheduleCreate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return msc.AddEntryIDs(ids...)
+}
+
+// Mutation returns the MaintenanceScheduleMutation object of the builder.
+func (msc *MaintenanceScheduleCreate) Mutation() *MaintenanceScheduleMutation {
+	return msc.mutation
+}
+
+// Save creates the MaintenanceSchedule in the database.
+func (msc *MaintenanceScheduleCreate) Save(ctx context.Context) (*MaintenanceSchedule, error) {
+	msc.defaults()
+	return withHooks(ctx, msc.sqlSave, msc.mutation, msc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (msc *MaintenanceScheduleCreate) SaveX(ctx context.Context) *MaintenanceSchedule {
+	v, err := msc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (msc *MaintenanceScheduleCreate) Exec(ctx context.Context) error {
+	_, err := msc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msc *MaintenanceScheduleCreate) ExecX(ctx context.Context) {
+	if err := msc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (msc *MaintenanceScheduleCreate) defaults() {
+	if _, ok := msc.mutation.CreatedAt(); !ok {
+		v := maintenanceschedule.DefaultCreatedAt()
+		msc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := msc.mutation.UpdatedAt(); !ok {
+		v := maintenanceschedule.DefaultUpdatedAt()
+		msc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := msc.mutation.NotifyDaysBefore(); !ok {
+		v := maintenanceschedule.DefaultNotifyDaysBefore
+		msc.mutation.SetNotifyDaysBefore(v)
+	}
+	if _, ok := msc.mutation.ID(); !ok {
+		v := maintenanceschedule.DefaultID()
+		msc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (msc *MaintenanceScheduleCreate) check() error {
+	if _, ok := msc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MaintenanceSchedule.created_at"`)}
+	}
+	if _, ok := msc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MaintenanceSchedule.updated_at"`)}
+	}
+	if _, ok := msc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "MaintenanceSchedule.item_id"`)}
+	}
+	if _, ok := msc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "MaintenanceSchedule.name"`)}
+	}
+	if v, ok := msc.mutation.Name(); ok {
+		if err := maintenanceschedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MaintenanceSchedule.name": %w`, err)}
+		}
+	}
+	if v, ok := msc.mutation.Description(); ok {
+		if err := maintenanceschedule.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "MaintenanceSchedule.description": %w`, err)}
+		}
+	}
+	if _, ok := msc.mutation.Rule(); !ok {
+		return &ValidationError{Name: "rule", err: errors.New(`ent: missing required field "MaintenanceSchedule.rule"`)}
+	}
+	if v, ok := msc.mutation.Rule(); ok {
+		if err := maintenanceschedule.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`ent: validator failed for field "MaintenanceSchedule.rule": %w`, err)}
+		}
+	}
+	if _, ok := msc.mutation.NotifyDaysBefore(); !ok {
+		return &ValidationError{Name: "notify_days_before", err: errors.New(`ent: missing required field "MaintenanceSchedule.notify_days_before"`)}
+	}
+	if v, ok := msc.mutation.NotifyDaysBefore(); ok {
+		if err := maintenanceschedule.NotifyDaysBeforeValidator(v); err != nil {
+			return &ValidationError{Name: "notify_days_before", err: fmt.Errorf(`ent: validator failed for field "MaintenanceSchedule.notify_days_before": %w`, err)}
+		}
+	}
+	if _, ok := msc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "MaintenanceSchedule.item"`)}
+	}
+	return nil
+}
+
+func (msc *MaintenanceScheduleCreate) sqlSave(ctx context.Context) (*MaintenanceSchedule, error) {
+	if err := msc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := msc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, msc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	msc.mutation.id = &_node.ID
+	msc.mutation.done = true
+	return _node, nil
+}
+
+func (msc *MaintenanceScheduleCreate) createSpec() (*MaintenanceSchedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MaintenanceSchedule{config: msc.config}
+		_spec = sqlgraph.NewCreateSpec(maintenanceschedule.Table, sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeUUID))
+	)
+	if id, ok := msc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := msc.mutation.CreatedAt(); ok {
+		_spec.SetField(maintenanceschedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := msc.mutation.UpdatedAt(); ok {
+		_spec.SetField(maintenanceschedule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := msc.mutation.Name(); ok {
+		_spec.SetField(maintenanceschedule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := msc.mutation.Description(); ok {
+		_spec.SetField(maintenanceschedule.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := msc.mutation.Rule(); ok {
+		_spec.SetField(maintenanceschedule.FieldRule, field.TypeString, value)
+		_node.Rule = value
+	}
+	if value, ok := msc.mutation.NotifyDaysBefore(); ok {
+		_spec.SetField(maintenanceschedule.FieldNotifyDaysBefore, field.TypeInt, value)
+		_node.NotifyDaysBefore = value
+	}
+	if nodes := msc.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenanceschedule.ItemTable,
+			Columns: []string{maintenanceschedule.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := msc.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   maintenanceschedule.EntriesTable,
+			Columns: []string{maintenanceschedule.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MaintenanceScheduleCreateBulk is the builder for creating many MaintenanceSchedule entities in bulk.
+type MaintenanceScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*MaintenanceScheduleCreate
+}
+
+// Save creates the MaintenanceSchedule entities in the database.
+func (mscb *MaintenanceScheduleCreateBulk) Save(ctx context.Context) ([]*MaintenanceSchedule, error) {
+	if mscb.err != nil {
+		return nil, mscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mscb.builders))
+	nodes := make([]*MaintenanceSchedule, len(mscb.builders))
+	mutators := make([]Mutator, len(mscb.builders))
+	for i := range mscb.builders {
+		func(i int, root context.Context) {
+			builder := mscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MaintenanceScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mscb *MaintenanceScheduleCreateBulk) SaveX(ctx context.Context) []*MaintenanceSchedule {
+	v, err := mscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mscb *MaintenanceScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := mscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mscb *MaintenanceScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := mscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// MaintenanceScheduleDelete is the builder for deleting a MaintenanceSchedule entity.
+type MaintenanceScheduleDelete struct {
+	config
+	hooks    []Hook
+	mutation *MaintenanceScheduleMutation
+}
+
+// Where appends a list predicates to the MaintenanceScheduleDelete builder.
+func (msd *MaintenanceScheduleDelete) Where(ps ...predicate.MaintenanceSchedule) *MaintenanceScheduleDelete {
+	msd.mutation.Where(ps...)
+	return msd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (msd *MaintenanceScheduleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, msd.sqlExec, msd.mutation, msd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msd *MaintenanceScheduleDelete) ExecX(ctx context.Context) int {
+	n, err := msd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (msd *MaintenanceScheduleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(maintenanceschedule.Table, sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeUUID))
+	if ps := msd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, msd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	msd.mutation.done = true
+	return affected, err
+}
+
+// MaintenanceScheduleDeleteOne is the builder for deleting a single MaintenanceSchedule entity.
+type MaintenanceScheduleDeleteOne struct {
+	msd *MaintenanceScheduleDelete
+}
+
+// Where appends a list predicates to the MaintenanceScheduleDelete builder.
+func (msdo *MaintenanceScheduleDeleteOne) Where(ps ...predicate.MaintenanceSchedule) *MaintenanceScheduleDeleteOne {
+	msdo.msd.mutation.Where(ps...)
+	return msdo
+}
+
+// Exec executes the deletion query.
+func (msdo *MaintenanceScheduleDeleteOne) Exec(ctx context.Context) error {
+	n, err := msdo.msd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{maintenanceschedule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msdo *MaintenanceScheduleDeleteOne) ExecX(ctx context.Context) {
+	if err := msdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,684 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// MaintenanceScheduleQuery is the builder for querying MaintenanceSchedule entities.
+type MaintenanceScheduleQuery struct {
+	config
+	ctx         *QueryContext
+	order       []maintenanceschedule.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.MaintenanceSchedule
+	withItem    *ItemQuery
+	withEntries *MaintenanceEntryQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MaintenanceScheduleQuery builder.
+func (msq *MaintenanceScheduleQuery) Where(ps ...predicate.MaintenanceSchedule) *MaintenanceScheduleQuery {
+	msq.predicates = append(msq.predicates, ps...)
+	return msq
+}
+
+// Limit the number of records to be returned by this query.
+func (msq *MaintenanceScheduleQuery) Limit(limit int) *MaintenanceScheduleQuery {
+	msq.ctx.Limit = &limit
+	return msq
+}
+
+// Offset to start from.
+func (msq *MaintenanceScheduleQuery) Offset(offset int) *MaintenanceScheduleQuery {
+	msq.ctx.Offset = &offset
+	return msq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (msq *MaintenanceScheduleQuery) Unique(unique bool) *MaintenanceScheduleQuery {
+	msq.ctx.Unique = &unique
+	return msq
+}
+
+// Order specifies how the records should be ordered.
+func (msq *MaintenanceScheduleQuery) Order(o ...maintenanceschedule.OrderOption) *MaintenanceScheduleQuery {
+	msq.order = append(msq.order, o...)
+	return msq
+}
+
+// QueryItem chains the current query on the "item" edge.
+func (msq *MaintenanceScheduleQuery) QueryItem() *ItemQuery {
+	query := (&ItemClient{config: msq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := msq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := msq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(maintenanceschedule.Table, maintenanceschedule.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, maintenanceschedule.ItemTable, maintenanceschedule.ItemColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(msq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEntries chains the current query on the "entries" edge.
+func (msq *MaintenanceScheduleQuery) QueryEntries() *MaintenanceEntryQuery {
+	query := (&MaintenanceEntryClient{config: msq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := msq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := msq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(maintenanceschedule.Table, maintenanceschedule.FieldID, selector),
+			sqlgraph.To(maintenanceentry.Table, maintenanceentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, maintenanceschedule.EntriesTable, maintenanceschedule.EntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(msq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MaintenanceSchedule entity from the query.
+// Returns a *NotFoundError when no MaintenanceSchedule was found.
+func (msq *MaintenanceScheduleQuery) First(ctx context.Context) (*MaintenanceSchedule, error) {
+	nodes, err := msq.Limit(1).All(setContextOp(ctx, msq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{maintenanceschedule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (msq *MaintenanceScheduleQuery) FirstX(ctx context.Context) *MaintenanceSchedule {
+	node, err := msq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MaintenanceSchedule ID from the query.
+// Returns a *NotFoundError when no MaintenanceSchedule ID was found.
+func (msq *MaintenanceScheduleQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = msq.Limit(1).IDs(setContextOp(ctx, msq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{maintenanceschedule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (msq *MaintenanceScheduleQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := msq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MaintenanceSchedule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MaintenanceSchedule entity is found.
+// Returns a *NotFoundError when no MaintenanceSchedule entities are found.
+func (msq *MaintenanceScheduleQuery) Only(ctx context.Context) (*MaintenanceSchedule, error) {
+	nodes, err := msq.Limit(2).All(setContextOp(ctx, msq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{maintenanceschedule.Label}
+	default:
+		return nil, &NotSingularError{maintenanceschedule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (msq *MaintenanceScheduleQuery) OnlyX(ctx context.Context) *MaintenanceSchedule {
+	node, err := msq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MaintenanceSchedule ID in the query.
+// Returns a *NotSingularError when more than one MaintenanceSchedule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (msq *MaintenanceScheduleQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = msq.Limit(2).IDs(setContextOp(ctx, msq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{maintenanceschedule.Label}
+	default:
+		err = &NotSingularError{maintenanceschedule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (msq *MaintenanceScheduleQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := msq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MaintenanceSchedules.
+func (msq *MaintenanceScheduleQuery) All(ctx context.Context) ([]*MaintenanceSchedule, error) {
+	ctx = setContextOp(ctx, msq.ctx, "All")
+	if err := msq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MaintenanceSchedule, *MaintenanceScheduleQuery]()
+	return withInterceptors[[]*MaintenanceSchedule](ctx, msq, qr, msq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (msq *MaintenanceScheduleQuery) AllX(ctx context.Context) []*MaintenanceSchedule {
+	nodes, err := msq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MaintenanceSchedule IDs.
+func (msq *MaintenanceScheduleQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if msq.ctx.Unique == nil && msq.path != nil {
+		msq.Unique(true)
+	}
+	ctx = setContextOp(ctx, msq.ctx, "IDs")
+	if err = msq.Select(maintenanceschedule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (msq *MaintenanceScheduleQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := msq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (msq *MaintenanceScheduleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, msq.ctx, "Count")
+	if err := msq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, msq, querierCount[*MaintenanceScheduleQuery](), msq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (msq *MaintenanceScheduleQuery) CountX(ctx context.Context) int {
+	count, err := msq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (msq *MaintenanceScheduleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, msq.ctx, "Exist")
+	switch _, err := msq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (msq *MaintenanceScheduleQuery) ExistX(ctx context.Context) bool {
+	exist, err := msq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MaintenanceScheduleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (msq *MaintenanceScheduleQuery) Clone() *MaintenanceScheduleQuery {
+	if msq == nil {
+		return nil
+	}
+	return &MaintenanceScheduleQuery{
+		config:      msq.config,
+		ctx:         msq.ctx.Clone(),
+		order:       append([]maintenanceschedule.OrderOption{}, msq.order...),
+		inters:      append([]Interceptor{}, msq.inters...),
+		predicates:  append([]predicate.MaintenanceSchedule{}, msq.predicates...),
+		withItem:    msq.withItem.Clone(),
+		withEntries: msq.withEntries.Clone(),
+		// clone intermediate query.
+		sql:  msq.sql.Clone(),
+		path: msq.path,
+	}
+}
+
+// WithItem tells the query-builder to eager-load the nodes that are connected to
+// the "item" edge. The optional arguments are used to configure the query builder of the edge.
+func (msq *MaintenanceScheduleQuery) WithItem(opts ...func(*ItemQuery)) *MaintenanceScheduleQuery {
+	query := (&ItemClient{config: msq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	msq.withItem = query
+	return msq
+}
+
+// WithEntries tells the query-builder to eager-load the nodes that are connected to
+// the "entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (msq *MaintenanceScheduleQuery) WithEntries(opts ...func(*MaintenanceEntryQuery)) *MaintenanceScheduleQuery {
+	query := (&MaintenanceEntryClient{config: msq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	msq.withEntries = query
+	return msq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MaintenanceSchedule.Query().
+//		GroupBy(maintenanceschedule.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (msq *MaintenanceScheduleQuery) GroupBy(field string, fields ...string) *MaintenanceScheduleGroupBy {
+	msq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MaintenanceScheduleGroupBy{build: msq}
+	grbuild.flds = &msq.ctx.Fields
+	grbuild.label = maintenanceschedule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.MaintenanceSchedule.Query().
+//		Select(maintenanceschedule.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (msq *MaintenanceScheduleQuery) Select(fields ...string) *MaintenanceScheduleSelect {
+	msq.ctx.Fields = append(msq.ctx.Fields, fields...)
+	sbuild := &MaintenanceScheduleSelect{MaintenanceScheduleQuery: msq}
+	sbuild.label = maintenanceschedule.Label
+	sbuild.flds, sbuild.scan = &msq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MaintenanceScheduleSelect configured with the given aggregations.
+func (msq *MaintenanceScheduleQuery) Aggregate(fns ...AggregateFunc) *MaintenanceScheduleSelect {
+	return msq.Select().Aggregate(fns...)
+}
+
+func (msq *MaintenanceScheduleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range msq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, msq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range msq.ctx.Fields {
+		if !maintenanceschedule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if msq.path != nil {
+		prev, err := msq.path(ctx)
+		if err != nil {
+			return err
+		}
+		msq.sql = prev
+	}
+	return nil
+}
+
+func (msq *MaintenanceScheduleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MaintenanceSchedule, error) {
+	var (
+		nodes       = []*MaintenanceSchedule{}
+		_spec       = msq.querySpec()
+		loadedTypes = [2]bool{
+			msq.withItem != nil,
+			msq.withEntries != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MaintenanceSchedule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MaintenanceSchedule{config: msq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, msq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := msq.withItem; query != nil {
+		if err := msq.loadItem(ctx, query, nodes, nil,
+			func(n *MaintenanceSchedule, e *Item) { n.Edges.Item = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := msq.withEntries; query != nil {
+		if err := msq.loadEntries(ctx, query, nodes,
+			func(n *MaintenanceSchedule) { n.Edges.Entries = []*MaintenanceEntry{} },
+			func(n *MaintenanceSchedule, e *MaintenanceEntry) { n.Edges.Entries = append(n.Edges.Entries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (msq *MaintenanceScheduleQuery) loadItem(ctx context.Context, query *ItemQuery, nodes []*MaintenanceSchedule, init func(*MaintenanceSchedule), assign func(*MaintenanceSchedule, *Item)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*MaintenanceSchedule)
+	for i := range nodes {
+		fk := nodes[i].ItemID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(item.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "item_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (msq *MaintenanceScheduleQuery) loadEntries(ctx context.Context, query *MaintenanceEntryQuery, nodes []*MaintenanceSchedule, init func(*MaintenanceSchedule), assign func(*MaintenanceSchedule, *MaintenanceEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*MaintenanceSchedule)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(maintenanceentry.FieldScheduleID)
+	}
+	query.Where(predicate.MaintenanceEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(maintenanceschedule.EntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ScheduleID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "schedule_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "schedule_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (msq *MaintenanceScheduleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := msq.querySpec()
+	_spec.Node.Columns = msq.ctx.Fields
+	if len(msq.ctx.Fields) > 0 {
+		_spec.Unique = msq.ctx.Unique != nil && *msq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, msq.driver, _spec)
+}
+
+func (msq *MaintenanceScheduleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(maintenanceschedule.Table, maintenanceschedule.Columns, sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeUUID))
+	_spec.From = msq.sql
+	if unique := msq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if msq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := msq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, maintenanceschedule.FieldID)
+		for i := range fields {
+			if fields[i] != maintenanceschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if msq.withItem != nil {
+			_spec.Node.AddColumnOnce(maintenanceschedule.FieldItemID)
+		}
+	}
+	if ps := msq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := msq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := msq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := msq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (msq *MaintenanceScheduleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(msq.driver.Dialect())
+	t1 := builder.Table(maintenanceschedule.Table)
+	columns := msq.ctx.Fields
+	if len(columns) == 0 {
+		columns = maintenanceschedule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if msq.sql != nil {
+		selector = msq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if msq.ctx.Unique != nil && *msq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range msq.predicates {
+		p(selector)
+	}
+	for _, p := range msq.order {
+		p(selector)
+	}
+	if offset := msq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := msq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MaintenanceScheduleGroupBy is the group-by builder for MaintenanceSchedule entities.
+type MaintenanceScheduleGroupBy struct {
+	selector
+	build *MaintenanceScheduleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (msgb *MaintenanceScheduleGroupBy) Aggregate(fns ...AggregateFunc) *MaintenanceScheduleGroupBy {
+	msgb.fns = append(msgb.fns, fns...)
+	return msgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (msgb *MaintenanceScheduleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, msgb.build.ctx, "GroupBy")
+	if err := msgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MaintenanceScheduleQuery, *MaintenanceScheduleGroupBy](ctx, msgb.build, msgb, msgb.build.inters, v)
+}
+
+func (msgb *MaintenanceScheduleGroupBy) sqlScan(ctx context.Context, root *MaintenanceScheduleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(msgb.fns))
+	for _, fn := range msgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*msgb.flds)+len(msgb.fns))
+		for _, f := range *msgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*msgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := msgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MaintenanceScheduleSelect is the builder for selecting fields of MaintenanceSchedule entities.
+type MaintenanceScheduleSelect struct {
+	*MaintenanceScheduleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mss *MaintenanceScheduleSelect) Aggregate(fns ...AggregateFunc) *MaintenanceScheduleSelect {
+	mss.fns = append(mss.fns, fns...)
+	return mss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mss *MaintenanceScheduleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mss.ctx, "Select")
+	if err := mss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MaintenanceScheduleQuery, *MaintenanceScheduleSelect](ctx, mss.MaintenanceScheduleQuery, mss, mss.inters, v)
+}
+
+func (mss *MaintenanceScheduleSelect) sqlScan(ctx context.Context, root *MaintenanceScheduleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mss.fns))
+	for _, fn := range mss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,721 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// MaintenanceScheduleUpdate is the builder for updating MaintenanceSchedule entities.
+type MaintenanceScheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MaintenanceScheduleMutation
+}
+
+// Where appends a list predicates to the MaintenanceScheduleUpdate builder.
+func (msu *MaintenanceScheduleUpdate) Where(ps ...predicate.MaintenanceSchedule) *MaintenanceScheduleUpdate {
+	msu.mutation.Where(ps...)
+	return msu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (msu *MaintenanceScheduleUpdate) SetUpdatedAt(t time.Time) *MaintenanceScheduleUpdate {
+	msu.mutation.SetUpdatedAt(t)
+	return msu
+}
+
+// SetItemID sets the "item_id" field.
+func (msu *MaintenanceScheduleUpdate) SetItemID(u uuid.UUID) *MaintenanceScheduleUpdate {
+	msu.mutation.SetItemID(u)
+	return msu
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (msu *MaintenanceScheduleUpdate) SetNillableItemID(u *uuid.UUID) *MaintenanceScheduleUpdate {
+	if u != nil {
+		msu.SetItemID(*u)
+	}
+	return msu
+}
+
+// SetName sets the "name" field.
+func (msu *MaintenanceScheduleUpdate) SetName(s string) *MaintenanceScheduleUpdate {
+	msu.mutation.SetName(s)
+	return msu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (msu *MaintenanceScheduleUpdate) SetNillableName(s *string) *MaintenanceScheduleUpdate {
+	if s != nil {
+		msu.SetName(*s)
+	}
+	return msu
+}
+
+// SetDescription sets the "description" field.
+func (msu *MaintenanceScheduleUpdate) SetDescription(s string) *MaintenanceScheduleUpdate {
+	msu.mutation.SetDescription(s)
+	return msu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (msu *MaintenanceScheduleUpdate) SetNillableDescription(s *string) *MaintenanceScheduleUpdate {
+	if s != nil {
+		msu.SetDescription(*s)
+	}
+	return msu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (msu *MaintenanceScheduleUpdate) ClearDescription() *MaintenanceScheduleUpdate {
+	msu.mutation.ClearDescription()
+	return msu
+}
+
+// SetRule sets the "rule" field.
+func (msu *MaintenanceScheduleUpdate) SetRule(s string) *MaintenanceScheduleUpdate {
+	msu.mutation.SetRule(s)
+	return msu
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (msu *MaintenanceScheduleUpdate) SetNillableRule(s *string) *MaintenanceScheduleUpdate {
+	if s != nil {
+		msu.SetRule(*s)
+	}
+	return msu
+}
+
+// SetNotifyDaysBefore sets the "notify_days_before" field.
+func (msu *MaintenanceScheduleUpdate) SetNotifyDaysBefore(i int) *MaintenanceScheduleUpdate {
+	msu.mutation.ResetNotifyDaysBefore()
+	msu.mutation.SetNotifyDaysBefore(i)
+	return msu
+}
+
+// SetNillableNotifyDaysBefore sets the "notify_days_before" field if the given value is not nil.
+func (msu *MaintenanceScheduleUpdate) SetNillableNotifyDaysBefore(i *int) *MaintenanceScheduleUpdate {
+	if i != nil {
+		msu.SetNotifyDaysBefore(*i)
+	}
+	return msu
+}
+
+// AddNotifyDaysBefore adds i to the "notify_days_before" field.
+func (msu *MaintenanceScheduleUpdate) AddNotifyDaysBefore(i int) *MaintenanceScheduleUpdate {
+	msu.mutation.AddNotifyDaysBefore(i)
+	return msu
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (msu *MaintenanceScheduleUpdate) SetItem(i *Item) *MaintenanceScheduleUpdate {
+	return msu.SetItemID(i.ID)
+}
+
+// AddEntryIDs adds the "entries" edge to the MaintenanceEntry entity by IDs.
+func (msu *MaintenanceScheduleUpdate) AddEntryIDs(ids ...uuid.UUID) *MaintenanceScheduleUpdate {
+	msu.mutation.AddEntryIDs(ids...)
+	return msu
+}
+
+// AddEntries adds the "entries" edges to the MaintenanceEntry entity.
+func (msu *MaintenanceScheduleUpdate) AddEntries(m ...*MaintenanceEntry) *MaintenanceScheduleUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return msu.AddEntryIDs(ids...)
+}
+
+// Mutation returns the MaintenanceScheduleMutation object of the builder.
+func (msu *MaintenanceScheduleUpdate) Mutation() *MaintenanceScheduleMutation {
+	return msu.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (msu *MaintenanceScheduleUpdate) ClearItem() *MaintenanceScheduleUpdate {
+	msu.mutation.ClearItem()
+	return msu
+}
+
+// ClearEntries clears all "entries" edges to the MaintenanceEntry entity.
+func (msu *MaintenanceScheduleUpdate) ClearEntries() *MaintenanceScheduleUpdate {
+	msu.mutation.ClearEntries()
+	return msu
+}
+
+// RemoveEntryIDs removes the "entries" edge to MaintenanceEntry entities by IDs.
+func (msu *MaintenanceScheduleUpdate) RemoveEntryIDs(ids ...uuid.UUID) *MaintenanceScheduleUpdate {
+	msu.mutation.RemoveEntryIDs(ids...)
+	return msu
+}
+
+// RemoveEntries removes "entries" edges to MaintenanceEntry entities.
+func (msu *MaintenanceScheduleUpdate) RemoveEntries(m ...*MaintenanceEntry) *MaintenanceScheduleUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return msu.RemoveEntryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (msu *MaintenanceScheduleUpdate) Save(ctx context.Context) (int, error) {
+	msu.defaults()
+	return withHooks(ctx, msu.sqlSave, msu.mutation, msu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (msu *MaintenanceScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := msu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (msu *MaintenanceScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := msu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msu *MaintenanceScheduleUpdate) ExecX(ctx context.Context) {
+	if err := msu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (msu *MaintenanceScheduleUpdate) defaults() {
+	if _, ok := msu.mutation.UpdatedAt(); !ok {
+		v := maintenanceschedule.UpdateDefaultUpdatedAt()
+		msu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (msu *MaintenanceScheduleUpdate) check() error {
+	if v, ok := msu.mutation.Name(); ok {
+		if err := maintenanceschedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MaintenanceSchedule.name": %w`, err)}
+		}
+	}
+	if v, ok := msu.mutation.Description(); ok {
+		if err := maintenanceschedule.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "MaintenanceSchedule.description": %w`, err)}
+		}
+	}
+	if v, ok := msu.mutation.Rule(); ok {
+		if err := maintenanceschedule.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`ent: validator failed for field "MaintenanceSchedule.rule": %w`, err)}
+		}
+	}
+	if v, ok := msu.mutation.NotifyDaysBefore(); ok {
+		if err := maintenanceschedule.NotifyDaysBeforeValidator(v); err != nil {
+			return &ValidationError{Name: "notify_days_before", err: fmt.Errorf(`ent: validator failed for field "MaintenanceSchedule.notify_days_before": %w`, err)}
+		}
+	}
+	if _, ok := msu.mutation.ItemID(); msu.mutation.ItemCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MaintenanceSchedule.item"`)
+	}
+	return nil
+}
+
+func (msu *MaintenanceScheduleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := msu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(maintenanceschedule.Table, maintenanceschedule.Columns, sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeUUID))
+	if ps := msu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := msu.mutation.UpdatedAt(); ok {
+		_spec.SetField(maintenanceschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := msu.mutation.Name(); ok {
+		_spec.SetField(maintenanceschedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := msu.mutation.Description(); ok {
+		_spec.SetField(maintenanceschedule.FieldDescription, field.TypeString, value)
+	}
+	if msu.mutation.DescriptionCleared() {
+		_spec.ClearField(maintenanceschedule.FieldDescription, field.TypeString)
+	}
+	if value, ok := msu.mutation.Rule(); ok {
+		_spec.SetField(maintenanceschedule.FieldRule, field.TypeString, value)
+	}
+	if value, ok := msu.mutation.NotifyDaysBefore(); ok {
+		_spec.SetField(maintenanceschedule.FieldNotifyDaysBefore, field.TypeInt, value)
+	}
+	if value, ok := msu.mutation.AddedNotifyDaysBefore(); ok {
+		_spec.AddField(maintenanceschedule.FieldNotifyDaysBefore, field.TypeInt, value)
+	}
+	if msu.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenanceschedule.ItemTable,
+			Columns: []string{maintenanceschedule.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := msu.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenanceschedule.ItemTable,
+			Columns: []string{maintenanceschedule.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if msu.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   maintenanceschedule.EntriesTable,
+			Columns: []string{maintenanceschedule.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := msu.mutation.RemovedEntriesIDs(); len(nodes) > 0 && !msu.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   maintenanceschedule.EntriesTable,
+			Columns: []string{maintenanceschedule.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := msu.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   maintenanceschedule.EntriesTable,
+			Columns: []string{maintenanceschedule.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, msu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{maintenanceschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	msu.mutation.done = true
+	return n, nil
+}
+
+// MaintenanceScheduleUpdateOne is the builder for updating a single MaintenanceSchedule entity.
+type MaintenanceScheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MaintenanceScheduleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (msuo *MaintenanceScheduleUpdateOne) SetUpdatedAt(t time.Time) *MaintenanceScheduleUpdateOne {
+	msuo.mutation.SetUpdatedAt(t)
+	return msuo
+}
+
+// SetItemID sets the "item_id" field.
+func (msuo *MaintenanceScheduleUpdateOne) SetItemID(u uuid.UUID) *MaintenanceScheduleUpdateOne {
+	msuo.mutation.SetItemID(u)
+	return msuo
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (msuo *MaintenanceScheduleUpdateOne) SetNillableItemID(u *uuid.UUID) *MaintenanceScheduleUpdateOne {
+	if u != nil {
+		msuo.SetItemID(*u)
+	}
+	return msuo
+}
+
+// SetName sets the "name" field.
+func (msuo *MaintenanceScheduleUpdateOne) SetName(s string) *MaintenanceScheduleUpdateOne {
+	msuo.mutation.SetName(s)
+	return msuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (msuo *MaintenanceScheduleUpdateOne) SetNillableName(s *string) *MaintenanceScheduleUpdateOne {
+	if s != nil {
+		msuo.SetName(*s)
+	}
+	return msuo
+}
+
+// SetDescription sets the "description" field.
+func (msuo *MaintenanceScheduleUpdateOne) SetDescription(s string) *MaintenanceScheduleUpdateOne {
+	msuo.mutation.SetDescription(s)
+	return msuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (msuo *MaintenanceScheduleUpdateOne) SetNillableDescription(s *string) *MaintenanceScheduleUpdateOne {
+	if s != nil {
+		msuo.SetDescription(*s)
+	}
+	return msuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (msuo *MaintenanceScheduleUpdateOne) ClearDescription() *MaintenanceScheduleUpdateOne {
+	msuo.mutation.ClearDescription()
+	return msuo
+}
+
+// SetRule sets the "rule" field.
+func (msuo *MaintenanceScheduleUpdateOne) SetRule(s string) *MaintenanceScheduleUpdateOne {
+	msuo.mutation.SetRule(s)
+	return msuo
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (msuo *MaintenanceScheduleUpdateOne) SetNillableRule(s *string) *MaintenanceScheduleUpdateOne {
+	if s != nil {
+		msuo.SetRule(*s)
+	}
+	return msuo
+}
+
+// SetNotifyDaysBefore sets the "notify_days_before" field.
+func (msuo *MaintenanceScheduleUpdateOne) SetNotifyDaysBefore(i int) *MaintenanceScheduleUpdateOne {
+	msuo.mutation.ResetNotifyDaysBefore()
+	msuo.mutation.SetNotifyDaysBefore(i)
+	return msuo
+}
+
+// SetNillableNotifyDaysBefore sets the "notify_days_before" field if the given value is not nil.
+func (msuo *MaintenanceScheduleUpdateOne) SetNillableNotifyDaysBefore(i *int) *MaintenanceScheduleUpdateOne {
+	if i != nil {
+		msuo.SetNotifyDaysBefore(*i)
+	}
+	return msuo
+}
+
+// AddNotifyDaysBefore adds i to the "notify_days_before" field.
+func (msuo *MaintenanceScheduleUpdateOne) AddNotifyDaysBefore(i int) *MaintenanceScheduleUpdateOne {
+	msuo.mutation.AddNotifyDaysBefore(i)
+	return msuo
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (msuo *MaintenanceScheduleUpdateOne) SetItem(i *Item) *MaintenanceScheduleUpdateOne {
+	return msuo.SetItemID(i.ID)
+}
+
+// AddEntryIDs adds the "entries" edge to the MaintenanceEntry entity by IDs.
+func (msuo *MaintenanceScheduleUpdateOne) AddEntryIDs(ids ...uuid.UUID) *MaintenanceScheduleUpdateOne {
+	msuo.mutation.AddEntryIDs(ids...)
+	return msuo
+}
+
+// AddEntries adds the "entries" edges to the MaintenanceEntry entity.
+func (msuo *MaintenanceScheduleUpdateOne) AddEntries(m ...*MaintenanceEntry) *MaintenanceScheduleUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return msuo.AddEntryIDs(ids...)
+}
+
+// Mutation returns the MaintenanceScheduleMutation object of the builder.
+func (msuo *MaintenanceScheduleUpdateOne) Mutation() *MaintenanceScheduleMutation {
+	return msuo.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (msuo *MaintenanceScheduleUpdateOne) ClearItem() *MaintenanceScheduleUpdateOne {
+	msuo.mutation.ClearItem()
+	return msuo
+}
+
+// ClearEntries clears all "entries" edges to the MaintenanceEntry entity.
+func (msuo *MaintenanceScheduleUpdateOne) ClearEntries() *MaintenanceScheduleUpdateOne {
+	msuo.mutation.ClearEntries()
+	return msuo
+}
+
+// RemoveEntryIDs removes the "entries" edge to MaintenanceEntry entities by IDs.
+func (msuo *MaintenanceScheduleUpdateOne) RemoveEntryIDs(ids ...uuid.UUID) *MaintenanceScheduleUpdateOne {
+	msuo.mutation.RemoveEntryIDs(ids...)
+	return msuo
+}
+
+// RemoveEntries removes "entries" edges to MaintenanceEntry entities.
+func (msuo *MaintenanceScheduleUpdateOne) RemoveEntries(m ...*MaintenanceEntry) *MaintenanceScheduleUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return msuo.RemoveEntryIDs(ids...)
+}
+
+// Where appends a list predicates to the MaintenanceScheduleUpdate builder.
+func (msuo *MaintenanceScheduleUpdateOne) Where(ps ...predicate.MaintenanceSchedule) *MaintenanceScheduleUpdateOne {
+	msuo.mutation.Where(ps...)
+	return msuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (msuo *MaintenanceScheduleUpdateOne) Select(field string, fields ...string) *MaintenanceScheduleUpdateOne {
+	msuo.fields = append([]string{field}, fields...)
+	return msuo
+}
+
+// Save executes the query and returns the updated MaintenanceSchedule entity.
+func (msuo *MaintenanceScheduleUpdateOne) Save(ctx context.Context) (*MaintenanceSchedule, error) {
+	msuo.defaults()
+	return withHooks(ctx, msuo.sqlSave, msuo.mutation, msuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (msuo *MaintenanceScheduleUpdateOne) SaveX(ctx context.Context) *MaintenanceSchedule {
+	node, err := msuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (msuo *MaintenanceScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := msuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (msuo *MaintenanceScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := msuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (msuo *MaintenanceScheduleUpdateOne) defaults() {
+	if _, ok := msuo.mutation.UpdatedAt(); !ok {
+		v := maintenanceschedule.UpdateDefaultUpdatedAt()
+		msuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (msuo *MaintenanceScheduleUpdateOne) check() error {
+	if v, ok := msuo.mutation.Name(); ok {
+		if err := maintenanceschedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "MaintenanceSchedule.name": %w`, err)}
+		}
+	}
+	if v, ok := msuo.mutation.Description(); ok {
+		if err := maintenanceschedule.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "MaintenanceSchedule.description": %w`, err)}
+		}
+	}
+	if v, ok := msuo.mutation.Rule(); ok {
+		if err := maintenanceschedule.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`ent: validator failed for field "MaintenanceSchedule.rule": %w`, err)}
+		}
+	}
+	if v, ok := msuo.mutation.NotifyDaysBefore(); ok {
+		if err := maintenanceschedule.NotifyDaysBeforeValidator(v); err != nil {
+			return &ValidationError{Name: "notify_days_before", err: fmt.Errorf(`ent: validator failed for field "MaintenanceSchedule.notify_days_before": %w`, err)}
+		}
+	}
+	if _, ok := msuo.mutation.ItemID(); msuo.mutation.ItemCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MaintenanceSchedule.item"`)
+	}
+	return nil
+}
+
+func (msuo *MaintenanceScheduleUpdateOne) sqlSave(ctx context.Context) (_node *MaintenanceSchedule, err error) {
+	if err := msuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(maintenanceschedule.Table, maintenanceschedule.Columns, sqlgraph.NewFieldSpec(maintenanceschedule.FieldID, field.TypeUUID))
+	id, ok := msuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MaintenanceSchedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := msuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, maintenanceschedule.FieldID)
+		for _, f := range fields {
+			if !maintenanceschedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != maintenanceschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := msuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := msuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(maintenanceschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := msuo.mutation.Name(); ok {
+		_spec.SetField(maintenanceschedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := msuo.mutation.Description(); ok {
+		_spec.SetField(maintenanceschedule.FieldDescription, field.TypeString, value)
+	}
+	if msuo.mutation.DescriptionCleared() {
+		_spec.ClearField(maintenanceschedule.FieldDescription, field.TypeString)
+	}
+	if value, ok := msuo.mutation.Rule(); ok {
+		_spec.SetField(maintenanceschedule.FieldRule, field.TypeString, value)
+	}
+	if value, ok := msuo.mutation.NotifyDaysBefore(); ok {
+		_spec.SetField(maintenanceschedule.FieldNotifyDaysBefore, field.TypeInt, value)
+	}
+	if value, ok := msuo.mutation.AddedNotifyDaysBefore(); ok {
+		_spec.AddField(maintenanceschedule.FieldNotifyDaysBefore, field.TypeInt, value)
+	}
+	if msuo.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenanceschedule.ItemTable,
+			Columns: []string{maintenanceschedule.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := msuo.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenanceschedule.ItemTable,
+			Columns: []string{maintenanceschedule.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if msuo.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   maintenanceschedule.EntriesTable,
+			Columns: []string{maintenanceschedule.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := msuo.mutation.RemovedEntriesIDs(); len(nodes) > 0 && !msuo.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   maintenanceschedule.EntriesTable,
+			Columns: []string{maintenanceschedule.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := msuo.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   maintenanceschedule.EntriesTable,
+			Columns: []string{maintenanceschedule.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenanceentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MaintenanceSchedule{config: msuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, msuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{maintenanceschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	msuo.mutation.done = true
+	return _node, nil
+}
@@ -38,10 +38,61 @@ var (
 			},
 		},
 	}
+	// AuditEntriesColumns holds the columns for the "audit_entries" table.
+	AuditEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"create", "update", "delete", "restore", "purge"}},
+		{Name: "entity_type", Type: field.TypeEnum, Enums: []string{"item", "location", "label", "attachment"}},
+		{Name: "entity_id", Type: field.TypeUUID},
+		{Name: "entity_name", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "item_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "group_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// AuditEntriesTable holds the schema information for the "audit_entries" table.
+	AuditEntriesTable = &schema.Table{
+		Name:       "audit_entries",
+		Columns:    AuditEntriesColumns,
+		PrimaryKey: []*schema.Column{AuditEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "audit_entries_groups_audit_entries",
+				Columns:    []*schema.Column{AuditEntriesColumns[9]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "audit_entries_users_audit_entries",
+				Columns:    []*schema.Column{AuditEntriesColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditentry_group_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[9], AuditEntriesColumns[1]},
+			},
+			{
+				Name:    "auditentry_item_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[7]},
+			},
+			{
+				Name:    "auditentry_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[5]},
+			},
+		},
+	}
 	// AuthRolesColumns holds the columns for the "auth_roles" table.
 	AuthRolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "user", "attachments"}, Default: "user"},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "user", "attachments", "items_read", "items_write"}, Default: "user"},
 		{Name: "auth_tokens_roles", Type: field.TypeUUID, Unique: true, Nullable: true},
 	}
 	// AuthRolesTable holds the schema information for the "auth_roles" table.
@@ -65,6 +116,12 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "token", Type: field.TypeBytes, Unique: true},
 		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "session_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "group_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_auth_tokens", Type: field.TypeUUID, Nullable: true},
 	}
 	// AuthTokensTable holds the schema information for the "auth_tokens" table.
@@ -73,9 +130,21 @@ var (
 		Columns:    AuthTokensColumns,
 		PrimaryKey: []*schema.Column{AuthTokensColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "auth_tokens_auth_tokens_attachment_tokens",
+				Columns:    []*schema.Column{AuthTokensColumns[9]},
+				RefColumns: []*schema.Column{AuthTokensColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "auth_tokens_groups_auth_tokens",
+				Columns:    []*schema.Column{AuthTokensColumns[10]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 			{
 				Symbol:     "auth_tokens_users_auth_tokens",
-				Columns:    []*schema.Column{AuthTokensColumns[5]},
+				Columns:    []*schema.Column{AuthTokensColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -118,6 +187,10 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "currency", Type: field.TypeString, Default: "usd"},
+		{Name: "timezone", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "notify_time", Type: field.TypeString, Size: 5, Default: "08:00"},
+		{Name: "digest", Type: field.TypeEnum, Enums: []string{"none", "daily", "weekly"}, Default: "none"},
+		{Name: "notified_on", Type: field.TypeTime, Nullable: true},
 	}
 	// GroupsTable holds the schema information for the "groups" table.
 	GroupsTable = &schema.Table{
@@ -149,6 +222,42 @@ var (
 			},
 		},
 	}
+	// GroupMembershipsColumns holds the columns for the "group_memberships" table.
+	GroupMembershipsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"viewer", "editor", "owner"}, Default: "editor"},
+		{Name: "group_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// GroupMembershipsTable holds the schema information for the "group_memberships" table.
+	GroupMembershipsTable = &schema.Table{
+		Name:       "group_memberships",
+		Columns:    GroupMembershipsColumns,
+		PrimaryKey: []*schema.Column{GroupMembershipsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_memberships_groups_memberships",
+				Columns:    []*schema.Column{GroupMembershipsColumns[4]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "group_memberships_users_memberships",
+				Columns:    []*schema.Column{GroupMembershipsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "groupmembership_user_id_group_id",
+				Unique:  true,
+				Columns: []*schema.Column{GroupMembershipsColumns[5], GroupMembershipsColumns[4]},
+			},
+		},
+	}
 	// ItemsColumns holds the columns for the "items" table.
 	ItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -156,6 +265,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "import_ref", Type: field.TypeString, Nullable: true, Size: 100},
 		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 1000},
 		{Name: "quantity", Type: field.TypeInt, Default: 1},
@@ -187,24 +297,29 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "items_groups_items",
-				Columns:    []*schema.Column{ItemsColumns[24]},
+				Columns:    []*schema.Column{ItemsColumns[25]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "items_items_children",
-				Columns:    []*schema.Column{ItemsColumns[25]},
+				Columns:    []*schema.Column{ItemsColumns[26]},
 				RefColumns: []*schema.Column{ItemsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "items_locations_items",
-				Columns:    []*schema.Column{ItemsColumns[26]},
+				Columns:    []*schema.Column{ItemsColumns[27]},
 				RefColumns: []*schema.Column{LocationsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "item_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{ItemsColumns[5]},
+			},
 			{
 				Name:    "item_name",
 				Unique:  false,
@@ -213,27 +328,27 @@ var (
 			{
 				Name:    "item_manufacturer",
 				Unique:  false,
-				Columns: []*schema.Column{ItemsColumns[13]},
+				Columns: []*schema.Column{ItemsColumns[14]},
 			},
 			{
 				Name:    "item_model_number",
 				Unique:  false,
-				Columns: []*schema.Column{ItemsColumns[12]},
+				Columns: []*schema.Column{ItemsColumns[13]},
 			},
 			{
 				Name:    "item_serial_number",
 				Unique:  false,
-				Columns: []*schema.Column{ItemsColumns[11]},
+				Columns: []*schema.Column{ItemsColumns[12]},
 			},
 			{
 				Name:    "item_archived",
 				Unique:  false,
-				Columns: []*schema.Column{ItemsColumns[9]},
+				Columns: []*schema.Column{ItemsColumns[10]},
 			},
 			{
 				Name:    "item_asset_id",
 				Unique:  false,
-				Columns: []*schema.Column{ItemsColumns[10]},
+				Columns: []*schema.Column{ItemsColumns[11]},
 			},
 		},
 	}
@@ -296,6 +411,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "group_locations", Type: field.TypeUUID},
 		{Name: "location_children", Type: field.TypeUUID, Nullable: true},
 	}
@@ -307,17 +423,24 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "locations_groups_locations",
-				Columns:    []*schema.Column{LocationsColumns[5]},
+				Columns:    []*schema.Column{LocationsColumns[6]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "locations_locations_children",
-				Columns:    []*schema.Column{LocationsColumns[6]},
+				Columns:    []*schema.Column{LocationsColumns[7]},
 				RefColumns: []*schema.Column{LocationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "location_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{LocationsColumns[5]},
+			},
+		},
 	}
 	// MaintenanceEntriesColumns holds the columns for the "maintenance_entries" table.
 	MaintenanceEntriesColumns = []*schema.Column{
@@ -330,6 +453,7 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2500},
 		{Name: "cost", Type: field.TypeFloat64, Default: 0},
 		{Name: "item_id", Type: field.TypeUUID},
+		{Name: "schedule_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// MaintenanceEntriesTable holds the schema information for the "maintenance_entries" table.
 	MaintenanceEntriesTable = &schema.Table{
@@ -343,6 +467,152 @@ var (
 				RefColumns: []*schema.Column{ItemsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
+			{
+				Symbol:     "maintenance_entries_maintenance_schedules_entries",
+				Columns:    []*schema.Column{MaintenanceEntriesColumns[9]},
+				RefColumns: []*schema.Column{MaintenanceSchedulesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// MaintenanceSchedulesColumns holds the columns for the "maintenance_schedules" table.
+	MaintenanceSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2500},
+		{Name: "rule", Type: field.TypeString, Size: 255},
+		{Name: "notify_days_before", Type: field.TypeInt, Default: 0},
+		{Name: "item_id", Type: field.TypeUUID},
+	}
+	// MaintenanceSchedulesTable holds the schema information for the "maintenance_schedules" table.
+	MaintenanceSchedulesTable = &schema.Table{
+		Name:       "maintenance_schedules",
+		Columns:    MaintenanceSchedulesColumns,
+		PrimaryKey: []*schema.Column{MaintenanceSchedulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "maintenance_schedules_items_maintenance_schedules",
+				Columns:    []*schema.Column{MaintenanceSchedulesColumns[7]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// NotificationRemindersColumns holds the columns for the "notification_reminders" table.
+	NotificationRemindersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "item_id", Type: field.TypeUUID},
+		{Name: "target", Type: field.TypeString, Size: 255},
+		{Name: "title", Type: field.TypeString, Size: 255},
+		{Name: "due_date", Type: field.TypeTime},
+		{Name: "lead_days", Type: field.TypeInt},
+		{Name: "group_id", Type: field.TypeUUID},
+		{Name: "rule_id", Type: field.TypeUUID},
+	}
+	// NotificationRemindersTable holds the schema information for the "notification_reminders" table.
+	NotificationRemindersTable = &schema.Table{
+		Name:       "notification_reminders",
+		Columns:    NotificationRemindersColumns,
+		PrimaryKey: []*schema.Column{NotificationRemindersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_reminders_groups_notification_reminders",
+				Columns:    []*schema.Column{NotificationRemindersColumns[8]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "notification_reminders_notification_rules_reminders",
+				Columns:    []*schema.Column{NotificationRemindersColumns[9]},
+				RefColumns: []*schema.Column{NotificationRulesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationreminder_rule_id_item_id_target_due_date_lead_days",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationRemindersColumns[9], NotificationRemindersColumns[3], NotificationRemindersColumns[4], NotificationRemindersColumns[6], NotificationRemindersColumns[7]},
+			},
+			{
+				Name:    "notificationreminder_group_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationRemindersColumns[8], NotificationRemindersColumns[1]},
+			},
+		},
+	}
+	// NotificationRulesColumns holds the columns for the "notification_rules" table.
+	NotificationRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"warranty", "maintenance", "field"}},
+		{Name: "custom_field", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "lead_days", Type: field.TypeJSON},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "group_id", Type: field.TypeUUID},
+	}
+	// NotificationRulesTable holds the schema information for the "notification_rules" table.
+	NotificationRulesTable = &schema.Table{
+		Name:       "notification_rules",
+		Columns:    NotificationRulesColumns,
+		PrimaryKey: []*schema.Column{NotificationRulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_rules_groups_notification_rules",
+				Columns:    []*schema.Column{NotificationRulesColumns[8]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationrule_group_id_is_active",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationRulesColumns[8], NotificationRulesColumns[7]},
+			},
+		},
+	}
+	// NotificationTemplatesColumns holds the columns for the "notification_templates" table.
+	NotificationTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"maintenance", "reminder", "digest"}},
+		{Name: "body", Type: field.TypeString, Size: 5000},
+		{Name: "group_id", Type: field.TypeUUID},
+		{Name: "notifier_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// NotificationTemplatesTable holds the schema information for the "notification_templates" table.
+	NotificationTemplatesTable = &schema.Table{
+		Name:       "notification_templates",
+		Columns:    NotificationTemplatesColumns,
+		PrimaryKey: []*schema.Column{NotificationTemplatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_templates_groups_notification_templates",
+				Columns:    []*schema.Column{NotificationTemplatesColumns[5]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "notification_templates_notifiers_templates",
+				Columns:    []*schema.Column{NotificationTemplatesColumns[6]},
+				RefColumns: []*schema.Column{NotifiersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationtemplate_group_id_type",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationTemplatesColumns[5], NotificationTemplatesColumns[3]},
+			},
 		},
 	}
 	// NotifiersColumns holds the columns for the "notifiers" table.
@@ -353,6 +623,7 @@ var (
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "url", Type: field.TypeString, Size: 2083},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "failure_count", Type: field.TypeInt, Default: 0},
 		{Name: "group_id", Type: field.TypeUUID},
 		{Name: "user_id", Type: field.TypeUUID},
 	}
@@ -364,13 +635,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "notifiers_groups_notifiers",
-				Columns:    []*schema.Column{NotifiersColumns[6]},
+				Columns:    []*schema.Column{NotifiersColumns[7]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "notifiers_users_notifiers",
-				Columns:    []*schema.Column{NotifiersColumns[7]},
+				Columns:    []*schema.Column{NotifiersColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -379,22 +650,79 @@ var (
 			{
 				Name:    "notifier_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{NotifiersColumns[7]},
+				Columns: []*schema.Column{NotifiersColumns[8]},
 			},
 			{
 				Name:    "notifier_user_id_is_active",
 				Unique:  false,
-				Columns: []*schema.Column{NotifiersColumns[7], NotifiersColumns[5]},
+				Columns: []*schema.Column{NotifiersColumns[8], NotifiersColumns[5]},
 			},
 			{
 				Name:    "notifier_group_id",
 				Unique:  false,
-				Columns: []*schema.Column{NotifiersColumns[6]},
+				Columns: []*schema.Column{NotifiersColumns[7]},
 			},
 			{
 				Name:    "notifier_group_id_is_active",
 				Unique:  false,
-				Columns: []*schema.Column{NotifiersColumns[6], NotifiersColumns[5]},
+				Columns: []*schema.Column{NotifiersColumns[7], NotifiersColumns[5]},
+			},
+		},
+	}
+	// NotifierDeliveriesColumns holds the columns for the "notifier_deliveries" table.
+	NotifierDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeString, Size: 255},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"success", "failed"}},
+		{Name: "attempt", Type: field.TypeInt, Default: 1},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2000},
+		{Name: "notifier_id", Type: field.TypeUUID},
+	}
+	// NotifierDeliveriesTable holds the schema information for the "notifier_deliveries" table.
+	NotifierDeliveriesTable = &schema.Table{
+		Name:       "notifier_deliveries",
+		Columns:    NotifierDeliveriesColumns,
+		PrimaryKey: []*schema.Column{NotifierDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notifier_deliveries_notifiers_deliveries",
+				Columns:    []*schema.Column{NotifierDeliveriesColumns[7]},
+				RefColumns: []*schema.Column{NotifiersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notifierdelivery_notifier_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotifierDeliveriesColumns[7], NotifierDeliveriesColumns[1]},
+			},
+		},
+	}
+	// SavedSearchesColumns holds the columns for the "saved_searches" table.
+	SavedSearchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "query", Type: field.TypeJSON},
+		{Name: "order_by", Type: field.TypeEnum, Enums: []string{"name", "createdAt", "updatedAt"}, Default: "name"},
+		{Name: "group_saved_searches", Type: field.TypeUUID},
+	}
+	// SavedSearchesTable holds the schema information for the "saved_searches" table.
+	SavedSearchesTable = &schema.Table{
+		Name:       "saved_searches",
+		Columns:    SavedSearchesColumns,
+		PrimaryKey: []*schema.Column{SavedSearchesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "saved_searches_groups_saved_searches",
+				Columns:    []*schema.Column{SavedSearchesColumns[7]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 		},
 	}
@@ -408,8 +736,12 @@ var (
 		{Name: "password", Type: field.TypeString, Size: 255},
 		{Name: "is_superuser", Type: field.TypeBool, Default: false},
 		{Name: "superuser", Type: field.TypeBool, Default: false},
-		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "owner"}, Default: "user"},
 		{Name: "activated_on", Type: field.TypeTime, Nullable: true},
+		{Name: "disabled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "totp_enabled", Type: field.TypeBool, Default: false},
+		{Name: "totp_last_step", Type: field.TypeInt64, Default: 0},
+		{Name: "totp_recovery_codes", Type: field.TypeJSON, Nullable: true},
 		{Name: "group_users", Type: field.TypeUUID},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -420,12 +752,75 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_groups_users",
-				Columns:    []*schema.Column{UsersColumns[10]},
+				Columns:    []*schema.Column{UsersColumns[14]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// UserTokensColumns holds the columns for the "user_tokens" table.
+	UserTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "purpose", Type: field.TypeEnum, Enums: []string{"password_reset", "email_verification", "two_factor"}},
+		{Name: "token", Type: field.TypeBytes, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// UserTokensTable holds the schema information for the "user_tokens" table.
+	UserTokensTable = &schema.Table{
+		Name:       "user_tokens",
+		Columns:    UserTokensColumns,
+		PrimaryKey: []*schema.Column{UserTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_tokens_users_user_tokens",
+				Columns:    []*schema.Column{UserTokensColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usertoken_user_id_purpose",
+				Unique:  false,
+				Columns: []*schema.Column{UserTokensColumns[7], UserTokensColumns[3]},
+			},
+		},
+	}
+	// WebAuthnCredentialsColumns holds the columns for the "web_authn_credentials" table.
+	WebAuthnCredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "credential_id", Type: field.TypeBytes, Unique: true},
+		{Name: "public_key", Type: field.TypeBytes},
+		{Name: "attestation_type", Type: field.TypeString, Nullable: true},
+		{Name: "transports", Type: field.TypeJSON, Nullable: true},
+		{Name: "aaguid", Type: field.TypeBytes, Nullable: true},
+		{Name: "sign_count", Type: field.TypeUint32, Default: 0},
+		{Name: "backup_eligible", Type: field.TypeBool, Default: false},
+		{Name: "backup_state", Type: field.TypeBool, Default: false},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// WebAuthnCredentialsTable holds the schema information for the "web_authn_credentials" table.
+	WebAuthnCredentialsTable = &schema.Table{
+		Name:       "web_authn_credentials",
+		Columns:    WebAuthnCredentialsColumns,
+		PrimaryKey: []*schema.Column{WebAuthnCredentialsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "web_authn_credentials_users_webauthn_credentials",
+				Columns:    []*schema.Column{WebAuthnCredentialsColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// LabelItemsColumns holds the columns for the "label_items" table.
 	LabelItemsColumns = []*schema.Column{
 		{Name: "label_id", Type: field.TypeUUID},
@@ -454,18 +849,28 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AttachmentsTable,
+		AuditEntriesTable,
 		AuthRolesTable,
 		AuthTokensTable,
 		DocumentsTable,
 		GroupsTable,
 		GroupInvitationTokensTable,
+		GroupMembershipsTable,
 		ItemsTable,
 		ItemFieldsTable,
 		LabelsTable,
 		LocationsTable,
 		MaintenanceEntriesTable,
+		MaintenanceSchedulesTable,
+		NotificationRemindersTable,
+		NotificationRulesTable,
+		NotificationTemplatesTable,
 		NotifiersTable,
+		NotifierDeliveriesTable,
+		SavedSearchesTable,
 		UsersTable,
+		UserTokensTable,
+		WebAuthnCredentialsTable,
 		LabelItemsTable,
 	}
 )
@@ -473,10 +878,16 @@ var (
 func init() {
 	AttachmentsTable.ForeignKeys[0].RefTable = DocumentsTable
 	AttachmentsTable.ForeignKeys[1].RefTable = ItemsTable
+	AuditEntriesTable.ForeignKeys[0].RefTable = GroupsTable
+	AuditEntriesTable.ForeignKeys[1].RefTable = UsersTable
 	AuthRolesTable.ForeignKeys[0].RefTable = AuthTokensTable
-	AuthTokensTable.ForeignKeys[0].RefTable = UsersTable
+	AuthTokensTable.ForeignKeys[0].RefTable = AuthTokensTable
+	AuthTokensTable.ForeignKeys[1].RefTable = GroupsTable
+	AuthTokensTable.ForeignKeys[2].RefTable = UsersTable
 	DocumentsTable.ForeignKeys[0].RefTable = GroupsTable
 	GroupInvitationTokensTable.ForeignKeys[0].RefTable = GroupsTable
+	GroupMembershipsTable.ForeignKeys[0].RefTable = GroupsTable
+	GroupMembershipsTable.ForeignKeys[1].RefTable = UsersTable
 	ItemsTable.ForeignKeys[0].RefTable = GroupsTable
 	ItemsTable.ForeignKeys[1].RefTable = ItemsTable
 	ItemsTable.ForeignKeys[2].RefTable = LocationsTable
@@ -485,9 +896,20 @@ func init() {
 	LocationsTable.ForeignKeys[0].RefTable = GroupsTable
 	LocationsTable.ForeignKeys[1].RefTable = LocationsTable
 	MaintenanceEntriesTable.ForeignKeys[0].RefTable = ItemsTable
+	MaintenanceEntriesTable.ForeignKeys[1].RefTable = MaintenanceSchedulesTable
+	MaintenanceSchedulesTable.ForeignKeys[0].RefTable = ItemsTable
+	NotificationRemindersTable.ForeignKeys[0].RefTable = GroupsTable
+	NotificationRemindersTable.ForeignKeys[1].RefTable = NotificationRulesTable
+	NotificationRulesTable.ForeignKeys[0].RefTable = GroupsTable
+	NotificationTemplatesTable.ForeignKeys[0].RefTable = GroupsTable
+	NotificationTemplatesTable.ForeignKeys[1].RefTable = NotifiersTable
 	NotifiersTable.ForeignKeys[0].RefTable = GroupsTable
 	NotifiersTable.ForeignKeys[1].RefTable = UsersTable
+	NotifierDeliveriesTable.ForeignKeys[0].RefTable = NotifiersTable
+	SavedSearchesTable.ForeignKeys[0].RefTable = GroupsTable
 	UsersTable.ForeignKeys[0].RefTable = GroupsTable
+	UserTokensTable.ForeignKeys[0].RefTable = UsersTable
+	WebAuthnCredentialsTable.ForeignKeys[0].RefTable = UsersTable
 	LabelItemsTable.ForeignKeys[0].RefTable = LabelsTable
 	LabelItemsTable.ForeignKeys[1].RefTable = ItemsTable
 }
@@ -13,19 +13,30 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/auditentry"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authroles"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/document"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/groupinvitationtoken"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/itemfield"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationreminder"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationrule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationtemplate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifierdelivery"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/usertoken"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/webauthncredential"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
 )
 
 const (
@@ -38,18 +49,28 @@ const (
 
 	// Node types.
 	TypeAttachment           = "Attachment"
+	TypeAuditEntry           = "AuditEntry"
 	TypeAuthRoles            = "AuthRoles"
 	TypeAuthTokens           = "AuthTokens"
 	TypeDocument             = "Document"
 	TypeGroup                = "Group"
 	TypeGroupInvitationToken = "GroupInvitationToken"
+	TypeGroupMembership      = "GroupMembership"
 	TypeItem                 = "Item"
 	TypeItemField            = "ItemField"
 	TypeLabel                = "Label"
 	TypeLocation             = "Location"
 	TypeMaintenanceEntry     = "MaintenanceEntry"
+	TypeMaintenanceSchedule  = "MaintenanceSchedule"
+	TypeNotificationReminder = "NotificationReminder"
+	TypeNotificationRule     = "NotificationRule"
+	TypeNotificationTemplate = "NotificationTemplate"
 	TypeNotifier             = "Notifier"
+	TypeNotifierDelivery     = "NotifierDelivery"
+	TypeSavedSearch          = "SavedSearch"
 	TypeUser                 = "User"
+	TypeUserToken            = "UserToken"
+	TypeWebAuthnCredential   = "WebAuthnCredential"
 )
 
 // AttachmentMutation represents an operation that mutates the Attachment nodes in the graph.
@@ -672,32 +693,42 @@ func (m *AttachmentMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Attachment edge %s", name)
 }
 
-// AuthRolesMutation represents an operation that mutates the AuthRoles nodes in the graph.
-type AuthRolesMutation struct {
+// AuditEntryMutation represents an operation that mutates the AuditEntry nodes in the graph.
+type AuditEntryMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *int
-	role          *authroles.Role
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	action        *auditentry.Action
+	entity_type   *auditentry.EntityType
+	entity_id     *uuid.UUID
+	entity_name   *string
+	item_id       *uuid.UUID
+	changes       *[]types.FieldChange
+	appendchanges []types.FieldChange
 	clearedFields map[string]struct{}
-	token         *uuid.UUID
-	clearedtoken  bool
+	group         *uuid.UUID
+	clearedgroup  bool
+	user          *uuid.UUID
+	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*AuthRoles, error)
-	predicates    []predicate.AuthRoles
+	oldValue      func(context.Context) (*AuditEntry, error)
+	predicates    []predicate.AuditEntry
 }
 
-var _ ent.Mutation = (*AuthRolesMutation)(nil)
+var _ ent.Mutation = (*AuditEntryMutation)(nil)
 
-// authrolesOption allows management of the mutation configuration using functional options.
-type authrolesOption func(*AuthRolesMutation)
+// auditentryOption allows management of the mutation configuration using functional options.
+type auditentryOption func(*AuditEntryMutation)
 
-// newAuthRolesMutation creates new mutation for the AuthRoles entity.
-func newAuthRolesMutation(c config, op Op, opts ...authrolesOption) *AuthRolesMutation {
-	m := &AuthRolesMutation{
+// newAuditEntryMutation creates new mutation for the AuditEntry entity.
+func newAuditEntryMutation(c config, op Op, opts ...auditentryOption) *AuditEntryMutation {
+	m := &AuditEntryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAuthRoles,
+		typ:           TypeAuditEntry,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -706,20 +737,20 @@ func newAuthRolesMutation(c config, op Op, opts ...authrolesOption) *AuthRolesMu
 	return m
 }
 
-// withAuthRolesID sets the ID field of the mutation.
-func withAuthRolesID(id int) authrolesOption {
-	return func(m *AuthRolesMutation) {
+// withAuditEntryID sets the ID field of the mutation.
+func withAuditEntryID(id uuid.UUID) auditentryOption {
+	return func(m *AuditEntryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *AuthRoles
+			value *AuditEntry
 		)
-		m.oldValue = func(ctx context.Context) (*AuthRoles, error) {
+		m.oldValue = func(ctx context.Context) (*AuditEntry, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().AuthRoles.Get(ctx, id)
+					value, err = m.Client().AuditEntry.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -728,10 +759,10 @@ func withAuthRolesID(id int) authrolesOption {
 	}
 }
 
-// withAuthRoles sets the old AuthRoles of the mutation.
-func withAuthRoles(node *AuthRoles) authrolesOption {
-	return func(m *AuthRolesMutation) {
-		m.oldValue = func(context.Context) (*AuthRoles, error) {
+// withAuditEntry sets the old AuditEntry of the mutation.
+func withAuditEntry(node *AuditEntry) auditentryOption {
+	return func(m *AuditEntryMutation) {
+		m.oldValue = func(context.Context) (*AuditEntry, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -740,7 +771,7 @@ func withAuthRoles(node *AuthRoles) authrolesOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AuthRolesMutation) Client() *Client {
+func (m AuditEntryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -748,7 +779,7 @@ func (m AuthRolesMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AuthRolesMutation) Tx() (*Tx, error) {
+func (m AuditEntryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -757,9 +788,15 @@ func (m AuthRolesMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AuditEntry entities.
+func (m *AuditEntryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AuthRolesMutation) ID() (id int, exists bool) {
+func (m *AuditEntryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -770,596 +807,491 @@ func (m *AuthRolesMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AuthRolesMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AuditEntryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().AuthRoles.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditEntry.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRole sets the "role" field.
-func (m *AuthRolesMutation) SetRole(a authroles.Role) {
-	m.role = &a
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditEntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *AuthRolesMutation) Role() (r authroles.Role, exists bool) {
-	v := m.role
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the AuthRoles entity.
-// If the AuthRoles object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRolesMutation) OldRole(ctx context.Context) (v authroles.Role, err error) {
+func (m *AuditEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Role, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *AuthRolesMutation) ResetRole() {
-	m.role = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetTokenID sets the "token" edge to the AuthTokens entity by id.
-func (m *AuthRolesMutation) SetTokenID(id uuid.UUID) {
-	m.token = &id
+// SetUpdatedAt sets the "updated_at" field.
+func (m *AuditEntryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ClearToken clears the "token" edge to the AuthTokens entity.
-func (m *AuthRolesMutation) ClearToken() {
-	m.clearedtoken = true
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *AuditEntryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TokenCleared reports if the "token" edge to the AuthTokens entity was cleared.
-func (m *AuthRolesMutation) TokenCleared() bool {
-	return m.clearedtoken
+// OldUpdatedAt returns the old "updated_at" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// TokenID returns the "token" edge ID in the mutation.
-func (m *AuthRolesMutation) TokenID() (id uuid.UUID, exists bool) {
-	if m.token != nil {
-		return *m.token, true
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *AuditEntryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetGroupID sets the "group_id" field.
+func (m *AuditEntryMutation) SetGroupID(u uuid.UUID) {
+	m.group = &u
+}
+
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *AuditEntryMutation) GroupID() (r uuid.UUID, exists bool) {
+	v := m.group
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// TokenIDs returns the "token" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TokenID instead. It exists only for internal usage by the builders.
-func (m *AuthRolesMutation) TokenIDs() (ids []uuid.UUID) {
-	if id := m.token; id != nil {
-		ids = append(ids, *id)
+// OldGroupID returns the old "group_id" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldGroupID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+	}
+	return oldValue.GroupID, nil
 }
 
-// ResetToken resets all changes to the "token" edge.
-func (m *AuthRolesMutation) ResetToken() {
-	m.token = nil
-	m.clearedtoken = false
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *AuditEntryMutation) ResetGroupID() {
+	m.group = nil
 }
 
-// Where appends a list predicates to the AuthRolesMutation builder.
-func (m *AuthRolesMutation) Where(ps ...predicate.AuthRoles) {
-	m.predicates = append(m.predicates, ps...)
+// SetAction sets the "action" field.
+func (m *AuditEntryMutation) SetAction(a auditentry.Action) {
+	m.action = &a
 }
 
-// WhereP appends storage-level predicates to the AuthRolesMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuthRolesMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AuthRoles, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditEntryMutation) Action() (r auditentry.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *AuthRolesMutation) Op() Op {
-	return m.op
+// OldAction returns the old "action" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldAction(ctx context.Context) (v auditentry.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *AuthRolesMutation) SetOp(op Op) {
-	m.op = op
+// ResetAction resets all changes to the "action" field.
+func (m *AuditEntryMutation) ResetAction() {
+	m.action = nil
 }
 
-// Type returns the node type of this mutation (AuthRoles).
-func (m *AuthRolesMutation) Type() string {
-	return m.typ
+// SetEntityType sets the "entity_type" field.
+func (m *AuditEntryMutation) SetEntityType(at auditentry.EntityType) {
+	m.entity_type = &at
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *AuthRolesMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.role != nil {
-		fields = append(fields, authroles.FieldRole)
+// EntityType returns the value of the "entity_type" field in the mutation.
+func (m *AuditEntryMutation) EntityType() (r auditentry.EntityType, exists bool) {
+	v := m.entity_type
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *AuthRolesMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case authroles.FieldRole:
-		return m.Role()
+// OldEntityType returns the old "entity_type" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldEntityType(ctx context.Context) (v auditentry.EntityType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityType is only allowed on UpdateOne operations")
 	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *AuthRolesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case authroles.FieldRole:
-		return m.OldRole(ctx)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityType requires an ID field in the mutation")
 	}
-	return nil, fmt.Errorf("unknown AuthRoles field %s", name)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityType: %w", err)
+	}
+	return oldValue.EntityType, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *AuthRolesMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case authroles.FieldRole:
-		v, ok := value.(authroles.Role)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRole(v)
-		return nil
-	}
-	return fmt.Errorf("unknown AuthRoles field %s", name)
+// ResetEntityType resets all changes to the "entity_type" field.
+func (m *AuditEntryMutation) ResetEntityType() {
+	m.entity_type = nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *AuthRolesMutation) AddedFields() []string {
-	return nil
+// SetEntityID sets the "entity_id" field.
+func (m *AuditEntryMutation) SetEntityID(u uuid.UUID) {
+	m.entity_id = &u
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *AuthRolesMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// EntityID returns the value of the "entity_id" field in the mutation.
+func (m *AuditEntryMutation) EntityID() (r uuid.UUID, exists bool) {
+	v := m.entity_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *AuthRolesMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldEntityID returns the old "entity_id" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldEntityID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityID is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown AuthRoles numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityID: %w", err)
+	}
+	return oldValue.EntityID, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *AuthRolesMutation) ClearedFields() []string {
-	return nil
+// ResetEntityID resets all changes to the "entity_id" field.
+func (m *AuditEntryMutation) ResetEntityID() {
+	m.entity_id = nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *AuthRolesMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// SetEntityName sets the "entity_name" field.
+func (m *AuditEntryMutation) SetEntityName(s string) {
+	m.entity_name = &s
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *AuthRolesMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown AuthRoles nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *AuthRolesMutation) ResetField(name string) error {
-	switch name {
-	case authroles.FieldRole:
-		m.ResetRole()
-		return nil
+// EntityName returns the value of the "entity_name" field in the mutation.
+func (m *AuditEntryMutation) EntityName() (r string, exists bool) {
+	v := m.entity_name
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown AuthRoles field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuthRolesMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.token != nil {
-		edges = append(edges, authroles.EdgeToken)
+// OldEntityName returns the old "entity_name" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldEntityName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityName is only allowed on UpdateOne operations")
 	}
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *AuthRolesMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case authroles.EdgeToken:
-		if id := m.token; id != nil {
-			return []ent.Value{*id}
-		}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityName requires an ID field in the mutation")
 	}
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuthRolesMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *AuthRolesMutation) RemovedIDs(name string) []ent.Value {
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuthRolesMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedtoken {
-		edges = append(edges, authroles.EdgeToken)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityName: %w", err)
 	}
-	return edges
+	return oldValue.EntityName, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *AuthRolesMutation) EdgeCleared(name string) bool {
-	switch name {
-	case authroles.EdgeToken:
-		return m.clearedtoken
-	}
-	return false
+// ClearEntityName clears the value of the "entity_name" field.
+func (m *AuditEntryMutation) ClearEntityName() {
+	m.entity_name = nil
+	m.clearedFields[auditentry.FieldEntityName] = struct{}{}
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *AuthRolesMutation) ClearEdge(name string) error {
-	switch name {
-	case authroles.EdgeToken:
-		m.ClearToken()
-		return nil
-	}
-	return fmt.Errorf("unknown AuthRoles unique edge %s", name)
+// EntityNameCleared returns if the "entity_name" field was cleared in this mutation.
+func (m *AuditEntryMutation) EntityNameCleared() bool {
+	_, ok := m.clearedFields[auditentry.FieldEntityName]
+	return ok
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *AuthRolesMutation) ResetEdge(name string) error {
-	switch name {
-	case authroles.EdgeToken:
-		m.ResetToken()
-		return nil
-	}
-	return fmt.Errorf("unknown AuthRoles edge %s", name)
+// ResetEntityName resets all changes to the "entity_name" field.
+func (m *AuditEntryMutation) ResetEntityName() {
+	m.entity_name = nil
+	delete(m.clearedFields, auditentry.FieldEntityName)
 }
 
-// AuthTokensMutation represents an operation that mutates the AuthTokens nodes in the graph.
-type AuthTokensMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	created_at    *time.Time
-	updated_at    *time.Time
-	token         *[]byte
-	expires_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *uuid.UUID
-	cleareduser   bool
-	roles         *int
-	clearedroles  bool
-	done          bool
-	oldValue      func(context.Context) (*AuthTokens, error)
-	predicates    []predicate.AuthTokens
+// SetItemID sets the "item_id" field.
+func (m *AuditEntryMutation) SetItemID(u uuid.UUID) {
+	m.item_id = &u
 }
 
-var _ ent.Mutation = (*AuthTokensMutation)(nil)
-
-// authtokensOption allows management of the mutation configuration using functional options.
-type authtokensOption func(*AuthTokensMutation)
-
-// newAuthTokensMutation creates new mutation for the AuthTokens entity.
-func newAuthTokensMutation(c config, op Op, opts ...authtokensOption) *AuthTokensMutation {
-	m := &AuthTokensMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeAuthTokens,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *AuditEntryMutation) ItemID() (r uuid.UUID, exists bool) {
+	v := m.item_id
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withAuthTokensID sets the ID field of the mutation.
-func withAuthTokensID(id uuid.UUID) authtokensOption {
-	return func(m *AuthTokensMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *AuthTokens
-		)
-		m.oldValue = func(ctx context.Context) (*AuthTokens, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().AuthTokens.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldItemID returns the old "item_id" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldItemID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
 	}
-}
-
-// withAuthTokens sets the old AuthTokens of the mutation.
-func withAuthTokens(node *AuthTokens) authtokensOption {
-	return func(m *AuthTokensMutation) {
-		m.oldValue = func(context.Context) (*AuthTokens, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemID requires an ID field in the mutation")
 	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AuthTokensMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m AuthTokensMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return oldValue.ItemID, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of AuthTokens entities.
-func (m *AuthTokensMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ClearItemID clears the value of the "item_id" field.
+func (m *AuditEntryMutation) ClearItemID() {
+	m.item_id = nil
+	m.clearedFields[auditentry.FieldItemID] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *AuthTokensMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ItemIDCleared returns if the "item_id" field was cleared in this mutation.
+func (m *AuditEntryMutation) ItemIDCleared() bool {
+	_, ok := m.clearedFields[auditentry.FieldItemID]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *AuthTokensMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().AuthTokens.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetItemID resets all changes to the "item_id" field.
+func (m *AuditEntryMutation) ResetItemID() {
+	m.item_id = nil
+	delete(m.clearedFields, auditentry.FieldItemID)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *AuthTokensMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetUserID sets the "user_id" field.
+func (m *AuditEntryMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *AuthTokensMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AuditEntryMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the AuthTokens entity.
-// If the AuthTokens object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthTokensMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEntryMutation) OldUserID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UserID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *AuthTokensMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearUserID clears the value of the "user_id" field.
+func (m *AuditEntryMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[auditentry.FieldUserID] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *AuthTokensMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *AuditEntryMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[auditentry.FieldUserID]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *AuthTokensMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AuditEntryMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, auditentry.FieldUserID)
+}
+
+// SetChanges sets the "changes" field.
+func (m *AuditEntryMutation) SetChanges(tc []types.FieldChange) {
+	m.changes = &tc
+	m.appendchanges = nil
+}
+
+// Changes returns the value of the "changes" field in the mutation.
+func (m *AuditEntryMutation) Changes() (r []types.FieldChange, exists bool) {
+	v := m.changes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the AuthTokens entity.
-// If the AuthTokens object wasn't provided to the builder, the object is fetched from the database.
+// OldChanges returns the old "changes" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthTokensMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEntryMutation) OldChanges(ctx context.Context) (v []types.FieldChange, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldChanges requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Changes, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *AuthTokensMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AppendChanges adds tc to the "changes" field.
+func (m *AuditEntryMutation) AppendChanges(tc []types.FieldChange) {
+	m.appendchanges = append(m.appendchanges, tc...)
 }
 
-// SetToken sets the "token" field.
-func (m *AuthTokensMutation) SetToken(b []byte) {
-	m.token = &b
+// AppendedChanges returns the list of values that were appended to the "changes" field in this mutation.
+func (m *AuditEntryMutation) AppendedChanges() ([]types.FieldChange, bool) {
+	if len(m.appendchanges) == 0 {
+		return nil, false
+	}
+	return m.appendchanges, true
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *AuthTokensMutation) Token() (r []byte, exists bool) {
-	v := m.token
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearChanges clears the value of the "changes" field.
+func (m *AuditEntryMutation) ClearChanges() {
+	m.changes = nil
+	m.appendchanges = nil
+	m.clearedFields[auditentry.FieldChanges] = struct{}{}
 }
 
-// OldToken returns the old "token" field's value of the AuthTokens entity.
-// If the AuthTokens object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthTokensMutation) OldToken(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
-	}
-	return oldValue.Token, nil
+// ChangesCleared returns if the "changes" field was cleared in this mutation.
+func (m *AuditEntryMutation) ChangesCleared() bool {
+	_, ok := m.clearedFields[auditentry.FieldChanges]
+	return ok
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *AuthTokensMutation) ResetToken() {
-	m.token = nil
+// ResetChanges resets all changes to the "changes" field.
+func (m *AuditEntryMutation) ResetChanges() {
+	m.changes = nil
+	m.appendchanges = nil
+	delete(m.clearedFields, auditentry.FieldChanges)
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *AuthTokensMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *AuditEntryMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[auditentry.FieldGroupID] = struct{}{}
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *AuthTokensMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *AuditEntryMutation) GroupCleared() bool {
+	return m.clearedgroup
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the AuthTokens entity.
-// If the AuthTokens object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthTokensMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *AuditEntryMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.ExpiresAt, nil
-}
-
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *AuthTokensMutation) ResetExpiresAt() {
-	m.expires_at = nil
+	return
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *AuthTokensMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
+// ResetGroup resets all changes to the "group" edge.
+func (m *AuditEntryMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *AuthTokensMutation) ClearUser() {
+func (m *AuditEntryMutation) ClearUser() {
 	m.cleareduser = true
+	m.clearedFields[auditentry.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *AuthTokensMutation) UserCleared() bool {
-	return m.cleareduser
-}
-
-// UserID returns the "user" edge ID in the mutation.
-func (m *AuthTokensMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
+func (m *AuditEntryMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
 }
 
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *AuthTokensMutation) UserIDs() (ids []uuid.UUID) {
+func (m *AuditEntryMutation) UserIDs() (ids []uuid.UUID) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1367,59 +1299,20 @@ func (m *AuthTokensMutation) UserIDs() (ids []uuid.UUID) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *AuthTokensMutation) ResetUser() {
+func (m *AuditEntryMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// SetRolesID sets the "roles" edge to the AuthRoles entity by id.
-func (m *AuthTokensMutation) SetRolesID(id int) {
-	m.roles = &id
-}
-
-// ClearRoles clears the "roles" edge to the AuthRoles entity.
-func (m *AuthTokensMutation) ClearRoles() {
-	m.clearedroles = true
-}
-
-// RolesCleared reports if the "roles" edge to the AuthRoles entity was cleared.
-func (m *AuthTokensMutation) RolesCleared() bool {
-	return m.clearedroles
-}
-
-// RolesID returns the "roles" edge ID in the mutation.
-func (m *AuthTokensMutation) RolesID() (id int, exists bool) {
-	if m.roles != nil {
-		return *m.roles, true
-	}
-	return
-}
-
-// RolesIDs returns the "roles" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RolesID instead. It exists only for internal usage by the builders.
-func (m *AuthTokensMutation) RolesIDs() (ids []int) {
-	if id := m.roles; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetRoles resets all changes to the "roles" edge.
-func (m *AuthTokensMutation) ResetRoles() {
-	m.roles = nil
-	m.clearedroles = false
-}
-
-// Where appends a list predicates to the AuthTokensMutation builder.
-func (m *AuthTokensMutation) Where(ps ...predicate.AuthTokens) {
+// Where appends a list predicates to the AuditEntryMutation builder.
+func (m *AuditEntryMutation) Where(ps ...predicate.AuditEntry) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the AuthTokensMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuditEntryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuthTokensMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AuthTokens, len(ps))
+func (m *AuditEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditEntry, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1427,36 +1320,54 @@ func (m *AuthTokensMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *AuthTokensMutation) Op() Op {
+func (m *AuditEntryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AuthTokensMutation) SetOp(op Op) {
+func (m *AuditEntryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (AuthTokens).
-func (m *AuthTokensMutation) Type() string {
+// Type returns the node type of this mutation (AuditEntry).
+func (m *AuditEntryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *AuthTokensMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+func (m *AuditEntryMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.created_at != nil {
-		fields = append(fields, authtokens.FieldCreatedAt)
+		fields = append(fields, auditentry.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, authtokens.FieldUpdatedAt)
+		fields = append(fields, auditentry.FieldUpdatedAt)
 	}
-	if m.token != nil {
-		fields = append(fields, authtokens.FieldToken)
+	if m.group != nil {
+		fields = append(fields, auditentry.FieldGroupID)
 	}
-	if m.expires_at != nil {
-		fields = append(fields, authtokens.FieldExpiresAt)
+	if m.action != nil {
+		fields = append(fields, auditentry.FieldAction)
+	}
+	if m.entity_type != nil {
+		fields = append(fields, auditentry.FieldEntityType)
+	}
+	if m.entity_id != nil {
+		fields = append(fields, auditentry.FieldEntityID)
+	}
+	if m.entity_name != nil {
+		fields = append(fields, auditentry.FieldEntityName)
+	}
+	if m.item_id != nil {
+		fields = append(fields, auditentry.FieldItemID)
+	}
+	if m.user != nil {
+		fields = append(fields, auditentry.FieldUserID)
+	}
+	if m.changes != nil {
+		fields = append(fields, auditentry.FieldChanges)
 	}
 	return fields
 }
@@ -1464,16 +1375,28 @@ func (m *AuthTokensMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AuthTokensMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditEntryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case authtokens.FieldCreatedAt:
+	case auditentry.FieldCreatedAt:
 		return m.CreatedAt()
-	case authtokens.FieldUpdatedAt:
+	case auditentry.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case authtokens.FieldToken:
-		return m.Token()
-	case authtokens.FieldExpiresAt:
-		return m.ExpiresAt()
+	case auditentry.FieldGroupID:
+		return m.GroupID()
+	case auditentry.FieldAction:
+		return m.Action()
+	case auditentry.FieldEntityType:
+		return m.EntityType()
+	case auditentry.FieldEntityID:
+		return m.EntityID()
+	case auditentry.FieldEntityName:
+		return m.EntityName()
+	case auditentry.FieldItemID:
+		return m.ItemID()
+	case auditentry.FieldUserID:
+		return m.UserID()
+	case auditentry.FieldChanges:
+		return m.Changes()
 	}
 	return nil, false
 }
@@ -1481,140 +1404,239 @@ func (m *AuthTokensMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AuthTokensMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case authtokens.FieldCreatedAt:
+	case auditentry.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case authtokens.FieldUpdatedAt:
+	case auditentry.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case authtokens.FieldToken:
-		return m.OldToken(ctx)
-	case authtokens.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
+	case auditentry.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case auditentry.FieldAction:
+		return m.OldAction(ctx)
+	case auditentry.FieldEntityType:
+		return m.OldEntityType(ctx)
+	case auditentry.FieldEntityID:
+		return m.OldEntityID(ctx)
+	case auditentry.FieldEntityName:
+		return m.OldEntityName(ctx)
+	case auditentry.FieldItemID:
+		return m.OldItemID(ctx)
+	case auditentry.FieldUserID:
+		return m.OldUserID(ctx)
+	case auditentry.FieldChanges:
+		return m.OldChanges(ctx)
 	}
-	return nil, fmt.Errorf("unknown AuthTokens field %s", name)
+	return nil, fmt.Errorf("unknown AuditEntry field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthTokensMutation) SetField(name string, value ent.Value) error {
+func (m *AuditEntryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case authtokens.FieldCreatedAt:
+	case auditentry.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case authtokens.FieldUpdatedAt:
+	case auditentry.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case authtokens.FieldToken:
-		v, ok := value.([]byte)
+	case auditentry.FieldGroupID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
+		m.SetGroupID(v)
 		return nil
-	case authtokens.FieldExpiresAt:
-		v, ok := value.(time.Time)
+	case auditentry.FieldAction:
+		v, ok := value.(auditentry.Action)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiresAt(v)
+		m.SetAction(v)
+		return nil
+	case auditentry.FieldEntityType:
+		v, ok := value.(auditentry.EntityType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityType(v)
+		return nil
+	case auditentry.FieldEntityID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityID(v)
+		return nil
+	case auditentry.FieldEntityName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityName(v)
+		return nil
+	case auditentry.FieldItemID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case auditentry.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case auditentry.FieldChanges:
+		v, ok := value.([]types.FieldChange)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChanges(v)
 		return nil
 	}
-	return fmt.Errorf("unknown AuthTokens field %s", name)
+	return fmt.Errorf("unknown AuditEntry field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AuthTokensMutation) AddedFields() []string {
+func (m *AuditEntryMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AuthTokensMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuditEntryMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthTokensMutation) AddField(name string, value ent.Value) error {
+func (m *AuditEntryMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown AuthTokens numeric field %s", name)
+	return fmt.Errorf("unknown AuditEntry numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AuthTokensMutation) ClearedFields() []string {
-	return nil
+func (m *AuditEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditentry.FieldEntityName) {
+		fields = append(fields, auditentry.FieldEntityName)
+	}
+	if m.FieldCleared(auditentry.FieldItemID) {
+		fields = append(fields, auditentry.FieldItemID)
+	}
+	if m.FieldCleared(auditentry.FieldUserID) {
+		fields = append(fields, auditentry.FieldUserID)
+	}
+	if m.FieldCleared(auditentry.FieldChanges) {
+		fields = append(fields, auditentry.FieldChanges)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AuthTokensMutation) FieldCleared(name string) bool {
+func (m *AuditEntryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AuthTokensMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown AuthTokens nullable field %s", name)
+func (m *AuditEntryMutation) ClearField(name string) error {
+	switch name {
+	case auditentry.FieldEntityName:
+		m.ClearEntityName()
+		return nil
+	case auditentry.FieldItemID:
+		m.ClearItemID()
+		return nil
+	case auditentry.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case auditentry.FieldChanges:
+		m.ClearChanges()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEntry nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AuthTokensMutation) ResetField(name string) error {
+func (m *AuditEntryMutation) ResetField(name string) error {
 	switch name {
-	case authtokens.FieldCreatedAt:
+	case auditentry.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case authtokens.FieldUpdatedAt:
+	case auditentry.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case authtokens.FieldToken:
-		m.ResetToken()
+	case auditentry.FieldGroupID:
+		m.ResetGroupID()
 		return nil
-	case authtokens.FieldExpiresAt:
-		m.ResetExpiresAt()
+	case auditentry.FieldAction:
+		m.ResetAction()
+		return nil
+	case auditentry.FieldEntityType:
+		m.ResetEntityType()
+		return nil
+	case auditentry.FieldEntityID:
+		m.ResetEntityID()
+		return nil
+	case auditentry.FieldEntityName:
+		m.ResetEntityName()
+		return nil
+	case auditentry.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case auditentry.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case auditentry.FieldChanges:
+		m.ResetChanges()
 		return nil
 	}
-	return fmt.Errorf("unknown AuthTokens field %s", name)
+	return fmt.Errorf("unknown AuditEntry field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuthTokensMutation) AddedEdges() []string {
+func (m *AuditEntryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, authtokens.EdgeUser)
+	if m.group != nil {
+		edges = append(edges, auditentry.EdgeGroup)
 	}
-	if m.roles != nil {
-		edges = append(edges, authtokens.EdgeRoles)
+	if m.user != nil {
+		edges = append(edges, auditentry.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AuthTokensMutation) AddedIDs(name string) []ent.Value {
+func (m *AuditEntryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case authtokens.EdgeUser:
-		if id := m.user; id != nil {
+	case auditentry.EdgeGroup:
+		if id := m.group; id != nil {
 			return []ent.Value{*id}
 		}
-	case authtokens.EdgeRoles:
-		if id := m.roles; id != nil {
+	case auditentry.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1622,101 +1644,95 @@ func (m *AuthTokensMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuthTokensMutation) RemovedEdges() []string {
+func (m *AuditEntryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AuthTokensMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuditEntryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuthTokensMutation) ClearedEdges() []string {
+func (m *AuditEntryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, authtokens.EdgeUser)
+	if m.clearedgroup {
+		edges = append(edges, auditentry.EdgeGroup)
 	}
-	if m.clearedroles {
-		edges = append(edges, authtokens.EdgeRoles)
+	if m.cleareduser {
+		edges = append(edges, auditentry.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AuthTokensMutation) EdgeCleared(name string) bool {
+func (m *AuditEntryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case authtokens.EdgeUser:
+	case auditentry.EdgeGroup:
+		return m.clearedgroup
+	case auditentry.EdgeUser:
 		return m.cleareduser
-	case authtokens.EdgeRoles:
-		return m.clearedroles
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AuthTokensMutation) ClearEdge(name string) error {
+func (m *AuditEntryMutation) ClearEdge(name string) error {
 	switch name {
-	case authtokens.EdgeUser:
-		m.ClearUser()
+	case auditentry.EdgeGroup:
+		m.ClearGroup()
 		return nil
-	case authtokens.EdgeRoles:
-		m.ClearRoles()
+	case auditentry.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown AuthTokens unique edge %s", name)
+	return fmt.Errorf("unknown AuditEntry unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AuthTokensMutation) ResetEdge(name string) error {
+func (m *AuditEntryMutation) ResetEdge(name string) error {
 	switch name {
-	case authtokens.EdgeUser:
-		m.ResetUser()
+	case auditentry.EdgeGroup:
+		m.ResetGroup()
 		return nil
-	case authtokens.EdgeRoles:
-		m.ResetRoles()
+	case auditentry.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown AuthTokens edge %s", name)
+	return fmt.Errorf("unknown AuditEntry edge %s", name)
 }
 
-// DocumentMutation represents an operation that mutates the Document nodes in the graph.
-type DocumentMutation struct {
+// AuthRolesMutation represents an operation that mutates the AuthRoles nodes in the graph.
+type AuthRolesMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	created_at         *time.Time
-	updated_at         *time.Time
-	title              *string
-	_path              *string
-	clearedFields      map[string]struct{}
-	group              *uuid.UUID
-	clearedgroup       bool
-	attachments        map[uuid.UUID]struct{}
-	removedattachments map[uuid.UUID]struct{}
-	clearedattachments bool
-	done               bool
-	oldValue           func(context.Context) (*Document, error)
-	predicates         []predicate.Document
+	op            Op
+	typ           string
+	id            *int
+	role          *authroles.Role
+	clearedFields map[string]struct{}
+	token         *uuid.UUID
+	clearedtoken  bool
+	done          bool
+	oldValue      func(context.Context) (*AuthRoles, error)
+	predicates    []predicate.AuthRoles
 }
 
-var _ ent.Mutation = (*DocumentMutation)(nil)
+var _ ent.Mutation = (*AuthRolesMutation)(nil)
 
-// documentOption allows management of the mutation configuration using functional options.
-type documentOption func(*DocumentMutation)
+// authrolesOption allows management of the mutation configuration using functional options.
+type authrolesOption func(*AuthRolesMutation)
 
-// newDocumentMutation creates new mutation for the Document entity.
-func newDocumentMutation(c config, op Op, opts ...documentOption) *DocumentMutation {
-	m := &DocumentMutation{
+// newAuthRolesMutation creates new mutation for the AuthRoles entity.
+func newAuthRolesMutation(c config, op Op, opts ...authrolesOption) *AuthRolesMutation {
+	m := &AuthRolesMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDocument,
+		typ:           TypeAuthRoles,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1725,20 +1741,20 @@ func newDocumentMutation(c config, op Op, opts ...documentOption) *DocumentMutat
 	return m
 }
 
-// withDocumentID sets the ID field of the mutation.
-func withDocumentID(id uuid.UUID) documentOption {
-	return func(m *DocumentMutation) {
+// withAuthRolesID sets the ID field of the mutation.
+func withAuthRolesID(id int) authrolesOption {
+	return func(m *AuthRolesMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Document
+			value *AuthRoles
 		)
-		m.oldValue = func(ctx context.Context) (*Document, error) {
+		m.oldValue = func(ctx context.Context) (*AuthRoles, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Document.Get(ctx, id)
+					value, err = m.Client().AuthRoles.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1747,10 +1763,10 @@ func withDocumentID(id uuid.UUID) documentOption {
 	}
 }
 
-// withDocument sets the old Document of the mutation.
-func withDocument(node *Document) documentOption {
-	return func(m *DocumentMutation) {
-		m.oldValue = func(context.Context) (*Document, error) {
+// withAuthRoles sets the old AuthRoles of the mutation.
+func withAuthRoles(node *AuthRoles) authrolesOption {
+	return func(m *AuthRolesMutation) {
+		m.oldValue = func(context.Context) (*AuthRoles, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1759,7 +1775,7 @@ func withDocument(node *Document) documentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DocumentMutation) Client() *Client {
+func (m AuthRolesMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1767,7 +1783,7 @@ func (m DocumentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DocumentMutation) Tx() (*Tx, error) {
+func (m AuthRolesMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1776,15 +1792,9 @@ func (m DocumentMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Document entities.
-func (m *DocumentMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DocumentMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AuthRolesMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1795,304 +1805,133 @@ func (m *DocumentMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DocumentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AuthRolesMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Document.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuthRoles.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DocumentMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetRole sets the "role" field.
+func (m *AuthRolesMutation) SetRole(a authroles.Role) {
+	m.role = &a
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DocumentMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Role returns the value of the "role" field in the mutation.
+func (m *AuthRolesMutation) Role() (r authroles.Role, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the AuthRoles entity.
+// If the AuthRoles object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuthRolesMutation) OldRole(ctx context.Context) (v authroles.Role, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Role, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DocumentMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetRole resets all changes to the "role" field.
+func (m *AuthRolesMutation) ResetRole() {
+	m.role = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *DocumentMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetTokenID sets the "token" edge to the AuthTokens entity by id.
+func (m *AuthRolesMutation) SetTokenID(id uuid.UUID) {
+	m.token = &id
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DocumentMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearToken clears the "token" edge to the AuthTokens entity.
+func (m *AuthRolesMutation) ClearToken() {
+	m.clearedtoken = true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// TokenCleared reports if the "token" edge to the AuthTokens entity was cleared.
+func (m *AuthRolesMutation) TokenCleared() bool {
+	return m.clearedtoken
+}
+
+// TokenID returns the "token" edge ID in the mutation.
+func (m *AuthRolesMutation) TokenID() (id uuid.UUID, exists bool) {
+	if m.token != nil {
+		return *m.token, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	return
+}
+
+// TokenIDs returns the "token" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TokenID instead. It exists only for internal usage by the builders.
+func (m *AuthRolesMutation) TokenIDs() (ids []uuid.UUID) {
+	if id := m.token; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DocumentMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetToken resets all changes to the "token" edge.
+func (m *AuthRolesMutation) ResetToken() {
+	m.token = nil
+	m.clearedtoken = false
 }
 
-// SetTitle sets the "title" field.
-func (m *DocumentMutation) SetTitle(s string) {
-	m.title = &s
+// Where appends a list predicates to the AuthRolesMutation builder.
+func (m *AuthRolesMutation) Where(ps ...predicate.AuthRoles) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *DocumentMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the AuthRolesMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuthRolesMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuthRoles, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldTitle returns the old "title" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
-	}
-	return oldValue.Title, nil
+// Op returns the operation name.
+func (m *AuthRolesMutation) Op() Op {
+	return m.op
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *DocumentMutation) ResetTitle() {
-	m.title = nil
+// SetOp allows setting the mutation operation.
+func (m *AuthRolesMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetPath sets the "path" field.
-func (m *DocumentMutation) SetPath(s string) {
-	m._path = &s
-}
-
-// Path returns the value of the "path" field in the mutation.
-func (m *DocumentMutation) Path() (r string, exists bool) {
-	v := m._path
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPath returns the old "path" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldPath(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPath is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPath requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPath: %w", err)
-	}
-	return oldValue.Path, nil
-}
-
-// ResetPath resets all changes to the "path" field.
-func (m *DocumentMutation) ResetPath() {
-	m._path = nil
-}
-
-// SetGroupID sets the "group" edge to the Group entity by id.
-func (m *DocumentMutation) SetGroupID(id uuid.UUID) {
-	m.group = &id
-}
-
-// ClearGroup clears the "group" edge to the Group entity.
-func (m *DocumentMutation) ClearGroup() {
-	m.clearedgroup = true
-}
-
-// GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *DocumentMutation) GroupCleared() bool {
-	return m.clearedgroup
-}
-
-// GroupID returns the "group" edge ID in the mutation.
-func (m *DocumentMutation) GroupID() (id uuid.UUID, exists bool) {
-	if m.group != nil {
-		return *m.group, true
-	}
-	return
-}
-
-// GroupIDs returns the "group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *DocumentMutation) GroupIDs() (ids []uuid.UUID) {
-	if id := m.group; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetGroup resets all changes to the "group" edge.
-func (m *DocumentMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
-}
-
-// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
-func (m *DocumentMutation) AddAttachmentIDs(ids ...uuid.UUID) {
-	if m.attachments == nil {
-		m.attachments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.attachments[ids[i]] = struct{}{}
-	}
-}
-
-// ClearAttachments clears the "attachments" edge to the Attachment entity.
-func (m *DocumentMutation) ClearAttachments() {
-	m.clearedattachments = true
-}
-
-// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
-func (m *DocumentMutation) AttachmentsCleared() bool {
-	return m.clearedattachments
-}
-
-// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
-func (m *DocumentMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
-	if m.removedattachments == nil {
-		m.removedattachments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.attachments, ids[i])
-		m.removedattachments[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
-func (m *DocumentMutation) RemovedAttachmentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedattachments {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
-func (m *DocumentMutation) AttachmentsIDs() (ids []uuid.UUID) {
-	for id := range m.attachments {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetAttachments resets all changes to the "attachments" edge.
-func (m *DocumentMutation) ResetAttachments() {
-	m.attachments = nil
-	m.clearedattachments = false
-	m.removedattachments = nil
-}
-
-// Where appends a list predicates to the DocumentMutation builder.
-func (m *DocumentMutation) Where(ps ...predicate.Document) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the DocumentMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DocumentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Document, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *DocumentMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *DocumentMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (Document).
-func (m *DocumentMutation) Type() string {
-	return m.typ
+// Type returns the node type of this mutation (AuthRoles).
+func (m *AuthRolesMutation) Type() string {
+	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DocumentMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.created_at != nil {
-		fields = append(fields, document.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, document.FieldUpdatedAt)
-	}
-	if m.title != nil {
-		fields = append(fields, document.FieldTitle)
-	}
-	if m._path != nil {
-		fields = append(fields, document.FieldPath)
+func (m *AuthRolesMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.role != nil {
+		fields = append(fields, authroles.FieldRole)
 	}
 	return fields
 }
@@ -2100,16 +1939,10 @@ func (m *DocumentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DocumentMutation) Field(name string) (ent.Value, bool) {
+func (m *AuthRolesMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case document.FieldCreatedAt:
-		return m.CreatedAt()
-	case document.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case document.FieldTitle:
-		return m.Title()
-	case document.FieldPath:
-		return m.Path()
+	case authroles.FieldRole:
+		return m.Role()
 	}
 	return nil, false
 }
@@ -2117,268 +1950,198 @@ func (m *DocumentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DocumentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuthRolesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case document.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case document.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case document.FieldTitle:
-		return m.OldTitle(ctx)
-	case document.FieldPath:
-		return m.OldPath(ctx)
+	case authroles.FieldRole:
+		return m.OldRole(ctx)
 	}
-	return nil, fmt.Errorf("unknown Document field %s", name)
+	return nil, fmt.Errorf("unknown AuthRoles field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DocumentMutation) SetField(name string, value ent.Value) error {
+func (m *AuthRolesMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case document.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case document.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case document.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case document.FieldPath:
-		v, ok := value.(string)
+	case authroles.FieldRole:
+		v, ok := value.(authroles.Role)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPath(v)
+		m.SetRole(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Document field %s", name)
+	return fmt.Errorf("unknown AuthRoles field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DocumentMutation) AddedFields() []string {
+func (m *AuthRolesMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DocumentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuthRolesMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DocumentMutation) AddField(name string, value ent.Value) error {
+func (m *AuthRolesMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Document numeric field %s", name)
+	return fmt.Errorf("unknown AuthRoles numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DocumentMutation) ClearedFields() []string {
+func (m *AuthRolesMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DocumentMutation) FieldCleared(name string) bool {
+func (m *AuthRolesMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DocumentMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Document nullable field %s", name)
+func (m *AuthRolesMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AuthRoles nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DocumentMutation) ResetField(name string) error {
+func (m *AuthRolesMutation) ResetField(name string) error {
 	switch name {
-	case document.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case document.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case document.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case document.FieldPath:
-		m.ResetPath()
+	case authroles.FieldRole:
+		m.ResetRole()
 		return nil
 	}
-	return fmt.Errorf("unknown Document field %s", name)
+	return fmt.Errorf("unknown AuthRoles field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DocumentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.group != nil {
-		edges = append(edges, document.EdgeGroup)
-	}
-	if m.attachments != nil {
-		edges = append(edges, document.EdgeAttachments)
+func (m *AuthRolesMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.token != nil {
+		edges = append(edges, authroles.EdgeToken)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DocumentMutation) AddedIDs(name string) []ent.Value {
+func (m *AuthRolesMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case document.EdgeGroup:
-		if id := m.group; id != nil {
+	case authroles.EdgeToken:
+		if id := m.token; id != nil {
 			return []ent.Value{*id}
 		}
-	case document.EdgeAttachments:
-		ids := make([]ent.Value, 0, len(m.attachments))
-		for id := range m.attachments {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DocumentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedattachments != nil {
-		edges = append(edges, document.EdgeAttachments)
-	}
+func (m *AuthRolesMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DocumentMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case document.EdgeAttachments:
-		ids := make([]ent.Value, 0, len(m.removedattachments))
-		for id := range m.removedattachments {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AuthRolesMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DocumentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedgroup {
-		edges = append(edges, document.EdgeGroup)
-	}
-	if m.clearedattachments {
-		edges = append(edges, document.EdgeAttachments)
+func (m *AuthRolesMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtoken {
+		edges = append(edges, authroles.EdgeToken)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DocumentMutation) EdgeCleared(name string) bool {
+func (m *AuthRolesMutation) EdgeCleared(name string) bool {
 	switch name {
-	case document.EdgeGroup:
-		return m.clearedgroup
-	case document.EdgeAttachments:
-		return m.clearedattachments
+	case authroles.EdgeToken:
+		return m.clearedtoken
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DocumentMutation) ClearEdge(name string) error {
+func (m *AuthRolesMutation) ClearEdge(name string) error {
 	switch name {
-	case document.EdgeGroup:
-		m.ClearGroup()
+	case authroles.EdgeToken:
+		m.ClearToken()
 		return nil
 	}
-	return fmt.Errorf("unknown Document unique edge %s", name)
+	return fmt.Errorf("unknown AuthRoles unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DocumentMutation) ResetEdge(name string) error {
+func (m *AuthRolesMutation) ResetEdge(name string) error {
 	switch name {
-	case document.EdgeGroup:
-		m.ResetGroup()
-		return nil
-	case document.EdgeAttachments:
-		m.ResetAttachments()
+	case authroles.EdgeToken:
+		m.ResetToken()
 		return nil
 	}
-	return fmt.Errorf("unknown Document edge %s", name)
+	return fmt.Errorf("unknown AuthRoles edge %s", name)
 }
 
-// GroupMutation represents an operation that mutates the Group nodes in the graph.
-type GroupMutation struct {
+// AuthTokensMutation represents an operation that mutates the AuthTokens nodes in the graph.
+type AuthTokensMutation struct {
 	config
 	op                       Op
 	typ                      string
 	id                       *uuid.UUID
 	created_at               *time.Time
 	updated_at               *time.Time
+	token                    *[]byte
+	expires_at               *time.Time
 	name                     *string
-	currency                 *string
+	last_used_at             *time.Time
+	ip_address               *string
+	user_agent               *string
 	clearedFields            map[string]struct{}
-	users                    map[uuid.UUID]struct{}
-	removedusers             map[uuid.UUID]struct{}
-	clearedusers             bool
-	locations                map[uuid.UUID]struct{}
-	removedlocations         map[uuid.UUID]struct{}
-	clearedlocations         bool
-	items                    map[uuid.UUID]struct{}
-	removeditems             map[uuid.UUID]struct{}
-	cleareditems             bool
-	labels                   map[uuid.UUID]struct{}
-	removedlabels            map[uuid.UUID]struct{}
-	clearedlabels            bool
-	documents                map[uuid.UUID]struct{}
-	removeddocuments         map[uuid.UUID]struct{}
-	cleareddocuments         bool
-	invitation_tokens        map[uuid.UUID]struct{}
-	removedinvitation_tokens map[uuid.UUID]struct{}
-	clearedinvitation_tokens bool
-	notifiers                map[uuid.UUID]struct{}
-	removednotifiers         map[uuid.UUID]struct{}
-	clearednotifiers         bool
+	user                     *uuid.UUID
+	cleareduser              bool
+	session                  *uuid.UUID
+	clearedsession           bool
+	attachment_tokens        map[uuid.UUID]struct{}
+	removedattachment_tokens map[uuid.UUID]struct{}
+	clearedattachment_tokens bool
+	group                    *uuid.UUID
+	clearedgroup             bool
+	roles                    *int
+	clearedroles             bool
 	done                     bool
-	oldValue                 func(context.Context) (*Group, error)
-	predicates               []predicate.Group
+	oldValue                 func(context.Context) (*AuthTokens, error)
+	predicates               []predicate.AuthTokens
 }
 
-var _ ent.Mutation = (*GroupMutation)(nil)
+var _ ent.Mutation = (*AuthTokensMutation)(nil)
 
-// groupOption allows management of the mutation configuration using functional options.
-type groupOption func(*GroupMutation)
+// authtokensOption allows management of the mutation configuration using functional options.
+type authtokensOption func(*AuthTokensMutation)
 
-// newGroupMutation creates new mutation for the Group entity.
-func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
-	m := &GroupMutation{
+// newAuthTokensMutation creates new mutation for the AuthTokens entity.
+func newAuthTokensMutation(c config, op Op, opts ...authtokensOption) *AuthTokensMutation {
+	m := &AuthTokensMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGroup,
+		typ:           TypeAuthTokens,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2387,20 +2150,20 @@ func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
 	return m
 }
 
-// withGroupID sets the ID field of the mutation.
-func withGroupID(id uuid.UUID) groupOption {
-	return func(m *GroupMutation) {
+// withAuthTokensID sets the ID field of the mutation.
+func withAuthTokensID(id uuid.UUID) authtokensOption {
+	return func(m *AuthTokensMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Group
+			value *AuthTokens
 		)
-		m.oldValue = func(ctx context.Context) (*Group, error) {
+		m.oldValue = func(ctx context.Context) (*AuthTokens, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Group.Get(ctx, id)
+					value, err = m.Client().AuthTokens.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2409,10 +2172,10 @@ func withGroupID(id uuid.UUID) groupOption {
 	}
 }
 
-// withGroup sets the old Group of the mutation.
-func withGroup(node *Group) groupOption {
-	return func(m *GroupMutation) {
-		m.oldValue = func(context.Context) (*Group, error) {
+// withAuthTokens sets the old AuthTokens of the mutation.
+func withAuthTokens(node *AuthTokens) authtokensOption {
+	return func(m *AuthTokensMutation) {
+		m.oldValue = func(context.Context) (*AuthTokens, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2421,7 +2184,7 @@ func withGroup(node *Group) groupOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GroupMutation) Client() *Client {
+func (m AuthTokensMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2429,7 +2192,7 @@ func (m GroupMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GroupMutation) Tx() (*Tx, error) {
+func (m AuthTokensMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2439,14 +2202,14 @@ func (m GroupMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Group entities.
-func (m *GroupMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of AuthTokens entities.
+func (m *AuthTokensMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GroupMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AuthTokensMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2457,7 +2220,7 @@ func (m *GroupMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GroupMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AuthTokensMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2466,19 +2229,19 @@ func (m *GroupMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuthTokens.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *GroupMutation) SetCreatedAt(t time.Time) {
+func (m *AuthTokensMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AuthTokensMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2486,10 +2249,10 @@ func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuthTokens entity.
+// If the AuthTokens object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuthTokensMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2504,17 +2267,17 @@ func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GroupMutation) ResetCreatedAt() {
+func (m *AuthTokensMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *GroupMutation) SetUpdatedAt(t time.Time) {
+func (m *AuthTokensMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *AuthTokensMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2522,10 +2285,10 @@ func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the AuthTokens entity.
+// If the AuthTokens object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuthTokensMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2540,469 +2303,571 @@ func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *GroupMutation) ResetUpdatedAt() {
+func (m *AuthTokensMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetName sets the "name" field.
-func (m *GroupMutation) SetName(s string) {
-	m.name = &s
+// SetToken sets the "token" field.
+func (m *AuthTokensMutation) SetToken(b []byte) {
+	m.token = &b
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *GroupMutation) Name() (r string, exists bool) {
-	v := m.name
+// Token returns the value of the "token" field in the mutation.
+func (m *AuthTokensMutation) Token() (r []byte, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the AuthTokens entity.
+// If the AuthTokens object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AuthTokensMutation) OldToken(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Token, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *GroupMutation) ResetName() {
-	m.name = nil
+// ResetToken resets all changes to the "token" field.
+func (m *AuthTokensMutation) ResetToken() {
+	m.token = nil
 }
 
-// SetCurrency sets the "currency" field.
-func (m *GroupMutation) SetCurrency(s string) {
-	m.currency = &s
+// SetExpiresAt sets the "expires_at" field.
+func (m *AuthTokensMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Currency returns the value of the "currency" field in the mutation.
-func (m *GroupMutation) Currency() (r string, exists bool) {
-	v := m.currency
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *AuthTokensMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCurrency returns the old "currency" field's value of the Group entity.
-// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the AuthTokens entity.
+// If the AuthTokens object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMutation) OldCurrency(ctx context.Context) (v string, err error) {
+func (m *AuthTokensMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrency requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Currency, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetCurrency resets all changes to the "currency" field.
-func (m *GroupMutation) ResetCurrency() {
-	m.currency = nil
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *AuthTokensMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *GroupMutation) AddUserIDs(ids ...uuid.UUID) {
-	if m.users == nil {
-		m.users = make(map[uuid.UUID]struct{})
+// SetName sets the "name" field.
+func (m *AuthTokensMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *AuthTokensMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the AuthTokens entity.
+// If the AuthTokens object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthTokensMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
+	return oldValue.Name, nil
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *GroupMutation) ClearUsers() {
-	m.clearedusers = true
+// ClearName clears the value of the "name" field.
+func (m *AuthTokensMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[authtokens.FieldName] = struct{}{}
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *GroupMutation) UsersCleared() bool {
-	return m.clearedusers
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *AuthTokensMutation) NameCleared() bool {
+	_, ok := m.clearedFields[authtokens.FieldName]
+	return ok
 }
 
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *GroupMutation) RemoveUserIDs(ids ...uuid.UUID) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
-	}
+// ResetName resets all changes to the "name" field.
+func (m *AuthTokensMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, authtokens.FieldName)
 }
 
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *GroupMutation) RemovedUsersIDs() (ids []uuid.UUID) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
-	}
-	return
+// SetSessionID sets the "session_id" field.
+func (m *AuthTokensMutation) SetSessionID(u uuid.UUID) {
+	m.session = &u
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *GroupMutation) UsersIDs() (ids []uuid.UUID) {
-	for id := range m.users {
-		ids = append(ids, id)
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *AuthTokensMutation) SessionID() (r uuid.UUID, exists bool) {
+	v := m.session
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetUsers resets all changes to the "users" edge.
-func (m *GroupMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
+	return *v, true
 }
 
-// AddLocationIDs adds the "locations" edge to the Location entity by ids.
-func (m *GroupMutation) AddLocationIDs(ids ...uuid.UUID) {
-	if m.locations == nil {
-		m.locations = make(map[uuid.UUID]struct{})
+// OldSessionID returns the old "session_id" field's value of the AuthTokens entity.
+// If the AuthTokens object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthTokensMutation) OldSessionID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.locations[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
 	}
+	return oldValue.SessionID, nil
 }
 
-// ClearLocations clears the "locations" edge to the Location entity.
-func (m *GroupMutation) ClearLocations() {
-	m.clearedlocations = true
+// ClearSessionID clears the value of the "session_id" field.
+func (m *AuthTokensMutation) ClearSessionID() {
+	m.session = nil
+	m.clearedFields[authtokens.FieldSessionID] = struct{}{}
 }
 
-// LocationsCleared reports if the "locations" edge to the Location entity was cleared.
-func (m *GroupMutation) LocationsCleared() bool {
-	return m.clearedlocations
+// SessionIDCleared returns if the "session_id" field was cleared in this mutation.
+func (m *AuthTokensMutation) SessionIDCleared() bool {
+	_, ok := m.clearedFields[authtokens.FieldSessionID]
+	return ok
 }
 
-// RemoveLocationIDs removes the "locations" edge to the Location entity by IDs.
-func (m *GroupMutation) RemoveLocationIDs(ids ...uuid.UUID) {
-	if m.removedlocations == nil {
-		m.removedlocations = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.locations, ids[i])
-		m.removedlocations[ids[i]] = struct{}{}
-	}
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *AuthTokensMutation) ResetSessionID() {
+	m.session = nil
+	delete(m.clearedFields, authtokens.FieldSessionID)
 }
 
-// RemovedLocations returns the removed IDs of the "locations" edge to the Location entity.
-func (m *GroupMutation) RemovedLocationsIDs() (ids []uuid.UUID) {
-	for id := range m.removedlocations {
-		ids = append(ids, id)
-	}
-	return
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *AuthTokensMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// LocationsIDs returns the "locations" edge IDs in the mutation.
-func (m *GroupMutation) LocationsIDs() (ids []uuid.UUID) {
-	for id := range m.locations {
-		ids = append(ids, id)
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *AuthTokensMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetLocations resets all changes to the "locations" edge.
-func (m *GroupMutation) ResetLocations() {
-	m.locations = nil
-	m.clearedlocations = false
-	m.removedlocations = nil
+	return *v, true
 }
 
-// AddItemIDs adds the "items" edge to the Item entity by ids.
-func (m *GroupMutation) AddItemIDs(ids ...uuid.UUID) {
-	if m.items == nil {
-		m.items = make(map[uuid.UUID]struct{})
+// OldLastUsedAt returns the old "last_used_at" field's value of the AuthTokens entity.
+// If the AuthTokens object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthTokensMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.items[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
+	return oldValue.LastUsedAt, nil
 }
 
-// ClearItems clears the "items" edge to the Item entity.
-func (m *GroupMutation) ClearItems() {
-	m.cleareditems = true
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *AuthTokensMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[authtokens.FieldLastUsedAt] = struct{}{}
 }
 
-// ItemsCleared reports if the "items" edge to the Item entity was cleared.
-func (m *GroupMutation) ItemsCleared() bool {
-	return m.cleareditems
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *AuthTokensMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[authtokens.FieldLastUsedAt]
+	return ok
 }
 
-// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
-func (m *GroupMutation) RemoveItemIDs(ids ...uuid.UUID) {
-	if m.removeditems == nil {
-		m.removeditems = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.items, ids[i])
-		m.removeditems[ids[i]] = struct{}{}
-	}
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *AuthTokensMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, authtokens.FieldLastUsedAt)
 }
 
-// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
-func (m *GroupMutation) RemovedItemsIDs() (ids []uuid.UUID) {
-	for id := range m.removeditems {
-		ids = append(ids, id)
+// SetIPAddress sets the "ip_address" field.
+func (m *AuthTokensMutation) SetIPAddress(s string) {
+	m.ip_address = &s
+}
+
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *AuthTokensMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ItemsIDs returns the "items" edge IDs in the mutation.
-func (m *GroupMutation) ItemsIDs() (ids []uuid.UUID) {
-	for id := range m.items {
-		ids = append(ids, id)
+// OldIPAddress returns the old "ip_address" field's value of the AuthTokens entity.
+// If the AuthTokens object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthTokensMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+	}
+	return oldValue.IPAddress, nil
 }
 
-// ResetItems resets all changes to the "items" edge.
-func (m *GroupMutation) ResetItems() {
-	m.items = nil
-	m.cleareditems = false
-	m.removeditems = nil
+// ClearIPAddress clears the value of the "ip_address" field.
+func (m *AuthTokensMutation) ClearIPAddress() {
+	m.ip_address = nil
+	m.clearedFields[authtokens.FieldIPAddress] = struct{}{}
 }
 
-// AddLabelIDs adds the "labels" edge to the Label entity by ids.
-func (m *GroupMutation) AddLabelIDs(ids ...uuid.UUID) {
-	if m.labels == nil {
-		m.labels = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.labels[ids[i]] = struct{}{}
-	}
+// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
+func (m *AuthTokensMutation) IPAddressCleared() bool {
+	_, ok := m.clearedFields[authtokens.FieldIPAddress]
+	return ok
 }
 
-// ClearLabels clears the "labels" edge to the Label entity.
-func (m *GroupMutation) ClearLabels() {
-	m.clearedlabels = true
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *AuthTokensMutation) ResetIPAddress() {
+	m.ip_address = nil
+	delete(m.clearedFields, authtokens.FieldIPAddress)
 }
 
-// LabelsCleared reports if the "labels" edge to the Label entity was cleared.
-func (m *GroupMutation) LabelsCleared() bool {
-	return m.clearedlabels
+// SetUserAgent sets the "user_agent" field.
+func (m *AuthTokensMutation) SetUserAgent(s string) {
+	m.user_agent = &s
 }
 
-// RemoveLabelIDs removes the "labels" edge to the Label entity by IDs.
-func (m *GroupMutation) RemoveLabelIDs(ids ...uuid.UUID) {
-	if m.removedlabels == nil {
-		m.removedlabels = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.labels, ids[i])
-		m.removedlabels[ids[i]] = struct{}{}
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *AuthTokensMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedLabels returns the removed IDs of the "labels" edge to the Label entity.
-func (m *GroupMutation) RemovedLabelsIDs() (ids []uuid.UUID) {
-	for id := range m.removedlabels {
-		ids = append(ids, id)
+// OldUserAgent returns the old "user_agent" field's value of the AuthTokens entity.
+// If the AuthTokens object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthTokensMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
 }
 
-// LabelsIDs returns the "labels" edge IDs in the mutation.
-func (m *GroupMutation) LabelsIDs() (ids []uuid.UUID) {
-	for id := range m.labels {
-		ids = append(ids, id)
-	}
-	return
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *AuthTokensMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[authtokens.FieldUserAgent] = struct{}{}
 }
 
-// ResetLabels resets all changes to the "labels" edge.
-func (m *GroupMutation) ResetLabels() {
-	m.labels = nil
-	m.clearedlabels = false
-	m.removedlabels = nil
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *AuthTokensMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[authtokens.FieldUserAgent]
+	return ok
 }
 
-// AddDocumentIDs adds the "documents" edge to the Document entity by ids.
-func (m *GroupMutation) AddDocumentIDs(ids ...uuid.UUID) {
-	if m.documents == nil {
-		m.documents = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.documents[ids[i]] = struct{}{}
-	}
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *AuthTokensMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, authtokens.FieldUserAgent)
 }
 
-// ClearDocuments clears the "documents" edge to the Document entity.
-func (m *GroupMutation) ClearDocuments() {
-	m.cleareddocuments = true
+// SetGroupID sets the "group_id" field.
+func (m *AuthTokensMutation) SetGroupID(u uuid.UUID) {
+	m.group = &u
 }
 
-// DocumentsCleared reports if the "documents" edge to the Document entity was cleared.
-func (m *GroupMutation) DocumentsCleared() bool {
-	return m.cleareddocuments
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *AuthTokensMutation) GroupID() (r uuid.UUID, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveDocumentIDs removes the "documents" edge to the Document entity by IDs.
-func (m *GroupMutation) RemoveDocumentIDs(ids ...uuid.UUID) {
-	if m.removeddocuments == nil {
-		m.removeddocuments = make(map[uuid.UUID]struct{})
+// OldGroupID returns the old "group_id" field's value of the AuthTokens entity.
+// If the AuthTokens object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthTokensMutation) OldGroupID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.documents, ids[i])
-		m.removeddocuments[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
 	}
+	return oldValue.GroupID, nil
 }
 
-// RemovedDocuments returns the removed IDs of the "documents" edge to the Document entity.
-func (m *GroupMutation) RemovedDocumentsIDs() (ids []uuid.UUID) {
-	for id := range m.removeddocuments {
-		ids = append(ids, id)
-	}
-	return
+// ClearGroupID clears the value of the "group_id" field.
+func (m *AuthTokensMutation) ClearGroupID() {
+	m.group = nil
+	m.clearedFields[authtokens.FieldGroupID] = struct{}{}
 }
 
-// DocumentsIDs returns the "documents" edge IDs in the mutation.
-func (m *GroupMutation) DocumentsIDs() (ids []uuid.UUID) {
-	for id := range m.documents {
-		ids = append(ids, id)
-	}
-	return
+// GroupIDCleared returns if the "group_id" field was cleared in this mutation.
+func (m *AuthTokensMutation) GroupIDCleared() bool {
+	_, ok := m.clearedFields[authtokens.FieldGroupID]
+	return ok
 }
 
-// ResetDocuments resets all changes to the "documents" edge.
-func (m *GroupMutation) ResetDocuments() {
-	m.documents = nil
-	m.cleareddocuments = false
-	m.removeddocuments = nil
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *AuthTokensMutation) ResetGroupID() {
+	m.group = nil
+	delete(m.clearedFields, authtokens.FieldGroupID)
 }
 
-// AddInvitationTokenIDs adds the "invitation_tokens" edge to the GroupInvitationToken entity by ids.
-func (m *GroupMutation) AddInvitationTokenIDs(ids ...uuid.UUID) {
-	if m.invitation_tokens == nil {
-		m.invitation_tokens = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.invitation_tokens[ids[i]] = struct{}{}
-	}
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *AuthTokensMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
 }
 
-// ClearInvitationTokens clears the "invitation_tokens" edge to the GroupInvitationToken entity.
-func (m *GroupMutation) ClearInvitationTokens() {
-	m.clearedinvitation_tokens = true
+// ClearUser clears the "user" edge to the User entity.
+func (m *AuthTokensMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// InvitationTokensCleared reports if the "invitation_tokens" edge to the GroupInvitationToken entity was cleared.
-func (m *GroupMutation) InvitationTokensCleared() bool {
-	return m.clearedinvitation_tokens
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *AuthTokensMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// RemoveInvitationTokenIDs removes the "invitation_tokens" edge to the GroupInvitationToken entity by IDs.
-func (m *GroupMutation) RemoveInvitationTokenIDs(ids ...uuid.UUID) {
-	if m.removedinvitation_tokens == nil {
-		m.removedinvitation_tokens = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.invitation_tokens, ids[i])
-		m.removedinvitation_tokens[ids[i]] = struct{}{}
+// UserID returns the "user" edge ID in the mutation.
+func (m *AuthTokensMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
+	return
 }
 
-// RemovedInvitationTokens returns the removed IDs of the "invitation_tokens" edge to the GroupInvitationToken entity.
-func (m *GroupMutation) RemovedInvitationTokensIDs() (ids []uuid.UUID) {
-	for id := range m.removedinvitation_tokens {
-		ids = append(ids, id)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *AuthTokensMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// InvitationTokensIDs returns the "invitation_tokens" edge IDs in the mutation.
-func (m *GroupMutation) InvitationTokensIDs() (ids []uuid.UUID) {
-	for id := range m.invitation_tokens {
-		ids = append(ids, id)
+// ResetUser resets all changes to the "user" edge.
+func (m *AuthTokensMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearSession clears the "session" edge to the AuthTokens entity.
+func (m *AuthTokensMutation) ClearSession() {
+	m.clearedsession = true
+	m.clearedFields[authtokens.FieldSessionID] = struct{}{}
+}
+
+// SessionCleared reports if the "session" edge to the AuthTokens entity was cleared.
+func (m *AuthTokensMutation) SessionCleared() bool {
+	return m.SessionIDCleared() || m.clearedsession
+}
+
+// SessionIDs returns the "session" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SessionID instead. It exists only for internal usage by the builders.
+func (m *AuthTokensMutation) SessionIDs() (ids []uuid.UUID) {
+	if id := m.session; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetInvitationTokens resets all changes to the "invitation_tokens" edge.
-func (m *GroupMutation) ResetInvitationTokens() {
-	m.invitation_tokens = nil
-	m.clearedinvitation_tokens = false
-	m.removedinvitation_tokens = nil
+// ResetSession resets all changes to the "session" edge.
+func (m *AuthTokensMutation) ResetSession() {
+	m.session = nil
+	m.clearedsession = false
 }
 
-// AddNotifierIDs adds the "notifiers" edge to the Notifier entity by ids.
-func (m *GroupMutation) AddNotifierIDs(ids ...uuid.UUID) {
-	if m.notifiers == nil {
-		m.notifiers = make(map[uuid.UUID]struct{})
+// AddAttachmentTokenIDs adds the "attachment_tokens" edge to the AuthTokens entity by ids.
+func (m *AuthTokensMutation) AddAttachmentTokenIDs(ids ...uuid.UUID) {
+	if m.attachment_tokens == nil {
+		m.attachment_tokens = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.notifiers[ids[i]] = struct{}{}
+		m.attachment_tokens[ids[i]] = struct{}{}
 	}
 }
 
-// ClearNotifiers clears the "notifiers" edge to the Notifier entity.
-func (m *GroupMutation) ClearNotifiers() {
-	m.clearednotifiers = true
+// ClearAttachmentTokens clears the "attachment_tokens" edge to the AuthTokens entity.
+func (m *AuthTokensMutation) ClearAttachmentTokens() {
+	m.clearedattachment_tokens = true
 }
 
-// NotifiersCleared reports if the "notifiers" edge to the Notifier entity was cleared.
-func (m *GroupMutation) NotifiersCleared() bool {
-	return m.clearednotifiers
+// AttachmentTokensCleared reports if the "attachment_tokens" edge to the AuthTokens entity was cleared.
+func (m *AuthTokensMutation) AttachmentTokensCleared() bool {
+	return m.clearedattachment_tokens
 }
 
-// RemoveNotifierIDs removes the "notifiers" edge to the Notifier entity by IDs.
-func (m *GroupMutation) RemoveNotifierIDs(ids ...uuid.UUID) {
-	if m.removednotifiers == nil {
-		m.removednotifiers = make(map[uuid.UUID]struct{})
+// RemoveAttachmentTokenIDs removes the "attachment_tokens" edge to the AuthTokens entity by IDs.
+func (m *AuthTokensMutation) RemoveAttachmentTokenIDs(ids ...uuid.UUID) {
+	if m.removedattachment_tokens == nil {
+		m.removedattachment_tokens = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.notifiers, ids[i])
-		m.removednotifiers[ids[i]] = struct{}{}
+		delete(m.attachment_tokens, ids[i])
+		m.removedattachment_tokens[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedNotifiers returns the removed IDs of the "notifiers" edge to the Notifier entity.
-func (m *GroupMutation) RemovedNotifiersIDs() (ids []uuid.UUID) {
-	for id := range m.removednotifiers {
+// RemovedAttachmentTokens returns the removed IDs of the "attachment_tokens" edge to the AuthTokens entity.
+func (m *AuthTokensMutation) RemovedAttachmentTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedattachment_tokens {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// NotifiersIDs returns the "notifiers" edge IDs in the mutation.
-func (m *GroupMutation) NotifiersIDs() (ids []uuid.UUID) {
-	for id := range m.notifiers {
+// AttachmentTokensIDs returns the "attachment_tokens" edge IDs in the mutation.
+func (m *AuthTokensMutation) AttachmentTokensIDs() (ids []uuid.UUID) {
+	for id := range m.attachment_tokens {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetNotifiers resets all changes to the "notifiers" edge.
-func (m *GroupMutation) ResetNotifiers() {
-	m.notifiers = nil
-	m.clearednotifiers = false
-	m.removednotifiers = nil
+// ResetAttachmentTokens resets all changes to the "attachment_tokens" edge.
+func (m *AuthTokensMutation) ResetAttachmentTokens() {
+	m.attachment_tokens = nil
+	m.clearedattachment_tokens = false
+	m.removedattachment_tokens = nil
 }
 
-// Where appends a list predicates to the GroupMutation builder.
-func (m *GroupMutation) Where(ps ...predicate.Group) {
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *AuthTokensMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[authtokens.FieldGroupID] = struct{}{}
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *AuthTokensMutation) GroupCleared() bool {
+	return m.GroupIDCleared() || m.clearedgroup
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *AuthTokensMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *AuthTokensMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// SetRolesID sets the "roles" edge to the AuthRoles entity by id.
+func (m *AuthTokensMutation) SetRolesID(id int) {
+	m.roles = &id
+}
+
+// ClearRoles clears the "roles" edge to the AuthRoles entity.
+func (m *AuthTokensMutation) ClearRoles() {
+	m.clearedroles = true
+}
+
+// RolesCleared reports if the "roles" edge to the AuthRoles entity was cleared.
+func (m *AuthTokensMutation) RolesCleared() bool {
+	return m.clearedroles
+}
+
+// RolesID returns the "roles" edge ID in the mutation.
+func (m *AuthTokensMutation) RolesID() (id int, exists bool) {
+	if m.roles != nil {
+		return *m.roles, true
+	}
+	return
+}
+
+// RolesIDs returns the "roles" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RolesID instead. It exists only for internal usage by the builders.
+func (m *AuthTokensMutation) RolesIDs() (ids []int) {
+	if id := m.roles; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoles resets all changes to the "roles" edge.
+func (m *AuthTokensMutation) ResetRoles() {
+	m.roles = nil
+	m.clearedroles = false
+}
+
+// Where appends a list predicates to the AuthTokensMutation builder.
+func (m *AuthTokensMutation) Where(ps ...predicate.AuthTokens) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuthTokensMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Group, len(ps))
+func (m *AuthTokensMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuthTokens, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3010,36 +2875,54 @@ func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GroupMutation) Op() Op {
+func (m *AuthTokensMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GroupMutation) SetOp(op Op) {
+func (m *AuthTokensMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Group).
-func (m *GroupMutation) Type() string {
+// Type returns the node type of this mutation (AuthTokens).
+func (m *AuthTokensMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GroupMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+func (m *AuthTokensMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.created_at != nil {
-		fields = append(fields, group.FieldCreatedAt)
+		fields = append(fields, authtokens.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, group.FieldUpdatedAt)
+		fields = append(fields, authtokens.FieldUpdatedAt)
+	}
+	if m.token != nil {
+		fields = append(fields, authtokens.FieldToken)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, authtokens.FieldExpiresAt)
 	}
 	if m.name != nil {
-		fields = append(fields, group.FieldName)
+		fields = append(fields, authtokens.FieldName)
 	}
-	if m.currency != nil {
-		fields = append(fields, group.FieldCurrency)
+	if m.session != nil {
+		fields = append(fields, authtokens.FieldSessionID)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, authtokens.FieldLastUsedAt)
+	}
+	if m.ip_address != nil {
+		fields = append(fields, authtokens.FieldIPAddress)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, authtokens.FieldUserAgent)
+	}
+	if m.group != nil {
+		fields = append(fields, authtokens.FieldGroupID)
 	}
 	return fields
 }
@@ -3047,16 +2930,28 @@ func (m *GroupMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GroupMutation) Field(name string) (ent.Value, bool) {
+func (m *AuthTokensMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case group.FieldCreatedAt:
+	case authtokens.FieldCreatedAt:
 		return m.CreatedAt()
-	case group.FieldUpdatedAt:
+	case authtokens.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case group.FieldName:
+	case authtokens.FieldToken:
+		return m.Token()
+	case authtokens.FieldExpiresAt:
+		return m.ExpiresAt()
+	case authtokens.FieldName:
 		return m.Name()
-	case group.FieldCurrency:
-		return m.Currency()
+	case authtokens.FieldSessionID:
+		return m.SessionID()
+	case authtokens.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case authtokens.FieldIPAddress:
+		return m.IPAddress()
+	case authtokens.FieldUserAgent:
+		return m.UserAgent()
+	case authtokens.FieldGroupID:
+		return m.GroupID()
 	}
 	return nil, false
 }
@@ -3064,265 +2959,296 @@ func (m *GroupMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuthTokensMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case group.FieldCreatedAt:
+	case authtokens.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case group.FieldUpdatedAt:
+	case authtokens.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case group.FieldName:
+	case authtokens.FieldToken:
+		return m.OldToken(ctx)
+	case authtokens.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case authtokens.FieldName:
 		return m.OldName(ctx)
-	case group.FieldCurrency:
-		return m.OldCurrency(ctx)
+	case authtokens.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case authtokens.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case authtokens.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case authtokens.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case authtokens.FieldGroupID:
+		return m.OldGroupID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Group field %s", name)
+	return nil, fmt.Errorf("unknown AuthTokens field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMutation) SetField(name string, value ent.Value) error {
+func (m *AuthTokensMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case group.FieldCreatedAt:
+	case authtokens.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case group.FieldUpdatedAt:
+	case authtokens.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case group.FieldName:
+	case authtokens.FieldToken:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case authtokens.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case authtokens.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case group.FieldCurrency:
+	case authtokens.FieldSessionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case authtokens.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case authtokens.FieldIPAddress:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCurrency(v)
+		m.SetIPAddress(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Group field %s", name)
+	case authtokens.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case authtokens.FieldGroupID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuthTokens field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GroupMutation) AddedFields() []string {
+func (m *AuthTokensMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuthTokensMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMutation) AddField(name string, value ent.Value) error {
+func (m *AuthTokensMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Group numeric field %s", name)
+	return fmt.Errorf("unknown AuthTokens numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GroupMutation) ClearedFields() []string {
-	return nil
+func (m *AuthTokensMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(authtokens.FieldName) {
+		fields = append(fields, authtokens.FieldName)
+	}
+	if m.FieldCleared(authtokens.FieldSessionID) {
+		fields = append(fields, authtokens.FieldSessionID)
+	}
+	if m.FieldCleared(authtokens.FieldLastUsedAt) {
+		fields = append(fields, authtokens.FieldLastUsedAt)
+	}
+	if m.FieldCleared(authtokens.FieldIPAddress) {
+		fields = append(fields, authtokens.FieldIPAddress)
+	}
+	if m.FieldCleared(authtokens.FieldUserAgent) {
+		fields = append(fields, authtokens.FieldUserAgent)
+	}
+	if m.FieldCleared(authtokens.FieldGroupID) {
+		fields = append(fields, authtokens.FieldGroupID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GroupMutation) FieldCleared(name string) bool {
+func (m *AuthTokensMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GroupMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Group nullable field %s", name)
+func (m *AuthTokensMutation) ClearField(name string) error {
+	switch name {
+	case authtokens.FieldName:
+		m.ClearName()
+		return nil
+	case authtokens.FieldSessionID:
+		m.ClearSessionID()
+		return nil
+	case authtokens.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case authtokens.FieldIPAddress:
+		m.ClearIPAddress()
+		return nil
+	case authtokens.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	case authtokens.FieldGroupID:
+		m.ClearGroupID()
+		return nil
+	}
+	return fmt.Errorf("unknown AuthTokens nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GroupMutation) ResetField(name string) error {
+func (m *AuthTokensMutation) ResetField(name string) error {
 	switch name {
-	case group.FieldCreatedAt:
+	case authtokens.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case group.FieldUpdatedAt:
+	case authtokens.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case group.FieldName:
+	case authtokens.FieldToken:
+		m.ResetToken()
+		return nil
+	case authtokens.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case authtokens.FieldName:
 		m.ResetName()
 		return nil
-	case group.FieldCurrency:
-		m.ResetCurrency()
+	case authtokens.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case authtokens.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case authtokens.FieldIPAddress:
+		m.ResetIPAddress()
+		return nil
+	case authtokens.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case authtokens.FieldGroupID:
+		m.ResetGroupID()
 		return nil
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	return fmt.Errorf("unknown AuthTokens field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GroupMutation) AddedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.users != nil {
-		edges = append(edges, group.EdgeUsers)
-	}
-	if m.locations != nil {
-		edges = append(edges, group.EdgeLocations)
-	}
-	if m.items != nil {
-		edges = append(edges, group.EdgeItems)
+func (m *AuthTokensMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.user != nil {
+		edges = append(edges, authtokens.EdgeUser)
 	}
-	if m.labels != nil {
-		edges = append(edges, group.EdgeLabels)
+	if m.session != nil {
+		edges = append(edges, authtokens.EdgeSession)
 	}
-	if m.documents != nil {
-		edges = append(edges, group.EdgeDocuments)
+	if m.attachment_tokens != nil {
+		edges = append(edges, authtokens.EdgeAttachmentTokens)
 	}
-	if m.invitation_tokens != nil {
-		edges = append(edges, group.EdgeInvitationTokens)
+	if m.group != nil {
+		edges = append(edges, authtokens.EdgeGroup)
 	}
-	if m.notifiers != nil {
-		edges = append(edges, group.EdgeNotifiers)
+	if m.roles != nil {
+		edges = append(edges, authtokens.EdgeRoles)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GroupMutation) AddedIDs(name string) []ent.Value {
+func (m *AuthTokensMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case group.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeLocations:
-		ids := make([]ent.Value, 0, len(m.locations))
-		for id := range m.locations {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.items))
-		for id := range m.items {
-			ids = append(ids, id)
+	case authtokens.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case group.EdgeLabels:
-		ids := make([]ent.Value, 0, len(m.labels))
-		for id := range m.labels {
-			ids = append(ids, id)
+	case authtokens.EdgeSession:
+		if id := m.session; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case group.EdgeDocuments:
-		ids := make([]ent.Value, 0, len(m.documents))
-		for id := range m.documents {
+	case authtokens.EdgeAttachmentTokens:
+		ids := make([]ent.Value, 0, len(m.attachment_tokens))
+		for id := range m.attachment_tokens {
 			ids = append(ids, id)
 		}
 		return ids
-	case group.EdgeInvitationTokens:
-		ids := make([]ent.Value, 0, len(m.invitation_tokens))
-		for id := range m.invitation_tokens {
-			ids = append(ids, id)
+	case authtokens.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case group.EdgeNotifiers:
-		ids := make([]ent.Value, 0, len(m.notifiers))
-		for id := range m.notifiers {
-			ids = append(ids, id)
+	case authtokens.EdgeRoles:
+		if id := m.roles; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GroupMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.removedusers != nil {
-		edges = append(edges, group.EdgeUsers)
-	}
-	if m.removedlocations != nil {
-		edges = append(edges, group.EdgeLocations)
-	}
-	if m.removeditems != nil {
-		edges = append(edges, group.EdgeItems)
-	}
-	if m.removedlabels != nil {
-		edges = append(edges, group.EdgeLabels)
-	}
-	if m.removeddocuments != nil {
-		edges = append(edges, group.EdgeDocuments)
-	}
-	if m.removedinvitation_tokens != nil {
-		edges = append(edges, group.EdgeInvitationTokens)
-	}
-	if m.removednotifiers != nil {
-		edges = append(edges, group.EdgeNotifiers)
+func (m *AuthTokensMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedattachment_tokens != nil {
+		edges = append(edges, authtokens.EdgeAttachmentTokens)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuthTokensMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case group.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeLocations:
-		ids := make([]ent.Value, 0, len(m.removedlocations))
-		for id := range m.removedlocations {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.removeditems))
-		for id := range m.removeditems {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeLabels:
-		ids := make([]ent.Value, 0, len(m.removedlabels))
-		for id := range m.removedlabels {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeDocuments:
-		ids := make([]ent.Value, 0, len(m.removeddocuments))
-		for id := range m.removeddocuments {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeInvitationTokens:
-		ids := make([]ent.Value, 0, len(m.removedinvitation_tokens))
-		for id := range m.removedinvitation_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeNotifiers:
-		ids := make([]ent.Value, 0, len(m.removednotifiers))
-		for id := range m.removednotifiers {
+	case authtokens.EdgeAttachmentTokens:
+		ids := make([]ent.Value, 0, len(m.removedattachment_tokens))
+		for id := range m.removedattachment_tokens {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3331,122 +3257,119 @@ func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GroupMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.clearedusers {
-		edges = append(edges, group.EdgeUsers)
-	}
-	if m.clearedlocations {
-		edges = append(edges, group.EdgeLocations)
-	}
-	if m.cleareditems {
-		edges = append(edges, group.EdgeItems)
+func (m *AuthTokensMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.cleareduser {
+		edges = append(edges, authtokens.EdgeUser)
 	}
-	if m.clearedlabels {
-		edges = append(edges, group.EdgeLabels)
+	if m.clearedsession {
+		edges = append(edges, authtokens.EdgeSession)
 	}
-	if m.cleareddocuments {
-		edges = append(edges, group.EdgeDocuments)
+	if m.clearedattachment_tokens {
+		edges = append(edges, authtokens.EdgeAttachmentTokens)
 	}
-	if m.clearedinvitation_tokens {
-		edges = append(edges, group.EdgeInvitationTokens)
+	if m.clearedgroup {
+		edges = append(edges, authtokens.EdgeGroup)
 	}
-	if m.clearednotifiers {
-		edges = append(edges, group.EdgeNotifiers)
+	if m.clearedroles {
+		edges = append(edges, authtokens.EdgeRoles)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GroupMutation) EdgeCleared(name string) bool {
+func (m *AuthTokensMutation) EdgeCleared(name string) bool {
 	switch name {
-	case group.EdgeUsers:
-		return m.clearedusers
-	case group.EdgeLocations:
-		return m.clearedlocations
-	case group.EdgeItems:
-		return m.cleareditems
-	case group.EdgeLabels:
-		return m.clearedlabels
-	case group.EdgeDocuments:
-		return m.cleareddocuments
-	case group.EdgeInvitationTokens:
-		return m.clearedinvitation_tokens
-	case group.EdgeNotifiers:
-		return m.clearednotifiers
+	case authtokens.EdgeUser:
+		return m.cleareduser
+	case authtokens.EdgeSession:
+		return m.clearedsession
+	case authtokens.EdgeAttachmentTokens:
+		return m.clearedattachment_tokens
+	case authtokens.EdgeGroup:
+		return m.clearedgroup
+	case authtokens.EdgeRoles:
+		return m.clearedroles
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GroupMutation) ClearEdge(name string) error {
+func (m *AuthTokensMutation) ClearEdge(name string) error {
 	switch name {
-	}
-	return fmt.Errorf("unknown Group unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *GroupMutation) ResetEdge(name string) error {
-	switch name {
-	case group.EdgeUsers:
-		m.ResetUsers()
+	case authtokens.EdgeUser:
+		m.ClearUser()
 		return nil
-	case group.EdgeLocations:
-		m.ResetLocations()
+	case authtokens.EdgeSession:
+		m.ClearSession()
 		return nil
-	case group.EdgeItems:
-		m.ResetItems()
+	case authtokens.EdgeGroup:
+		m.ClearGroup()
 		return nil
-	case group.EdgeLabels:
-		m.ResetLabels()
+	case authtokens.EdgeRoles:
+		m.ClearRoles()
 		return nil
-	case group.EdgeDocuments:
-		m.ResetDocuments()
+	}
+	return fmt.Errorf("unknown AuthTokens unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuthTokensMutation) ResetEdge(name string) error {
+	switch name {
+	case authtokens.EdgeUser:
+		m.ResetUser()
 		return nil
-	case group.EdgeInvitationTokens:
-		m.ResetInvitationTokens()
+	case authtokens.EdgeSession:
+		m.ResetSession()
 		return nil
-	case group.EdgeNotifiers:
-		m.ResetNotifiers()
+	case authtokens.EdgeAttachmentTokens:
+		m.ResetAttachmentTokens()
+		return nil
+	case authtokens.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case authtokens.EdgeRoles:
+		m.ResetRoles()
 		return nil
 	}
-	return fmt.Errorf("unknown Group edge %s", name)
+	return fmt.Errorf("unknown AuthTokens edge %s", name)
 }
 
-// GroupInvitationTokenMutation represents an operation that mutates the GroupInvitationToken nodes in the graph.
-type GroupInvitationTokenMutation struct {
+// DocumentMutation represents an operation that mutates the Document nodes in the graph.
+type DocumentMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	created_at    *time.Time
-	updated_at    *time.Time
-	token         *[]byte
-	expires_at    *time.Time
-	uses          *int
-	adduses       *int
-	clearedFields map[string]struct{}
-	group         *uuid.UUID
-	clearedgroup  bool
-	done          bool
-	oldValue      func(context.Context) (*GroupInvitationToken, error)
-	predicates    []predicate.GroupInvitationToken
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	created_at         *time.Time
+	updated_at         *time.Time
+	title              *string
+	_path              *string
+	clearedFields      map[string]struct{}
+	group              *uuid.UUID
+	clearedgroup       bool
+	attachments        map[uuid.UUID]struct{}
+	removedattachments map[uuid.UUID]struct{}
+	clearedattachments bool
+	done               bool
+	oldValue           func(context.Context) (*Document, error)
+	predicates         []predicate.Document
 }
 
-var _ ent.Mutation = (*GroupInvitationTokenMutation)(nil)
+var _ ent.Mutation = (*DocumentMutation)(nil)
 
-// groupinvitationtokenOption allows management of the mutation configuration using functional options.
-type groupinvitationtokenOption func(*GroupInvitationTokenMutation)
+// documentOption allows management of the mutation configuration using functional options.
+type documentOption func(*DocumentMutation)
 
-// newGroupInvitationTokenMutation creates new mutation for the GroupInvitationToken entity.
-func newGroupInvitationTokenMutation(c config, op Op, opts ...groupinvitationtokenOption) *GroupInvitationTokenMutation {
-	m := &GroupInvitationTokenMutation{
+// newDocumentMutation creates new mutation for the Document entity.
+func newDocumentMutation(c config, op Op, opts ...documentOption) *DocumentMutation {
+	m := &DocumentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGroupInvitationToken,
+		typ:           TypeDocument,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3455,20 +3378,20 @@ func newGroupInvitationTokenMutation(c config, op Op, opts ...groupinvitationtok
 	return m
 }
 
-// withGroupInvitationTokenID sets the ID field of the mutation.
-func withGroupInvitationTokenID(id uuid.UUID) groupinvitationtokenOption {
-	return func(m *GroupInvitationTokenMutation) {
+// withDocumentID sets the ID field of the mutation.
+func withDocumentID(id uuid.UUID) documentOption {
+	return func(m *DocumentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *GroupInvitationToken
+			value *Document
 		)
-		m.oldValue = func(ctx context.Context) (*GroupInvitationToken, error) {
+		m.oldValue = func(ctx context.Context) (*Document, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().GroupInvitationToken.Get(ctx, id)
+					value, err = m.Client().Document.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3477,10 +3400,10 @@ func withGroupInvitationTokenID(id uuid.UUID) groupinvitationtokenOption {
 	}
 }
 
-// withGroupInvitationToken sets the old GroupInvitationToken of the mutation.
-func withGroupInvitationToken(node *GroupInvitationToken) groupinvitationtokenOption {
-	return func(m *GroupInvitationTokenMutation) {
-		m.oldValue = func(context.Context) (*GroupInvitationToken, error) {
+// withDocument sets the old Document of the mutation.
+func withDocument(node *Document) documentOption {
+	return func(m *DocumentMutation) {
+		m.oldValue = func(context.Context) (*Document, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3489,7 +3412,7 @@ func withGroupInvitationToken(node *GroupInvitationToken) groupinvitationtokenOp
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GroupInvitationTokenMutation) Client() *Client {
+func (m DocumentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3497,7 +3420,7 @@ func (m GroupInvitationTokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GroupInvitationTokenMutation) Tx() (*Tx, error) {
+func (m DocumentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3507,14 +3430,14 @@ func (m GroupInvitationTokenMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of GroupInvitationToken entities.
-func (m *GroupInvitationTokenMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Document entities.
+func (m *DocumentMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GroupInvitationTokenMutation) ID() (id uuid.UUID, exists bool) {
+func (m *DocumentMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3525,7 +3448,7 @@ func (m *GroupInvitationTokenMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GroupInvitationTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *DocumentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3534,19 +3457,19 @@ func (m *GroupInvitationTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, er
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().GroupInvitationToken.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Document.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *GroupInvitationTokenMutation) SetCreatedAt(t time.Time) {
+func (m *DocumentMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GroupInvitationTokenMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *DocumentMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3554,10 +3477,10 @@ func (m *GroupInvitationTokenMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the GroupInvitationToken entity.
-// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupInvitationTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DocumentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3572,17 +3495,17 @@ func (m *GroupInvitationTokenMutation) OldCreatedAt(ctx context.Context) (v time
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GroupInvitationTokenMutation) ResetCreatedAt() {
+func (m *DocumentMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *GroupInvitationTokenMutation) SetUpdatedAt(t time.Time) {
+func (m *DocumentMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *GroupInvitationTokenMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *DocumentMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3590,10 +3513,10 @@ func (m *GroupInvitationTokenMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the GroupInvitationToken entity.
-// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupInvitationTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DocumentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3608,186 +3531,184 @@ func (m *GroupInvitationTokenMutation) OldUpdatedAt(ctx context.Context) (v time
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *GroupInvitationTokenMutation) ResetUpdatedAt() {
+func (m *DocumentMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetToken sets the "token" field.
-func (m *GroupInvitationTokenMutation) SetToken(b []byte) {
-	m.token = &b
+// SetTitle sets the "title" field.
+func (m *DocumentMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *GroupInvitationTokenMutation) Token() (r []byte, exists bool) {
-	v := m.token
+// Title returns the value of the "title" field in the mutation.
+func (m *DocumentMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the GroupInvitationToken entity.
-// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupInvitationTokenMutation) OldToken(ctx context.Context) (v []byte, err error) {
+func (m *DocumentMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.Token, nil
+	return oldValue.Title, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *GroupInvitationTokenMutation) ResetToken() {
-	m.token = nil
+// ResetTitle resets all changes to the "title" field.
+func (m *DocumentMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *GroupInvitationTokenMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// SetPath sets the "path" field.
+func (m *DocumentMutation) SetPath(s string) {
+	m._path = &s
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *GroupInvitationTokenMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// Path returns the value of the "path" field in the mutation.
+func (m *DocumentMutation) Path() (r string, exists bool) {
+	v := m._path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the GroupInvitationToken entity.
-// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
+// OldPath returns the old "path" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupInvitationTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *DocumentMutation) OldPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.Path, nil
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *GroupInvitationTokenMutation) ResetExpiresAt() {
-	m.expires_at = nil
+// ResetPath resets all changes to the "path" field.
+func (m *DocumentMutation) ResetPath() {
+	m._path = nil
 }
 
-// SetUses sets the "uses" field.
-func (m *GroupInvitationTokenMutation) SetUses(i int) {
-	m.uses = &i
-	m.adduses = nil
+// SetGroupID sets the "group" edge to the Group entity by id.
+func (m *DocumentMutation) SetGroupID(id uuid.UUID) {
+	m.group = &id
 }
 
-// Uses returns the value of the "uses" field in the mutation.
-func (m *GroupInvitationTokenMutation) Uses() (r int, exists bool) {
-	v := m.uses
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *DocumentMutation) ClearGroup() {
+	m.clearedgroup = true
 }
 
-// OldUses returns the old "uses" field's value of the GroupInvitationToken entity.
-// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupInvitationTokenMutation) OldUses(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUses is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUses requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUses: %w", err)
-	}
-	return oldValue.Uses, nil
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *DocumentMutation) GroupCleared() bool {
+	return m.clearedgroup
 }
 
-// AddUses adds i to the "uses" field.
-func (m *GroupInvitationTokenMutation) AddUses(i int) {
-	if m.adduses != nil {
-		*m.adduses += i
-	} else {
-		m.adduses = &i
+// GroupID returns the "group" edge ID in the mutation.
+func (m *DocumentMutation) GroupID() (id uuid.UUID, exists bool) {
+	if m.group != nil {
+		return *m.group, true
 	}
+	return
 }
 
-// AddedUses returns the value that was added to the "uses" field in this mutation.
-func (m *GroupInvitationTokenMutation) AddedUses() (r int, exists bool) {
-	v := m.adduses
-	if v == nil {
-		return
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *DocumentMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// ResetUses resets all changes to the "uses" field.
-func (m *GroupInvitationTokenMutation) ResetUses() {
-	m.uses = nil
-	m.adduses = nil
+// ResetGroup resets all changes to the "group" edge.
+func (m *DocumentMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
 }
 
-// SetGroupID sets the "group" edge to the Group entity by id.
-func (m *GroupInvitationTokenMutation) SetGroupID(id uuid.UUID) {
-	m.group = &id
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
+func (m *DocumentMutation) AddAttachmentIDs(ids ...uuid.UUID) {
+	if m.attachments == nil {
+		m.attachments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.attachments[ids[i]] = struct{}{}
+	}
 }
 
-// ClearGroup clears the "group" edge to the Group entity.
-func (m *GroupInvitationTokenMutation) ClearGroup() {
-	m.clearedgroup = true
+// ClearAttachments clears the "attachments" edge to the Attachment entity.
+func (m *DocumentMutation) ClearAttachments() {
+	m.clearedattachments = true
 }
 
-// GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *GroupInvitationTokenMutation) GroupCleared() bool {
-	return m.clearedgroup
+// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
+func (m *DocumentMutation) AttachmentsCleared() bool {
+	return m.clearedattachments
 }
 
-// GroupID returns the "group" edge ID in the mutation.
-func (m *GroupInvitationTokenMutation) GroupID() (id uuid.UUID, exists bool) {
-	if m.group != nil {
-		return *m.group, true
+// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
+func (m *DocumentMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
+	if m.removedattachments == nil {
+		m.removedattachments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.attachments, ids[i])
+		m.removedattachments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
+func (m *DocumentMutation) RemovedAttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedattachments {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// GroupIDs returns the "group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *GroupInvitationTokenMutation) GroupIDs() (ids []uuid.UUID) {
-	if id := m.group; id != nil {
-		ids = append(ids, *id)
+// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
+func (m *DocumentMutation) AttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.attachments {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetGroup resets all changes to the "group" edge.
-func (m *GroupInvitationTokenMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
+// ResetAttachments resets all changes to the "attachments" edge.
+func (m *DocumentMutation) ResetAttachments() {
+	m.attachments = nil
+	m.clearedattachments = false
+	m.removedattachments = nil
 }
 
-// Where appends a list predicates to the GroupInvitationTokenMutation builder.
-func (m *GroupInvitationTokenMutation) Where(ps ...predicate.GroupInvitationToken) {
+// Where appends a list predicates to the DocumentMutation builder.
+func (m *DocumentMutation) Where(ps ...predicate.Document) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the GroupInvitationTokenMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DocumentMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GroupInvitationTokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.GroupInvitationToken, len(ps))
+func (m *DocumentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Document, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3795,39 +3716,36 @@ func (m *GroupInvitationTokenMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GroupInvitationTokenMutation) Op() Op {
+func (m *DocumentMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GroupInvitationTokenMutation) SetOp(op Op) {
+func (m *DocumentMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (GroupInvitationToken).
-func (m *GroupInvitationTokenMutation) Type() string {
+// Type returns the node type of this mutation (Document).
+func (m *DocumentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GroupInvitationTokenMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *DocumentMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.created_at != nil {
-		fields = append(fields, groupinvitationtoken.FieldCreatedAt)
+		fields = append(fields, document.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, groupinvitationtoken.FieldUpdatedAt)
-	}
-	if m.token != nil {
-		fields = append(fields, groupinvitationtoken.FieldToken)
+		fields = append(fields, document.FieldUpdatedAt)
 	}
-	if m.expires_at != nil {
-		fields = append(fields, groupinvitationtoken.FieldExpiresAt)
+	if m.title != nil {
+		fields = append(fields, document.FieldTitle)
 	}
-	if m.uses != nil {
-		fields = append(fields, groupinvitationtoken.FieldUses)
+	if m._path != nil {
+		fields = append(fields, document.FieldPath)
 	}
 	return fields
 }
@@ -3835,18 +3753,16 @@ func (m *GroupInvitationTokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GroupInvitationTokenMutation) Field(name string) (ent.Value, bool) {
+func (m *DocumentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case groupinvitationtoken.FieldCreatedAt:
+	case document.FieldCreatedAt:
 		return m.CreatedAt()
-	case groupinvitationtoken.FieldUpdatedAt:
+	case document.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case groupinvitationtoken.FieldToken:
-		return m.Token()
-	case groupinvitationtoken.FieldExpiresAt:
-		return m.ExpiresAt()
-	case groupinvitationtoken.FieldUses:
-		return m.Uses()
+	case document.FieldTitle:
+		return m.Title()
+	case document.FieldPath:
+		return m.Path()
 	}
 	return nil, false
 }
@@ -3854,290 +3770,293 @@ func (m *GroupInvitationTokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GroupInvitationTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DocumentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case groupinvitationtoken.FieldCreatedAt:
+	case document.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case groupinvitationtoken.FieldUpdatedAt:
+	case document.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case groupinvitationtoken.FieldToken:
-		return m.OldToken(ctx)
-	case groupinvitationtoken.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case groupinvitationtoken.FieldUses:
-		return m.OldUses(ctx)
+	case document.FieldTitle:
+		return m.OldTitle(ctx)
+	case document.FieldPath:
+		return m.OldPath(ctx)
 	}
-	return nil, fmt.Errorf("unknown GroupInvitationToken field %s", name)
+	return nil, fmt.Errorf("unknown Document field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupInvitationTokenMutation) SetField(name string, value ent.Value) error {
+func (m *DocumentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case groupinvitationtoken.FieldCreatedAt:
+	case document.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case groupinvitationtoken.FieldUpdatedAt:
+	case document.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case groupinvitationtoken.FieldToken:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetToken(v)
-		return nil
-	case groupinvitationtoken.FieldExpiresAt:
-		v, ok := value.(time.Time)
+	case document.FieldTitle:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiresAt(v)
+		m.SetTitle(v)
 		return nil
-	case groupinvitationtoken.FieldUses:
-		v, ok := value.(int)
+	case document.FieldPath:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUses(v)
+		m.SetPath(v)
 		return nil
 	}
-	return fmt.Errorf("unknown GroupInvitationToken field %s", name)
+	return fmt.Errorf("unknown Document field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GroupInvitationTokenMutation) AddedFields() []string {
-	var fields []string
-	if m.adduses != nil {
-		fields = append(fields, groupinvitationtoken.FieldUses)
-	}
-	return fields
+func (m *DocumentMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GroupInvitationTokenMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case groupinvitationtoken.FieldUses:
-		return m.AddedUses()
-	}
+func (m *DocumentMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupInvitationTokenMutation) AddField(name string, value ent.Value) error {
+func (m *DocumentMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case groupinvitationtoken.FieldUses:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUses(v)
-		return nil
 	}
-	return fmt.Errorf("unknown GroupInvitationToken numeric field %s", name)
+	return fmt.Errorf("unknown Document numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GroupInvitationTokenMutation) ClearedFields() []string {
+func (m *DocumentMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GroupInvitationTokenMutation) FieldCleared(name string) bool {
+func (m *DocumentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GroupInvitationTokenMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown GroupInvitationToken nullable field %s", name)
+func (m *DocumentMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Document nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GroupInvitationTokenMutation) ResetField(name string) error {
+func (m *DocumentMutation) ResetField(name string) error {
 	switch name {
-	case groupinvitationtoken.FieldCreatedAt:
+	case document.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case groupinvitationtoken.FieldUpdatedAt:
+	case document.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case groupinvitationtoken.FieldToken:
-		m.ResetToken()
-		return nil
-	case groupinvitationtoken.FieldExpiresAt:
-		m.ResetExpiresAt()
+	case document.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case groupinvitationtoken.FieldUses:
-		m.ResetUses()
+	case document.FieldPath:
+		m.ResetPath()
 		return nil
 	}
-	return fmt.Errorf("unknown GroupInvitationToken field %s", name)
+	return fmt.Errorf("unknown Document field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GroupInvitationTokenMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *DocumentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.group != nil {
-		edges = append(edges, groupinvitationtoken.EdgeGroup)
+		edges = append(edges, document.EdgeGroup)
+	}
+	if m.attachments != nil {
+		edges = append(edges, document.EdgeAttachments)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GroupInvitationTokenMutation) AddedIDs(name string) []ent.Value {
+func (m *DocumentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case groupinvitationtoken.EdgeGroup:
+	case document.EdgeGroup:
 		if id := m.group; id != nil {
 			return []ent.Value{*id}
 		}
+	case document.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.attachments))
+		for id := range m.attachments {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GroupInvitationTokenMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+func (m *DocumentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedattachments != nil {
+		edges = append(edges, document.EdgeAttachments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GroupInvitationTokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *DocumentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case document.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.removedattachments))
+		for id := range m.removedattachments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GroupInvitationTokenMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *DocumentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.clearedgroup {
-		edges = append(edges, groupinvitationtoken.EdgeGroup)
+		edges = append(edges, document.EdgeGroup)
+	}
+	if m.clearedattachments {
+		edges = append(edges, document.EdgeAttachments)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GroupInvitationTokenMutation) EdgeCleared(name string) bool {
+func (m *DocumentMutation) EdgeCleared(name string) bool {
 	switch name {
-	case groupinvitationtoken.EdgeGroup:
+	case document.EdgeGroup:
 		return m.clearedgroup
+	case document.EdgeAttachments:
+		return m.clearedattachments
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GroupInvitationTokenMutation) ClearEdge(name string) error {
+func (m *DocumentMutation) ClearEdge(name string) error {
 	switch name {
-	case groupinvitationtoken.EdgeGroup:
+	case document.EdgeGroup:
 		m.ClearGroup()
 		return nil
 	}
-	return fmt.Errorf("unknown GroupInvitationToken unique edge %s", name)
+	return fmt.Errorf("unknown Document unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GroupInvitationTokenMutation) ResetEdge(name string) error {
+func (m *DocumentMutation) ResetEdge(name string) error {
 	switch name {
-	case groupinvitationtoken.EdgeGroup:
+	case document.EdgeGroup:
 		m.ResetGroup()
 		return nil
+	case document.EdgeAttachments:
+		m.ResetAttachments()
+		return nil
 	}
-	return fmt.Errorf("unknown GroupInvitationToken edge %s", name)
+	return fmt.Errorf("unknown Document edge %s", name)
 }
 
-// ItemMutation represents an operation that mutates the Item nodes in the graph.
-type ItemMutation struct {
+// GroupMutation represents an operation that mutates the Group nodes in the graph.
+type GroupMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *uuid.UUID
-	created_at                 *time.Time
-	updated_at                 *time.Time
-	name                       *string
-	description                *string
-	import_ref                 *string
-	notes                      *string
-	quantity                   *int
-	addquantity                *int
-	insured                    *bool
-	archived                   *bool
-	asset_id                   *int
-	addasset_id                *int
-	serial_number              *string
-	model_number               *string
-	manufacturer               *string
-	lifetime_warranty          *bool
-	warranty_expires           *time.Time
-	warranty_details           *string
-	purchase_time              *time.Time
-	purchase_from              *string
-	purchase_price             *float64
-	addpurchase_price          *float64
-	sold_time                  *time.Time
-	sold_to                    *string
-	sold_price                 *float64
-	addsold_price              *float64
-	sold_notes                 *string
-	clearedFields              map[string]struct{}
-	group                      *uuid.UUID
-	clearedgroup               bool
-	parent                     *uuid.UUID
-	clearedparent              bool
-	children                   map[uuid.UUID]struct{}
-	removedchildren            map[uuid.UUID]struct{}
-	clearedchildren            bool
-	label                      map[uuid.UUID]struct{}
-	removedlabel               map[uuid.UUID]struct{}
-	clearedlabel               bool
-	location                   *uuid.UUID
-	clearedlocation            bool
-	fields                     map[uuid.UUID]struct{}
-	removedfields              map[uuid.UUID]struct{}
-	clearedfields              bool
-	maintenance_entries        map[uuid.UUID]struct{}
-	removedmaintenance_entries map[uuid.UUID]struct{}
-	clearedmaintenance_entries bool
-	attachments                map[uuid.UUID]struct{}
-	removedattachments         map[uuid.UUID]struct{}
-	clearedattachments         bool
-	done                       bool
-	oldValue                   func(context.Context) (*Item, error)
-	predicates                 []predicate.Item
+	op                            Op
+	typ                           string
+	id                            *uuid.UUID
+	created_at                    *time.Time
+	updated_at                    *time.Time
+	name                          *string
+	currency                      *string
+	timezone                      *string
+	notify_time                   *string
+	digest                        *group.Digest
+	notified_on                   *time.Time
+	clearedFields                 map[string]struct{}
+	users                         map[uuid.UUID]struct{}
+	removedusers                  map[uuid.UUID]struct{}
+	clearedusers                  bool
+	memberships                   map[uuid.UUID]struct{}
+	removedmemberships            map[uuid.UUID]struct{}
+	clearedmemberships            bool
+	auth_tokens                   map[uuid.UUID]struct{}
+	removedauth_tokens            map[uuid.UUID]struct{}
+	clearedauth_tokens            bool
+	locations                     map[uuid.UUID]struct{}
+	removedlocations              map[uuid.UUID]struct{}
+	clearedlocations              bool
+	items                         map[uuid.UUID]struct{}
+	removeditems                  map[uuid.UUID]struct{}
+	cleareditems                  bool
+	labels                        map[uuid.UUID]struct{}
+	removedlabels                 map[uuid.UUID]struct{}
+	clearedlabels                 bool
+	documents                     map[uuid.UUID]struct{}
+	removeddocuments              map[uuid.UUID]struct{}
+	cleareddocuments              bool
+	invitation_tokens             map[uuid.UUID]struct{}
+	removedinvitation_tokens      map[uuid.UUID]struct{}
+	clearedinvitation_tokens      bool
+	notifiers                     map[uuid.UUID]struct{}
+	removednotifiers              map[uuid.UUID]struct{}
+	clearednotifiers              bool
+	audit_entries                 map[uuid.UUID]struct{}
+	removedaudit_entries          map[uuid.UUID]struct{}
+	clearedaudit_entries          bool
+	saved_searches                map[uuid.UUID]struct{}
+	removedsaved_searches         map[uuid.UUID]struct{}
+	clearedsaved_searches         bool
+	notification_rules            map[uuid.UUID]struct{}
+	removednotification_rules     map[uuid.UUID]struct{}
+	clearednotification_rules     bool
+	notification_reminders        map[uuid.UUID]struct{}
+	removednotification_reminders map[uuid.UUID]struct{}
+	clearednotification_reminders bool
+	notification_templates        map[uuid.UUID]struct{}
+	removednotification_templates map[uuid.UUID]struct{}
+	clearednotification_templates bool
+	done                          bool
+	oldValue                      func(context.Context) (*Group, error)
+	predicates                    []predicate.Group
 }
 
-var _ ent.Mutation = (*ItemMutation)(nil)
+var _ ent.Mutation = (*GroupMutation)(nil)
 
-// itemOption allows management of the mutation configuration using functional options.
-type itemOption func(*ItemMutation)
+// groupOption allows management of the mutation configuration using functional options.
+type groupOption func(*GroupMutation)
 
-// newItemMutation creates new mutation for the Item entity.
-func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
-	m := &ItemMutation{
+// newGroupMutation creates new mutation for the Group entity.
+func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
+	m := &GroupMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeItem,
+		typ:           TypeGroup,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4146,20 +4065,20 @@ func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
 	return m
 }
 
-// withItemID sets the ID field of the mutation.
-func withItemID(id uuid.UUID) itemOption {
-	return func(m *ItemMutation) {
+// withGroupID sets the ID field of the mutation.
+func withGroupID(id uuid.UUID) groupOption {
+	return func(m *GroupMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Item
+			value *Group
 		)
-		m.oldValue = func(ctx context.Context) (*Item, error) {
+		m.oldValue = func(ctx context.Context) (*Group, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Item.Get(ctx, id)
+					value, err = m.Client().Group.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4168,10 +4087,10 @@ func withItemID(id uuid.UUID) itemOption {
 	}
 }
 
-// withItem sets the old Item of the mutation.
-func withItem(node *Item) itemOption {
-	return func(m *ItemMutation) {
-		m.oldValue = func(context.Context) (*Item, error) {
+// withGroup sets the old Group of the mutation.
+func withGroup(node *Group) groupOption {
+	return func(m *GroupMutation) {
+		m.oldValue = func(context.Context) (*Group, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4180,7 +4099,7 @@ func withItem(node *Item) itemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ItemMutation) Client() *Client {
+func (m GroupMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4188,7 +4107,7 @@ func (m ItemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ItemMutation) Tx() (*Tx, error) {
+func (m GroupMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4198,14 +4117,14 @@ func (m ItemMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Item entities.
-func (m *ItemMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Group entities.
+func (m *GroupMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ItemMutation) ID() (id uuid.UUID, exists bool) {
+func (m *GroupMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4216,7 +4135,7 @@ func (m *ItemMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ItemMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *GroupMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4225,19 +4144,19 @@ func (m *ItemMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Item.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ItemMutation) SetCreatedAt(t time.Time) {
+func (m *GroupMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ItemMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4245,10 +4164,10 @@ func (m *ItemMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4263,17 +4182,17 @@ func (m *ItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ItemMutation) ResetCreatedAt() {
+func (m *GroupMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ItemMutation) SetUpdatedAt(t time.Time) {
+func (m *GroupMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ItemMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -4281,10 +4200,10 @@ func (m *ItemMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -4299,17 +4218,17 @@ func (m *ItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ItemMutation) ResetUpdatedAt() {
+func (m *GroupMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetName sets the "name" field.
-func (m *ItemMutation) SetName(s string) {
+func (m *GroupMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *ItemMutation) Name() (r string, exists bool) {
+func (m *GroupMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -4317,10 +4236,10 @@ func (m *ItemMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -4335,1375 +4254,12208 @@ func (m *ItemMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *ItemMutation) ResetName() {
+func (m *GroupMutation) ResetName() {
 	m.name = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ItemMutation) SetDescription(s string) {
-	m.description = &s
+// SetCurrency sets the "currency" field.
+func (m *GroupMutation) SetCurrency(s string) {
+	m.currency = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ItemMutation) Description() (r string, exists bool) {
-	v := m.description
+// Currency returns the value of the "currency" field in the mutation.
+func (m *GroupMutation) Currency() (r string, exists bool) {
+	v := m.currency
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldCurrency returns the old "currency" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *GroupMutation) OldCurrency(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *ItemMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[item.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ItemMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[item.FieldDescription]
-	return ok
+	return oldValue.Currency, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ItemMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, item.FieldDescription)
+// ResetCurrency resets all changes to the "currency" field.
+func (m *GroupMutation) ResetCurrency() {
+	m.currency = nil
 }
 
-// SetImportRef sets the "import_ref" field.
-func (m *ItemMutation) SetImportRef(s string) {
-	m.import_ref = &s
+// SetTimezone sets the "timezone" field.
+func (m *GroupMutation) SetTimezone(s string) {
+	m.timezone = &s
 }
 
-// ImportRef returns the value of the "import_ref" field in the mutation.
-func (m *ItemMutation) ImportRef() (r string, exists bool) {
-	v := m.import_ref
+// Timezone returns the value of the "timezone" field in the mutation.
+func (m *GroupMutation) Timezone() (r string, exists bool) {
+	v := m.timezone
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImportRef returns the old "import_ref" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldTimezone returns the old "timezone" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldImportRef(ctx context.Context) (v string, err error) {
+func (m *GroupMutation) OldTimezone(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImportRef is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimezone is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImportRef requires an ID field in the mutation")
+		return v, errors.New("OldTimezone requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImportRef: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
 	}
-	return oldValue.ImportRef, nil
+	return oldValue.Timezone, nil
 }
 
-// ClearImportRef clears the value of the "import_ref" field.
-func (m *ItemMutation) ClearImportRef() {
-	m.import_ref = nil
-	m.clearedFields[item.FieldImportRef] = struct{}{}
+// ClearTimezone clears the value of the "timezone" field.
+func (m *GroupMutation) ClearTimezone() {
+	m.timezone = nil
+	m.clearedFields[group.FieldTimezone] = struct{}{}
 }
 
-// ImportRefCleared returns if the "import_ref" field was cleared in this mutation.
-func (m *ItemMutation) ImportRefCleared() bool {
-	_, ok := m.clearedFields[item.FieldImportRef]
+// TimezoneCleared returns if the "timezone" field was cleared in this mutation.
+func (m *GroupMutation) TimezoneCleared() bool {
+	_, ok := m.clearedFields[group.FieldTimezone]
 	return ok
 }
 
-// ResetImportRef resets all changes to the "import_ref" field.
-func (m *ItemMutation) ResetImportRef() {
-	m.import_ref = nil
-	delete(m.clearedFields, item.FieldImportRef)
+// ResetTimezone resets all changes to the "timezone" field.
+func (m *GroupMutation) ResetTimezone() {
+	m.timezone = nil
+	delete(m.clearedFields, group.FieldTimezone)
 }
 
-// SetNotes sets the "notes" field.
-func (m *ItemMutation) SetNotes(s string) {
-	m.notes = &s
+// SetNotifyTime sets the "notify_time" field.
+func (m *GroupMutation) SetNotifyTime(s string) {
+	m.notify_time = &s
 }
 
-// Notes returns the value of the "notes" field in the mutation.
-func (m *ItemMutation) Notes() (r string, exists bool) {
-	v := m.notes
+// NotifyTime returns the value of the "notify_time" field in the mutation.
+func (m *GroupMutation) NotifyTime() (r string, exists bool) {
+	v := m.notify_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNotes returns the old "notes" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldNotifyTime returns the old "notify_time" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldNotes(ctx context.Context) (v string, err error) {
+func (m *GroupMutation) OldNotifyTime(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotifyTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNotes requires an ID field in the mutation")
+		return v, errors.New("OldNotifyTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotifyTime: %w", err)
 	}
-	return oldValue.Notes, nil
-}
-
-// ClearNotes clears the value of the "notes" field.
-func (m *ItemMutation) ClearNotes() {
-	m.notes = nil
-	m.clearedFields[item.FieldNotes] = struct{}{}
-}
-
-// NotesCleared returns if the "notes" field was cleared in this mutation.
-func (m *ItemMutation) NotesCleared() bool {
-	_, ok := m.clearedFields[item.FieldNotes]
-	return ok
+	return oldValue.NotifyTime, nil
 }
 
-// ResetNotes resets all changes to the "notes" field.
-func (m *ItemMutation) ResetNotes() {
-	m.notes = nil
-	delete(m.clearedFields, item.FieldNotes)
+// ResetNotifyTime resets all changes to the "notify_time" field.
+func (m *GroupMutation) ResetNotifyTime() {
+	m.notify_time = nil
 }
 
-// SetQuantity sets the "quantity" field.
-func (m *ItemMutation) SetQuantity(i int) {
-	m.quantity = &i
-	m.addquantity = nil
+// SetDigest sets the "digest" field.
+func (m *GroupMutation) SetDigest(gr group.Digest) {
+	m.digest = &gr
 }
 
-// Quantity returns the value of the "quantity" field in the mutation.
-func (m *ItemMutation) Quantity() (r int, exists bool) {
-	v := m.quantity
+// Digest returns the value of the "digest" field in the mutation.
+func (m *GroupMutation) Digest() (r group.Digest, exists bool) {
+	v := m.digest
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuantity returns the old "quantity" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldDigest returns the old "digest" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldQuantity(ctx context.Context) (v int, err error) {
+func (m *GroupMutation) OldDigest(ctx context.Context) (v group.Digest, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+		return v, errors.New("OldDigest is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuantity requires an ID field in the mutation")
+		return v, errors.New("OldDigest requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+		return v, fmt.Errorf("querying old value for OldDigest: %w", err)
 	}
-	return oldValue.Quantity, nil
+	return oldValue.Digest, nil
 }
 
-// AddQuantity adds i to the "quantity" field.
-func (m *ItemMutation) AddQuantity(i int) {
-	if m.addquantity != nil {
-		*m.addquantity += i
-	} else {
-		m.addquantity = &i
-	}
-}
-
-// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
-func (m *ItemMutation) AddedQuantity() (r int, exists bool) {
-	v := m.addquantity
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetQuantity resets all changes to the "quantity" field.
-func (m *ItemMutation) ResetQuantity() {
-	m.quantity = nil
-	m.addquantity = nil
+// ResetDigest resets all changes to the "digest" field.
+func (m *GroupMutation) ResetDigest() {
+	m.digest = nil
 }
 
-// SetInsured sets the "insured" field.
-func (m *ItemMutation) SetInsured(b bool) {
-	m.insured = &b
+// SetNotifiedOn sets the "notified_on" field.
+func (m *GroupMutation) SetNotifiedOn(t time.Time) {
+	m.notified_on = &t
 }
 
-// Insured returns the value of the "insured" field in the mutation.
-func (m *ItemMutation) Insured() (r bool, exists bool) {
-	v := m.insured
+// NotifiedOn returns the value of the "notified_on" field in the mutation.
+func (m *GroupMutation) NotifiedOn() (r time.Time, exists bool) {
+	v := m.notified_on
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInsured returns the old "insured" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldNotifiedOn returns the old "notified_on" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldInsured(ctx context.Context) (v bool, err error) {
+func (m *GroupMutation) OldNotifiedOn(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInsured is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotifiedOn is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInsured requires an ID field in the mutation")
+		return v, errors.New("OldNotifiedOn requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInsured: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotifiedOn: %w", err)
 	}
-	return oldValue.Insured, nil
+	return oldValue.NotifiedOn, nil
 }
 
-// ResetInsured resets all changes to the "insured" field.
-func (m *ItemMutation) ResetInsured() {
-	m.insured = nil
+// ClearNotifiedOn clears the value of the "notified_on" field.
+func (m *GroupMutation) ClearNotifiedOn() {
+	m.notified_on = nil
+	m.clearedFields[group.FieldNotifiedOn] = struct{}{}
 }
 
-// SetArchived sets the "archived" field.
-func (m *ItemMutation) SetArchived(b bool) {
-	m.archived = &b
+// NotifiedOnCleared returns if the "notified_on" field was cleared in this mutation.
+func (m *GroupMutation) NotifiedOnCleared() bool {
+	_, ok := m.clearedFields[group.FieldNotifiedOn]
+	return ok
 }
 
-// Archived returns the value of the "archived" field in the mutation.
-func (m *ItemMutation) Archived() (r bool, exists bool) {
-	v := m.archived
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetNotifiedOn resets all changes to the "notified_on" field.
+func (m *GroupMutation) ResetNotifiedOn() {
+	m.notified_on = nil
+	delete(m.clearedFields, group.FieldNotifiedOn)
 }
 
-// OldArchived returns the old "archived" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldArchived(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldArchived is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldArchived requires an ID field in the mutation")
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *GroupMutation) AddUserIDs(ids ...uuid.UUID) {
+	if m.users == nil {
+		m.users = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldArchived: %w", err)
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
 	}
-	return oldValue.Archived, nil
 }
 
-// ResetArchived resets all changes to the "archived" field.
-func (m *ItemMutation) ResetArchived() {
-	m.archived = nil
+// ClearUsers clears the "users" edge to the User entity.
+func (m *GroupMutation) ClearUsers() {
+	m.clearedusers = true
 }
 
-// SetAssetID sets the "asset_id" field.
-func (m *ItemMutation) SetAssetID(i int) {
-	m.asset_id = &i
-	m.addasset_id = nil
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *GroupMutation) UsersCleared() bool {
+	return m.clearedusers
 }
 
-// AssetID returns the value of the "asset_id" field in the mutation.
-func (m *ItemMutation) AssetID() (r int, exists bool) {
-	v := m.asset_id
-	if v == nil {
-		return
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *GroupMutation) RemoveUserIDs(ids ...uuid.UUID) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldAssetID returns the old "asset_id" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldAssetID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAssetID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAssetID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAssetID: %w", err)
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *GroupMutation) RemovedUsersIDs() (ids []uuid.UUID) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
 	}
-	return oldValue.AssetID, nil
+	return
 }
 
-// AddAssetID adds i to the "asset_id" field.
-func (m *ItemMutation) AddAssetID(i int) {
-	if m.addasset_id != nil {
-		*m.addasset_id += i
-	} else {
-		m.addasset_id = &i
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *GroupMutation) UsersIDs() (ids []uuid.UUID) {
+	for id := range m.users {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedAssetID returns the value that was added to the "asset_id" field in this mutation.
-func (m *ItemMutation) AddedAssetID() (r int, exists bool) {
-	v := m.addasset_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetUsers resets all changes to the "users" edge.
+func (m *GroupMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
 }
 
-// ResetAssetID resets all changes to the "asset_id" field.
-func (m *ItemMutation) ResetAssetID() {
-	m.asset_id = nil
-	m.addasset_id = nil
+// AddMembershipIDs adds the "memberships" edge to the GroupMembership entity by ids.
+func (m *GroupMutation) AddMembershipIDs(ids ...uuid.UUID) {
+	if m.memberships == nil {
+		m.memberships = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.memberships[ids[i]] = struct{}{}
+	}
 }
 
-// SetSerialNumber sets the "serial_number" field.
-func (m *ItemMutation) SetSerialNumber(s string) {
-	m.serial_number = &s
+// ClearMemberships clears the "memberships" edge to the GroupMembership entity.
+func (m *GroupMutation) ClearMemberships() {
+	m.clearedmemberships = true
 }
 
-// SerialNumber returns the value of the "serial_number" field in the mutation.
-func (m *ItemMutation) SerialNumber() (r string, exists bool) {
-	v := m.serial_number
-	if v == nil {
-		return
-	}
-	return *v, true
+// MembershipsCleared reports if the "memberships" edge to the GroupMembership entity was cleared.
+func (m *GroupMutation) MembershipsCleared() bool {
+	return m.clearedmemberships
 }
 
-// OldSerialNumber returns the old "serial_number" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldSerialNumber(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSerialNumber is only allowed on UpdateOne operations")
+// RemoveMembershipIDs removes the "memberships" edge to the GroupMembership entity by IDs.
+func (m *GroupMutation) RemoveMembershipIDs(ids ...uuid.UUID) {
+	if m.removedmemberships == nil {
+		m.removedmemberships = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSerialNumber requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.memberships, ids[i])
+		m.removedmemberships[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSerialNumber: %w", err)
+}
+
+// RemovedMemberships returns the removed IDs of the "memberships" edge to the GroupMembership entity.
+func (m *GroupMutation) RemovedMembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmemberships {
+		ids = append(ids, id)
 	}
-	return oldValue.SerialNumber, nil
+	return
 }
 
-// ClearSerialNumber clears the value of the "serial_number" field.
-func (m *ItemMutation) ClearSerialNumber() {
-	m.serial_number = nil
-	m.clearedFields[item.FieldSerialNumber] = struct{}{}
+// MembershipsIDs returns the "memberships" edge IDs in the mutation.
+func (m *GroupMutation) MembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.memberships {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SerialNumberCleared returns if the "serial_number" field was cleared in this mutation.
-func (m *ItemMutation) SerialNumberCleared() bool {
-	_, ok := m.clearedFields[item.FieldSerialNumber]
-	return ok
+// ResetMemberships resets all changes to the "memberships" edge.
+func (m *GroupMutation) ResetMemberships() {
+	m.memberships = nil
+	m.clearedmemberships = false
+	m.removedmemberships = nil
 }
 
-// ResetSerialNumber resets all changes to the "serial_number" field.
-func (m *ItemMutation) ResetSerialNumber() {
-	m.serial_number = nil
-	delete(m.clearedFields, item.FieldSerialNumber)
+// AddAuthTokenIDs adds the "auth_tokens" edge to the AuthTokens entity by ids.
+func (m *GroupMutation) AddAuthTokenIDs(ids ...uuid.UUID) {
+	if m.auth_tokens == nil {
+		m.auth_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.auth_tokens[ids[i]] = struct{}{}
+	}
 }
 
-// SetModelNumber sets the "model_number" field.
-func (m *ItemMutation) SetModelNumber(s string) {
-	m.model_number = &s
+// ClearAuthTokens clears the "auth_tokens" edge to the AuthTokens entity.
+func (m *GroupMutation) ClearAuthTokens() {
+	m.clearedauth_tokens = true
 }
 
-// ModelNumber returns the value of the "model_number" field in the mutation.
-func (m *ItemMutation) ModelNumber() (r string, exists bool) {
-	v := m.model_number
-	if v == nil {
-		return
-	}
-	return *v, true
+// AuthTokensCleared reports if the "auth_tokens" edge to the AuthTokens entity was cleared.
+func (m *GroupMutation) AuthTokensCleared() bool {
+	return m.clearedauth_tokens
 }
 
-// OldModelNumber returns the old "model_number" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldModelNumber(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelNumber is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelNumber requires an ID field in the mutation")
+// RemoveAuthTokenIDs removes the "auth_tokens" edge to the AuthTokens entity by IDs.
+func (m *GroupMutation) RemoveAuthTokenIDs(ids ...uuid.UUID) {
+	if m.removedauth_tokens == nil {
+		m.removedauth_tokens = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelNumber: %w", err)
+	for i := range ids {
+		delete(m.auth_tokens, ids[i])
+		m.removedauth_tokens[ids[i]] = struct{}{}
 	}
-	return oldValue.ModelNumber, nil
-}
-
-// ClearModelNumber clears the value of the "model_number" field.
-func (m *ItemMutation) ClearModelNumber() {
-	m.model_number = nil
-	m.clearedFields[item.FieldModelNumber] = struct{}{}
 }
 
-// ModelNumberCleared returns if the "model_number" field was cleared in this mutation.
-func (m *ItemMutation) ModelNumberCleared() bool {
-	_, ok := m.clearedFields[item.FieldModelNumber]
-	return ok
+// RemovedAuthTokens returns the removed IDs of the "auth_tokens" edge to the AuthTokens entity.
+func (m *GroupMutation) RemovedAuthTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedauth_tokens {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetModelNumber resets all changes to the "model_number" field.
-func (m *ItemMutation) ResetModelNumber() {
-	m.model_number = nil
-	delete(m.clearedFields, item.FieldModelNumber)
+// AuthTokensIDs returns the "auth_tokens" edge IDs in the mutation.
+func (m *GroupMutation) AuthTokensIDs() (ids []uuid.UUID) {
+	for id := range m.auth_tokens {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetManufacturer sets the "manufacturer" field.
-func (m *ItemMutation) SetManufacturer(s string) {
-	m.manufacturer = &s
+// ResetAuthTokens resets all changes to the "auth_tokens" edge.
+func (m *GroupMutation) ResetAuthTokens() {
+	m.auth_tokens = nil
+	m.clearedauth_tokens = false
+	m.removedauth_tokens = nil
 }
 
-// Manufacturer returns the value of the "manufacturer" field in the mutation.
-func (m *ItemMutation) Manufacturer() (r string, exists bool) {
-	v := m.manufacturer
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldManufacturer returns the old "manufacturer" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldManufacturer(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldManufacturer is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldManufacturer requires an ID field in the mutation")
+// AddLocationIDs adds the "locations" edge to the Location entity by ids.
+func (m *GroupMutation) AddLocationIDs(ids ...uuid.UUID) {
+	if m.locations == nil {
+		m.locations = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldManufacturer: %w", err)
+	for i := range ids {
+		m.locations[ids[i]] = struct{}{}
 	}
-	return oldValue.Manufacturer, nil
 }
 
-// ClearManufacturer clears the value of the "manufacturer" field.
-func (m *ItemMutation) ClearManufacturer() {
-	m.manufacturer = nil
-	m.clearedFields[item.FieldManufacturer] = struct{}{}
+// ClearLocations clears the "locations" edge to the Location entity.
+func (m *GroupMutation) ClearLocations() {
+	m.clearedlocations = true
 }
 
-// ManufacturerCleared returns if the "manufacturer" field was cleared in this mutation.
-func (m *ItemMutation) ManufacturerCleared() bool {
-	_, ok := m.clearedFields[item.FieldManufacturer]
-	return ok
+// LocationsCleared reports if the "locations" edge to the Location entity was cleared.
+func (m *GroupMutation) LocationsCleared() bool {
+	return m.clearedlocations
 }
 
-// ResetManufacturer resets all changes to the "manufacturer" field.
-func (m *ItemMutation) ResetManufacturer() {
-	m.manufacturer = nil
-	delete(m.clearedFields, item.FieldManufacturer)
+// RemoveLocationIDs removes the "locations" edge to the Location entity by IDs.
+func (m *GroupMutation) RemoveLocationIDs(ids ...uuid.UUID) {
+	if m.removedlocations == nil {
+		m.removedlocations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.locations, ids[i])
+		m.removedlocations[ids[i]] = struct{}{}
+	}
 }
 
-// SetLifetimeWarranty sets the "lifetime_warranty" field.
-func (m *ItemMutation) SetLifetimeWarranty(b bool) {
-	m.lifetime_warranty = &b
+// RemovedLocations returns the removed IDs of the "locations" edge to the Location entity.
+func (m *GroupMutation) RemovedLocationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedlocations {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// LifetimeWarranty returns the value of the "lifetime_warranty" field in the mutation.
-func (m *ItemMutation) LifetimeWarranty() (r bool, exists bool) {
-	v := m.lifetime_warranty
-	if v == nil {
-		return
+// LocationsIDs returns the "locations" edge IDs in the mutation.
+func (m *GroupMutation) LocationsIDs() (ids []uuid.UUID) {
+	for id := range m.locations {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldLifetimeWarranty returns the old "lifetime_warranty" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldLifetimeWarranty(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLifetimeWarranty is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLifetimeWarranty requires an ID field in the mutation")
+// ResetLocations resets all changes to the "locations" edge.
+func (m *GroupMutation) ResetLocations() {
+	m.locations = nil
+	m.clearedlocations = false
+	m.removedlocations = nil
+}
+
+// AddItemIDs adds the "items" edge to the Item entity by ids.
+func (m *GroupMutation) AddItemIDs(ids ...uuid.UUID) {
+	if m.items == nil {
+		m.items = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLifetimeWarranty: %w", err)
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
 	}
-	return oldValue.LifetimeWarranty, nil
 }
 
-// ResetLifetimeWarranty resets all changes to the "lifetime_warranty" field.
-func (m *ItemMutation) ResetLifetimeWarranty() {
-	m.lifetime_warranty = nil
+// ClearItems clears the "items" edge to the Item entity.
+func (m *GroupMutation) ClearItems() {
+	m.cleareditems = true
 }
 
-// SetWarrantyExpires sets the "warranty_expires" field.
-func (m *ItemMutation) SetWarrantyExpires(t time.Time) {
-	m.warranty_expires = &t
+// ItemsCleared reports if the "items" edge to the Item entity was cleared.
+func (m *GroupMutation) ItemsCleared() bool {
+	return m.cleareditems
 }
 
-// WarrantyExpires returns the value of the "warranty_expires" field in the mutation.
-func (m *ItemMutation) WarrantyExpires() (r time.Time, exists bool) {
-	v := m.warranty_expires
-	if v == nil {
-		return
+// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
+func (m *GroupMutation) RemoveItemIDs(ids ...uuid.UUID) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldWarrantyExpires returns the old "warranty_expires" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldWarrantyExpires(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWarrantyExpires is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWarrantyExpires requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWarrantyExpires: %w", err)
+// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
+func (m *GroupMutation) RemovedItemsIDs() (ids []uuid.UUID) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
 	}
-	return oldValue.WarrantyExpires, nil
+	return
 }
 
-// ClearWarrantyExpires clears the value of the "warranty_expires" field.
-func (m *ItemMutation) ClearWarrantyExpires() {
-	m.warranty_expires = nil
-	m.clearedFields[item.FieldWarrantyExpires] = struct{}{}
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *GroupMutation) ItemsIDs() (ids []uuid.UUID) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// WarrantyExpiresCleared returns if the "warranty_expires" field was cleared in this mutation.
-func (m *ItemMutation) WarrantyExpiresCleared() bool {
-	_, ok := m.clearedFields[item.FieldWarrantyExpires]
-	return ok
+// ResetItems resets all changes to the "items" edge.
+func (m *GroupMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
 }
 
-// ResetWarrantyExpires resets all changes to the "warranty_expires" field.
-func (m *ItemMutation) ResetWarrantyExpires() {
-	m.warranty_expires = nil
-	delete(m.clearedFields, item.FieldWarrantyExpires)
+// AddLabelIDs adds the "labels" edge to the Label entity by ids.
+func (m *GroupMutation) AddLabelIDs(ids ...uuid.UUID) {
+	if m.labels == nil {
+		m.labels = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.labels[ids[i]] = struct{}{}
+	}
 }
 
-// SetWarrantyDetails sets the "warranty_details" field.
-func (m *ItemMutation) SetWarrantyDetails(s string) {
-	m.warranty_details = &s
+// ClearLabels clears the "labels" edge to the Label entity.
+func (m *GroupMutation) ClearLabels() {
+	m.clearedlabels = true
 }
 
-// WarrantyDetails returns the value of the "warranty_details" field in the mutation.
-func (m *ItemMutation) WarrantyDetails() (r string, exists bool) {
-	v := m.warranty_details
-	if v == nil {
-		return
-	}
-	return *v, true
+// LabelsCleared reports if the "labels" edge to the Label entity was cleared.
+func (m *GroupMutation) LabelsCleared() bool {
+	return m.clearedlabels
 }
 
-// OldWarrantyDetails returns the old "warranty_details" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldWarrantyDetails(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWarrantyDetails is only allowed on UpdateOne operations")
+// RemoveLabelIDs removes the "labels" edge to the Label entity by IDs.
+func (m *GroupMutation) RemoveLabelIDs(ids ...uuid.UUID) {
+	if m.removedlabels == nil {
+		m.removedlabels = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWarrantyDetails requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.labels, ids[i])
+		m.removedlabels[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWarrantyDetails: %w", err)
+}
+
+// RemovedLabels returns the removed IDs of the "labels" edge to the Label entity.
+func (m *GroupMutation) RemovedLabelsIDs() (ids []uuid.UUID) {
+	for id := range m.removedlabels {
+		ids = append(ids, id)
 	}
-	return oldValue.WarrantyDetails, nil
+	return
 }
 
-// ClearWarrantyDetails clears the value of the "warranty_details" field.
-func (m *ItemMutation) ClearWarrantyDetails() {
-	m.warranty_details = nil
-	m.clearedFields[item.FieldWarrantyDetails] = struct{}{}
+// LabelsIDs returns the "labels" edge IDs in the mutation.
+func (m *GroupMutation) LabelsIDs() (ids []uuid.UUID) {
+	for id := range m.labels {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// WarrantyDetailsCleared returns if the "warranty_details" field was cleared in this mutation.
-func (m *ItemMutation) WarrantyDetailsCleared() bool {
-	_, ok := m.clearedFields[item.FieldWarrantyDetails]
-	return ok
+// ResetLabels resets all changes to the "labels" edge.
+func (m *GroupMutation) ResetLabels() {
+	m.labels = nil
+	m.clearedlabels = false
+	m.removedlabels = nil
 }
 
-// ResetWarrantyDetails resets all changes to the "warranty_details" field.
-func (m *ItemMutation) ResetWarrantyDetails() {
-	m.warranty_details = nil
-	delete(m.clearedFields, item.FieldWarrantyDetails)
+// AddDocumentIDs adds the "documents" edge to the Document entity by ids.
+func (m *GroupMutation) AddDocumentIDs(ids ...uuid.UUID) {
+	if m.documents == nil {
+		m.documents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.documents[ids[i]] = struct{}{}
+	}
 }
 
-// SetPurchaseTime sets the "purchase_time" field.
-func (m *ItemMutation) SetPurchaseTime(t time.Time) {
-	m.purchase_time = &t
+// ClearDocuments clears the "documents" edge to the Document entity.
+func (m *GroupMutation) ClearDocuments() {
+	m.cleareddocuments = true
 }
 
-// PurchaseTime returns the value of the "purchase_time" field in the mutation.
-func (m *ItemMutation) PurchaseTime() (r time.Time, exists bool) {
-	v := m.purchase_time
-	if v == nil {
-		return
-	}
-	return *v, true
+// DocumentsCleared reports if the "documents" edge to the Document entity was cleared.
+func (m *GroupMutation) DocumentsCleared() bool {
+	return m.cleareddocuments
 }
 
-// OldPurchaseTime returns the old "purchase_time" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldPurchaseTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPurchaseTime is only allowed on UpdateOne operations")
+// RemoveDocumentIDs removes the "documents" edge to the Document entity by IDs.
+func (m *GroupMutation) RemoveDocumentIDs(ids ...uuid.UUID) {
+	if m.removeddocuments == nil {
+		m.removeddocuments = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPurchaseTime requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.documents, ids[i])
+		m.removeddocuments[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPurchaseTime: %w", err)
+}
+
+// RemovedDocuments returns the removed IDs of the "documents" edge to the Document entity.
+func (m *GroupMutation) RemovedDocumentsIDs() (ids []uuid.UUID) {
+	for id := range m.removeddocuments {
+		ids = append(ids, id)
 	}
-	return oldValue.PurchaseTime, nil
+	return
 }
 
-// ClearPurchaseTime clears the value of the "purchase_time" field.
-func (m *ItemMutation) ClearPurchaseTime() {
-	m.purchase_time = nil
-	m.clearedFields[item.FieldPurchaseTime] = struct{}{}
+// DocumentsIDs returns the "documents" edge IDs in the mutation.
+func (m *GroupMutation) DocumentsIDs() (ids []uuid.UUID) {
+	for id := range m.documents {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// PurchaseTimeCleared returns if the "purchase_time" field was cleared in this mutation.
-func (m *ItemMutation) PurchaseTimeCleared() bool {
-	_, ok := m.clearedFields[item.FieldPurchaseTime]
-	return ok
+// ResetDocuments resets all changes to the "documents" edge.
+func (m *GroupMutation) ResetDocuments() {
+	m.documents = nil
+	m.cleareddocuments = false
+	m.removeddocuments = nil
 }
 
-// ResetPurchaseTime resets all changes to the "purchase_time" field.
-func (m *ItemMutation) ResetPurchaseTime() {
-	m.purchase_time = nil
-	delete(m.clearedFields, item.FieldPurchaseTime)
+// AddInvitationTokenIDs adds the "invitation_tokens" edge to the GroupInvitationToken entity by ids.
+func (m *GroupMutation) AddInvitationTokenIDs(ids ...uuid.UUID) {
+	if m.invitation_tokens == nil {
+		m.invitation_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.invitation_tokens[ids[i]] = struct{}{}
+	}
 }
 
-// SetPurchaseFrom sets the "purchase_from" field.
-func (m *ItemMutation) SetPurchaseFrom(s string) {
-	m.purchase_from = &s
+// ClearInvitationTokens clears the "invitation_tokens" edge to the GroupInvitationToken entity.
+func (m *GroupMutation) ClearInvitationTokens() {
+	m.clearedinvitation_tokens = true
 }
 
-// PurchaseFrom returns the value of the "purchase_from" field in the mutation.
-func (m *ItemMutation) PurchaseFrom() (r string, exists bool) {
-	v := m.purchase_from
-	if v == nil {
-		return
-	}
-	return *v, true
+// InvitationTokensCleared reports if the "invitation_tokens" edge to the GroupInvitationToken entity was cleared.
+func (m *GroupMutation) InvitationTokensCleared() bool {
+	return m.clearedinvitation_tokens
 }
 
-// OldPurchaseFrom returns the old "purchase_from" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldPurchaseFrom(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPurchaseFrom is only allowed on UpdateOne operations")
+// RemoveInvitationTokenIDs removes the "invitation_tokens" edge to the GroupInvitationToken entity by IDs.
+func (m *GroupMutation) RemoveInvitationTokenIDs(ids ...uuid.UUID) {
+	if m.removedinvitation_tokens == nil {
+		m.removedinvitation_tokens = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPurchaseFrom requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.invitation_tokens, ids[i])
+		m.removedinvitation_tokens[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPurchaseFrom: %w", err)
+}
+
+// RemovedInvitationTokens returns the removed IDs of the "invitation_tokens" edge to the GroupInvitationToken entity.
+func (m *GroupMutation) RemovedInvitationTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedinvitation_tokens {
+		ids = append(ids, id)
 	}
-	return oldValue.PurchaseFrom, nil
+	return
 }
 
-// ClearPurchaseFrom clears the value of the "purchase_from" field.
-func (m *ItemMutation) ClearPurchaseFrom() {
-	m.purchase_from = nil
-	m.clearedFields[item.FieldPurchaseFrom] = struct{}{}
+// InvitationTokensIDs returns the "invitation_tokens" edge IDs in the mutation.
+func (m *GroupMutation) InvitationTokensIDs() (ids []uuid.UUID) {
+	for id := range m.invitation_tokens {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// PurchaseFromCleared returns if the "purchase_from" field was cleared in this mutation.
-func (m *ItemMutation) PurchaseFromCleared() bool {
-	_, ok := m.clearedFields[item.FieldPurchaseFrom]
-	return ok
+// ResetInvitationTokens resets all changes to the "invitation_tokens" edge.
+func (m *GroupMutation) ResetInvitationTokens() {
+	m.invitation_tokens = nil
+	m.clearedinvitation_tokens = false
+	m.removedinvitation_tokens = nil
 }
 
-// ResetPurchaseFrom resets all changes to the "purchase_from" field.
-func (m *ItemMutation) ResetPurchaseFrom() {
-	m.purchase_from = nil
-	delete(m.clearedFields, item.FieldPurchaseFrom)
+// AddNotifierIDs adds the "notifiers" edge to the Notifier entity by ids.
+func (m *GroupMutation) AddNotifierIDs(ids ...uuid.UUID) {
+	if m.notifiers == nil {
+		m.notifiers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.notifiers[ids[i]] = struct{}{}
+	}
 }
 
-// SetPurchasePrice sets the "purchase_price" field.
-func (m *ItemMutation) SetPurchasePrice(f float64) {
-	m.purchase_price = &f
-	m.addpurchase_price = nil
+// ClearNotifiers clears the "notifiers" edge to the Notifier entity.
+func (m *GroupMutation) ClearNotifiers() {
+	m.clearednotifiers = true
 }
 
-// PurchasePrice returns the value of the "purchase_price" field in the mutation.
-func (m *ItemMutation) PurchasePrice() (r float64, exists bool) {
-	v := m.purchase_price
-	if v == nil {
-		return
-	}
-	return *v, true
+// NotifiersCleared reports if the "notifiers" edge to the Notifier entity was cleared.
+func (m *GroupMutation) NotifiersCleared() bool {
+	return m.clearednotifiers
 }
 
-// OldPurchasePrice returns the old "purchase_price" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldPurchasePrice(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPurchasePrice is only allowed on UpdateOne operations")
+// RemoveNotifierIDs removes the "notifiers" edge to the Notifier entity by IDs.
+func (m *GroupMutation) RemoveNotifierIDs(ids ...uuid.UUID) {
+	if m.removednotifiers == nil {
+		m.removednotifiers = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPurchasePrice requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.notifiers, ids[i])
+		m.removednotifiers[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPurchasePrice: %w", err)
+}
+
+// RemovedNotifiers returns the removed IDs of the "notifiers" edge to the Notifier entity.
+func (m *GroupMutation) RemovedNotifiersIDs() (ids []uuid.UUID) {
+	for id := range m.removednotifiers {
+		ids = append(ids, id)
 	}
-	return oldValue.PurchasePrice, nil
+	return
 }
 
-// AddPurchasePrice adds f to the "purchase_price" field.
-func (m *ItemMutation) AddPurchasePrice(f float64) {
-	if m.addpurchase_price != nil {
-		*m.addpurchase_price += f
-	} else {
-		m.addpurchase_price = &f
+// NotifiersIDs returns the "notifiers" edge IDs in the mutation.
+func (m *GroupMutation) NotifiersIDs() (ids []uuid.UUID) {
+	for id := range m.notifiers {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedPurchasePrice returns the value that was added to the "purchase_price" field in this mutation.
-func (m *ItemMutation) AddedPurchasePrice() (r float64, exists bool) {
-	v := m.addpurchase_price
-	if v == nil {
-		return
+// ResetNotifiers resets all changes to the "notifiers" edge.
+func (m *GroupMutation) ResetNotifiers() {
+	m.notifiers = nil
+	m.clearednotifiers = false
+	m.removednotifiers = nil
+}
+
+// AddAuditEntryIDs adds the "audit_entries" edge to the AuditEntry entity by ids.
+func (m *GroupMutation) AddAuditEntryIDs(ids ...uuid.UUID) {
+	if m.audit_entries == nil {
+		m.audit_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.audit_entries[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetPurchasePrice resets all changes to the "purchase_price" field.
-func (m *ItemMutation) ResetPurchasePrice() {
-	m.purchase_price = nil
-	m.addpurchase_price = nil
+// ClearAuditEntries clears the "audit_entries" edge to the AuditEntry entity.
+func (m *GroupMutation) ClearAuditEntries() {
+	m.clearedaudit_entries = true
 }
 
-// SetSoldTime sets the "sold_time" field.
-func (m *ItemMutation) SetSoldTime(t time.Time) {
-	m.sold_time = &t
+// AuditEntriesCleared reports if the "audit_entries" edge to the AuditEntry entity was cleared.
+func (m *GroupMutation) AuditEntriesCleared() bool {
+	return m.clearedaudit_entries
 }
 
-// SoldTime returns the value of the "sold_time" field in the mutation.
-func (m *ItemMutation) SoldTime() (r time.Time, exists bool) {
-	v := m.sold_time
-	if v == nil {
-		return
+// RemoveAuditEntryIDs removes the "audit_entries" edge to the AuditEntry entity by IDs.
+func (m *GroupMutation) RemoveAuditEntryIDs(ids ...uuid.UUID) {
+	if m.removedaudit_entries == nil {
+		m.removedaudit_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.audit_entries, ids[i])
+		m.removedaudit_entries[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldSoldTime returns the old "sold_time" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldSoldTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSoldTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSoldTime requires an ID field in the mutation")
+// RemovedAuditEntries returns the removed IDs of the "audit_entries" edge to the AuditEntry entity.
+func (m *GroupMutation) RemovedAuditEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedaudit_entries {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSoldTime: %w", err)
+	return
+}
+
+// AuditEntriesIDs returns the "audit_entries" edge IDs in the mutation.
+func (m *GroupMutation) AuditEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.audit_entries {
+		ids = append(ids, id)
 	}
-	return oldValue.SoldTime, nil
+	return
 }
 
-// ClearSoldTime clears the value of the "sold_time" field.
-func (m *ItemMutation) ClearSoldTime() {
-	m.sold_time = nil
-	m.clearedFields[item.FieldSoldTime] = struct{}{}
+// ResetAuditEntries resets all changes to the "audit_entries" edge.
+func (m *GroupMutation) ResetAuditEntries() {
+	m.audit_entries = nil
+	m.clearedaudit_entries = false
+	m.removedaudit_entries = nil
 }
 
-// SoldTimeCleared returns if the "sold_time" field was cleared in this mutation.
-func (m *ItemMutation) SoldTimeCleared() bool {
-	_, ok := m.clearedFields[item.FieldSoldTime]
-	return ok
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by ids.
+func (m *GroupMutation) AddSavedSearchIDs(ids ...uuid.UUID) {
+	if m.saved_searches == nil {
+		m.saved_searches = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.saved_searches[ids[i]] = struct{}{}
+	}
 }
 
-// ResetSoldTime resets all changes to the "sold_time" field.
-func (m *ItemMutation) ResetSoldTime() {
-	m.sold_time = nil
-	delete(m.clearedFields, item.FieldSoldTime)
+// ClearSavedSearches clears the "saved_searches" edge to the SavedSearch entity.
+func (m *GroupMutation) ClearSavedSearches() {
+	m.clearedsaved_searches = true
 }
 
-// SetSoldTo sets the "sold_to" field.
-func (m *ItemMutation) SetSoldTo(s string) {
-	m.sold_to = &s
-}
-
-// SoldTo returns the value of the "sold_to" field in the mutation.
-func (m *ItemMutation) SoldTo() (r string, exists bool) {
-	v := m.sold_to
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSoldTo returns the old "sold_to" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldSoldTo(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSoldTo is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSoldTo requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSoldTo: %w", err)
-	}
-	return oldValue.SoldTo, nil
-}
-
-// ClearSoldTo clears the value of the "sold_to" field.
-func (m *ItemMutation) ClearSoldTo() {
-	m.sold_to = nil
-	m.clearedFields[item.FieldSoldTo] = struct{}{}
-}
-
-// SoldToCleared returns if the "sold_to" field was cleared in this mutation.
-func (m *ItemMutation) SoldToCleared() bool {
-	_, ok := m.clearedFields[item.FieldSoldTo]
-	return ok
-}
-
-// ResetSoldTo resets all changes to the "sold_to" field.
-func (m *ItemMutation) ResetSoldTo() {
-	m.sold_to = nil
-	delete(m.clearedFields, item.FieldSoldTo)
-}
-
-// SetSoldPrice sets the "sold_price" field.
-func (m *ItemMutation) SetSoldPrice(f float64) {
-	m.sold_price = &f
-	m.addsold_price = nil
-}
-
-// SoldPrice returns the value of the "sold_price" field in the mutation.
-func (m *ItemMutation) SoldPrice() (r float64, exists bool) {
-	v := m.sold_price
-	if v == nil {
-		return
-	}
-	return *v, true
+// SavedSearchesCleared reports if the "saved_searches" edge to the SavedSearch entity was cleared.
+func (m *GroupMutation) SavedSearchesCleared() bool {
+	return m.clearedsaved_searches
 }
 
-// OldSoldPrice returns the old "sold_price" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldSoldPrice(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSoldPrice is only allowed on UpdateOne operations")
+// RemoveSavedSearchIDs removes the "saved_searches" edge to the SavedSearch entity by IDs.
+func (m *GroupMutation) RemoveSavedSearchIDs(ids ...uuid.UUID) {
+	if m.removedsaved_searches == nil {
+		m.removedsaved_searches = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSoldPrice requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSoldPrice: %w", err)
+	for i := range ids {
+		delete(m.saved_searches, ids[i])
+		m.removedsaved_searches[ids[i]] = struct{}{}
 	}
-	return oldValue.SoldPrice, nil
 }
 
-// AddSoldPrice adds f to the "sold_price" field.
-func (m *ItemMutation) AddSoldPrice(f float64) {
-	if m.addsold_price != nil {
-		*m.addsold_price += f
-	} else {
-		m.addsold_price = &f
+// RemovedSavedSearches returns the removed IDs of the "saved_searches" edge to the SavedSearch entity.
+func (m *GroupMutation) RemovedSavedSearchesIDs() (ids []uuid.UUID) {
+	for id := range m.removedsaved_searches {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedSoldPrice returns the value that was added to the "sold_price" field in this mutation.
-func (m *ItemMutation) AddedSoldPrice() (r float64, exists bool) {
-	v := m.addsold_price
-	if v == nil {
-		return
+// SavedSearchesIDs returns the "saved_searches" edge IDs in the mutation.
+func (m *GroupMutation) SavedSearchesIDs() (ids []uuid.UUID) {
+	for id := range m.saved_searches {
+		ids = append(ids, id)
 	}
-	return *v, true
-}
-
-// ResetSoldPrice resets all changes to the "sold_price" field.
-func (m *ItemMutation) ResetSoldPrice() {
-	m.sold_price = nil
-	m.addsold_price = nil
-}
-
-// SetSoldNotes sets the "sold_notes" field.
-func (m *ItemMutation) SetSoldNotes(s string) {
-	m.sold_notes = &s
+	return
 }
 
-// SoldNotes returns the value of the "sold_notes" field in the mutation.
-func (m *ItemMutation) SoldNotes() (r string, exists bool) {
-	v := m.sold_notes
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetSavedSearches resets all changes to the "saved_searches" edge.
+func (m *GroupMutation) ResetSavedSearches() {
+	m.saved_searches = nil
+	m.clearedsaved_searches = false
+	m.removedsaved_searches = nil
 }
 
-// OldSoldNotes returns the old "sold_notes" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldSoldNotes(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSoldNotes is only allowed on UpdateOne operations")
+// AddNotificationRuleIDs adds the "notification_rules" edge to the NotificationRule entity by ids.
+func (m *GroupMutation) AddNotificationRuleIDs(ids ...uuid.UUID) {
+	if m.notification_rules == nil {
+		m.notification_rules = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSoldNotes requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSoldNotes: %w", err)
+	for i := range ids {
+		m.notification_rules[ids[i]] = struct{}{}
 	}
-	return oldValue.SoldNotes, nil
-}
-
-// ClearSoldNotes clears the value of the "sold_notes" field.
-func (m *ItemMutation) ClearSoldNotes() {
-	m.sold_notes = nil
-	m.clearedFields[item.FieldSoldNotes] = struct{}{}
-}
-
-// SoldNotesCleared returns if the "sold_notes" field was cleared in this mutation.
-func (m *ItemMutation) SoldNotesCleared() bool {
-	_, ok := m.clearedFields[item.FieldSoldNotes]
-	return ok
-}
-
-// ResetSoldNotes resets all changes to the "sold_notes" field.
-func (m *ItemMutation) ResetSoldNotes() {
-	m.sold_notes = nil
-	delete(m.clearedFields, item.FieldSoldNotes)
-}
-
-// SetGroupID sets the "group" edge to the Group entity by id.
-func (m *ItemMutation) SetGroupID(id uuid.UUID) {
-	m.group = &id
 }
 
-// ClearGroup clears the "group" edge to the Group entity.
-func (m *ItemMutation) ClearGroup() {
-	m.clearedgroup = true
+// ClearNotificationRules clears the "notification_rules" edge to the NotificationRule entity.
+func (m *GroupMutation) ClearNotificationRules() {
+	m.clearednotification_rules = true
 }
 
-// GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *ItemMutation) GroupCleared() bool {
-	return m.clearedgroup
+// NotificationRulesCleared reports if the "notification_rules" edge to the NotificationRule entity was cleared.
+func (m *GroupMutation) NotificationRulesCleared() bool {
+	return m.clearednotification_rules
 }
 
-// GroupID returns the "group" edge ID in the mutation.
-func (m *ItemMutation) GroupID() (id uuid.UUID, exists bool) {
-	if m.group != nil {
-		return *m.group, true
+// RemoveNotificationRuleIDs removes the "notification_rules" edge to the NotificationRule entity by IDs.
+func (m *GroupMutation) RemoveNotificationRuleIDs(ids ...uuid.UUID) {
+	if m.removednotification_rules == nil {
+		m.removednotification_rules = make(map[uuid.UUID]struct{})
 	}
-	return
-}
-
-// GroupIDs returns the "group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *ItemMutation) GroupIDs() (ids []uuid.UUID) {
-	if id := m.group; id != nil {
-		ids = append(ids, *id)
+	for i := range ids {
+		delete(m.notification_rules, ids[i])
+		m.removednotification_rules[ids[i]] = struct{}{}
 	}
-	return
-}
-
-// ResetGroup resets all changes to the "group" edge.
-func (m *ItemMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
-}
-
-// SetParentID sets the "parent" edge to the Item entity by id.
-func (m *ItemMutation) SetParentID(id uuid.UUID) {
-	m.parent = &id
-}
-
-// ClearParent clears the "parent" edge to the Item entity.
-func (m *ItemMutation) ClearParent() {
-	m.clearedparent = true
 }
 
-// ParentCleared reports if the "parent" edge to the Item entity was cleared.
-func (m *ItemMutation) ParentCleared() bool {
-	return m.clearedparent
-}
-
-// ParentID returns the "parent" edge ID in the mutation.
-func (m *ItemMutation) ParentID() (id uuid.UUID, exists bool) {
-	if m.parent != nil {
-		return *m.parent, true
+// RemovedNotificationRules returns the removed IDs of the "notification_rules" edge to the NotificationRule entity.
+func (m *GroupMutation) RemovedNotificationRulesIDs() (ids []uuid.UUID) {
+	for id := range m.removednotification_rules {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *ItemMutation) ParentIDs() (ids []uuid.UUID) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
+// NotificationRulesIDs returns the "notification_rules" edge IDs in the mutation.
+func (m *GroupMutation) NotificationRulesIDs() (ids []uuid.UUID) {
+	for id := range m.notification_rules {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *ItemMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+// ResetNotificationRules resets all changes to the "notification_rules" edge.
+func (m *GroupMutation) ResetNotificationRules() {
+	m.notification_rules = nil
+	m.clearednotification_rules = false
+	m.removednotification_rules = nil
 }
 
-// AddChildIDs adds the "children" edge to the Item entity by ids.
-func (m *ItemMutation) AddChildIDs(ids ...uuid.UUID) {
-	if m.children == nil {
-		m.children = make(map[uuid.UUID]struct{})
+// AddNotificationReminderIDs adds the "notification_reminders" edge to the NotificationReminder entity by ids.
+func (m *GroupMutation) AddNotificationReminderIDs(ids ...uuid.UUID) {
+	if m.notification_reminders == nil {
+		m.notification_reminders = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.children[ids[i]] = struct{}{}
+		m.notification_reminders[ids[i]] = struct{}{}
 	}
 }
 
-// ClearChildren clears the "children" edge to the Item entity.
-func (m *ItemMutation) ClearChildren() {
-	m.clearedchildren = true
+// ClearNotificationReminders clears the "notification_reminders" edge to the NotificationReminder entity.
+func (m *GroupMutation) ClearNotificationReminders() {
+	m.clearednotification_reminders = true
 }
 
-// ChildrenCleared reports if the "children" edge to the Item entity was cleared.
-func (m *ItemMutation) ChildrenCleared() bool {
-	return m.clearedchildren
+// NotificationRemindersCleared reports if the "notification_reminders" edge to the NotificationReminder entity was cleared.
+func (m *GroupMutation) NotificationRemindersCleared() bool {
+	return m.clearednotification_reminders
 }
 
-// RemoveChildIDs removes the "children" edge to the Item entity by IDs.
-func (m *ItemMutation) RemoveChildIDs(ids ...uuid.UUID) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[uuid.UUID]struct{})
+// RemoveNotificationReminderIDs removes the "notification_reminders" edge to the NotificationReminder entity by IDs.
+func (m *GroupMutation) RemoveNotificationReminderIDs(ids ...uuid.UUID) {
+	if m.removednotification_reminders == nil {
+		m.removednotification_reminders = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
+		delete(m.notification_reminders, ids[i])
+		m.removednotification_reminders[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedChildren returns the removed IDs of the "children" edge to the Item entity.
-func (m *ItemMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
-	for id := range m.removedchildren {
+// RemovedNotificationReminders returns the removed IDs of the "notification_reminders" edge to the NotificationReminder entity.
+func (m *GroupMutation) RemovedNotificationRemindersIDs() (ids []uuid.UUID) {
+	for id := range m.removednotification_reminders {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *ItemMutation) ChildrenIDs() (ids []uuid.UUID) {
-	for id := range m.children {
+// NotificationRemindersIDs returns the "notification_reminders" edge IDs in the mutation.
+func (m *GroupMutation) NotificationRemindersIDs() (ids []uuid.UUID) {
+	for id := range m.notification_reminders {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *ItemMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+// ResetNotificationReminders resets all changes to the "notification_reminders" edge.
+func (m *GroupMutation) ResetNotificationReminders() {
+	m.notification_reminders = nil
+	m.clearednotification_reminders = false
+	m.removednotification_reminders = nil
 }
 
-// AddLabelIDs adds the "label" edge to the Label entity by ids.
-func (m *ItemMutation) AddLabelIDs(ids ...uuid.UUID) {
-	if m.label == nil {
-		m.label = make(map[uuid.UUID]struct{})
+// AddNotificationTemplateIDs adds the "notification_templates" edge to the NotificationTemplate entity by ids.
+func (m *GroupMutation) AddNotificationTemplateIDs(ids ...uuid.UUID) {
+	if m.notification_templates == nil {
+		m.notification_templates = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.label[ids[i]] = struct{}{}
+		m.notification_templates[ids[i]] = struct{}{}
 	}
 }
 
-// ClearLabel clears the "label" edge to the Label entity.
-func (m *ItemMutation) ClearLabel() {
-	m.clearedlabel = true
+// ClearNotificationTemplates clears the "notification_templates" edge to the NotificationTemplate entity.
+func (m *GroupMutation) ClearNotificationTemplates() {
+	m.clearednotification_templates = true
 }
 
-// LabelCleared reports if the "label" edge to the Label entity was cleared.
-func (m *ItemMutation) LabelCleared() bool {
-	return m.clearedlabel
+// NotificationTemplatesCleared reports if the "notification_templates" edge to the NotificationTemplate entity was cleared.
+func (m *GroupMutation) NotificationTemplatesCleared() bool {
+	return m.clearednotification_templates
 }
 
-// RemoveLabelIDs removes the "label" edge to the Label entity by IDs.
-func (m *ItemMutation) RemoveLabelIDs(ids ...uuid.UUID) {
-	if m.removedlabel == nil {
-		m.removedlabel = make(map[uuid.UUID]struct{})
+// RemoveNotificationTemplateIDs removes the "notification_templates" edge to the NotificationTemplate entity by IDs.
+func (m *GroupMutation) RemoveNotificationTemplateIDs(ids ...uuid.UUID) {
+	if m.removednotification_templates == nil {
+		m.removednotification_templates = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.label, ids[i])
-		m.removedlabel[ids[i]] = struct{}{}
+		delete(m.notification_templates, ids[i])
+		m.removednotification_templates[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedLabel returns the removed IDs of the "label" edge to the Label entity.
-func (m *ItemMutation) RemovedLabelIDs() (ids []uuid.UUID) {
-	for id := range m.removedlabel {
+// RemovedNotificationTemplates returns the removed IDs of the "notification_templates" edge to the NotificationTemplate entity.
+func (m *GroupMutation) RemovedNotificationTemplatesIDs() (ids []uuid.UUID) {
+	for id := range m.removednotification_templates {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// LabelIDs returns the "label" edge IDs in the mutation.
-func (m *ItemMutation) LabelIDs() (ids []uuid.UUID) {
-	for id := range m.label {
+// NotificationTemplatesIDs returns the "notification_templates" edge IDs in the mutation.
+func (m *GroupMutation) NotificationTemplatesIDs() (ids []uuid.UUID) {
+	for id := range m.notification_templates {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetLabel resets all changes to the "label" edge.
-func (m *ItemMutation) ResetLabel() {
-	m.label = nil
-	m.clearedlabel = false
-	m.removedlabel = nil
-}
-
-// SetLocationID sets the "location" edge to the Location entity by id.
-func (m *ItemMutation) SetLocationID(id uuid.UUID) {
-	m.location = &id
+// ResetNotificationTemplates resets all changes to the "notification_templates" edge.
+func (m *GroupMutation) ResetNotificationTemplates() {
+	m.notification_templates = nil
+	m.clearednotification_templates = false
+	m.removednotification_templates = nil
 }
 
-// ClearLocation clears the "location" edge to the Location entity.
-func (m *ItemMutation) ClearLocation() {
-	m.clearedlocation = true
+// Where appends a list predicates to the GroupMutation builder.
+func (m *GroupMutation) Where(ps ...predicate.Group) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// LocationCleared reports if the "location" edge to the Location entity was cleared.
-func (m *ItemMutation) LocationCleared() bool {
-	return m.clearedlocation
+// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Group, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// LocationID returns the "location" edge ID in the mutation.
-func (m *ItemMutation) LocationID() (id uuid.UUID, exists bool) {
-	if m.location != nil {
-		return *m.location, true
-	}
-	return
+// Op returns the operation name.
+func (m *GroupMutation) Op() Op {
+	return m.op
 }
 
-// LocationIDs returns the "location" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// LocationID instead. It exists only for internal usage by the builders.
-func (m *ItemMutation) LocationIDs() (ids []uuid.UUID) {
-	if id := m.location; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetOp allows setting the mutation operation.
+func (m *GroupMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ResetLocation resets all changes to the "location" edge.
-func (m *ItemMutation) ResetLocation() {
-	m.location = nil
-	m.clearedlocation = false
+// Type returns the node type of this mutation (Group).
+func (m *GroupMutation) Type() string {
+	return m.typ
 }
 
-// AddFieldIDs adds the "fields" edge to the ItemField entity by ids.
-func (m *ItemMutation) AddFieldIDs(ids ...uuid.UUID) {
-	if m.fields == nil {
-		m.fields = make(map[uuid.UUID]struct{})
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, group.FieldCreatedAt)
 	}
-	for i := range ids {
-		m.fields[ids[i]] = struct{}{}
+	if m.updated_at != nil {
+		fields = append(fields, group.FieldUpdatedAt)
 	}
-}
-
-// ClearFields clears the "fields" edge to the ItemField entity.
-func (m *ItemMutation) ClearFields() {
-	m.clearedfields = true
-}
-
-// FieldsCleared reports if the "fields" edge to the ItemField entity was cleared.
-func (m *ItemMutation) FieldsCleared() bool {
-	return m.clearedfields
-}
-
-// RemoveFieldIDs removes the "fields" edge to the ItemField entity by IDs.
-func (m *ItemMutation) RemoveFieldIDs(ids ...uuid.UUID) {
-	if m.removedfields == nil {
-		m.removedfields = make(map[uuid.UUID]struct{})
+	if m.name != nil {
+		fields = append(fields, group.FieldName)
 	}
-	for i := range ids {
-		delete(m.fields, ids[i])
-		m.removedfields[ids[i]] = struct{}{}
+	if m.currency != nil {
+		fields = append(fields, group.FieldCurrency)
 	}
-}
-
-// RemovedFields returns the removed IDs of the "fields" edge to the ItemField entity.
-func (m *ItemMutation) RemovedFieldsIDs() (ids []uuid.UUID) {
-	for id := range m.removedfields {
-		ids = append(ids, id)
+	if m.timezone != nil {
+		fields = append(fields, group.FieldTimezone)
 	}
-	return
+	if m.notify_time != nil {
+		fields = append(fields, group.FieldNotifyTime)
+	}
+	if m.digest != nil {
+		fields = append(fields, group.FieldDigest)
+	}
+	if m.notified_on != nil {
+		fields = append(fields, group.FieldNotifiedOn)
+	}
+	return fields
 }
 
-// FieldsIDs returns the "fields" edge IDs in the mutation.
-func (m *ItemMutation) FieldsIDs() (ids []uuid.UUID) {
-	for id := range m.fields {
-		ids = append(ids, id)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case group.FieldCreatedAt:
+		return m.CreatedAt()
+	case group.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case group.FieldName:
+		return m.Name()
+	case group.FieldCurrency:
+		return m.Currency()
+	case group.FieldTimezone:
+		return m.Timezone()
+	case group.FieldNotifyTime:
+		return m.NotifyTime()
+	case group.FieldDigest:
+		return m.Digest()
+	case group.FieldNotifiedOn:
+		return m.NotifiedOn()
 	}
-	return
+	return nil, false
 }
 
-// ResetFields resets all changes to the "fields" edge.
-func (m *ItemMutation) ResetFields() {
-	m.fields = nil
-	m.clearedfields = false
-	m.removedfields = nil
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case group.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case group.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case group.FieldName:
+		return m.OldName(ctx)
+	case group.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case group.FieldTimezone:
+		return m.OldTimezone(ctx)
+	case group.FieldNotifyTime:
+		return m.OldNotifyTime(ctx)
+	case group.FieldDigest:
+		return m.OldDigest(ctx)
+	case group.FieldNotifiedOn:
+		return m.OldNotifiedOn(ctx)
+	}
+	return nil, fmt.Errorf("unknown Group field %s", name)
 }
 
-// AddMaintenanceEntryIDs adds the "maintenance_entries" edge to the MaintenanceEntry entity by ids.
-func (m *ItemMutation) AddMaintenanceEntryIDs(ids ...uuid.UUID) {
-	if m.maintenance_entries == nil {
-		m.maintenance_entries = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.maintenance_entries[ids[i]] = struct{}{}
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case group.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case group.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case group.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case group.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case group.FieldTimezone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimezone(v)
+		return nil
+	case group.FieldNotifyTime:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotifyTime(v)
+		return nil
+	case group.FieldDigest:
+		v, ok := value.(group.Digest)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDigest(v)
+		return nil
+	case group.FieldNotifiedOn:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotifiedOn(v)
+		return nil
 	}
+	return fmt.Errorf("unknown Group field %s", name)
 }
 
-// ClearMaintenanceEntries clears the "maintenance_entries" edge to the MaintenanceEntry entity.
-func (m *ItemMutation) ClearMaintenanceEntries() {
-	m.clearedmaintenance_entries = true
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupMutation) AddedFields() []string {
+	return nil
 }
 
-// MaintenanceEntriesCleared reports if the "maintenance_entries" edge to the MaintenanceEntry entity was cleared.
-func (m *ItemMutation) MaintenanceEntriesCleared() bool {
-	return m.clearedmaintenance_entries
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// RemoveMaintenanceEntryIDs removes the "maintenance_entries" edge to the MaintenanceEntry entity by IDs.
-func (m *ItemMutation) RemoveMaintenanceEntryIDs(ids ...uuid.UUID) {
-	if m.removedmaintenance_entries == nil {
-		m.removedmaintenance_entries = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.maintenance_entries, ids[i])
-		m.removedmaintenance_entries[ids[i]] = struct{}{}
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
+	return fmt.Errorf("unknown Group numeric field %s", name)
 }
 
-// RemovedMaintenanceEntries returns the removed IDs of the "maintenance_entries" edge to the MaintenanceEntry entity.
-func (m *ItemMutation) RemovedMaintenanceEntriesIDs() (ids []uuid.UUID) {
-	for id := range m.removedmaintenance_entries {
-		ids = append(ids, id)
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(group.FieldTimezone) {
+		fields = append(fields, group.FieldTimezone)
 	}
-	return
+	if m.FieldCleared(group.FieldNotifiedOn) {
+		fields = append(fields, group.FieldNotifiedOn)
+	}
+	return fields
 }
 
-// MaintenanceEntriesIDs returns the "maintenance_entries" edge IDs in the mutation.
-func (m *ItemMutation) MaintenanceEntriesIDs() (ids []uuid.UUID) {
-	for id := range m.maintenance_entries {
-		ids = append(ids, id)
-	}
-	return
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ResetMaintenanceEntries resets all changes to the "maintenance_entries" edge.
-func (m *ItemMutation) ResetMaintenanceEntries() {
-	m.maintenance_entries = nil
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupMutation) ClearField(name string) error {
+	switch name {
+	case group.FieldTimezone:
+		m.ClearTimezone()
+		return nil
+	case group.FieldNotifiedOn:
+		m.ClearNotifiedOn()
+		return nil
+	}
+	return fmt.Errorf("unknown Group nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupMutation) ResetField(name string) error {
+	switch name {
+	case group.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case group.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case group.FieldName:
+		m.ResetName()
+		return nil
+	case group.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case group.FieldTimezone:
+		m.ResetTimezone()
+		return nil
+	case group.FieldNotifyTime:
+		m.ResetNotifyTime()
+		return nil
+	case group.FieldDigest:
+		m.ResetDigest()
+		return nil
+	case group.FieldNotifiedOn:
+		m.ResetNotifiedOn()
+		return nil
+	}
+	return fmt.Errorf("unknown Group field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 14)
+	if m.users != nil {
+		edges = append(edges, group.EdgeUsers)
+	}
+	if m.memberships != nil {
+		edges = append(edges, group.EdgeMemberships)
+	}
+	if m.auth_tokens != nil {
+		edges = append(edges, group.EdgeAuthTokens)
+	}
+	if m.locations != nil {
+		edges = append(edges, group.EdgeLocations)
+	}
+	if m.items != nil {
+		edges = append(edges, group.EdgeItems)
+	}
+	if m.labels != nil {
+		edges = append(edges, group.EdgeLabels)
+	}
+	if m.documents != nil {
+		edges = append(edges, group.EdgeDocuments)
+	}
+	if m.invitation_tokens != nil {
+		edges = append(edges, group.EdgeInvitationTokens)
+	}
+	if m.notifiers != nil {
+		edges = append(edges, group.EdgeNotifiers)
+	}
+	if m.audit_entries != nil {
+		edges = append(edges, group.EdgeAuditEntries)
+	}
+	if m.saved_searches != nil {
+		edges = append(edges, group.EdgeSavedSearches)
+	}
+	if m.notification_rules != nil {
+		edges = append(edges, group.EdgeNotificationRules)
+	}
+	if m.notification_reminders != nil {
+		edges = append(edges, group.EdgeNotificationReminders)
+	}
+	if m.notification_templates != nil {
+		edges = append(edges, group.EdgeNotificationTemplates)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case group.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.memberships))
+		for id := range m.memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeAuthTokens:
+		ids := make([]ent.Value, 0, len(m.auth_tokens))
+		for id := range m.auth_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeLocations:
+		ids := make([]ent.Value, 0, len(m.locations))
+		for id := range m.locations {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeLabels:
+		ids := make([]ent.Value, 0, len(m.labels))
+		for id := range m.labels {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.documents))
+		for id := range m.documents {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeInvitationTokens:
+		ids := make([]ent.Value, 0, len(m.invitation_tokens))
+		for id := range m.invitation_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeNotifiers:
+		ids := make([]ent.Value, 0, len(m.notifiers))
+		for id := range m.notifiers {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeAuditEntries:
+		ids := make([]ent.Value, 0, len(m.audit_entries))
+		for id := range m.audit_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeSavedSearches:
+		ids := make([]ent.Value, 0, len(m.saved_searches))
+		for id := range m.saved_searches {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeNotificationRules:
+		ids := make([]ent.Value, 0, len(m.notification_rules))
+		for id := range m.notification_rules {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeNotificationReminders:
+		ids := make([]ent.Value, 0, len(m.notification_reminders))
+		for id := range m.notification_reminders {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeNotificationTemplates:
+		ids := make([]ent.Value, 0, len(m.notification_templates))
+		for id := range m.notification_templates {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 14)
+	if m.removedusers != nil {
+		edges = append(edges, group.EdgeUsers)
+	}
+	if m.removedmemberships != nil {
+		edges = append(edges, group.EdgeMemberships)
+	}
+	if m.removedauth_tokens != nil {
+		edges = append(edges, group.EdgeAuthTokens)
+	}
+	if m.removedlocations != nil {
+		edges = append(edges, group.EdgeLocations)
+	}
+	if m.removeditems != nil {
+		edges = append(edges, group.EdgeItems)
+	}
+	if m.removedlabels != nil {
+		edges = append(edges, group.EdgeLabels)
+	}
+	if m.removeddocuments != nil {
+		edges = append(edges, group.EdgeDocuments)
+	}
+	if m.removedinvitation_tokens != nil {
+		edges = append(edges, group.EdgeInvitationTokens)
+	}
+	if m.removednotifiers != nil {
+		edges = append(edges, group.EdgeNotifiers)
+	}
+	if m.removedaudit_entries != nil {
+		edges = append(edges, group.EdgeAuditEntries)
+	}
+	if m.removedsaved_searches != nil {
+		edges = append(edges, group.EdgeSavedSearches)
+	}
+	if m.removednotification_rules != nil {
+		edges = append(edges, group.EdgeNotificationRules)
+	}
+	if m.removednotification_reminders != nil {
+		edges = append(edges, group.EdgeNotificationReminders)
+	}
+	if m.removednotification_templates != nil {
+		edges = append(edges, group.EdgeNotificationTemplates)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case group.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.removedmemberships))
+		for id := range m.removedmemberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeAuthTokens:
+		ids := make([]ent.Value, 0, len(m.removedauth_tokens))
+		for id := range m.removedauth_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeLocations:
+		ids := make([]ent.Value, 0, len(m.removedlocations))
+		for id := range m.removedlocations {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeLabels:
+		ids := make([]ent.Value, 0, len(m.removedlabels))
+		for id := range m.removedlabels {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.removeddocuments))
+		for id := range m.removeddocuments {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeInvitationTokens:
+		ids := make([]ent.Value, 0, len(m.removedinvitation_tokens))
+		for id := range m.removedinvitation_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeNotifiers:
+		ids := make([]ent.Value, 0, len(m.removednotifiers))
+		for id := range m.removednotifiers {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeAuditEntries:
+		ids := make([]ent.Value, 0, len(m.removedaudit_entries))
+		for id := range m.removedaudit_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeSavedSearches:
+		ids := make([]ent.Value, 0, len(m.removedsaved_searches))
+		for id := range m.removedsaved_searches {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeNotificationRules:
+		ids := make([]ent.Value, 0, len(m.removednotification_rules))
+		for id := range m.removednotification_rules {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeNotificationReminders:
+		ids := make([]ent.Value, 0, len(m.removednotification_reminders))
+		for id := range m.removednotification_reminders {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeNotificationTemplates:
+		ids := make([]ent.Value, 0, len(m.removednotification_templates))
+		for id := range m.removednotification_templates {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 14)
+	if m.clearedusers {
+		edges = append(edges, group.EdgeUsers)
+	}
+	if m.clearedmemberships {
+		edges = append(edges, group.EdgeMemberships)
+	}
+	if m.clearedauth_tokens {
+		edges = append(edges, group.EdgeAuthTokens)
+	}
+	if m.clearedlocations {
+		edges = append(edges, group.EdgeLocations)
+	}
+	if m.cleareditems {
+		edges = append(edges, group.EdgeItems)
+	}
+	if m.clearedlabels {
+		edges = append(edges, group.EdgeLabels)
+	}
+	if m.cleareddocuments {
+		edges = append(edges, group.EdgeDocuments)
+	}
+	if m.clearedinvitation_tokens {
+		edges = append(edges, group.EdgeInvitationTokens)
+	}
+	if m.clearednotifiers {
+		edges = append(edges, group.EdgeNotifiers)
+	}
+	if m.clearedaudit_entries {
+		edges = append(edges, group.EdgeAuditEntries)
+	}
+	if m.clearedsaved_searches {
+		edges = append(edges, group.EdgeSavedSearches)
+	}
+	if m.clearednotification_rules {
+		edges = append(edges, group.EdgeNotificationRules)
+	}
+	if m.clearednotification_reminders {
+		edges = append(edges, group.EdgeNotificationReminders)
+	}
+	if m.clearednotification_templates {
+		edges = append(edges, group.EdgeNotificationTemplates)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupMutation) EdgeCleared(name string) bool {
+	switch name {
+	case group.EdgeUsers:
+		return m.clearedusers
+	case group.EdgeMemberships:
+		return m.clearedmemberships
+	case group.EdgeAuthTokens:
+		return m.clearedauth_tokens
+	case group.EdgeLocations:
+		return m.clearedlocations
+	case group.EdgeItems:
+		return m.cleareditems
+	case group.EdgeLabels:
+		return m.clearedlabels
+	case group.EdgeDocuments:
+		return m.cleareddocuments
+	case group.EdgeInvitationTokens:
+		return m.clearedinvitation_tokens
+	case group.EdgeNotifiers:
+		return m.clearednotifiers
+	case group.EdgeAuditEntries:
+		return m.clearedaudit_entries
+	case group.EdgeSavedSearches:
+		return m.clearedsaved_searches
+	case group.EdgeNotificationRules:
+		return m.clearednotification_rules
+	case group.EdgeNotificationReminders:
+		return m.clearednotification_reminders
+	case group.EdgeNotificationTemplates:
+		return m.clearednotification_templates
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Group unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupMutation) ResetEdge(name string) error {
+	switch name {
+	case group.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case group.EdgeMemberships:
+		m.ResetMemberships()
+		return nil
+	case group.EdgeAuthTokens:
+		m.ResetAuthTokens()
+		return nil
+	case group.EdgeLocations:
+		m.ResetLocations()
+		return nil
+	case group.EdgeItems:
+		m.ResetItems()
+		return nil
+	case group.EdgeLabels:
+		m.ResetLabels()
+		return nil
+	case group.EdgeDocuments:
+		m.ResetDocuments()
+		return nil
+	case group.EdgeInvitationTokens:
+		m.ResetInvitationTokens()
+		return nil
+	case group.EdgeNotifiers:
+		m.ResetNotifiers()
+		return nil
+	case group.EdgeAuditEntries:
+		m.ResetAuditEntries()
+		return nil
+	case group.EdgeSavedSearches:
+		m.ResetSavedSearches()
+		return nil
+	case group.EdgeNotificationRules:
+		m.ResetNotificationRules()
+		return nil
+	case group.EdgeNotificationReminders:
+		m.ResetNotificationReminders()
+		return nil
+	case group.EdgeNotificationTemplates:
+		m.ResetNotificationTemplates()
+		return nil
+	}
+	return fmt.Errorf("unknown Group edge %s", name)
+}
+
+// GroupInvitationTokenMutation represents an operation that mutates the GroupInvitationToken nodes in the graph.
+type GroupInvitationTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	token         *[]byte
+	expires_at    *time.Time
+	uses          *int
+	adduses       *int
+	clearedFields map[string]struct{}
+	group         *uuid.UUID
+	clearedgroup  bool
+	done          bool
+	oldValue      func(context.Context) (*GroupInvitationToken, error)
+	predicates    []predicate.GroupInvitationToken
+}
+
+var _ ent.Mutation = (*GroupInvitationTokenMutation)(nil)
+
+// groupinvitationtokenOption allows management of the mutation configuration using functional options.
+type groupinvitationtokenOption func(*GroupInvitationTokenMutation)
+
+// newGroupInvitationTokenMutation creates new mutation for the GroupInvitationToken entity.
+func newGroupInvitationTokenMutation(c config, op Op, opts ...groupinvitationtokenOption) *GroupInvitationTokenMutation {
+	m := &GroupInvitationTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroupInvitationToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGroupInvitationTokenID sets the ID field of the mutation.
+func withGroupInvitationTokenID(id uuid.UUID) groupinvitationtokenOption {
+	return func(m *GroupInvitationTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GroupInvitationToken
+		)
+		m.oldValue = func(ctx context.Context) (*GroupInvitationToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GroupInvitationToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGroupInvitationToken sets the old GroupInvitationToken of the mutation.
+func withGroupInvitationToken(node *GroupInvitationToken) groupinvitationtokenOption {
+	return func(m *GroupInvitationTokenMutation) {
+		m.oldValue = func(context.Context) (*GroupInvitationToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupInvitationTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupInvitationTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GroupInvitationToken entities.
+func (m *GroupInvitationTokenMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupInvitationTokenMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupInvitationTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GroupInvitationToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GroupInvitationTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GroupInvitationTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GroupInvitationToken entity.
+// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupInvitationTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GroupInvitationTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GroupInvitationTokenMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GroupInvitationTokenMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the GroupInvitationToken entity.
+// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupInvitationTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GroupInvitationTokenMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetToken sets the "token" field.
+func (m *GroupInvitationTokenMutation) SetToken(b []byte) {
+	m.token = &b
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *GroupInvitationTokenMutation) Token() (r []byte, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the GroupInvitationToken entity.
+// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupInvitationTokenMutation) OldToken(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *GroupInvitationTokenMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *GroupInvitationTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *GroupInvitationTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the GroupInvitationToken entity.
+// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupInvitationTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *GroupInvitationTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetUses sets the "uses" field.
+func (m *GroupInvitationTokenMutation) SetUses(i int) {
+	m.uses = &i
+	m.adduses = nil
+}
+
+// Uses returns the value of the "uses" field in the mutation.
+func (m *GroupInvitationTokenMutation) Uses() (r int, exists bool) {
+	v := m.uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUses returns the old "uses" field's value of the GroupInvitationToken entity.
+// If the GroupInvitationToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupInvitationTokenMutation) OldUses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUses: %w", err)
+	}
+	return oldValue.Uses, nil
+}
+
+// AddUses adds i to the "uses" field.
+func (m *GroupInvitationTokenMutation) AddUses(i int) {
+	if m.adduses != nil {
+		*m.adduses += i
+	} else {
+		m.adduses = &i
+	}
+}
+
+// AddedUses returns the value that was added to the "uses" field in this mutation.
+func (m *GroupInvitationTokenMutation) AddedUses() (r int, exists bool) {
+	v := m.adduses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUses resets all changes to the "uses" field.
+func (m *GroupInvitationTokenMutation) ResetUses() {
+	m.uses = nil
+	m.adduses = nil
+}
+
+// SetGroupID sets the "group" edge to the Group entity by id.
+func (m *GroupInvitationTokenMutation) SetGroupID(id uuid.UUID) {
+	m.group = &id
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *GroupInvitationTokenMutation) ClearGroup() {
+	m.clearedgroup = true
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *GroupInvitationTokenMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupID returns the "group" edge ID in the mutation.
+func (m *GroupInvitationTokenMutation) GroupID() (id uuid.UUID, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *GroupInvitationTokenMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *GroupInvitationTokenMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// Where appends a list predicates to the GroupInvitationTokenMutation builder.
+func (m *GroupInvitationTokenMutation) Where(ps ...predicate.GroupInvitationToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupInvitationTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupInvitationTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GroupInvitationToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupInvitationTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupInvitationTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GroupInvitationToken).
+func (m *GroupInvitationTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupInvitationTokenMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, groupinvitationtoken.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, groupinvitationtoken.FieldUpdatedAt)
+	}
+	if m.token != nil {
+		fields = append(fields, groupinvitationtoken.FieldToken)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, groupinvitationtoken.FieldExpiresAt)
+	}
+	if m.uses != nil {
+		fields = append(fields, groupinvitationtoken.FieldUses)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupInvitationTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case groupinvitationtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	case groupinvitationtoken.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case groupinvitationtoken.FieldToken:
+		return m.Token()
+	case groupinvitationtoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case groupinvitationtoken.FieldUses:
+		return m.Uses()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupInvitationTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case groupinvitationtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case groupinvitationtoken.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case groupinvitationtoken.FieldToken:
+		return m.OldToken(ctx)
+	case groupinvitationtoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case groupinvitationtoken.FieldUses:
+		return m.OldUses(ctx)
+	}
+	return nil, fmt.Errorf("unknown GroupInvitationToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupInvitationTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case groupinvitationtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case groupinvitationtoken.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case groupinvitationtoken.FieldToken:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case groupinvitationtoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case groupinvitationtoken.FieldUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUses(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GroupInvitationToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupInvitationTokenMutation) AddedFields() []string {
+	var fields []string
+	if m.adduses != nil {
+		fields = append(fields, groupinvitationtoken.FieldUses)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupInvitationTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case groupinvitationtoken.FieldUses:
+		return m.AddedUses()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupInvitationTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case groupinvitationtoken.FieldUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUses(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GroupInvitationToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupInvitationTokenMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupInvitationTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupInvitationTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown GroupInvitationToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupInvitationTokenMutation) ResetField(name string) error {
+	switch name {
+	case groupinvitationtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case groupinvitationtoken.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case groupinvitationtoken.FieldToken:
+		m.ResetToken()
+		return nil
+	case groupinvitationtoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case groupinvitationtoken.FieldUses:
+		m.ResetUses()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupInvitationToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupInvitationTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.group != nil {
+		edges = append(edges, groupinvitationtoken.EdgeGroup)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupInvitationTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case groupinvitationtoken.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupInvitationTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupInvitationTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupInvitationTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgroup {
+		edges = append(edges, groupinvitationtoken.EdgeGroup)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupInvitationTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case groupinvitationtoken.EdgeGroup:
+		return m.clearedgroup
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupInvitationTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case groupinvitationtoken.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupInvitationToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupInvitationTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case groupinvitationtoken.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupInvitationToken edge %s", name)
+}
+
+// GroupMembershipMutation represents an operation that mutates the GroupMembership nodes in the graph.
+type GroupMembershipMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	role          *groupmembership.Role
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	group         *uuid.UUID
+	clearedgroup  bool
+	done          bool
+	oldValue      func(context.Context) (*GroupMembership, error)
+	predicates    []predicate.GroupMembership
+}
+
+var _ ent.Mutation = (*GroupMembershipMutation)(nil)
+
+// groupmembershipOption allows management of the mutation configuration using functional options.
+type groupmembershipOption func(*GroupMembershipMutation)
+
+// newGroupMembershipMutation creates new mutation for the GroupMembership entity.
+func newGroupMembershipMutation(c config, op Op, opts ...groupmembershipOption) *GroupMembershipMutation {
+	m := &GroupMembershipMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroupMembership,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGroupMembershipID sets the ID field of the mutation.
+func withGroupMembershipID(id uuid.UUID) groupmembershipOption {
+	return func(m *GroupMembershipMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GroupMembership
+		)
+		m.oldValue = func(ctx context.Context) (*GroupMembership, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GroupMembership.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGroupMembership sets the old GroupMembership of the mutation.
+func withGroupMembership(node *GroupMembership) groupmembershipOption {
+	return func(m *GroupMembershipMutation) {
+		m.oldValue = func(context.Context) (*GroupMembership, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupMembershipMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupMembershipMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GroupMembership entities.
+func (m *GroupMembershipMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupMembershipMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupMembershipMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GroupMembership.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GroupMembershipMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GroupMembershipMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GroupMembership entity.
+// If the GroupMembership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMembershipMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GroupMembershipMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GroupMembershipMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GroupMembershipMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the GroupMembership entity.
+// If the GroupMembership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMembershipMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GroupMembershipMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *GroupMembershipMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *GroupMembershipMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the GroupMembership entity.
+// If the GroupMembership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMembershipMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *GroupMembershipMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetGroupID sets the "group_id" field.
+func (m *GroupMembershipMutation) SetGroupID(u uuid.UUID) {
+	m.group = &u
+}
+
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *GroupMembershipMutation) GroupID() (r uuid.UUID, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupID returns the old "group_id" field's value of the GroupMembership entity.
+// If the GroupMembership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMembershipMutation) OldGroupID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+	}
+	return oldValue.GroupID, nil
+}
+
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *GroupMembershipMutation) ResetGroupID() {
+	m.group = nil
+}
+
+// SetRole sets the "role" field.
+func (m *GroupMembershipMutation) SetRole(gr groupmembership.Role) {
+	m.role = &gr
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *GroupMembershipMutation) Role() (r groupmembership.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the GroupMembership entity.
+// If the GroupMembership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMembershipMutation) OldRole(ctx context.Context) (v groupmembership.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *GroupMembershipMutation) ResetRole() {
+	m.role = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *GroupMembershipMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[groupmembership.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *GroupMembershipMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *GroupMembershipMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *GroupMembershipMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *GroupMembershipMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[groupmembership.FieldGroupID] = struct{}{}
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *GroupMembershipMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *GroupMembershipMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *GroupMembershipMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// Where appends a list predicates to the GroupMembershipMutation builder.
+func (m *GroupMembershipMutation) Where(ps ...predicate.GroupMembership) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupMembershipMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMembershipMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GroupMembership, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupMembershipMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupMembershipMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GroupMembership).
+func (m *GroupMembershipMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupMembershipMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, groupmembership.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, groupmembership.FieldUpdatedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, groupmembership.FieldUserID)
+	}
+	if m.group != nil {
+		fields = append(fields, groupmembership.FieldGroupID)
+	}
+	if m.role != nil {
+		fields = append(fields, groupmembership.FieldRole)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupMembershipMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case groupmembership.FieldCreatedAt:
+		return m.CreatedAt()
+	case groupmembership.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case groupmembership.FieldUserID:
+		return m.UserID()
+	case groupmembership.FieldGroupID:
+		return m.GroupID()
+	case groupmembership.FieldRole:
+		return m.Role()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupMembershipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case groupmembership.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case groupmembership.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case groupmembership.FieldUserID:
+		return m.OldUserID(ctx)
+	case groupmembership.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case groupmembership.FieldRole:
+		return m.OldRole(ctx)
+	}
+	return nil, fmt.Errorf("unknown GroupMembership field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMembershipMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case groupmembership.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case groupmembership.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case groupmembership.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case groupmembership.FieldGroupID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupID(v)
+		return nil
+	case groupmembership.FieldRole:
+		v, ok := value.(groupmembership.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GroupMembership field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupMembershipMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupMembershipMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMembershipMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GroupMembership numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupMembershipMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupMembershipMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupMembershipMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown GroupMembership nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupMembershipMutation) ResetField(name string) error {
+	switch name {
+	case groupmembership.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case groupmembership.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case groupmembership.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case groupmembership.FieldGroupID:
+		m.ResetGroupID()
+		return nil
+	case groupmembership.FieldRole:
+		m.ResetRole()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupMembership field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupMembershipMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, groupmembership.EdgeUser)
+	}
+	if m.group != nil {
+		edges = append(edges, groupmembership.EdgeGroup)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupMembershipMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case groupmembership.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case groupmembership.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupMembershipMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupMembershipMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupMembershipMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, groupmembership.EdgeUser)
+	}
+	if m.clearedgroup {
+		edges = append(edges, groupmembership.EdgeGroup)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupMembershipMutation) EdgeCleared(name string) bool {
+	switch name {
+	case groupmembership.EdgeUser:
+		return m.cleareduser
+	case groupmembership.EdgeGroup:
+		return m.clearedgroup
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupMembershipMutation) ClearEdge(name string) error {
+	switch name {
+	case groupmembership.EdgeUser:
+		m.ClearUser()
+		return nil
+	case groupmembership.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupMembership unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupMembershipMutation) ResetEdge(name string) error {
+	switch name {
+	case groupmembership.EdgeUser:
+		m.ResetUser()
+		return nil
+	case groupmembership.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupMembership edge %s", name)
+}
+
+// ItemMutation represents an operation that mutates the Item nodes in the graph.
+type ItemMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *uuid.UUID
+	created_at                   *time.Time
+	updated_at                   *time.Time
+	name                         *string
+	description                  *string
+	deleted_at                   *time.Time
+	import_ref                   *string
+	notes                        *string
+	quantity                     *int
+	addquantity                  *int
+	insured                      *bool
+	archived                     *bool
+	asset_id                     *int
+	addasset_id                  *int
+	serial_number                *string
+	model_number                 *string
+	manufacturer                 *string
+	lifetime_warranty            *bool
+	warranty_expires             *time.Time
+	warranty_details             *string
+	purchase_time                *time.Time
+	purchase_from                *string
+	purchase_price               *float64
+	addpurchase_price            *float64
+	sold_time                    *time.Time
+	sold_to                      *string
+	sold_price                   *float64
+	addsold_price                *float64
+	sold_notes                   *string
+	clearedFields                map[string]struct{}
+	group                        *uuid.UUID
+	clearedgroup                 bool
+	parent                       *uuid.UUID
+	clearedparent                bool
+	children                     map[uuid.UUID]struct{}
+	removedchildren              map[uuid.UUID]struct{}
+	clearedchildren              bool
+	label                        map[uuid.UUID]struct{}
+	removedlabel                 map[uuid.UUID]struct{}
+	clearedlabel                 bool
+	location                     *uuid.UUID
+	clearedlocation              bool
+	fields                       map[uuid.UUID]struct{}
+	removedfields                map[uuid.UUID]struct{}
+	clearedfields                bool
+	maintenance_entries          map[uuid.UUID]struct{}
+	removedmaintenance_entries   map[uuid.UUID]struct{}
+	clearedmaintenance_entries   bool
+	maintenance_schedules        map[uuid.UUID]struct{}
+	removedmaintenance_schedules map[uuid.UUID]struct{}
+	clearedmaintenance_schedules bool
+	attachments                  map[uuid.UUID]struct{}
+	removedattachments           map[uuid.UUID]struct{}
+	clearedattachments           bool
+	done                         bool
+	oldValue                     func(context.Context) (*Item, error)
+	predicates                   []predicate.Item
+}
+
+var _ ent.Mutation = (*ItemMutation)(nil)
+
+// itemOption allows management of the mutation configuration using functional options.
+type itemOption func(*ItemMutation)
+
+// newItemMutation creates new mutation for the Item entity.
+func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
+	m := &ItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withItemID sets the ID field of the mutation.
+func withItemID(id uuid.UUID) itemOption {
+	return func(m *ItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Item
+		)
+		m.oldValue = func(ctx context.Context) (*Item, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Item.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withItem sets the old Item of the mutation.
+func withItem(node *Item) itemOption {
+	return func(m *ItemMutation) {
+		m.oldValue = func(context.Context) (*Item, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Item entities.
+func (m *ItemMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItemMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItemMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Item.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ItemMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ItemMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ItemMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ItemMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ItemMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ItemMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *ItemMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ItemMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ItemMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ItemMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ItemMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ItemMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[item.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ItemMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[item.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ItemMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, item.FieldDescription)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ItemMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ItemMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ItemMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[item.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ItemMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[item.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ItemMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, item.FieldDeletedAt)
+}
+
+// SetImportRef sets the "import_ref" field.
+func (m *ItemMutation) SetImportRef(s string) {
+	m.import_ref = &s
+}
+
+// ImportRef returns the value of the "import_ref" field in the mutation.
+func (m *ItemMutation) ImportRef() (r string, exists bool) {
+	v := m.import_ref
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImportRef returns the old "import_ref" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldImportRef(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImportRef is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImportRef requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImportRef: %w", err)
+	}
+	return oldValue.ImportRef, nil
+}
+
+// ClearImportRef clears the value of the "import_ref" field.
+func (m *ItemMutation) ClearImportRef() {
+	m.import_ref = nil
+	m.clearedFields[item.FieldImportRef] = struct{}{}
+}
+
+// ImportRefCleared returns if the "import_ref" field was cleared in this mutation.
+func (m *ItemMutation) ImportRefCleared() bool {
+	_, ok := m.clearedFields[item.FieldImportRef]
+	return ok
+}
+
+// ResetImportRef resets all changes to the "import_ref" field.
+func (m *ItemMutation) ResetImportRef() {
+	m.import_ref = nil
+	delete(m.clearedFields, item.FieldImportRef)
+}
+
+// SetNotes sets the "notes" field.
+func (m *ItemMutation) SetNotes(s string) {
+	m.notes = &s
+}
+
+// Notes returns the value of the "notes" field in the mutation.
+func (m *ItemMutation) Notes() (r string, exists bool) {
+	v := m.notes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotes returns the old "notes" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldNotes(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+	}
+	return oldValue.Notes, nil
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (m *ItemMutation) ClearNotes() {
+	m.notes = nil
+	m.clearedFields[item.FieldNotes] = struct{}{}
+}
+
+// NotesCleared returns if the "notes" field was cleared in this mutation.
+func (m *ItemMutation) NotesCleared() bool {
+	_, ok := m.clearedFields[item.FieldNotes]
+	return ok
+}
+
+// ResetNotes resets all changes to the "notes" field.
+func (m *ItemMutation) ResetNotes() {
+	m.notes = nil
+	delete(m.clearedFields, item.FieldNotes)
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *ItemMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *ItemMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldQuantity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (m *ItemMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *ItemMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *ItemMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetInsured sets the "insured" field.
+func (m *ItemMutation) SetInsured(b bool) {
+	m.insured = &b
+}
+
+// Insured returns the value of the "insured" field in the mutation.
+func (m *ItemMutation) Insured() (r bool, exists bool) {
+	v := m.insured
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInsured returns the old "insured" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldInsured(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInsured is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInsured requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInsured: %w", err)
+	}
+	return oldValue.Insured, nil
+}
+
+// ResetInsured resets all changes to the "insured" field.
+func (m *ItemMutation) ResetInsured() {
+	m.insured = nil
+}
+
+// SetArchived sets the "archived" field.
+func (m *ItemMutation) SetArchived(b bool) {
+	m.archived = &b
+}
+
+// Archived returns the value of the "archived" field in the mutation.
+func (m *ItemMutation) Archived() (r bool, exists bool) {
+	v := m.archived
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchived returns the old "archived" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldArchived(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchived is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchived requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchived: %w", err)
+	}
+	return oldValue.Archived, nil
+}
+
+// ResetArchived resets all changes to the "archived" field.
+func (m *ItemMutation) ResetArchived() {
+	m.archived = nil
+}
+
+// SetAssetID sets the "asset_id" field.
+func (m *ItemMutation) SetAssetID(i int) {
+	m.asset_id = &i
+	m.addasset_id = nil
+}
+
+// AssetID returns the value of the "asset_id" field in the mutation.
+func (m *ItemMutation) AssetID() (r int, exists bool) {
+	v := m.asset_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssetID returns the old "asset_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldAssetID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssetID: %w", err)
+	}
+	return oldValue.AssetID, nil
+}
+
+// AddAssetID adds i to the "asset_id" field.
+func (m *ItemMutation) AddAssetID(i int) {
+	if m.addasset_id != nil {
+		*m.addasset_id += i
+	} else {
+		m.addasset_id = &i
+	}
+}
+
+// AddedAssetID returns the value that was added to the "asset_id" field in this mutation.
+func (m *ItemMutation) AddedAssetID() (r int, exists bool) {
+	v := m.addasset_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAssetID resets all changes to the "asset_id" field.
+func (m *ItemMutation) ResetAssetID() {
+	m.asset_id = nil
+	m.addasset_id = nil
+}
+
+// SetSerialNumber sets the "serial_number" field.
+func (m *ItemMutation) SetSerialNumber(s string) {
+	m.serial_number = &s
+}
+
+// SerialNumber returns the value of the "serial_number" field in the mutation.
+func (m *ItemMutation) SerialNumber() (r string, exists bool) {
+	v := m.serial_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSerialNumber returns the old "serial_number" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldSerialNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSerialNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSerialNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSerialNumber: %w", err)
+	}
+	return oldValue.SerialNumber, nil
+}
+
+// ClearSerialNumber clears the value of the "serial_number" field.
+func (m *ItemMutation) ClearSerialNumber() {
+	m.serial_number = nil
+	m.clearedFields[item.FieldSerialNumber] = struct{}{}
+}
+
+// SerialNumberCleared returns if the "serial_number" field was cleared in this mutation.
+func (m *ItemMutation) SerialNumberCleared() bool {
+	_, ok := m.clearedFields[item.FieldSerialNumber]
+	return ok
+}
+
+// ResetSerialNumber resets all changes to the "serial_number" field.
+func (m *ItemMutation) ResetSerialNumber() {
+	m.serial_number = nil
+	delete(m.clearedFields, item.FieldSerialNumber)
+}
+
+// SetModelNumber sets the "model_number" field.
+func (m *ItemMutation) SetModelNumber(s string) {
+	m.model_number = &s
+}
+
+// ModelNumber returns the value of the "model_number" field in the mutation.
+func (m *ItemMutation) ModelNumber() (r string, exists bool) {
+	v := m.model_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelNumber returns the old "model_number" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldModelNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelNumber: %w", err)
+	}
+	return oldValue.ModelNumber, nil
+}
+
+// ClearModelNumber clears the value of the "model_number" field.
+func (m *ItemMutation) ClearModelNumber() {
+	m.model_number = nil
+	m.clearedFields[item.FieldModelNumber] = struct{}{}
+}
+
+// ModelNumberCleared returns if the "model_number" field was cleared in this mutation.
+func (m *ItemMutation) ModelNumberCleared() bool {
+	_, ok := m.clearedFields[item.FieldModelNumber]
+	return ok
+}
+
+// ResetModelNumber resets all changes to the "model_number" field.
+func (m *ItemMutation) ResetModelNumber() {
+	m.model_number = nil
+	delete(m.clearedFields, item.FieldModelNumber)
+}
+
+// SetManufacturer sets the "manufacturer" field.
+func (m *ItemMutation) SetManufacturer(s string) {
+	m.manufacturer = &s
+}
+
+// Manufacturer returns the value of the "manufacturer" field in the mutation.
+func (m *ItemMutation) Manufacturer() (r string, exists bool) {
+	v := m.manufacturer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldManufacturer returns the old "manufacturer" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldManufacturer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldManufacturer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldManufacturer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldManufacturer: %w", err)
+	}
+	return oldValue.Manufacturer, nil
+}
+
+// ClearManufacturer clears the value of the "manufacturer" field.
+func (m *ItemMutation) ClearManufacturer() {
+	m.manufacturer = nil
+	m.clearedFields[item.FieldManufacturer] = struct{}{}
+}
+
+// ManufacturerCleared returns if the "manufacturer" field was cleared in this mutation.
+func (m *ItemMutation) ManufacturerCleared() bool {
+	_, ok := m.clearedFields[item.FieldManufacturer]
+	return ok
+}
+
+// ResetManufacturer resets all changes to the "manufacturer" field.
+func (m *ItemMutation) ResetManufacturer() {
+	m.manufacturer = nil
+	delete(m.clearedFields, item.FieldManufacturer)
+}
+
+// SetLifetimeWarranty sets the "lifetime_warranty" field.
+func (m *ItemMutation) SetLifetimeWarranty(b bool) {
+	m.lifetime_warranty = &b
+}
+
+// LifetimeWarranty returns the value of the "lifetime_warranty" field in the mutation.
+func (m *ItemMutation) LifetimeWarranty() (r bool, exists bool) {
+	v := m.lifetime_warranty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLifetimeWarranty returns the old "lifetime_warranty" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldLifetimeWarranty(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLifetimeWarranty is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLifetimeWarranty requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLifetimeWarranty: %w", err)
+	}
+	return oldValue.LifetimeWarranty, nil
+}
+
+// ResetLifetimeWarranty resets all changes to the "lifetime_warranty" field.
+func (m *ItemMutation) ResetLifetimeWarranty() {
+	m.lifetime_warranty = nil
+}
+
+// SetWarrantyExpires sets the "warranty_expires" field.
+func (m *ItemMutation) SetWarrantyExpires(t time.Time) {
+	m.warranty_expires = &t
+}
+
+// WarrantyExpires returns the value of the "warranty_expires" field in the mutation.
+func (m *ItemMutation) WarrantyExpires() (r time.Time, exists bool) {
+	v := m.warranty_expires
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWarrantyExpires returns the old "warranty_expires" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldWarrantyExpires(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWarrantyExpires is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWarrantyExpires requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWarrantyExpires: %w", err)
+	}
+	return oldValue.WarrantyExpires, nil
+}
+
+// ClearWarrantyExpires clears the value of the "warranty_expires" field.
+func (m *ItemMutation) ClearWarrantyExpires() {
+	m.warranty_expires = nil
+	m.clearedFields[item.FieldWarrantyExpires] = struct{}{}
+}
+
+// WarrantyExpiresCleared returns if the "warranty_expires" field was cleared in this mutation.
+func (m *ItemMutation) WarrantyExpiresCleared() bool {
+	_, ok := m.clearedFields[item.FieldWarrantyExpires]
+	return ok
+}
+
+// ResetWarrantyExpires resets all changes to the "warranty_expires" field.
+func (m *ItemMutation) ResetWarrantyExpires() {
+	m.warranty_expires = nil
+	delete(m.clearedFields, item.FieldWarrantyExpires)
+}
+
+// SetWarrantyDetails sets the "warranty_details" field.
+func (m *ItemMutation) SetWarrantyDetails(s string) {
+	m.warranty_details = &s
+}
+
+// WarrantyDetails returns the value of the "warranty_details" field in the mutation.
+func (m *ItemMutation) WarrantyDetails() (r string, exists bool) {
+	v := m.warranty_details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWarrantyDetails returns the old "warranty_details" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldWarrantyDetails(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWarrantyDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWarrantyDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWarrantyDetails: %w", err)
+	}
+	return oldValue.WarrantyDetails, nil
+}
+
+// ClearWarrantyDetails clears the value of the "warranty_details" field.
+func (m *ItemMutation) ClearWarrantyDetails() {
+	m.warranty_details = nil
+	m.clearedFields[item.FieldWarrantyDetails] = struct{}{}
+}
+
+// WarrantyDetailsCleared returns if the "warranty_details" field was cleared in this mutation.
+func (m *ItemMutation) WarrantyDetailsCleared() bool {
+	_, ok := m.clearedFields[item.FieldWarrantyDetails]
+	return ok
+}
+
+// ResetWarrantyDetails resets all changes to the "warranty_details" field.
+func (m *ItemMutation) ResetWarrantyDetails() {
+	m.warranty_details = nil
+	delete(m.clearedFields, item.FieldWarrantyDetails)
+}
+
+// SetPurchaseTime sets the "purchase_time" field.
+func (m *ItemMutation) SetPurchaseTime(t time.Time) {
+	m.purchase_time = &t
+}
+
+// PurchaseTime returns the value of the "purchase_time" field in the mutation.
+func (m *ItemMutation) PurchaseTime() (r time.Time, exists bool) {
+	v := m.purchase_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPurchaseTime returns the old "purchase_time" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldPurchaseTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurchaseTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurchaseTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurchaseTime: %w", err)
+	}
+	return oldValue.PurchaseTime, nil
+}
+
+// ClearPurchaseTime clears the value of the "purchase_time" field.
+func (m *ItemMutation) ClearPurchaseTime() {
+	m.purchase_time = nil
+	m.clearedFields[item.FieldPurchaseTime] = struct{}{}
+}
+
+// PurchaseTimeCleared returns if the "purchase_time" field was cleared in this mutation.
+func (m *ItemMutation) PurchaseTimeCleared() bool {
+	_, ok := m.clearedFields[item.FieldPurchaseTime]
+	return ok
+}
+
+// ResetPurchaseTime resets all changes to the "purchase_time" field.
+func (m *ItemMutation) ResetPurchaseTime() {
+	m.purchase_time = nil
+	delete(m.clearedFields, item.FieldPurchaseTime)
+}
+
+// SetPurchaseFrom sets the "purchase_from" field.
+func (m *ItemMutation) SetPurchaseFrom(s string) {
+	m.purchase_from = &s
+}
+
+// PurchaseFrom returns the value of the "purchase_from" field in the mutation.
+func (m *ItemMutation) PurchaseFrom() (r string, exists bool) {
+	v := m.purchase_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPurchaseFrom returns the old "purchase_from" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldPurchaseFrom(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurchaseFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurchaseFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurchaseFrom: %w", err)
+	}
+	return oldValue.PurchaseFrom, nil
+}
+
+// ClearPurchaseFrom clears the value of the "purchase_from" field.
+func (m *ItemMutation) ClearPurchaseFrom() {
+	m.purchase_from = nil
+	m.clearedFields[item.FieldPurchaseFrom] = struct{}{}
+}
+
+// PurchaseFromCleared returns if the "purchase_from" field was cleared in this mutation.
+func (m *ItemMutation) PurchaseFromCleared() bool {
+	_, ok := m.clearedFields[item.FieldPurchaseFrom]
+	return ok
+}
+
+// ResetPurchaseFrom resets all changes to the "purchase_from" field.
+func (m *ItemMutation) ResetPurchaseFrom() {
+	m.purchase_from = nil
+	delete(m.clearedFields, item.FieldPurchaseFrom)
+}
+
+// SetPurchasePrice sets the "purchase_price" field.
+func (m *ItemMutation) SetPurchasePrice(f float64) {
+	m.purchase_price = &f
+	m.addpurchase_price = nil
+}
+
+// PurchasePrice returns the value of the "purchase_price" field in the mutation.
+func (m *ItemMutation) PurchasePrice() (r float64, exists bool) {
+	v := m.purchase_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPurchasePrice returns the old "purchase_price" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldPurchasePrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurchasePrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurchasePrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurchasePrice: %w", err)
+	}
+	return oldValue.PurchasePrice, nil
+}
+
+// AddPurchasePrice adds f to the "purchase_price" field.
+func (m *ItemMutation) AddPurchasePrice(f float64) {
+	if m.addpurchase_price != nil {
+		*m.addpurchase_price += f
+	} else {
+		m.addpurchase_price = &f
+	}
+}
+
+// AddedPurchasePrice returns the value that was added to the "purchase_price" field in this mutation.
+func (m *ItemMutation) AddedPurchasePrice() (r float64, exists bool) {
+	v := m.addpurchase_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPurchasePrice resets all changes to the "purchase_price" field.
+func (m *ItemMutation) ResetPurchasePrice() {
+	m.purchase_price = nil
+	m.addpurchase_price = nil
+}
+
+// SetSoldTime sets the "sold_time" field.
+func (m *ItemMutation) SetSoldTime(t time.Time) {
+	m.sold_time = &t
+}
+
+// SoldTime returns the value of the "sold_time" field in the mutation.
+func (m *ItemMutation) SoldTime() (r time.Time, exists bool) {
+	v := m.sold_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoldTime returns the old "sold_time" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldSoldTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoldTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoldTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoldTime: %w", err)
+	}
+	return oldValue.SoldTime, nil
+}
+
+// ClearSoldTime clears the value of the "sold_time" field.
+func (m *ItemMutation) ClearSoldTime() {
+	m.sold_time = nil
+	m.clearedFields[item.FieldSoldTime] = struct{}{}
+}
+
+// SoldTimeCleared returns if the "sold_time" field was cleared in this mutation.
+func (m *ItemMutation) SoldTimeCleared() bool {
+	_, ok := m.clearedFields[item.FieldSoldTime]
+	return ok
+}
+
+// ResetSoldTime resets all changes to the "sold_time" field.
+func (m *ItemMutation) ResetSoldTime() {
+	m.sold_time = nil
+	delete(m.clearedFields, item.FieldSoldTime)
+}
+
+// SetSoldTo sets the "sold_to" field.
+func (m *ItemMutation) SetSoldTo(s string) {
+	m.sold_to = &s
+}
+
+// SoldTo returns the value of the "sold_to" field in the mutation.
+func (m *ItemMutation) SoldTo() (r string, exists bool) {
+	v := m.sold_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoldTo returns the old "sold_to" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldSoldTo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoldTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoldTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoldTo: %w", err)
+	}
+	return oldValue.SoldTo, nil
+}
+
+// ClearSoldTo clears the value of the "sold_to" field.
+func (m *ItemMutation) ClearSoldTo() {
+	m.sold_to = nil
+	m.clearedFields[item.FieldSoldTo] = struct{}{}
+}
+
+// SoldToCleared returns if the "sold_to" field was cleared in this mutation.
+func (m *ItemMutation) SoldToCleared() bool {
+	_, ok := m.clearedFields[item.FieldSoldTo]
+	return ok
+}
+
+// ResetSoldTo resets all changes to the "sold_to" field.
+func (m *ItemMutation) ResetSoldTo() {
+	m.sold_to = nil
+	delete(m.clearedFields, item.FieldSoldTo)
+}
+
+// SetSoldPrice sets the "sold_price" field.
+func (m *ItemMutation) SetSoldPrice(f float64) {
+	m.sold_price = &f
+	m.addsold_price = nil
+}
+
+// SoldPrice returns the value of the "sold_price" field in the mutation.
+func (m *ItemMutation) SoldPrice() (r float64, exists bool) {
+	v := m.sold_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoldPrice returns the old "sold_price" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldSoldPrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoldPrice: %w", err)
+	}
+	return oldValue.SoldPrice, nil
+}
+
+// AddSoldPrice adds f to the "sold_price" field.
+func (m *ItemMutation) AddSoldPrice(f float64) {
+	if m.addsold_price != nil {
+		*m.addsold_price += f
+	} else {
+		m.addsold_price = &f
+	}
+}
+
+// AddedSoldPrice returns the value that was added to the "sold_price" field in this mutation.
+func (m *ItemMutation) AddedSoldPrice() (r float64, exists bool) {
+	v := m.addsold_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSoldPrice resets all changes to the "sold_price" field.
+func (m *ItemMutation) ResetSoldPrice() {
+	m.sold_price = nil
+	m.addsold_price = nil
+}
+
+// SetSoldNotes sets the "sold_notes" field.
+func (m *ItemMutation) SetSoldNotes(s string) {
+	m.sold_notes = &s
+}
+
+// SoldNotes returns the value of the "sold_notes" field in the mutation.
+func (m *ItemMutation) SoldNotes() (r string, exists bool) {
+	v := m.sold_notes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoldNotes returns the old "sold_notes" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldSoldNotes(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoldNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoldNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoldNotes: %w", err)
+	}
+	return oldValue.SoldNotes, nil
+}
+
+// ClearSoldNotes clears the value of the "sold_notes" field.
+func (m *ItemMutation) ClearSoldNotes() {
+	m.sold_notes = nil
+	m.clearedFields[item.FieldSoldNotes] = struct{}{}
+}
+
+// SoldNotesCleared returns if the "sold_notes" field was cleared in this mutation.
+func (m *ItemMutation) SoldNotesCleared() bool {
+	_, ok := m.clearedFields[item.FieldSoldNotes]
+	return ok
+}
+
+// ResetSoldNotes resets all changes to the "sold_notes" field.
+func (m *ItemMutation) ResetSoldNotes() {
+	m.sold_notes = nil
+	delete(m.clearedFields, item.FieldSoldNotes)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by id.
+func (m *ItemMutation) SetGroupID(id uuid.UUID) {
+	m.group = &id
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *ItemMutation) ClearGroup() {
+	m.clearedgroup = true
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *ItemMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupID returns the "group" edge ID in the mutation.
+func (m *ItemMutation) GroupID() (id uuid.UUID, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *ItemMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *ItemMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// SetParentID sets the "parent" edge to the Item entity by id.
+func (m *ItemMutation) SetParentID(id uuid.UUID) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Item entity.
+func (m *ItemMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Item entity was cleared.
+func (m *ItemMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *ItemMutation) ParentID() (id uuid.UUID, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *ItemMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *ItemMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Item entity by ids.
+func (m *ItemMutation) AddChildIDs(ids ...uuid.UUID) {
+	if m.children == nil {
+		m.children = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Item entity.
+func (m *ItemMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Item entity was cleared.
+func (m *ItemMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Item entity by IDs.
+func (m *ItemMutation) RemoveChildIDs(ids ...uuid.UUID) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Item entity.
+func (m *ItemMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *ItemMutation) ChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *ItemMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddLabelIDs adds the "label" edge to the Label entity by ids.
+func (m *ItemMutation) AddLabelIDs(ids ...uuid.UUID) {
+	if m.label == nil {
+		m.label = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.label[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLabel clears the "label" edge to the Label entity.
+func (m *ItemMutation) ClearLabel() {
+	m.clearedlabel = true
+}
+
+// LabelCleared reports if the "label" edge to the Label entity was cleared.
+func (m *ItemMutation) LabelCleared() bool {
+	return m.clearedlabel
+}
+
+// RemoveLabelIDs removes the "label" edge to the Label entity by IDs.
+func (m *ItemMutation) RemoveLabelIDs(ids ...uuid.UUID) {
+	if m.removedlabel == nil {
+		m.removedlabel = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.label, ids[i])
+		m.removedlabel[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLabel returns the removed IDs of the "label" edge to the Label entity.
+func (m *ItemMutation) RemovedLabelIDs() (ids []uuid.UUID) {
+	for id := range m.removedlabel {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LabelIDs returns the "label" edge IDs in the mutation.
+func (m *ItemMutation) LabelIDs() (ids []uuid.UUID) {
+	for id := range m.label {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLabel resets all changes to the "label" edge.
+func (m *ItemMutation) ResetLabel() {
+	m.label = nil
+	m.clearedlabel = false
+	m.removedlabel = nil
+}
+
+// SetLocationID sets the "location" edge to the Location entity by id.
+func (m *ItemMutation) SetLocationID(id uuid.UUID) {
+	m.location = &id
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *ItemMutation) ClearLocation() {
+	m.clearedlocation = true
+}
+
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *ItemMutation) LocationCleared() bool {
+	return m.clearedlocation
+}
+
+// LocationID returns the "location" edge ID in the mutation.
+func (m *ItemMutation) LocationID() (id uuid.UUID, exists bool) {
+	if m.location != nil {
+		return *m.location, true
+	}
+	return
+}
+
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *ItemMutation) LocationIDs() (ids []uuid.UUID) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *ItemMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// AddFieldIDs adds the "fields" edge to the ItemField entity by ids.
+func (m *ItemMutation) AddFieldIDs(ids ...uuid.UUID) {
+	if m.fields == nil {
+		m.fields = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.fields[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFields clears the "fields" edge to the ItemField entity.
+func (m *ItemMutation) ClearFields() {
+	m.clearedfields = true
+}
+
+// FieldsCleared reports if the "fields" edge to the ItemField entity was cleared.
+func (m *ItemMutation) FieldsCleared() bool {
+	return m.clearedfields
+}
+
+// RemoveFieldIDs removes the "fields" edge to the ItemField entity by IDs.
+func (m *ItemMutation) RemoveFieldIDs(ids ...uuid.UUID) {
+	if m.removedfields == nil {
+		m.removedfields = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.fields, ids[i])
+		m.removedfields[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFields returns the removed IDs of the "fields" edge to the ItemField entity.
+func (m *ItemMutation) RemovedFieldsIDs() (ids []uuid.UUID) {
+	for id := range m.removedfields {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FieldsIDs returns the "fields" edge IDs in the mutation.
+func (m *ItemMutation) FieldsIDs() (ids []uuid.UUID) {
+	for id := range m.fields {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFields resets all changes to the "fields" edge.
+func (m *ItemMutation) ResetFields() {
+	m.fields = nil
+	m.clearedfields = false
+	m.removedfields = nil
+}
+
+// AddMaintenanceEntryIDs adds the "maintenance_entries" edge to the MaintenanceEntry entity by ids.
+func (m *ItemMutation) AddMaintenanceEntryIDs(ids ...uuid.UUID) {
+	if m.maintenance_entries == nil {
+		m.maintenance_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.maintenance_entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMaintenanceEntries clears the "maintenance_entries" edge to the MaintenanceEntry entity.
+func (m *ItemMutation) ClearMaintenanceEntries() {
+	m.clearedmaintenance_entries = true
+}
+
+// MaintenanceEntriesCleared reports if the "maintenance_entries" edge to the MaintenanceEntry entity was cleared.
+func (m *ItemMutation) MaintenanceEntriesCleared() bool {
+	return m.clearedmaintenance_entries
+}
+
+// RemoveMaintenanceEntryIDs removes the "maintenance_entries" edge to the MaintenanceEntry entity by IDs.
+func (m *ItemMutation) RemoveMaintenanceEntryIDs(ids ...uuid.UUID) {
+	if m.removedmaintenance_entries == nil {
+		m.removedmaintenance_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.maintenance_entries, ids[i])
+		m.removedmaintenance_entries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMaintenanceEntries returns the removed IDs of the "maintenance_entries" edge to the MaintenanceEntry entity.
+func (m *ItemMutation) RemovedMaintenanceEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedmaintenance_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MaintenanceEntriesIDs returns the "maintenance_entries" edge IDs in the mutation.
+func (m *ItemMutation) MaintenanceEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.maintenance_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMaintenanceEntries resets all changes to the "maintenance_entries" edge.
+func (m *ItemMutation) ResetMaintenanceEntries() {
+	m.maintenance_entries = nil
 	m.clearedmaintenance_entries = false
 	m.removedmaintenance_entries = nil
 }
 
-// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
-func (m *ItemMutation) AddAttachmentIDs(ids ...uuid.UUID) {
-	if m.attachments == nil {
-		m.attachments = make(map[uuid.UUID]struct{})
+// AddMaintenanceScheduleIDs adds the "maintenance_schedules" edge to the MaintenanceSchedule entity by ids.
+func (m *ItemMutation) AddMaintenanceScheduleIDs(ids ...uuid.UUID) {
+	if m.maintenance_schedules == nil {
+		m.maintenance_schedules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.maintenance_schedules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMaintenanceSchedules clears the "maintenance_schedules" edge to the MaintenanceSchedule entity.
+func (m *ItemMutation) ClearMaintenanceSchedules() {
+	m.clearedmaintenance_schedules = true
+}
+
+// MaintenanceSchedulesCleared reports if the "maintenance_schedules" edge to the MaintenanceSchedule entity was cleared.
+func (m *ItemMutation) MaintenanceSchedulesCleared() bool {
+	return m.clearedmaintenance_schedules
+}
+
+// RemoveMaintenanceScheduleIDs removes the "maintenance_schedules" edge to the MaintenanceSchedule entity by IDs.
+func (m *ItemMutation) RemoveMaintenanceScheduleIDs(ids ...uuid.UUID) {
+	if m.removedmaintenance_schedules == nil {
+		m.removedmaintenance_schedules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.maintenance_schedules, ids[i])
+		m.removedmaintenance_schedules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMaintenanceSchedules returns the removed IDs of the "maintenance_schedules" edge to the MaintenanceSchedule entity.
+func (m *ItemMutation) RemovedMaintenanceSchedulesIDs() (ids []uuid.UUID) {
+	for id := range m.removedmaintenance_schedules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MaintenanceSchedulesIDs returns the "maintenance_schedules" edge IDs in the mutation.
+func (m *ItemMutation) MaintenanceSchedulesIDs() (ids []uuid.UUID) {
+	for id := range m.maintenance_schedules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMaintenanceSchedules resets all changes to the "maintenance_schedules" edge.
+func (m *ItemMutation) ResetMaintenanceSchedules() {
+	m.maintenance_schedules = nil
+	m.clearedmaintenance_schedules = false
+	m.removedmaintenance_schedules = nil
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
+func (m *ItemMutation) AddAttachmentIDs(ids ...uuid.UUID) {
+	if m.attachments == nil {
+		m.attachments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.attachments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttachments clears the "attachments" edge to the Attachment entity.
+func (m *ItemMutation) ClearAttachments() {
+	m.clearedattachments = true
+}
+
+// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
+func (m *ItemMutation) AttachmentsCleared() bool {
+	return m.clearedattachments
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
+func (m *ItemMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
+	if m.removedattachments == nil {
+		m.removedattachments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.attachments, ids[i])
+		m.removedattachments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
+func (m *ItemMutation) RemovedAttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedattachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
+func (m *ItemMutation) AttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.attachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttachments resets all changes to the "attachments" edge.
+func (m *ItemMutation) ResetAttachments() {
+	m.attachments = nil
+	m.clearedattachments = false
+	m.removedattachments = nil
+}
+
+// Where appends a list predicates to the ItemMutation builder.
+func (m *ItemMutation) Where(ps ...predicate.Item) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Item, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Item).
+func (m *ItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemMutation) Fields() []string {
+	fields := make([]string, 0, 24)
+	if m.created_at != nil {
+		fields = append(fields, item.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, item.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, item.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, item.FieldDescription)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, item.FieldDeletedAt)
+	}
+	if m.import_ref != nil {
+		fields = append(fields, item.FieldImportRef)
+	}
+	if m.notes != nil {
+		fields = append(fields, item.FieldNotes)
+	}
+	if m.quantity != nil {
+		fields = append(fields, item.FieldQuantity)
+	}
+	if m.insured != nil {
+		fields = append(fields, item.FieldInsured)
+	}
+	if m.archived != nil {
+		fields = append(fields, item.FieldArchived)
+	}
+	if m.asset_id != nil {
+		fields = append(fields, item.FieldAssetID)
+	}
+	if m.serial_number != nil {
+		fields = append(fields, item.FieldSerialNumber)
+	}
+	if m.model_number != nil {
+		fields = append(fields, item.FieldModelNumber)
+	}
+	if m.manufacturer != nil {
+		fields = append(fields, item.FieldManufacturer)
+	}
+	if m.lifetime_warranty != nil {
+		fields = append(fields, item.FieldLifetimeWarranty)
+	}
+	if m.warranty_expires != nil {
+		fields = append(fields, item.FieldWarrantyExpires)
+	}
+	if m.warranty_details != nil {
+		fields = append(fields, item.FieldWarrantyDetails)
+	}
+	if m.purchase_time != nil {
+		fields = append(fields, item.FieldPurchaseTime)
+	}
+	if m.purchase_from != nil {
+		fields = append(fields, item.FieldPurchaseFrom)
+	}
+	if m.purchase_price != nil {
+		fields = append(fields, item.FieldPurchasePrice)
+	}
+	if m.sold_time != nil {
+		fields = append(fields, item.FieldSoldTime)
+	}
+	if m.sold_to != nil {
+		fields = append(fields, item.FieldSoldTo)
+	}
+	if m.sold_price != nil {
+		fields = append(fields, item.FieldSoldPrice)
+	}
+	if m.sold_notes != nil {
+		fields = append(fields, item.FieldSoldNotes)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case item.FieldCreatedAt:
+		return m.CreatedAt()
+	case item.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case item.FieldName:
+		return m.Name()
+	case item.FieldDescription:
+		return m.Description()
+	case item.FieldDeletedAt:
+		return m.DeletedAt()
+	case item.FieldImportRef:
+		return m.ImportRef()
+	case item.FieldNotes:
+		return m.Notes()
+	case item.FieldQuantity:
+		return m.Quantity()
+	case item.FieldInsured:
+		return m.Insured()
+	case item.FieldArchived:
+		return m.Archived()
+	case item.FieldAssetID:
+		return m.AssetID()
+	case item.FieldSerialNumber:
+		return m.SerialNumber()
+	case item.FieldModelNumber:
+		return m.ModelNumber()
+	case item.FieldManufacturer:
+		return m.Manufacturer()
+	case item.FieldLifetimeWarranty:
+		return m.LifetimeWarranty()
+	case item.FieldWarrantyExpires:
+		return m.WarrantyExpires()
+	case item.FieldWarrantyDetails:
+		return m.WarrantyDetails()
+	case item.FieldPurchaseTime:
+		return m.PurchaseTime()
+	case item.FieldPurchaseFrom:
+		return m.PurchaseFrom()
+	case item.FieldPurchasePrice:
+		return m.PurchasePrice()
+	case item.FieldSoldTime:
+		return m.SoldTime()
+	case item.FieldSoldTo:
+		return m.SoldTo()
+	case item.FieldSoldPrice:
+		return m.SoldPrice()
+	case item.FieldSoldNotes:
+		return m.SoldNotes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case item.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case item.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case item.FieldName:
+		return m.OldName(ctx)
+	case item.FieldDescription:
+		return m.OldDescription(ctx)
+	case item.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case item.FieldImportRef:
+		return m.OldImportRef(ctx)
+	case item.FieldNotes:
+		return m.OldNotes(ctx)
+	case item.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case item.FieldInsured:
+		return m.OldInsured(ctx)
+	case item.FieldArchived:
+		return m.OldArchived(ctx)
+	case item.FieldAssetID:
+		return m.OldAssetID(ctx)
+	case item.FieldSerialNumber:
+		return m.OldSerialNumber(ctx)
+	case item.FieldModelNumber:
+		return m.OldModelNumber(ctx)
+	case item.FieldManufacturer:
+		return m.OldManufacturer(ctx)
+	case item.FieldLifetimeWarranty:
+		return m.OldLifetimeWarranty(ctx)
+	case item.FieldWarrantyExpires:
+		return m.OldWarrantyExpires(ctx)
+	case item.FieldWarrantyDetails:
+		return m.OldWarrantyDetails(ctx)
+	case item.FieldPurchaseTime:
+		return m.OldPurchaseTime(ctx)
+	case item.FieldPurchaseFrom:
+		return m.OldPurchaseFrom(ctx)
+	case item.FieldPurchasePrice:
+		return m.OldPurchasePrice(ctx)
+	case item.FieldSoldTime:
+		return m.OldSoldTime(ctx)
+	case item.FieldSoldTo:
+		return m.OldSoldTo(ctx)
+	case item.FieldSoldPrice:
+		return m.OldSoldPrice(ctx)
+	case item.FieldSoldNotes:
+		return m.OldSoldNotes(ctx)
+	}
+	return nil, fmt.Errorf("unknown Item field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case item.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case item.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case item.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case item.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case item.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case item.FieldImportRef:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImportRef(v)
+		return nil
+	case item.FieldNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotes(v)
+		return nil
+	case item.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case item.FieldInsured:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInsured(v)
+		return nil
+	case item.FieldArchived:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchived(v)
+		return nil
+	case item.FieldAssetID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssetID(v)
+		return nil
+	case item.FieldSerialNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSerialNumber(v)
+		return nil
+	case item.FieldModelNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelNumber(v)
+		return nil
+	case item.FieldManufacturer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetManufacturer(v)
+		return nil
+	case item.FieldLifetimeWarranty:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLifetimeWarranty(v)
+		return nil
+	case item.FieldWarrantyExpires:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWarrantyExpires(v)
+		return nil
+	case item.FieldWarrantyDetails:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWarrantyDetails(v)
+		return nil
+	case item.FieldPurchaseTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurchaseTime(v)
+		return nil
+	case item.FieldPurchaseFrom:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurchaseFrom(v)
+		return nil
+	case item.FieldPurchasePrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurchasePrice(v)
+		return nil
+	case item.FieldSoldTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoldTime(v)
+		return nil
+	case item.FieldSoldTo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoldTo(v)
+		return nil
+	case item.FieldSoldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoldPrice(v)
+		return nil
+	case item.FieldSoldNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoldNotes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Item field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, item.FieldQuantity)
+	}
+	if m.addasset_id != nil {
+		fields = append(fields, item.FieldAssetID)
+	}
+	if m.addpurchase_price != nil {
+		fields = append(fields, item.FieldPurchasePrice)
+	}
+	if m.addsold_price != nil {
+		fields = append(fields, item.FieldSoldPrice)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case item.FieldQuantity:
+		return m.AddedQuantity()
+	case item.FieldAssetID:
+		return m.AddedAssetID()
+	case item.FieldPurchasePrice:
+		return m.AddedPurchasePrice()
+	case item.FieldSoldPrice:
+		return m.AddedSoldPrice()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case item.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	case item.FieldAssetID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAssetID(v)
+		return nil
+	case item.FieldPurchasePrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPurchasePrice(v)
+		return nil
+	case item.FieldSoldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSoldPrice(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Item numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(item.FieldDescription) {
+		fields = append(fields, item.FieldDescription)
+	}
+	if m.FieldCleared(item.FieldDeletedAt) {
+		fields = append(fields, item.FieldDeletedAt)
+	}
+	if m.FieldCleared(item.FieldImportRef) {
+		fields = append(fields, item.FieldImportRef)
+	}
+	if m.FieldCleared(item.FieldNotes) {
+		fields = append(fields, item.FieldNotes)
+	}
+	if m.FieldCleared(item.FieldSerialNumber) {
+		fields = append(fields, item.FieldSerialNumber)
+	}
+	if m.FieldCleared(item.FieldModelNumber) {
+		fields = append(fields, item.FieldModelNumber)
+	}
+	if m.FieldCleared(item.FieldManufacturer) {
+		fields = append(fields, item.FieldManufacturer)
+	}
+	if m.FieldCleared(item.FieldWarrantyExpires) {
+		fields = append(fields, item.FieldWarrantyExpires)
+	}
+	if m.FieldCleared(item.FieldWarrantyDetails) {
+		fields = append(fields, item.FieldWarrantyDetails)
+	}
+	if m.FieldCleared(item.FieldPurchaseTime) {
+		fields = append(fields, item.FieldPurchaseTime)
+	}
+	if m.FieldCleared(item.FieldPurchaseFrom) {
+		fields = append(fields, item.FieldPurchaseFrom)
+	}
+	if m.FieldCleared(item.FieldSoldTime) {
+		fields = append(fields, item.FieldSoldTime)
+	}
+	if m.FieldCleared(item.FieldSoldTo) {
+		fields = append(fields, item.FieldSoldTo)
+	}
+	if m.FieldCleared(item.FieldSoldNotes) {
+		fields = append(fields, item.FieldSoldNotes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemMutation) ClearField(name string) error {
+	switch name {
+	case item.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case item.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case item.FieldImportRef:
+		m.ClearImportRef()
+		return nil
+	case item.FieldNotes:
+		m.ClearNotes()
+		return nil
+	case item.FieldSerialNumber:
+		m.ClearSerialNumber()
+		return nil
+	case item.FieldModelNumber:
+		m.ClearModelNumber()
+		return nil
+	case item.FieldManufacturer:
+		m.ClearManufacturer()
+		return nil
+	case item.FieldWarrantyExpires:
+		m.ClearWarrantyExpires()
+		return nil
+	case item.FieldWarrantyDetails:
+		m.ClearWarrantyDetails()
+		return nil
+	case item.FieldPurchaseTime:
+		m.ClearPurchaseTime()
+		return nil
+	case item.FieldPurchaseFrom:
+		m.ClearPurchaseFrom()
+		return nil
+	case item.FieldSoldTime:
+		m.ClearSoldTime()
+		return nil
+	case item.FieldSoldTo:
+		m.ClearSoldTo()
+		return nil
+	case item.FieldSoldNotes:
+		m.ClearSoldNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown Item nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemMutation) ResetField(name string) error {
+	switch name {
+	case item.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case item.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case item.FieldName:
+		m.ResetName()
+		return nil
+	case item.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case item.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case item.FieldImportRef:
+		m.ResetImportRef()
+		return nil
+	case item.FieldNotes:
+		m.ResetNotes()
+		return nil
+	case item.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case item.FieldInsured:
+		m.ResetInsured()
+		return nil
+	case item.FieldArchived:
+		m.ResetArchived()
+		return nil
+	case item.FieldAssetID:
+		m.ResetAssetID()
+		return nil
+	case item.FieldSerialNumber:
+		m.ResetSerialNumber()
+		return nil
+	case item.FieldModelNumber:
+		m.ResetModelNumber()
+		return nil
+	case item.FieldManufacturer:
+		m.ResetManufacturer()
+		return nil
+	case item.FieldLifetimeWarranty:
+		m.ResetLifetimeWarranty()
+		return nil
+	case item.FieldWarrantyExpires:
+		m.ResetWarrantyExpires()
+		return nil
+	case item.FieldWarrantyDetails:
+		m.ResetWarrantyDetails()
+		return nil
+	case item.FieldPurchaseTime:
+		m.ResetPurchaseTime()
+		return nil
+	case item.FieldPurchaseFrom:
+		m.ResetPurchaseFrom()
+		return nil
+	case item.FieldPurchasePrice:
+		m.ResetPurchasePrice()
+		return nil
+	case item.FieldSoldTime:
+		m.ResetSoldTime()
+		return nil
+	case item.FieldSoldTo:
+		m.ResetSoldTo()
+		return nil
+	case item.FieldSoldPrice:
+		m.ResetSoldPrice()
+		return nil
+	case item.FieldSoldNotes:
+		m.ResetSoldNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown Item field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.group != nil {
+		edges = append(edges, item.EdgeGroup)
+	}
+	if m.parent != nil {
+		edges = append(edges, item.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, item.EdgeChildren)
+	}
+	if m.label != nil {
+		edges = append(edges, item.EdgeLabel)
+	}
+	if m.location != nil {
+		edges = append(edges, item.EdgeLocation)
+	}
+	if m.fields != nil {
+		edges = append(edges, item.EdgeFields)
+	}
+	if m.maintenance_entries != nil {
+		edges = append(edges, item.EdgeMaintenanceEntries)
+	}
+	if m.maintenance_schedules != nil {
+		edges = append(edges, item.EdgeMaintenanceSchedules)
+	}
+	if m.attachments != nil {
+		edges = append(edges, item.EdgeAttachments)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case item.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case item.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case item.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeLabel:
+		ids := make([]ent.Value, 0, len(m.label))
+		for id := range m.label {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	case item.EdgeFields:
+		ids := make([]ent.Value, 0, len(m.fields))
+		for id := range m.fields {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeMaintenanceEntries:
+		ids := make([]ent.Value, 0, len(m.maintenance_entries))
+		for id := range m.maintenance_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeMaintenanceSchedules:
+		ids := make([]ent.Value, 0, len(m.maintenance_schedules))
+		for id := range m.maintenance_schedules {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.attachments))
+		for id := range m.attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.removedchildren != nil {
+		edges = append(edges, item.EdgeChildren)
+	}
+	if m.removedlabel != nil {
+		edges = append(edges, item.EdgeLabel)
+	}
+	if m.removedfields != nil {
+		edges = append(edges, item.EdgeFields)
+	}
+	if m.removedmaintenance_entries != nil {
+		edges = append(edges, item.EdgeMaintenanceEntries)
+	}
+	if m.removedmaintenance_schedules != nil {
+		edges = append(edges, item.EdgeMaintenanceSchedules)
+	}
+	if m.removedattachments != nil {
+		edges = append(edges, item.EdgeAttachments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case item.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeLabel:
+		ids := make([]ent.Value, 0, len(m.removedlabel))
+		for id := range m.removedlabel {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeFields:
+		ids := make([]ent.Value, 0, len(m.removedfields))
+		for id := range m.removedfields {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeMaintenanceEntries:
+		ids := make([]ent.Value, 0, len(m.removedmaintenance_entries))
+		for id := range m.removedmaintenance_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeMaintenanceSchedules:
+		ids := make([]ent.Value, 0, len(m.removedmaintenance_schedules))
+		for id := range m.removedmaintenance_schedules {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.removedattachments))
+		for id := range m.removedattachments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.clearedgroup {
+		edges = append(edges, item.EdgeGroup)
+	}
+	if m.clearedparent {
+		edges = append(edges, item.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, item.EdgeChildren)
+	}
+	if m.clearedlabel {
+		edges = append(edges, item.EdgeLabel)
+	}
+	if m.clearedlocation {
+		edges = append(edges, item.EdgeLocation)
+	}
+	if m.clearedfields {
+		edges = append(edges, item.EdgeFields)
+	}
+	if m.clearedmaintenance_entries {
+		edges = append(edges, item.EdgeMaintenanceEntries)
+	}
+	if m.clearedmaintenance_schedules {
+		edges = append(edges, item.EdgeMaintenanceSchedules)
+	}
+	if m.clearedattachments {
+		edges = append(edges, item.EdgeAttachments)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case item.EdgeGroup:
+		return m.clearedgroup
+	case item.EdgeParent:
+		return m.clearedparent
+	case item.EdgeChildren:
+		return m.clearedchildren
+	case item.EdgeLabel:
+		return m.clearedlabel
+	case item.EdgeLocation:
+		return m.clearedlocation
+	case item.EdgeFields:
+		return m.clearedfields
+	case item.EdgeMaintenanceEntries:
+		return m.clearedmaintenance_entries
+	case item.EdgeMaintenanceSchedules:
+		return m.clearedmaintenance_schedules
+	case item.EdgeAttachments:
+		return m.clearedattachments
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemMutation) ClearEdge(name string) error {
+	switch name {
+	case item.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	case item.EdgeParent:
+		m.ClearParent()
+		return nil
+	case item.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown Item unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemMutation) ResetEdge(name string) error {
+	switch name {
+	case item.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case item.EdgeParent:
+		m.ResetParent()
+		return nil
+	case item.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case item.EdgeLabel:
+		m.ResetLabel()
+		return nil
+	case item.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	case item.EdgeFields:
+		m.ResetFields()
+		return nil
+	case item.EdgeMaintenanceEntries:
+		m.ResetMaintenanceEntries()
+		return nil
+	case item.EdgeMaintenanceSchedules:
+		m.ResetMaintenanceSchedules()
+		return nil
+	case item.EdgeAttachments:
+		m.ResetAttachments()
+		return nil
+	}
+	return fmt.Errorf("unknown Item edge %s", name)
+}
+
+// ItemFieldMutation represents an operation that mutates the ItemField nodes in the graph.
+type ItemFieldMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	created_at      *time.Time
+	updated_at      *time.Time
+	name            *string
+	description     *string
+	_type           *itemfield.Type
+	text_value      *string
+	number_value    *int
+	addnumber_value *int
+	boolean_value   *bool
+	time_value      *time.Time
+	clearedFields   map[string]struct{}
+	item            *uuid.UUID
+	cleareditem     bool
+	done            bool
+	oldValue        func(context.Context) (*ItemField, error)
+	predicates      []predicate.ItemField
+}
+
+var _ ent.Mutation = (*ItemFieldMutation)(nil)
+
+// itemfieldOption allows management of the mutation configuration using functional options.
+type itemfieldOption func(*ItemFieldMutation)
+
+// newItemFieldMutation creates new mutation for the ItemField entity.
+func newItemFieldMutation(c config, op Op, opts ...itemfieldOption) *ItemFieldMutation {
+	m := &ItemFieldMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItemField,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withItemFieldID sets the ID field of the mutation.
+func withItemFieldID(id uuid.UUID) itemfieldOption {
+	return func(m *ItemFieldMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ItemField
+		)
+		m.oldValue = func(ctx context.Context) (*ItemField, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ItemField.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withItemField sets the old ItemField of the mutation.
+func withItemField(node *ItemField) itemfieldOption {
+	return func(m *ItemFieldMutation) {
+		m.oldValue = func(context.Context) (*ItemField, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItemFieldMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItemFieldMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ItemField entities.
+func (m *ItemFieldMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItemFieldMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItemFieldMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ItemField.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ItemFieldMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ItemFieldMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ItemFieldMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ItemFieldMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ItemFieldMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ItemFieldMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *ItemFieldMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ItemFieldMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ItemFieldMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ItemFieldMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ItemFieldMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ItemFieldMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[itemfield.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ItemFieldMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[itemfield.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ItemFieldMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, itemfield.FieldDescription)
+}
+
+// SetType sets the "type" field.
+func (m *ItemFieldMutation) SetType(i itemfield.Type) {
+	m._type = &i
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ItemFieldMutation) GetType() (r itemfield.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldType(ctx context.Context) (v itemfield.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ItemFieldMutation) ResetType() {
+	m._type = nil
+}
+
+// SetTextValue sets the "text_value" field.
+func (m *ItemFieldMutation) SetTextValue(s string) {
+	m.text_value = &s
+}
+
+// TextValue returns the value of the "text_value" field in the mutation.
+func (m *ItemFieldMutation) TextValue() (r string, exists bool) {
+	v := m.text_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTextValue returns the old "text_value" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldTextValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTextValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTextValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTextValue: %w", err)
+	}
+	return oldValue.TextValue, nil
+}
+
+// ClearTextValue clears the value of the "text_value" field.
+func (m *ItemFieldMutation) ClearTextValue() {
+	m.text_value = nil
+	m.clearedFields[itemfield.FieldTextValue] = struct{}{}
+}
+
+// TextValueCleared returns if the "text_value" field was cleared in this mutation.
+func (m *ItemFieldMutation) TextValueCleared() bool {
+	_, ok := m.clearedFields[itemfield.FieldTextValue]
+	return ok
+}
+
+// ResetTextValue resets all changes to the "text_value" field.
+func (m *ItemFieldMutation) ResetTextValue() {
+	m.text_value = nil
+	delete(m.clearedFields, itemfield.FieldTextValue)
+}
+
+// SetNumberValue sets the "number_value" field.
+func (m *ItemFieldMutation) SetNumberValue(i int) {
+	m.number_value = &i
+	m.addnumber_value = nil
+}
+
+// NumberValue returns the value of the "number_value" field in the mutation.
+func (m *ItemFieldMutation) NumberValue() (r int, exists bool) {
+	v := m.number_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumberValue returns the old "number_value" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldNumberValue(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumberValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumberValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumberValue: %w", err)
+	}
+	return oldValue.NumberValue, nil
+}
+
+// AddNumberValue adds i to the "number_value" field.
+func (m *ItemFieldMutation) AddNumberValue(i int) {
+	if m.addnumber_value != nil {
+		*m.addnumber_value += i
+	} else {
+		m.addnumber_value = &i
+	}
+}
+
+// AddedNumberValue returns the value that was added to the "number_value" field in this mutation.
+func (m *ItemFieldMutation) AddedNumberValue() (r int, exists bool) {
+	v := m.addnumber_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearNumberValue clears the value of the "number_value" field.
+func (m *ItemFieldMutation) ClearNumberValue() {
+	m.number_value = nil
+	m.addnumber_value = nil
+	m.clearedFields[itemfield.FieldNumberValue] = struct{}{}
+}
+
+// NumberValueCleared returns if the "number_value" field was cleared in this mutation.
+func (m *ItemFieldMutation) NumberValueCleared() bool {
+	_, ok := m.clearedFields[itemfield.FieldNumberValue]
+	return ok
+}
+
+// ResetNumberValue resets all changes to the "number_value" field.
+func (m *ItemFieldMutation) ResetNumberValue() {
+	m.number_value = nil
+	m.addnumber_value = nil
+	delete(m.clearedFields, itemfield.FieldNumberValue)
+}
+
+// SetBooleanValue sets the "boolean_value" field.
+func (m *ItemFieldMutation) SetBooleanValue(b bool) {
+	m.boolean_value = &b
+}
+
+// BooleanValue returns the value of the "boolean_value" field in the mutation.
+func (m *ItemFieldMutation) BooleanValue() (r bool, exists bool) {
+	v := m.boolean_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBooleanValue returns the old "boolean_value" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldBooleanValue(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBooleanValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBooleanValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBooleanValue: %w", err)
+	}
+	return oldValue.BooleanValue, nil
+}
+
+// ResetBooleanValue resets all changes to the "boolean_value" field.
+func (m *ItemFieldMutation) ResetBooleanValue() {
+	m.boolean_value = nil
+}
+
+// SetTimeValue sets the "time_value" field.
+func (m *ItemFieldMutation) SetTimeValue(t time.Time) {
+	m.time_value = &t
+}
+
+// TimeValue returns the value of the "time_value" field in the mutation.
+func (m *ItemFieldMutation) TimeValue() (r time.Time, exists bool) {
+	v := m.time_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeValue returns the old "time_value" field's value of the ItemField entity.
+// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemFieldMutation) OldTimeValue(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeValue: %w", err)
+	}
+	return oldValue.TimeValue, nil
+}
+
+// ResetTimeValue resets all changes to the "time_value" field.
+func (m *ItemFieldMutation) ResetTimeValue() {
+	m.time_value = nil
+}
+
+// SetItemID sets the "item" edge to the Item entity by id.
+func (m *ItemFieldMutation) SetItemID(id uuid.UUID) {
+	m.item = &id
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *ItemFieldMutation) ClearItem() {
+	m.cleareditem = true
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *ItemFieldMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemID returns the "item" edge ID in the mutation.
+func (m *ItemFieldMutation) ItemID() (id uuid.UUID, exists bool) {
+	if m.item != nil {
+		return *m.item, true
+	}
+	return
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *ItemFieldMutation) ItemIDs() (ids []uuid.UUID) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *ItemFieldMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// Where appends a list predicates to the ItemFieldMutation builder.
+func (m *ItemFieldMutation) Where(ps ...predicate.ItemField) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemFieldMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemFieldMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ItemField, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemFieldMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemFieldMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ItemField).
+func (m *ItemFieldMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemFieldMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, itemfield.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, itemfield.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, itemfield.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, itemfield.FieldDescription)
+	}
+	if m._type != nil {
+		fields = append(fields, itemfield.FieldType)
+	}
+	if m.text_value != nil {
+		fields = append(fields, itemfield.FieldTextValue)
+	}
+	if m.number_value != nil {
+		fields = append(fields, itemfield.FieldNumberValue)
+	}
+	if m.boolean_value != nil {
+		fields = append(fields, itemfield.FieldBooleanValue)
+	}
+	if m.time_value != nil {
+		fields = append(fields, itemfield.FieldTimeValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemFieldMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case itemfield.FieldCreatedAt:
+		return m.CreatedAt()
+	case itemfield.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case itemfield.FieldName:
+		return m.Name()
+	case itemfield.FieldDescription:
+		return m.Description()
+	case itemfield.FieldType:
+		return m.GetType()
+	case itemfield.FieldTextValue:
+		return m.TextValue()
+	case itemfield.FieldNumberValue:
+		return m.NumberValue()
+	case itemfield.FieldBooleanValue:
+		return m.BooleanValue()
+	case itemfield.FieldTimeValue:
+		return m.TimeValue()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemFieldMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case itemfield.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case itemfield.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case itemfield.FieldName:
+		return m.OldName(ctx)
+	case itemfield.FieldDescription:
+		return m.OldDescription(ctx)
+	case itemfield.FieldType:
+		return m.OldType(ctx)
+	case itemfield.FieldTextValue:
+		return m.OldTextValue(ctx)
+	case itemfield.FieldNumberValue:
+		return m.OldNumberValue(ctx)
+	case itemfield.FieldBooleanValue:
+		return m.OldBooleanValue(ctx)
+	case itemfield.FieldTimeValue:
+		return m.OldTimeValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown ItemField field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemFieldMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case itemfield.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case itemfield.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case itemfield.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case itemfield.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case itemfield.FieldType:
+		v, ok := value.(itemfield.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case itemfield.FieldTextValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTextValue(v)
+		return nil
+	case itemfield.FieldNumberValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumberValue(v)
+		return nil
+	case itemfield.FieldBooleanValue:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBooleanValue(v)
+		return nil
+	case itemfield.FieldTimeValue:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ItemField field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemFieldMutation) AddedFields() []string {
+	var fields []string
+	if m.addnumber_value != nil {
+		fields = append(fields, itemfield.FieldNumberValue)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemFieldMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case itemfield.FieldNumberValue:
+		return m.AddedNumberValue()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemFieldMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case itemfield.FieldNumberValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNumberValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ItemField numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemFieldMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(itemfield.FieldDescription) {
+		fields = append(fields, itemfield.FieldDescription)
+	}
+	if m.FieldCleared(itemfield.FieldTextValue) {
+		fields = append(fields, itemfield.FieldTextValue)
+	}
+	if m.FieldCleared(itemfield.FieldNumberValue) {
+		fields = append(fields, itemfield.FieldNumberValue)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemFieldMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemFieldMutation) ClearField(name string) error {
+	switch name {
+	case itemfield.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case itemfield.FieldTextValue:
+		m.ClearTextValue()
+		return nil
+	case itemfield.FieldNumberValue:
+		m.ClearNumberValue()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemField nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemFieldMutation) ResetField(name string) error {
+	switch name {
+	case itemfield.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case itemfield.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case itemfield.FieldName:
+		m.ResetName()
+		return nil
+	case itemfield.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case itemfield.FieldType:
+		m.ResetType()
+		return nil
+	case itemfield.FieldTextValue:
+		m.ResetTextValue()
+		return nil
+	case itemfield.FieldNumberValue:
+		m.ResetNumberValue()
+		return nil
+	case itemfield.FieldBooleanValue:
+		m.ResetBooleanValue()
+		return nil
+	case itemfield.FieldTimeValue:
+		m.ResetTimeValue()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemField field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemFieldMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.item != nil {
+		edges = append(edges, itemfield.EdgeItem)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemFieldMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case itemfield.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemFieldMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemFieldMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemFieldMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareditem {
+		edges = append(edges, itemfield.EdgeItem)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemFieldMutation) EdgeCleared(name string) bool {
+	switch name {
+	case itemfield.EdgeItem:
+		return m.cleareditem
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemFieldMutation) ClearEdge(name string) error {
+	switch name {
+	case itemfield.EdgeItem:
+		m.ClearItem()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemField unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemFieldMutation) ResetEdge(name string) error {
+	switch name {
+	case itemfield.EdgeItem:
+		m.ResetItem()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemField edge %s", name)
+}
+
+// LabelMutation represents an operation that mutates the Label nodes in the graph.
+type LabelMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	name          *string
+	description   *string
+	color         *string
+	clearedFields map[string]struct{}
+	group         *uuid.UUID
+	clearedgroup  bool
+	items         map[uuid.UUID]struct{}
+	removeditems  map[uuid.UUID]struct{}
+	cleareditems  bool
+	done          bool
+	oldValue      func(context.Context) (*Label, error)
+	predicates    []predicate.Label
+}
+
+var _ ent.Mutation = (*LabelMutation)(nil)
+
+// labelOption allows management of the mutation configuration using functional options.
+type labelOption func(*LabelMutation)
+
+// newLabelMutation creates new mutation for the Label entity.
+func newLabelMutation(c config, op Op, opts ...labelOption) *LabelMutation {
+	m := &LabelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLabel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLabelID sets the ID field of the mutation.
+func withLabelID(id uuid.UUID) labelOption {
+	return func(m *LabelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Label
+		)
+		m.oldValue = func(ctx context.Context) (*Label, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Label.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLabel sets the old Label of the mutation.
+func withLabel(node *Label) labelOption {
+	return func(m *LabelMutation) {
+		m.oldValue = func(context.Context) (*Label, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LabelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LabelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Label entities.
+func (m *LabelMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LabelMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LabelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Label.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LabelMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LabelMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Label entity.
+// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LabelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LabelMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LabelMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LabelMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Label entity.
+// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LabelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LabelMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *LabelMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LabelMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Label entity.
+// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LabelMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LabelMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *LabelMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *LabelMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Label entity.
+// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LabelMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *LabelMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[label.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *LabelMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[label.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *LabelMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, label.FieldDescription)
+}
+
+// SetColor sets the "color" field.
+func (m *LabelMutation) SetColor(s string) {
+	m.color = &s
+}
+
+// Color returns the value of the "color" field in the mutation.
+func (m *LabelMutation) Color() (r string, exists bool) {
+	v := m.color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColor returns the old "color" field's value of the Label entity.
+// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LabelMutation) OldColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
+}
+
+// ClearColor clears the value of the "color" field.
+func (m *LabelMutation) ClearColor() {
+	m.color = nil
+	m.clearedFields[label.FieldColor] = struct{}{}
+}
+
+// ColorCleared returns if the "color" field was cleared in this mutation.
+func (m *LabelMutation) ColorCleared() bool {
+	_, ok := m.clearedFields[label.FieldColor]
+	return ok
+}
+
+// ResetColor resets all changes to the "color" field.
+func (m *LabelMutation) ResetColor() {
+	m.color = nil
+	delete(m.clearedFields, label.FieldColor)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by id.
+func (m *LabelMutation) SetGroupID(id uuid.UUID) {
+	m.group = &id
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *LabelMutation) ClearGroup() {
+	m.clearedgroup = true
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *LabelMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupID returns the "group" edge ID in the mutation.
+func (m *LabelMutation) GroupID() (id uuid.UUID, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *LabelMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *LabelMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// AddItemIDs adds the "items" edge to the Item entity by ids.
+func (m *LabelMutation) AddItemIDs(ids ...uuid.UUID) {
+	if m.items == nil {
+		m.items = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItems clears the "items" edge to the Item entity.
+func (m *LabelMutation) ClearItems() {
+	m.cleareditems = true
+}
+
+// ItemsCleared reports if the "items" edge to the Item entity was cleared.
+func (m *LabelMutation) ItemsCleared() bool {
+	return m.cleareditems
+}
+
+// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
+func (m *LabelMutation) RemoveItemIDs(ids ...uuid.UUID) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
+func (m *LabelMutation) RemovedItemsIDs() (ids []uuid.UUID) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *LabelMutation) ItemsIDs() (ids []uuid.UUID) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItems resets all changes to the "items" edge.
+func (m *LabelMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
+}
+
+// Where appends a list predicates to the LabelMutation builder.
+func (m *LabelMutation) Where(ps ...predicate.Label) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LabelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LabelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Label, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LabelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LabelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Label).
+func (m *LabelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LabelMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, label.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, label.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, label.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, label.FieldDescription)
+	}
+	if m.color != nil {
+		fields = append(fields, label.FieldColor)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LabelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case label.FieldCreatedAt:
+		return m.CreatedAt()
+	case label.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case label.FieldName:
+		return m.Name()
+	case label.FieldDescription:
+		return m.Description()
+	case label.FieldColor:
+		return m.Color()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LabelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case label.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case label.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case label.FieldName:
+		return m.OldName(ctx)
+	case label.FieldDescription:
+		return m.OldDescription(ctx)
+	case label.FieldColor:
+		return m.OldColor(ctx)
+	}
+	return nil, fmt.Errorf("unknown Label field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LabelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case label.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case label.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case label.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case label.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case label.FieldColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColor(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Label field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LabelMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LabelMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LabelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Label numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LabelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(label.FieldDescription) {
+		fields = append(fields, label.FieldDescription)
+	}
+	if m.FieldCleared(label.FieldColor) {
+		fields = append(fields, label.FieldColor)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LabelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LabelMutation) ClearField(name string) error {
+	switch name {
+	case label.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case label.FieldColor:
+		m.ClearColor()
+		return nil
+	}
+	return fmt.Errorf("unknown Label nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LabelMutation) ResetField(name string) error {
+	switch name {
+	case label.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case label.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case label.FieldName:
+		m.ResetName()
+		return nil
+	case label.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case label.FieldColor:
+		m.ResetColor()
+		return nil
+	}
+	return fmt.Errorf("unknown Label field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LabelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.group != nil {
+		edges = append(edges, label.EdgeGroup)
+	}
+	if m.items != nil {
+		edges = append(edges, label.EdgeItems)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LabelMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case label.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case label.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LabelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeditems != nil {
+		edges = append(edges, label.EdgeItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LabelMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case label.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LabelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgroup {
+		edges = append(edges, label.EdgeGroup)
+	}
+	if m.cleareditems {
+		edges = append(edges, label.EdgeItems)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LabelMutation) EdgeCleared(name string) bool {
+	switch name {
+	case label.EdgeGroup:
+		return m.clearedgroup
+	case label.EdgeItems:
+		return m.cleareditems
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LabelMutation) ClearEdge(name string) error {
+	switch name {
+	case label.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown Label unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LabelMutation) ResetEdge(name string) error {
+	switch name {
+	case label.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case label.EdgeItems:
+		m.ResetItems()
+		return nil
+	}
+	return fmt.Errorf("unknown Label edge %s", name)
+}
+
+// LocationMutation represents an operation that mutates the Location nodes in the graph.
+type LocationMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	created_at      *time.Time
+	updated_at      *time.Time
+	name            *string
+	description     *string
+	deleted_at      *time.Time
+	clearedFields   map[string]struct{}
+	group           *uuid.UUID
+	clearedgroup    bool
+	parent          *uuid.UUID
+	clearedparent   bool
+	children        map[uuid.UUID]struct{}
+	removedchildren map[uuid.UUID]struct{}
+	clearedchildren bool
+	items           map[uuid.UUID]struct{}
+	removeditems    map[uuid.UUID]struct{}
+	cleareditems    bool
+	done            bool
+	oldValue        func(context.Context) (*Location, error)
+	predicates      []predicate.Location
+}
+
+var _ ent.Mutation = (*LocationMutation)(nil)
+
+// locationOption allows management of the mutation configuration using functional options.
+type locationOption func(*LocationMutation)
+
+// newLocationMutation creates new mutation for the Location entity.
+func newLocationMutation(c config, op Op, opts ...locationOption) *LocationMutation {
+	m := &LocationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLocation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLocationID sets the ID field of the mutation.
+func withLocationID(id uuid.UUID) locationOption {
+	return func(m *LocationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Location
+		)
+		m.oldValue = func(ctx context.Context) (*Location, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Location.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLocation sets the old Location of the mutation.
+func withLocation(node *Location) locationOption {
+	return func(m *LocationMutation) {
+		m.oldValue = func(context.Context) (*Location, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LocationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LocationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Location entities.
+func (m *LocationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LocationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LocationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Location.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LocationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LocationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LocationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LocationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LocationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LocationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *LocationMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LocationMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LocationMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *LocationMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *LocationMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *LocationMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[location.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *LocationMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[location.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *LocationMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, location.FieldDescription)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *LocationMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *LocationMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *LocationMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[location.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *LocationMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[location.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *LocationMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, location.FieldDeletedAt)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by id.
+func (m *LocationMutation) SetGroupID(id uuid.UUID) {
+	m.group = &id
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *LocationMutation) ClearGroup() {
+	m.clearedgroup = true
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *LocationMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupID returns the "group" edge ID in the mutation.
+func (m *LocationMutation) GroupID() (id uuid.UUID, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *LocationMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *LocationMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// SetParentID sets the "parent" edge to the Location entity by id.
+func (m *LocationMutation) SetParentID(id uuid.UUID) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Location entity.
+func (m *LocationMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Location entity was cleared.
+func (m *LocationMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *LocationMutation) ParentID() (id uuid.UUID, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *LocationMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *LocationMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Location entity by ids.
+func (m *LocationMutation) AddChildIDs(ids ...uuid.UUID) {
+	if m.children == nil {
+		m.children = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Location entity.
+func (m *LocationMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Location entity was cleared.
+func (m *LocationMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Location entity by IDs.
+func (m *LocationMutation) RemoveChildIDs(ids ...uuid.UUID) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Location entity.
+func (m *LocationMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *LocationMutation) ChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *LocationMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddItemIDs adds the "items" edge to the Item entity by ids.
+func (m *LocationMutation) AddItemIDs(ids ...uuid.UUID) {
+	if m.items == nil {
+		m.items = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItems clears the "items" edge to the Item entity.
+func (m *LocationMutation) ClearItems() {
+	m.cleareditems = true
+}
+
+// ItemsCleared reports if the "items" edge to the Item entity was cleared.
+func (m *LocationMutation) ItemsCleared() bool {
+	return m.cleareditems
+}
+
+// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
+func (m *LocationMutation) RemoveItemIDs(ids ...uuid.UUID) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
+func (m *LocationMutation) RemovedItemsIDs() (ids []uuid.UUID) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *LocationMutation) ItemsIDs() (ids []uuid.UUID) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItems resets all changes to the "items" edge.
+func (m *LocationMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
+}
+
+// Where appends a list predicates to the LocationMutation builder.
+func (m *LocationMutation) Where(ps ...predicate.Location) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LocationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LocationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Location, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LocationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LocationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Location).
+func (m *LocationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LocationMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, location.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, location.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, location.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, location.FieldDescription)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, location.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LocationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case location.FieldCreatedAt:
+		return m.CreatedAt()
+	case location.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case location.FieldName:
+		return m.Name()
+	case location.FieldDescription:
+		return m.Description()
+	case location.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case location.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case location.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case location.FieldName:
+		return m.OldName(ctx)
+	case location.FieldDescription:
+		return m.OldDescription(ctx)
+	case location.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Location field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case location.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case location.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case location.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case location.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case location.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Location field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LocationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LocationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Location numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LocationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(location.FieldDescription) {
+		fields = append(fields, location.FieldDescription)
+	}
+	if m.FieldCleared(location.FieldDeletedAt) {
+		fields = append(fields, location.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LocationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LocationMutation) ClearField(name string) error {
+	switch name {
+	case location.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case location.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Location nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LocationMutation) ResetField(name string) error {
+	switch name {
+	case location.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case location.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case location.FieldName:
+		m.ResetName()
+		return nil
+	case location.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case location.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Location field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LocationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.group != nil {
+		edges = append(edges, location.EdgeGroup)
+	}
+	if m.parent != nil {
+		edges = append(edges, location.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, location.EdgeChildren)
+	}
+	if m.items != nil {
+		edges = append(edges, location.EdgeItems)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LocationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case location.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case location.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case location.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LocationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedchildren != nil {
+		edges = append(edges, location.EdgeChildren)
+	}
+	if m.removeditems != nil {
+		edges = append(edges, location.EdgeItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LocationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case location.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LocationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedgroup {
+		edges = append(edges, location.EdgeGroup)
+	}
+	if m.clearedparent {
+		edges = append(edges, location.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, location.EdgeChildren)
+	}
+	if m.cleareditems {
+		edges = append(edges, location.EdgeItems)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LocationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case location.EdgeGroup:
+		return m.clearedgroup
+	case location.EdgeParent:
+		return m.clearedparent
+	case location.EdgeChildren:
+		return m.clearedchildren
+	case location.EdgeItems:
+		return m.cleareditems
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LocationMutation) ClearEdge(name string) error {
+	switch name {
+	case location.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	case location.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Location unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LocationMutation) ResetEdge(name string) error {
+	switch name {
+	case location.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case location.EdgeParent:
+		m.ResetParent()
+		return nil
+	case location.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case location.EdgeItems:
+		m.ResetItems()
+		return nil
+	}
+	return fmt.Errorf("unknown Location edge %s", name)
+}
+
+// MaintenanceEntryMutation represents an operation that mutates the MaintenanceEntry nodes in the graph.
+type MaintenanceEntryMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	created_at      *time.Time
+	updated_at      *time.Time
+	date            *time.Time
+	scheduled_date  *time.Time
+	name            *string
+	description     *string
+	cost            *float64
+	addcost         *float64
+	clearedFields   map[string]struct{}
+	item            *uuid.UUID
+	cleareditem     bool
+	schedule        *uuid.UUID
+	clearedschedule bool
+	done            bool
+	oldValue        func(context.Context) (*MaintenanceEntry, error)
+	predicates      []predicate.MaintenanceEntry
+}
+
+var _ ent.Mutation = (*MaintenanceEntryMutation)(nil)
+
+// maintenanceentryOption allows management of the mutation configuration using functional options.
+type maintenanceentryOption func(*MaintenanceEntryMutation)
+
+// newMaintenanceEntryMutation creates new mutation for the MaintenanceEntry entity.
+func newMaintenanceEntryMutation(c config, op Op, opts ...maintenanceentryOption) *MaintenanceEntryMutation {
+	m := &MaintenanceEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMaintenanceEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMaintenanceEntryID sets the ID field of the mutation.
+func withMaintenanceEntryID(id uuid.UUID) maintenanceentryOption {
+	return func(m *MaintenanceEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MaintenanceEntry
+		)
+		m.oldValue = func(ctx context.Context) (*MaintenanceEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MaintenanceEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMaintenanceEntry sets the old MaintenanceEntry of the mutation.
+func withMaintenanceEntry(node *MaintenanceEntry) maintenanceentryOption {
+	return func(m *MaintenanceEntryMutation) {
+		m.oldValue = func(context.Context) (*MaintenanceEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MaintenanceEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MaintenanceEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MaintenanceEntry entities.
+func (m *MaintenanceEntryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MaintenanceEntryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MaintenanceEntryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MaintenanceEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MaintenanceEntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MaintenanceEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MaintenanceEntry entity.
+// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MaintenanceEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MaintenanceEntryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MaintenanceEntryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the MaintenanceEntry entity.
+// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceEntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MaintenanceEntryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetItemID sets the "item_id" field.
+func (m *MaintenanceEntryMutation) SetItemID(u uuid.UUID) {
+	m.item = &u
+}
+
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *MaintenanceEntryMutation) ItemID() (r uuid.UUID, exists bool) {
+	v := m.item
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemID returns the old "item_id" field's value of the MaintenanceEntry entity.
+// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceEntryMutation) OldItemID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+	}
+	return oldValue.ItemID, nil
+}
+
+// ResetItemID resets all changes to the "item_id" field.
+func (m *MaintenanceEntryMutation) ResetItemID() {
+	m.item = nil
+}
+
+// SetDate sets the "date" field.
+func (m *MaintenanceEntryMutation) SetDate(t time.Time) {
+	m.date = &t
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *MaintenanceEntryMutation) Date() (r time.Time, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDate returns the old "date" field's value of the MaintenanceEntry entity.
+// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceEntryMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	}
+	return oldValue.Date, nil
+}
+
+// ClearDate clears the value of the "date" field.
+func (m *MaintenanceEntryMutation) ClearDate() {
+	m.date = nil
+	m.clearedFields[maintenanceentry.FieldDate] = struct{}{}
+}
+
+// DateCleared returns if the "date" field was cleared in this mutation.
+func (m *MaintenanceEntryMutation) DateCleared() bool {
+	_, ok := m.clearedFields[maintenanceentry.FieldDate]
+	return ok
+}
+
+// ResetDate resets all changes to the "date" field.
+func (m *MaintenanceEntryMutation) ResetDate() {
+	m.date = nil
+	delete(m.clearedFields, maintenanceentry.FieldDate)
+}
+
+// SetScheduledDate sets the "scheduled_date" field.
+func (m *MaintenanceEntryMutation) SetScheduledDate(t time.Time) {
+	m.scheduled_date = &t
+}
+
+// ScheduledDate returns the value of the "scheduled_date" field in the mutation.
+func (m *MaintenanceEntryMutation) ScheduledDate() (r time.Time, exists bool) {
+	v := m.scheduled_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduledDate returns the old "scheduled_date" field's value of the MaintenanceEntry entity.
+// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceEntryMutation) OldScheduledDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduledDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduledDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduledDate: %w", err)
+	}
+	return oldValue.ScheduledDate, nil
+}
+
+// ClearScheduledDate clears the value of the "scheduled_date" field.
+func (m *MaintenanceEntryMutation) ClearScheduledDate() {
+	m.scheduled_date = nil
+	m.clearedFields[maintenanceentry.FieldScheduledDate] = struct{}{}
+}
+
+// ScheduledDateCleared returns if the "scheduled_date" field was cleared in this mutation.
+func (m *MaintenanceEntryMutation) ScheduledDateCleared() bool {
+	_, ok := m.clearedFields[maintenanceentry.FieldScheduledDate]
+	return ok
+}
+
+// ResetScheduledDate resets all changes to the "scheduled_date" field.
+func (m *MaintenanceEntryMutation) ResetScheduledDate() {
+	m.scheduled_date = nil
+	delete(m.clearedFields, maintenanceentry.FieldScheduledDate)
+}
+
+// SetName sets the "name" field.
+func (m *MaintenanceEntryMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MaintenanceEntryMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the MaintenanceEntry entity.
+// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceEntryMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MaintenanceEntryMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *MaintenanceEntryMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *MaintenanceEntryMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the MaintenanceEntry entity.
+// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceEntryMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *MaintenanceEntryMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[maintenanceentry.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *MaintenanceEntryMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[maintenanceentry.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *MaintenanceEntryMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, maintenanceentry.FieldDescription)
+}
+
+// SetCost sets the "cost" field.
+func (m *MaintenanceEntryMutation) SetCost(f float64) {
+	m.cost = &f
+	m.addcost = nil
+}
+
+// Cost returns the value of the "cost" field in the mutation.
+func (m *MaintenanceEntryMutation) Cost() (r float64, exists bool) {
+	v := m.cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCost returns the old "cost" field's value of the MaintenanceEntry entity.
+// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceEntryMutation) OldCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCost: %w", err)
+	}
+	return oldValue.Cost, nil
+}
+
+// AddCost adds f to the "cost" field.
+func (m *MaintenanceEntryMutation) AddCost(f float64) {
+	if m.addcost != nil {
+		*m.addcost += f
+	} else {
+		m.addcost = &f
+	}
+}
+
+// AddedCost returns the value that was added to the "cost" field in this mutation.
+func (m *MaintenanceEntryMutation) AddedCost() (r float64, exists bool) {
+	v := m.addcost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCost resets all changes to the "cost" field.
+func (m *MaintenanceEntryMutation) ResetCost() {
+	m.cost = nil
+	m.addcost = nil
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (m *MaintenanceEntryMutation) SetScheduleID(u uuid.UUID) {
+	m.schedule = &u
+}
+
+// ScheduleID returns the value of the "schedule_id" field in the mutation.
+func (m *MaintenanceEntryMutation) ScheduleID() (r uuid.UUID, exists bool) {
+	v := m.schedule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduleID returns the old "schedule_id" field's value of the MaintenanceEntry entity.
+// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceEntryMutation) OldScheduleID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduleID: %w", err)
+	}
+	return oldValue.ScheduleID, nil
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (m *MaintenanceEntryMutation) ClearScheduleID() {
+	m.schedule = nil
+	m.clearedFields[maintenanceentry.FieldScheduleID] = struct{}{}
+}
+
+// ScheduleIDCleared returns if the "schedule_id" field was cleared in this mutation.
+func (m *MaintenanceEntryMutation) ScheduleIDCleared() bool {
+	_, ok := m.clearedFields[maintenanceentry.FieldScheduleID]
+	return ok
+}
+
+// ResetScheduleID resets all changes to the "schedule_id" field.
+func (m *MaintenanceEntryMutation) ResetScheduleID() {
+	m.schedule = nil
+	delete(m.clearedFields, maintenanceentry.FieldScheduleID)
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *MaintenanceEntryMutation) ClearItem() {
+	m.cleareditem = true
+	m.clearedFields[maintenanceentry.FieldItemID] = struct{}{}
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *MaintenanceEntryMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *MaintenanceEntryMutation) ItemIDs() (ids []uuid.UUID) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *MaintenanceEntryMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// ClearSchedule clears the "schedule" edge to the MaintenanceSchedule entity.
+func (m *MaintenanceEntryMutation) ClearSchedule() {
+	m.clearedschedule = true
+	m.clearedFields[maintenanceentry.FieldScheduleID] = struct{}{}
+}
+
+// ScheduleCleared reports if the "schedule" edge to the MaintenanceSchedule entity was cleared.
+func (m *MaintenanceEntryMutation) ScheduleCleared() bool {
+	return m.ScheduleIDCleared() || m.clearedschedule
+}
+
+// ScheduleIDs returns the "schedule" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ScheduleID instead. It exists only for internal usage by the builders.
+func (m *MaintenanceEntryMutation) ScheduleIDs() (ids []uuid.UUID) {
+	if id := m.schedule; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSchedule resets all changes to the "schedule" edge.
+func (m *MaintenanceEntryMutation) ResetSchedule() {
+	m.schedule = nil
+	m.clearedschedule = false
+}
+
+// Where appends a list predicates to the MaintenanceEntryMutation builder.
+func (m *MaintenanceEntryMutation) Where(ps ...predicate.MaintenanceEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MaintenanceEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MaintenanceEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MaintenanceEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MaintenanceEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MaintenanceEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MaintenanceEntry).
+func (m *MaintenanceEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MaintenanceEntryMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, maintenanceentry.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, maintenanceentry.FieldUpdatedAt)
+	}
+	if m.item != nil {
+		fields = append(fields, maintenanceentry.FieldItemID)
+	}
+	if m.date != nil {
+		fields = append(fields, maintenanceentry.FieldDate)
+	}
+	if m.scheduled_date != nil {
+		fields = append(fields, maintenanceentry.FieldScheduledDate)
+	}
+	if m.name != nil {
+		fields = append(fields, maintenanceentry.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, maintenanceentry.FieldDescription)
+	}
+	if m.cost != nil {
+		fields = append(fields, maintenanceentry.FieldCost)
+	}
+	if m.schedule != nil {
+		fields = append(fields, maintenanceentry.FieldScheduleID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MaintenanceEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case maintenanceentry.FieldCreatedAt:
+		return m.CreatedAt()
+	case maintenanceentry.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case maintenanceentry.FieldItemID:
+		return m.ItemID()
+	case maintenanceentry.FieldDate:
+		return m.Date()
+	case maintenanceentry.FieldScheduledDate:
+		return m.ScheduledDate()
+	case maintenanceentry.FieldName:
+		return m.Name()
+	case maintenanceentry.FieldDescription:
+		return m.Description()
+	case maintenanceentry.FieldCost:
+		return m.Cost()
+	case maintenanceentry.FieldScheduleID:
+		return m.ScheduleID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MaintenanceEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case maintenanceentry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case maintenanceentry.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case maintenanceentry.FieldItemID:
+		return m.OldItemID(ctx)
+	case maintenanceentry.FieldDate:
+		return m.OldDate(ctx)
+	case maintenanceentry.FieldScheduledDate:
+		return m.OldScheduledDate(ctx)
+	case maintenanceentry.FieldName:
+		return m.OldName(ctx)
+	case maintenanceentry.FieldDescription:
+		return m.OldDescription(ctx)
+	case maintenanceentry.FieldCost:
+		return m.OldCost(ctx)
+	case maintenanceentry.FieldScheduleID:
+		return m.OldScheduleID(ctx)
+	}
+	return nil, fmt.Errorf("unknown MaintenanceEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaintenanceEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case maintenanceentry.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case maintenanceentry.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case maintenanceentry.FieldItemID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case maintenanceentry.FieldDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
+		return nil
+	case maintenanceentry.FieldScheduledDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledDate(v)
+		return nil
+	case maintenanceentry.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case maintenanceentry.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case maintenanceentry.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCost(v)
+		return nil
+	case maintenanceentry.FieldScheduleID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduleID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MaintenanceEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addcost != nil {
+		fields = append(fields, maintenanceentry.FieldCost)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MaintenanceEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case maintenanceentry.FieldCost:
+		return m.AddedCost()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaintenanceEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case maintenanceentry.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCost(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MaintenanceEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(maintenanceentry.FieldDate) {
+		fields = append(fields, maintenanceentry.FieldDate)
+	}
+	if m.FieldCleared(maintenanceentry.FieldScheduledDate) {
+		fields = append(fields, maintenanceentry.FieldScheduledDate)
+	}
+	if m.FieldCleared(maintenanceentry.FieldDescription) {
+		fields = append(fields, maintenanceentry.FieldDescription)
+	}
+	if m.FieldCleared(maintenanceentry.FieldScheduleID) {
+		fields = append(fields, maintenanceentry.FieldScheduleID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MaintenanceEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MaintenanceEntryMutation) ClearField(name string) error {
+	switch name {
+	case maintenanceentry.FieldDate:
+		m.ClearDate()
+		return nil
+	case maintenanceentry.FieldScheduledDate:
+		m.ClearScheduledDate()
+		return nil
+	case maintenanceentry.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case maintenanceentry.FieldScheduleID:
+		m.ClearScheduleID()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MaintenanceEntryMutation) ResetField(name string) error {
+	switch name {
+	case maintenanceentry.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case maintenanceentry.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case maintenanceentry.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case maintenanceentry.FieldDate:
+		m.ResetDate()
+		return nil
+	case maintenanceentry.FieldScheduledDate:
+		m.ResetScheduledDate()
+		return nil
+	case maintenanceentry.FieldName:
+		m.ResetName()
+		return nil
+	case maintenanceentry.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case maintenanceentry.FieldCost:
+		m.ResetCost()
+		return nil
+	case maintenanceentry.FieldScheduleID:
+		m.ResetScheduleID()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MaintenanceEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.item != nil {
+		edges = append(edges, maintenanceentry.EdgeItem)
+	}
+	if m.schedule != nil {
+		edges = append(edges, maintenanceentry.EdgeSchedule)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MaintenanceEntryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case maintenanceentry.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case maintenanceentry.EdgeSchedule:
+		if id := m.schedule; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MaintenanceEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MaintenanceEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MaintenanceEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareditem {
+		edges = append(edges, maintenanceentry.EdgeItem)
+	}
+	if m.clearedschedule {
+		edges = append(edges, maintenanceentry.EdgeSchedule)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MaintenanceEntryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case maintenanceentry.EdgeItem:
+		return m.cleareditem
+	case maintenanceentry.EdgeSchedule:
+		return m.clearedschedule
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MaintenanceEntryMutation) ClearEdge(name string) error {
+	switch name {
+	case maintenanceentry.EdgeItem:
+		m.ClearItem()
+		return nil
+	case maintenanceentry.EdgeSchedule:
+		m.ClearSchedule()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MaintenanceEntryMutation) ResetEdge(name string) error {
+	switch name {
+	case maintenanceentry.EdgeItem:
+		m.ResetItem()
+		return nil
+	case maintenanceentry.EdgeSchedule:
+		m.ResetSchedule()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceEntry edge %s", name)
+}
+
+// MaintenanceScheduleMutation represents an operation that mutates the MaintenanceSchedule nodes in the graph.
+type MaintenanceScheduleMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	created_at            *time.Time
+	updated_at            *time.Time
+	name                  *string
+	description           *string
+	rule                  *string
+	notify_days_before    *int
+	addnotify_days_before *int
+	clearedFields         map[string]struct{}
+	item                  *uuid.UUID
+	cleareditem           bool
+	entries               map[uuid.UUID]struct{}
+	removedentries        map[uuid.UUID]struct{}
+	clearedentries        bool
+	done                  bool
+	oldValue              func(context.Context) (*MaintenanceSchedule, error)
+	predicates            []predicate.MaintenanceSchedule
+}
+
+var _ ent.Mutation = (*MaintenanceScheduleMutation)(nil)
+
+// maintenancescheduleOption allows management of the mutation configuration using functional options.
+type maintenancescheduleOption func(*MaintenanceScheduleMutation)
+
+// newMaintenanceScheduleMutation creates new mutation for the MaintenanceSchedule entity.
+func newMaintenanceScheduleMutation(c config, op Op, opts ...maintenancescheduleOption) *MaintenanceScheduleMutation {
+	m := &MaintenanceScheduleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMaintenanceSchedule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMaintenanceScheduleID sets the ID field of the mutation.
+func withMaintenanceScheduleID(id uuid.UUID) maintenancescheduleOption {
+	return func(m *MaintenanceScheduleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MaintenanceSchedule
+		)
+		m.oldValue = func(ctx context.Context) (*MaintenanceSchedule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MaintenanceSchedule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMaintenanceSchedule sets the old MaintenanceSchedule of the mutation.
+func withMaintenanceSchedule(node *MaintenanceSchedule) maintenancescheduleOption {
+	return func(m *MaintenanceScheduleMutation) {
+		m.oldValue = func(context.Context) (*MaintenanceSchedule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MaintenanceScheduleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MaintenanceScheduleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MaintenanceSchedule entities.
+func (m *MaintenanceScheduleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MaintenanceScheduleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MaintenanceScheduleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MaintenanceSchedule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MaintenanceScheduleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MaintenanceScheduleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MaintenanceSchedule entity.
+// If the MaintenanceSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceScheduleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MaintenanceScheduleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MaintenanceScheduleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MaintenanceScheduleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the MaintenanceSchedule entity.
+// If the MaintenanceSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceScheduleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MaintenanceScheduleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetItemID sets the "item_id" field.
+func (m *MaintenanceScheduleMutation) SetItemID(u uuid.UUID) {
+	m.item = &u
+}
+
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *MaintenanceScheduleMutation) ItemID() (r uuid.UUID, exists bool) {
+	v := m.item
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemID returns the old "item_id" field's value of the MaintenanceSchedule entity.
+// If the MaintenanceSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceScheduleMutation) OldItemID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+	}
+	return oldValue.ItemID, nil
+}
+
+// ResetItemID resets all changes to the "item_id" field.
+func (m *MaintenanceScheduleMutation) ResetItemID() {
+	m.item = nil
+}
+
+// SetName sets the "name" field.
+func (m *MaintenanceScheduleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MaintenanceScheduleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the MaintenanceSchedule entity.
+// If the MaintenanceSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceScheduleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MaintenanceScheduleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *MaintenanceScheduleMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *MaintenanceScheduleMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the MaintenanceSchedule entity.
+// If the MaintenanceSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceScheduleMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *MaintenanceScheduleMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[maintenanceschedule.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *MaintenanceScheduleMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[maintenanceschedule.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *MaintenanceScheduleMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, maintenanceschedule.FieldDescription)
+}
+
+// SetRule sets the "rule" field.
+func (m *MaintenanceScheduleMutation) SetRule(s string) {
+	m.rule = &s
+}
+
+// Rule returns the value of the "rule" field in the mutation.
+func (m *MaintenanceScheduleMutation) Rule() (r string, exists bool) {
+	v := m.rule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRule returns the old "rule" field's value of the MaintenanceSchedule entity.
+// If the MaintenanceSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceScheduleMutation) OldRule(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRule is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRule requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRule: %w", err)
+	}
+	return oldValue.Rule, nil
+}
+
+// ResetRule resets all changes to the "rule" field.
+func (m *MaintenanceScheduleMutation) ResetRule() {
+	m.rule = nil
+}
+
+// SetNotifyDaysBefore sets the "notify_days_before" field.
+func (m *MaintenanceScheduleMutation) SetNotifyDaysBefore(i int) {
+	m.notify_days_before = &i
+	m.addnotify_days_before = nil
+}
+
+// NotifyDaysBefore returns the value of the "notify_days_before" field in the mutation.
+func (m *MaintenanceScheduleMutation) NotifyDaysBefore() (r int, exists bool) {
+	v := m.notify_days_before
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotifyDaysBefore returns the old "notify_days_before" field's value of the MaintenanceSchedule entity.
+// If the MaintenanceSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceScheduleMutation) OldNotifyDaysBefore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotifyDaysBefore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotifyDaysBefore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotifyDaysBefore: %w", err)
+	}
+	return oldValue.NotifyDaysBefore, nil
+}
+
+// AddNotifyDaysBefore adds i to the "notify_days_before" field.
+func (m *MaintenanceScheduleMutation) AddNotifyDaysBefore(i int) {
+	if m.addnotify_days_before != nil {
+		*m.addnotify_days_before += i
+	} else {
+		m.addnotify_days_before = &i
+	}
+}
+
+// AddedNotifyDaysBefore returns the value that was added to the "notify_days_before" field in this mutation.
+func (m *MaintenanceScheduleMutation) AddedNotifyDaysBefore() (r int, exists bool) {
+	v := m.addnotify_days_before
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNotifyDaysBefore resets all changes to the "notify_days_before" field.
+func (m *MaintenanceScheduleMutation) ResetNotifyDaysBefore() {
+	m.notify_days_before = nil
+	m.addnotify_days_before = nil
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *MaintenanceScheduleMutation) ClearItem() {
+	m.cleareditem = true
+	m.clearedFields[maintenanceschedule.FieldItemID] = struct{}{}
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *MaintenanceScheduleMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *MaintenanceScheduleMutation) ItemIDs() (ids []uuid.UUID) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *MaintenanceScheduleMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// AddEntryIDs adds the "entries" edge to the MaintenanceEntry entity by ids.
+func (m *MaintenanceScheduleMutation) AddEntryIDs(ids ...uuid.UUID) {
+	if m.entries == nil {
+		m.entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEntries clears the "entries" edge to the MaintenanceEntry entity.
+func (m *MaintenanceScheduleMutation) ClearEntries() {
+	m.clearedentries = true
+}
+
+// EntriesCleared reports if the "entries" edge to the MaintenanceEntry entity was cleared.
+func (m *MaintenanceScheduleMutation) EntriesCleared() bool {
+	return m.clearedentries
+}
+
+// RemoveEntryIDs removes the "entries" edge to the MaintenanceEntry entity by IDs.
+func (m *MaintenanceScheduleMutation) RemoveEntryIDs(ids ...uuid.UUID) {
+	if m.removedentries == nil {
+		m.removedentries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.entries, ids[i])
+		m.removedentries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEntries returns the removed IDs of the "entries" edge to the MaintenanceEntry entity.
+func (m *MaintenanceScheduleMutation) RemovedEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedentries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EntriesIDs returns the "entries" edge IDs in the mutation.
+func (m *MaintenanceScheduleMutation) EntriesIDs() (ids []uuid.UUID) {
+	for id := range m.entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEntries resets all changes to the "entries" edge.
+func (m *MaintenanceScheduleMutation) ResetEntries() {
+	m.entries = nil
+	m.clearedentries = false
+	m.removedentries = nil
+}
+
+// Where appends a list predicates to the MaintenanceScheduleMutation builder.
+func (m *MaintenanceScheduleMutation) Where(ps ...predicate.MaintenanceSchedule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MaintenanceScheduleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MaintenanceScheduleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MaintenanceSchedule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MaintenanceScheduleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MaintenanceScheduleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MaintenanceSchedule).
+func (m *MaintenanceScheduleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MaintenanceScheduleMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, maintenanceschedule.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, maintenanceschedule.FieldUpdatedAt)
+	}
+	if m.item != nil {
+		fields = append(fields, maintenanceschedule.FieldItemID)
+	}
+	if m.name != nil {
+		fields = append(fields, maintenanceschedule.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, maintenanceschedule.FieldDescription)
+	}
+	if m.rule != nil {
+		fields = append(fields, maintenanceschedule.FieldRule)
+	}
+	if m.notify_days_before != nil {
+		fields = append(fields, maintenanceschedule.FieldNotifyDaysBefore)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MaintenanceScheduleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case maintenanceschedule.FieldCreatedAt:
+		return m.CreatedAt()
+	case maintenanceschedule.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case maintenanceschedule.FieldItemID:
+		return m.ItemID()
+	case maintenanceschedule.FieldName:
+		return m.Name()
+	case maintenanceschedule.FieldDescription:
+		return m.Description()
+	case maintenanceschedule.FieldRule:
+		return m.Rule()
+	case maintenanceschedule.FieldNotifyDaysBefore:
+		return m.NotifyDaysBefore()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MaintenanceScheduleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case maintenanceschedule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case maintenanceschedule.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case maintenanceschedule.FieldItemID:
+		return m.OldItemID(ctx)
+	case maintenanceschedule.FieldName:
+		return m.OldName(ctx)
+	case maintenanceschedule.FieldDescription:
+		return m.OldDescription(ctx)
+	case maintenanceschedule.FieldRule:
+		return m.OldRule(ctx)
+	case maintenanceschedule.FieldNotifyDaysBefore:
+		return m.OldNotifyDaysBefore(ctx)
+	}
+	return nil, fmt.Errorf("unknown MaintenanceSchedule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaintenanceScheduleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case maintenanceschedule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case maintenanceschedule.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case maintenanceschedule.FieldItemID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case maintenanceschedule.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case maintenanceschedule.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case maintenanceschedule.FieldRule:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRule(v)
+		return nil
+	case maintenanceschedule.FieldNotifyDaysBefore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotifyDaysBefore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceSchedule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MaintenanceScheduleMutation) AddedFields() []string {
+	var fields []string
+	if m.addnotify_days_before != nil {
+		fields = append(fields, maintenanceschedule.FieldNotifyDaysBefore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MaintenanceScheduleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case maintenanceschedule.FieldNotifyDaysBefore:
+		return m.AddedNotifyDaysBefore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaintenanceScheduleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case maintenanceschedule.FieldNotifyDaysBefore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNotifyDaysBefore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceSchedule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MaintenanceScheduleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(maintenanceschedule.FieldDescription) {
+		fields = append(fields, maintenanceschedule.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MaintenanceScheduleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MaintenanceScheduleMutation) ClearField(name string) error {
+	switch name {
+	case maintenanceschedule.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceSchedule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MaintenanceScheduleMutation) ResetField(name string) error {
+	switch name {
+	case maintenanceschedule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case maintenanceschedule.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case maintenanceschedule.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case maintenanceschedule.FieldName:
+		m.ResetName()
+		return nil
+	case maintenanceschedule.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case maintenanceschedule.FieldRule:
+		m.ResetRule()
+		return nil
+	case maintenanceschedule.FieldNotifyDaysBefore:
+		m.ResetNotifyDaysBefore()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceSchedule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MaintenanceScheduleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.item != nil {
+		edges = append(edges, maintenanceschedule.EdgeItem)
+	}
+	if m.entries != nil {
+		edges = append(edges, maintenanceschedule.EdgeEntries)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MaintenanceScheduleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case maintenanceschedule.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case maintenanceschedule.EdgeEntries:
+		ids := make([]ent.Value, 0, len(m.entries))
+		for id := range m.entries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MaintenanceScheduleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedentries != nil {
+		edges = append(edges, maintenanceschedule.EdgeEntries)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MaintenanceScheduleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case maintenanceschedule.EdgeEntries:
+		ids := make([]ent.Value, 0, len(m.removedentries))
+		for id := range m.removedentries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MaintenanceScheduleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareditem {
+		edges = append(edges, maintenanceschedule.EdgeItem)
+	}
+	if m.clearedentries {
+		edges = append(edges, maintenanceschedule.EdgeEntries)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MaintenanceScheduleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case maintenanceschedule.EdgeItem:
+		return m.cleareditem
+	case maintenanceschedule.EdgeEntries:
+		return m.clearedentries
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MaintenanceScheduleMutation) ClearEdge(name string) error {
+	switch name {
+	case maintenanceschedule.EdgeItem:
+		m.ClearItem()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceSchedule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MaintenanceScheduleMutation) ResetEdge(name string) error {
+	switch name {
+	case maintenanceschedule.EdgeItem:
+		m.ResetItem()
+		return nil
+	case maintenanceschedule.EdgeEntries:
+		m.ResetEntries()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceSchedule edge %s", name)
+}
+
+// NotificationReminderMutation represents an operation that mutates the NotificationReminder nodes in the graph.
+type NotificationReminderMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	item_id       *uuid.UUID
+	target        *string
+	title         *string
+	due_date      *time.Time
+	lead_days     *int
+	addlead_days  *int
+	clearedFields map[string]struct{}
+	group         *uuid.UUID
+	clearedgroup  bool
+	rule          *uuid.UUID
+	clearedrule   bool
+	done          bool
+	oldValue      func(context.Context) (*NotificationReminder, error)
+	predicates    []predicate.NotificationReminder
+}
+
+var _ ent.Mutation = (*NotificationReminderMutation)(nil)
+
+// notificationreminderOption allows management of the mutation configuration using functional options.
+type notificationreminderOption func(*NotificationReminderMutation)
+
+// newNotificationReminderMutation creates new mutation for the NotificationReminder entity.
+func newNotificationReminderMutation(c config, op Op, opts ...notificationreminderOption) *NotificationReminderMutation {
+	m := &NotificationReminderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotificationReminder,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationReminderID sets the ID field of the mutation.
+func withNotificationReminderID(id uuid.UUID) notificationreminderOption {
+	return func(m *NotificationReminderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NotificationReminder
+		)
+		m.oldValue = func(ctx context.Context) (*NotificationReminder, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NotificationReminder.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotificationReminder sets the old NotificationReminder of the mutation.
+func withNotificationReminder(node *NotificationReminder) notificationreminderOption {
+	return func(m *NotificationReminderMutation) {
+		m.oldValue = func(context.Context) (*NotificationReminder, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationReminderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationReminderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NotificationReminder entities.
+func (m *NotificationReminderMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationReminderMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationReminderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NotificationReminder.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationReminderMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationReminderMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the NotificationReminder entity.
+// If the NotificationReminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationReminderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationReminderMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NotificationReminderMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NotificationReminderMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the NotificationReminder entity.
+// If the NotificationReminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationReminderMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NotificationReminderMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetGroupID sets the "group_id" field.
+func (m *NotificationReminderMutation) SetGroupID(u uuid.UUID) {
+	m.group = &u
+}
+
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *NotificationReminderMutation) GroupID() (r uuid.UUID, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupID returns the old "group_id" field's value of the NotificationReminder entity.
+// If the NotificationReminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationReminderMutation) OldGroupID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+	}
+	return oldValue.GroupID, nil
+}
+
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *NotificationReminderMutation) ResetGroupID() {
+	m.group = nil
+}
+
+// SetRuleID sets the "rule_id" field.
+func (m *NotificationReminderMutation) SetRuleID(u uuid.UUID) {
+	m.rule = &u
+}
+
+// RuleID returns the value of the "rule_id" field in the mutation.
+func (m *NotificationReminderMutation) RuleID() (r uuid.UUID, exists bool) {
+	v := m.rule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRuleID returns the old "rule_id" field's value of the NotificationReminder entity.
+// If the NotificationReminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationReminderMutation) OldRuleID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRuleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRuleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRuleID: %w", err)
+	}
+	return oldValue.RuleID, nil
+}
+
+// ResetRuleID resets all changes to the "rule_id" field.
+func (m *NotificationReminderMutation) ResetRuleID() {
+	m.rule = nil
+}
+
+// SetItemID sets the "item_id" field.
+func (m *NotificationReminderMutation) SetItemID(u uuid.UUID) {
+	m.item_id = &u
+}
+
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *NotificationReminderMutation) ItemID() (r uuid.UUID, exists bool) {
+	v := m.item_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemID returns the old "item_id" field's value of the NotificationReminder entity.
+// If the NotificationReminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationReminderMutation) OldItemID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+	}
+	return oldValue.ItemID, nil
+}
+
+// ResetItemID resets all changes to the "item_id" field.
+func (m *NotificationReminderMutation) ResetItemID() {
+	m.item_id = nil
+}
+
+// SetTarget sets the "target" field.
+func (m *NotificationReminderMutation) SetTarget(s string) {
+	m.target = &s
+}
+
+// Target returns the value of the "target" field in the mutation.
+func (m *NotificationReminderMutation) Target() (r string, exists bool) {
+	v := m.target
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTarget returns the old "target" field's value of the NotificationReminder entity.
+// If the NotificationReminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationReminderMutation) OldTarget(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTarget is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTarget requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTarget: %w", err)
+	}
+	return oldValue.Target, nil
+}
+
+// ResetTarget resets all changes to the "target" field.
+func (m *NotificationReminderMutation) ResetTarget() {
+	m.target = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *NotificationReminderMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *NotificationReminderMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the NotificationReminder entity.
+// If the NotificationReminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationReminderMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *NotificationReminderMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDueDate sets the "due_date" field.
+func (m *NotificationReminderMutation) SetDueDate(t time.Time) {
+	m.due_date = &t
+}
+
+// DueDate returns the value of the "due_date" field in the mutation.
+func (m *NotificationReminderMutation) DueDate() (r time.Time, exists bool) {
+	v := m.due_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDueDate returns the old "due_date" field's value of the NotificationReminder entity.
+// If the NotificationReminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationReminderMutation) OldDueDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueDate: %w", err)
+	}
+	return oldValue.DueDate, nil
+}
+
+// ResetDueDate resets all changes to the "due_date" field.
+func (m *NotificationReminderMutation) ResetDueDate() {
+	m.due_date = nil
+}
+
+// SetLeadDays sets the "lead_days" field.
+func (m *NotificationReminderMutation) SetLeadDays(i int) {
+	m.lead_days = &i
+	m.addlead_days = nil
+}
+
+// LeadDays returns the value of the "lead_days" field in the mutation.
+func (m *NotificationReminderMutation) LeadDays() (r int, exists bool) {
+	v := m.lead_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeadDays returns the old "lead_days" field's value of the NotificationReminder entity.
+// If the NotificationReminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationReminderMutation) OldLeadDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeadDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeadDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeadDays: %w", err)
+	}
+	return oldValue.LeadDays, nil
+}
+
+// AddLeadDays adds i to the "lead_days" field.
+func (m *NotificationReminderMutation) AddLeadDays(i int) {
+	if m.addlead_days != nil {
+		*m.addlead_days += i
+	} else {
+		m.addlead_days = &i
+	}
+}
+
+// AddedLeadDays returns the value that was added to the "lead_days" field in this mutation.
+func (m *NotificationReminderMutation) AddedLeadDays() (r int, exists bool) {
+	v := m.addlead_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLeadDays resets all changes to the "lead_days" field.
+func (m *NotificationReminderMutation) ResetLeadDays() {
+	m.lead_days = nil
+	m.addlead_days = nil
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *NotificationReminderMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[notificationreminder.FieldGroupID] = struct{}{}
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *NotificationReminderMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *NotificationReminderMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *NotificationReminderMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// ClearRule clears the "rule" edge to the NotificationRule entity.
+func (m *NotificationReminderMutation) ClearRule() {
+	m.clearedrule = true
+	m.clearedFields[notificationreminder.FieldRuleID] = struct{}{}
+}
+
+// RuleCleared reports if the "rule" edge to the NotificationRule entity was cleared.
+func (m *NotificationReminderMutation) RuleCleared() bool {
+	return m.clearedrule
+}
+
+// RuleIDs returns the "rule" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RuleID instead. It exists only for internal usage by the builders.
+func (m *NotificationReminderMutation) RuleIDs() (ids []uuid.UUID) {
+	if id := m.rule; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRule resets all changes to the "rule" edge.
+func (m *NotificationReminderMutation) ResetRule() {
+	m.rule = nil
+	m.clearedrule = false
+}
+
+// Where appends a list predicates to the NotificationReminderMutation builder.
+func (m *NotificationReminderMutation) Where(ps ...predicate.NotificationReminder) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationReminderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationReminderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationReminder, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationReminderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationReminderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NotificationReminder).
+func (m *NotificationReminderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationReminderMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, notificationreminder.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, notificationreminder.FieldUpdatedAt)
+	}
+	if m.group != nil {
+		fields = append(fields, notificationreminder.FieldGroupID)
+	}
+	if m.rule != nil {
+		fields = append(fields, notificationreminder.FieldRuleID)
+	}
+	if m.item_id != nil {
+		fields = append(fields, notificationreminder.FieldItemID)
+	}
+	if m.target != nil {
+		fields = append(fields, notificationreminder.FieldTarget)
+	}
+	if m.title != nil {
+		fields = append(fields, notificationreminder.FieldTitle)
+	}
+	if m.due_date != nil {
+		fields = append(fields, notificationreminder.FieldDueDate)
+	}
+	if m.lead_days != nil {
+		fields = append(fields, notificationreminder.FieldLeadDays)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationReminderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notificationreminder.FieldCreatedAt:
+		return m.CreatedAt()
+	case notificationreminder.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case notificationreminder.FieldGroupID:
+		return m.GroupID()
+	case notificationreminder.FieldRuleID:
+		return m.RuleID()
+	case notificationreminder.FieldItemID:
+		return m.ItemID()
+	case notificationreminder.FieldTarget:
+		return m.Target()
+	case notificationreminder.FieldTitle:
+		return m.Title()
+	case notificationreminder.FieldDueDate:
+		return m.DueDate()
+	case notificationreminder.FieldLeadDays:
+		return m.LeadDays()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationReminderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notificationreminder.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case notificationreminder.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case notificationreminder.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case notificationreminder.FieldRuleID:
+		return m.OldRuleID(ctx)
+	case notificationreminder.FieldItemID:
+		return m.OldItemID(ctx)
+	case notificationreminder.FieldTarget:
+		return m.OldTarget(ctx)
+	case notificationreminder.FieldTitle:
+		return m.OldTitle(ctx)
+	case notificationreminder.FieldDueDate:
+		return m.OldDueDate(ctx)
+	case notificationreminder.FieldLeadDays:
+		return m.OldLeadDays(ctx)
+	}
+	return nil, fmt.Errorf("unknown NotificationReminder field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationReminderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notificationreminder.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case notificationreminder.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case notificationreminder.FieldGroupID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupID(v)
+		return nil
+	case notificationreminder.FieldRuleID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRuleID(v)
+		return nil
+	case notificationreminder.FieldItemID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case notificationreminder.FieldTarget:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTarget(v)
+		return nil
+	case notificationreminder.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case notificationreminder.FieldDueDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueDate(v)
+		return nil
+	case notificationreminder.FieldLeadDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeadDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationReminder field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationReminderMutation) AddedFields() []string {
+	var fields []string
+	if m.addlead_days != nil {
+		fields = append(fields, notificationreminder.FieldLeadDays)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationReminderMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case notificationreminder.FieldLeadDays:
+		return m.AddedLeadDays()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationReminderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case notificationreminder.FieldLeadDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLeadDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationReminder numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationReminderMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationReminderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationReminderMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown NotificationReminder nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationReminderMutation) ResetField(name string) error {
+	switch name {
+	case notificationreminder.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case notificationreminder.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case notificationreminder.FieldGroupID:
+		m.ResetGroupID()
+		return nil
+	case notificationreminder.FieldRuleID:
+		m.ResetRuleID()
+		return nil
+	case notificationreminder.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case notificationreminder.FieldTarget:
+		m.ResetTarget()
+		return nil
+	case notificationreminder.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case notificationreminder.FieldDueDate:
+		m.ResetDueDate()
+		return nil
+	case notificationreminder.FieldLeadDays:
+		m.ResetLeadDays()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationReminder field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationReminderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.group != nil {
+		edges = append(edges, notificationreminder.EdgeGroup)
+	}
+	if m.rule != nil {
+		edges = append(edges, notificationreminder.EdgeRule)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationReminderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notificationreminder.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case notificationreminder.EdgeRule:
+		if id := m.rule; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationReminderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationReminderMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationReminderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgroup {
+		edges = append(edges, notificationreminder.EdgeGroup)
+	}
+	if m.clearedrule {
+		edges = append(edges, notificationreminder.EdgeRule)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationReminderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notificationreminder.EdgeGroup:
+		return m.clearedgroup
+	case notificationreminder.EdgeRule:
+		return m.clearedrule
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationReminderMutation) ClearEdge(name string) error {
+	switch name {
+	case notificationreminder.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	case notificationreminder.EdgeRule:
+		m.ClearRule()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationReminder unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationReminderMutation) ResetEdge(name string) error {
+	switch name {
+	case notificationreminder.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case notificationreminder.EdgeRule:
+		m.ResetRule()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationReminder edge %s", name)
+}
+
+// NotificationRuleMutation represents an operation that mutates the NotificationRule nodes in the graph.
+type NotificationRuleMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	created_at       *time.Time
+	updated_at       *time.Time
+	name             *string
+	kind             *notificationrule.Kind
+	custom_field     *string
+	lead_days        *[]int
+	appendlead_days  []int
+	is_active        *bool
+	clearedFields    map[string]struct{}
+	group            *uuid.UUID
+	clearedgroup     bool
+	reminders        map[uuid.UUID]struct{}
+	removedreminders map[uuid.UUID]struct{}
+	clearedreminders bool
+	done             bool
+	oldValue         func(context.Context) (*NotificationRule, error)
+	predicates       []predicate.NotificationRule
+}
+
+var _ ent.Mutation = (*NotificationRuleMutation)(nil)
+
+// notificationruleOption allows management of the mutation configuration using functional options.
+type notificationruleOption func(*NotificationRuleMutation)
+
+// newNotificationRuleMutation creates new mutation for the NotificationRule entity.
+func newNotificationRuleMutation(c config, op Op, opts ...notificationruleOption) *NotificationRuleMutation {
+	m := &NotificationRuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotificationRule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationRuleID sets the ID field of the mutation.
+func withNotificationRuleID(id uuid.UUID) notificationruleOption {
+	return func(m *NotificationRuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NotificationRule
+		)
+		m.oldValue = func(ctx context.Context) (*NotificationRule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NotificationRule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotificationRule sets the old NotificationRule of the mutation.
+func withNotificationRule(node *NotificationRule) notificationruleOption {
+	return func(m *NotificationRuleMutation) {
+		m.oldValue = func(context.Context) (*NotificationRule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationRuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationRuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NotificationRule entities.
+func (m *NotificationRuleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationRuleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationRuleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NotificationRule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationRuleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationRuleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationRuleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NotificationRuleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NotificationRuleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationRuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NotificationRuleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetGroupID sets the "group_id" field.
+func (m *NotificationRuleMutation) SetGroupID(u uuid.UUID) {
+	m.group = &u
+}
+
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *NotificationRuleMutation) GroupID() (r uuid.UUID, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupID returns the old "group_id" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationRuleMutation) OldGroupID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+	}
+	return oldValue.GroupID, nil
+}
+
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *NotificationRuleMutation) ResetGroupID() {
+	m.group = nil
+}
+
+// SetName sets the "name" field.
+func (m *NotificationRuleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *NotificationRuleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationRuleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *NotificationRuleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *NotificationRuleMutation) SetKind(n notificationrule.Kind) {
+	m.kind = &n
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *NotificationRuleMutation) Kind() (r notificationrule.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationRuleMutation) OldKind(ctx context.Context) (v notificationrule.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *NotificationRuleMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetCustomField sets the "custom_field" field.
+func (m *NotificationRuleMutation) SetCustomField(s string) {
+	m.custom_field = &s
+}
+
+// CustomField returns the value of the "custom_field" field in the mutation.
+func (m *NotificationRuleMutation) CustomField() (r string, exists bool) {
+	v := m.custom_field
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCustomField returns the old "custom_field" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationRuleMutation) OldCustomField(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCustomField is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCustomField requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCustomField: %w", err)
+	}
+	return oldValue.CustomField, nil
+}
+
+// ClearCustomField clears the value of the "custom_field" field.
+func (m *NotificationRuleMutation) ClearCustomField() {
+	m.custom_field = nil
+	m.clearedFields[notificationrule.FieldCustomField] = struct{}{}
+}
+
+// CustomFieldCleared returns if the "custom_field" field was cleared in this mutation.
+func (m *NotificationRuleMutation) CustomFieldCleared() bool {
+	_, ok := m.clearedFields[notificationrule.FieldCustomField]
+	return ok
+}
+
+// ResetCustomField resets all changes to the "custom_field" field.
+func (m *NotificationRuleMutation) ResetCustomField() {
+	m.custom_field = nil
+	delete(m.clearedFields, notificationrule.FieldCustomField)
+}
+
+// SetLeadDays sets the "lead_days" field.
+func (m *NotificationRuleMutation) SetLeadDays(i []int) {
+	m.lead_days = &i
+	m.appendlead_days = nil
+}
+
+// LeadDays returns the value of the "lead_days" field in the mutation.
+func (m *NotificationRuleMutation) LeadDays() (r []int, exists bool) {
+	v := m.lead_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeadDays returns the old "lead_days" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationRuleMutation) OldLeadDays(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeadDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeadDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeadDays: %w", err)
+	}
+	return oldValue.LeadDays, nil
+}
+
+// AppendLeadDays adds i to the "lead_days" field.
+func (m *NotificationRuleMutation) AppendLeadDays(i []int) {
+	m.appendlead_days = append(m.appendlead_days, i...)
+}
+
+// AppendedLeadDays returns the list of values that were appended to the "lead_days" field in this mutation.
+func (m *NotificationRuleMutation) AppendedLeadDays() ([]int, bool) {
+	if len(m.appendlead_days) == 0 {
+		return nil, false
+	}
+	return m.appendlead_days, true
+}
+
+// ResetLeadDays resets all changes to the "lead_days" field.
+func (m *NotificationRuleMutation) ResetLeadDays() {
+	m.lead_days = nil
+	m.appendlead_days = nil
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *NotificationRuleMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *NotificationRuleMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationRuleMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *NotificationRuleMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *NotificationRuleMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[notificationrule.FieldGroupID] = struct{}{}
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *NotificationRuleMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *NotificationRuleMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *NotificationRuleMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// AddReminderIDs adds the "reminders" edge to the NotificationReminder entity by ids.
+func (m *NotificationRuleMutation) AddReminderIDs(ids ...uuid.UUID) {
+	if m.reminders == nil {
+		m.reminders = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reminders[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReminders clears the "reminders" edge to the NotificationReminder entity.
+func (m *NotificationRuleMutation) ClearReminders() {
+	m.clearedreminders = true
+}
+
+// RemindersCleared reports if the "reminders" edge to the NotificationReminder entity was cleared.
+func (m *NotificationRuleMutation) RemindersCleared() bool {
+	return m.clearedreminders
+}
+
+// RemoveReminderIDs removes the "reminders" edge to the NotificationReminder entity by IDs.
+func (m *NotificationRuleMutation) RemoveReminderIDs(ids ...uuid.UUID) {
+	if m.removedreminders == nil {
+		m.removedreminders = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reminders, ids[i])
+		m.removedreminders[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReminders returns the removed IDs of the "reminders" edge to the NotificationReminder entity.
+func (m *NotificationRuleMutation) RemovedRemindersIDs() (ids []uuid.UUID) {
+	for id := range m.removedreminders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RemindersIDs returns the "reminders" edge IDs in the mutation.
+func (m *NotificationRuleMutation) RemindersIDs() (ids []uuid.UUID) {
+	for id := range m.reminders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReminders resets all changes to the "reminders" edge.
+func (m *NotificationRuleMutation) ResetReminders() {
+	m.reminders = nil
+	m.clearedreminders = false
+	m.removedreminders = nil
+}
+
+// Where appends a list predicates to the NotificationRuleMutation builder.
+func (m *NotificationRuleMutation) Where(ps ...predicate.NotificationRule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationRuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationRuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NotificationRule).
+func (m *NotificationRuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationRuleMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, notificationrule.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, notificationrule.FieldUpdatedAt)
+	}
+	if m.group != nil {
+		fields = append(fields, notificationrule.FieldGroupID)
+	}
+	if m.name != nil {
+		fields = append(fields, notificationrule.FieldName)
+	}
+	if m.kind != nil {
+		fields = append(fields, notificationrule.FieldKind)
+	}
+	if m.custom_field != nil {
+		fields = append(fields, notificationrule.FieldCustomField)
+	}
+	if m.lead_days != nil {
+		fields = append(fields, notificationrule.FieldLeadDays)
+	}
+	if m.is_active != nil {
+		fields = append(fields, notificationrule.FieldIsActive)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationRuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notificationrule.FieldCreatedAt:
+		return m.CreatedAt()
+	case notificationrule.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case notificationrule.FieldGroupID:
+		return m.GroupID()
+	case notificationrule.FieldName:
+		return m.Name()
+	case notificationrule.FieldKind:
+		return m.Kind()
+	case notificationrule.FieldCustomField:
+		return m.CustomField()
+	case notificationrule.FieldLeadDays:
+		return m.LeadDays()
+	case notificationrule.FieldIsActive:
+		return m.IsActive()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notificationrule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case notificationrule.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case notificationrule.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case notificationrule.FieldName:
+		return m.OldName(ctx)
+	case notificationrule.FieldKind:
+		return m.OldKind(ctx)
+	case notificationrule.FieldCustomField:
+		return m.OldCustomField(ctx)
+	case notificationrule.FieldLeadDays:
+		return m.OldLeadDays(ctx)
+	case notificationrule.FieldIsActive:
+		return m.OldIsActive(ctx)
+	}
+	return nil, fmt.Errorf("unknown NotificationRule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationRuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notificationrule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case notificationrule.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case notificationrule.FieldGroupID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupID(v)
+		return nil
+	case notificationrule.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case notificationrule.FieldKind:
+		v, ok := value.(notificationrule.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case notificationrule.FieldCustomField:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCustomField(v)
+		return nil
+	case notificationrule.FieldLeadDays:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeadDays(v)
+		return nil
+	case notificationrule.FieldIsActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsActive(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationRule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationRuleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationRuleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NotificationRule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationRuleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notificationrule.FieldCustomField) {
+		fields = append(fields, notificationrule.FieldCustomField)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationRuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationRuleMutation) ClearField(name string) error {
+	switch name {
+	case notificationrule.FieldCustomField:
+		m.ClearCustomField()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationRule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationRuleMutation) ResetField(name string) error {
+	switch name {
+	case notificationrule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case notificationrule.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case notificationrule.FieldGroupID:
+		m.ResetGroupID()
+		return nil
+	case notificationrule.FieldName:
+		m.ResetName()
+		return nil
+	case notificationrule.FieldKind:
+		m.ResetKind()
+		return nil
+	case notificationrule.FieldCustomField:
+		m.ResetCustomField()
+		return nil
+	case notificationrule.FieldLeadDays:
+		m.ResetLeadDays()
+		return nil
+	case notificationrule.FieldIsActive:
+		m.ResetIsActive()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationRule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.group != nil {
+		edges = append(edges, notificationrule.EdgeGroup)
+	}
+	if m.reminders != nil {
+		edges = append(edges, notificationrule.EdgeReminders)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationRuleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notificationrule.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case notificationrule.EdgeReminders:
+		ids := make([]ent.Value, 0, len(m.reminders))
+		for id := range m.reminders {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedreminders != nil {
+		edges = append(edges, notificationrule.EdgeReminders)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationRuleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case notificationrule.EdgeReminders:
+		ids := make([]ent.Value, 0, len(m.removedreminders))
+		for id := range m.removedreminders {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgroup {
+		edges = append(edges, notificationrule.EdgeGroup)
+	}
+	if m.clearedreminders {
+		edges = append(edges, notificationrule.EdgeReminders)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationRuleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notificationrule.EdgeGroup:
+		return m.clearedgroup
+	case notificationrule.EdgeReminders:
+		return m.clearedreminders
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationRuleMutation) ClearEdge(name string) error {
+	switch name {
+	case notificationrule.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationRule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationRuleMutation) ResetEdge(name string) error {
+	switch name {
+	case notificationrule.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case notificationrule.EdgeReminders:
+		m.ResetReminders()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationRule edge %s", name)
+}
+
+// NotificationTemplateMutation represents an operation that mutates the NotificationTemplate nodes in the graph.
+type NotificationTemplateMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	created_at      *time.Time
+	updated_at      *time.Time
+	_type           *notificationtemplate.Type
+	body            *string
+	clearedFields   map[string]struct{}
+	group           *uuid.UUID
+	clearedgroup    bool
+	notifier        *uuid.UUID
+	clearednotifier bool
+	done            bool
+	oldValue        func(context.Context) (*NotificationTemplate, error)
+	predicates      []predicate.NotificationTemplate
+}
+
+var _ ent.Mutation = (*NotificationTemplateMutation)(nil)
+
+// notificationtemplateOption allows management of the mutation configuration using functional options.
+type notificationtemplateOption func(*NotificationTemplateMutation)
+
+// newNotificationTemplateMutation creates new mutation for the NotificationTemplate entity.
+func newNotificationTemplateMutation(c config, op Op, opts ...notificationtemplateOption) *NotificationTemplateMutation {
+	m := &NotificationTemplateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotificationTemplate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationTemplateID sets the ID field of the mutation.
+func withNotificationTemplateID(id uuid.UUID) notificationtemplateOption {
+	return func(m *NotificationTemplateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NotificationTemplate
+		)
+		m.oldValue = func(ctx context.Context) (*NotificationTemplate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NotificationTemplate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotificationTemplate sets the old NotificationTemplate of the mutation.
+func withNotificationTemplate(node *NotificationTemplate) notificationtemplateOption {
+	return func(m *NotificationTemplateMutation) {
+		m.oldValue = func(context.Context) (*NotificationTemplate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationTemplateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationTemplateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NotificationTemplate entities.
+func (m *NotificationTemplateMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationTemplateMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationTemplateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NotificationTemplate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationTemplateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationTemplateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the NotificationTemplate entity.
+// If the NotificationTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationTemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationTemplateMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NotificationTemplateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NotificationTemplateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the NotificationTemplate entity.
+// If the NotificationTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationTemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NotificationTemplateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetGroupID sets the "group_id" field.
+func (m *NotificationTemplateMutation) SetGroupID(u uuid.UUID) {
+	m.group = &u
+}
+
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *NotificationTemplateMutation) GroupID() (r uuid.UUID, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupID returns the old "group_id" field's value of the NotificationTemplate entity.
+// If the NotificationTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationTemplateMutation) OldGroupID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+	}
+	return oldValue.GroupID, nil
+}
+
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *NotificationTemplateMutation) ResetGroupID() {
+	m.group = nil
+}
+
+// SetType sets the "type" field.
+func (m *NotificationTemplateMutation) SetType(n notificationtemplate.Type) {
+	m._type = &n
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *NotificationTemplateMutation) GetType() (r notificationtemplate.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the NotificationTemplate entity.
+// If the NotificationTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationTemplateMutation) OldType(ctx context.Context) (v notificationtemplate.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *NotificationTemplateMutation) ResetType() {
+	m._type = nil
+}
+
+// SetNotifierID sets the "notifier_id" field.
+func (m *NotificationTemplateMutation) SetNotifierID(u uuid.UUID) {
+	m.notifier = &u
+}
+
+// NotifierID returns the value of the "notifier_id" field in the mutation.
+func (m *NotificationTemplateMutation) NotifierID() (r uuid.UUID, exists bool) {
+	v := m.notifier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotifierID returns the old "notifier_id" field's value of the NotificationTemplate entity.
+// If the NotificationTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationTemplateMutation) OldNotifierID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotifierID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.attachments[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotifierID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotifierID: %w", err)
 	}
+	return oldValue.NotifierID, nil
 }
 
-// ClearAttachments clears the "attachments" edge to the Attachment entity.
-func (m *ItemMutation) ClearAttachments() {
-	m.clearedattachments = true
+// ClearNotifierID clears the value of the "notifier_id" field.
+func (m *NotificationTemplateMutation) ClearNotifierID() {
+	m.notifier = nil
+	m.clearedFields[notificationtemplate.FieldNotifierID] = struct{}{}
 }
 
-// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
-func (m *ItemMutation) AttachmentsCleared() bool {
-	return m.clearedattachments
+// NotifierIDCleared returns if the "notifier_id" field was cleared in this mutation.
+func (m *NotificationTemplateMutation) NotifierIDCleared() bool {
+	_, ok := m.clearedFields[notificationtemplate.FieldNotifierID]
+	return ok
 }
 
-// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
-func (m *ItemMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
-	if m.removedattachments == nil {
-		m.removedattachments = make(map[uuid.UUID]struct{})
+// ResetNotifierID resets all changes to the "notifier_id" field.
+func (m *NotificationTemplateMutation) ResetNotifierID() {
+	m.notifier = nil
+	delete(m.clearedFields, notificationtemplate.FieldNotifierID)
+}
+
+// SetBody sets the "body" field.
+func (m *NotificationTemplateMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *NotificationTemplateMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.attachments, ids[i])
-		m.removedattachments[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the NotificationTemplate entity.
+// If the NotificationTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationTemplateMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
 	}
+	return oldValue.Body, nil
 }
 
-// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
-func (m *ItemMutation) RemovedAttachmentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedattachments {
-		ids = append(ids, id)
+// ResetBody resets all changes to the "body" field.
+func (m *NotificationTemplateMutation) ResetBody() {
+	m.body = nil
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *NotificationTemplateMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[notificationtemplate.FieldGroupID] = struct{}{}
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *NotificationTemplateMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *NotificationTemplateMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
-func (m *ItemMutation) AttachmentsIDs() (ids []uuid.UUID) {
-	for id := range m.attachments {
-		ids = append(ids, id)
+// ResetGroup resets all changes to the "group" edge.
+func (m *NotificationTemplateMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// ClearNotifier clears the "notifier" edge to the Notifier entity.
+func (m *NotificationTemplateMutation) ClearNotifier() {
+	m.clearednotifier = true
+	m.clearedFields[notificationtemplate.FieldNotifierID] = struct{}{}
+}
+
+// NotifierCleared reports if the "notifier" edge to the Notifier entity was cleared.
+func (m *NotificationTemplateMutation) NotifierCleared() bool {
+	return m.NotifierIDCleared() || m.clearednotifier
+}
+
+// NotifierIDs returns the "notifier" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NotifierID instead. It exists only for internal usage by the builders.
+func (m *NotificationTemplateMutation) NotifierIDs() (ids []uuid.UUID) {
+	if id := m.notifier; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetAttachments resets all changes to the "attachments" edge.
-func (m *ItemMutation) ResetAttachments() {
-	m.attachments = nil
-	m.clearedattachments = false
-	m.removedattachments = nil
+// ResetNotifier resets all changes to the "notifier" edge.
+func (m *NotificationTemplateMutation) ResetNotifier() {
+	m.notifier = nil
+	m.clearednotifier = false
 }
 
-// Where appends a list predicates to the ItemMutation builder.
-func (m *ItemMutation) Where(ps ...predicate.Item) {
+// Where appends a list predicates to the NotificationTemplateMutation builder.
+func (m *NotificationTemplateMutation) Where(ps ...predicate.NotificationTemplate) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NotificationTemplateMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Item, len(ps))
+func (m *NotificationTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationTemplate, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5711,93 +16463,42 @@ func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ItemMutation) Op() Op {
+func (m *NotificationTemplateMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ItemMutation) SetOp(op Op) {
+func (m *NotificationTemplateMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Item).
-func (m *ItemMutation) Type() string {
+// Type returns the node type of this mutation (NotificationTemplate).
+func (m *NotificationTemplateMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ItemMutation) Fields() []string {
-	fields := make([]string, 0, 23)
+func (m *NotificationTemplateMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.created_at != nil {
-		fields = append(fields, item.FieldCreatedAt)
+		fields = append(fields, notificationtemplate.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, item.FieldUpdatedAt)
-	}
-	if m.name != nil {
-		fields = append(fields, item.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, item.FieldDescription)
-	}
-	if m.import_ref != nil {
-		fields = append(fields, item.FieldImportRef)
-	}
-	if m.notes != nil {
-		fields = append(fields, item.FieldNotes)
-	}
-	if m.quantity != nil {
-		fields = append(fields, item.FieldQuantity)
-	}
-	if m.insured != nil {
-		fields = append(fields, item.FieldInsured)
-	}
-	if m.archived != nil {
-		fields = append(fields, item.FieldArchived)
-	}
-	if m.asset_id != nil {
-		fields = append(fields, item.FieldAssetID)
-	}
-	if m.serial_number != nil {
-		fields = append(fields, item.FieldSerialNumber)
-	}
-	if m.model_number != nil {
-		fields = append(fields, item.FieldModelNumber)
-	}
-	if m.manufacturer != nil {
-		fields = append(fields, item.FieldManufacturer)
+		fields = append(fields, notificationtemplate.FieldUpdatedAt)
 	}
-	if m.lifetime_warranty != nil {
-		fields = append(fields, item.FieldLifetimeWarranty)
-	}
-	if m.warranty_expires != nil {
-		fields = append(fields, item.FieldWarrantyExpires)
-	}
-	if m.warranty_details != nil {
-		fields = append(fields, item.FieldWarrantyDetails)
-	}
-	if m.purchase_time != nil {
-		fields = append(fields, item.FieldPurchaseTime)
-	}
-	if m.purchase_from != nil {
-		fields = append(fields, item.FieldPurchaseFrom)
-	}
-	if m.purchase_price != nil {
-		fields = append(fields, item.FieldPurchasePrice)
-	}
-	if m.sold_time != nil {
-		fields = append(fields, item.FieldSoldTime)
+	if m.group != nil {
+		fields = append(fields, notificationtemplate.FieldGroupID)
 	}
-	if m.sold_to != nil {
-		fields = append(fields, item.FieldSoldTo)
+	if m._type != nil {
+		fields = append(fields, notificationtemplate.FieldType)
 	}
-	if m.sold_price != nil {
-		fields = append(fields, item.FieldSoldPrice)
+	if m.notifier != nil {
+		fields = append(fields, notificationtemplate.FieldNotifierID)
 	}
-	if m.sold_notes != nil {
-		fields = append(fields, item.FieldSoldNotes)
+	if m.body != nil {
+		fields = append(fields, notificationtemplate.FieldBody)
 	}
 	return fields
 }
@@ -5805,810 +16506,304 @@ func (m *ItemMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ItemMutation) Field(name string) (ent.Value, bool) {
+func (m *NotificationTemplateMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case item.FieldCreatedAt:
+	case notificationtemplate.FieldCreatedAt:
 		return m.CreatedAt()
-	case item.FieldUpdatedAt:
+	case notificationtemplate.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case item.FieldName:
-		return m.Name()
-	case item.FieldDescription:
-		return m.Description()
-	case item.FieldImportRef:
-		return m.ImportRef()
-	case item.FieldNotes:
-		return m.Notes()
-	case item.FieldQuantity:
-		return m.Quantity()
-	case item.FieldInsured:
-		return m.Insured()
-	case item.FieldArchived:
-		return m.Archived()
-	case item.FieldAssetID:
-		return m.AssetID()
-	case item.FieldSerialNumber:
-		return m.SerialNumber()
-	case item.FieldModelNumber:
-		return m.ModelNumber()
-	case item.FieldManufacturer:
-		return m.Manufacturer()
-	case item.FieldLifetimeWarranty:
-		return m.LifetimeWarranty()
-	case item.FieldWarrantyExpires:
-		return m.WarrantyExpires()
-	case item.FieldWarrantyDetails:
-		return m.WarrantyDetails()
-	case item.FieldPurchaseTime:
-		return m.PurchaseTime()
-	case item.FieldPurchaseFrom:
-		return m.PurchaseFrom()
-	case item.FieldPurchasePrice:
-		return m.PurchasePrice()
-	case item.FieldSoldTime:
-		return m.SoldTime()
-	case item.FieldSoldTo:
-		return m.SoldTo()
-	case item.FieldSoldPrice:
-		return m.SoldPrice()
-	case item.FieldSoldNotes:
-		return m.SoldNotes()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case item.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case item.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case item.FieldName:
-		return m.OldName(ctx)
-	case item.FieldDescription:
-		return m.OldDescription(ctx)
-	case item.FieldImportRef:
-		return m.OldImportRef(ctx)
-	case item.FieldNotes:
-		return m.OldNotes(ctx)
-	case item.FieldQuantity:
-		return m.OldQuantity(ctx)
-	case item.FieldInsured:
-		return m.OldInsured(ctx)
-	case item.FieldArchived:
-		return m.OldArchived(ctx)
-	case item.FieldAssetID:
-		return m.OldAssetID(ctx)
-	case item.FieldSerialNumber:
-		return m.OldSerialNumber(ctx)
-	case item.FieldModelNumber:
-		return m.OldModelNumber(ctx)
-	case item.FieldManufacturer:
-		return m.OldManufacturer(ctx)
-	case item.FieldLifetimeWarranty:
-		return m.OldLifetimeWarranty(ctx)
-	case item.FieldWarrantyExpires:
-		return m.OldWarrantyExpires(ctx)
-	case item.FieldWarrantyDetails:
-		return m.OldWarrantyDetails(ctx)
-	case item.FieldPurchaseTime:
-		return m.OldPurchaseTime(ctx)
-	case item.FieldPurchaseFrom:
-		return m.OldPurchaseFrom(ctx)
-	case item.FieldPurchasePrice:
-		return m.OldPurchasePrice(ctx)
-	case item.FieldSoldTime:
-		return m.OldSoldTime(ctx)
-	case item.FieldSoldTo:
-		return m.OldSoldTo(ctx)
-	case item.FieldSoldPrice:
-		return m.OldSoldPrice(ctx)
-	case item.FieldSoldNotes:
-		return m.OldSoldNotes(ctx)
+	case notificationtemplate.FieldGroupID:
+		return m.GroupID()
+	case notificationtemplate.FieldType:
+		return m.GetType()
+	case notificationtemplate.FieldNotifierID:
+		return m.NotifierID()
+	case notificationtemplate.FieldBody:
+		return m.Body()
 	}
-	return nil, fmt.Errorf("unknown Item field %s", name)
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationTemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notificationtemplate.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case notificationtemplate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case notificationtemplate.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case notificationtemplate.FieldType:
+		return m.OldType(ctx)
+	case notificationtemplate.FieldNotifierID:
+		return m.OldNotifierID(ctx)
+	case notificationtemplate.FieldBody:
+		return m.OldBody(ctx)
+	}
+	return nil, fmt.Errorf("unknown NotificationTemplate field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ItemMutation) SetField(name string, value ent.Value) error {
+func (m *NotificationTemplateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case item.FieldCreatedAt:
+	case notificationtemplate.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case item.FieldUpdatedAt:
+	case notificationtemplate.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case item.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case item.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case item.FieldImportRef:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetImportRef(v)
-		return nil
-	case item.FieldNotes:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNotes(v)
-		return nil
-	case item.FieldQuantity:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetQuantity(v)
-		return nil
-	case item.FieldInsured:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInsured(v)
-		return nil
-	case item.FieldArchived:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetArchived(v)
-		return nil
-	case item.FieldAssetID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAssetID(v)
-		return nil
-	case item.FieldSerialNumber:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSerialNumber(v)
-		return nil
-	case item.FieldModelNumber:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetModelNumber(v)
-		return nil
-	case item.FieldManufacturer:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetManufacturer(v)
-		return nil
-	case item.FieldLifetimeWarranty:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLifetimeWarranty(v)
-		return nil
-	case item.FieldWarrantyExpires:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWarrantyExpires(v)
-		return nil
-	case item.FieldWarrantyDetails:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWarrantyDetails(v)
-		return nil
-	case item.FieldPurchaseTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPurchaseTime(v)
-		return nil
-	case item.FieldPurchaseFrom:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPurchaseFrom(v)
-		return nil
-	case item.FieldPurchasePrice:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPurchasePrice(v)
-		return nil
-	case item.FieldSoldTime:
-		v, ok := value.(time.Time)
+	case notificationtemplate.FieldGroupID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSoldTime(v)
+		m.SetGroupID(v)
 		return nil
-	case item.FieldSoldTo:
-		v, ok := value.(string)
+	case notificationtemplate.FieldType:
+		v, ok := value.(notificationtemplate.Type)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSoldTo(v)
+		m.SetType(v)
 		return nil
-	case item.FieldSoldPrice:
-		v, ok := value.(float64)
+	case notificationtemplate.FieldNotifierID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSoldPrice(v)
+		m.SetNotifierID(v)
 		return nil
-	case item.FieldSoldNotes:
+	case notificationtemplate.FieldBody:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSoldNotes(v)
+		m.SetBody(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Item field %s", name)
+	return fmt.Errorf("unknown NotificationTemplate field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ItemMutation) AddedFields() []string {
-	var fields []string
-	if m.addquantity != nil {
-		fields = append(fields, item.FieldQuantity)
-	}
-	if m.addasset_id != nil {
-		fields = append(fields, item.FieldAssetID)
-	}
-	if m.addpurchase_price != nil {
-		fields = append(fields, item.FieldPurchasePrice)
-	}
-	if m.addsold_price != nil {
-		fields = append(fields, item.FieldSoldPrice)
-	}
-	return fields
+func (m *NotificationTemplateMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case item.FieldQuantity:
-		return m.AddedQuantity()
-	case item.FieldAssetID:
-		return m.AddedAssetID()
-	case item.FieldPurchasePrice:
-		return m.AddedPurchasePrice()
-	case item.FieldSoldPrice:
-		return m.AddedSoldPrice()
-	}
+func (m *NotificationTemplateMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ItemMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case item.FieldQuantity:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddQuantity(v)
-		return nil
-	case item.FieldAssetID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAssetID(v)
-		return nil
-	case item.FieldPurchasePrice:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPurchasePrice(v)
-		return nil
-	case item.FieldSoldPrice:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSoldPrice(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Item numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ItemMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(item.FieldDescription) {
-		fields = append(fields, item.FieldDescription)
-	}
-	if m.FieldCleared(item.FieldImportRef) {
-		fields = append(fields, item.FieldImportRef)
-	}
-	if m.FieldCleared(item.FieldNotes) {
-		fields = append(fields, item.FieldNotes)
-	}
-	if m.FieldCleared(item.FieldSerialNumber) {
-		fields = append(fields, item.FieldSerialNumber)
-	}
-	if m.FieldCleared(item.FieldModelNumber) {
-		fields = append(fields, item.FieldModelNumber)
-	}
-	if m.FieldCleared(item.FieldManufacturer) {
-		fields = append(fields, item.FieldManufacturer)
-	}
-	if m.FieldCleared(item.FieldWarrantyExpires) {
-		fields = append(fields, item.FieldWarrantyExpires)
-	}
-	if m.FieldCleared(item.FieldWarrantyDetails) {
-		fields = append(fields, item.FieldWarrantyDetails)
-	}
-	if m.FieldCleared(item.FieldPurchaseTime) {
-		fields = append(fields, item.FieldPurchaseTime)
-	}
-	if m.FieldCleared(item.FieldPurchaseFrom) {
-		fields = append(fields, item.FieldPurchaseFrom)
-	}
-	if m.FieldCleared(item.FieldSoldTime) {
-		fields = append(fields, item.FieldSoldTime)
-	}
-	if m.FieldCleared(item.FieldSoldTo) {
-		fields = append(fields, item.FieldSoldTo)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationTemplateMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	if m.FieldCleared(item.FieldSoldNotes) {
-		fields = append(fields, item.FieldSoldNotes)
+	return fmt.Errorf("unknown NotificationTemplate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationTemplateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notificationtemplate.FieldNotifierID) {
+		fields = append(fields, notificationtemplate.FieldNotifierID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ItemMutation) FieldCleared(name string) bool {
+func (m *NotificationTemplateMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ItemMutation) ClearField(name string) error {
+func (m *NotificationTemplateMutation) ClearField(name string) error {
 	switch name {
-	case item.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case item.FieldImportRef:
-		m.ClearImportRef()
-		return nil
-	case item.FieldNotes:
-		m.ClearNotes()
-		return nil
-	case item.FieldSerialNumber:
-		m.ClearSerialNumber()
-		return nil
-	case item.FieldModelNumber:
-		m.ClearModelNumber()
-		return nil
-	case item.FieldManufacturer:
-		m.ClearManufacturer()
-		return nil
-	case item.FieldWarrantyExpires:
-		m.ClearWarrantyExpires()
-		return nil
-	case item.FieldWarrantyDetails:
-		m.ClearWarrantyDetails()
-		return nil
-	case item.FieldPurchaseTime:
-		m.ClearPurchaseTime()
-		return nil
-	case item.FieldPurchaseFrom:
-		m.ClearPurchaseFrom()
-		return nil
-	case item.FieldSoldTime:
-		m.ClearSoldTime()
-		return nil
-	case item.FieldSoldTo:
-		m.ClearSoldTo()
-		return nil
-	case item.FieldSoldNotes:
-		m.ClearSoldNotes()
+	case notificationtemplate.FieldNotifierID:
+		m.ClearNotifierID()
 		return nil
 	}
-	return fmt.Errorf("unknown Item nullable field %s", name)
+	return fmt.Errorf("unknown NotificationTemplate nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ItemMutation) ResetField(name string) error {
+func (m *NotificationTemplateMutation) ResetField(name string) error {
 	switch name {
-	case item.FieldCreatedAt:
+	case notificationtemplate.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case item.FieldUpdatedAt:
+	case notificationtemplate.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case item.FieldName:
-		m.ResetName()
-		return nil
-	case item.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case item.FieldImportRef:
-		m.ResetImportRef()
-		return nil
-	case item.FieldNotes:
-		m.ResetNotes()
-		return nil
-	case item.FieldQuantity:
-		m.ResetQuantity()
-		return nil
-	case item.FieldInsured:
-		m.ResetInsured()
-		return nil
-	case item.FieldArchived:
-		m.ResetArchived()
-		return nil
-	case item.FieldAssetID:
-		m.ResetAssetID()
-		return nil
-	case item.FieldSerialNumber:
-		m.ResetSerialNumber()
-		return nil
-	case item.FieldModelNumber:
-		m.ResetModelNumber()
-		return nil
-	case item.FieldManufacturer:
-		m.ResetManufacturer()
-		return nil
-	case item.FieldLifetimeWarranty:
-		m.ResetLifetimeWarranty()
-		return nil
-	case item.FieldWarrantyExpires:
-		m.ResetWarrantyExpires()
-		return nil
-	case item.FieldWarrantyDetails:
-		m.ResetWarrantyDetails()
-		return nil
-	case item.FieldPurchaseTime:
-		m.ResetPurchaseTime()
-		return nil
-	case item.FieldPurchaseFrom:
-		m.ResetPurchaseFrom()
-		return nil
-	case item.FieldPurchasePrice:
-		m.ResetPurchasePrice()
-		return nil
-	case item.FieldSoldTime:
-		m.ResetSoldTime()
+	case notificationtemplate.FieldGroupID:
+		m.ResetGroupID()
 		return nil
-	case item.FieldSoldTo:
-		m.ResetSoldTo()
+	case notificationtemplate.FieldType:
+		m.ResetType()
 		return nil
-	case item.FieldSoldPrice:
-		m.ResetSoldPrice()
+	case notificationtemplate.FieldNotifierID:
+		m.ResetNotifierID()
 		return nil
-	case item.FieldSoldNotes:
-		m.ResetSoldNotes()
+	case notificationtemplate.FieldBody:
+		m.ResetBody()
 		return nil
 	}
-	return fmt.Errorf("unknown Item field %s", name)
+	return fmt.Errorf("unknown NotificationTemplate field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ItemMutation) AddedEdges() []string {
-	edges := make([]string, 0, 8)
+func (m *NotificationTemplateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.group != nil {
-		edges = append(edges, item.EdgeGroup)
-	}
-	if m.parent != nil {
-		edges = append(edges, item.EdgeParent)
-	}
-	if m.children != nil {
-		edges = append(edges, item.EdgeChildren)
-	}
-	if m.label != nil {
-		edges = append(edges, item.EdgeLabel)
-	}
-	if m.location != nil {
-		edges = append(edges, item.EdgeLocation)
-	}
-	if m.fields != nil {
-		edges = append(edges, item.EdgeFields)
-	}
-	if m.maintenance_entries != nil {
-		edges = append(edges, item.EdgeMaintenanceEntries)
+		edges = append(edges, notificationtemplate.EdgeGroup)
 	}
-	if m.attachments != nil {
-		edges = append(edges, item.EdgeAttachments)
+	if m.notifier != nil {
+		edges = append(edges, notificationtemplate.EdgeNotifier)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ItemMutation) AddedIDs(name string) []ent.Value {
+func (m *NotificationTemplateMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case item.EdgeGroup:
+	case notificationtemplate.EdgeGroup:
 		if id := m.group; id != nil {
 			return []ent.Value{*id}
 		}
-	case item.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
-	case item.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeLabel:
-		ids := make([]ent.Value, 0, len(m.label))
-		for id := range m.label {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeLocation:
-		if id := m.location; id != nil {
+	case notificationtemplate.EdgeNotifier:
+		if id := m.notifier; id != nil {
 			return []ent.Value{*id}
 		}
-	case item.EdgeFields:
-		ids := make([]ent.Value, 0, len(m.fields))
-		for id := range m.fields {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeMaintenanceEntries:
-		ids := make([]ent.Value, 0, len(m.maintenance_entries))
-		for id := range m.maintenance_entries {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeAttachments:
-		ids := make([]ent.Value, 0, len(m.attachments))
-		for id := range m.attachments {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ItemMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.removedchildren != nil {
-		edges = append(edges, item.EdgeChildren)
-	}
-	if m.removedlabel != nil {
-		edges = append(edges, item.EdgeLabel)
-	}
-	if m.removedfields != nil {
-		edges = append(edges, item.EdgeFields)
-	}
-	if m.removedmaintenance_entries != nil {
-		edges = append(edges, item.EdgeMaintenanceEntries)
-	}
-	if m.removedattachments != nil {
-		edges = append(edges, item.EdgeAttachments)
-	}
+func (m *NotificationTemplateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ItemMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case item.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeLabel:
-		ids := make([]ent.Value, 0, len(m.removedlabel))
-		for id := range m.removedlabel {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeFields:
-		ids := make([]ent.Value, 0, len(m.removedfields))
-		for id := range m.removedfields {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeMaintenanceEntries:
-		ids := make([]ent.Value, 0, len(m.removedmaintenance_entries))
-		for id := range m.removedmaintenance_entries {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeAttachments:
-		ids := make([]ent.Value, 0, len(m.removedattachments))
-		for id := range m.removedattachments {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ItemMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.clearedgroup {
-		edges = append(edges, item.EdgeGroup)
-	}
-	if m.clearedparent {
-		edges = append(edges, item.EdgeParent)
-	}
-	if m.clearedchildren {
-		edges = append(edges, item.EdgeChildren)
-	}
-	if m.clearedlabel {
-		edges = append(edges, item.EdgeLabel)
-	}
-	if m.clearedlocation {
-		edges = append(edges, item.EdgeLocation)
-	}
-	if m.clearedfields {
-		edges = append(edges, item.EdgeFields)
-	}
-	if m.clearedmaintenance_entries {
-		edges = append(edges, item.EdgeMaintenanceEntries)
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationTemplateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationTemplateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgroup {
+		edges = append(edges, notificationtemplate.EdgeGroup)
 	}
-	if m.clearedattachments {
-		edges = append(edges, item.EdgeAttachments)
+	if m.clearednotifier {
+		edges = append(edges, notificationtemplate.EdgeNotifier)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ItemMutation) EdgeCleared(name string) bool {
+func (m *NotificationTemplateMutation) EdgeCleared(name string) bool {
 	switch name {
-	case item.EdgeGroup:
+	case notificationtemplate.EdgeGroup:
 		return m.clearedgroup
-	case item.EdgeParent:
-		return m.clearedparent
-	case item.EdgeChildren:
-		return m.clearedchildren
-	case item.EdgeLabel:
-		return m.clearedlabel
-	case item.EdgeLocation:
-		return m.clearedlocation
-	case item.EdgeFields:
-		return m.clearedfields
-	case item.EdgeMaintenanceEntries:
-		return m.clearedmaintenance_entries
-	case item.EdgeAttachments:
-		return m.clearedattachments
+	case notificationtemplate.EdgeNotifier:
+		return m.clearednotifier
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ItemMutation) ClearEdge(name string) error {
+func (m *NotificationTemplateMutation) ClearEdge(name string) error {
 	switch name {
-	case item.EdgeGroup:
+	case notificationtemplate.EdgeGroup:
 		m.ClearGroup()
 		return nil
-	case item.EdgeParent:
-		m.ClearParent()
-		return nil
-	case item.EdgeLocation:
-		m.ClearLocation()
+	case notificationtemplate.EdgeNotifier:
+		m.ClearNotifier()
 		return nil
 	}
-	return fmt.Errorf("unknown Item unique edge %s", name)
+	return fmt.Errorf("unknown NotificationTemplate unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ItemMutation) ResetEdge(name string) error {
+func (m *NotificationTemplateMutation) ResetEdge(name string) error {
 	switch name {
-	case item.EdgeGroup:
+	case notificationtemplate.EdgeGroup:
 		m.ResetGroup()
 		return nil
-	case item.EdgeParent:
-		m.ResetParent()
-		return nil
-	case item.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	case item.EdgeLabel:
-		m.ResetLabel()
-		return nil
-	case item.EdgeLocation:
-		m.ResetLocation()
-		return nil
-	case item.EdgeFields:
-		m.ResetFields()
-		return nil
-	case item.EdgeMaintenanceEntries:
-		m.ResetMaintenanceEntries()
-		return nil
-	case item.EdgeAttachments:
-		m.ResetAttachments()
+	case notificationtemplate.EdgeNotifier:
+		m.ResetNotifier()
 		return nil
 	}
-	return fmt.Errorf("unknown Item edge %s", name)
+	return fmt.Errorf("unknown NotificationTemplate edge %s", name)
 }
 
-// ItemFieldMutation represents an operation that mutates the ItemField nodes in the graph.
-type ItemFieldMutation struct {
+// NotifierMutation represents an operation that mutates the Notifier nodes in the graph.
+type NotifierMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	created_at      *time.Time
-	updated_at      *time.Time
-	name            *string
-	description     *string
-	_type           *itemfield.Type
-	text_value      *string
-	number_value    *int
-	addnumber_value *int
-	boolean_value   *bool
-	time_value      *time.Time
-	clearedFields   map[string]struct{}
-	item            *uuid.UUID
-	cleareditem     bool
-	done            bool
-	oldValue        func(context.Context) (*ItemField, error)
-	predicates      []predicate.ItemField
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	created_at        *time.Time
+	updated_at        *time.Time
+	name              *string
+	url               *string
+	is_active         *bool
+	failure_count     *int
+	addfailure_count  *int
+	clearedFields     map[string]struct{}
+	group             *uuid.UUID
+	clearedgroup      bool
+	user              *uuid.UUID
+	cleareduser       bool
+	templates         map[uuid.UUID]struct{}
+	removedtemplates  map[uuid.UUID]struct{}
+	clearedtemplates  bool
+	deliveries        map[uuid.UUID]struct{}
+	removeddeliveries map[uuid.UUID]struct{}
+	cleareddeliveries bool
+	done              bool
+	oldValue          func(context.Context) (*Notifier, error)
+	predicates        []predicate.Notifier
 }
 
-var _ ent.Mutation = (*ItemFieldMutation)(nil)
+var _ ent.Mutation = (*NotifierMutation)(nil)
 
-// itemfieldOption allows management of the mutation configuration using functional options.
-type itemfieldOption func(*ItemFieldMutation)
+// notifierOption allows management of the mutation configuration using functional options.
+type notifierOption func(*NotifierMutation)
 
-// newItemFieldMutation creates new mutation for the ItemField entity.
-func newItemFieldMutation(c config, op Op, opts ...itemfieldOption) *ItemFieldMutation {
-	m := &ItemFieldMutation{
+// newNotifierMutation creates new mutation for the Notifier entity.
+func newNotifierMutation(c config, op Op, opts ...notifierOption) *NotifierMutation {
+	m := &NotifierMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeItemField,
+		typ:           TypeNotifier,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6617,20 +16812,20 @@ func newItemFieldMutation(c config, op Op, opts ...itemfieldOption) *ItemFieldMu
 	return m
 }
 
-// withItemFieldID sets the ID field of the mutation.
-func withItemFieldID(id uuid.UUID) itemfieldOption {
-	return func(m *ItemFieldMutation) {
+// withNotifierID sets the ID field of the mutation.
+func withNotifierID(id uuid.UUID) notifierOption {
+	return func(m *NotifierMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ItemField
+			value *Notifier
 		)
-		m.oldValue = func(ctx context.Context) (*ItemField, error) {
+		m.oldValue = func(ctx context.Context) (*Notifier, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ItemField.Get(ctx, id)
+					value, err = m.Client().Notifier.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6639,10 +16834,10 @@ func withItemFieldID(id uuid.UUID) itemfieldOption {
 	}
 }
 
-// withItemField sets the old ItemField of the mutation.
-func withItemField(node *ItemField) itemfieldOption {
-	return func(m *ItemFieldMutation) {
-		m.oldValue = func(context.Context) (*ItemField, error) {
+// withNotifier sets the old Notifier of the mutation.
+func withNotifier(node *Notifier) notifierOption {
+	return func(m *NotifierMutation) {
+		m.oldValue = func(context.Context) (*Notifier, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6651,7 +16846,7 @@ func withItemField(node *ItemField) itemfieldOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ItemFieldMutation) Client() *Client {
+func (m NotifierMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6659,7 +16854,7 @@ func (m ItemFieldMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ItemFieldMutation) Tx() (*Tx, error) {
+func (m NotifierMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6669,14 +16864,14 @@ func (m ItemFieldMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ItemField entities.
-func (m *ItemFieldMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Notifier entities.
+func (m *NotifierMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ItemFieldMutation) ID() (id uuid.UUID, exists bool) {
+func (m *NotifierMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6687,7 +16882,7 @@ func (m *ItemFieldMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ItemFieldMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *NotifierMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6696,19 +16891,19 @@ func (m *ItemFieldMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ItemField.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Notifier.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ItemFieldMutation) SetCreatedAt(t time.Time) {
+func (m *NotifierMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ItemFieldMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *NotifierMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -6716,10 +16911,10 @@ func (m *ItemFieldMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NotifierMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -6734,17 +16929,17 @@ func (m *ItemFieldMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ItemFieldMutation) ResetCreatedAt() {
+func (m *NotifierMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ItemFieldMutation) SetUpdatedAt(t time.Time) {
+func (m *NotifierMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ItemFieldMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *NotifierMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -6752,10 +16947,10 @@ func (m *ItemFieldMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NotifierMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -6770,370 +16965,417 @@ func (m *ItemFieldMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ItemFieldMutation) ResetUpdatedAt() {
+func (m *NotifierMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetName sets the "name" field.
-func (m *ItemFieldMutation) SetName(s string) {
-	m.name = &s
+// SetGroupID sets the "group_id" field.
+func (m *NotifierMutation) SetGroupID(u uuid.UUID) {
+	m.group = &u
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ItemFieldMutation) Name() (r string, exists bool) {
-	v := m.name
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *NotifierMutation) GroupID() (r uuid.UUID, exists bool) {
+	v := m.group
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// OldGroupID returns the old "group_id" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *NotifierMutation) OldGroupID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.GroupID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ItemFieldMutation) ResetName() {
-	m.name = nil
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *NotifierMutation) ResetGroupID() {
+	m.group = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ItemFieldMutation) SetDescription(s string) {
-	m.description = &s
+// SetUserID sets the "user_id" field.
+func (m *NotifierMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ItemFieldMutation) Description() (r string, exists bool) {
-	v := m.description
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *NotifierMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *NotifierMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.UserID, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *ItemFieldMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[itemfield.FieldDescription] = struct{}{}
+// ResetUserID resets all changes to the "user_id" field.
+func (m *NotifierMutation) ResetUserID() {
+	m.user = nil
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ItemFieldMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[itemfield.FieldDescription]
-	return ok
+// SetName sets the "name" field.
+func (m *NotifierMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ItemFieldMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, itemfield.FieldDescription)
+// Name returns the value of the "name" field in the mutation.
+func (m *NotifierMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotifierMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *NotifierMutation) ResetName() {
+	m.name = nil
 }
 
-// SetType sets the "type" field.
-func (m *ItemFieldMutation) SetType(i itemfield.Type) {
-	m._type = &i
+// SetURL sets the "url" field.
+func (m *NotifierMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *ItemFieldMutation) GetType() (r itemfield.Type, exists bool) {
-	v := m._type
+// URL returns the value of the "url" field in the mutation.
+func (m *NotifierMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldType(ctx context.Context) (v itemfield.Type, err error) {
+func (m *NotifierMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.URL, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *ItemFieldMutation) ResetType() {
-	m._type = nil
+// ResetURL resets all changes to the "url" field.
+func (m *NotifierMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetTextValue sets the "text_value" field.
-func (m *ItemFieldMutation) SetTextValue(s string) {
-	m.text_value = &s
+// SetIsActive sets the "is_active" field.
+func (m *NotifierMutation) SetIsActive(b bool) {
+	m.is_active = &b
 }
 
-// TextValue returns the value of the "text_value" field in the mutation.
-func (m *ItemFieldMutation) TextValue() (r string, exists bool) {
-	v := m.text_value
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *NotifierMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTextValue returns the old "text_value" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// OldIsActive returns the old "is_active" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldTextValue(ctx context.Context) (v string, err error) {
+func (m *NotifierMutation) OldIsActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTextValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTextValue requires an ID field in the mutation")
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTextValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
 	}
-	return oldValue.TextValue, nil
-}
-
-// ClearTextValue clears the value of the "text_value" field.
-func (m *ItemFieldMutation) ClearTextValue() {
-	m.text_value = nil
-	m.clearedFields[itemfield.FieldTextValue] = struct{}{}
-}
-
-// TextValueCleared returns if the "text_value" field was cleared in this mutation.
-func (m *ItemFieldMutation) TextValueCleared() bool {
-	_, ok := m.clearedFields[itemfield.FieldTextValue]
-	return ok
+	return oldValue.IsActive, nil
 }
 
-// ResetTextValue resets all changes to the "text_value" field.
-func (m *ItemFieldMutation) ResetTextValue() {
-	m.text_value = nil
-	delete(m.clearedFields, itemfield.FieldTextValue)
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *NotifierMutation) ResetIsActive() {
+	m.is_active = nil
 }
 
-// SetNumberValue sets the "number_value" field.
-func (m *ItemFieldMutation) SetNumberValue(i int) {
-	m.number_value = &i
-	m.addnumber_value = nil
+// SetFailureCount sets the "failure_count" field.
+func (m *NotifierMutation) SetFailureCount(i int) {
+	m.failure_count = &i
+	m.addfailure_count = nil
 }
 
-// NumberValue returns the value of the "number_value" field in the mutation.
-func (m *ItemFieldMutation) NumberValue() (r int, exists bool) {
-	v := m.number_value
+// FailureCount returns the value of the "failure_count" field in the mutation.
+func (m *NotifierMutation) FailureCount() (r int, exists bool) {
+	v := m.failure_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNumberValue returns the old "number_value" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
+// OldFailureCount returns the old "failure_count" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldNumberValue(ctx context.Context) (v int, err error) {
+func (m *NotifierMutation) OldFailureCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNumberValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldFailureCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNumberValue requires an ID field in the mutation")
+		return v, errors.New("OldFailureCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNumberValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldFailureCount: %w", err)
 	}
-	return oldValue.NumberValue, nil
+	return oldValue.FailureCount, nil
 }
 
-// AddNumberValue adds i to the "number_value" field.
-func (m *ItemFieldMutation) AddNumberValue(i int) {
-	if m.addnumber_value != nil {
-		*m.addnumber_value += i
+// AddFailureCount adds i to the "failure_count" field.
+func (m *NotifierMutation) AddFailureCount(i int) {
+	if m.addfailure_count != nil {
+		*m.addfailure_count += i
 	} else {
-		m.addnumber_value = &i
+		m.addfailure_count = &i
 	}
 }
 
-// AddedNumberValue returns the value that was added to the "number_value" field in this mutation.
-func (m *ItemFieldMutation) AddedNumberValue() (r int, exists bool) {
-	v := m.addnumber_value
+// AddedFailureCount returns the value that was added to the "failure_count" field in this mutation.
+func (m *NotifierMutation) AddedFailureCount() (r int, exists bool) {
+	v := m.addfailure_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearNumberValue clears the value of the "number_value" field.
-func (m *ItemFieldMutation) ClearNumberValue() {
-	m.number_value = nil
-	m.addnumber_value = nil
-	m.clearedFields[itemfield.FieldNumberValue] = struct{}{}
+// ResetFailureCount resets all changes to the "failure_count" field.
+func (m *NotifierMutation) ResetFailureCount() {
+	m.failure_count = nil
+	m.addfailure_count = nil
 }
 
-// NumberValueCleared returns if the "number_value" field was cleared in this mutation.
-func (m *ItemFieldMutation) NumberValueCleared() bool {
-	_, ok := m.clearedFields[itemfield.FieldNumberValue]
-	return ok
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *NotifierMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[notifier.FieldGroupID] = struct{}{}
 }
 
-// ResetNumberValue resets all changes to the "number_value" field.
-func (m *ItemFieldMutation) ResetNumberValue() {
-	m.number_value = nil
-	m.addnumber_value = nil
-	delete(m.clearedFields, itemfield.FieldNumberValue)
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *NotifierMutation) GroupCleared() bool {
+	return m.clearedgroup
 }
 
-// SetBooleanValue sets the "boolean_value" field.
-func (m *ItemFieldMutation) SetBooleanValue(b bool) {
-	m.boolean_value = &b
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *NotifierMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// BooleanValue returns the value of the "boolean_value" field in the mutation.
-func (m *ItemFieldMutation) BooleanValue() (r bool, exists bool) {
-	v := m.boolean_value
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetGroup resets all changes to the "group" edge.
+func (m *NotifierMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
 }
 
-// OldBooleanValue returns the old "boolean_value" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldBooleanValue(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBooleanValue is only allowed on UpdateOne operations")
+// ClearUser clears the "user" edge to the User entity.
+func (m *NotifierMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[notifier.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *NotifierMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *NotifierMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBooleanValue requires an ID field in the mutation")
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *NotifierMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddTemplateIDs adds the "templates" edge to the NotificationTemplate entity by ids.
+func (m *NotifierMutation) AddTemplateIDs(ids ...uuid.UUID) {
+	if m.templates == nil {
+		m.templates = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBooleanValue: %w", err)
+	for i := range ids {
+		m.templates[ids[i]] = struct{}{}
 	}
-	return oldValue.BooleanValue, nil
 }
 
-// ResetBooleanValue resets all changes to the "boolean_value" field.
-func (m *ItemFieldMutation) ResetBooleanValue() {
-	m.boolean_value = nil
+// ClearTemplates clears the "templates" edge to the NotificationTemplate entity.
+func (m *NotifierMutation) ClearTemplates() {
+	m.clearedtemplates = true
 }
 
-// SetTimeValue sets the "time_value" field.
-func (m *ItemFieldMutation) SetTimeValue(t time.Time) {
-	m.time_value = &t
+// TemplatesCleared reports if the "templates" edge to the NotificationTemplate entity was cleared.
+func (m *NotifierMutation) TemplatesCleared() bool {
+	return m.clearedtemplates
 }
 
-// TimeValue returns the value of the "time_value" field in the mutation.
-func (m *ItemFieldMutation) TimeValue() (r time.Time, exists bool) {
-	v := m.time_value
-	if v == nil {
-		return
+// RemoveTemplateIDs removes the "templates" edge to the NotificationTemplate entity by IDs.
+func (m *NotifierMutation) RemoveTemplateIDs(ids ...uuid.UUID) {
+	if m.removedtemplates == nil {
+		m.removedtemplates = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.templates, ids[i])
+		m.removedtemplates[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldTimeValue returns the old "time_value" field's value of the ItemField entity.
-// If the ItemField object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemFieldMutation) OldTimeValue(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimeValue is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimeValue requires an ID field in the mutation")
+// RemovedTemplates returns the removed IDs of the "templates" edge to the NotificationTemplate entity.
+func (m *NotifierMutation) RemovedTemplatesIDs() (ids []uuid.UUID) {
+	for id := range m.removedtemplates {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimeValue: %w", err)
+	return
+}
+
+// TemplatesIDs returns the "templates" edge IDs in the mutation.
+func (m *NotifierMutation) TemplatesIDs() (ids []uuid.UUID) {
+	for id := range m.templates {
+		ids = append(ids, id)
 	}
-	return oldValue.TimeValue, nil
+	return
 }
 
-// ResetTimeValue resets all changes to the "time_value" field.
-func (m *ItemFieldMutation) ResetTimeValue() {
-	m.time_value = nil
+// ResetTemplates resets all changes to the "templates" edge.
+func (m *NotifierMutation) ResetTemplates() {
+	m.templates = nil
+	m.clearedtemplates = false
+	m.removedtemplates = nil
 }
 
-// SetItemID sets the "item" edge to the Item entity by id.
-func (m *ItemFieldMutation) SetItemID(id uuid.UUID) {
-	m.item = &id
+// AddDeliveryIDs adds the "deliveries" edge to the NotifierDelivery entity by ids.
+func (m *NotifierMutation) AddDeliveryIDs(ids ...uuid.UUID) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.deliveries[ids[i]] = struct{}{}
+	}
 }
 
-// ClearItem clears the "item" edge to the Item entity.
-func (m *ItemFieldMutation) ClearItem() {
-	m.cleareditem = true
+// ClearDeliveries clears the "deliveries" edge to the NotifierDelivery entity.
+func (m *NotifierMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
 }
 
-// ItemCleared reports if the "item" edge to the Item entity was cleared.
-func (m *ItemFieldMutation) ItemCleared() bool {
-	return m.cleareditem
+// DeliveriesCleared reports if the "deliveries" edge to the NotifierDelivery entity was cleared.
+func (m *NotifierMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
 }
 
-// ItemID returns the "item" edge ID in the mutation.
-func (m *ItemFieldMutation) ItemID() (id uuid.UUID, exists bool) {
-	if m.item != nil {
-		return *m.item, true
+// RemoveDeliveryIDs removes the "deliveries" edge to the NotifierDelivery entity by IDs.
+func (m *NotifierMutation) RemoveDeliveryIDs(ids ...uuid.UUID) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the NotifierDelivery entity.
+func (m *NotifierMutation) RemovedDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.removeddeliveries {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ItemIDs returns the "item" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ItemID instead. It exists only for internal usage by the builders.
-func (m *ItemFieldMutation) ItemIDs() (ids []uuid.UUID) {
-	if id := m.item; id != nil {
-		ids = append(ids, *id)
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *NotifierMutation) DeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.deliveries {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetItem resets all changes to the "item" edge.
-func (m *ItemFieldMutation) ResetItem() {
-	m.item = nil
-	m.cleareditem = false
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *NotifierMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
 }
 
-// Where appends a list predicates to the ItemFieldMutation builder.
-func (m *ItemFieldMutation) Where(ps ...predicate.ItemField) {
+// Where appends a list predicates to the NotifierMutation builder.
+func (m *NotifierMutation) Where(ps ...predicate.Notifier) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ItemFieldMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NotifierMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ItemFieldMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ItemField, len(ps))
+func (m *NotifierMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Notifier, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7141,51 +17383,48 @@ func (m *ItemFieldMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ItemFieldMutation) Op() Op {
+func (m *NotifierMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ItemFieldMutation) SetOp(op Op) {
+func (m *NotifierMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ItemField).
-func (m *ItemFieldMutation) Type() string {
+// Type returns the node type of this mutation (Notifier).
+func (m *NotifierMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ItemFieldMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *NotifierMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.created_at != nil {
-		fields = append(fields, itemfield.FieldCreatedAt)
+		fields = append(fields, notifier.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, itemfield.FieldUpdatedAt)
-	}
-	if m.name != nil {
-		fields = append(fields, itemfield.FieldName)
+		fields = append(fields, notifier.FieldUpdatedAt)
 	}
-	if m.description != nil {
-		fields = append(fields, itemfield.FieldDescription)
+	if m.group != nil {
+		fields = append(fields, notifier.FieldGroupID)
 	}
-	if m._type != nil {
-		fields = append(fields, itemfield.FieldType)
+	if m.user != nil {
+		fields = append(fields, notifier.FieldUserID)
 	}
-	if m.text_value != nil {
-		fields = append(fields, itemfield.FieldTextValue)
+	if m.name != nil {
+		fields = append(fields, notifier.FieldName)
 	}
-	if m.number_value != nil {
-		fields = append(fields, itemfield.FieldNumberValue)
+	if m.url != nil {
+		fields = append(fields, notifier.FieldURL)
 	}
-	if m.boolean_value != nil {
-		fields = append(fields, itemfield.FieldBooleanValue)
+	if m.is_active != nil {
+		fields = append(fields, notifier.FieldIsActive)
 	}
-	if m.time_value != nil {
-		fields = append(fields, itemfield.FieldTimeValue)
+	if m.failure_count != nil {
+		fields = append(fields, notifier.FieldFailureCount)
 	}
 	return fields
 }
@@ -7193,26 +17432,24 @@ func (m *ItemFieldMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ItemFieldMutation) Field(name string) (ent.Value, bool) {
+func (m *NotifierMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case itemfield.FieldCreatedAt:
+	case notifier.FieldCreatedAt:
 		return m.CreatedAt()
-	case itemfield.FieldUpdatedAt:
+	case notifier.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case itemfield.FieldName:
+	case notifier.FieldGroupID:
+		return m.GroupID()
+	case notifier.FieldUserID:
+		return m.UserID()
+	case notifier.FieldName:
 		return m.Name()
-	case itemfield.FieldDescription:
-		return m.Description()
-	case itemfield.FieldType:
-		return m.GetType()
-	case itemfield.FieldTextValue:
-		return m.TextValue()
-	case itemfield.FieldNumberValue:
-		return m.NumberValue()
-	case itemfield.FieldBooleanValue:
-		return m.BooleanValue()
-	case itemfield.FieldTimeValue:
-		return m.TimeValue()
+	case notifier.FieldURL:
+		return m.URL()
+	case notifier.FieldIsActive:
+		return m.IsActive()
+	case notifier.FieldFailureCount:
+		return m.FailureCount()
 	}
 	return nil, false
 }
@@ -7220,108 +17457,99 @@ func (m *ItemFieldMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ItemFieldMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *NotifierMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case itemfield.FieldCreatedAt:
+	case notifier.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case itemfield.FieldUpdatedAt:
+	case notifier.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case itemfield.FieldName:
+	case notifier.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case notifier.FieldUserID:
+		return m.OldUserID(ctx)
+	case notifier.FieldName:
 		return m.OldName(ctx)
-	case itemfield.FieldDescription:
-		return m.OldDescription(ctx)
-	case itemfield.FieldType:
-		return m.OldType(ctx)
-	case itemfield.FieldTextValue:
-		return m.OldTextValue(ctx)
-	case itemfield.FieldNumberValue:
-		return m.OldNumberValue(ctx)
-	case itemfield.FieldBooleanValue:
-		return m.OldBooleanValue(ctx)
-	case itemfield.FieldTimeValue:
-		return m.OldTimeValue(ctx)
+	case notifier.FieldURL:
+		return m.OldURL(ctx)
+	case notifier.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case notifier.FieldFailureCount:
+		return m.OldFailureCount(ctx)
 	}
-	return nil, fmt.Errorf("unknown ItemField field %s", name)
+	return nil, fmt.Errorf("unknown Notifier field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ItemFieldMutation) SetField(name string, value ent.Value) error {
+func (m *NotifierMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case itemfield.FieldCreatedAt:
+	case notifier.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case itemfield.FieldUpdatedAt:
+	case notifier.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case itemfield.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case itemfield.FieldDescription:
-		v, ok := value.(string)
+	case notifier.FieldGroupID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetGroupID(v)
 		return nil
-	case itemfield.FieldType:
-		v, ok := value.(itemfield.Type)
+	case notifier.FieldUserID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetUserID(v)
 		return nil
-	case itemfield.FieldTextValue:
+	case notifier.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTextValue(v)
+		m.SetName(v)
 		return nil
-	case itemfield.FieldNumberValue:
-		v, ok := value.(int)
+	case notifier.FieldURL:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNumberValue(v)
+		m.SetURL(v)
 		return nil
-	case itemfield.FieldBooleanValue:
+	case notifier.FieldIsActive:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBooleanValue(v)
+		m.SetIsActive(v)
 		return nil
-	case itemfield.FieldTimeValue:
-		v, ok := value.(time.Time)
+	case notifier.FieldFailureCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimeValue(v)
+		m.SetFailureCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ItemField field %s", name)
+	return fmt.Errorf("unknown Notifier field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ItemFieldMutation) AddedFields() []string {
+func (m *NotifierMutation) AddedFields() []string {
 	var fields []string
-	if m.addnumber_value != nil {
-		fields = append(fields, itemfield.FieldNumberValue)
+	if m.addfailure_count != nil {
+		fields = append(fields, notifier.FieldFailureCount)
 	}
 	return fields
 }
@@ -7329,10 +17557,10 @@ func (m *ItemFieldMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ItemFieldMutation) AddedField(name string) (ent.Value, bool) {
+func (m *NotifierMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case itemfield.FieldNumberValue:
-		return m.AddedNumberValue()
+	case notifier.FieldFailureCount:
+		return m.AddedFailureCount()
 	}
 	return nil, false
 }
@@ -7340,201 +17568,248 @@ func (m *ItemFieldMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ItemFieldMutation) AddField(name string, value ent.Value) error {
+func (m *NotifierMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case itemfield.FieldNumberValue:
+	case notifier.FieldFailureCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddNumberValue(v)
+		m.AddFailureCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ItemField numeric field %s", name)
+	return fmt.Errorf("unknown Notifier numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ItemFieldMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(itemfield.FieldDescription) {
-		fields = append(fields, itemfield.FieldDescription)
-	}
-	if m.FieldCleared(itemfield.FieldTextValue) {
-		fields = append(fields, itemfield.FieldTextValue)
-	}
-	if m.FieldCleared(itemfield.FieldNumberValue) {
-		fields = append(fields, itemfield.FieldNumberValue)
-	}
-	return fields
+func (m *NotifierMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ItemFieldMutation) FieldCleared(name string) bool {
+func (m *NotifierMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ItemFieldMutation) ClearField(name string) error {
-	switch name {
-	case itemfield.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case itemfield.FieldTextValue:
-		m.ClearTextValue()
-		return nil
-	case itemfield.FieldNumberValue:
-		m.ClearNumberValue()
-		return nil
-	}
-	return fmt.Errorf("unknown ItemField nullable field %s", name)
+func (m *NotifierMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Notifier nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ItemFieldMutation) ResetField(name string) error {
+func (m *NotifierMutation) ResetField(name string) error {
 	switch name {
-	case itemfield.FieldCreatedAt:
+	case notifier.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case itemfield.FieldUpdatedAt:
+	case notifier.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case itemfield.FieldName:
-		m.ResetName()
-		return nil
-	case itemfield.FieldDescription:
-		m.ResetDescription()
+	case notifier.FieldGroupID:
+		m.ResetGroupID()
 		return nil
-	case itemfield.FieldType:
-		m.ResetType()
+	case notifier.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case itemfield.FieldTextValue:
-		m.ResetTextValue()
+	case notifier.FieldName:
+		m.ResetName()
 		return nil
-	case itemfield.FieldNumberValue:
-		m.ResetNumberValue()
+	case notifier.FieldURL:
+		m.ResetURL()
 		return nil
-	case itemfield.FieldBooleanValue:
-		m.ResetBooleanValue()
+	case notifier.FieldIsActive:
+		m.ResetIsActive()
 		return nil
-	case itemfield.FieldTimeValue:
-		m.ResetTimeValue()
+	case notifier.FieldFailureCount:
+		m.ResetFailureCount()
 		return nil
 	}
-	return fmt.Errorf("unknown ItemField field %s", name)
+	return fmt.Errorf("unknown Notifier field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ItemFieldMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.item != nil {
-		edges = append(edges, itemfield.EdgeItem)
+func (m *NotifierMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.group != nil {
+		edges = append(edges, notifier.EdgeGroup)
+	}
+	if m.user != nil {
+		edges = append(edges, notifier.EdgeUser)
+	}
+	if m.templates != nil {
+		edges = append(edges, notifier.EdgeTemplates)
+	}
+	if m.deliveries != nil {
+		edges = append(edges, notifier.EdgeDeliveries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ItemFieldMutation) AddedIDs(name string) []ent.Value {
+func (m *NotifierMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case itemfield.EdgeItem:
-		if id := m.item; id != nil {
+	case notifier.EdgeGroup:
+		if id := m.group; id != nil {
 			return []ent.Value{*id}
 		}
+	case notifier.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case notifier.EdgeTemplates:
+		ids := make([]ent.Value, 0, len(m.templates))
+		for id := range m.templates {
+			ids = append(ids, id)
+		}
+		return ids
+	case notifier.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ItemFieldMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *NotifierMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedtemplates != nil {
+		edges = append(edges, notifier.EdgeTemplates)
+	}
+	if m.removeddeliveries != nil {
+		edges = append(edges, notifier.EdgeDeliveries)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ItemFieldMutation) RemovedIDs(name string) []ent.Value {
+func (m *NotifierMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case notifier.EdgeTemplates:
+		ids := make([]ent.Value, 0, len(m.removedtemplates))
+		for id := range m.removedtemplates {
+			ids = append(ids, id)
+		}
+		return ids
+	case notifier.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ItemFieldMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareditem {
-		edges = append(edges, itemfield.EdgeItem)
+func (m *NotifierMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedgroup {
+		edges = append(edges, notifier.EdgeGroup)
+	}
+	if m.cleareduser {
+		edges = append(edges, notifier.EdgeUser)
+	}
+	if m.clearedtemplates {
+		edges = append(edges, notifier.EdgeTemplates)
+	}
+	if m.cleareddeliveries {
+		edges = append(edges, notifier.EdgeDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ItemFieldMutation) EdgeCleared(name string) bool {
+func (m *NotifierMutation) EdgeCleared(name string) bool {
 	switch name {
-	case itemfield.EdgeItem:
-		return m.cleareditem
+	case notifier.EdgeGroup:
+		return m.clearedgroup
+	case notifier.EdgeUser:
+		return m.cleareduser
+	case notifier.EdgeTemplates:
+		return m.clearedtemplates
+	case notifier.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ItemFieldMutation) ClearEdge(name string) error {
+func (m *NotifierMutation) ClearEdge(name string) error {
 	switch name {
-	case itemfield.EdgeItem:
-		m.ClearItem()
+	case notifier.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	case notifier.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown ItemField unique edge %s", name)
+	return fmt.Errorf("unknown Notifier unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ItemFieldMutation) ResetEdge(name string) error {
+func (m *NotifierMutation) ResetEdge(name string) error {
 	switch name {
-	case itemfield.EdgeItem:
-		m.ResetItem()
+	case notifier.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case notifier.EdgeUser:
+		m.ResetUser()
+		return nil
+	case notifier.EdgeTemplates:
+		m.ResetTemplates()
+		return nil
+	case notifier.EdgeDeliveries:
+		m.ResetDeliveries()
 		return nil
 	}
-	return fmt.Errorf("unknown ItemField edge %s", name)
+	return fmt.Errorf("unknown Notifier edge %s", name)
 }
 
-// LabelMutation represents an operation that mutates the Label nodes in the graph.
-type LabelMutation struct {
+// NotifierDeliveryMutation represents an operation that mutates the NotifierDelivery nodes in the graph.
+type NotifierDeliveryMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	created_at    *time.Time
-	updated_at    *time.Time
-	name          *string
-	description   *string
-	color         *string
-	clearedFields map[string]struct{}
-	group         *uuid.UUID
-	clearedgroup  bool
-	items         map[uuid.UUID]struct{}
-	removeditems  map[uuid.UUID]struct{}
-	cleareditems  bool
-	done          bool
-	oldValue      func(context.Context) (*Label, error)
-	predicates    []predicate.Label
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	created_at      *time.Time
+	updated_at      *time.Time
+	_type           *string
+	status          *notifierdelivery.Status
+	attempt         *int
+	addattempt      *int
+	error           *string
+	clearedFields   map[string]struct{}
+	notifier        *uuid.UUID
+	clearednotifier bool
+	done            bool
+	oldValue        func(context.Context) (*NotifierDelivery, error)
+	predicates      []predicate.NotifierDelivery
 }
 
-var _ ent.Mutation = (*LabelMutation)(nil)
+var _ ent.Mutation = (*NotifierDeliveryMutation)(nil)
 
-// labelOption allows management of the mutation configuration using functional options.
-type labelOption func(*LabelMutation)
+// notifierdeliveryOption allows management of the mutation configuration using functional options.
+type notifierdeliveryOption func(*NotifierDeliveryMutation)
 
-// newLabelMutation creates new mutation for the Label entity.
-func newLabelMutation(c config, op Op, opts ...labelOption) *LabelMutation {
-	m := &LabelMutation{
+// newNotifierDeliveryMutation creates new mutation for the NotifierDelivery entity.
+func newNotifierDeliveryMutation(c config, op Op, opts ...notifierdeliveryOption) *NotifierDeliveryMutation {
+	m := &NotifierDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLabel,
+		typ:           TypeNotifierDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7543,20 +17818,20 @@ func newLabelMutation(c config, op Op, opts ...labelOption) *LabelMutation {
 	return m
 }
 
-// withLabelID sets the ID field of the mutation.
-func withLabelID(id uuid.UUID) labelOption {
-	return func(m *LabelMutation) {
+// withNotifierDeliveryID sets the ID field of the mutation.
+func withNotifierDeliveryID(id uuid.UUID) notifierdeliveryOption {
+	return func(m *NotifierDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Label
+			value *NotifierDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*Label, error) {
+		m.oldValue = func(ctx context.Context) (*NotifierDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Label.Get(ctx, id)
+					value, err = m.Client().NotifierDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7565,10 +17840,10 @@ func withLabelID(id uuid.UUID) labelOption {
 	}
 }
 
-// withLabel sets the old Label of the mutation.
-func withLabel(node *Label) labelOption {
-	return func(m *LabelMutation) {
-		m.oldValue = func(context.Context) (*Label, error) {
+// withNotifierDelivery sets the old NotifierDelivery of the mutation.
+func withNotifierDelivery(node *NotifierDelivery) notifierdeliveryOption {
+	return func(m *NotifierDeliveryMutation) {
+		m.oldValue = func(context.Context) (*NotifierDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7577,7 +17852,7 @@ func withLabel(node *Label) labelOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LabelMutation) Client() *Client {
+func (m NotifierDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7585,7 +17860,7 @@ func (m LabelMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LabelMutation) Tx() (*Tx, error) {
+func (m NotifierDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7595,14 +17870,14 @@ func (m LabelMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Label entities.
-func (m *LabelMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of NotifierDelivery entities.
+func (m *NotifierDeliveryMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LabelMutation) ID() (id uuid.UUID, exists bool) {
+func (m *NotifierDeliveryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7613,7 +17888,7 @@ func (m *LabelMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LabelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *NotifierDeliveryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7622,19 +17897,19 @@ func (m *LabelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Label.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().NotifierDelivery.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *LabelMutation) SetCreatedAt(t time.Time) {
+func (m *NotifierDeliveryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *LabelMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *NotifierDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -7642,10 +17917,10 @@ func (m *LabelMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Label entity.
-// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the NotifierDelivery entity.
+// If the NotifierDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LabelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NotifierDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -7660,17 +17935,17 @@ func (m *LabelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *LabelMutation) ResetCreatedAt() {
+func (m *NotifierDeliveryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *LabelMutation) SetUpdatedAt(t time.Time) {
+func (m *NotifierDeliveryMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *LabelMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *NotifierDeliveryMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -7678,10 +17953,10 @@ func (m *LabelMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Label entity.
-// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the NotifierDelivery entity.
+// If the NotifierDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LabelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NotifierDeliveryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -7696,246 +17971,259 @@ func (m *LabelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *LabelMutation) ResetUpdatedAt() {
+func (m *NotifierDeliveryMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetName sets the "name" field.
-func (m *LabelMutation) SetName(s string) {
-	m.name = &s
+// SetNotifierID sets the "notifier_id" field.
+func (m *NotifierDeliveryMutation) SetNotifierID(u uuid.UUID) {
+	m.notifier = &u
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *LabelMutation) Name() (r string, exists bool) {
-	v := m.name
+// NotifierID returns the value of the "notifier_id" field in the mutation.
+func (m *NotifierDeliveryMutation) NotifierID() (r uuid.UUID, exists bool) {
+	v := m.notifier
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Label entity.
-// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// OldNotifierID returns the old "notifier_id" field's value of the NotifierDelivery entity.
+// If the NotifierDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LabelMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *NotifierDeliveryMutation) OldNotifierID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotifierID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldNotifierID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotifierID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.NotifierID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *LabelMutation) ResetName() {
-	m.name = nil
+// ResetNotifierID resets all changes to the "notifier_id" field.
+func (m *NotifierDeliveryMutation) ResetNotifierID() {
+	m.notifier = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *LabelMutation) SetDescription(s string) {
-	m.description = &s
+// SetType sets the "type" field.
+func (m *NotifierDeliveryMutation) SetType(s string) {
+	m._type = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *LabelMutation) Description() (r string, exists bool) {
-	v := m.description
+// GetType returns the value of the "type" field in the mutation.
+func (m *NotifierDeliveryMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Label entity.
-// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the NotifierDelivery entity.
+// If the NotifierDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LabelMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *NotifierDeliveryMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *LabelMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[label.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *LabelMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[label.FieldDescription]
-	return ok
+	return oldValue.Type, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *LabelMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, label.FieldDescription)
+// ResetType resets all changes to the "type" field.
+func (m *NotifierDeliveryMutation) ResetType() {
+	m._type = nil
 }
 
-// SetColor sets the "color" field.
-func (m *LabelMutation) SetColor(s string) {
-	m.color = &s
+// SetStatus sets the "status" field.
+func (m *NotifierDeliveryMutation) SetStatus(n notifierdelivery.Status) {
+	m.status = &n
 }
 
-// Color returns the value of the "color" field in the mutation.
-func (m *LabelMutation) Color() (r string, exists bool) {
-	v := m.color
+// Status returns the value of the "status" field in the mutation.
+func (m *NotifierDeliveryMutation) Status() (r notifierdelivery.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldColor returns the old "color" field's value of the Label entity.
-// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the NotifierDelivery entity.
+// If the NotifierDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LabelMutation) OldColor(ctx context.Context) (v string, err error) {
+func (m *NotifierDeliveryMutation) OldStatus(ctx context.Context) (v notifierdelivery.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldColor requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Color, nil
+	return oldValue.Status, nil
 }
 
-// ClearColor clears the value of the "color" field.
-func (m *LabelMutation) ClearColor() {
-	m.color = nil
-	m.clearedFields[label.FieldColor] = struct{}{}
-}
-
-// ColorCleared returns if the "color" field was cleared in this mutation.
-func (m *LabelMutation) ColorCleared() bool {
-	_, ok := m.clearedFields[label.FieldColor]
-	return ok
+// ResetStatus resets all changes to the "status" field.
+func (m *NotifierDeliveryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// ResetColor resets all changes to the "color" field.
-func (m *LabelMutation) ResetColor() {
-	m.color = nil
-	delete(m.clearedFields, label.FieldColor)
+// SetAttempt sets the "attempt" field.
+func (m *NotifierDeliveryMutation) SetAttempt(i int) {
+	m.attempt = &i
+	m.addattempt = nil
 }
 
-// SetGroupID sets the "group" edge to the Group entity by id.
-func (m *LabelMutation) SetGroupID(id uuid.UUID) {
-	m.group = &id
+// Attempt returns the value of the "attempt" field in the mutation.
+func (m *NotifierDeliveryMutation) Attempt() (r int, exists bool) {
+	v := m.attempt
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearGroup clears the "group" edge to the Group entity.
-func (m *LabelMutation) ClearGroup() {
-	m.clearedgroup = true
+// OldAttempt returns the old "attempt" field's value of the NotifierDelivery entity.
+// If the NotifierDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotifierDeliveryMutation) OldAttempt(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempt: %w", err)
+	}
+	return oldValue.Attempt, nil
 }
 
-// GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *LabelMutation) GroupCleared() bool {
-	return m.clearedgroup
+// AddAttempt adds i to the "attempt" field.
+func (m *NotifierDeliveryMutation) AddAttempt(i int) {
+	if m.addattempt != nil {
+		*m.addattempt += i
+	} else {
+		m.addattempt = &i
+	}
 }
 
-// GroupID returns the "group" edge ID in the mutation.
-func (m *LabelMutation) GroupID() (id uuid.UUID, exists bool) {
-	if m.group != nil {
-		return *m.group, true
+// AddedAttempt returns the value that was added to the "attempt" field in this mutation.
+func (m *NotifierDeliveryMutation) AddedAttempt() (r int, exists bool) {
+	v := m.addattempt
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// GroupIDs returns the "group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *LabelMutation) GroupIDs() (ids []uuid.UUID) {
-	if id := m.group; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ResetAttempt resets all changes to the "attempt" field.
+func (m *NotifierDeliveryMutation) ResetAttempt() {
+	m.attempt = nil
+	m.addattempt = nil
 }
 
-// ResetGroup resets all changes to the "group" edge.
-func (m *LabelMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
+// SetError sets the "error" field.
+func (m *NotifierDeliveryMutation) SetError(s string) {
+	m.error = &s
 }
 
-// AddItemIDs adds the "items" edge to the Item entity by ids.
-func (m *LabelMutation) AddItemIDs(ids ...uuid.UUID) {
-	if m.items == nil {
-		m.items = make(map[uuid.UUID]struct{})
+// Error returns the value of the "error" field in the mutation.
+func (m *NotifierDeliveryMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the NotifierDelivery entity.
+// If the NotifierDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotifierDeliveryMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.items[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
 }
 
-// ClearItems clears the "items" edge to the Item entity.
-func (m *LabelMutation) ClearItems() {
-	m.cleareditems = true
+// ClearError clears the value of the "error" field.
+func (m *NotifierDeliveryMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[notifierdelivery.FieldError] = struct{}{}
 }
 
-// ItemsCleared reports if the "items" edge to the Item entity was cleared.
-func (m *LabelMutation) ItemsCleared() bool {
-	return m.cleareditems
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *NotifierDeliveryMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[notifierdelivery.FieldError]
+	return ok
 }
 
-// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
-func (m *LabelMutation) RemoveItemIDs(ids ...uuid.UUID) {
-	if m.removeditems == nil {
-		m.removeditems = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.items, ids[i])
-		m.removeditems[ids[i]] = struct{}{}
-	}
+// ResetError resets all changes to the "error" field.
+func (m *NotifierDeliveryMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, notifierdelivery.FieldError)
 }
 
-// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
-func (m *LabelMutation) RemovedItemsIDs() (ids []uuid.UUID) {
-	for id := range m.removeditems {
-		ids = append(ids, id)
-	}
-	return
+// ClearNotifier clears the "notifier" edge to the Notifier entity.
+func (m *NotifierDeliveryMutation) ClearNotifier() {
+	m.clearednotifier = true
+	m.clearedFields[notifierdelivery.FieldNotifierID] = struct{}{}
 }
 
-// ItemsIDs returns the "items" edge IDs in the mutation.
-func (m *LabelMutation) ItemsIDs() (ids []uuid.UUID) {
-	for id := range m.items {
-		ids = append(ids, id)
+// NotifierCleared reports if the "notifier" edge to the Notifier entity was cleared.
+func (m *NotifierDeliveryMutation) NotifierCleared() bool {
+	return m.clearednotifier
+}
+
+// NotifierIDs returns the "notifier" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NotifierID instead. It exists only for internal usage by the builders.
+func (m *NotifierDeliveryMutation) NotifierIDs() (ids []uuid.UUID) {
+	if id := m.notifier; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetItems resets all changes to the "items" edge.
-func (m *LabelMutation) ResetItems() {
-	m.items = nil
-	m.cleareditems = false
-	m.removeditems = nil
+// ResetNotifier resets all changes to the "notifier" edge.
+func (m *NotifierDeliveryMutation) ResetNotifier() {
+	m.notifier = nil
+	m.clearednotifier = false
 }
 
-// Where appends a list predicates to the LabelMutation builder.
-func (m *LabelMutation) Where(ps ...predicate.Label) {
+// Where appends a list predicates to the NotifierDeliveryMutation builder.
+func (m *NotifierDeliveryMutation) Where(ps ...predicate.NotifierDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LabelMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NotifierDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LabelMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Label, len(ps))
+func (m *NotifierDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotifierDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7943,39 +18231,45 @@ func (m *LabelMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LabelMutation) Op() Op {
+func (m *NotifierDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LabelMutation) SetOp(op Op) {
+func (m *NotifierDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Label).
-func (m *LabelMutation) Type() string {
+// Type returns the node type of this mutation (NotifierDelivery).
+func (m *NotifierDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LabelMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *NotifierDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.created_at != nil {
-		fields = append(fields, label.FieldCreatedAt)
+		fields = append(fields, notifierdelivery.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, label.FieldUpdatedAt)
+		fields = append(fields, notifierdelivery.FieldUpdatedAt)
 	}
-	if m.name != nil {
-		fields = append(fields, label.FieldName)
+	if m.notifier != nil {
+		fields = append(fields, notifierdelivery.FieldNotifierID)
 	}
-	if m.description != nil {
-		fields = append(fields, label.FieldDescription)
+	if m._type != nil {
+		fields = append(fields, notifierdelivery.FieldType)
 	}
-	if m.color != nil {
-		fields = append(fields, label.FieldColor)
+	if m.status != nil {
+		fields = append(fields, notifierdelivery.FieldStatus)
+	}
+	if m.attempt != nil {
+		fields = append(fields, notifierdelivery.FieldAttempt)
+	}
+	if m.error != nil {
+		fields = append(fields, notifierdelivery.FieldError)
 	}
 	return fields
 }
@@ -7983,18 +18277,22 @@ func (m *LabelMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LabelMutation) Field(name string) (ent.Value, bool) {
+func (m *NotifierDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case label.FieldCreatedAt:
+	case notifierdelivery.FieldCreatedAt:
 		return m.CreatedAt()
-	case label.FieldUpdatedAt:
+	case notifierdelivery.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case label.FieldName:
-		return m.Name()
-	case label.FieldDescription:
-		return m.Description()
-	case label.FieldColor:
-		return m.Color()
+	case notifierdelivery.FieldNotifierID:
+		return m.NotifierID()
+	case notifierdelivery.FieldType:
+		return m.GetType()
+	case notifierdelivery.FieldStatus:
+		return m.Status()
+	case notifierdelivery.FieldAttempt:
+		return m.Attempt()
+	case notifierdelivery.FieldError:
+		return m.Error()
 	}
 	return nil, false
 }
@@ -8002,306 +18300,305 @@ func (m *LabelMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LabelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *NotifierDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case label.FieldCreatedAt:
+	case notifierdelivery.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case label.FieldUpdatedAt:
+	case notifierdelivery.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case label.FieldName:
-		return m.OldName(ctx)
-	case label.FieldDescription:
-		return m.OldDescription(ctx)
-	case label.FieldColor:
-		return m.OldColor(ctx)
+	case notifierdelivery.FieldNotifierID:
+		return m.OldNotifierID(ctx)
+	case notifierdelivery.FieldType:
+		return m.OldType(ctx)
+	case notifierdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case notifierdelivery.FieldAttempt:
+		return m.OldAttempt(ctx)
+	case notifierdelivery.FieldError:
+		return m.OldError(ctx)
 	}
-	return nil, fmt.Errorf("unknown Label field %s", name)
+	return nil, fmt.Errorf("unknown NotifierDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LabelMutation) SetField(name string, value ent.Value) error {
+func (m *NotifierDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case label.FieldCreatedAt:
+	case notifierdelivery.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case label.FieldUpdatedAt:
+	case notifierdelivery.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case label.FieldName:
-		v, ok := value.(string)
+	case notifierdelivery.FieldNotifierID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetNotifierID(v)
 		return nil
-	case label.FieldDescription:
+	case notifierdelivery.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetType(v)
 		return nil
-	case label.FieldColor:
+	case notifierdelivery.FieldStatus:
+		v, ok := value.(notifierdelivery.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case notifierdelivery.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempt(v)
+		return nil
+	case notifierdelivery.FieldError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetColor(v)
+		m.SetError(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Label field %s", name)
+	return fmt.Errorf("unknown NotifierDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LabelMutation) AddedFields() []string {
-	return nil
+func (m *NotifierDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempt != nil {
+		fields = append(fields, notifierdelivery.FieldAttempt)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LabelMutation) AddedField(name string) (ent.Value, bool) {
+func (m *NotifierDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case notifierdelivery.FieldAttempt:
+		return m.AddedAttempt()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LabelMutation) AddField(name string, value ent.Value) error {
+func (m *NotifierDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case notifierdelivery.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Label numeric field %s", name)
+	return fmt.Errorf("unknown NotifierDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LabelMutation) ClearedFields() []string {
+func (m *NotifierDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(label.FieldDescription) {
-		fields = append(fields, label.FieldDescription)
-	}
-	if m.FieldCleared(label.FieldColor) {
-		fields = append(fields, label.FieldColor)
+	if m.FieldCleared(notifierdelivery.FieldError) {
+		fields = append(fields, notifierdelivery.FieldError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LabelMutation) FieldCleared(name string) bool {
+func (m *NotifierDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LabelMutation) ClearField(name string) error {
+func (m *NotifierDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case label.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case label.FieldColor:
-		m.ClearColor()
+	case notifierdelivery.FieldError:
+		m.ClearError()
 		return nil
 	}
-	return fmt.Errorf("unknown Label nullable field %s", name)
+	return fmt.Errorf("unknown NotifierDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LabelMutation) ResetField(name string) error {
+func (m *NotifierDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case label.FieldCreatedAt:
+	case notifierdelivery.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case label.FieldUpdatedAt:
+	case notifierdelivery.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case label.FieldName:
-		m.ResetName()
+	case notifierdelivery.FieldNotifierID:
+		m.ResetNotifierID()
 		return nil
-	case label.FieldDescription:
-		m.ResetDescription()
+	case notifierdelivery.FieldType:
+		m.ResetType()
 		return nil
-	case label.FieldColor:
-		m.ResetColor()
+	case notifierdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case notifierdelivery.FieldAttempt:
+		m.ResetAttempt()
+		return nil
+	case notifierdelivery.FieldError:
+		m.ResetError()
 		return nil
 	}
-	return fmt.Errorf("unknown Label field %s", name)
+	return fmt.Errorf("unknown NotifierDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LabelMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.group != nil {
-		edges = append(edges, label.EdgeGroup)
-	}
-	if m.items != nil {
-		edges = append(edges, label.EdgeItems)
+func (m *NotifierDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.notifier != nil {
+		edges = append(edges, notifierdelivery.EdgeNotifier)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LabelMutation) AddedIDs(name string) []ent.Value {
+func (m *NotifierDeliveryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case label.EdgeGroup:
-		if id := m.group; id != nil {
+	case notifierdelivery.EdgeNotifier:
+		if id := m.notifier; id != nil {
 			return []ent.Value{*id}
 		}
-	case label.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.items))
-		for id := range m.items {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LabelMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removeditems != nil {
-		edges = append(edges, label.EdgeItems)
-	}
+func (m *NotifierDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LabelMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case label.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.removeditems))
-		for id := range m.removeditems {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *NotifierDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LabelMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedgroup {
-		edges = append(edges, label.EdgeGroup)
-	}
-	if m.cleareditems {
-		edges = append(edges, label.EdgeItems)
+func (m *NotifierDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearednotifier {
+		edges = append(edges, notifierdelivery.EdgeNotifier)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LabelMutation) EdgeCleared(name string) bool {
+func (m *NotifierDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case label.EdgeGroup:
-		return m.clearedgroup
-	case label.EdgeItems:
-		return m.cleareditems
+	case notifierdelivery.EdgeNotifier:
+		return m.clearednotifier
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LabelMutation) ClearEdge(name string) error {
+func (m *NotifierDeliveryMutation) ClearEdge(name string) error {
 	switch name {
-	case label.EdgeGroup:
-		m.ClearGroup()
+	case notifierdelivery.EdgeNotifier:
+		m.ClearNotifier()
 		return nil
 	}
-	return fmt.Errorf("unknown Label unique edge %s", name)
+	return fmt.Errorf("unknown NotifierDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LabelMutation) ResetEdge(name string) error {
+func (m *NotifierDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case label.EdgeGroup:
-		m.ResetGroup()
-		return nil
-	case label.EdgeItems:
-		m.ResetItems()
+	case notifierdelivery.EdgeNotifier:
+		m.ResetNotifier()
 		return nil
 	}
-	return fmt.Errorf("unknown Label edge %s", name)
+	return fmt.Errorf("unknown NotifierDelivery edge %s", name)
 }
 
-// LocationMutation represents an operation that mutates the Location nodes in the graph.
-type LocationMutation struct {
+// SavedSearchMutation represents an operation that mutates the SavedSearch nodes in the graph.
+type SavedSearchMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	created_at      *time.Time
-	updated_at      *time.Time
-	name            *string
-	description     *string
-	clearedFields   map[string]struct{}
-	group           *uuid.UUID
-	clearedgroup    bool
-	parent          *uuid.UUID
-	clearedparent   bool
-	children        map[uuid.UUID]struct{}
-	removedchildren map[uuid.UUID]struct{}
-	clearedchildren bool
-	items           map[uuid.UUID]struct{}
-	removeditems    map[uuid.UUID]struct{}
-	cleareditems    bool
-	done            bool
-	oldValue        func(context.Context) (*Location, error)
-	predicates      []predicate.Location
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	name          *string
+	description   *string
+	query         *types.SavedQuery
+	order_by      *savedsearch.OrderBy
+	clearedFields map[string]struct{}
+	group         *uuid.UUID
+	clearedgroup  bool
+	done          bool
+	oldValue      func(context.Context) (*SavedSearch, error)
+	predicates    []predicate.SavedSearch
 }
 
-var _ ent.Mutation = (*LocationMutation)(nil)
+var _ ent.Mutation = (*SavedSearchMutation)(nil)
 
-// locationOption allows management of the mutation configuration using functional options.
-type locationOption func(*LocationMutation)
+// savedsearchOption allows management of the mutation configuration using functional options.
+type savedsearchOption func(*SavedSearchMutation)
 
-// newLocationMutation creates new mutation for the Location entity.
-func newLocationMutation(c config, op Op, opts ...locationOption) *LocationMutation {
-	m := &LocationMutation{
+// newSavedSearchMutation creates new mutation for the SavedSearch entity.
+func newSavedSearchMutation(c config, op Op, opts ...savedsearchOption) *SavedSearchMutation {
+	m := &SavedSearchMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLocation,
+		typ:           TypeSavedSearch,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
 		opt(m)
 	}
 	return m
-}
-
-// withLocationID sets the ID field of the mutation.
-func withLocationID(id uuid.UUID) locationOption {
-	return func(m *LocationMutation) {
+}
+
+// withSavedSearchID sets the ID field of the mutation.
+func withSavedSearchID(id uuid.UUID) savedsearchOption {
+	return func(m *SavedSearchMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Location
+			value *SavedSearch
 		)
-		m.oldValue = func(ctx context.Context) (*Location, error) {
+		m.oldValue = func(ctx context.Context) (*SavedSearch, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Location.Get(ctx, id)
+					value, err = m.Client().SavedSearch.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8310,10 +18607,10 @@ func withLocationID(id uuid.UUID) locationOption {
 	}
 }
 
-// withLocation sets the old Location of the mutation.
-func withLocation(node *Location) locationOption {
-	return func(m *LocationMutation) {
-		m.oldValue = func(context.Context) (*Location, error) {
+// withSavedSearch sets the old SavedSearch of the mutation.
+func withSavedSearch(node *SavedSearch) savedsearchOption {
+	return func(m *SavedSearchMutation) {
+		m.oldValue = func(context.Context) (*SavedSearch, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8322,7 +18619,7 @@ func withLocation(node *Location) locationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LocationMutation) Client() *Client {
+func (m SavedSearchMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8330,7 +18627,7 @@ func (m LocationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LocationMutation) Tx() (*Tx, error) {
+func (m SavedSearchMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8340,14 +18637,14 @@ func (m LocationMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Location entities.
-func (m *LocationMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of SavedSearch entities.
+func (m *SavedSearchMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LocationMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SavedSearchMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8358,7 +18655,7 @@ func (m *LocationMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LocationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SavedSearchMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8367,19 +18664,19 @@ func (m *LocationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Location.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SavedSearch.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *LocationMutation) SetCreatedAt(t time.Time) {
+func (m *SavedSearchMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *LocationMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SavedSearchMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -8387,10 +18684,10 @@ func (m *LocationMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Location entity.
-// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LocationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SavedSearchMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -8405,17 +18702,17 @@ func (m *LocationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *LocationMutation) ResetCreatedAt() {
+func (m *SavedSearchMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *LocationMutation) SetUpdatedAt(t time.Time) {
+func (m *SavedSearchMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *LocationMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *SavedSearchMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -8423,10 +18720,10 @@ func (m *LocationMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Location entity.
-// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LocationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SavedSearchMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -8441,17 +18738,17 @@ func (m *LocationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *LocationMutation) ResetUpdatedAt() {
+func (m *SavedSearchMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetName sets the "name" field.
-func (m *LocationMutation) SetName(s string) {
+func (m *SavedSearchMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *LocationMutation) Name() (r string, exists bool) {
+func (m *SavedSearchMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -8459,10 +18756,10 @@ func (m *LocationMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Location entity.
-// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LocationMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *SavedSearchMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -8477,17 +18774,17 @@ func (m *LocationMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *LocationMutation) ResetName() {
+func (m *SavedSearchMutation) ResetName() {
 	m.name = nil
 }
 
 // SetDescription sets the "description" field.
-func (m *LocationMutation) SetDescription(s string) {
+func (m *SavedSearchMutation) SetDescription(s string) {
 	m.description = &s
 }
 
 // Description returns the value of the "description" field in the mutation.
-func (m *LocationMutation) Description() (r string, exists bool) {
+func (m *SavedSearchMutation) Description() (r string, exists bool) {
 	v := m.description
 	if v == nil {
 		return
@@ -8495,10 +18792,10 @@ func (m *LocationMutation) Description() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Location entity.
-// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LocationMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *SavedSearchMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
@@ -8513,40 +18810,112 @@ func (m *LocationMutation) OldDescription(ctx context.Context) (v string, err er
 }
 
 // ClearDescription clears the value of the "description" field.
-func (m *LocationMutation) ClearDescription() {
+func (m *SavedSearchMutation) ClearDescription() {
 	m.description = nil
-	m.clearedFields[location.FieldDescription] = struct{}{}
+	m.clearedFields[savedsearch.FieldDescription] = struct{}{}
 }
 
 // DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *LocationMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[location.FieldDescription]
+func (m *SavedSearchMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[savedsearch.FieldDescription]
 	return ok
 }
 
 // ResetDescription resets all changes to the "description" field.
-func (m *LocationMutation) ResetDescription() {
+func (m *SavedSearchMutation) ResetDescription() {
 	m.description = nil
-	delete(m.clearedFields, location.FieldDescription)
+	delete(m.clearedFields, savedsearch.FieldDescription)
+}
+
+// SetQuery sets the "query" field.
+func (m *SavedSearchMutation) SetQuery(tq types.SavedQuery) {
+	m.query = &tq
+}
+
+// Query returns the value of the "query" field in the mutation.
+func (m *SavedSearchMutation) Query() (r types.SavedQuery, exists bool) {
+	v := m.query
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuery returns the old "query" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldQuery(ctx context.Context) (v types.SavedQuery, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuery is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuery requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuery: %w", err)
+	}
+	return oldValue.Query, nil
+}
+
+// ResetQuery resets all changes to the "query" field.
+func (m *SavedSearchMutation) ResetQuery() {
+	m.query = nil
+}
+
+// SetOrderBy sets the "order_by" field.
+func (m *SavedSearchMutation) SetOrderBy(sb savedsearch.OrderBy) {
+	m.order_by = &sb
+}
+
+// OrderBy returns the value of the "order_by" field in the mutation.
+func (m *SavedSearchMutation) OrderBy() (r savedsearch.OrderBy, exists bool) {
+	v := m.order_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrderBy returns the old "order_by" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldOrderBy(ctx context.Context) (v savedsearch.OrderBy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderBy: %w", err)
+	}
+	return oldValue.OrderBy, nil
+}
+
+// ResetOrderBy resets all changes to the "order_by" field.
+func (m *SavedSearchMutation) ResetOrderBy() {
+	m.order_by = nil
 }
 
 // SetGroupID sets the "group" edge to the Group entity by id.
-func (m *LocationMutation) SetGroupID(id uuid.UUID) {
+func (m *SavedSearchMutation) SetGroupID(id uuid.UUID) {
 	m.group = &id
 }
 
 // ClearGroup clears the "group" edge to the Group entity.
-func (m *LocationMutation) ClearGroup() {
+func (m *SavedSearchMutation) ClearGroup() {
 	m.clearedgroup = true
 }
 
 // GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *LocationMutation) GroupCleared() bool {
+func (m *SavedSearchMutation) GroupCleared() bool {
 	return m.clearedgroup
 }
 
 // GroupID returns the "group" edge ID in the mutation.
-func (m *LocationMutation) GroupID() (id uuid.UUID, exists bool) {
+func (m *SavedSearchMutation) GroupID() (id uuid.UUID, exists bool) {
 	if m.group != nil {
 		return *m.group, true
 	}
@@ -8556,7 +18925,7 @@ func (m *LocationMutation) GroupID() (id uuid.UUID, exists bool) {
 // GroupIDs returns the "group" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // GroupID instead. It exists only for internal usage by the builders.
-func (m *LocationMutation) GroupIDs() (ids []uuid.UUID) {
+func (m *SavedSearchMutation) GroupIDs() (ids []uuid.UUID) {
 	if id := m.group; id != nil {
 		ids = append(ids, *id)
 	}
@@ -8564,1004 +18933,1389 @@ func (m *LocationMutation) GroupIDs() (ids []uuid.UUID) {
 }
 
 // ResetGroup resets all changes to the "group" edge.
-func (m *LocationMutation) ResetGroup() {
+func (m *SavedSearchMutation) ResetGroup() {
 	m.group = nil
 	m.clearedgroup = false
 }
 
-// SetParentID sets the "parent" edge to the Location entity by id.
-func (m *LocationMutation) SetParentID(id uuid.UUID) {
-	m.parent = &id
+// Where appends a list predicates to the SavedSearchMutation builder.
+func (m *SavedSearchMutation) Where(ps ...predicate.SavedSearch) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearParent clears the "parent" edge to the Location entity.
-func (m *LocationMutation) ClearParent() {
-	m.clearedparent = true
+// WhereP appends storage-level predicates to the SavedSearchMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SavedSearchMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SavedSearch, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// ParentCleared reports if the "parent" edge to the Location entity was cleared.
-func (m *LocationMutation) ParentCleared() bool {
-	return m.clearedparent
+// Op returns the operation name.
+func (m *SavedSearchMutation) Op() Op {
+	return m.op
 }
 
-// ParentID returns the "parent" edge ID in the mutation.
-func (m *LocationMutation) ParentID() (id uuid.UUID, exists bool) {
-	if m.parent != nil {
-		return *m.parent, true
+// SetOp allows setting the mutation operation.
+func (m *SavedSearchMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SavedSearch).
+func (m *SavedSearchMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SavedSearchMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, savedsearch.FieldCreatedAt)
 	}
-	return
+	if m.updated_at != nil {
+		fields = append(fields, savedsearch.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, savedsearch.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, savedsearch.FieldDescription)
+	}
+	if m.query != nil {
+		fields = append(fields, savedsearch.FieldQuery)
+	}
+	if m.order_by != nil {
+		fields = append(fields, savedsearch.FieldOrderBy)
+	}
+	return fields
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *LocationMutation) ParentIDs() (ids []uuid.UUID) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SavedSearchMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case savedsearch.FieldCreatedAt:
+		return m.CreatedAt()
+	case savedsearch.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case savedsearch.FieldName:
+		return m.Name()
+	case savedsearch.FieldDescription:
+		return m.Description()
+	case savedsearch.FieldQuery:
+		return m.Query()
+	case savedsearch.FieldOrderBy:
+		return m.OrderBy()
 	}
-	return
+	return nil, false
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *LocationMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SavedSearchMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case savedsearch.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case savedsearch.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case savedsearch.FieldName:
+		return m.OldName(ctx)
+	case savedsearch.FieldDescription:
+		return m.OldDescription(ctx)
+	case savedsearch.FieldQuery:
+		return m.OldQuery(ctx)
+	case savedsearch.FieldOrderBy:
+		return m.OldOrderBy(ctx)
+	}
+	return nil, fmt.Errorf("unknown SavedSearch field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedSearchMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case savedsearch.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case savedsearch.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case savedsearch.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case savedsearch.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case savedsearch.FieldQuery:
+		v, ok := value.(types.SavedQuery)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuery(v)
+		return nil
+	case savedsearch.FieldOrderBy:
+		v, ok := value.(savedsearch.OrderBy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SavedSearch field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SavedSearchMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SavedSearchMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedSearchMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SavedSearch numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SavedSearchMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(savedsearch.FieldDescription) {
+		fields = append(fields, savedsearch.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SavedSearchMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SavedSearchMutation) ClearField(name string) error {
+	switch name {
+	case savedsearch.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedSearch nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SavedSearchMutation) ResetField(name string) error {
+	switch name {
+	case savedsearch.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case savedsearch.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case savedsearch.FieldName:
+		m.ResetName()
+		return nil
+	case savedsearch.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case savedsearch.FieldQuery:
+		m.ResetQuery()
+		return nil
+	case savedsearch.FieldOrderBy:
+		m.ResetOrderBy()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedSearch field %s", name)
 }
 
-// AddChildIDs adds the "children" edge to the Location entity by ids.
-func (m *LocationMutation) AddChildIDs(ids ...uuid.UUID) {
-	if m.children == nil {
-		m.children = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SavedSearchMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.group != nil {
+		edges = append(edges, savedsearch.EdgeGroup)
 	}
+	return edges
 }
 
-// ClearChildren clears the "children" edge to the Location entity.
-func (m *LocationMutation) ClearChildren() {
-	m.clearedchildren = true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SavedSearchMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case savedsearch.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// ChildrenCleared reports if the "children" edge to the Location entity was cleared.
-func (m *LocationMutation) ChildrenCleared() bool {
-	return m.clearedchildren
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SavedSearchMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// RemoveChildIDs removes the "children" edge to the Location entity by IDs.
-func (m *LocationMutation) RemoveChildIDs(ids ...uuid.UUID) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
-	}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SavedSearchMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// RemovedChildren returns the removed IDs of the "children" edge to the Location entity.
-func (m *LocationMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SavedSearchMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgroup {
+		edges = append(edges, savedsearch.EdgeGroup)
 	}
-	return
+	return edges
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *LocationMutation) ChildrenIDs() (ids []uuid.UUID) {
-	for id := range m.children {
-		ids = append(ids, id)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SavedSearchMutation) EdgeCleared(name string) bool {
+	switch name {
+	case savedsearch.EdgeGroup:
+		return m.clearedgroup
 	}
-	return
+	return false
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *LocationMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SavedSearchMutation) ClearEdge(name string) error {
+	switch name {
+	case savedsearch.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedSearch unique edge %s", name)
 }
 
-// AddItemIDs adds the "items" edge to the Item entity by ids.
-func (m *LocationMutation) AddItemIDs(ids ...uuid.UUID) {
-	if m.items == nil {
-		m.items = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.items[ids[i]] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SavedSearchMutation) ResetEdge(name string) error {
+	switch name {
+	case savedsearch.EdgeGroup:
+		m.ResetGroup()
+		return nil
 	}
+	return fmt.Errorf("unknown SavedSearch edge %s", name)
 }
 
-// ClearItems clears the "items" edge to the Item entity.
-func (m *LocationMutation) ClearItems() {
-	m.cleareditems = true
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *uuid.UUID
+	created_at                  *time.Time
+	updated_at                  *time.Time
+	name                        *string
+	email                       *string
+	password                    *string
+	is_superuser                *bool
+	superuser                   *bool
+	activated_on                *time.Time
+	disabled_at                 *time.Time
+	totp_secret                 *string
+	totp_enabled                *bool
+	totp_last_step              *int64
+	addtotp_last_step           *int64
+	totp_recovery_codes         *[]string
+	appendtotp_recovery_codes   []string
+	clearedFields               map[string]struct{}
+	group                       *uuid.UUID
+	clearedgroup                bool
+	memberships                 map[uuid.UUID]struct{}
+	removedmemberships          map[uuid.UUID]struct{}
+	clearedmemberships          bool
+	auth_tokens                 map[uuid.UUID]struct{}
+	removedauth_tokens          map[uuid.UUID]struct{}
+	clearedauth_tokens          bool
+	notifiers                   map[uuid.UUID]struct{}
+	removednotifiers            map[uuid.UUID]struct{}
+	clearednotifiers            bool
+	user_tokens                 map[uuid.UUID]struct{}
+	removeduser_tokens          map[uuid.UUID]struct{}
+	cleareduser_tokens          bool
+	webauthn_credentials        map[uuid.UUID]struct{}
+	removedwebauthn_credentials map[uuid.UUID]struct{}
+	clearedwebauthn_credentials bool
+	audit_entries               map[uuid.UUID]struct{}
+	removedaudit_entries        map[uuid.UUID]struct{}
+	clearedaudit_entries        bool
+	done                        bool
+	oldValue                    func(context.Context) (*User, error)
+	predicates                  []predicate.User
 }
 
-// ItemsCleared reports if the "items" edge to the Item entity was cleared.
-func (m *LocationMutation) ItemsCleared() bool {
-	return m.cleareditems
-}
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
-func (m *LocationMutation) RemoveItemIDs(ids ...uuid.UUID) {
-	if m.removeditems == nil {
-		m.removeditems = make(map[uuid.UUID]struct{})
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		delete(m.items, ids[i])
-		m.removeditems[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
-func (m *LocationMutation) RemovedItemsIDs() (ids []uuid.UUID) {
-	for id := range m.removeditems {
-		ids = append(ids, id)
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// ItemsIDs returns the "items" edge IDs in the mutation.
-func (m *LocationMutation) ItemsIDs() (ids []uuid.UUID) {
-	for id := range m.items {
-		ids = append(ids, id)
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
-}
-
-// ResetItems resets all changes to the "items" edge.
-func (m *LocationMutation) ResetItems() {
-	m.items = nil
-	m.cleareditems = false
-	m.removeditems = nil
 }
 
-// Where appends a list predicates to the LocationMutation builder.
-func (m *LocationMutation) Where(ps ...predicate.Location) {
-	m.predicates = append(m.predicates, ps...)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// WhereP appends storage-level predicates to the LocationMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LocationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Location, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	m.Where(p...)
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// Op returns the operation name.
-func (m *LocationMutation) Op() Op {
-	return m.op
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// SetOp allows setting the mutation operation.
-func (m *LocationMutation) SetOp(op Op) {
-	m.op = op
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// Type returns the node type of this mutation (Location).
-func (m *LocationMutation) Type() string {
-	return m.typ
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *LocationMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.created_at != nil {
-		fields = append(fields, location.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, location.FieldUpdatedAt)
-	}
-	if m.name != nil {
-		fields = append(fields, location.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, location.FieldDescription)
-	}
-	return fields
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *LocationMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case location.FieldCreatedAt:
-		return m.CreatedAt()
-	case location.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case location.FieldName:
-		return m.Name()
-	case location.FieldDescription:
-		return m.Description()
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *LocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case location.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case location.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case location.FieldName:
-		return m.OldName(ctx)
-	case location.FieldDescription:
-		return m.OldDescription(ctx)
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return nil, fmt.Errorf("unknown Location field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *LocationMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case location.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case location.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case location.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case location.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	return fmt.Errorf("unknown Location field %s", name)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *LocationMutation) AddedFields() []string {
-	return nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *LocationMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *LocationMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Location numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *LocationMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(location.FieldDescription) {
-		fields = append(fields, location.FieldDescription)
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *LocationMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *LocationMutation) ClearField(name string) error {
-	switch name {
-	case location.FieldDescription:
-		m.ClearDescription()
-		return nil
-	}
-	return fmt.Errorf("unknown Location nullable field %s", name)
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *LocationMutation) ResetField(name string) error {
-	switch name {
-	case location.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case location.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case location.FieldName:
-		m.ResetName()
-		return nil
-	case location.FieldDescription:
-		m.ResetDescription()
-		return nil
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Location field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LocationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.group != nil {
-		edges = append(edges, location.EdgeGroup)
-	}
-	if m.parent != nil {
-		edges = append(edges, location.EdgeParent)
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	if m.children != nil {
-		edges = append(edges, location.EdgeChildren)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
-	if m.items != nil {
-		edges = append(edges, location.EdgeItems)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return edges
+	return oldValue.Name, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *LocationMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case location.EdgeGroup:
-		if id := m.group; id != nil {
-			return []ent.Value{*id}
-		}
-	case location.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
-	case location.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
-	case location.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.items))
-		for id := range m.items {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LocationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedchildren != nil {
-		edges = append(edges, location.EdgeChildren)
-	}
-	if m.removeditems != nil {
-		edges = append(edges, location.EdgeItems)
-	}
-	return edges
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *LocationMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case location.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	case location.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.removeditems))
-		for id := range m.removeditems {
-			ids = append(ids, id)
-		}
-		return ids
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LocationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedgroup {
-		edges = append(edges, location.EdgeGroup)
-	}
-	if m.clearedparent {
-		edges = append(edges, location.EdgeParent)
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
-	if m.clearedchildren {
-		edges = append(edges, location.EdgeChildren)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
-	if m.cleareditems {
-		edges = append(edges, location.EdgeItems)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return edges
+	return oldValue.Email, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *LocationMutation) EdgeCleared(name string) bool {
-	switch name {
-	case location.EdgeGroup:
-		return m.clearedgroup
-	case location.EdgeParent:
-		return m.clearedparent
-	case location.EdgeChildren:
-		return m.clearedchildren
-	case location.EdgeItems:
-		return m.cleareditems
-	}
-	return false
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *LocationMutation) ClearEdge(name string) error {
-	switch name {
-	case location.EdgeGroup:
-		m.ClearGroup()
-		return nil
-	case location.EdgeParent:
-		m.ClearParent()
-		return nil
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Location unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *LocationMutation) ResetEdge(name string) error {
-	switch name {
-	case location.EdgeGroup:
-		m.ResetGroup()
-		return nil
-	case location.EdgeParent:
-		m.ResetParent()
-		return nil
-	case location.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	case location.EdgeItems:
-		m.ResetItems()
-		return nil
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Location edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
 }
 
-// MaintenanceEntryMutation represents an operation that mutates the MaintenanceEntry nodes in the graph.
-type MaintenanceEntryMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	created_at     *time.Time
-	updated_at     *time.Time
-	date           *time.Time
-	scheduled_date *time.Time
-	name           *string
-	description    *string
-	cost           *float64
-	addcost        *float64
-	clearedFields  map[string]struct{}
-	item           *uuid.UUID
-	cleareditem    bool
-	done           bool
-	oldValue       func(context.Context) (*MaintenanceEntry, error)
-	predicates     []predicate.MaintenanceEntry
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
 }
 
-var _ ent.Mutation = (*MaintenanceEntryMutation)(nil)
+// SetIsSuperuser sets the "is_superuser" field.
+func (m *UserMutation) SetIsSuperuser(b bool) {
+	m.is_superuser = &b
+}
 
-// maintenanceentryOption allows management of the mutation configuration using functional options.
-type maintenanceentryOption func(*MaintenanceEntryMutation)
+// IsSuperuser returns the value of the "is_superuser" field in the mutation.
+func (m *UserMutation) IsSuperuser() (r bool, exists bool) {
+	v := m.is_superuser
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newMaintenanceEntryMutation creates new mutation for the MaintenanceEntry entity.
-func newMaintenanceEntryMutation(c config, op Op, opts ...maintenanceentryOption) *MaintenanceEntryMutation {
-	m := &MaintenanceEntryMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeMaintenanceEntry,
-		clearedFields: make(map[string]struct{}),
+// OldIsSuperuser returns the old "is_superuser" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsSuperuser(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsSuperuser is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsSuperuser requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsSuperuser: %w", err)
+	}
+	return oldValue.IsSuperuser, nil
 }
 
-// withMaintenanceEntryID sets the ID field of the mutation.
-func withMaintenanceEntryID(id uuid.UUID) maintenanceentryOption {
-	return func(m *MaintenanceEntryMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *MaintenanceEntry
-		)
-		m.oldValue = func(ctx context.Context) (*MaintenanceEntry, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().MaintenanceEntry.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetIsSuperuser resets all changes to the "is_superuser" field.
+func (m *UserMutation) ResetIsSuperuser() {
+	m.is_superuser = nil
 }
 
-// withMaintenanceEntry sets the old MaintenanceEntry of the mutation.
-func withMaintenanceEntry(node *MaintenanceEntry) maintenanceentryOption {
-	return func(m *MaintenanceEntryMutation) {
-		m.oldValue = func(context.Context) (*MaintenanceEntry, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetSuperuser sets the "superuser" field.
+func (m *UserMutation) SetSuperuser(b bool) {
+	m.superuser = &b
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MaintenanceEntryMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// Superuser returns the value of the "superuser" field in the mutation.
+func (m *UserMutation) Superuser() (r bool, exists bool) {
+	v := m.superuser
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m MaintenanceEntryMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// OldSuperuser returns the old "superuser" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSuperuser(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuperuser is only allowed on UpdateOne operations")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuperuser requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuperuser: %w", err)
+	}
+	return oldValue.Superuser, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of MaintenanceEntry entities.
-func (m *MaintenanceEntryMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ResetSuperuser resets all changes to the "superuser" field.
+func (m *UserMutation) ResetSuperuser() {
+	m.superuser = nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *MaintenanceEntryMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// SetActivatedOn sets the "activated_on" field.
+func (m *UserMutation) SetActivatedOn(t time.Time) {
+	m.activated_on = &t
+}
+
+// ActivatedOn returns the value of the "activated_on" field in the mutation.
+func (m *UserMutation) ActivatedOn() (r time.Time, exists bool) {
+	v := m.activated_on
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *MaintenanceEntryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MaintenanceEntry.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldActivatedOn returns the old "activated_on" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldActivatedOn(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivatedOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivatedOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivatedOn: %w", err)
 	}
+	return oldValue.ActivatedOn, nil
+}
+
+// ClearActivatedOn clears the value of the "activated_on" field.
+func (m *UserMutation) ClearActivatedOn() {
+	m.activated_on = nil
+	m.clearedFields[user.FieldActivatedOn] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *MaintenanceEntryMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ActivatedOnCleared returns if the "activated_on" field was cleared in this mutation.
+func (m *UserMutation) ActivatedOnCleared() bool {
+	_, ok := m.clearedFields[user.FieldActivatedOn]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MaintenanceEntryMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetActivatedOn resets all changes to the "activated_on" field.
+func (m *UserMutation) ResetActivatedOn() {
+	m.activated_on = nil
+	delete(m.clearedFields, user.FieldActivatedOn)
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (m *UserMutation) SetDisabledAt(t time.Time) {
+	m.disabled_at = &t
+}
+
+// DisabledAt returns the value of the "disabled_at" field in the mutation.
+func (m *UserMutation) DisabledAt() (r time.Time, exists bool) {
+	v := m.disabled_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the MaintenanceEntry entity.
-// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldDisabledAt returns the old "disabled_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MaintenanceEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldDisabledAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDisabledAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDisabledAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDisabledAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.DisabledAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MaintenanceEntryMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (m *UserMutation) ClearDisabledAt() {
+	m.disabled_at = nil
+	m.clearedFields[user.FieldDisabledAt] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *MaintenanceEntryMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// DisabledAtCleared returns if the "disabled_at" field was cleared in this mutation.
+func (m *UserMutation) DisabledAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldDisabledAt]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MaintenanceEntryMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetDisabledAt resets all changes to the "disabled_at" field.
+func (m *UserMutation) ResetDisabledAt() {
+	m.disabled_at = nil
+	delete(m.clearedFields, user.FieldDisabledAt)
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (m *UserMutation) SetTotpSecret(s string) {
+	m.totp_secret = &s
+}
+
+// TotpSecret returns the value of the "totp_secret" field in the mutation.
+func (m *UserMutation) TotpSecret() (r string, exists bool) {
+	v := m.totp_secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the MaintenanceEntry entity.
-// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldTotpSecret returns the old "totp_secret" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MaintenanceEntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldTotpSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotpSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTotpSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotpSecret: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.TotpSecret, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MaintenanceEntryMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (m *UserMutation) ClearTotpSecret() {
+	m.totp_secret = nil
+	m.clearedFields[user.FieldTotpSecret] = struct{}{}
 }
 
-// SetItemID sets the "item_id" field.
-func (m *MaintenanceEntryMutation) SetItemID(u uuid.UUID) {
-	m.item = &u
+// TotpSecretCleared returns if the "totp_secret" field was cleared in this mutation.
+func (m *UserMutation) TotpSecretCleared() bool {
+	_, ok := m.clearedFields[user.FieldTotpSecret]
+	return ok
 }
 
-// ItemID returns the value of the "item_id" field in the mutation.
-func (m *MaintenanceEntryMutation) ItemID() (r uuid.UUID, exists bool) {
-	v := m.item
+// ResetTotpSecret resets all changes to the "totp_secret" field.
+func (m *UserMutation) ResetTotpSecret() {
+	m.totp_secret = nil
+	delete(m.clearedFields, user.FieldTotpSecret)
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (m *UserMutation) SetTotpEnabled(b bool) {
+	m.totp_enabled = &b
+}
+
+// TotpEnabled returns the value of the "totp_enabled" field in the mutation.
+func (m *UserMutation) TotpEnabled() (r bool, exists bool) {
+	v := m.totp_enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldItemID returns the old "item_id" field's value of the MaintenanceEntry entity.
-// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldTotpEnabled returns the old "totp_enabled" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MaintenanceEntryMutation) OldItemID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *UserMutation) OldTotpEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotpEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldItemID requires an ID field in the mutation")
+		return v, errors.New("OldTotpEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotpEnabled: %w", err)
 	}
-	return oldValue.ItemID, nil
+	return oldValue.TotpEnabled, nil
 }
 
-// ResetItemID resets all changes to the "item_id" field.
-func (m *MaintenanceEntryMutation) ResetItemID() {
-	m.item = nil
+// ResetTotpEnabled resets all changes to the "totp_enabled" field.
+func (m *UserMutation) ResetTotpEnabled() {
+	m.totp_enabled = nil
 }
 
-// SetDate sets the "date" field.
-func (m *MaintenanceEntryMutation) SetDate(t time.Time) {
-	m.date = &t
+// SetTotpLastStep sets the "totp_last_step" field.
+func (m *UserMutation) SetTotpLastStep(i int64) {
+	m.totp_last_step = &i
+	m.addtotp_last_step = nil
 }
 
-// Date returns the value of the "date" field in the mutation.
-func (m *MaintenanceEntryMutation) Date() (r time.Time, exists bool) {
-	v := m.date
+// TotpLastStep returns the value of the "totp_last_step" field in the mutation.
+func (m *UserMutation) TotpLastStep() (r int64, exists bool) {
+	v := m.totp_last_step
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDate returns the old "date" field's value of the MaintenanceEntry entity.
-// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldTotpLastStep returns the old "totp_last_step" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MaintenanceEntryMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldTotpLastStep(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotpLastStep is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDate requires an ID field in the mutation")
+		return v, errors.New("OldTotpLastStep requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotpLastStep: %w", err)
 	}
-	return oldValue.Date, nil
+	return oldValue.TotpLastStep, nil
 }
 
-// ClearDate clears the value of the "date" field.
-func (m *MaintenanceEntryMutation) ClearDate() {
-	m.date = nil
-	m.clearedFields[maintenanceentry.FieldDate] = struct{}{}
+// AddTotpLastStep adds i to the "totp_last_step" field.
+func (m *UserMutation) AddTotpLastStep(i int64) {
+	if m.addtotp_last_step != nil {
+		*m.addtotp_last_step += i
+	} else {
+		m.addtotp_last_step = &i
+	}
 }
 
-// DateCleared returns if the "date" field was cleared in this mutation.
-func (m *MaintenanceEntryMutation) DateCleared() bool {
-	_, ok := m.clearedFields[maintenanceentry.FieldDate]
-	return ok
+// AddedTotpLastStep returns the value that was added to the "totp_last_step" field in this mutation.
+func (m *UserMutation) AddedTotpLastStep() (r int64, exists bool) {
+	v := m.addtotp_last_step
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetDate resets all changes to the "date" field.
-func (m *MaintenanceEntryMutation) ResetDate() {
-	m.date = nil
-	delete(m.clearedFields, maintenanceentry.FieldDate)
+// ResetTotpLastStep resets all changes to the "totp_last_step" field.
+func (m *UserMutation) ResetTotpLastStep() {
+	m.totp_last_step = nil
+	m.addtotp_last_step = nil
 }
 
-// SetScheduledDate sets the "scheduled_date" field.
-func (m *MaintenanceEntryMutation) SetScheduledDate(t time.Time) {
-	m.scheduled_date = &t
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (m *UserMutation) SetTotpRecoveryCodes(s []string) {
+	m.totp_recovery_codes = &s
+	m.appendtotp_recovery_codes = nil
 }
 
-// ScheduledDate returns the value of the "scheduled_date" field in the mutation.
-func (m *MaintenanceEntryMutation) ScheduledDate() (r time.Time, exists bool) {
-	v := m.scheduled_date
+// TotpRecoveryCodes returns the value of the "totp_recovery_codes" field in the mutation.
+func (m *UserMutation) TotpRecoveryCodes() (r []string, exists bool) {
+	v := m.totp_recovery_codes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScheduledDate returns the old "scheduled_date" field's value of the MaintenanceEntry entity.
-// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldTotpRecoveryCodes returns the old "totp_recovery_codes" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MaintenanceEntryMutation) OldScheduledDate(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldTotpRecoveryCodes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScheduledDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotpRecoveryCodes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScheduledDate requires an ID field in the mutation")
+		return v, errors.New("OldTotpRecoveryCodes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotpRecoveryCodes: %w", err)
+	}
+	return oldValue.TotpRecoveryCodes, nil
+}
+
+// AppendTotpRecoveryCodes adds s to the "totp_recovery_codes" field.
+func (m *UserMutation) AppendTotpRecoveryCodes(s []string) {
+	m.appendtotp_recovery_codes = append(m.appendtotp_recovery_codes, s...)
+}
+
+// AppendedTotpRecoveryCodes returns the list of values that were appended to the "totp_recovery_codes" field in this mutation.
+func (m *UserMutation) AppendedTotpRecoveryCodes() ([]string, bool) {
+	if len(m.appendtotp_recovery_codes) == 0 {
+		return nil, false
+	}
+	return m.appendtotp_recovery_codes, true
+}
+
+// ClearTotpRecoveryCodes clears the value of the "totp_recovery_codes" field.
+func (m *UserMutation) ClearTotpRecoveryCodes() {
+	m.totp_recovery_codes = nil
+	m.appendtotp_recovery_codes = nil
+	m.clearedFields[user.FieldTotpRecoveryCodes] = struct{}{}
+}
+
+// TotpRecoveryCodesCleared returns if the "totp_recovery_codes" field was cleared in this mutation.
+func (m *UserMutation) TotpRecoveryCodesCleared() bool {
+	_, ok := m.clearedFields[user.FieldTotpRecoveryCodes]
+	return ok
+}
+
+// ResetTotpRecoveryCodes resets all changes to the "totp_recovery_codes" field.
+func (m *UserMutation) ResetTotpRecoveryCodes() {
+	m.totp_recovery_codes = nil
+	m.appendtotp_recovery_codes = nil
+	delete(m.clearedFields, user.FieldTotpRecoveryCodes)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by id.
+func (m *UserMutation) SetGroupID(id uuid.UUID) {
+	m.group = &id
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *UserMutation) ClearGroup() {
+	m.clearedgroup = true
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *UserMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupID returns the "group" edge ID in the mutation.
+func (m *UserMutation) GroupID() (id uuid.UUID, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *UserMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// AddMembershipIDs adds the "memberships" edge to the GroupMembership entity by ids.
+func (m *UserMutation) AddMembershipIDs(ids ...uuid.UUID) {
+	if m.memberships == nil {
+		m.memberships = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.memberships[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMemberships clears the "memberships" edge to the GroupMembership entity.
+func (m *UserMutation) ClearMemberships() {
+	m.clearedmemberships = true
+}
+
+// MembershipsCleared reports if the "memberships" edge to the GroupMembership entity was cleared.
+func (m *UserMutation) MembershipsCleared() bool {
+	return m.clearedmemberships
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to the GroupMembership entity by IDs.
+func (m *UserMutation) RemoveMembershipIDs(ids ...uuid.UUID) {
+	if m.removedmemberships == nil {
+		m.removedmemberships = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.memberships, ids[i])
+		m.removedmemberships[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMemberships returns the removed IDs of the "memberships" edge to the GroupMembership entity.
+func (m *UserMutation) RemovedMembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmemberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembershipsIDs returns the "memberships" edge IDs in the mutation.
+func (m *UserMutation) MembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.memberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMemberships resets all changes to the "memberships" edge.
+func (m *UserMutation) ResetMemberships() {
+	m.memberships = nil
+	m.clearedmemberships = false
+	m.removedmemberships = nil
+}
+
+// AddAuthTokenIDs adds the "auth_tokens" edge to the AuthTokens entity by ids.
+func (m *UserMutation) AddAuthTokenIDs(ids ...uuid.UUID) {
+	if m.auth_tokens == nil {
+		m.auth_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.auth_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAuthTokens clears the "auth_tokens" edge to the AuthTokens entity.
+func (m *UserMutation) ClearAuthTokens() {
+	m.clearedauth_tokens = true
+}
+
+// AuthTokensCleared reports if the "auth_tokens" edge to the AuthTokens entity was cleared.
+func (m *UserMutation) AuthTokensCleared() bool {
+	return m.clearedauth_tokens
+}
+
+// RemoveAuthTokenIDs removes the "auth_tokens" edge to the AuthTokens entity by IDs.
+func (m *UserMutation) RemoveAuthTokenIDs(ids ...uuid.UUID) {
+	if m.removedauth_tokens == nil {
+		m.removedauth_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.auth_tokens, ids[i])
+		m.removedauth_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAuthTokens returns the removed IDs of the "auth_tokens" edge to the AuthTokens entity.
+func (m *UserMutation) RemovedAuthTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedauth_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AuthTokensIDs returns the "auth_tokens" edge IDs in the mutation.
+func (m *UserMutation) AuthTokensIDs() (ids []uuid.UUID) {
+	for id := range m.auth_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAuthTokens resets all changes to the "auth_tokens" edge.
+func (m *UserMutation) ResetAuthTokens() {
+	m.auth_tokens = nil
+	m.clearedauth_tokens = false
+	m.removedauth_tokens = nil
+}
+
+// AddNotifierIDs adds the "notifiers" edge to the Notifier entity by ids.
+func (m *UserMutation) AddNotifierIDs(ids ...uuid.UUID) {
+	if m.notifiers == nil {
+		m.notifiers = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScheduledDate: %w", err)
+	for i := range ids {
+		m.notifiers[ids[i]] = struct{}{}
 	}
-	return oldValue.ScheduledDate, nil
 }
 
-// ClearScheduledDate clears the value of the "scheduled_date" field.
-func (m *MaintenanceEntryMutation) ClearScheduledDate() {
-	m.scheduled_date = nil
-	m.clearedFields[maintenanceentry.FieldScheduledDate] = struct{}{}
+// ClearNotifiers clears the "notifiers" edge to the Notifier entity.
+func (m *UserMutation) ClearNotifiers() {
+	m.clearednotifiers = true
 }
 
-// ScheduledDateCleared returns if the "scheduled_date" field was cleared in this mutation.
-func (m *MaintenanceEntryMutation) ScheduledDateCleared() bool {
-	_, ok := m.clearedFields[maintenanceentry.FieldScheduledDate]
-	return ok
+// NotifiersCleared reports if the "notifiers" edge to the Notifier entity was cleared.
+func (m *UserMutation) NotifiersCleared() bool {
+	return m.clearednotifiers
 }
 
-// ResetScheduledDate resets all changes to the "scheduled_date" field.
-func (m *MaintenanceEntryMutation) ResetScheduledDate() {
-	m.scheduled_date = nil
-	delete(m.clearedFields, maintenanceentry.FieldScheduledDate)
+// RemoveNotifierIDs removes the "notifiers" edge to the Notifier entity by IDs.
+func (m *UserMutation) RemoveNotifierIDs(ids ...uuid.UUID) {
+	if m.removednotifiers == nil {
+		m.removednotifiers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.notifiers, ids[i])
+		m.removednotifiers[ids[i]] = struct{}{}
+	}
 }
 
-// SetName sets the "name" field.
-func (m *MaintenanceEntryMutation) SetName(s string) {
-	m.name = &s
+// RemovedNotifiers returns the removed IDs of the "notifiers" edge to the Notifier entity.
+func (m *UserMutation) RemovedNotifiersIDs() (ids []uuid.UUID) {
+	for id := range m.removednotifiers {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *MaintenanceEntryMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+// NotifiersIDs returns the "notifiers" edge IDs in the mutation.
+func (m *UserMutation) NotifiersIDs() (ids []uuid.UUID) {
+	for id := range m.notifiers {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldName returns the old "name" field's value of the MaintenanceEntry entity.
-// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MaintenanceEntryMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+// ResetNotifiers resets all changes to the "notifiers" edge.
+func (m *UserMutation) ResetNotifiers() {
+	m.notifiers = nil
+	m.clearednotifiers = false
+	m.removednotifiers = nil
+}
+
+// AddUserTokenIDs adds the "user_tokens" edge to the UserToken entity by ids.
+func (m *UserMutation) AddUserTokenIDs(ids ...uuid.UUID) {
+	if m.user_tokens == nil {
+		m.user_tokens = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	for i := range ids {
+		m.user_tokens[ids[i]] = struct{}{}
 	}
-	return oldValue.Name, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *MaintenanceEntryMutation) ResetName() {
-	m.name = nil
+// ClearUserTokens clears the "user_tokens" edge to the UserToken entity.
+func (m *UserMutation) ClearUserTokens() {
+	m.cleareduser_tokens = true
 }
 
-// SetDescription sets the "description" field.
-func (m *MaintenanceEntryMutation) SetDescription(s string) {
-	m.description = &s
+// UserTokensCleared reports if the "user_tokens" edge to the UserToken entity was cleared.
+func (m *UserMutation) UserTokensCleared() bool {
+	return m.cleareduser_tokens
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *MaintenanceEntryMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
+// RemoveUserTokenIDs removes the "user_tokens" edge to the UserToken entity by IDs.
+func (m *UserMutation) RemoveUserTokenIDs(ids ...uuid.UUID) {
+	if m.removeduser_tokens == nil {
+		m.removeduser_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.user_tokens, ids[i])
+		m.removeduser_tokens[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the MaintenanceEntry entity.
-// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MaintenanceEntryMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+// RemovedUserTokens returns the removed IDs of the "user_tokens" edge to the UserToken entity.
+func (m *UserMutation) RemovedUserTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removeduser_tokens {
+		ids = append(ids, id)
 	}
-	return oldValue.Description, nil
+	return
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *MaintenanceEntryMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[maintenanceentry.FieldDescription] = struct{}{}
+// UserTokensIDs returns the "user_tokens" edge IDs in the mutation.
+func (m *UserMutation) UserTokensIDs() (ids []uuid.UUID) {
+	for id := range m.user_tokens {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *MaintenanceEntryMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[maintenanceentry.FieldDescription]
-	return ok
+// ResetUserTokens resets all changes to the "user_tokens" edge.
+func (m *UserMutation) ResetUserTokens() {
+	m.user_tokens = nil
+	m.cleareduser_tokens = false
+	m.removeduser_tokens = nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *MaintenanceEntryMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, maintenanceentry.FieldDescription)
+// AddWebauthnCredentialIDs adds the "webauthn_credentials" edge to the WebAuthnCredential entity by ids.
+func (m *UserMutation) AddWebauthnCredentialIDs(ids ...uuid.UUID) {
+	if m.webauthn_credentials == nil {
+		m.webauthn_credentials = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.webauthn_credentials[ids[i]] = struct{}{}
+	}
 }
 
-// SetCost sets the "cost" field.
-func (m *MaintenanceEntryMutation) SetCost(f float64) {
-	m.cost = &f
-	m.addcost = nil
+// ClearWebauthnCredentials clears the "webauthn_credentials" edge to the WebAuthnCredential entity.
+func (m *UserMutation) ClearWebauthnCredentials() {
+	m.clearedwebauthn_credentials = true
 }
 
-// Cost returns the value of the "cost" field in the mutation.
-func (m *MaintenanceEntryMutation) Cost() (r float64, exists bool) {
-	v := m.cost
-	if v == nil {
-		return
-	}
-	return *v, true
+// WebauthnCredentialsCleared reports if the "webauthn_credentials" edge to the WebAuthnCredential entity was cleared.
+func (m *UserMutation) WebauthnCredentialsCleared() bool {
+	return m.clearedwebauthn_credentials
 }
 
-// OldCost returns the old "cost" field's value of the MaintenanceEntry entity.
-// If the MaintenanceEntry object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MaintenanceEntryMutation) OldCost(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCost is only allowed on UpdateOne operations")
+// RemoveWebauthnCredentialIDs removes the "webauthn_credentials" edge to the WebAuthnCredential entity by IDs.
+func (m *UserMutation) RemoveWebauthnCredentialIDs(ids ...uuid.UUID) {
+	if m.removedwebauthn_credentials == nil {
+		m.removedwebauthn_credentials = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCost requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.webauthn_credentials, ids[i])
+		m.removedwebauthn_credentials[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCost: %w", err)
+}
+
+// RemovedWebauthnCredentials returns the removed IDs of the "webauthn_credentials" edge to the WebAuthnCredential entity.
+func (m *UserMutation) RemovedWebauthnCredentialsIDs() (ids []uuid.UUID) {
+	for id := range m.removedwebauthn_credentials {
+		ids = append(ids, id)
 	}
-	return oldValue.Cost, nil
+	return
 }
 
-// AddCost adds f to the "cost" field.
-func (m *MaintenanceEntryMutation) AddCost(f float64) {
-	if m.addcost != nil {
-		*m.addcost += f
-	} else {
-		m.addcost = &f
+// WebauthnCredentialsIDs returns the "webauthn_credentials" edge IDs in the mutation.
+func (m *UserMutation) WebauthnCredentialsIDs() (ids []uuid.UUID) {
+	for id := range m.webauthn_credentials {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedCost returns the value that was added to the "cost" field in this mutation.
-func (m *MaintenanceEntryMutation) AddedCost() (r float64, exists bool) {
-	v := m.addcost
-	if v == nil {
-		return
+// ResetWebauthnCredentials resets all changes to the "webauthn_credentials" edge.
+func (m *UserMutation) ResetWebauthnCredentials() {
+	m.webauthn_credentials = nil
+	m.clearedwebauthn_credentials = false
+	m.removedwebauthn_credentials = nil
+}
+
+// AddAuditEntryIDs adds the "audit_entries" edge to the AuditEntry entity by ids.
+func (m *UserMutation) AddAuditEntryIDs(ids ...uuid.UUID) {
+	if m.audit_entries == nil {
+		m.audit_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.audit_entries[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetCost resets all changes to the "cost" field.
-func (m *MaintenanceEntryMutation) ResetCost() {
-	m.cost = nil
-	m.addcost = nil
+// ClearAuditEntries clears the "audit_entries" edge to the AuditEntry entity.
+func (m *UserMutation) ClearAuditEntries() {
+	m.clearedaudit_entries = true
 }
 
-// ClearItem clears the "item" edge to the Item entity.
-func (m *MaintenanceEntryMutation) ClearItem() {
-	m.cleareditem = true
-	m.clearedFields[maintenanceentry.FieldItemID] = struct{}{}
+// AuditEntriesCleared reports if the "audit_entries" edge to the AuditEntry entity was cleared.
+func (m *UserMutation) AuditEntriesCleared() bool {
+	return m.clearedaudit_entries
 }
 
-// ItemCleared reports if the "item" edge to the Item entity was cleared.
-func (m *MaintenanceEntryMutation) ItemCleared() bool {
-	return m.cleareditem
+// RemoveAuditEntryIDs removes the "audit_entries" edge to the AuditEntry entity by IDs.
+func (m *UserMutation) RemoveAuditEntryIDs(ids ...uuid.UUID) {
+	if m.removedaudit_entries == nil {
+		m.removedaudit_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.audit_entries, ids[i])
+		m.removedaudit_entries[ids[i]] = struct{}{}
+	}
 }
 
-// ItemIDs returns the "item" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ItemID instead. It exists only for internal usage by the builders.
-func (m *MaintenanceEntryMutation) ItemIDs() (ids []uuid.UUID) {
-	if id := m.item; id != nil {
-		ids = append(ids, *id)
+// RemovedAuditEntries returns the removed IDs of the "audit_entries" edge to the AuditEntry entity.
+func (m *UserMutation) RemovedAuditEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedaudit_entries {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetItem resets all changes to the "item" edge.
-func (m *MaintenanceEntryMutation) ResetItem() {
-	m.item = nil
-	m.cleareditem = false
+// AuditEntriesIDs returns the "audit_entries" edge IDs in the mutation.
+func (m *UserMutation) AuditEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.audit_entries {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the MaintenanceEntryMutation builder.
-func (m *MaintenanceEntryMutation) Where(ps ...predicate.MaintenanceEntry) {
+// ResetAuditEntries resets all changes to the "audit_entries" edge.
+func (m *UserMutation) ResetAuditEntries() {
+	m.audit_entries = nil
+	m.clearedaudit_entries = false
+	m.removedaudit_entries = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MaintenanceEntryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MaintenanceEntryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MaintenanceEntry, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9569,48 +20323,63 @@ func (m *MaintenanceEntryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MaintenanceEntryMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MaintenanceEntryMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MaintenanceEntry).
-func (m *MaintenanceEntryMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MaintenanceEntryMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 13)
 	if m.created_at != nil {
-		fields = append(fields, maintenanceentry.FieldCreatedAt)
+		fields = append(fields, user.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, maintenanceentry.FieldUpdatedAt)
+		fields = append(fields, user.FieldUpdatedAt)
 	}
-	if m.item != nil {
-		fields = append(fields, maintenanceentry.FieldItemID)
+	if m.name != nil {
+		fields = append(fields, user.FieldName)
 	}
-	if m.date != nil {
-		fields = append(fields, maintenanceentry.FieldDate)
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
 	}
-	if m.scheduled_date != nil {
-		fields = append(fields, maintenanceentry.FieldScheduledDate)
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
 	}
-	if m.name != nil {
-		fields = append(fields, maintenanceentry.FieldName)
+	if m.is_superuser != nil {
+		fields = append(fields, user.FieldIsSuperuser)
 	}
-	if m.description != nil {
-		fields = append(fields, maintenanceentry.FieldDescription)
+	if m.superuser != nil {
+		fields = append(fields, user.FieldSuperuser)
 	}
-	if m.cost != nil {
-		fields = append(fields, maintenanceentry.FieldCost)
+	if m.activated_on != nil {
+		fields = append(fields, user.FieldActivatedOn)
+	}
+	if m.disabled_at != nil {
+		fields = append(fields, user.FieldDisabledAt)
+	}
+	if m.totp_secret != nil {
+		fields = append(fields, user.FieldTotpSecret)
+	}
+	if m.totp_enabled != nil {
+		fields = append(fields, user.FieldTotpEnabled)
+	}
+	if m.totp_last_step != nil {
+		fields = append(fields, user.FieldTotpLastStep)
+	}
+	if m.totp_recovery_codes != nil {
+		fields = append(fields, user.FieldTotpRecoveryCodes)
 	}
 	return fields
 }
@@ -9618,24 +20387,34 @@ func (m *MaintenanceEntryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MaintenanceEntryMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case maintenanceentry.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		return m.CreatedAt()
-	case maintenanceentry.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case maintenanceentry.FieldItemID:
-		return m.ItemID()
-	case maintenanceentry.FieldDate:
-		return m.Date()
-	case maintenanceentry.FieldScheduledDate:
-		return m.ScheduledDate()
-	case maintenanceentry.FieldName:
+	case user.FieldName:
 		return m.Name()
-	case maintenanceentry.FieldDescription:
-		return m.Description()
-	case maintenanceentry.FieldCost:
-		return m.Cost()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldIsSuperuser:
+		return m.IsSuperuser()
+	case user.FieldSuperuser:
+		return m.Superuser()
+	case user.FieldActivatedOn:
+		return m.ActivatedOn()
+	case user.FieldDisabledAt:
+		return m.DisabledAt()
+	case user.FieldTotpSecret:
+		return m.TotpSecret()
+	case user.FieldTotpEnabled:
+		return m.TotpEnabled()
+	case user.FieldTotpLastStep:
+		return m.TotpLastStep()
+	case user.FieldTotpRecoveryCodes:
+		return m.TotpRecoveryCodes()
 	}
 	return nil, false
 }
@@ -9643,99 +20422,144 @@ func (m *MaintenanceEntryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MaintenanceEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case maintenanceentry.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case maintenanceentry.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case maintenanceentry.FieldItemID:
-		return m.OldItemID(ctx)
-	case maintenanceentry.FieldDate:
-		return m.OldDate(ctx)
-	case maintenanceentry.FieldScheduledDate:
-		return m.OldScheduledDate(ctx)
-	case maintenanceentry.FieldName:
+	case user.FieldName:
 		return m.OldName(ctx)
-	case maintenanceentry.FieldDescription:
-		return m.OldDescription(ctx)
-	case maintenanceentry.FieldCost:
-		return m.OldCost(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldIsSuperuser:
+		return m.OldIsSuperuser(ctx)
+	case user.FieldSuperuser:
+		return m.OldSuperuser(ctx)
+	case user.FieldActivatedOn:
+		return m.OldActivatedOn(ctx)
+	case user.FieldDisabledAt:
+		return m.OldDisabledAt(ctx)
+	case user.FieldTotpSecret:
+		return m.OldTotpSecret(ctx)
+	case user.FieldTotpEnabled:
+		return m.OldTotpEnabled(ctx)
+	case user.FieldTotpLastStep:
+		return m.OldTotpLastStep(ctx)
+	case user.FieldTotpRecoveryCodes:
+		return m.OldTotpRecoveryCodes(ctx)
 	}
-	return nil, fmt.Errorf("unknown MaintenanceEntry field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MaintenanceEntryMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case maintenanceentry.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case maintenanceentry.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case maintenanceentry.FieldItemID:
-		v, ok := value.(uuid.UUID)
+	case user.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetItemID(v)
+		m.SetName(v)
 		return nil
-	case maintenanceentry.FieldDate:
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case user.FieldIsSuperuser:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsSuperuser(v)
+		return nil
+	case user.FieldSuperuser:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuperuser(v)
+		return nil
+	case user.FieldActivatedOn:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDate(v)
+		m.SetActivatedOn(v)
 		return nil
-	case maintenanceentry.FieldScheduledDate:
+	case user.FieldDisabledAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScheduledDate(v)
+		m.SetDisabledAt(v)
 		return nil
-	case maintenanceentry.FieldName:
+	case user.FieldTotpSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetTotpSecret(v)
 		return nil
-	case maintenanceentry.FieldDescription:
-		v, ok := value.(string)
+	case user.FieldTotpEnabled:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetTotpEnabled(v)
 		return nil
-	case maintenanceentry.FieldCost:
-		v, ok := value.(float64)
+	case user.FieldTotpLastStep:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCost(v)
+		m.SetTotpLastStep(v)
+		return nil
+	case user.FieldTotpRecoveryCodes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpRecoveryCodes(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MaintenanceEntry field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MaintenanceEntryMutation) AddedFields() []string {
+func (m *UserMutation) AddedFields() []string {
 	var fields []string
-	if m.addcost != nil {
-		fields = append(fields, maintenanceentry.FieldCost)
+	if m.addtotp_last_step != nil {
+		fields = append(fields, user.FieldTotpLastStep)
 	}
 	return fields
 }
@@ -9743,10 +20567,10 @@ func (m *MaintenanceEntryMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MaintenanceEntryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case maintenanceentry.FieldCost:
-		return m.AddedCost()
+	case user.FieldTotpLastStep:
+		return m.AddedTotpLastStep()
 	}
 	return nil, false
 }
@@ -9754,197 +20578,376 @@ func (m *MaintenanceEntryMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MaintenanceEntryMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case maintenanceentry.FieldCost:
-		v, ok := value.(float64)
+	case user.FieldTotpLastStep:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCost(v)
+		m.AddTotpLastStep(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MaintenanceEntry numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MaintenanceEntryMutation) ClearedFields() []string {
+func (m *UserMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(maintenanceentry.FieldDate) {
-		fields = append(fields, maintenanceentry.FieldDate)
+	if m.FieldCleared(user.FieldActivatedOn) {
+		fields = append(fields, user.FieldActivatedOn)
 	}
-	if m.FieldCleared(maintenanceentry.FieldScheduledDate) {
-		fields = append(fields, maintenanceentry.FieldScheduledDate)
+	if m.FieldCleared(user.FieldDisabledAt) {
+		fields = append(fields, user.FieldDisabledAt)
 	}
-	if m.FieldCleared(maintenanceentry.FieldDescription) {
-		fields = append(fields, maintenanceentry.FieldDescription)
+	if m.FieldCleared(user.FieldTotpSecret) {
+		fields = append(fields, user.FieldTotpSecret)
+	}
+	if m.FieldCleared(user.FieldTotpRecoveryCodes) {
+		fields = append(fields, user.FieldTotpRecoveryCodes)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MaintenanceEntryMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MaintenanceEntryMutation) ClearField(name string) error {
+func (m *UserMutation) ClearField(name string) error {
 	switch name {
-	case maintenanceentry.FieldDate:
-		m.ClearDate()
+	case user.FieldActivatedOn:
+		m.ClearActivatedOn()
 		return nil
-	case maintenanceentry.FieldScheduledDate:
-		m.ClearScheduledDate()
+	case user.FieldDisabledAt:
+		m.ClearDisabledAt()
 		return nil
-	case maintenanceentry.FieldDescription:
-		m.ClearDescription()
+	case user.FieldTotpSecret:
+		m.ClearTotpSecret()
+		return nil
+	case user.FieldTotpRecoveryCodes:
+		m.ClearTotpRecoveryCodes()
 		return nil
 	}
-	return fmt.Errorf("unknown MaintenanceEntry nullable field %s", name)
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MaintenanceEntryMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case maintenanceentry.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case maintenanceentry.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case maintenanceentry.FieldItemID:
-		m.ResetItemID()
+	case user.FieldName:
+		m.ResetName()
 		return nil
-	case maintenanceentry.FieldDate:
-		m.ResetDate()
+	case user.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case maintenanceentry.FieldScheduledDate:
-		m.ResetScheduledDate()
+	case user.FieldPassword:
+		m.ResetPassword()
 		return nil
-	case maintenanceentry.FieldName:
-		m.ResetName()
+	case user.FieldIsSuperuser:
+		m.ResetIsSuperuser()
 		return nil
-	case maintenanceentry.FieldDescription:
-		m.ResetDescription()
+	case user.FieldSuperuser:
+		m.ResetSuperuser()
 		return nil
-	case maintenanceentry.FieldCost:
-		m.ResetCost()
+	case user.FieldActivatedOn:
+		m.ResetActivatedOn()
+		return nil
+	case user.FieldDisabledAt:
+		m.ResetDisabledAt()
+		return nil
+	case user.FieldTotpSecret:
+		m.ResetTotpSecret()
+		return nil
+	case user.FieldTotpEnabled:
+		m.ResetTotpEnabled()
+		return nil
+	case user.FieldTotpLastStep:
+		m.ResetTotpLastStep()
+		return nil
+	case user.FieldTotpRecoveryCodes:
+		m.ResetTotpRecoveryCodes()
 		return nil
 	}
-	return fmt.Errorf("unknown MaintenanceEntry field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MaintenanceEntryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.item != nil {
-		edges = append(edges, maintenanceentry.EdgeItem)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.group != nil {
+		edges = append(edges, user.EdgeGroup)
+	}
+	if m.memberships != nil {
+		edges = append(edges, user.EdgeMemberships)
+	}
+	if m.auth_tokens != nil {
+		edges = append(edges, user.EdgeAuthTokens)
+	}
+	if m.notifiers != nil {
+		edges = append(edges, user.EdgeNotifiers)
+	}
+	if m.user_tokens != nil {
+		edges = append(edges, user.EdgeUserTokens)
+	}
+	if m.webauthn_credentials != nil {
+		edges = append(edges, user.EdgeWebauthnCredentials)
+	}
+	if m.audit_entries != nil {
+		edges = append(edges, user.EdgeAuditEntries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MaintenanceEntryMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case maintenanceentry.EdgeItem:
-		if id := m.item; id != nil {
+	case user.EdgeGroup:
+		if id := m.group; id != nil {
 			return []ent.Value{*id}
 		}
+	case user.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.memberships))
+		for id := range m.memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAuthTokens:
+		ids := make([]ent.Value, 0, len(m.auth_tokens))
+		for id := range m.auth_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotifiers:
+		ids := make([]ent.Value, 0, len(m.notifiers))
+		for id := range m.notifiers {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserTokens:
+		ids := make([]ent.Value, 0, len(m.user_tokens))
+		for id := range m.user_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWebauthnCredentials:
+		ids := make([]ent.Value, 0, len(m.webauthn_credentials))
+		for id := range m.webauthn_credentials {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAuditEntries:
+		ids := make([]ent.Value, 0, len(m.audit_entries))
+		for id := range m.audit_entries {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MaintenanceEntryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedmemberships != nil {
+		edges = append(edges, user.EdgeMemberships)
+	}
+	if m.removedauth_tokens != nil {
+		edges = append(edges, user.EdgeAuthTokens)
+	}
+	if m.removednotifiers != nil {
+		edges = append(edges, user.EdgeNotifiers)
+	}
+	if m.removeduser_tokens != nil {
+		edges = append(edges, user.EdgeUserTokens)
+	}
+	if m.removedwebauthn_credentials != nil {
+		edges = append(edges, user.EdgeWebauthnCredentials)
+	}
+	if m.removedaudit_entries != nil {
+		edges = append(edges, user.EdgeAuditEntries)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MaintenanceEntryMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.removedmemberships))
+		for id := range m.removedmemberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAuthTokens:
+		ids := make([]ent.Value, 0, len(m.removedauth_tokens))
+		for id := range m.removedauth_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotifiers:
+		ids := make([]ent.Value, 0, len(m.removednotifiers))
+		for id := range m.removednotifiers {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserTokens:
+		ids := make([]ent.Value, 0, len(m.removeduser_tokens))
+		for id := range m.removeduser_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWebauthnCredentials:
+		ids := make([]ent.Value, 0, len(m.removedwebauthn_credentials))
+		for id := range m.removedwebauthn_credentials {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAuditEntries:
+		ids := make([]ent.Value, 0, len(m.removedaudit_entries))
+		for id := range m.removedaudit_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MaintenanceEntryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareditem {
-		edges = append(edges, maintenanceentry.EdgeItem)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedgroup {
+		edges = append(edges, user.EdgeGroup)
+	}
+	if m.clearedmemberships {
+		edges = append(edges, user.EdgeMemberships)
+	}
+	if m.clearedauth_tokens {
+		edges = append(edges, user.EdgeAuthTokens)
+	}
+	if m.clearednotifiers {
+		edges = append(edges, user.EdgeNotifiers)
+	}
+	if m.cleareduser_tokens {
+		edges = append(edges, user.EdgeUserTokens)
+	}
+	if m.clearedwebauthn_credentials {
+		edges = append(edges, user.EdgeWebauthnCredentials)
+	}
+	if m.clearedaudit_entries {
+		edges = append(edges, user.EdgeAuditEntries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MaintenanceEntryMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case maintenanceentry.EdgeItem:
-		return m.cleareditem
+	case user.EdgeGroup:
+		return m.clearedgroup
+	case user.EdgeMemberships:
+		return m.clearedmemberships
+	case user.EdgeAuthTokens:
+		return m.clearedauth_tokens
+	case user.EdgeNotifiers:
+		return m.clearednotifiers
+	case user.EdgeUserTokens:
+		return m.cleareduser_tokens
+	case user.EdgeWebauthnCredentials:
+		return m.clearedwebauthn_credentials
+	case user.EdgeAuditEntries:
+		return m.clearedaudit_entries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MaintenanceEntryMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
-	case maintenanceentry.EdgeItem:
-		m.ClearItem()
+	case user.EdgeGroup:
+		m.ClearGroup()
 		return nil
 	}
-	return fmt.Errorf("unknown MaintenanceEntry unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MaintenanceEntryMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case maintenanceentry.EdgeItem:
-		m.ResetItem()
+	case user.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case user.EdgeMemberships:
+		m.ResetMemberships()
+		return nil
+	case user.EdgeAuthTokens:
+		m.ResetAuthTokens()
+		return nil
+	case user.EdgeNotifiers:
+		m.ResetNotifiers()
+		return nil
+	case user.EdgeUserTokens:
+		m.ResetUserTokens()
+		return nil
+	case user.EdgeWebauthnCredentials:
+		m.ResetWebauthnCredentials()
+		return nil
+	case user.EdgeAuditEntries:
+		m.ResetAuditEntries()
 		return nil
 	}
-	return fmt.Errorf("unknown MaintenanceEntry edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// NotifierMutation represents an operation that mutates the Notifier nodes in the graph.
-type NotifierMutation struct {
+// UserTokenMutation represents an operation that mutates the UserToken nodes in the graph.
+type UserTokenMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
 	created_at    *time.Time
 	updated_at    *time.Time
-	name          *string
-	url           *string
-	is_active     *bool
+	purpose       *usertoken.Purpose
+	token         *[]byte
+	expires_at    *time.Time
+	attempts      *int
+	addattempts   *int
 	clearedFields map[string]struct{}
-	group         *uuid.UUID
-	clearedgroup  bool
 	user          *uuid.UUID
 	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*Notifier, error)
-	predicates    []predicate.Notifier
+	oldValue      func(context.Context) (*UserToken, error)
+	predicates    []predicate.UserToken
 }
 
-var _ ent.Mutation = (*NotifierMutation)(nil)
+var _ ent.Mutation = (*UserTokenMutation)(nil)
 
-// notifierOption allows management of the mutation configuration using functional options.
-type notifierOption func(*NotifierMutation)
+// usertokenOption allows management of the mutation configuration using functional options.
+type usertokenOption func(*UserTokenMutation)
 
-// newNotifierMutation creates new mutation for the Notifier entity.
-func newNotifierMutation(c config, op Op, opts ...notifierOption) *NotifierMutation {
-	m := &NotifierMutation{
+// newUserTokenMutation creates new mutation for the UserToken entity.
+func newUserTokenMutation(c config, op Op, opts ...usertokenOption) *UserTokenMutation {
+	m := &UserTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNotifier,
+		typ:           TypeUserToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9953,20 +20956,20 @@ func newNotifierMutation(c config, op Op, opts ...notifierOption) *NotifierMutat
 	return m
 }
 
-// withNotifierID sets the ID field of the mutation.
-func withNotifierID(id uuid.UUID) notifierOption {
-	return func(m *NotifierMutation) {
+// withUserTokenID sets the ID field of the mutation.
+func withUserTokenID(id uuid.UUID) usertokenOption {
+	return func(m *UserTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Notifier
+			value *UserToken
 		)
-		m.oldValue = func(ctx context.Context) (*Notifier, error) {
+		m.oldValue = func(ctx context.Context) (*UserToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Notifier.Get(ctx, id)
+					value, err = m.Client().UserToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -9975,10 +20978,10 @@ func withNotifierID(id uuid.UUID) notifierOption {
 	}
 }
 
-// withNotifier sets the old Notifier of the mutation.
-func withNotifier(node *Notifier) notifierOption {
-	return func(m *NotifierMutation) {
-		m.oldValue = func(context.Context) (*Notifier, error) {
+// withUserToken sets the old UserToken of the mutation.
+func withUserToken(node *UserToken) usertokenOption {
+	return func(m *UserTokenMutation) {
+		m.oldValue = func(context.Context) (*UserToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9987,7 +20990,7 @@ func withNotifier(node *Notifier) notifierOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NotifierMutation) Client() *Client {
+func (m UserTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9995,7 +20998,7 @@ func (m NotifierMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NotifierMutation) Tx() (*Tx, error) {
+func (m UserTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -10005,14 +21008,14 @@ func (m NotifierMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Notifier entities.
-func (m *NotifierMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of UserToken entities.
+func (m *UserTokenMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NotifierMutation) ID() (id uuid.UUID, exists bool) {
+func (m *UserTokenMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -10023,7 +21026,7 @@ func (m *NotifierMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NotifierMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *UserTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -10032,19 +21035,19 @@ func (m *NotifierMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Notifier.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UserToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *NotifierMutation) SetCreatedAt(t time.Time) {
+func (m *UserTokenMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NotifierMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UserTokenMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -10052,10 +21055,10 @@ func (m *NotifierMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Notifier entity.
-// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the UserToken entity.
+// If the UserToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotifierMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -10070,17 +21073,17 @@ func (m *NotifierMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NotifierMutation) ResetCreatedAt() {
+func (m *UserTokenMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *NotifierMutation) SetUpdatedAt(t time.Time) {
+func (m *UserTokenMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *NotifierMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *UserTokenMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -10088,10 +21091,10 @@ func (m *NotifierMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Notifier entity.
-// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the UserToken entity.
+// If the UserToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotifierMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -10106,232 +21109,225 @@ func (m *NotifierMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *NotifierMutation) ResetUpdatedAt() {
+func (m *UserTokenMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetGroupID sets the "group_id" field.
-func (m *NotifierMutation) SetGroupID(u uuid.UUID) {
-	m.group = &u
+// SetUserID sets the "user_id" field.
+func (m *UserTokenMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
 }
 
-// GroupID returns the value of the "group_id" field in the mutation.
-func (m *NotifierMutation) GroupID() (r uuid.UUID, exists bool) {
-	v := m.group
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserTokenMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGroupID returns the old "group_id" field's value of the Notifier entity.
-// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the UserToken entity.
+// If the UserToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotifierMutation) OldGroupID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *UserTokenMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGroupID requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.GroupID, nil
+	return oldValue.UserID, nil
 }
 
-// ResetGroupID resets all changes to the "group_id" field.
-func (m *NotifierMutation) ResetGroupID() {
-	m.group = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserTokenMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *NotifierMutation) SetUserID(u uuid.UUID) {
-	m.user = &u
+// SetPurpose sets the "purpose" field.
+func (m *UserTokenMutation) SetPurpose(u usertoken.Purpose) {
+	m.purpose = &u
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *NotifierMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user
+// Purpose returns the value of the "purpose" field in the mutation.
+func (m *UserTokenMutation) Purpose() (r usertoken.Purpose, exists bool) {
+	v := m.purpose
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Notifier entity.
-// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// OldPurpose returns the old "purpose" field's value of the UserToken entity.
+// If the UserToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotifierMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *UserTokenMutation) OldPurpose(ctx context.Context) (v usertoken.Purpose, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPurpose is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldPurpose requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPurpose: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.Purpose, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *NotifierMutation) ResetUserID() {
-	m.user = nil
+// ResetPurpose resets all changes to the "purpose" field.
+func (m *UserTokenMutation) ResetPurpose() {
+	m.purpose = nil
 }
 
-// SetName sets the "name" field.
-func (m *NotifierMutation) SetName(s string) {
-	m.name = &s
+// SetToken sets the "token" field.
+func (m *UserTokenMutation) SetToken(b []byte) {
+	m.token = &b
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *NotifierMutation) Name() (r string, exists bool) {
-	v := m.name
+// Token returns the value of the "token" field in the mutation.
+func (m *UserTokenMutation) Token() (r []byte, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Notifier entity.
-// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the UserToken entity.
+// If the UserToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotifierMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *UserTokenMutation) OldToken(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Token, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *NotifierMutation) ResetName() {
-	m.name = nil
+// ResetToken resets all changes to the "token" field.
+func (m *UserTokenMutation) ResetToken() {
+	m.token = nil
 }
 
-// SetURL sets the "url" field.
-func (m *NotifierMutation) SetURL(s string) {
-	m.url = &s
+// SetExpiresAt sets the "expires_at" field.
+func (m *UserTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *NotifierMutation) URL() (r string, exists bool) {
-	v := m.url
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *UserTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the Notifier entity.
-// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the UserToken entity.
+// If the UserToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotifierMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *UserTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *NotifierMutation) ResetURL() {
-	m.url = nil
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *UserTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// SetIsActive sets the "is_active" field.
-func (m *NotifierMutation) SetIsActive(b bool) {
-	m.is_active = &b
+// SetAttempts sets the "attempts" field.
+func (m *UserTokenMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// IsActive returns the value of the "is_active" field in the mutation.
-func (m *NotifierMutation) IsActive() (r bool, exists bool) {
-	v := m.is_active
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *UserTokenMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsActive returns the old "is_active" field's value of the Notifier entity.
-// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the UserToken entity.
+// If the UserToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotifierMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+func (m *UserTokenMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsActive requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.IsActive, nil
-}
-
-// ResetIsActive resets all changes to the "is_active" field.
-func (m *NotifierMutation) ResetIsActive() {
-	m.is_active = nil
-}
-
-// ClearGroup clears the "group" edge to the Group entity.
-func (m *NotifierMutation) ClearGroup() {
-	m.clearedgroup = true
-	m.clearedFields[notifier.FieldGroupID] = struct{}{}
-}
-
-// GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *NotifierMutation) GroupCleared() bool {
-	return m.clearedgroup
+	return oldValue.Attempts, nil
 }
 
-// GroupIDs returns the "group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *NotifierMutation) GroupIDs() (ids []uuid.UUID) {
-	if id := m.group; id != nil {
-		ids = append(ids, *id)
+// AddAttempts adds i to the "attempts" field.
+func (m *UserTokenMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
 	}
-	return
 }
 
-// ResetGroup resets all changes to the "group" edge.
-func (m *NotifierMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *UserTokenMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *UserTokenMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *NotifierMutation) ClearUser() {
+func (m *UserTokenMutation) ClearUser() {
 	m.cleareduser = true
-	m.clearedFields[notifier.FieldUserID] = struct{}{}
+	m.clearedFields[usertoken.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *NotifierMutation) UserCleared() bool {
+func (m *UserTokenMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *NotifierMutation) UserIDs() (ids []uuid.UUID) {
+func (m *UserTokenMutation) UserIDs() (ids []uuid.UUID) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -10339,20 +21335,20 @@ func (m *NotifierMutation) UserIDs() (ids []uuid.UUID) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *NotifierMutation) ResetUser() {
+func (m *UserTokenMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the NotifierMutation builder.
-func (m *NotifierMutation) Where(ps ...predicate.Notifier) {
+// Where appends a list predicates to the UserTokenMutation builder.
+func (m *UserTokenMutation) Where(ps ...predicate.UserToken) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NotifierMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserTokenMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NotifierMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Notifier, len(ps))
+func (m *UserTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserToken, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -10360,45 +21356,45 @@ func (m *NotifierMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NotifierMutation) Op() Op {
+func (m *UserTokenMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NotifierMutation) SetOp(op Op) {
+func (m *UserTokenMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Notifier).
-func (m *NotifierMutation) Type() string {
+// Type returns the node type of this mutation (UserToken).
+func (m *UserTokenMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NotifierMutation) Fields() []string {
+func (m *UserTokenMutation) Fields() []string {
 	fields := make([]string, 0, 7)
 	if m.created_at != nil {
-		fields = append(fields, notifier.FieldCreatedAt)
+		fields = append(fields, usertoken.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, notifier.FieldUpdatedAt)
-	}
-	if m.group != nil {
-		fields = append(fields, notifier.FieldGroupID)
+		fields = append(fields, usertoken.FieldUpdatedAt)
 	}
 	if m.user != nil {
-		fields = append(fields, notifier.FieldUserID)
+		fields = append(fields, usertoken.FieldUserID)
 	}
-	if m.name != nil {
-		fields = append(fields, notifier.FieldName)
+	if m.purpose != nil {
+		fields = append(fields, usertoken.FieldPurpose)
 	}
-	if m.url != nil {
-		fields = append(fields, notifier.FieldURL)
+	if m.token != nil {
+		fields = append(fields, usertoken.FieldToken)
 	}
-	if m.is_active != nil {
-		fields = append(fields, notifier.FieldIsActive)
+	if m.expires_at != nil {
+		fields = append(fields, usertoken.FieldExpiresAt)
+	}
+	if m.attempts != nil {
+		fields = append(fields, usertoken.FieldAttempts)
 	}
 	return fields
 }
@@ -10406,22 +21402,22 @@ func (m *NotifierMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NotifierMutation) Field(name string) (ent.Value, bool) {
+func (m *UserTokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case notifier.FieldCreatedAt:
+	case usertoken.FieldCreatedAt:
 		return m.CreatedAt()
-	case notifier.FieldUpdatedAt:
+	case usertoken.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case notifier.FieldGroupID:
-		return m.GroupID()
-	case notifier.FieldUserID:
+	case usertoken.FieldUserID:
 		return m.UserID()
-	case notifier.FieldName:
-		return m.Name()
-	case notifier.FieldURL:
-		return m.URL()
-	case notifier.FieldIsActive:
-		return m.IsActive()
+	case usertoken.FieldPurpose:
+		return m.Purpose()
+	case usertoken.FieldToken:
+		return m.Token()
+	case usertoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case usertoken.FieldAttempts:
+		return m.Attempts()
 	}
 	return nil, false
 }
@@ -10429,175 +21425,183 @@ func (m *NotifierMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NotifierMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case notifier.FieldCreatedAt:
+	case usertoken.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case notifier.FieldUpdatedAt:
+	case usertoken.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case notifier.FieldGroupID:
-		return m.OldGroupID(ctx)
-	case notifier.FieldUserID:
+	case usertoken.FieldUserID:
 		return m.OldUserID(ctx)
-	case notifier.FieldName:
-		return m.OldName(ctx)
-	case notifier.FieldURL:
-		return m.OldURL(ctx)
-	case notifier.FieldIsActive:
-		return m.OldIsActive(ctx)
+	case usertoken.FieldPurpose:
+		return m.OldPurpose(ctx)
+	case usertoken.FieldToken:
+		return m.OldToken(ctx)
+	case usertoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case usertoken.FieldAttempts:
+		return m.OldAttempts(ctx)
 	}
-	return nil, fmt.Errorf("unknown Notifier field %s", name)
+	return nil, fmt.Errorf("unknown UserToken field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NotifierMutation) SetField(name string, value ent.Value) error {
+func (m *UserTokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case notifier.FieldCreatedAt:
+	case usertoken.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case notifier.FieldUpdatedAt:
+	case usertoken.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case notifier.FieldGroupID:
+	case usertoken.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGroupID(v)
+		m.SetUserID(v)
 		return nil
-	case notifier.FieldUserID:
-		v, ok := value.(uuid.UUID)
+	case usertoken.FieldPurpose:
+		v, ok := value.(usertoken.Purpose)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetPurpose(v)
 		return nil
-	case notifier.FieldName:
-		v, ok := value.(string)
+	case usertoken.FieldToken:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetToken(v)
 		return nil
-	case notifier.FieldURL:
-		v, ok := value.(string)
+	case usertoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetURL(v)
+		m.SetExpiresAt(v)
 		return nil
-	case notifier.FieldIsActive:
-		v, ok := value.(bool)
+	case usertoken.FieldAttempts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsActive(v)
+		m.SetAttempts(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Notifier field %s", name)
+	return fmt.Errorf("unknown UserToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NotifierMutation) AddedFields() []string {
-	return nil
+func (m *UserTokenMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, usertoken.FieldAttempts)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NotifierMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case usertoken.FieldAttempts:
+		return m.AddedAttempts()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NotifierMutation) AddField(name string, value ent.Value) error {
+func (m *UserTokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case usertoken.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Notifier numeric field %s", name)
+	return fmt.Errorf("unknown UserToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NotifierMutation) ClearedFields() []string {
+func (m *UserTokenMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NotifierMutation) FieldCleared(name string) bool {
+func (m *UserTokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NotifierMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Notifier nullable field %s", name)
+func (m *UserTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NotifierMutation) ResetField(name string) error {
+func (m *UserTokenMutation) ResetField(name string) error {
 	switch name {
-	case notifier.FieldCreatedAt:
+	case usertoken.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case notifier.FieldUpdatedAt:
+	case usertoken.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case notifier.FieldGroupID:
-		m.ResetGroupID()
-		return nil
-	case notifier.FieldUserID:
+	case usertoken.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case notifier.FieldName:
-		m.ResetName()
+	case usertoken.FieldPurpose:
+		m.ResetPurpose()
 		return nil
-	case notifier.FieldURL:
-		m.ResetURL()
+	case usertoken.FieldToken:
+		m.ResetToken()
 		return nil
-	case notifier.FieldIsActive:
-		m.ResetIsActive()
+	case usertoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case usertoken.FieldAttempts:
+		m.ResetAttempts()
 		return nil
 	}
-	return fmt.Errorf("unknown Notifier field %s", name)
+	return fmt.Errorf("unknown UserToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NotifierMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.group != nil {
-		edges = append(edges, notifier.EdgeGroup)
-	}
+func (m *UserTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.user != nil {
-		edges = append(edges, notifier.EdgeUser)
+		edges = append(edges, usertoken.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NotifierMutation) AddedIDs(name string) []ent.Value {
+func (m *UserTokenMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case notifier.EdgeGroup:
-		if id := m.group; id != nil {
-			return []ent.Value{*id}
-		}
-	case notifier.EdgeUser:
+	case usertoken.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
@@ -10606,36 +21610,31 @@ func (m *NotifierMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NotifierMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *UserTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NotifierMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserTokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NotifierMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedgroup {
-		edges = append(edges, notifier.EdgeGroup)
-	}
+func (m *UserTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.cleareduser {
-		edges = append(edges, notifier.EdgeUser)
+		edges = append(edges, usertoken.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NotifierMutation) EdgeCleared(name string) bool {
+func (m *UserTokenMutation) EdgeCleared(name string) bool {
 	switch name {
-	case notifier.EdgeGroup:
-		return m.clearedgroup
-	case notifier.EdgeUser:
+	case usertoken.EdgeUser:
 		return m.cleareduser
 	}
 	return false
@@ -10643,72 +21642,65 @@ func (m *NotifierMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NotifierMutation) ClearEdge(name string) error {
+func (m *UserTokenMutation) ClearEdge(name string) error {
 	switch name {
-	case notifier.EdgeGroup:
-		m.ClearGroup()
-		return nil
-	case notifier.EdgeUser:
+	case usertoken.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Notifier unique edge %s", name)
+	return fmt.Errorf("unknown UserToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NotifierMutation) ResetEdge(name string) error {
+func (m *UserTokenMutation) ResetEdge(name string) error {
 	switch name {
-	case notifier.EdgeGroup:
-		m.ResetGroup()
-		return nil
-	case notifier.EdgeUser:
+	case usertoken.EdgeUser:
 		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Notifier edge %s", name)
+	return fmt.Errorf("unknown UserToken edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// WebAuthnCredentialMutation represents an operation that mutates the WebAuthnCredential nodes in the graph.
+type WebAuthnCredentialMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	created_at         *time.Time
-	updated_at         *time.Time
-	name               *string
-	email              *string
-	password           *string
-	is_superuser       *bool
-	superuser          *bool
-	role               *user.Role
-	activated_on       *time.Time
-	clearedFields      map[string]struct{}
-	group              *uuid.UUID
-	clearedgroup       bool
-	auth_tokens        map[uuid.UUID]struct{}
-	removedauth_tokens map[uuid.UUID]struct{}
-	clearedauth_tokens bool
-	notifiers          map[uuid.UUID]struct{}
-	removednotifiers   map[uuid.UUID]struct{}
-	clearednotifiers   bool
-	done               bool
-	oldValue           func(context.Context) (*User, error)
-	predicates         []predicate.User
-}
-
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
-
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	created_at       *time.Time
+	updated_at       *time.Time
+	name             *string
+	credential_id    *[]byte
+	public_key       *[]byte
+	attestation_type *string
+	transports       *[]string
+	appendtransports []string
+	aaguid           *[]byte
+	sign_count       *uint32
+	addsign_count    *int32
+	backup_eligible  *bool
+	backup_state     *bool
+	last_used_at     *time.Time
+	clearedFields    map[string]struct{}
+	user             *uuid.UUID
+	cleareduser      bool
+	done             bool
+	oldValue         func(context.Context) (*WebAuthnCredential, error)
+	predicates       []predicate.WebAuthnCredential
+}
+
+var _ ent.Mutation = (*WebAuthnCredentialMutation)(nil)
+
+// webauthncredentialOption allows management of the mutation configuration using functional options.
+type webauthncredentialOption func(*WebAuthnCredentialMutation)
+
+// newWebAuthnCredentialMutation creates new mutation for the WebAuthnCredential entity.
+func newWebAuthnCredentialMutation(c config, op Op, opts ...webauthncredentialOption) *WebAuthnCredentialMutation {
+	m := &WebAuthnCredentialMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeWebAuthnCredential,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -10717,20 +21709,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
+// withWebAuthnCredentialID sets the ID field of the mutation.
+func withWebAuthnCredentialID(id uuid.UUID) webauthncredentialOption {
+	return func(m *WebAuthnCredentialMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *WebAuthnCredential
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*WebAuthnCredential, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().WebAuthnCredential.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -10739,10 +21731,10 @@ func withUserID(id uuid.UUID) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withWebAuthnCredential sets the old WebAuthnCredential of the mutation.
+func withWebAuthnCredential(node *WebAuthnCredential) webauthncredentialOption {
+	return func(m *WebAuthnCredentialMutation) {
+		m.oldValue = func(context.Context) (*WebAuthnCredential, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -10751,7 +21743,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m WebAuthnCredentialMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -10759,7 +21751,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m WebAuthnCredentialMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -10769,14 +21761,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of WebAuthnCredential entities.
+func (m *WebAuthnCredentialMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebAuthnCredentialMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -10787,7 +21779,7 @@ func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebAuthnCredentialMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -10796,19 +21788,19 @@ func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebAuthnCredential.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
+func (m *WebAuthnCredentialMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WebAuthnCredentialMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -10816,10 +21808,10 @@ func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebAuthnCredentialMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -10834,17 +21826,17 @@ func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
+func (m *WebAuthnCredentialMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
+func (m *WebAuthnCredentialMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *WebAuthnCredentialMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -10852,10 +21844,10 @@ func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebAuthnCredentialMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -10870,17 +21862,53 @@ func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
+func (m *WebAuthnCredentialMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
+// SetUserID sets the "user_id" field.
+func (m *WebAuthnCredentialMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *WebAuthnCredentialMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebAuthnCredentialMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *WebAuthnCredentialMutation) ResetUserID() {
+	m.user = nil
+}
+
 // SetName sets the "name" field.
-func (m *UserMutation) SetName(s string) {
+func (m *WebAuthnCredentialMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *UserMutation) Name() (r string, exists bool) {
+func (m *WebAuthnCredentialMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -10888,10 +21916,10 @@ func (m *UserMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WebAuthnCredentialMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -10906,395 +21934,458 @@ func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *UserMutation) ResetName() {
+func (m *WebAuthnCredentialMutation) ResetName() {
 	m.name = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetCredentialID sets the "credential_id" field.
+func (m *WebAuthnCredentialMutation) SetCredentialID(b []byte) {
+	m.credential_id = &b
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// CredentialID returns the value of the "credential_id" field in the mutation.
+func (m *WebAuthnCredentialMutation) CredentialID() (r []byte, exists bool) {
+	v := m.credential_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCredentialID returns the old "credential_id" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *WebAuthnCredentialMutation) OldCredentialID(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldCredentialID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldCredentialID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldCredentialID: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.CredentialID, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ResetCredentialID resets all changes to the "credential_id" field.
+func (m *WebAuthnCredentialMutation) ResetCredentialID() {
+	m.credential_id = nil
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// SetPublicKey sets the "public_key" field.
+func (m *WebAuthnCredentialMutation) SetPublicKey(b []byte) {
+	m.public_key = &b
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *WebAuthnCredentialMutation) PublicKey() (r []byte, exists bool) {
+	v := m.public_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldPublicKey returns the old "public_key" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *WebAuthnCredentialMutation) OldPublicKey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.PublicKey, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *WebAuthnCredentialMutation) ResetPublicKey() {
+	m.public_key = nil
 }
 
-// SetIsSuperuser sets the "is_superuser" field.
-func (m *UserMutation) SetIsSuperuser(b bool) {
-	m.is_superuser = &b
+// SetAttestationType sets the "attestation_type" field.
+func (m *WebAuthnCredentialMutation) SetAttestationType(s string) {
+	m.attestation_type = &s
 }
 
-// IsSuperuser returns the value of the "is_superuser" field in the mutation.
-func (m *UserMutation) IsSuperuser() (r bool, exists bool) {
-	v := m.is_superuser
+// AttestationType returns the value of the "attestation_type" field in the mutation.
+func (m *WebAuthnCredentialMutation) AttestationType() (r string, exists bool) {
+	v := m.attestation_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsSuperuser returns the old "is_superuser" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldAttestationType returns the old "attestation_type" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIsSuperuser(ctx context.Context) (v bool, err error) {
+func (m *WebAuthnCredentialMutation) OldAttestationType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsSuperuser is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttestationType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsSuperuser requires an ID field in the mutation")
+		return v, errors.New("OldAttestationType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsSuperuser: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttestationType: %w", err)
 	}
-	return oldValue.IsSuperuser, nil
+	return oldValue.AttestationType, nil
 }
 
-// ResetIsSuperuser resets all changes to the "is_superuser" field.
-func (m *UserMutation) ResetIsSuperuser() {
-	m.is_superuser = nil
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (m *WebAuthnCredentialMutation) ClearAttestationType() {
+	m.attestation_type = nil
+	m.clearedFields[webauthncredential.FieldAttestationType] = struct{}{}
 }
 
-// SetSuperuser sets the "superuser" field.
-func (m *UserMutation) SetSuperuser(b bool) {
-	m.superuser = &b
+// AttestationTypeCleared returns if the "attestation_type" field was cleared in this mutation.
+func (m *WebAuthnCredentialMutation) AttestationTypeCleared() bool {
+	_, ok := m.clearedFields[webauthncredential.FieldAttestationType]
+	return ok
 }
 
-// Superuser returns the value of the "superuser" field in the mutation.
-func (m *UserMutation) Superuser() (r bool, exists bool) {
-	v := m.superuser
+// ResetAttestationType resets all changes to the "attestation_type" field.
+func (m *WebAuthnCredentialMutation) ResetAttestationType() {
+	m.attestation_type = nil
+	delete(m.clearedFields, webauthncredential.FieldAttestationType)
+}
+
+// SetTransports sets the "transports" field.
+func (m *WebAuthnCredentialMutation) SetTransports(s []string) {
+	m.transports = &s
+	m.appendtransports = nil
+}
+
+// Transports returns the value of the "transports" field in the mutation.
+func (m *WebAuthnCredentialMutation) Transports() (r []string, exists bool) {
+	v := m.transports
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSuperuser returns the old "superuser" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldTransports returns the old "transports" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldSuperuser(ctx context.Context) (v bool, err error) {
+func (m *WebAuthnCredentialMutation) OldTransports(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuperuser is only allowed on UpdateOne operations")
+		return v, errors.New("OldTransports is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuperuser requires an ID field in the mutation")
+		return v, errors.New("OldTransports requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuperuser: %w", err)
+		return v, fmt.Errorf("querying old value for OldTransports: %w", err)
 	}
-	return oldValue.Superuser, nil
+	return oldValue.Transports, nil
 }
 
-// ResetSuperuser resets all changes to the "superuser" field.
-func (m *UserMutation) ResetSuperuser() {
-	m.superuser = nil
+// AppendTransports adds s to the "transports" field.
+func (m *WebAuthnCredentialMutation) AppendTransports(s []string) {
+	m.appendtransports = append(m.appendtransports, s...)
 }
 
-// SetRole sets the "role" field.
-func (m *UserMutation) SetRole(u user.Role) {
-	m.role = &u
+// AppendedTransports returns the list of values that were appended to the "transports" field in this mutation.
+func (m *WebAuthnCredentialMutation) AppendedTransports() ([]string, bool) {
+	if len(m.appendtransports) == 0 {
+		return nil, false
+	}
+	return m.appendtransports, true
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *UserMutation) Role() (r user.Role, exists bool) {
-	v := m.role
+// ClearTransports clears the value of the "transports" field.
+func (m *WebAuthnCredentialMutation) ClearTransports() {
+	m.transports = nil
+	m.appendtransports = nil
+	m.clearedFields[webauthncredential.FieldTransports] = struct{}{}
+}
+
+// TransportsCleared returns if the "transports" field was cleared in this mutation.
+func (m *WebAuthnCredentialMutation) TransportsCleared() bool {
+	_, ok := m.clearedFields[webauthncredential.FieldTransports]
+	return ok
+}
+
+// ResetTransports resets all changes to the "transports" field.
+func (m *WebAuthnCredentialMutation) ResetTransports() {
+	m.transports = nil
+	m.appendtransports = nil
+	delete(m.clearedFields, webauthncredential.FieldTransports)
+}
+
+// SetAaguid sets the "aaguid" field.
+func (m *WebAuthnCredentialMutation) SetAaguid(b []byte) {
+	m.aaguid = &b
+}
+
+// Aaguid returns the value of the "aaguid" field in the mutation.
+func (m *WebAuthnCredentialMutation) Aaguid() (r []byte, exists bool) {
+	v := m.aaguid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldAaguid returns the old "aaguid" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
+func (m *WebAuthnCredentialMutation) OldAaguid(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldAaguid is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldAaguid requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldAaguid: %w", err)
 	}
-	return oldValue.Role, nil
+	return oldValue.Aaguid, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *UserMutation) ResetRole() {
-	m.role = nil
+// ClearAaguid clears the value of the "aaguid" field.
+func (m *WebAuthnCredentialMutation) ClearAaguid() {
+	m.aaguid = nil
+	m.clearedFields[webauthncredential.FieldAaguid] = struct{}{}
 }
 
-// SetActivatedOn sets the "activated_on" field.
-func (m *UserMutation) SetActivatedOn(t time.Time) {
-	m.activated_on = &t
+// AaguidCleared returns if the "aaguid" field was cleared in this mutation.
+func (m *WebAuthnCredentialMutation) AaguidCleared() bool {
+	_, ok := m.clearedFields[webauthncredential.FieldAaguid]
+	return ok
 }
 
-// ActivatedOn returns the value of the "activated_on" field in the mutation.
-func (m *UserMutation) ActivatedOn() (r time.Time, exists bool) {
-	v := m.activated_on
+// ResetAaguid resets all changes to the "aaguid" field.
+func (m *WebAuthnCredentialMutation) ResetAaguid() {
+	m.aaguid = nil
+	delete(m.clearedFields, webauthncredential.FieldAaguid)
+}
+
+// SetSignCount sets the "sign_count" field.
+func (m *WebAuthnCredentialMutation) SetSignCount(u uint32) {
+	m.sign_count = &u
+	m.addsign_count = nil
+}
+
+// SignCount returns the value of the "sign_count" field in the mutation.
+func (m *WebAuthnCredentialMutation) SignCount() (r uint32, exists bool) {
+	v := m.sign_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActivatedOn returns the old "activated_on" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldSignCount returns the old "sign_count" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldActivatedOn(ctx context.Context) (v time.Time, err error) {
+func (m *WebAuthnCredentialMutation) OldSignCount(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActivatedOn is only allowed on UpdateOne operations")
+		return v, errors.New("OldSignCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActivatedOn requires an ID field in the mutation")
+		return v, errors.New("OldSignCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActivatedOn: %w", err)
+		return v, fmt.Errorf("querying old value for OldSignCount: %w", err)
 	}
-	return oldValue.ActivatedOn, nil
+	return oldValue.SignCount, nil
 }
 
-// ClearActivatedOn clears the value of the "activated_on" field.
-func (m *UserMutation) ClearActivatedOn() {
-	m.activated_on = nil
-	m.clearedFields[user.FieldActivatedOn] = struct{}{}
+// AddSignCount adds u to the "sign_count" field.
+func (m *WebAuthnCredentialMutation) AddSignCount(u int32) {
+	if m.addsign_count != nil {
+		*m.addsign_count += u
+	} else {
+		m.addsign_count = &u
+	}
 }
 
-// ActivatedOnCleared returns if the "activated_on" field was cleared in this mutation.
-func (m *UserMutation) ActivatedOnCleared() bool {
-	_, ok := m.clearedFields[user.FieldActivatedOn]
-	return ok
+// AddedSignCount returns the value that was added to the "sign_count" field in this mutation.
+func (m *WebAuthnCredentialMutation) AddedSignCount() (r int32, exists bool) {
+	v := m.addsign_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetActivatedOn resets all changes to the "activated_on" field.
-func (m *UserMutation) ResetActivatedOn() {
-	m.activated_on = nil
-	delete(m.clearedFields, user.FieldActivatedOn)
+// ResetSignCount resets all changes to the "sign_count" field.
+func (m *WebAuthnCredentialMutation) ResetSignCount() {
+	m.sign_count = nil
+	m.addsign_count = nil
 }
 
-// SetGroupID sets the "group" edge to the Group entity by id.
-func (m *UserMutation) SetGroupID(id uuid.UUID) {
-	m.group = &id
+// SetBackupEligible sets the "backup_eligible" field.
+func (m *WebAuthnCredentialMutation) SetBackupEligible(b bool) {
+	m.backup_eligible = &b
 }
 
-// ClearGroup clears the "group" edge to the Group entity.
-func (m *UserMutation) ClearGroup() {
-	m.clearedgroup = true
+// BackupEligible returns the value of the "backup_eligible" field in the mutation.
+func (m *WebAuthnCredentialMutation) BackupEligible() (r bool, exists bool) {
+	v := m.backup_eligible
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *UserMutation) GroupCleared() bool {
-	return m.clearedgroup
+// OldBackupEligible returns the old "backup_eligible" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebAuthnCredentialMutation) OldBackupEligible(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackupEligible is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackupEligible requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackupEligible: %w", err)
+	}
+	return oldValue.BackupEligible, nil
 }
 
-// GroupID returns the "group" edge ID in the mutation.
-func (m *UserMutation) GroupID() (id uuid.UUID, exists bool) {
-	if m.group != nil {
-		return *m.group, true
-	}
-	return
+// ResetBackupEligible resets all changes to the "backup_eligible" field.
+func (m *WebAuthnCredentialMutation) ResetBackupEligible() {
+	m.backup_eligible = nil
 }
 
-// GroupIDs returns the "group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) GroupIDs() (ids []uuid.UUID) {
-	if id := m.group; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetBackupState sets the "backup_state" field.
+func (m *WebAuthnCredentialMutation) SetBackupState(b bool) {
+	m.backup_state = &b
 }
 
-// ResetGroup resets all changes to the "group" edge.
-func (m *UserMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
+// BackupState returns the value of the "backup_state" field in the mutation.
+func (m *WebAuthnCredentialMutation) BackupState() (r bool, exists bool) {
+	v := m.backup_state
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddAuthTokenIDs adds the "auth_tokens" edge to the AuthTokens entity by ids.
-func (m *UserMutation) AddAuthTokenIDs(ids ...uuid.UUID) {
-	if m.auth_tokens == nil {
-		m.auth_tokens = make(map[uuid.UUID]struct{})
+// OldBackupState returns the old "backup_state" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebAuthnCredentialMutation) OldBackupState(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackupState is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.auth_tokens[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackupState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackupState: %w", err)
 	}
+	return oldValue.BackupState, nil
 }
 
-// ClearAuthTokens clears the "auth_tokens" edge to the AuthTokens entity.
-func (m *UserMutation) ClearAuthTokens() {
-	m.clearedauth_tokens = true
+// ResetBackupState resets all changes to the "backup_state" field.
+func (m *WebAuthnCredentialMutation) ResetBackupState() {
+	m.backup_state = nil
 }
 
-// AuthTokensCleared reports if the "auth_tokens" edge to the AuthTokens entity was cleared.
-func (m *UserMutation) AuthTokensCleared() bool {
-	return m.clearedauth_tokens
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *WebAuthnCredentialMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// RemoveAuthTokenIDs removes the "auth_tokens" edge to the AuthTokens entity by IDs.
-func (m *UserMutation) RemoveAuthTokenIDs(ids ...uuid.UUID) {
-	if m.removedauth_tokens == nil {
-		m.removedauth_tokens = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.auth_tokens, ids[i])
-		m.removedauth_tokens[ids[i]] = struct{}{}
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *WebAuthnCredentialMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedAuthTokens returns the removed IDs of the "auth_tokens" edge to the AuthTokens entity.
-func (m *UserMutation) RemovedAuthTokensIDs() (ids []uuid.UUID) {
-	for id := range m.removedauth_tokens {
-		ids = append(ids, id)
+// OldLastUsedAt returns the old "last_used_at" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebAuthnCredentialMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// AuthTokensIDs returns the "auth_tokens" edge IDs in the mutation.
-func (m *UserMutation) AuthTokensIDs() (ids []uuid.UUID) {
-	for id := range m.auth_tokens {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
-	return
-}
-
-// ResetAuthTokens resets all changes to the "auth_tokens" edge.
-func (m *UserMutation) ResetAuthTokens() {
-	m.auth_tokens = nil
-	m.clearedauth_tokens = false
-	m.removedauth_tokens = nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
 }
 
-// AddNotifierIDs adds the "notifiers" edge to the Notifier entity by ids.
-func (m *UserMutation) AddNotifierIDs(ids ...uuid.UUID) {
-	if m.notifiers == nil {
-		m.notifiers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.notifiers[ids[i]] = struct{}{}
-	}
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *WebAuthnCredentialMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[webauthncredential.FieldLastUsedAt] = struct{}{}
 }
 
-// ClearNotifiers clears the "notifiers" edge to the Notifier entity.
-func (m *UserMutation) ClearNotifiers() {
-	m.clearednotifiers = true
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *WebAuthnCredentialMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[webauthncredential.FieldLastUsedAt]
+	return ok
 }
 
-// NotifiersCleared reports if the "notifiers" edge to the Notifier entity was cleared.
-func (m *UserMutation) NotifiersCleared() bool {
-	return m.clearednotifiers
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *WebAuthnCredentialMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, webauthncredential.FieldLastUsedAt)
 }
 
-// RemoveNotifierIDs removes the "notifiers" edge to the Notifier entity by IDs.
-func (m *UserMutation) RemoveNotifierIDs(ids ...uuid.UUID) {
-	if m.removednotifiers == nil {
-		m.removednotifiers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.notifiers, ids[i])
-		m.removednotifiers[ids[i]] = struct{}{}
-	}
+// ClearUser clears the "user" edge to the User entity.
+func (m *WebAuthnCredentialMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[webauthncredential.FieldUserID] = struct{}{}
 }
 
-// RemovedNotifiers returns the removed IDs of the "notifiers" edge to the Notifier entity.
-func (m *UserMutation) RemovedNotifiersIDs() (ids []uuid.UUID) {
-	for id := range m.removednotifiers {
-		ids = append(ids, id)
-	}
-	return
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *WebAuthnCredentialMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// NotifiersIDs returns the "notifiers" edge IDs in the mutation.
-func (m *UserMutation) NotifiersIDs() (ids []uuid.UUID) {
-	for id := range m.notifiers {
-		ids = append(ids, id)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *WebAuthnCredentialMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetNotifiers resets all changes to the "notifiers" edge.
-func (m *UserMutation) ResetNotifiers() {
-	m.notifiers = nil
-	m.clearednotifiers = false
-	m.removednotifiers = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *WebAuthnCredentialMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the WebAuthnCredentialMutation builder.
+func (m *WebAuthnCredentialMutation) Where(ps ...predicate.WebAuthnCredential) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebAuthnCredentialMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *WebAuthnCredentialMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebAuthnCredential, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -11302,51 +22393,63 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *WebAuthnCredentialMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *WebAuthnCredentialMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (WebAuthnCredential).
+func (m *WebAuthnCredentialMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *WebAuthnCredentialMutation) Fields() []string {
+	fields := make([]string, 0, 13)
 	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+		fields = append(fields, webauthncredential.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+		fields = append(fields, webauthncredential.FieldUpdatedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, webauthncredential.FieldUserID)
 	}
 	if m.name != nil {
-		fields = append(fields, user.FieldName)
+		fields = append(fields, webauthncredential.FieldName)
 	}
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+	if m.credential_id != nil {
+		fields = append(fields, webauthncredential.FieldCredentialID)
 	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+	if m.public_key != nil {
+		fields = append(fields, webauthncredential.FieldPublicKey)
 	}
-	if m.is_superuser != nil {
-		fields = append(fields, user.FieldIsSuperuser)
+	if m.attestation_type != nil {
+		fields = append(fields, webauthncredential.FieldAttestationType)
 	}
-	if m.superuser != nil {
-		fields = append(fields, user.FieldSuperuser)
+	if m.transports != nil {
+		fields = append(fields, webauthncredential.FieldTransports)
 	}
-	if m.role != nil {
-		fields = append(fields, user.FieldRole)
+	if m.aaguid != nil {
+		fields = append(fields, webauthncredential.FieldAaguid)
 	}
-	if m.activated_on != nil {
-		fields = append(fields, user.FieldActivatedOn)
+	if m.sign_count != nil {
+		fields = append(fields, webauthncredential.FieldSignCount)
+	}
+	if m.backup_eligible != nil {
+		fields = append(fields, webauthncredential.FieldBackupEligible)
+	}
+	if m.backup_state != nil {
+		fields = append(fields, webauthncredential.FieldBackupState)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, webauthncredential.FieldLastUsedAt)
 	}
 	return fields
 }
@@ -11354,26 +22457,34 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *WebAuthnCredentialMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldCreatedAt:
+	case webauthncredential.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
+	case webauthncredential.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case user.FieldName:
+	case webauthncredential.FieldUserID:
+		return m.UserID()
+	case webauthncredential.FieldName:
 		return m.Name()
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldIsSuperuser:
-		return m.IsSuperuser()
-	case user.FieldSuperuser:
-		return m.Superuser()
-	case user.FieldRole:
-		return m.Role()
-	case user.FieldActivatedOn:
-		return m.ActivatedOn()
+	case webauthncredential.FieldCredentialID:
+		return m.CredentialID()
+	case webauthncredential.FieldPublicKey:
+		return m.PublicKey()
+	case webauthncredential.FieldAttestationType:
+		return m.AttestationType()
+	case webauthncredential.FieldTransports:
+		return m.Transports()
+	case webauthncredential.FieldAaguid:
+		return m.Aaguid()
+	case webauthncredential.FieldSignCount:
+		return m.SignCount()
+	case webauthncredential.FieldBackupEligible:
+		return m.BackupEligible()
+	case webauthncredential.FieldBackupState:
+		return m.BackupState()
+	case webauthncredential.FieldLastUsedAt:
+		return m.LastUsedAt()
 	}
 	return nil, false
 }
@@ -11381,311 +22492,338 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebAuthnCredentialMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldCreatedAt:
+	case webauthncredential.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
+	case webauthncredential.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case user.FieldName:
+	case webauthncredential.FieldUserID:
+		return m.OldUserID(ctx)
+	case webauthncredential.FieldName:
 		return m.OldName(ctx)
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldIsSuperuser:
-		return m.OldIsSuperuser(ctx)
-	case user.FieldSuperuser:
-		return m.OldSuperuser(ctx)
-	case user.FieldRole:
-		return m.OldRole(ctx)
-	case user.FieldActivatedOn:
-		return m.OldActivatedOn(ctx)
-	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	case webauthncredential.FieldCredentialID:
+		return m.OldCredentialID(ctx)
+	case webauthncredential.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case webauthncredential.FieldAttestationType:
+		return m.OldAttestationType(ctx)
+	case webauthncredential.FieldTransports:
+		return m.OldTransports(ctx)
+	case webauthncredential.FieldAaguid:
+		return m.OldAaguid(ctx)
+	case webauthncredential.FieldSignCount:
+		return m.OldSignCount(ctx)
+	case webauthncredential.FieldBackupEligible:
+		return m.OldBackupEligible(ctx)
+	case webauthncredential.FieldBackupState:
+		return m.OldBackupState(ctx)
+	case webauthncredential.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WebAuthnCredential field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *WebAuthnCredentialMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldCreatedAt:
+	case webauthncredential.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case webauthncredential.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case user.FieldName:
+	case webauthncredential.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case webauthncredential.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case user.FieldEmail:
-		v, ok := value.(string)
+	case webauthncredential.FieldCredentialID:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetCredentialID(v)
 		return nil
-	case user.FieldPassword:
+	case webauthncredential.FieldPublicKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicKey(v)
+		return nil
+	case webauthncredential.FieldAttestationType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetAttestationType(v)
 		return nil
-	case user.FieldIsSuperuser:
-		v, ok := value.(bool)
+	case webauthncredential.FieldTransports:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsSuperuser(v)
+		m.SetTransports(v)
 		return nil
-	case user.FieldSuperuser:
+	case webauthncredential.FieldAaguid:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAaguid(v)
+		return nil
+	case webauthncredential.FieldSignCount:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSignCount(v)
+		return nil
+	case webauthncredential.FieldBackupEligible:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSuperuser(v)
+		m.SetBackupEligible(v)
 		return nil
-	case user.FieldRole:
-		v, ok := value.(user.Role)
+	case webauthncredential.FieldBackupState:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRole(v)
+		m.SetBackupState(v)
 		return nil
-	case user.FieldActivatedOn:
+	case webauthncredential.FieldLastUsedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActivatedOn(v)
+		m.SetLastUsedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebAuthnCredential field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
-	return nil
+func (m *WebAuthnCredentialMutation) AddedFields() []string {
+	var fields []string
+	if m.addsign_count != nil {
+		fields = append(fields, webauthncredential.FieldSignCount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebAuthnCredentialMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webauthncredential.FieldSignCount:
+		return m.AddedSignCount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *WebAuthnCredentialMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webauthncredential.FieldSignCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSignCount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown WebAuthnCredential numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *WebAuthnCredentialMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(user.FieldActivatedOn) {
-		fields = append(fields, user.FieldActivatedOn)
+	if m.FieldCleared(webauthncredential.FieldAttestationType) {
+		fields = append(fields, webauthncredential.FieldAttestationType)
+	}
+	if m.FieldCleared(webauthncredential.FieldTransports) {
+		fields = append(fields, webauthncredential.FieldTransports)
+	}
+	if m.FieldCleared(webauthncredential.FieldAaguid) {
+		fields = append(fields, webauthncredential.FieldAaguid)
+	}
+	if m.FieldCleared(webauthncredential.FieldLastUsedAt) {
+		fields = append(fields, webauthncredential.FieldLastUsedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *WebAuthnCredentialMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
+func (m *WebAuthnCredentialMutation) ClearField(name string) error {
 	switch name {
-	case user.FieldActivatedOn:
-		m.ClearActivatedOn()
+	case webauthncredential.FieldAttestationType:
+		m.ClearAttestationType()
+		return nil
+	case webauthncredential.FieldTransports:
+		m.ClearTransports()
+		return nil
+	case webauthncredential.FieldAaguid:
+		m.ClearAaguid()
+		return nil
+	case webauthncredential.FieldLastUsedAt:
+		m.ClearLastUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+	return fmt.Errorf("unknown WebAuthnCredential nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *WebAuthnCredentialMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldCreatedAt:
+	case webauthncredential.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldUpdatedAt:
+	case webauthncredential.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case user.FieldName:
+	case webauthncredential.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case webauthncredential.FieldName:
 		m.ResetName()
 		return nil
-	case user.FieldEmail:
-		m.ResetEmail()
+	case webauthncredential.FieldCredentialID:
+		m.ResetCredentialID()
 		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
+	case webauthncredential.FieldPublicKey:
+		m.ResetPublicKey()
 		return nil
-	case user.FieldIsSuperuser:
-		m.ResetIsSuperuser()
+	case webauthncredential.FieldAttestationType:
+		m.ResetAttestationType()
 		return nil
-	case user.FieldSuperuser:
-		m.ResetSuperuser()
+	case webauthncredential.FieldTransports:
+		m.ResetTransports()
 		return nil
-	case user.FieldRole:
-		m.ResetRole()
+	case webauthncredential.FieldAaguid:
+		m.ResetAaguid()
 		return nil
-	case user.FieldActivatedOn:
-		m.ResetActivatedOn()
+	case webauthncredential.FieldSignCount:
+		m.ResetSignCount()
+		return nil
+	case webauthncredential.FieldBackupEligible:
+		m.ResetBackupEligible()
+		return nil
+	case webauthncredential.FieldBackupState:
+		m.ResetBackupState()
+		return nil
+	case webauthncredential.FieldLastUsedAt:
+		m.ResetLastUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebAuthnCredential field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.group != nil {
-		edges = append(edges, user.EdgeGroup)
-	}
-	if m.auth_tokens != nil {
-		edges = append(edges, user.EdgeAuthTokens)
-	}
-	if m.notifiers != nil {
-		edges = append(edges, user.EdgeNotifiers)
+func (m *WebAuthnCredentialMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, webauthncredential.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *WebAuthnCredentialMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeGroup:
-		if id := m.group; id != nil {
+	case webauthncredential.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case user.EdgeAuthTokens:
-		ids := make([]ent.Value, 0, len(m.auth_tokens))
-		for id := range m.auth_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeNotifiers:
-		ids := make([]ent.Value, 0, len(m.notifiers))
-		for id := range m.notifiers {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedauth_tokens != nil {
-		edges = append(edges, user.EdgeAuthTokens)
-	}
-	if m.removednotifiers != nil {
-		edges = append(edges, user.EdgeNotifiers)
-	}
+func (m *WebAuthnCredentialMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeAuthTokens:
-		ids := make([]ent.Value, 0, len(m.removedauth_tokens))
-		for id := range m.removedauth_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeNotifiers:
-		ids := make([]ent.Value, 0, len(m.removednotifiers))
-		for id := range m.removednotifiers {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WebAuthnCredentialMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedgroup {
-		edges = append(edges, user.EdgeGroup)
-	}
-	if m.clearedauth_tokens {
-		edges = append(edges, user.EdgeAuthTokens)
-	}
-	if m.clearednotifiers {
-		edges = append(edges, user.EdgeNotifiers)
+func (m *WebAuthnCredentialMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, webauthncredential.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *WebAuthnCredentialMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeGroup:
-		return m.clearedgroup
-	case user.EdgeAuthTokens:
-		return m.clearedauth_tokens
-	case user.EdgeNotifiers:
-		return m.clearednotifiers
+	case webauthncredential.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *WebAuthnCredentialMutation) ClearEdge(name string) error {
 	switch name {
-	case user.EdgeGroup:
-		m.ClearGroup()
+	case webauthncredential.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown WebAuthnCredential unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *WebAuthnCredentialMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeGroup:
-		m.ResetGroup()
-		return nil
-	case user.EdgeAuthTokens:
-		m.ResetAuthTokens()
-		return nil
-	case user.EdgeNotifiers:
-		m.ResetNotifiers()
+	case webauthncredential.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown WebAuthnCredential edge %s", name)
 }
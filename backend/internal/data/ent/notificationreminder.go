@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationreminder"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationrule"
+)
+
+// NotificationReminder is the model entity for the NotificationReminder schema.
+type NotificationReminder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID uuid.UUID `json:"group_id,omitempty"`
+	// RuleID holds the value of the "rule_id" field.
+	RuleID uuid.UUID `json:"rule_id,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID uuid.UUID `json:"item_id,omitempty"`
+	// Target holds the value of the "target" field.
+	Target string `json:"target,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// DueDate holds the value of the "due_date" field.
+	DueDate time.Time `json:"due_date,omitempty"`
+	// LeadDays holds the value of the "lead_days" field.
+	LeadDays int `json:"lead_days,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NotificationReminderQuery when eager-loading is set.
+	Edges        NotificationReminderEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// NotificationReminderEdges holds the relations/edges for other nodes in the graph.
+type NotificationReminderEdges struct {
+	// Group holds the value of the group edge.
+	Group *Group `json:"group,omitempty"`
+	// Rule holds the value of the rule edge.
+	Rule *NotificationRule `json:"rule,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationReminderEdges) GroupOrErr() (*Group, error) {
+	if e.loadedTypes[0] {
+		if e.Group == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: group.Label}
+		}
+		return e.Group, nil
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// RuleOrErr returns the Rule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationReminderEdges) RuleOrErr() (*NotificationRule, error) {
+	if e.loadedTypes[1] {
+		if e.Rule == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: notificationrule.Label}
+		}
+		return e.Rule, nil
+	}
+	return nil, &NotLoadedError{edge: "rule"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationReminder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationreminder.FieldLeadDays:
+			values[i] = new(sql.NullInt64)
+		case notificationreminder.FieldTarget, notificationreminder.FieldTitle:
+			values[i] = new(sql.NullString)
+		case notificationreminder.FieldCreatedAt, notificationreminder.FieldUpdatedAt, notificationreminder.FieldDueDate:
+			values[i] = new(sql.NullTime)
+		case notificationreminder.FieldID, notificationreminder.FieldGroupID, notificationreminder.FieldRuleID, notificationreminder.FieldItemID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationReminder fields.
+func (nr *NotificationReminder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationreminder.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				nr.ID = *value
+			}
+		case notificationreminder.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				nr.CreatedAt = value.Time
+			}
+		case notificationreminder.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				nr.UpdatedAt = value.Time
+			}
+		case notificationreminder.FieldGroupID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value != nil {
+				nr.GroupID = *value
+			}
+		case notificationreminder.FieldRuleID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_id", values[i])
+			} else if value != nil {
+				nr.RuleID = *value
+			}
+		case notificationreminder.FieldItemID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value != nil {
+				nr.ItemID = *value
+			}
+		case notificationreminder.FieldTarget:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target", values[i])
+			} else if value.Valid {
+				nr.Target = value.String
+			}
+		case notificationreminder.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				nr.Title = value.String
+			}
+		case notificationreminder.FieldDueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_date", values[i])
+			} else if value.Valid {
+				nr.DueDate = value.Time
+			}
+		case notificationreminder.FieldLeadDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lead_days", values[i])
+			} else if value.Valid {
+				nr.LeadDays = int(value.Int64)
+			}
+		default:
+			nr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NotificationReminder.
+// This includes values selected through modifiers, order, etc.
+func (nr *NotificationReminder) Value(name string) (ent.Value, error) {
+	return nr.selectValues.Get(name)
+}
+
+// QueryGroup queries the "group" edge of the NotificationReminder entity.
+func (nr *NotificationReminder) QueryGroup() *GroupQuery {
+	return NewNotificationReminderClient(nr.config).QueryGroup(nr)
+}
+
+// QueryRule queries the "rule" edge of the NotificationReminder entity.
+func (nr *NotificationReminder) QueryRule() *NotificationRuleQuery {
+	return NewNotificationReminderClient(nr.config).QueryRule(nr)
+}
+
+// Update returns a builder for updating this NotificationReminder.
+// Note that you need to call NotificationReminder.Unwrap() before calling this method if this NotificationReminder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (nr *NotificationReminder) Update() *NotificationReminderUpdateOne {
+	return NewNotificationReminderClient(nr.config).UpdateOne(nr)
+}
+
+// Unwrap unwraps the NotificationReminder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (nr *NotificationReminder) Unwrap() *NotificationReminder {
+	_tx, ok := nr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NotificationReminder is not a transactional entity")
+	}
+	nr.config.driver = _tx.drv
+	return nr
+}
+
+// String implements the fmt.Stringer.
+func (nr *NotificationReminder) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationReminder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", nr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(nr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(nr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(fmt.Sprintf("%v", nr.GroupID))
+	builder.WriteString(", ")
+	builder.WriteString("rule_id=")
+	builder.WriteString(fmt.Sprintf("%v", nr.RuleID))
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", nr.ItemID))
+	builder.WriteString(", ")
+	builder.WriteString("target=")
+	builder.WriteString(nr.Target)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(nr.Title)
+	builder.WriteString(", ")
+	builder.WriteString("due_date=")
+	builder.WriteString(nr.DueDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("lead_days=")
+	builder.WriteString(fmt.Sprintf("%v", nr.LeadDays))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationReminders is a parsable slice of NotificationReminder.
+type NotificationReminders []*NotificationReminder
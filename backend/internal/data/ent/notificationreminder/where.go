@@ -0,0 +1,533 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationreminder
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldGroupID, v))
+}
+
+// RuleID applies equality check predicate on the "rule_id" field. It's identical to RuleIDEQ.
+func RuleID(v uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldRuleID, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldItemID, v))
+}
+
+// Target applies equality check predicate on the "target" field. It's identical to TargetEQ.
+func Target(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldTarget, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldTitle, v))
+}
+
+// DueDate applies equality check predicate on the "due_date" field. It's identical to DueDateEQ.
+func DueDate(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldDueDate, v))
+}
+
+// LeadDays applies equality check predicate on the "lead_days" field. It's identical to LeadDaysEQ.
+func LeadDays(v int) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldLeadDays, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// RuleIDEQ applies the EQ predicate on the "rule_id" field.
+func RuleIDEQ(v uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldRuleID, v))
+}
+
+// RuleIDNEQ applies the NEQ predicate on the "rule_id" field.
+func RuleIDNEQ(v uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNEQ(FieldRuleID, v))
+}
+
+// RuleIDIn applies the In predicate on the "rule_id" field.
+func RuleIDIn(vs ...uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldIn(FieldRuleID, vs...))
+}
+
+// RuleIDNotIn applies the NotIn predicate on the "rule_id" field.
+func RuleIDNotIn(vs ...uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNotIn(FieldRuleID, vs...))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v uuid.UUID) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldLTE(FieldItemID, v))
+}
+
+// TargetEQ applies the EQ predicate on the "target" field.
+func TargetEQ(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldTarget, v))
+}
+
+// TargetNEQ applies the NEQ predicate on the "target" field.
+func TargetNEQ(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNEQ(FieldTarget, v))
+}
+
+// TargetIn applies the In predicate on the "target" field.
+func TargetIn(vs ...string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldIn(FieldTarget, vs...))
+}
+
+// TargetNotIn applies the NotIn predicate on the "target" field.
+func TargetNotIn(vs ...string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNotIn(FieldTarget, vs...))
+}
+
+// TargetGT applies the GT predicate on the "target" field.
+func TargetGT(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldGT(FieldTarget, v))
+}
+
+// TargetGTE applies the GTE predicate on the "target" field.
+func TargetGTE(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldGTE(FieldTarget, v))
+}
+
+// TargetLT applies the LT predicate on the "target" field.
+func TargetLT(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldLT(FieldTarget, v))
+}
+
+// TargetLTE applies the LTE predicate on the "target" field.
+func TargetLTE(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldLTE(FieldTarget, v))
+}
+
+// TargetContains applies the Contains predicate on the "target" field.
+func TargetContains(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldContains(FieldTarget, v))
+}
+
+// TargetHasPrefix applies the HasPrefix predicate on the "target" field.
+func TargetHasPrefix(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldHasPrefix(FieldTarget, v))
+}
+
+// TargetHasSuffix applies the HasSuffix predicate on the "target" field.
+func TargetHasSuffix(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldHasSuffix(FieldTarget, v))
+}
+
+// TargetEqualFold applies the EqualFold predicate on the "target" field.
+func TargetEqualFold(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEqualFold(FieldTarget, v))
+}
+
+// TargetContainsFold applies the ContainsFold predicate on the "target" field.
+func TargetContainsFold(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldContainsFold(FieldTarget, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DueDateEQ applies the EQ predicate on the "due_date" field.
+func DueDateEQ(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldDueDate, v))
+}
+
+// DueDateNEQ applies the NEQ predicate on the "due_date" field.
+func DueDateNEQ(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNEQ(FieldDueDate, v))
+}
+
+// DueDateIn applies the In predicate on the "due_date" field.
+func DueDateIn(vs ...time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldIn(FieldDueDate, vs...))
+}
+
+// DueDateNotIn applies the NotIn predicate on the "due_date" field.
+func DueDateNotIn(vs ...time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNotIn(FieldDueDate, vs...))
+}
+
+// DueDateGT applies the GT predicate on the "due_date" field.
+func DueDateGT(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldGT(FieldDueDate, v))
+}
+
+// DueDateGTE applies the GTE predicate on the "due_date" field.
+func DueDateGTE(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldGTE(FieldDueDate, v))
+}
+
+// DueDateLT applies the LT predicate on the "due_date" field.
+func DueDateLT(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldLT(FieldDueDate, v))
+}
+
+// DueDateLTE applies the LTE predicate on the "due_date" field.
+func DueDateLTE(v time.Time) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldLTE(FieldDueDate, v))
+}
+
+// LeadDaysEQ applies the EQ predicate on the "lead_days" field.
+func LeadDaysEQ(v int) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldEQ(FieldLeadDays, v))
+}
+
+// LeadDaysNEQ applies the NEQ predicate on the "lead_days" field.
+func LeadDaysNEQ(v int) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNEQ(FieldLeadDays, v))
+}
+
+// LeadDaysIn applies the In predicate on the "lead_days" field.
+func LeadDaysIn(vs ...int) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldIn(FieldLeadDays, vs...))
+}
+
+// LeadDaysNotIn applies the NotIn predicate on the "lead_days" field.
+func LeadDaysNotIn(vs ...int) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldNotIn(FieldLeadDays, vs...))
+}
+
+// LeadDaysGT applies the GT predicate on the "lead_days" field.
+func LeadDaysGT(v int) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldGT(FieldLeadDays, v))
+}
+
+// LeadDaysGTE applies the GTE predicate on the "lead_days" field.
+func LeadDaysGTE(v int) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldGTE(FieldLeadDays, v))
+}
+
+// LeadDaysLT applies the LT predicate on the "lead_days" field.
+func LeadDaysLT(v int) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldLT(FieldLeadDays, v))
+}
+
+// LeadDaysLTE applies the LTE predicate on the "lead_days" field.
+func LeadDaysLTE(v int) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.FieldLTE(FieldLeadDays, v))
+}
+
+// HasGroup applies the HasEdge predicate on the "group" edge.
+func HasGroup() predicate.NotificationReminder {
+	return predicate.NotificationReminder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupWith applies the HasEdge predicate on the "group" edge with a given conditions (other predicates).
+func HasGroupWith(preds ...predicate.Group) predicate.NotificationReminder {
+	return predicate.NotificationReminder(func(s *sql.Selector) {
+		step := newGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRule applies the HasEdge predicate on the "rule" edge.
+func HasRule() predicate.NotificationReminder {
+	return predicate.NotificationReminder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RuleTable, RuleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRuleWith applies the HasEdge predicate on the "rule" edge with a given conditions (other predicates).
+func HasRuleWith(preds ...predicate.NotificationRule) predicate.NotificationReminder {
+	return predicate.NotificationReminder(func(s *sql.Selector) {
+		step := newRuleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NotificationReminder) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NotificationReminder) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NotificationReminder) predicate.NotificationReminder {
+	return predicate.NotificationReminder(sql.NotPredicates(p))
+}
@@ -0,0 +1,396 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationreminder"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationrule"
+)
+
+// NotificationReminderCreate is the builder for creating a NotificationReminder entity.
+type NotificationReminderCreate struct {
+	config
+	mutation *NotificationReminderMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nrc *NotificationReminderCreate) SetCreatedAt(t time.Time) *NotificationReminderCreate {
+	nrc.mutation.SetCreatedAt(t)
+	return nrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nrc *NotificationReminderCreate) SetNillableCreatedAt(t *time.Time) *NotificationReminderCreate {
+	if t != nil {
+		nrc.SetCreatedAt(*t)
+	}
+	return nrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nrc *NotificationReminderCreate) SetUpdatedAt(t time.Time) *NotificationReminderCreate {
+	nrc.mutation.SetUpdatedAt(t)
+	return nrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (nrc *NotificationReminderCreate) SetNillableUpdatedAt(t *time.Time) *NotificationReminderCreate {
+	if t != nil {
+		nrc.SetUpdatedAt(*t)
+	}
+	return nrc
+}
+
+// SetGroupID sets the "group_id" field.
+func (nrc *NotificationReminderCreate) SetGroupID(u uuid.UUID) *NotificationReminderCreate {
+	nrc.mutation.SetGroupID(u)
+	return nrc
+}
+
+// SetRuleID sets the "rule_id" field.
+func (nrc *NotificationReminderCreate) SetRuleID(u uuid.UUID) *NotificationReminderCreate {
+	nrc.mutation.SetRuleID(u)
+	return nrc
+}
+
+// SetItemID sets the "item_id" field.
+func (nrc *NotificationReminderCreate) SetItemID(u uuid.UUID) *NotificationReminderCreate {
+	nrc.mutation.SetItemID(u)
+	return nrc
+}
+
+// SetTarget sets the "target" field.
+func (nrc *NotificationReminderCreate) SetTarget(s string) *NotificationReminderCreate {
+	nrc.mutation.SetTarget(s)
+	return nrc
+}
+
+// SetTitle sets the "title" field.
+func (nrc *NotificationReminderCreate) SetTitle(s string) *NotificationReminderCreate {
+	nrc.mutation.SetTitle(s)
+	return nrc
+}
+
+// SetDueDate sets the "due_date" field.
+func (nrc *NotificationReminderCreate) SetDueDate(t time.Time) *NotificationReminderCreate {
+	nrc.mutation.SetDueDate(t)
+	return nrc
+}
+
+// SetLeadDays sets the "lead_days" field.
+func (nrc *NotificationReminderCreate) SetLeadDays(i int) *NotificationReminderCreate {
+	nrc.mutation.SetLeadDays(i)
+	return nrc
+}
+
+// SetID sets the "id" field.
+func (nrc *NotificationReminderCreate) SetID(u uuid.UUID) *NotificationReminderCreate {
+	nrc.mutation.SetID(u)
+	return nrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (nrc *NotificationReminderCreate) SetNillableID(u *uuid.UUID) *NotificationReminderCreate {
+	if u != nil {
+		nrc.SetID(*u)
+	}
+	return nrc
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (nrc *NotificationReminderCreate) SetGroup(g *Group) *NotificationReminderCreate {
+	return nrc.SetGroupID(g.ID)
+}
+
+// SetRule sets the "rule" edge to the NotificationRule entity.
+func (nrc *NotificationReminderCreate) SetRule(n *NotificationRule) *NotificationReminderCreate {
+	return nrc.SetRuleID(n.ID)
+}
+
+// Mutation returns the NotificationReminderMutation object of the builder.
+func (nrc *NotificationReminderCreate) Mutation() *NotificationReminderMutation {
+	return nrc.mutation
+}
+
+// Save creates the NotificationReminder in the database.
+func (nrc *NotificationReminderCreate) Save(ctx context.Context) (*NotificationReminder, error) {
+	nrc.defaults()
+	return withHooks(ctx, nrc.sqlSave, nrc.mutation, nrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nrc *NotificationReminderCreate) SaveX(ctx context.Context) *NotificationReminder {
+	v, err := nrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nrc *NotificationReminderCreate) Exec(ctx context.Context) error {
+	_, err := nrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nrc *NotificationReminderCreate) ExecX(ctx context.Context) {
+	if err := nrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nrc *NotificationReminderCreate) defaults() {
+	if _, ok := nrc.mutation.CreatedAt(); !ok {
+		v := notificationreminder.DefaultCreatedAt()
+		nrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := nrc.mutation.UpdatedAt(); !ok {
+		v := notificationreminder.DefaultUpdatedAt()
+		nrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := nrc.mutation.ID(); !ok {
+		v := notificationreminder.DefaultID()
+		nrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nrc *NotificationReminderCreate) check() error {
+	if _, ok := nrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NotificationReminder.created_at"`)}
+	}
+	if _, ok := nrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NotificationReminder.updated_at"`)}
+	}
+	if _, ok := nrc.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "NotificationReminder.group_id"`)}
+	}
+	if _, ok := nrc.mutation.RuleID(); !ok {
+		return &ValidationError{Name: "rule_id", err: errors.New(`ent: missing required field "NotificationReminder.rule_id"`)}
+	}
+	if _, ok := nrc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "NotificationReminder.item_id"`)}
+	}
+	if _, ok := nrc.mutation.Target(); !ok {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required field "NotificationReminder.target"`)}
+	}
+	if v, ok := nrc.mutation.Target(); ok {
+		if err := notificationreminder.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "NotificationReminder.target": %w`, err)}
+		}
+	}
+	if _, ok := nrc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "NotificationReminder.title"`)}
+	}
+	if v, ok := nrc.mutation.Title(); ok {
+		if err := notificationreminder.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "NotificationReminder.title": %w`, err)}
+		}
+	}
+	if _, ok := nrc.mutation.DueDate(); !ok {
+		return &ValidationError{Name: "due_date", err: errors.New(`ent: missing required field "NotificationReminder.due_date"`)}
+	}
+	if _, ok := nrc.mutation.LeadDays(); !ok {
+		return &ValidationError{Name: "lead_days", err: errors.New(`ent: missing required field "NotificationReminder.lead_days"`)}
+	}
+	if _, ok := nrc.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "NotificationReminder.group"`)}
+	}
+	if _, ok := nrc.mutation.RuleID(); !ok {
+		return &ValidationError{Name: "rule", err: errors.New(`ent: missing required edge "NotificationReminder.rule"`)}
+	}
+	return nil
+}
+
+func (nrc *NotificationReminderCreate) sqlSave(ctx context.Context) (*NotificationReminder, error) {
+	if err := nrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := nrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	nrc.mutation.id = &_node.ID
+	nrc.mutation.done = true
+	return _node, nil
+}
+
+func (nrc *NotificationReminderCreate) createSpec() (*NotificationReminder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationReminder{config: nrc.config}
+		_spec = sqlgraph.NewCreateSpec(notificationreminder.Table, sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID))
+	)
+	if id, ok := nrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := nrc.mutation.CreatedAt(); ok {
+		_spec.SetField(notificationreminder.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := nrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationreminder.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := nrc.mutation.ItemID(); ok {
+		_spec.SetField(notificationreminder.FieldItemID, field.TypeUUID, value)
+		_node.ItemID = value
+	}
+	if value, ok := nrc.mutation.Target(); ok {
+		_spec.SetField(notificationreminder.FieldTarget, field.TypeString, value)
+		_node.Target = value
+	}
+	if value, ok := nrc.mutation.Title(); ok {
+		_spec.SetField(notificationreminder.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := nrc.mutation.DueDate(); ok {
+		_spec.SetField(notificationreminder.FieldDueDate, field.TypeTime, value)
+		_node.DueDate = value
+	}
+	if value, ok := nrc.mutation.LeadDays(); ok {
+		_spec.SetField(notificationreminder.FieldLeadDays, field.TypeInt, value)
+		_node.LeadDays = value
+	}
+	if nodes := nrc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationreminder.GroupTable,
+			Columns: []string{notificationreminder.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nrc.mutation.RuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationreminder.RuleTable,
+			Columns: []string{notificationreminder.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RuleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NotificationReminderCreateBulk is the builder for creating many NotificationReminder entities in bulk.
+type NotificationReminderCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationReminderCreate
+}
+
+// Save creates the NotificationReminder entities in the database.
+func (nrcb *NotificationReminderCreateBulk) Save(ctx context.Context) ([]*NotificationReminder, error) {
+	if nrcb.err != nil {
+		return nil, nrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(nrcb.builders))
+	nodes := make([]*NotificationReminder, len(nrcb.builders))
+	mutators := make([]Mutator, len(nrcb.builders))
+	for i := range nrcb.builders {
+		func(i int, root context.Context) {
+			builder := nrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationReminderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, nrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, nrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, nrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nrcb *NotificationReminderCreateBulk) SaveX(ctx context.Context) []*NotificationReminder {
+	v, err := nrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nrcb *NotificationReminderCreateBulk) Exec(ctx context.Context) error {
+	_, err := nrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nrcb *NotificationReminderCreateBulk) ExecX(ctx context.Context) {
+	if err := nrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
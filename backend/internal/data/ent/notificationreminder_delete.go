@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationreminder"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// NotificationReminderDelete is the builder for deleting a NotificationReminder entity.
+type NotificationReminderDelete struct {
+	config
+	hooks    []Hook
+	mutation *NotificationReminderMutation
+}
+
+// Where appends a list predicates to the NotificationReminderDelete builder.
+func (nrd *NotificationReminderDelete) Where(ps ...predicate.NotificationReminder) *NotificationReminderDelete {
+	nrd.mutation.Where(ps...)
+	return nrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (nrd *NotificationReminderDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, nrd.sqlExec, nrd.mutation, nrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nrd *NotificationReminderDelete) ExecX(ctx context.Context) int {
+	n, err := nrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (nrd *NotificationReminderDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(notificationreminder.Table, sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID))
+	if ps := nrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, nrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	nrd.mutation.done = true
+	return affected, err
+}
+
+// NotificationReminderDeleteOne is the builder for deleting a single NotificationReminder entity.
+type NotificationReminderDeleteOne struct {
+	nrd *NotificationReminderDelete
+}
+
+// Where appends a list predicates to the NotificationReminderDelete builder.
+func (nrdo *NotificationReminderDeleteOne) Where(ps ...predicate.NotificationReminder) *NotificationReminderDeleteOne {
+	nrdo.nrd.mutation.Where(ps...)
+	return nrdo
+}
+
+// Exec executes the deletion query.
+func (nrdo *NotificationReminderDeleteOne) Exec(ctx context.Context) error {
+	n, err := nrdo.nrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{notificationreminder.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nrdo *NotificationReminderDeleteOne) ExecX(ctx context.Context) {
+	if err := nrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationreminder"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationrule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// NotificationReminderQuery is the builder for querying NotificationReminder entities.
+type NotificationReminderQuery struct {
+	config
+	ctx        *QueryContext
+	order      []notificationreminder.OrderOption
+	inters     []Interceptor
+	predicates []predicate.NotificationReminder
+	withGroup  *GroupQuery
+	withRule   *NotificationRuleQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NotificationReminderQuery builder.
+func (nrq *NotificationReminderQuery) Where(ps ...predicate.NotificationReminder) *NotificationReminderQuery {
+	nrq.predicates = append(nrq.predicates, ps...)
+	return nrq
+}
+
+// Limit the number of records to be returned by this query.
+func (nrq *NotificationReminderQuery) Limit(limit int) *NotificationReminderQuery {
+	nrq.ctx.Limit = &limit
+	return nrq
+}
+
+// Offset to start from.
+func (nrq *NotificationReminderQuery) Offset(offset int) *NotificationReminderQuery {
+	nrq.ctx.Offset = &offset
+	return nrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (nrq *NotificationReminderQuery) Unique(unique bool) *NotificationReminderQuery {
+	nrq.ctx.Unique = &unique
+	return nrq
+}
+
+// Order specifies how the records should be ordered.
+func (nrq *NotificationReminderQuery) Order(o ...notificationreminder.OrderOption) *NotificationReminderQuery {
+	nrq.order = append(nrq.order, o...)
+	return nrq
+}
+
+// QueryGroup chains the current query on the "group" edge.
+func (nrq *NotificationReminderQuery) QueryGroup() *GroupQuery {
+	query := (&GroupClient{config: nrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationreminder.Table, notificationreminder.FieldID, selector),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notificationreminder.GroupTable, notificationreminder.GroupColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRule chains the current query on the "rule" edge.
+func (nrq *NotificationReminderQuery) QueryRule() *NotificationRuleQuery {
+	query := (&NotificationRuleClient{config: nrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationreminder.Table, notificationreminder.FieldID, selector),
+			sqlgraph.To(notificationrule.Table, notificationrule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notificationreminder.RuleTable, notificationreminder.RuleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first NotificationReminder entity from the query.
+// Returns a *NotFoundError when no NotificationReminder was found.
+func (nrq *NotificationReminderQuery) First(ctx context.Context) (*NotificationReminder, error) {
+	nodes, err := nrq.Limit(1).All(setContextOp(ctx, nrq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{notificationreminder.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (nrq *NotificationReminderQuery) FirstX(ctx context.Context) *NotificationReminder {
+	node, err := nrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first NotificationReminder ID from the query.
+// Returns a *NotFoundError when no NotificationReminder ID was found.
+func (nrq *NotificationReminderQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = nrq.Limit(1).IDs(setContextOp(ctx, nrq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{notificationreminder.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (nrq *NotificationReminderQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := nrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single NotificationReminder entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one NotificationReminder entity is found.
+// Returns a *NotFoundError when no NotificationReminder entities are found.
+func (nrq *NotificationReminderQuery) Only(ctx context.Context) (*NotificationReminder, error) {
+	nodes, err := nrq.Limit(2).All(setContextOp(ctx, nrq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{notificationreminder.Label}
+	default:
+		return nil, &NotSingularError{notificationreminder.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (nrq *NotificationReminderQuery) OnlyX(ctx context.Context) *NotificationReminder {
+	node, err := nrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only NotificationReminder ID in the query.
+// Returns a *NotSingularError when more than one NotificationReminder ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (nrq *NotificationReminderQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = nrq.Limit(2).IDs(setContextOp(ctx, nrq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{notificationreminder.Label}
+	default:
+		err = &NotSingularError{notificationreminder.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (nrq *NotificationReminderQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := nrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of NotificationReminders.
+func (nrq *NotificationReminderQuery) All(ctx context.Context) ([]*NotificationReminder, error) {
+	ctx = setContextOp(ctx, nrq.ctx, "All")
+	if err := nrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*NotificationReminder, *NotificationReminderQuery]()
+	return withInterceptors[[]*NotificationReminder](ctx, nrq, qr, nrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (nrq *NotificationReminderQuery) AllX(ctx context.Context) []*NotificationReminder {
+	nodes, err := nrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of NotificationReminder IDs.
+func (nrq *NotificationReminderQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if nrq.ctx.Unique == nil && nrq.path != nil {
+		nrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, nrq.ctx, "IDs")
+	if err = nrq.Select(notificationreminder.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (nrq *NotificationReminderQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := nrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (nrq *NotificationReminderQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, nrq.ctx, "Count")
+	if err := nrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, nrq, querierCount[*NotificationReminderQuery](), nrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (nrq *NotificationReminderQuery) CountX(ctx context.Context) int {
+	count, err := nrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (nrq *NotificationReminderQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, nrq.ctx, "Exist")
+	switch _, err := nrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (nrq *NotificationReminderQuery) ExistX(ctx context.Context) bool {
+	exist, err := nrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NotificationReminderQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (nrq *NotificationReminderQuery) Clone() *NotificationReminderQuery {
+	if nrq == nil {
+		return nil
+	}
+	return &NotificationReminderQuery{
+		config:     nrq.config,
+		ctx:        nrq.ctx.Clone(),
+		order:      append([]notificationreminder.OrderOption{}, nrq.order...),
+		inters:     append([]Interceptor{}, nrq.inters...),
+		predicates: append([]predicate.NotificationReminder{}, nrq.predicates...),
+		withGroup:  nrq.withGroup.Clone(),
+		withRule:   nrq.withRule.Clone(),
+		// clone intermediate query.
+		sql:  nrq.sql.Clone(),
+		path: nrq.path,
+	}
+}
+
+// WithGroup tells the query-builder to eager-load the nodes that are connected to
+// the "group" edge. The optional arguments are used to configure the query builder of the edge.
+func (nrq *NotificationReminderQuery) WithGroup(opts ...func(*GroupQuery)) *NotificationReminderQuery {
+	query := (&GroupClient{config: nrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nrq.withGroup = query
+	return nrq
+}
+
+// WithRule tells the query-builder to eager-load the nodes that are connected to
+// the "rule" edge. The optional arguments are used to configure the query builder of the edge.
+func (nrq *NotificationReminderQuery) WithRule(opts ...func(*NotificationRuleQuery)) *NotificationReminderQuery {
+	query := (&NotificationRuleClient{config: nrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nrq.withRule = query
+	return nrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.NotificationReminder.Query().
+//		GroupBy(notificationreminder.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (nrq *NotificationReminderQuery) GroupBy(field string, fields ...string) *NotificationReminderGroupBy {
+	nrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &NotificationReminderGroupBy{build: nrq}
+	grbuild.flds = &nrq.ctx.Fields
+	grbuild.label = notificationreminder.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.NotificationReminder.Query().
+//		Select(notificationreminder.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (nrq *NotificationReminderQuery) Select(fields ...string) *NotificationReminderSelect {
+	nrq.ctx.Fields = append(nrq.ctx.Fields, fields...)
+	sbuild := &NotificationReminderSelect{NotificationReminderQuery: nrq}
+	sbuild.label = notificationreminder.Label
+	sbuild.flds, sbuild.scan = &nrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a NotificationReminderSelect configured with the given aggregations.
+func (nrq *NotificationReminderQuery) Aggregate(fns ...AggregateFunc) *NotificationReminderSelect {
+	return nrq.Select().Aggregate(fns...)
+}
+
+func (nrq *NotificationReminderQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range nrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, nrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range nrq.ctx.Fields {
+		if !notificationreminder.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if nrq.path != nil {
+		prev, err := nrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		nrq.sql = prev
+	}
+	return nil
+}
+
+func (nrq *NotificationReminderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*NotificationReminder, error) {
+	var (
+		nodes       = []*NotificationReminder{}
+		_spec       = nrq.querySpec()
+		loadedTypes = [2]bool{
+			nrq.withGroup != nil,
+			nrq.withRule != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*NotificationReminder).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &NotificationReminder{config: nrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, nrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := nrq.withGroup; query != nil {
+		if err := nrq.loadGroup(ctx, query, nodes, nil,
+			func(n *NotificationReminder, e *Group) { n.Edges.Group = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := nrq.withRule; query != nil {
+		if err := nrq.loadRule(ctx, query, nodes, nil,
+			func(n *NotificationReminder, e *NotificationRule) { n.Edges.Rule = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (nrq *NotificationReminderQuery) loadGroup(ctx context.Context, query *GroupQuery, nodes []*NotificationReminder, init func(*NotificationReminder), assign func(*NotificationReminder, *Group)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*NotificationReminder)
+	for i := range nodes {
+		fk := nodes[i].GroupID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(group.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "group_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (nrq *NotificationReminderQuery) loadRule(ctx context.Context, query *NotificationRuleQuery, nodes []*NotificationReminder, init func(*NotificationReminder), assign func(*NotificationReminder, *NotificationRule)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*NotificationReminder)
+	for i := range nodes {
+		fk := nodes[i].RuleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(notificationrule.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "rule_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (nrq *NotificationReminderQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := nrq.querySpec()
+	_spec.Node.Columns = nrq.ctx.Fields
+	if len(nrq.ctx.Fields) > 0 {
+		_spec.Unique = nrq.ctx.Unique != nil && *nrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, nrq.driver, _spec)
+}
+
+func (nrq *NotificationReminderQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(notificationreminder.Table, notificationreminder.Columns, sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID))
+	_spec.From = nrq.sql
+	if unique := nrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if nrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := nrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationreminder.FieldID)
+		for i := range fields {
+			if fields[i] != notificationreminder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if nrq.withGroup != nil {
+			_spec.Node.AddColumnOnce(notificationreminder.FieldGroupID)
+		}
+		if nrq.withRule != nil {
+			_spec.Node.AddColumnOnce(notificationreminder.FieldRuleID)
+		}
+	}
+	if ps := nrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := nrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := nrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := nrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (nrq *NotificationReminderQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(nrq.driver.Dialect())
+	t1 := builder.Table(notificationreminder.Table)
+	columns := nrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = notificationreminder.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if nrq.sql != nil {
+		selector = nrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if nrq.ctx.Unique != nil && *nrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range nrq.predicates {
+		p(selector)
+	}
+	for _, p := range nrq.order {
+		p(selector)
+	}
+	if offset := nrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := nrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// NotificationReminderGroupBy is the group-by builder for NotificationReminder entities.
+type NotificationReminderGroupBy struct {
+	selector
+	build *NotificationReminderQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (nrgb *NotificationReminderGroupBy) Aggregate(fns ...AggregateFunc) *NotificationReminderGroupBy {
+	nrgb.fns = append(nrgb.fns, fns...)
+	return nrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nrgb *NotificationReminderGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nrgb.build.ctx, "GroupBy")
+	if err := nrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NotificationReminderQuery, *NotificationReminderGroupBy](ctx, nrgb.build, nrgb, nrgb.build.inters, v)
+}
+
+func (nrgb *NotificationReminderGroupBy) sqlScan(ctx context.Context, root *NotificationReminderQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(nrgb.fns))
+	for _, fn := range nrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*nrgb.flds)+len(nrgb.fns))
+		for _, f := range *nrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*nrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// NotificationReminderSelect is the builder for selecting fields of NotificationReminder entities.
+type NotificationReminderSelect struct {
+	*NotificationReminderQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (nrs *NotificationReminderSelect) Aggregate(fns ...AggregateFunc) *NotificationReminderSelect {
+	nrs.fns = append(nrs.fns, fns...)
+	return nrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nrs *NotificationReminderSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nrs.ctx, "Select")
+	if err := nrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NotificationReminderQuery, *NotificationReminderSelect](ctx, nrs.NotificationReminderQuery, nrs, nrs.inters, v)
+}
+
+func (nrs *NotificationReminderSelect) sqlScan(ctx context.Context, root *NotificationReminderQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(nrs.fns))
+	for _, fn := range nrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*nrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
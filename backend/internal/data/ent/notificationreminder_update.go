@@ -0,0 +1,669 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationreminder"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationrule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// NotificationReminderUpdate is the builder for updating NotificationReminder entities.
+type NotificationReminderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationReminderMutation
+}
+
+// Where appends a list predicates to the NotificationReminderUpdate builder.
+func (nru *NotificationReminderUpdate) Where(ps ...predicate.NotificationReminder) *NotificationReminderUpdate {
+	nru.mutation.Where(ps...)
+	return nru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nru *NotificationReminderUpdate) SetUpdatedAt(t time.Time) *NotificationReminderUpdate {
+	nru.mutation.SetUpdatedAt(t)
+	return nru
+}
+
+// SetGroupID sets the "group_id" field.
+func (nru *NotificationReminderUpdate) SetGroupID(u uuid.UUID) *NotificationReminderUpdate {
+	nru.mutation.SetGroupID(u)
+	return nru
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (nru *NotificationReminderUpdate) SetNillableGroupID(u *uuid.UUID) *NotificationReminderUpdate {
+	if u != nil {
+		nru.SetGroupID(*u)
+	}
+	return nru
+}
+
+// SetRuleID sets the "rule_id" field.
+func (nru *NotificationReminderUpdate) SetRuleID(u uuid.UUID) *NotificationReminderUpdate {
+	nru.mutation.SetRuleID(u)
+	return nru
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (nru *NotificationReminderUpdate) SetNillableRuleID(u *uuid.UUID) *NotificationReminderUpdate {
+	if u != nil {
+		nru.SetRuleID(*u)
+	}
+	return nru
+}
+
+// SetItemID sets the "item_id" field.
+func (nru *NotificationReminderUpdate) SetItemID(u uuid.UUID) *NotificationReminderUpdate {
+	nru.mutation.SetItemID(u)
+	return nru
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (nru *NotificationReminderUpdate) SetNillableItemID(u *uuid.UUID) *NotificationReminderUpdate {
+	if u != nil {
+		nru.SetItemID(*u)
+	}
+	return nru
+}
+
+// SetTarget sets the "target" field.
+func (nru *NotificationReminderUpdate) SetTarget(s string) *NotificationReminderUpdate {
+	nru.mutation.SetTarget(s)
+	return nru
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (nru *NotificationReminderUpdate) SetNillableTarget(s *string) *NotificationReminderUpdate {
+	if s != nil {
+		nru.SetTarget(*s)
+	}
+	return nru
+}
+
+// SetTitle sets the "title" field.
+func (nru *NotificationReminderUpdate) SetTitle(s string) *NotificationReminderUpdate {
+	nru.mutation.SetTitle(s)
+	return nru
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (nru *NotificationReminderUpdate) SetNillableTitle(s *string) *NotificationReminderUpdate {
+	if s != nil {
+		nru.SetTitle(*s)
+	}
+	return nru
+}
+
+// SetDueDate sets the "due_date" field.
+func (nru *NotificationReminderUpdate) SetDueDate(t time.Time) *NotificationReminderUpdate {
+	nru.mutation.SetDueDate(t)
+	return nru
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (nru *NotificationReminderUpdate) SetNillableDueDate(t *time.Time) *NotificationReminderUpdate {
+	if t != nil {
+		nru.SetDueDate(*t)
+	}
+	return nru
+}
+
+// SetLeadDays sets the "lead_days" field.
+func (nru *NotificationReminderUpdate) SetLeadDays(i int) *NotificationReminderUpdate {
+	nru.mutation.ResetLeadDays()
+	nru.mutation.SetLeadDays(i)
+	return nru
+}
+
+// SetNillableLeadDays sets the "lead_days" field if the given value is not nil.
+func (nru *NotificationReminderUpdate) SetNillableLeadDays(i *int) *NotificationReminderUpdate {
+	if i != nil {
+		nru.SetLeadDays(*i)
+	}
+	return nru
+}
+
+// AddLeadDays adds i to the "lead_days" field.
+func (nru *NotificationReminderUpdate) AddLeadDays(i int) *NotificationReminderUpdate {
+	nru.mutation.AddLeadDays(i)
+	return nru
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (nru *NotificationReminderUpdate) SetGroup(g *Group) *NotificationReminderUpdate {
+	return nru.SetGroupID(g.ID)
+}
+
+// SetRule sets the "rule" edge to the NotificationRule entity.
+func (nru *NotificationReminderUpdate) SetRule(n *NotificationRule) *NotificationReminderUpdate {
+	return nru.SetRuleID(n.ID)
+}
+
+// Mutation returns the NotificationReminderMutation object of the builder.
+func (nru *NotificationReminderUpdate) Mutation() *NotificationReminderMutation {
+	return nru.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (nru *NotificationReminderUpdate) ClearGroup() *NotificationReminderUpdate {
+	nru.mutation.ClearGroup()
+	return nru
+}
+
+// ClearRule clears the "rule" edge to the NotificationRule entity.
+func (nru *NotificationReminderUpdate) ClearRule() *NotificationReminderUpdate {
+	nru.mutation.ClearRule()
+	return nru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nru *NotificationReminderUpdate) Save(ctx context.Context) (int, error) {
+	nru.defaults()
+	return withHooks(ctx, nru.sqlSave, nru.mutation, nru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nru *NotificationReminderUpdate) SaveX(ctx context.Context) int {
+	affected, err := nru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nru *NotificationReminderUpdate) Exec(ctx context.Context) error {
+	_, err := nru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nru *NotificationReminderUpdate) ExecX(ctx context.Context) {
+	if err := nru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nru *NotificationReminderUpdate) defaults() {
+	if _, ok := nru.mutation.UpdatedAt(); !ok {
+		v := notificationreminder.UpdateDefaultUpdatedAt()
+		nru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nru *NotificationReminderUpdate) check() error {
+	if v, ok := nru.mutation.Target(); ok {
+		if err := notificationreminder.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "NotificationReminder.target": %w`, err)}
+		}
+	}
+	if v, ok := nru.mutation.Title(); ok {
+		if err := notificationreminder.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "NotificationReminder.title": %w`, err)}
+		}
+	}
+	if _, ok := nru.mutation.GroupID(); nru.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "NotificationReminder.group"`)
+	}
+	if _, ok := nru.mutation.RuleID(); nru.mutation.RuleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "NotificationReminder.rule"`)
+	}
+	return nil
+}
+
+func (nru *NotificationReminderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := nru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationreminder.Table, notificationreminder.Columns, sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID))
+	if ps := nru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nru.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationreminder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := nru.mutation.ItemID(); ok {
+		_spec.SetField(notificationreminder.FieldItemID, field.TypeUUID, value)
+	}
+	if value, ok := nru.mutation.Target(); ok {
+		_spec.SetField(notificationreminder.FieldTarget, field.TypeString, value)
+	}
+	if value, ok := nru.mutation.Title(); ok {
+		_spec.SetField(notificationreminder.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := nru.mutation.DueDate(); ok {
+		_spec.SetField(notificationreminder.FieldDueDate, field.TypeTime, value)
+	}
+	if value, ok := nru.mutation.LeadDays(); ok {
+		_spec.SetField(notificationreminder.FieldLeadDays, field.TypeInt, value)
+	}
+	if value, ok := nru.mutation.AddedLeadDays(); ok {
+		_spec.AddField(notificationreminder.FieldLeadDays, field.TypeInt, value)
+	}
+	if nru.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationreminder.GroupTable,
+			Columns: []string{notificationreminder.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nru.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationreminder.GroupTable,
+			Columns: []string{notificationreminder.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nru.mutation.RuleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationreminder.RuleTable,
+			Columns: []string{notificationreminder.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nru.mutation.RuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationreminder.RuleTable,
+			Columns: []string{notificationreminder.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationreminder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nru.mutation.done = true
+	return n, nil
+}
+
+// NotificationReminderUpdateOne is the builder for updating a single NotificationReminder entity.
+type NotificationReminderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationReminderMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nruo *NotificationReminderUpdateOne) SetUpdatedAt(t time.Time) *NotificationReminderUpdateOne {
+	nruo.mutation.SetUpdatedAt(t)
+	return nruo
+}
+
+// SetGroupID sets the "group_id" field.
+func (nruo *NotificationReminderUpdateOne) SetGroupID(u uuid.UUID) *NotificationReminderUpdateOne {
+	nruo.mutation.SetGroupID(u)
+	return nruo
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (nruo *NotificationReminderUpdateOne) SetNillableGroupID(u *uuid.UUID) *NotificationReminderUpdateOne {
+	if u != nil {
+		nruo.SetGroupID(*u)
+	}
+	return nruo
+}
+
+// SetRuleID sets the "rule_id" field.
+func (nruo *NotificationReminderUpdateOne) SetRuleID(u uuid.UUID) *NotificationReminderUpdateOne {
+	nruo.mutation.SetRuleID(u)
+	return nruo
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (nruo *NotificationReminderUpdateOne) SetNillableRuleID(u *uuid.UUID) *NotificationReminderUpdateOne {
+	if u != nil {
+		nruo.SetRuleID(*u)
+	}
+	return nruo
+}
+
+// SetItemID sets the "item_id" field.
+func (nruo *NotificationReminderUpdateOne) SetItemID(u uuid.UUID) *NotificationReminderUpdateOne {
+	nruo.mutation.SetItemID(u)
+	return nruo
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (nruo *NotificationReminderUpdateOne) SetNillableItemID(u *uuid.UUID) *NotificationReminderUpdateOne {
+	if u != nil {
+		nruo.SetItemID(*u)
+	}
+	return nruo
+}
+
+// SetTarget sets the "target" field.
+func (nruo *NotificationReminderUpdateOne) SetTarget(s string) *NotificationReminderUpdateOne {
+	nruo.mutation.SetTarget(s)
+	return nruo
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (nruo *NotificationReminderUpdateOne) SetNillableTarget(s *string) *NotificationReminderUpdateOne {
+	if s != nil {
+		nruo.SetTarget(*s)
+	}
+	return nruo
+}
+
+// SetTitle sets the "title" field.
+func (nruo *NotificationReminderUpdateOne) SetTitle(s string) *NotificationReminderUpdateOne {
+	nruo.mutation.SetTitle(s)
+	return nruo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (nruo *NotificationReminderUpdateOne) SetNillableTitle(s *string) *NotificationReminderUpdateOne {
+	if s != nil {
+		nruo.SetTitle(*s)
+	}
+	return nruo
+}
+
+// SetDueDate sets the "due_date" field.
+func (nruo *NotificationReminderUpdateOne) SetDueDate(t time.Time) *NotificationReminderUpdateOne {
+	nruo.mutation.SetDueDate(t)
+	return nruo
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (nruo *NotificationReminderUpdateOne) SetNillableDueDate(t *time.Time) *NotificationReminderUpdateOne {
+	if t != nil {
+		nruo.SetDueDate(*t)
+	}
+	return nruo
+}
+
+// SetLeadDays sets the "lead_days" field.
+func (nruo *NotificationReminderUpdateOne) SetLeadDays(i int) *NotificationReminderUpdateOne {
+	nruo.mutation.ResetLeadDays()
+	nruo.mutation.SetLeadDays(i)
+	return nruo
+}
+
+// SetNillableLeadDays sets the "lead_days" field if the given value is not nil.
+func (nruo *NotificationReminderUpdateOne) SetNillableLeadDays(i *int) *NotificationReminderUpdateOne {
+	if i != nil {
+		nruo.SetLeadDays(*i)
+	}
+	return nruo
+}
+
+// AddLeadDays adds i to the "lead_days" field.
+func (nruo *NotificationReminderUpdateOne) AddLeadDays(i int) *NotificationReminderUpdateOne {
+	nruo.mutation.AddLeadDays(i)
+	return nruo
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (nruo *NotificationReminderUpdateOne) SetGroup(g *Group) *NotificationReminderUpdateOne {
+	return nruo.SetGroupID(g.ID)
+}
+
+// SetRule sets the "rule" edge to the NotificationRule entity.
+func (nruo *NotificationReminderUpdateOne) SetRule(n *NotificationRule) *NotificationReminderUpdateOne {
+	return nruo.SetRuleID(n.ID)
+}
+
+// Mutation returns the NotificationReminderMutation object of the builder.
+func (nruo *NotificationReminderUpdateOne) Mutation() *NotificationReminderMutation {
+	return nruo.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (nruo *NotificationReminderUpdateOne) ClearGroup() *NotificationReminderUpdateOne {
+	nruo.mutation.ClearGroup()
+	return nruo
+}
+
+// ClearRule clears the "rule" edge to the NotificationRule entity.
+func (nruo *NotificationReminderUpdateOne) ClearRule() *NotificationReminderUpdateOne {
+	nruo.mutation.ClearRule()
+	return nruo
+}
+
+// Where appends a list predicates to the NotificationReminderUpdate builder.
+func (nruo *NotificationReminderUpdateOne) Where(ps ...predicate.NotificationReminder) *NotificationReminderUpdateOne {
+	nruo.mutation.Where(ps...)
+	return nruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nruo *NotificationReminderUpdateOne) Select(field string, fields ...string) *NotificationReminderUpdateOne {
+	nruo.fields = append([]string{field}, fields...)
+	return nruo
+}
+
+// Save executes the query and returns the updated NotificationReminder entity.
+func (nruo *NotificationReminderUpdateOne) Save(ctx context.Context) (*NotificationReminder, error) {
+	nruo.defaults()
+	return withHooks(ctx, nruo.sqlSave, nruo.mutation, nruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nruo *NotificationReminderUpdateOne) SaveX(ctx context.Context) *NotificationReminder {
+	node, err := nruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nruo *NotificationReminderUpdateOne) Exec(ctx context.Context) error {
+	_, err := nruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nruo *NotificationReminderUpdateOne) ExecX(ctx context.Context) {
+	if err := nruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nruo *NotificationReminderUpdateOne) defaults() {
+	if _, ok := nruo.mutation.UpdatedAt(); !ok {
+		v := notificationreminder.UpdateDefaultUpdatedAt()
+		nruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nruo *NotificationReminderUpdateOne) check() error {
+	if v, ok := nruo.mutation.Target(); ok {
+		if err := notificationreminder.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "NotificationReminder.target": %w`, err)}
+		}
+	}
+	if v, ok := nruo.mutation.Title(); ok {
+		if err := notificationreminder.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "NotificationReminder.title": %w`, err)}
+		}
+	}
+	if _, ok := nruo.mutation.GroupID(); nruo.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "NotificationReminder.group"`)
+	}
+	if _, ok := nruo.mutation.RuleID(); nruo.mutation.RuleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "NotificationReminder.rule"`)
+	}
+	return nil
+}
+
+func (nruo *NotificationReminderUpdateOne) sqlSave(ctx context.Context) (_node *NotificationReminder, err error) {
+	if err := nruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationreminder.Table, notificationreminder.Columns, sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID))
+	id, ok := nruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationReminder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationreminder.FieldID)
+		for _, f := range fields {
+			if !notificationreminder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationreminder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationreminder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := nruo.mutation.ItemID(); ok {
+		_spec.SetField(notificationreminder.FieldItemID, field.TypeUUID, value)
+	}
+	if value, ok := nruo.mutation.Target(); ok {
+		_spec.SetField(notificationreminder.FieldTarget, field.TypeString, value)
+	}
+	if value, ok := nruo.mutation.Title(); ok {
+		_spec.SetField(notificationreminder.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := nruo.mutation.DueDate(); ok {
+		_spec.SetField(notificationreminder.FieldDueDate, field.TypeTime, value)
+	}
+	if value, ok := nruo.mutation.LeadDays(); ok {
+		_spec.SetField(notificationreminder.FieldLeadDays, field.TypeInt, value)
+	}
+	if value, ok := nruo.mutation.AddedLeadDays(); ok {
+		_spec.AddField(notificationreminder.FieldLeadDays, field.TypeInt, value)
+	}
+	if nruo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationreminder.GroupTable,
+			Columns: []string{notificationreminder.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nruo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationreminder.GroupTable,
+			Columns: []string{notificationreminder.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nruo.mutation.RuleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationreminder.RuleTable,
+			Columns: []string{notificationreminder.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nruo.mutation.RuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationreminder.RuleTable,
+			Columns: []string{notificationreminder.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NotificationReminder{config: nruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationreminder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationrule"
+)
+
+// NotificationRule is the model entity for the NotificationRule schema.
+type NotificationRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID uuid.UUID `json:"group_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind notificationrule.Kind `json:"kind,omitempty"`
+	// CustomField holds the value of the "custom_field" field.
+	CustomField string `json:"custom_field,omitempty"`
+	// LeadDays holds the value of the "lead_days" field.
+	LeadDays []int `json:"lead_days,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NotificationRuleQuery when eager-loading is set.
+	Edges        NotificationRuleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// NotificationRuleEdges holds the relations/edges for other nodes in the graph.
+type NotificationRuleEdges struct {
+	// Group holds the value of the group edge.
+	Group *Group `json:"group,omitempty"`
+	// Reminders holds the value of the reminders edge.
+	Reminders []*NotificationReminder `json:"reminders,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationRuleEdges) GroupOrErr() (*Group, error) {
+	if e.loadedTypes[0] {
+		if e.Group == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: group.Label}
+		}
+		return e.Group, nil
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// RemindersOrErr returns the Reminders value or an error if the edge
+// was not loaded in eager-loading.
+func (e NotificationRuleEdges) RemindersOrErr() ([]*NotificationReminder, error) {
+	if e.loadedTypes[1] {
+		return e.Reminders, nil
+	}
+	return nil, &NotLoadedError{edge: "reminders"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationrule.FieldLeadDays:
+			values[i] = new([]byte)
+		case notificationrule.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case notificationrule.FieldName, notificationrule.FieldKind, notificationrule.FieldCustomField:
+			values[i] = new(sql.NullString)
+		case notificationrule.FieldCreatedAt, notificationrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case notificationrule.FieldID, notificationrule.FieldGroupID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationRule fields.
+func (nr *NotificationRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationrule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				nr.ID = *value
+			}
+		case notificationrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				nr.CreatedAt = value.Time
+			}
+		case notificationrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				nr.UpdatedAt = value.Time
+			}
+		case notificationrule.FieldGroupID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value != nil {
+				nr.GroupID = *value
+			}
+		case notificationrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				nr.Name = value.String
+			}
+		case notificationrule.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				nr.Kind = notificationrule.Kind(value.String)
+			}
+		case notificationrule.FieldCustomField:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field custom_field", values[i])
+			} else if value.Valid {
+				nr.CustomField = value.String
+			}
+		case notificationrule.FieldLeadDays:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field lead_days", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &nr.LeadDays); err != nil {
+					return fmt.Errorf("unmarshal field lead_days: %w", err)
+				}
+			}
+		case notificationrule.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				nr.IsActive = value.Bool
+			}
+		default:
+			nr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NotificationRule.
+// This includes values selected through modifiers, order, etc.
+func (nr *NotificationRule) Value(name string) (ent.Value, error) {
+	return nr.selectValues.Get(name)
+}
+
+// QueryGroup queries the "group" edge of the NotificationRule entity.
+func (nr *NotificationRule) QueryGroup() *GroupQuery {
+	return NewNotificationRuleClient(nr.config).QueryGroup(nr)
+}
+
+// QueryReminders queries the "reminders" edge of the NotificationRule entity.
+func (nr *NotificationRule) QueryReminders() *NotificationReminderQuery {
+	return NewNotificationRuleClient(nr.config).QueryReminders(nr)
+}
+
+// Update returns a builder for updating this NotificationRule.
+// Note that you need to call NotificationRule.Unwrap() before calling this method if this NotificationRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (nr *NotificationRule) Update() *NotificationRuleUpdateOne {
+	return NewNotificationRuleClient(nr.config).UpdateOne(nr)
+}
+
+// Unwrap unwraps the NotificationRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (nr *NotificationRule) Unwrap() *NotificationRule {
+	_tx, ok := nr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NotificationRule is not a transactional entity")
+	}
+	nr.config.driver = _tx.drv
+	return nr
+}
+
+// String implements the fmt.Stringer.
+func (nr *NotificationRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", nr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(nr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(nr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(fmt.Sprintf("%v", nr.GroupID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(nr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", nr.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("custom_field=")
+	builder.WriteString(nr.CustomField)
+	builder.WriteString(", ")
+	builder.WriteString("lead_days=")
+	builder.WriteString(fmt.Sprintf("%v", nr.LeadDays))
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", nr.IsActive))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationRules is a parsable slice of NotificationRule.
+type NotificationRules []*NotificationRule
@@ -0,0 +1,418 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v uuid.UUID) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldGroupID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldName, v))
+}
+
+// CustomField applies equality check predicate on the "custom_field" field. It's identical to CustomFieldEQ.
+func CustomField(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldCustomField, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldIsActive, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v uuid.UUID) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v uuid.UUID) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...uuid.UUID) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...uuid.UUID) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// CustomFieldEQ applies the EQ predicate on the "custom_field" field.
+func CustomFieldEQ(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldCustomField, v))
+}
+
+// CustomFieldNEQ applies the NEQ predicate on the "custom_field" field.
+func CustomFieldNEQ(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldCustomField, v))
+}
+
+// CustomFieldIn applies the In predicate on the "custom_field" field.
+func CustomFieldIn(vs ...string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIn(FieldCustomField, vs...))
+}
+
+// CustomFieldNotIn applies the NotIn predicate on the "custom_field" field.
+func CustomFieldNotIn(vs ...string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotIn(FieldCustomField, vs...))
+}
+
+// CustomFieldGT applies the GT predicate on the "custom_field" field.
+func CustomFieldGT(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGT(FieldCustomField, v))
+}
+
+// CustomFieldGTE applies the GTE predicate on the "custom_field" field.
+func CustomFieldGTE(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGTE(FieldCustomField, v))
+}
+
+// CustomFieldLT applies the LT predicate on the "custom_field" field.
+func CustomFieldLT(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLT(FieldCustomField, v))
+}
+
+// CustomFieldLTE applies the LTE predicate on the "custom_field" field.
+func CustomFieldLTE(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLTE(FieldCustomField, v))
+}
+
+// CustomFieldContains applies the Contains predicate on the "custom_field" field.
+func CustomFieldContains(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldContains(FieldCustomField, v))
+}
+
+// CustomFieldHasPrefix applies the HasPrefix predicate on the "custom_field" field.
+func CustomFieldHasPrefix(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldHasPrefix(FieldCustomField, v))
+}
+
+// CustomFieldHasSuffix applies the HasSuffix predicate on the "custom_field" field.
+func CustomFieldHasSuffix(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldHasSuffix(FieldCustomField, v))
+}
+
+// CustomFieldIsNil applies the IsNil predicate on the "custom_field" field.
+func CustomFieldIsNil() predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIsNull(FieldCustomField))
+}
+
+// CustomFieldNotNil applies the NotNil predicate on the "custom_field" field.
+func CustomFieldNotNil() predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotNull(FieldCustomField))
+}
+
+// CustomFieldEqualFold applies the EqualFold predicate on the "custom_field" field.
+func CustomFieldEqualFold(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEqualFold(FieldCustomField, v))
+}
+
+// CustomFieldContainsFold applies the ContainsFold predicate on the "custom_field" field.
+func CustomFieldContainsFold(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldContainsFold(FieldCustomField, v))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// HasGroup applies the HasEdge predicate on the "group" edge.
+func HasGroup() predicate.NotificationRule {
+	return predicate.NotificationRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupWith applies the HasEdge predicate on the "group" edge with a given conditions (other predicates).
+func HasGroupWith(preds ...predicate.Group) predicate.NotificationRule {
+	return predicate.NotificationRule(func(s *sql.Selector) {
+		step := newGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReminders applies the HasEdge predicate on the "reminders" edge.
+func HasReminders() predicate.NotificationRule {
+	return predicate.NotificationRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RemindersTable, RemindersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRemindersWith applies the HasEdge predicate on the "reminders" edge with a given conditions (other predicates).
+func HasRemindersWith(preds ...predicate.NotificationReminder) predicate.NotificationRule {
+	return predicate.NotificationRule(func(s *sql.Selector) {
+		step := newRemindersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NotificationRule) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NotificationRule) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NotificationRule) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.NotPredicates(p))
+}
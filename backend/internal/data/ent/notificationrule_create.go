@@ -0,0 +1,415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationreminder"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationrule"
+)
+
+// NotificationRuleCreate is the builder for creating a NotificationRule entity.
+type NotificationRuleCreate struct {
+	config
+	mutation *NotificationRuleMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nrc *NotificationRuleCreate) SetCreatedAt(t time.Time) *NotificationRuleCreate {
+	nrc.mutation.SetCreatedAt(t)
+	return nrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nrc *NotificationRuleCreate) SetNillableCreatedAt(t *time.Time) *NotificationRuleCreate {
+	if t != nil {
+		nrc.SetCreatedAt(*t)
+	}
+	return nrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nrc *NotificationRuleCreate) SetUpdatedAt(t time.Time) *NotificationRuleCreate {
+	nrc.mutation.SetUpdatedAt(t)
+	return nrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (nrc *NotificationRuleCreate) SetNillableUpdatedAt(t *time.Time) *NotificationRuleCreate {
+	if t != nil {
+		nrc.SetUpdatedAt(*t)
+	}
+	return nrc
+}
+
+// SetGroupID sets the "group_id" field.
+func (nrc *NotificationRuleCreate) SetGroupID(u uuid.UUID) *NotificationRuleCreate {
+	nrc.mutation.SetGroupID(u)
+	return nrc
+}
+
+// SetName sets the "name" field.
+func (nrc *NotificationRuleCreate) SetName(s string) *NotificationRuleCreate {
+	nrc.mutation.SetName(s)
+	return nrc
+}
+
+// SetKind sets the "kind" field.
+func (nrc *NotificationRuleCreate) SetKind(n notificationrule.Kind) *NotificationRuleCreate {
+	nrc.mutation.SetKind(n)
+	return nrc
+}
+
+// SetCustomField sets the "custom_field" field.
+func (nrc *NotificationRuleCreate) SetCustomField(s string) *NotificationRuleCreate {
+	nrc.mutation.SetCustomField(s)
+	return nrc
+}
+
+// SetNillableCustomField sets the "custom_field" field if the given value is not nil.
+func (nrc *NotificationRuleCreate) SetNillableCustomField(s *string) *NotificationRuleCreate {
+	if s != nil {
+		nrc.SetCustomField(*s)
+	}
+	return nrc
+}
+
+// SetLeadDays sets the "lead_days" field.
+func (nrc *NotificationRuleCreate) SetLeadDays(i []int) *NotificationRuleCreate {
+	nrc.mutation.SetLeadDays(i)
+	return nrc
+}
+
+// SetIsActive sets the "is_active" field.
+func (nrc *NotificationRuleCreate) SetIsActive(b bool) *NotificationRuleCreate {
+	nrc.mutation.SetIsActive(b)
+	return nrc
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (nrc *NotificationRuleCreate) SetNillableIsActive(b *bool) *NotificationRuleCreate {
+	if b != nil {
+		nrc.SetIsActive(*b)
+	}
+	return nrc
+}
+
+// SetID sets the "id" field.
+func (nrc *NotificationRuleCreate) SetID(u uuid.UUID) *NotificationRuleCreate {
+	nrc.mutation.SetID(u)
+	return nrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (nrc *NotificationRuleCreate) SetNillableID(u *uuid.UUID) *NotificationRuleCreate {
+	if u != nil {
+		nrc.SetID(*u)
+	}
+	return nrc
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (nrc *NotificationRuleCreate) SetGroup(g *Group) *NotificationRuleCreate {
+	return nrc.SetGroupID(g.ID)
+}
+
+// AddReminderIDs adds the "reminders" edge to the NotificationReminder entity by IDs.
+func (nrc *NotificationRuleCreate) AddReminderIDs(ids ...uuid.UUID) *NotificationRuleCreate {
+	nrc.mutation.AddReminderIDs(ids...)
+	return nrc
+}
+
+// AddReminders adds the "reminders" edges to the NotificationReminder entity.
+func (nrc *NotificationRuleCreate) AddReminders(n ...*NotificationReminder) *NotificationRuleCreate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nrc.AddReminderIDs(ids...)
+}
+
+// Mutation returns the NotificationRuleMutation object of the builder.
+func (nrc *NotificationRuleCreate) Mutation() *NotificationRuleMutation {
+	return nrc.mutation
+}
+
+// Save creates the NotificationRule in the database.
+func (nrc *NotificationRuleCreate) Save(ctx context.Context) (*NotificationRule, error) {
+	nrc.defaults()
+	return withHooks(ctx, nrc.sqlSave, nrc.mutation, nrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nrc *NotificationRuleCreate) SaveX(ctx context.Context) *NotificationRule {
+	v, err := nrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nrc *NotificationRuleCreate) Exec(ctx context.Context) error {
+	_, err := nrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nrc *NotificationRuleCreate) ExecX(ctx context.Context) {
+	if err := nrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nrc *NotificationRuleCreate) defaults() {
+	if _, ok := nrc.mutation.CreatedAt(); !ok {
+		v := notificationrule.DefaultCreatedAt()
+		nrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := nrc.mutation.UpdatedAt(); !ok {
+		v := notificationrule.DefaultUpdatedAt()
+		nrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := nrc.mutation.IsActive(); !ok {
+		v := notificationrule.DefaultIsActive
+		nrc.mutation.SetIsActive(v)
+	}
+	if _, ok := nrc.mutation.ID(); !ok {
+		v := notificationrule.DefaultID()
+		nrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nrc *NotificationRuleCreate) check() error {
+	if _, ok := nrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NotificationRule.created_at"`)}
+	}
+	if _, ok := nrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NotificationRule.updated_at"`)}
+	}
+	if _, ok := nrc.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "NotificationRule.group_id"`)}
+	}
+	if _, ok := nrc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "NotificationRule.name"`)}
+	}
+	if v, ok := nrc.mutation.Name(); ok {
+		if err := notificationrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "NotificationRule.name": %w`, err)}
+		}
+	}
+	if _, ok := nrc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "NotificationRule.kind"`)}
+	}
+	if v, ok := nrc.mutation.Kind(); ok {
+		if err := notificationrule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "NotificationRule.kind": %w`, err)}
+		}
+	}
+	if v, ok := nrc.mutation.CustomField(); ok {
+		if err := notificationrule.CustomFieldValidator(v); err != nil {
+			return &ValidationError{Name: "custom_field", err: fmt.Errorf(`ent: validator failed for field "NotificationRule.custom_field": %w`, err)}
+		}
+	}
+	if _, ok := nrc.mutation.LeadDays(); !ok {
+		return &ValidationError{Name: "lead_days", err: errors.New(`ent: missing required field "NotificationRule.lead_days"`)}
+	}
+	if _, ok := nrc.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "NotificationRule.is_active"`)}
+	}
+	if _, ok := nrc.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "NotificationRule.group"`)}
+	}
+	return nil
+}
+
+func (nrc *NotificationRuleCreate) sqlSave(ctx context.Context) (*NotificationRule, error) {
+	if err := nrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := nrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	nrc.mutation.id = &_node.ID
+	nrc.mutation.done = true
+	return _node, nil
+}
+
+func (nrc *NotificationRuleCreate) createSpec() (*NotificationRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationRule{config: nrc.config}
+		_spec = sqlgraph.NewCreateSpec(notificationrule.Table, sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeUUID))
+	)
+	if id, ok := nrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := nrc.mutation.CreatedAt(); ok {
+		_spec.SetField(notificationrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := nrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := nrc.mutation.Name(); ok {
+		_spec.SetField(notificationrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := nrc.mutation.Kind(); ok {
+		_spec.SetField(notificationrule.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := nrc.mutation.CustomField(); ok {
+		_spec.SetField(notificationrule.FieldCustomField, field.TypeString, value)
+		_node.CustomField = value
+	}
+	if value, ok := nrc.mutation.LeadDays(); ok {
+		_spec.SetField(notificationrule.FieldLeadDays, field.TypeJSON, value)
+		_node.LeadDays = value
+	}
+	if value, ok := nrc.mutation.IsActive(); ok {
+		_spec.SetField(notificationrule.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if nodes := nrc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationrule.GroupTable,
+			Columns: []string{notificationrule.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nrc.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationrule.RemindersTable,
+			Columns: []string{notificationrule.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NotificationRuleCreateBulk is the builder for creating many NotificationRule entities in bulk.
+type NotificationRuleCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationRuleCreate
+}
+
+// Save creates the NotificationRule entities in the database.
+func (nrcb *NotificationRuleCreateBulk) Save(ctx context.Context) ([]*NotificationRule, error) {
+	if nrcb.err != nil {
+		return nil, nrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(nrcb.builders))
+	nodes := make([]*NotificationRule, len(nrcb.builders))
+	mutators := make([]Mutator, len(nrcb.builders))
+	for i := range nrcb.builders {
+		func(i int, root context.Context) {
+			builder := nrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, nrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, nrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, nrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nrcb *NotificationRuleCreateBulk) SaveX(ctx context.Context) []*NotificationRule {
+	v, err := nrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nrcb *NotificationRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := nrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nrcb *NotificationRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := nrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
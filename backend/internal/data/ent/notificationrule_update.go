@@ -0,0 +1,732 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationreminder"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationrule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// NotificationRuleUpdate is the builder for updating NotificationRule entities.
+type NotificationRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationRuleMutation
+}
+
+// Where appends a list predicates to the NotificationRuleUpdate builder.
+func (nru *NotificationRuleUpdate) Where(ps ...predicate.NotificationRule) *NotificationRuleUpdate {
+	nru.mutation.Where(ps...)
+	return nru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nru *NotificationRuleUpdate) SetUpdatedAt(t time.Time) *NotificationRuleUpdate {
+	nru.mutation.SetUpdatedAt(t)
+	return nru
+}
+
+// SetGroupID sets the "group_id" field.
+func (nru *NotificationRuleUpdate) SetGroupID(u uuid.UUID) *NotificationRuleUpdate {
+	nru.mutation.SetGroupID(u)
+	return nru
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (nru *NotificationRuleUpdate) SetNillableGroupID(u *uuid.UUID) *NotificationRuleUpdate {
+	if u != nil {
+		nru.SetGroupID(*u)
+	}
+	return nru
+}
+
+// SetName sets the "name" field.
+func (nru *NotificationRuleUpdate) SetName(s string) *NotificationRuleUpdate {
+	nru.mutation.SetName(s)
+	return nru
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (nru *NotificationRuleUpdate) SetNillableName(s *string) *NotificationRuleUpdate {
+	if s != nil {
+		nru.SetName(*s)
+	}
+	return nru
+}
+
+// SetKind sets the "kind" field.
+func (nru *NotificationRuleUpdate) SetKind(n notificationrule.Kind) *NotificationRuleUpdate {
+	nru.mutation.SetKind(n)
+	return nru
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (nru *NotificationRuleUpdate) SetNillableKind(n *notificationrule.Kind) *NotificationRuleUpdate {
+	if n != nil {
+		nru.SetKind(*n)
+	}
+	return nru
+}
+
+// SetCustomField sets the "custom_field" field.
+func (nru *NotificationRuleUpdate) SetCustomField(s string) *NotificationRuleUpdate {
+	nru.mutation.SetCustomField(s)
+	return nru
+}
+
+// SetNillableCustomField sets the "custom_field" field if the given value is not nil.
+func (nru *NotificationRuleUpdate) SetNillableCustomField(s *string) *NotificationRuleUpdate {
+	if s != nil {
+		nru.SetCustomField(*s)
+	}
+	return nru
+}
+
+// ClearCustomField clears the value of the "custom_field" field.
+func (nru *NotificationRuleUpdate) ClearCustomField() *NotificationRuleUpdate {
+	nru.mutation.ClearCustomField()
+	return nru
+}
+
+// SetLeadDays sets the "lead_days" field.
+func (nru *NotificationRuleUpdate) SetLeadDays(i []int) *NotificationRuleUpdate {
+	nru.mutation.SetLeadDays(i)
+	return nru
+}
+
+// AppendLeadDays appends i to the "lead_days" field.
+func (nru *NotificationRuleUpdate) AppendLeadDays(i []int) *NotificationRuleUpdate {
+	nru.mutation.AppendLeadDays(i)
+	return nru
+}
+
+// SetIsActive sets the "is_active" field.
+func (nru *NotificationRuleUpdate) SetIsActive(b bool) *NotificationRuleUpdate {
+	nru.mutation.SetIsActive(b)
+	return nru
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (nru *NotificationRuleUpdate) SetNillableIsActive(b *bool) *NotificationRuleUpdate {
+	if b != nil {
+		nru.SetIsActive(*b)
+	}
+	return nru
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (nru *NotificationRuleUpdate) SetGroup(g *Group) *NotificationRuleUpdate {
+	return nru.SetGroupID(g.ID)
+}
+
+// AddReminderIDs adds the "reminders" edge to the NotificationReminder entity by IDs.
+func (nru *NotificationRuleUpdate) AddReminderIDs(ids ...uuid.UUID) *NotificationRuleUpdate {
+	nru.mutation.AddReminderIDs(ids...)
+	return nru
+}
+
+// AddReminders adds the "reminders" edges to the NotificationReminder entity.
+func (nru *NotificationRuleUpdate) AddReminders(n ...*NotificationReminder) *NotificationRuleUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nru.AddReminderIDs(ids...)
+}
+
+// Mutation returns the NotificationRuleMutation object of the builder.
+func (nru *NotificationRuleUpdate) Mutation() *NotificationRuleMutation {
+	return nru.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (nru *NotificationRuleUpdate) ClearGroup() *NotificationRuleUpdate {
+	nru.mutation.ClearGroup()
+	return nru
+}
+
+// ClearReminders clears all "reminders" edges to the NotificationReminder entity.
+func (nru *NotificationRuleUpdate) ClearReminders() *NotificationRuleUpdate {
+	nru.mutation.ClearReminders()
+	return nru
+}
+
+// RemoveReminderIDs removes the "reminders" edge to NotificationReminder entities by IDs.
+func (nru *NotificationRuleUpdate) RemoveReminderIDs(ids ...uuid.UUID) *NotificationRuleUpdate {
+	nru.mutation.RemoveReminderIDs(ids...)
+	return nru
+}
+
+// RemoveReminders removes "reminders" edges to NotificationReminder entities.
+func (nru *NotificationRuleUpdate) RemoveReminders(n ...*NotificationReminder) *NotificationRuleUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nru.RemoveReminderIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nru *NotificationRuleUpdate) Save(ctx context.Context) (int, error) {
+	nru.defaults()
+	return withHooks(ctx, nru.sqlSave, nru.mutation, nru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nru *NotificationRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := nru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nru *NotificationRuleUpdate) Exec(ctx context.Context) error {
+	_, err := nru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nru *NotificationRuleUpdate) ExecX(ctx context.Context) {
+	if err := nru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nru *NotificationRuleUpdate) defaults() {
+	if _, ok := nru.mutation.UpdatedAt(); !ok {
+		v := notificationrule.UpdateDefaultUpdatedAt()
+		nru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nru *NotificationRuleUpdate) check() error {
+	if v, ok := nru.mutation.Name(); ok {
+		if err := notificationrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "NotificationRule.name": %w`, err)}
+		}
+	}
+	if v, ok := nru.mutation.Kind(); ok {
+		if err := notificationrule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "NotificationRule.kind": %w`, err)}
+		}
+	}
+	if v, ok := nru.mutation.CustomField(); ok {
+		if err := notificationrule.CustomFieldValidator(v); err != nil {
+			return &ValidationError{Name: "custom_field", err: fmt.Errorf(`ent: validator failed for field "NotificationRule.custom_field": %w`, err)}
+		}
+	}
+	if _, ok := nru.mutation.GroupID(); nru.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "NotificationRule.group"`)
+	}
+	return nil
+}
+
+func (nru *NotificationRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := nru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationrule.Table, notificationrule.Columns, sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeUUID))
+	if ps := nru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nru.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := nru.mutation.Name(); ok {
+		_spec.SetField(notificationrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := nru.mutation.Kind(); ok {
+		_spec.SetField(notificationrule.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := nru.mutation.CustomField(); ok {
+		_spec.SetField(notificationrule.FieldCustomField, field.TypeString, value)
+	}
+	if nru.mutation.CustomFieldCleared() {
+		_spec.ClearField(notificationrule.FieldCustomField, field.TypeString)
+	}
+	if value, ok := nru.mutation.LeadDays(); ok {
+		_spec.SetField(notificationrule.FieldLeadDays, field.TypeJSON, value)
+	}
+	if value, ok := nru.mutation.AppendedLeadDays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, notificationrule.FieldLeadDays, value)
+		})
+	}
+	if value, ok := nru.mutation.IsActive(); ok {
+		_spec.SetField(notificationrule.FieldIsActive, field.TypeBool, value)
+	}
+	if nru.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationrule.GroupTable,
+			Columns: []string{notificationrule.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nru.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationrule.GroupTable,
+			Columns: []string{notificationrule.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nru.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationrule.RemindersTable,
+			Columns: []string{notificationrule.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nru.mutation.RemovedRemindersIDs(); len(nodes) > 0 && !nru.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationrule.RemindersTable,
+			Columns: []string{notificationrule.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nru.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationrule.RemindersTable,
+			Columns: []string{notificationrule.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nru.mutation.done = true
+	return n, nil
+}
+
+// NotificationRuleUpdateOne is the builder for updating a single NotificationRule entity.
+type NotificationRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationRuleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nruo *NotificationRuleUpdateOne) SetUpdatedAt(t time.Time) *NotificationRuleUpdateOne {
+	nruo.mutation.SetUpdatedAt(t)
+	return nruo
+}
+
+// SetGroupID sets the "group_id" field.
+func (nruo *NotificationRuleUpdateOne) SetGroupID(u uuid.UUID) *NotificationRuleUpdateOne {
+	nruo.mutation.SetGroupID(u)
+	return nruo
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (nruo *NotificationRuleUpdateOne) SetNillableGroupID(u *uuid.UUID) *NotificationRuleUpdateOne {
+	if u != nil {
+		nruo.SetGroupID(*u)
+	}
+	return nruo
+}
+
+// SetName sets the "name" field.
+func (nruo *NotificationRuleUpdateOne) SetName(s string) *NotificationRuleUpdateOne {
+	nruo.mutation.SetName(s)
+	return nruo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (nruo *NotificationRuleUpdateOne) SetNillableName(s *string) *NotificationRuleUpdateOne {
+	if s != nil {
+		nruo.SetName(*s)
+	}
+	return nruo
+}
+
+// SetKind sets the "kind" field.
+func (nruo *NotificationRuleUpdateOne) SetKind(n notificationrule.Kind) *NotificationRuleUpdateOne {
+	nruo.mutation.SetKind(n)
+	return nruo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (nruo *NotificationRuleUpdateOne) SetNillableKind(n *notificationrule.Kind) *NotificationRuleUpdateOne {
+	if n != nil {
+		nruo.SetKind(*n)
+	}
+	return nruo
+}
+
+// SetCustomField sets the "custom_field" field.
+func (nruo *NotificationRuleUpdateOne) SetCustomField(s string) *NotificationRuleUpdateOne {
+	nruo.mutation.SetCustomField(s)
+	return nruo
+}
+
+// SetNillableCustomField sets the "custom_field" field if the given value is not nil.
+func (nruo *NotificationRuleUpdateOne) SetNillableCustomField(s *string) *NotificationRuleUpdateOne {
+	if s != nil {
+		nruo.SetCustomField(*s)
+	}
+	return nruo
+}
+
+// ClearCustomField clears the value of the "custom_field" field.
+func (nruo *NotificationRuleUpdateOne) ClearCustomField() *NotificationRuleUpdateOne {
+	nruo.mutation.ClearCustomField()
+	return nruo
+}
+
+// SetLeadDays sets the "lead_days" field.
+func (nruo *NotificationRuleUpdateOne) SetLeadDays(i []int) *NotificationRuleUpdateOne {
+	nruo.mutation.SetLeadDays(i)
+	return nruo
+}
+
+// AppendLeadDays appends i to the "lead_days" field.
+func (nruo *NotificationRuleUpdateOne) AppendLeadDays(i []int) *NotificationRuleUpdateOne {
+	nruo.mutation.AppendLeadDays(i)
+	return nruo
+}
+
+// SetIsActive sets the "is_active" field.
+func (nruo *NotificationRuleUpdateOne) SetIsActive(b bool) *NotificationRuleUpdateOne {
+	nruo.mutation.SetIsActive(b)
+	return nruo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (nruo *NotificationRuleUpdateOne) SetNillableIsActive(b *bool) *NotificationRuleUpdateOne {
+	if b != nil {
+		nruo.SetIsActive(*b)
+	}
+	return nruo
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (nruo *NotificationRuleUpdateOne) SetGroup(g *Group) *NotificationRuleUpdateOne {
+	return nruo.SetGroupID(g.ID)
+}
+
+// AddReminderIDs adds the "reminders" edge to the NotificationReminder entity by IDs.
+func (nruo *NotificationRuleUpdateOne) AddReminderIDs(ids ...uuid.UUID) *NotificationRuleUpdateOne {
+	nruo.mutation.AddReminderIDs(ids...)
+	return nruo
+}
+
+// AddReminders adds the "reminders" edges to the NotificationReminder entity.
+func (nruo *NotificationRuleUpdateOne) AddReminders(n ...*NotificationReminder) *NotificationRuleUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nruo.AddReminderIDs(ids...)
+}
+
+// Mutation returns the NotificationRuleMutation object of the builder.
+func (nruo *NotificationRuleUpdateOne) Mutation() *NotificationRuleMutation {
+	return nruo.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (nruo *NotificationRuleUpdateOne) ClearGroup() *NotificationRuleUpdateOne {
+	nruo.mutation.ClearGroup()
+	return nruo
+}
+
+// ClearReminders clears all "reminders" edges to the NotificationReminder entity.
+func (nruo *NotificationRuleUpdateOne) ClearReminders() *NotificationRuleUpdateOne {
+	nruo.mutation.ClearReminders()
+	return nruo
+}
+
+// RemoveReminderIDs removes the "reminders" edge to NotificationReminder entities by IDs.
+func (nruo *NotificationRuleUpdateOne) RemoveReminderIDs(ids ...uuid.UUID) *NotificationRuleUpdateOne {
+	nruo.mutation.RemoveReminderIDs(ids...)
+	return nruo
+}
+
+// RemoveReminders removes "reminders" edges to NotificationReminder entities.
+func (nruo *NotificationRuleUpdateOne) RemoveReminders(n ...*NotificationReminder) *NotificationRuleUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nruo.RemoveReminderIDs(ids...)
+}
+
+// Where appends a list predicates to the NotificationRuleUpdate builder.
+func (nruo *NotificationRuleUpdateOne) Where(ps ...predicate.NotificationRule) *NotificationRuleUpdateOne {
+	nruo.mutation.Where(ps...)
+	return nruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nruo *NotificationRuleUpdateOne) Select(field string, fields ...string) *NotificationRuleUpdateOne {
+	nruo.fields = append([]string{field}, fields...)
+	return nruo
+}
+
+// Save executes the query and returns the updated NotificationRule entity.
+func (nruo *NotificationRuleUpdateOne) Save(ctx context.Context) (*NotificationRule, error) {
+	nruo.defaults()
+	return withHooks(ctx, nruo.sqlSave, nruo.mutation, nruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nruo *NotificationRuleUpdateOne) SaveX(ctx context.Context) *NotificationRule {
+	node, err := nruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nruo *NotificationRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := nruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nruo *NotificationRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := nruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nruo *NotificationRuleUpdateOne) defaults() {
+	if _, ok := nruo.mutation.UpdatedAt(); !ok {
+		v := notificationrule.UpdateDefaultUpdatedAt()
+		nruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nruo *NotificationRuleUpdateOne) check() error {
+	if v, ok := nruo.mutation.Name(); ok {
+		if err := notificationrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "NotificationRule.name": %w`, err)}
+		}
+	}
+	if v, ok := nruo.mutation.Kind(); ok {
+		if err := notificationrule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "NotificationRule.kind": %w`, err)}
+		}
+	}
+	if v, ok := nruo.mutation.CustomField(); ok {
+		if err := notificationrule.CustomFieldValidator(v); err != nil {
+			return &ValidationError{Name: "custom_field", err: fmt.Errorf(`ent: validator failed for field "NotificationRule.custom_field": %w`, err)}
+		}
+	}
+	if _, ok := nruo.mutation.GroupID(); nruo.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "NotificationRule.group"`)
+	}
+	return nil
+}
+
+func (nruo *NotificationRuleUpdateOne) sqlSave(ctx context.Context) (_node *NotificationRule, err error) {
+	if err := nruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationrule.Table, notificationrule.Columns, sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeUUID))
+	id, ok := nruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationrule.FieldID)
+		for _, f := range fields {
+			if !notificationrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := nruo.mutation.Name(); ok {
+		_spec.SetField(notificationrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := nruo.mutation.Kind(); ok {
+		_spec.SetField(notificationrule.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := nruo.mutation.CustomField(); ok {
+		_spec.SetField(notificationrule.FieldCustomField, field.TypeString, value)
+	}
+	if nruo.mutation.CustomFieldCleared() {
+		_spec.ClearField(notificationrule.FieldCustomField, field.TypeString)
+	}
+	if value, ok := nruo.mutation.LeadDays(); ok {
+		_spec.SetField(notificationrule.FieldLeadDays, field.TypeJSON, value)
+	}
+	if value, ok := nruo.mutation.AppendedLeadDays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, notificationrule.FieldLeadDays, value)
+		})
+	}
+	if value, ok := nruo.mutation.IsActive(); ok {
+		_spec.SetField(notificationrule.FieldIsActive, field.TypeBool, value)
+	}
+	if nruo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationrule.GroupTable,
+			Columns: []string{notificationrule.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nruo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationrule.GroupTable,
+			Columns: []string{notificationrule.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nruo.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationrule.RemindersTable,
+			Columns: []string{notificationrule.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nruo.mutation.RemovedRemindersIDs(); len(nodes) > 0 && !nruo.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationrule.RemindersTable,
+			Columns: []string{notificationrule.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nruo.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationrule.RemindersTable,
+			Columns: []string{notificationrule.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NotificationRule{config: nruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nruo.mutation.done = true
+	return _node, nil
+}
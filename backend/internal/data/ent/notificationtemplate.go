@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationtemplate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+)
+
+// NotificationTemplate is the model entity for the NotificationTemplate schema.
+type NotificationTemplate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID uuid.UUID `json:"group_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type notificationtemplate.Type `json:"type,omitempty"`
+	// NotifierID holds the value of the "notifier_id" field.
+	NotifierID *uuid.UUID `json:"notifier_id,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NotificationTemplateQuery when eager-loading is set.
+	Edges        NotificationTemplateEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// NotificationTemplateEdges holds the relations/edges for other nodes in the graph.
+type NotificationTemplateEdges struct {
+	// Group holds the value of the group edge.
+	Group *Group `json:"group,omitempty"`
+	// Notifier holds the value of the notifier edge.
+	Notifier *Notifier `json:"notifier,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationTemplateEdges) GroupOrErr() (*Group, error) {
+	if e.loadedTypes[0] {
+		if e.Group == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: group.Label}
+		}
+		return e.Group, nil
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// NotifierOrErr returns the Notifier value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationTemplateEdges) NotifierOrErr() (*Notifier, error) {
+	if e.loadedTypes[1] {
+		if e.Notifier == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: notifier.Label}
+		}
+		return e.Notifier, nil
+	}
+	return nil, &NotLoadedError{edge: "notifier"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationTemplate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationtemplate.FieldNotifierID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case notificationtemplate.FieldType, notificationtemplate.FieldBody:
+			values[i] = new(sql.NullString)
+		case notificationtemplate.FieldCreatedAt, notificationtemplate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case notificationtemplate.FieldID, notificationtemplate.FieldGroupID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationTemplate fields.
+func (nt *NotificationTemplate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationtemplate.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				nt.ID = *value
+			}
+		case notificationtemplate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				nt.CreatedAt = value.Time
+			}
+		case notificationtemplate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				nt.UpdatedAt = value.Time
+			}
+		case notificationtemplate.FieldGroupID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value != nil {
+				nt.GroupID = *value
+			}
+		case notificationtemplate.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				nt.Type = notificationtemplate.Type(value.String)
+			}
+		case notificationtemplate.FieldNotifierID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field notifier_id", values[i])
+			} else if value.Valid {
+				nt.NotifierID = new(uuid.UUID)
+				*nt.NotifierID = *value.S.(*uuid.UUID)
+			}
+		case notificationtemplate.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				nt.Body = value.String
+			}
+		default:
+			nt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NotificationTemplate.
+// This includes values selected through modifiers, order, etc.
+func (nt *NotificationTemplate) Value(name string) (ent.Value, error) {
+	return nt.selectValues.Get(name)
+}
+
+// QueryGroup queries the "group" edge of the NotificationTemplate entity.
+func (nt *NotificationTemplate) QueryGroup() *GroupQuery {
+	return NewNotificationTemplateClient(nt.config).QueryGroup(nt)
+}
+
+// QueryNotifier queries the "notifier" edge of the NotificationTemplate entity.
+func (nt *NotificationTemplate) QueryNotifier() *NotifierQuery {
+	return NewNotificationTemplateClient(nt.config).QueryNotifier(nt)
+}
+
+// Update returns a builder for updating this NotificationTemplate.
+// Note that you need to call NotificationTemplate.Unwrap() before calling this method if this NotificationTemplate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (nt *NotificationTemplate) Update() *NotificationTemplateUpdateOne {
+	return NewNotificationTemplateClient(nt.config).UpdateOne(nt)
+}
+
+// Unwrap unwraps the NotificationTemplate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (nt *NotificationTemplate) Unwrap() *NotificationTemplate {
+	_tx, ok := nt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NotificationTemplate is not a transactional entity")
+	}
+	nt.config.driver = _tx.drv
+	return nt
+}
+
+// String implements the fmt.Stringer.
+func (nt *NotificationTemplate) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationTemplate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", nt.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(nt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(nt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(fmt.Sprintf("%v", nt.GroupID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", nt.Type))
+	builder.WriteString(", ")
+	if v := nt.NotifierID; v != nil {
+		builder.WriteString("notifier_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(nt.Body)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationTemplates is a parsable slice of NotificationTemplate.
+type NotificationTemplates []*NotificationTemplate
@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationtemplate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldEQ(FieldGroupID, v))
+}
+
+// NotifierID applies equality check predicate on the "notifier_id" field. It's identical to NotifierIDEQ.
+func NotifierID(v uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldEQ(FieldNotifierID, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldEQ(FieldBody, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldNotIn(FieldType, vs...))
+}
+
+// NotifierIDEQ applies the EQ predicate on the "notifier_id" field.
+func NotifierIDEQ(v uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldEQ(FieldNotifierID, v))
+}
+
+// NotifierIDNEQ applies the NEQ predicate on the "notifier_id" field.
+func NotifierIDNEQ(v uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldNEQ(FieldNotifierID, v))
+}
+
+// NotifierIDIn applies the In predicate on the "notifier_id" field.
+func NotifierIDIn(vs ...uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldIn(FieldNotifierID, vs...))
+}
+
+// NotifierIDNotIn applies the NotIn predicate on the "notifier_id" field.
+func NotifierIDNotIn(vs ...uuid.UUID) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldNotIn(FieldNotifierID, vs...))
+}
+
+// NotifierIDIsNil applies the IsNil predicate on the "notifier_id" field.
+func NotifierIDIsNil() predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldIsNull(FieldNotifierID))
+}
+
+// NotifierIDNotNil applies the NotNil predicate on the "notifier_id" field.
+func NotifierIDNotNil() predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldNotNull(FieldNotifierID))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.FieldContainsFold(FieldBody, v))
+}
+
+// HasGroup applies the HasEdge predicate on the "group" edge.
+func HasGroup() predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupWith applies the HasEdge predicate on the "group" edge with a given conditions (other predicates).
+func HasGroupWith(preds ...predicate.Group) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(func(s *sql.Selector) {
+		step := newGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotifier applies the HasEdge predicate on the "notifier" edge.
+func HasNotifier() predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, NotifierTable, NotifierColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotifierWith applies the HasEdge predicate on the "notifier" edge with a given conditions (other predicates).
+func HasNotifierWith(preds ...predicate.Notifier) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(func(s *sql.Selector) {
+		step := newNotifierStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NotificationTemplate) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NotificationTemplate) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NotificationTemplate) predicate.NotificationTemplate {
+	return predicate.NotificationTemplate(sql.NotPredicates(p))
+}
@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationtemplate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+)
+
+// NotificationTemplateCreate is the builder for creating a NotificationTemplate entity.
+type NotificationTemplateCreate struct {
+	config
+	mutation *NotificationTemplateMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ntc *NotificationTemplateCreate) SetCreatedAt(t time.Time) *NotificationTemplateCreate {
+	ntc.mutation.SetCreatedAt(t)
+	return ntc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ntc *NotificationTemplateCreate) SetNillableCreatedAt(t *time.Time) *NotificationTemplateCreate {
+	if t != nil {
+		ntc.SetCreatedAt(*t)
+	}
+	return ntc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ntc *NotificationTemplateCreate) SetUpdatedAt(t time.Time) *NotificationTemplateCreate {
+	ntc.mutation.SetUpdatedAt(t)
+	return ntc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ntc *NotificationTemplateCreate) SetNillableUpdatedAt(t *time.Time) *NotificationTemplateCreate {
+	if t != nil {
+		ntc.SetUpdatedAt(*t)
+	}
+	return ntc
+}
+
+// SetGroupID sets the "group_id" field.
+func (ntc *NotificationTemplateCreate) SetGroupID(u uuid.UUID) *NotificationTemplateCreate {
+	ntc.mutation.SetGroupID(u)
+	return ntc
+}
+
+// SetType sets the "type" field.
+func (ntc *NotificationTemplateCreate) SetType(n notificationtemplate.Type) *NotificationTemplateCreate {
+	ntc.mutation.SetType(n)
+	return ntc
+}
+
+// SetNotifierID sets the "notifier_id" field.
+func (ntc *NotificationTemplateCreate) SetNotifierID(u uuid.UUID) *NotificationTemplateCreate {
+	ntc.mutation.SetNotifierID(u)
+	return ntc
+}
+
+// SetNillableNotifierID sets the "notifier_id" field if the given value is not nil.
+func (ntc *NotificationTemplateCreate) SetNillableNotifierID(u *uuid.UUID) *NotificationTemplateCreate {
+	if u != nil {
+		ntc.SetNotifierID(*u)
+	}
+	return ntc
+}
+
+// SetBody sets the "body" field.
+func (ntc *NotificationTemplateCreate) SetBody(s string) *NotificationTemplateCreate {
+	ntc.mutation.SetBody(s)
+	return ntc
+}
+
+// SetID sets the "id" field.
+func (ntc *NotificationTemplateCreate) SetID(u uuid.UUID) *NotificationTemplateCreate {
+	ntc.mutation.SetID(u)
+	return ntc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ntc *NotificationTemplateCreate) SetNillableID(u *uuid.UUID) *NotificationTemplateCreate {
+	if u != nil {
+		ntc.SetID(*u)
+	}
+	return ntc
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (ntc *NotificationTemplateCreate) SetGroup(g *Group) *NotificationTemplateCreate {
+	return ntc.SetGroupID(g.ID)
+}
+
+// SetNotifier sets the "notifier" edge to the Notifier entity.
+func (ntc *NotificationTemplateCreate) SetNotifier(n *Notifier) *NotificationTemplateCreate {
+	return ntc.SetNotifierID(n.ID)
+}
+
+// Mutation returns the NotificationTemplateMutation object of the builder.
+func (ntc *NotificationTemplateCreate) Mutation() *NotificationTemplateMutation {
+	return ntc.mutation
+}
+
+// Save creates the NotificationTemplate in the database.
+func (ntc *NotificationTemplateCreate) Save(ctx context.Context) (*NotificationTemplate, error) {
+	ntc.defaults()
+	return withHooks(ctx, ntc.sqlSave, ntc.mutation, ntc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ntc *NotificationTemplateCreate) SaveX(ctx context.Context) *NotificationTemplate {
+	v, err := ntc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ntc *NotificationTemplateCreate) Exec(ctx context.Context) error {
+	_, err := ntc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ntc *NotificationTemplateCreate) ExecX(ctx context.Context) {
+	if err := ntc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ntc *NotificationTemplateCreate) defaults() {
+	if _, ok := ntc.mutation.CreatedAt(); !ok {
+		v := notificationtemplate.DefaultCreatedAt()
+		ntc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ntc.mutation.UpdatedAt(); !ok {
+		v := notificationtemplate.DefaultUpdatedAt()
+		ntc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ntc.mutation.ID(); !ok {
+		v := notificationtemplate.DefaultID()
+		ntc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ntc *NotificationTemplateCreate) check() error {
+	if _, ok := ntc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NotificationTemplate.created_at"`)}
+	}
+	if _, ok := ntc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NotificationTemplate.updated_at"`)}
+	}
+	if _, ok := ntc.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "NotificationTemplate.group_id"`)}
+	}
+	if _, ok := ntc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "NotificationTemplate.type"`)}
+	}
+	if v, ok := ntc.mutation.GetType(); ok {
+		if err := notificationtemplate.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "NotificationTemplate.type": %w`, err)}
+		}
+	}
+	if _, ok := ntc.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "NotificationTemplate.body"`)}
+	}
+	if v, ok := ntc.mutation.Body(); ok {
+		if err := notificationtemplate.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "NotificationTemplate.body": %w`, err)}
+		}
+	}
+	if _, ok := ntc.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "NotificationTemplate.group"`)}
+	}
+	return nil
+}
+
+func (ntc *NotificationTemplateCreate) sqlSave(ctx context.Context) (*NotificationTemplate, error) {
+	if err := ntc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ntc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ntc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ntc.mutation.id = &_node.ID
+	ntc.mutation.done = true
+	return _node, nil
+}
+
+func (ntc *NotificationTemplateCreate) createSpec() (*NotificationTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationTemplate{config: ntc.config}
+		_spec = sqlgraph.NewCreateSpec(notificationtemplate.Table, sqlgraph.NewFieldSpec(notificationtemplate.FieldID, field.TypeUUID))
+	)
+	if id, ok := ntc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ntc.mutation.CreatedAt(); ok {
+		_spec.SetField(notificationtemplate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ntc.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationtemplate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ntc.mutation.GetType(); ok {
+		_spec.SetField(notificationtemplate.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := ntc.mutation.Body(); ok {
+		_spec.SetField(notificationtemplate.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if nodes := ntc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationtemplate.GroupTable,
+			Columns: []string{notificationtemplate.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ntc.mutation.NotifierIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationtemplate.NotifierTable,
+			Columns: []string{notificationtemplate.NotifierColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.NotifierID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NotificationTemplateCreateBulk is the builder for creating many NotificationTemplate entities in bulk.
+type NotificationTemplateCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationTemplateCreate
+}
+
+// Save creates the NotificationTemplate entities in the database.
+func (ntcb *NotificationTemplateCreateBulk) Save(ctx context.Context) ([]*NotificationTemplate, error) {
+	if ntcb.err != nil {
+		return nil, ntcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ntcb.builders))
+	nodes := make([]*NotificationTemplate, len(ntcb.builders))
+	mutators := make([]Mutator, len(ntcb.builders))
+	for i := range ntcb.builders {
+		func(i int, root context.Context) {
+			builder := ntcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ntcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ntcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ntcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ntcb *NotificationTemplateCreateBulk) SaveX(ctx context.Context) []*NotificationTemplate {
+	v, err := ntcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ntcb *NotificationTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := ntcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ntcb *NotificationTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := ntcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
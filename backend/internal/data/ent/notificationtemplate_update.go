@@ -0,0 +1,553 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationtemplate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// NotificationTemplateUpdate is the builder for updating NotificationTemplate entities.
+type NotificationTemplateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationTemplateMutation
+}
+
+// Where appends a list predicates to the NotificationTemplateUpdate builder.
+func (ntu *NotificationTemplateUpdate) Where(ps ...predicate.NotificationTemplate) *NotificationTemplateUpdate {
+	ntu.mutation.Where(ps...)
+	return ntu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ntu *NotificationTemplateUpdate) SetUpdatedAt(t time.Time) *NotificationTemplateUpdate {
+	ntu.mutation.SetUpdatedAt(t)
+	return ntu
+}
+
+// SetGroupID sets the "group_id" field.
+func (ntu *NotificationTemplateUpdate) SetGroupID(u uuid.UUID) *NotificationTemplateUpdate {
+	ntu.mutation.SetGroupID(u)
+	return ntu
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (ntu *NotificationTemplateUpdate) SetNillableGroupID(u *uuid.UUID) *NotificationTemplateUpdate {
+	if u != nil {
+		ntu.SetGroupID(*u)
+	}
+	return ntu
+}
+
+// SetType sets the "type" field.
+func (ntu *NotificationTemplateUpdate) SetType(n notificationtemplate.Type) *NotificationTemplateUpdate {
+	ntu.mutation.SetType(n)
+	return ntu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ntu *NotificationTemplateUpdate) SetNillableType(n *notificationtemplate.Type) *NotificationTemplateUpdate {
+	if n != nil {
+		ntu.SetType(*n)
+	}
+	return ntu
+}
+
+// SetNotifierID sets the "notifier_id" field.
+func (ntu *NotificationTemplateUpdate) SetNotifierID(u uuid.UUID) *NotificationTemplateUpdate {
+	ntu.mutation.SetNotifierID(u)
+	return ntu
+}
+
+// SetNillableNotifierID sets the "notifier_id" field if the given value is not nil.
+func (ntu *NotificationTemplateUpdate) SetNillableNotifierID(u *uuid.UUID) *NotificationTemplateUpdate {
+	if u != nil {
+		ntu.SetNotifierID(*u)
+	}
+	return ntu
+}
+
+// ClearNotifierID clears the value of the "notifier_id" field.
+func (ntu *NotificationTemplateUpdate) ClearNotifierID() *NotificationTemplateUpdate {
+	ntu.mutation.ClearNotifierID()
+	return ntu
+}
+
+// SetBody sets the "body" field.
+func (ntu *NotificationTemplateUpdate) SetBody(s string) *NotificationTemplateUpdate {
+	ntu.mutation.SetBody(s)
+	return ntu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (ntu *NotificationTemplateUpdate) SetNillableBody(s *string) *NotificationTemplateUpdate {
+	if s != nil {
+		ntu.SetBody(*s)
+	}
+	return ntu
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (ntu *NotificationTemplateUpdate) SetGroup(g *Group) *NotificationTemplateUpdate {
+	return ntu.SetGroupID(g.ID)
+}
+
+// SetNotifier sets the "notifier" edge to the Notifier entity.
+func (ntu *NotificationTemplateUpdate) SetNotifier(n *Notifier) *NotificationTemplateUpdate {
+	return ntu.SetNotifierID(n.ID)
+}
+
+// Mutation returns the NotificationTemplateMutation object of the builder.
+func (ntu *NotificationTemplateUpdate) Mutation() *NotificationTemplateMutation {
+	return ntu.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (ntu *NotificationTemplateUpdate) ClearGroup() *NotificationTemplateUpdate {
+	ntu.mutation.ClearGroup()
+	return ntu
+}
+
+// ClearNotifier clears the "notifier" edge to the Notifier entity.
+func (ntu *NotificationTemplateUpdate) ClearNotifier() *NotificationTemplateUpdate {
+	ntu.mutation.ClearNotifier()
+	return ntu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ntu *NotificationTemplateUpdate) Save(ctx context.Context) (int, error) {
+	ntu.defaults()
+	return withHooks(ctx, ntu.sqlSave, ntu.mutation, ntu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ntu *NotificationTemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := ntu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ntu *NotificationTemplateUpdate) Exec(ctx context.Context) error {
+	_, err := ntu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ntu *NotificationTemplateUpdate) ExecX(ctx context.Context) {
+	if err := ntu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ntu *NotificationTemplateUpdate) defaults() {
+	if _, ok := ntu.mutation.UpdatedAt(); !ok {
+		v := notificationtemplate.UpdateDefaultUpdatedAt()
+		ntu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ntu *NotificationTemplateUpdate) check() error {
+	if v, ok := ntu.mutation.GetType(); ok {
+		if err := notificationtemplate.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "NotificationTemplate.type": %w`, err)}
+		}
+	}
+	if v, ok := ntu.mutation.Body(); ok {
+		if err := notificationtemplate.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "NotificationTemplate.body": %w`, err)}
+		}
+	}
+	if _, ok := ntu.mutation.GroupID(); ntu.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "NotificationTemplate.group"`)
+	}
+	return nil
+}
+
+func (ntu *NotificationTemplateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ntu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationtemplate.Table, notificationtemplate.Columns, sqlgraph.NewFieldSpec(notificationtemplate.FieldID, field.TypeUUID))
+	if ps := ntu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ntu.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationtemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ntu.mutation.GetType(); ok {
+		_spec.SetField(notificationtemplate.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := ntu.mutation.Body(); ok {
+		_spec.SetField(notificationtemplate.FieldBody, field.TypeString, value)
+	}
+	if ntu.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationtemplate.GroupTable,
+			Columns: []string{notificationtemplate.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ntu.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationtemplate.GroupTable,
+			Columns: []string{notificationtemplate.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ntu.mutation.NotifierCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationtemplate.NotifierTable,
+			Columns: []string{notificationtemplate.NotifierColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ntu.mutation.NotifierIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationtemplate.NotifierTable,
+			Columns: []string{notificationtemplate.NotifierColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ntu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationtemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ntu.mutation.done = true
+	return n, nil
+}
+
+// NotificationTemplateUpdateOne is the builder for updating a single NotificationTemplate entity.
+type NotificationTemplateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationTemplateMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ntuo *NotificationTemplateUpdateOne) SetUpdatedAt(t time.Time) *NotificationTemplateUpdateOne {
+	ntuo.mutation.SetUpdatedAt(t)
+	return ntuo
+}
+
+// SetGroupID sets the "group_id" field.
+func (ntuo *NotificationTemplateUpdateOne) SetGroupID(u uuid.UUID) *NotificationTemplateUpdateOne {
+	ntuo.mutation.SetGroupID(u)
+	return ntuo
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (ntuo *NotificationTemplateUpdateOne) SetNillableGroupID(u *uuid.UUID) *NotificationTemplateUpdateOne {
+	if u != nil {
+		ntuo.SetGroupID(*u)
+	}
+	return ntuo
+}
+
+// SetType sets the "type" field.
+func (ntuo *NotificationTemplateUpdateOne) SetType(n notificationtemplate.Type) *NotificationTemplateUpdateOne {
+	ntuo.mutation.SetType(n)
+	return ntuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ntuo *NotificationTemplateUpdateOne) SetNillableType(n *notificationtemplate.Type) *NotificationTemplateUpdateOne {
+	if n != nil {
+		ntuo.SetType(*n)
+	}
+	return ntuo
+}
+
+// SetNotifierID sets the "notifier_id" field.
+func (ntuo *NotificationTemplateUpdateOne) SetNotifierID(u uuid.UUID) *NotificationTemplateUpdateOne {
+	ntuo.mutation.SetNotifierID(u)
+	return ntuo
+}
+
+// SetNillableNotifierID sets the "notifier_id" field if the given value is not nil.
+func (ntuo *NotificationTemplateUpdateOne) SetNillableNotifierID(u *uuid.UUID) *NotificationTemplateUpdateOne {
+	if u != nil {
+		ntuo.SetNotifierID(*u)
+	}
+	return ntuo
+}
+
+// ClearNotifierID clears the value of the "notifier_id" field.
+func (ntuo *NotificationTemplateUpdateOne) ClearNotifierID() *NotificationTemplateUpdateOne {
+	ntuo.mutation.ClearNotifierID()
+	return ntuo
+}
+
+// SetBody sets the "body" field.
+func (ntuo *NotificationTemplateUpdateOne) SetBody(s string) *NotificationTemplateUpdateOne {
+	ntuo.mutation.SetBody(s)
+	return ntuo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (ntuo *NotificationTemplateUpdateOne) SetNillableBody(s *string) *NotificationTemplateUpdateOne {
+	if s != nil {
+		ntuo.SetBody(*s)
+	}
+	return ntuo
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (ntuo *NotificationTemplateUpdateOne) SetGroup(g *Group) *NotificationTemplateUpdateOne {
+	return ntuo.SetGroupID(g.ID)
+}
+
+// SetNotifier sets the "notifier" edge to the Notifier entity.
+func (ntuo *NotificationTemplateUpdateOne) SetNotifier(n *Notifier) *NotificationTemplateUpdateOne {
+	return ntuo.SetNotifierID(n.ID)
+}
+
+// Mutation returns the NotificationTemplateMutation object of the builder.
+func (ntuo *NotificationTemplateUpdateOne) Mutation() *NotificationTemplateMutation {
+	return ntuo.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (ntuo *NotificationTemplateUpdateOne) ClearGroup() *NotificationTemplateUpdateOne {
+	ntuo.mutation.ClearGroup()
+	return ntuo
+}
+
+// ClearNotifier clears the "notifier" edge to the Notifier entity.
+func (ntuo *NotificationTemplateUpdateOne) ClearNotifier() *NotificationTemplateUpdateOne {
+	ntuo.mutation.ClearNotifier()
+	return ntuo
+}
+
+// Where appends a list predicates to the NotificationTemplateUpdate builder.
+func (ntuo *NotificationTemplateUpdateOne) Where(ps ...predicate.NotificationTemplate) *NotificationTemplateUpdateOne {
+	ntuo.mutation.Where(ps...)
+	return ntuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ntuo *NotificationTemplateUpdateOne) Select(field string, fields ...string) *NotificationTemplateUpdateOne {
+	ntuo.fields = append([]string{field}, fields...)
+	return ntuo
+}
+
+// Save executes the query and returns the updated NotificationTemplate entity.
+func (ntuo *NotificationTemplateUpdateOne) Save(ctx context.Context) (*NotificationTemplate, error) {
+	ntuo.defaults()
+	return withHooks(ctx, ntuo.sqlSave, ntuo.mutation, ntuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ntuo *NotificationTemplateUpdateOne) SaveX(ctx context.Context) *NotificationTemplate {
+	node, err := ntuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ntuo *NotificationTemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := ntuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ntuo *NotificationTemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := ntuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ntuo *NotificationTemplateUpdateOne) defaults() {
+	if _, ok := ntuo.mutation.UpdatedAt(); !ok {
+		v := notificationtemplate.UpdateDefaultUpdatedAt()
+		ntuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ntuo *NotificationTemplateUpdateOne) check() error {
+	if v, ok := ntuo.mutation.GetType(); ok {
+		if err := notificationtemplate.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "NotificationTemplate.type": %w`, err)}
+		}
+	}
+	if v, ok := ntuo.mutation.Body(); ok {
+		if err := notificationtemplate.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "NotificationTemplate.body": %w`, err)}
+		}
+	}
+	if _, ok := ntuo.mutation.GroupID(); ntuo.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "NotificationTemplate.group"`)
+	}
+	return nil
+}
+
+func (ntuo *NotificationTemplateUpdateOne) sqlSave(ctx context.Context) (_node *NotificationTemplate, err error) {
+	if err := ntuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationtemplate.Table, notificationtemplate.Columns, sqlgraph.NewFieldSpec(notificationtemplate.FieldID, field.TypeUUID))
+	id, ok := ntuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationTemplate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ntuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationtemplate.FieldID)
+		for _, f := range fields {
+			if !notificationtemplate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationtemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ntuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ntuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationtemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ntuo.mutation.GetType(); ok {
+		_spec.SetField(notificationtemplate.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := ntuo.mutation.Body(); ok {
+		_spec.SetField(notificationtemplate.FieldBody, field.TypeString, value)
+	}
+	if ntuo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationtemplate.GroupTable,
+			Columns: []string{notificationtemplate.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ntuo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationtemplate.GroupTable,
+			Columns: []string{notificationtemplate.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ntuo.mutation.NotifierCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationtemplate.NotifierTable,
+			Columns: []string{notificationtemplate.NotifierColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ntuo.mutation.NotifierIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationtemplate.NotifierTable,
+			Columns: []string{notificationtemplate.NotifierColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NotificationTemplate{config: ntuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ntuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationtemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ntuo.mutation.done = true
+	return _node, nil
+}
@@ -34,6 +34,8 @@ type Notifier struct {
 	URL string `json:"-"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
+	// FailureCount holds the value of the "failure_count" field.
+	FailureCount int `json:"failure_count,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the NotifierQuery when eager-loading is set.
 	Edges        NotifierEdges `json:"edges"`
@@ -46,9 +48,13 @@ type NotifierEdges struct {
 	Group *Group `json:"group,omitempty"`
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Templates holds the value of the templates edge.
+	Templates []*NotificationTemplate `json:"templates,omitempty"`
+	// Deliveries holds the value of the deliveries edge.
+	Deliveries []*NotifierDelivery `json:"deliveries,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // GroupOrErr returns the Group value or an error if the edge
@@ -77,6 +83,24 @@ func (e NotifierEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// TemplatesOrErr returns the Templates value or an error if the edge
+// was not loaded in eager-loading.
+func (e NotifierEdges) TemplatesOrErr() ([]*NotificationTemplate, error) {
+	if e.loadedTypes[2] {
+		return e.Templates, nil
+	}
+	return nil, &NotLoadedError{edge: "templates"}
+}
+
+// DeliveriesOrErr returns the Deliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e NotifierEdges) DeliveriesOrErr() ([]*NotifierDelivery, error) {
+	if e.loadedTypes[3] {
+		return e.Deliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "deliveries"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Notifier) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -84,6 +108,8 @@ func (*Notifier) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case notifier.FieldIsActive:
 			values[i] = new(sql.NullBool)
+		case notifier.FieldFailureCount:
+			values[i] = new(sql.NullInt64)
 		case notifier.FieldName, notifier.FieldURL:
 			values[i] = new(sql.NullString)
 		case notifier.FieldCreatedAt, notifier.FieldUpdatedAt:
@@ -153,6 +179,12 @@ func (n *Notifier) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				n.IsActive = value.Bool
 			}
+		case notifier.FieldFailureCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_count", values[i])
+			} else if value.Valid {
+				n.FailureCount = int(value.Int64)
+			}
 		default:
 			n.selectValues.Set(columns[i], values[i])
 		}
@@ -176,6 +208,16 @@ func (n *Notifier) QueryUser() *UserQuery {
 	return NewNotifierClient(n.config).QueryUser(n)
 }
 
+// QueryTemplates queries the "templates" edge of the Notifier entity.
+func (n *Notifier) QueryTemplates() *NotificationTemplateQuery {
+	return NewNotifierClient(n.config).QueryTemplates(n)
+}
+
+// QueryDeliveries queries the "deliveries" edge of the Notifier entity.
+func (n *Notifier) QueryDeliveries() *NotifierDeliveryQuery {
+	return NewNotifierClient(n.config).QueryDeliveries(n)
+}
+
 // Update returns a builder for updating this Notifier.
 // Note that you need to call Notifier.Unwrap() before calling this method if this Notifier
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -218,6 +260,9 @@ func (n *Notifier) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", n.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("failure_count=")
+	builder.WriteString(fmt.Sprintf("%v", n.FailureCount))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -12,7 +12,9 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationtemplate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifierdelivery"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
 )
 
@@ -89,6 +91,20 @@ func (nc *NotifierCreate) SetNillableIsActive(b *bool) *NotifierCreate {
 	return nc
 }
 
+// SetFailureCount sets the "failure_count" field.
+func (nc *NotifierCreate) SetFailureCount(i int) *NotifierCreate {
+	nc.mutation.SetFailureCount(i)
+	return nc
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (nc *NotifierCreate) SetNillableFailureCount(i *int) *NotifierCreate {
+	if i != nil {
+		nc.SetFailureCount(*i)
+	}
+	return nc
+}
+
 // SetID sets the "id" field.
 func (nc *NotifierCreate) SetID(u uuid.UUID) *NotifierCreate {
 	nc.mutation.SetID(u)
@@ -113,6 +129,36 @@ func (nc *NotifierCreate) SetUser(u *User) *NotifierCreate {
 	return nc.SetUserID(u.ID)
 }
 
+// AddTemplateIDs adds the "templates" edge to the NotificationTemplate entity by IDs.
+func (nc *NotifierCreate) AddTemplateIDs(ids ...uuid.UUID) *NotifierCreate {
+	nc.mutation.AddTemplateIDs(ids...)
+	return nc
+}
+
+// AddTemplates adds the "templates" edges to the NotificationTemplate entity.
+func (nc *NotifierCreate) AddTemplates(n ...*NotificationTemplate) *NotifierCreate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nc.AddTemplateIDs(ids...)
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the NotifierDelivery entity by IDs.
+func (nc *NotifierCreate) AddDeliveryIDs(ids ...uuid.UUID) *NotifierCreate {
+	nc.mutation.AddDeliveryIDs(ids...)
+	return nc
+}
+
+// AddDeliveries adds the "deliveries" edges to the NotifierDelivery entity.
+func (nc *NotifierCreate) AddDeliveries(n ...*NotifierDelivery) *NotifierCreate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nc.AddDeliveryIDs(ids...)
+}
+
 // Mutation returns the NotifierMutation object of the builder.
 func (nc *NotifierCreate) Mutation() *NotifierMutation {
 	return nc.mutation
@@ -160,6 +206,10 @@ func (nc *NotifierCreate) defaults() {
 		v := notifier.DefaultIsActive
 		nc.mutation.SetIsActive(v)
 	}
+	if _, ok := nc.mutation.FailureCount(); !ok {
+		v := notifier.DefaultFailureCount
+		nc.mutation.SetFailureCount(v)
+	}
 	if _, ok := nc.mutation.ID(); !ok {
 		v := notifier.DefaultID()
 		nc.mutation.SetID(v)
@@ -199,6 +249,9 @@ func (nc *NotifierCreate) check() error {
 	if _, ok := nc.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "Notifier.is_active"`)}
 	}
+	if _, ok := nc.mutation.FailureCount(); !ok {
+		return &ValidationError{Name: "failure_count", err: errors.New(`ent: missing required field "Notifier.failure_count"`)}
+	}
 	if _, ok := nc.mutation.GroupID(); !ok {
 		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "Notifier.group"`)}
 	}
@@ -260,6 +313,10 @@ func (nc *NotifierCreate) createSpec() (*Notifier, *sqlgraph.CreateSpec) {
 		_spec.SetField(notifier.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
 	}
+	if value, ok := nc.mutation.FailureCount(); ok {
+		_spec.SetField(notifier.FieldFailureCount, field.TypeInt, value)
+		_node.FailureCount = value
+	}
 	if nodes := nc.mutation.GroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -294,6 +351,38 @@ func (nc *NotifierCreate) createSpec() (*Notifier, *sqlgraph.CreateSpec) {
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := nc.mutation.TemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notifier.TemplatesTable,
+			Columns: []string{notifier.TemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notifier.DeliveriesTable,
+			Columns: []string{notifier.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifierdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -12,7 +13,9 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationtemplate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifierdelivery"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
 )
@@ -20,12 +23,14 @@ import (
 // NotifierQuery is the builder for querying Notifier entities.
 type NotifierQuery struct {
 	config
-	ctx        *QueryContext
-	order      []notifier.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Notifier
-	withGroup  *GroupQuery
-	withUser   *UserQuery
+	ctx            *QueryContext
+	order          []notifier.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.Notifier
+	withGroup      *GroupQuery
+	withUser       *UserQuery
+	withTemplates  *NotificationTemplateQuery
+	withDeliveries *NotifierDeliveryQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -106,6 +111,50 @@ func (nq *NotifierQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryTemplates chains the current query on the "templates" edge.
+func (nq *NotifierQuery) QueryTemplates() *NotificationTemplateQuery {
+	query := (&NotificationTemplateClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notifier.Table, notifier.FieldID, selector),
+			sqlgraph.To(notificationtemplate.Table, notificationtemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, notifier.TemplatesTable, notifier.TemplatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDeliveries chains the current query on the "deliveries" edge.
+func (nq *NotifierQuery) QueryDeliveries() *NotifierDeliveryQuery {
+	query := (&NotifierDeliveryClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notifier.Table, notifier.FieldID, selector),
+			sqlgraph.To(notifierdelivery.Table, notifierdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, notifier.DeliveriesTable, notifier.DeliveriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Notifier entity from the query.
 // Returns a *NotFoundError when no Notifier was found.
 func (nq *NotifierQuery) First(ctx context.Context) (*Notifier, error) {
@@ -293,13 +342,15 @@ func (nq *NotifierQuery) Clone() *NotifierQuery {
 		return nil
 	}
 	return &NotifierQuery{
-		config:     nq.config,
-		ctx:        nq.ctx.Clone(),
-		order:      append([]notifier.OrderOption{}, nq.order...),
-		inters:     append([]Interceptor{}, nq.inters...),
-		predicates: append([]predicate.Notifier{}, nq.predicates...),
-		withGroup:  nq.withGroup.Clone(),
-		withUser:   nq.withUser.Clone(),
+		config:         nq.config,
+		ctx:            nq.ctx.Clone(),
+		order:          append([]notifier.OrderOption{}, nq.order...),
+		inters:         append([]Interceptor{}, nq.inters...),
+		predicates:     append([]predicate.Notifier{}, nq.predicates...),
+		withGroup:      nq.withGroup.Clone(),
+		withUser:       nq.withUser.Clone(),
+		withTemplates:  nq.withTemplates.Clone(),
+		withDeliveries: nq.withDeliveries.Clone(),
 		// clone intermediate query.
 		sql:  nq.sql.Clone(),
 		path: nq.path,
@@ -328,6 +379,28 @@ func (nq *NotifierQuery) WithUser(opts ...func(*UserQuery)) *NotifierQuery {
 	return nq
 }
 
+// WithTemplates tells the query-builder to eager-load the nodes that are connected to
+// the "templates" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NotifierQuery) WithTemplates(opts ...func(*NotificationTemplateQuery)) *NotifierQuery {
+	query := (&NotificationTemplateClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withTemplates = query
+	return nq
+}
+
+// WithDeliveries tells the query-builder to eager-load the nodes that are connected to
+// the "deliveries" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NotifierQuery) WithDeliveries(opts ...func(*NotifierDeliveryQuery)) *NotifierQuery {
+	query := (&NotifierDeliveryClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withDeliveries = query
+	return nq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -406,9 +479,11 @@ func (nq *NotifierQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Not
 	var (
 		nodes       = []*Notifier{}
 		_spec       = nq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			nq.withGroup != nil,
 			nq.withUser != nil,
+			nq.withTemplates != nil,
+			nq.withDeliveries != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -441,6 +516,20 @@ func (nq *NotifierQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Not
 			return nil, err
 		}
 	}
+	if query := nq.withTemplates; query != nil {
+		if err := nq.loadTemplates(ctx, query, nodes,
+			func(n *Notifier) { n.Edges.Templates = []*NotificationTemplate{} },
+			func(n *Notifier, e *NotificationTemplate) { n.Edges.Templates = append(n.Edges.Templates, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := nq.withDeliveries; query != nil {
+		if err := nq.loadDeliveries(ctx, query, nodes,
+			func(n *Notifier) { n.Edges.Deliveries = []*NotifierDelivery{} },
+			func(n *Notifier, e *NotifierDelivery) { n.Edges.Deliveries = append(n.Edges.Deliveries, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -502,6 +591,69 @@ func (nq *NotifierQuery) loadUser(ctx context.Context, query *UserQuery, nodes [
 	}
 	return nil
 }
+func (nq *NotifierQuery) loadTemplates(ctx context.Context, query *NotificationTemplateQuery, nodes []*Notifier, init func(*Notifier), assign func(*Notifier, *NotificationTemplate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Notifier)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notificationtemplate.FieldNotifierID)
+	}
+	query.Where(predicate.NotificationTemplate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(notifier.TemplatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.NotifierID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "notifier_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "notifier_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (nq *NotifierQuery) loadDeliveries(ctx context.Context, query *NotifierDeliveryQuery, nodes []*Notifier, init func(*Notifier), assign func(*Notifier, *NotifierDelivery)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Notifier)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notifierdelivery.FieldNotifierID)
+	}
+	query.Where(predicate.NotifierDelivery(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(notifier.DeliveriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.NotifierID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "notifier_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (nq *NotifierQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := nq.querySpec()
@@ -13,7 +13,9 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationtemplate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifierdelivery"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
 )
@@ -107,6 +109,27 @@ func (nu *NotifierUpdate) SetNillableIsActive(b *bool) *NotifierUpdate {
 	return nu
 }
 
+// SetFailureCount sets the "failure_count" field.
+func (nu *NotifierUpdate) SetFailureCount(i int) *NotifierUpdate {
+	nu.mutation.ResetFailureCount()
+	nu.mutation.SetFailureCount(i)
+	return nu
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (nu *NotifierUpdate) SetNillableFailureCount(i *int) *NotifierUpdate {
+	if i != nil {
+		nu.SetFailureCount(*i)
+	}
+	return nu
+}
+
+// AddFailureCount adds i to the "failure_count" field.
+func (nu *NotifierUpdate) AddFailureCount(i int) *NotifierUpdate {
+	nu.mutation.AddFailureCount(i)
+	return nu
+}
+
 // SetGroup sets the "group" edge to the Group entity.
 func (nu *NotifierUpdate) SetGroup(g *Group) *NotifierUpdate {
 	return nu.SetGroupID(g.ID)
@@ -117,6 +140,36 @@ func (nu *NotifierUpdate) SetUser(u *User) *NotifierUpdate {
 	return nu.SetUserID(u.ID)
 }
 
+// AddTemplateIDs adds the "templates" edge to the NotificationTemplate entity by IDs.
+func (nu *NotifierUpdate) AddTemplateIDs(ids ...uuid.UUID) *NotifierUpdate {
+	nu.mutation.AddTemplateIDs(ids...)
+	return nu
+}
+
+// AddTemplates adds the "templates" edges to the NotificationTemplate entity.
+func (nu *NotifierUpdate) AddTemplates(n ...*NotificationTemplate) *NotifierUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.AddTemplateIDs(ids...)
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the NotifierDelivery entity by IDs.
+func (nu *NotifierUpdate) AddDeliveryIDs(ids ...uuid.UUID) *NotifierUpdate {
+	nu.mutation.AddDeliveryIDs(ids...)
+	return nu
+}
+
+// AddDeliveries adds the "deliveries" edges to the NotifierDelivery entity.
+func (nu *NotifierUpdate) AddDeliveries(n ...*NotifierDelivery) *NotifierUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.AddDeliveryIDs(ids...)
+}
+
 // Mutation returns the NotifierMutation object of the builder.
 func (nu *NotifierUpdate) Mutation() *NotifierMutation {
 	return nu.mutation
@@ -134,6 +187,48 @@ func (nu *NotifierUpdate) ClearUser() *NotifierUpdate {
 	return nu
 }
 
+// ClearTemplates clears all "templates" edges to the NotificationTemplate entity.
+func (nu *NotifierUpdate) ClearTemplates() *NotifierUpdate {
+	nu.mutation.ClearTemplates()
+	return nu
+}
+
+// RemoveTemplateIDs removes the "templates" edge to NotificationTemplate entities by IDs.
+func (nu *NotifierUpdate) RemoveTemplateIDs(ids ...uuid.UUID) *NotifierUpdate {
+	nu.mutation.RemoveTemplateIDs(ids...)
+	return nu
+}
+
+// RemoveTemplates removes "templates" edges to NotificationTemplate entities.
+func (nu *NotifierUpdate) RemoveTemplates(n ...*NotificationTemplate) *NotifierUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.RemoveTemplateIDs(ids...)
+}
+
+// ClearDeliveries clears all "deliveries" edges to the NotifierDelivery entity.
+func (nu *NotifierUpdate) ClearDeliveries() *NotifierUpdate {
+	nu.mutation.ClearDeliveries()
+	return nu
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to NotifierDelivery entities by IDs.
+func (nu *NotifierUpdate) RemoveDeliveryIDs(ids ...uuid.UUID) *NotifierUpdate {
+	nu.mutation.RemoveDeliveryIDs(ids...)
+	return nu
+}
+
+// RemoveDeliveries removes "deliveries" edges to NotifierDelivery entities.
+func (nu *NotifierUpdate) RemoveDeliveries(n ...*NotifierDelivery) *NotifierUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.RemoveDeliveryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (nu *NotifierUpdate) Save(ctx context.Context) (int, error) {
 	nu.defaults()
@@ -215,6 +310,12 @@ func (nu *NotifierUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := nu.mutation.IsActive(); ok {
 		_spec.SetField(notifier.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := nu.mutation.FailureCount(); ok {
+		_spec.SetField(notifier.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := nu.mutation.AddedFailureCount(); ok {
+		_spec.AddField(notifier.FieldFailureCount, field.TypeInt, value)
+	}
 	if nu.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -273,6 +374,96 @@ func (nu *NotifierUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if nu.mutation.TemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notifier.TemplatesTable,
+			Columns: []string{notifier.TemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedTemplatesIDs(); len(nodes) > 0 && !nu.mutation.TemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notifier.TemplatesTable,
+			Columns: []string{notifier.TemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.TemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notifier.TemplatesTable,
+			Columns: []string{notifier.TemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notifier.DeliveriesTable,
+			Columns: []string{notifier.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifierdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !nu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notifier.DeliveriesTable,
+			Columns: []string{notifier.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifierdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notifier.DeliveriesTable,
+			Columns: []string{notifier.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifierdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{notifier.Label}
@@ -369,6 +560,27 @@ func (nuo *NotifierUpdateOne) SetNillableIsActive(b *bool) *NotifierUpdateOne {
 	return nuo
 }
 
+// SetFailureCount sets the "failure_count" field.
+func (nuo *NotifierUpdateOne) SetFailureCount(i int) *NotifierUpdateOne {
+	nuo.mutation.ResetFailureCount()
+	nuo.mutation.SetFailureCount(i)
+	return nuo
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (nuo *NotifierUpdateOne) SetNillableFailureCount(i *int) *NotifierUpdateOne {
+	if i != nil {
+		nuo.SetFailureCount(*i)
+	}
+	return nuo
+}
+
+// AddFailureCount adds i to the "failure_count" field.
+func (nuo *NotifierUpdateOne) AddFailureCount(i int) *NotifierUpdateOne {
+	nuo.mutation.AddFailureCount(i)
+	return nuo
+}
+
 // SetGroup sets the "group" edge to the Group entity.
 func (nuo *NotifierUpdateOne) SetGroup(g *Group) *NotifierUpdateOne {
 	return nuo.SetGroupID(g.ID)
@@ -379,6 +591,36 @@ func (nuo *NotifierUpdateOne) SetUser(u *User) *NotifierUpdateOne {
 	return nuo.SetUserID(u.ID)
 }
 
+// AddTemplateIDs adds the "templates" edge to the NotificationTemplate entity by IDs.
+func (nuo *NotifierUpdateOne) AddTemplateIDs(ids ...uuid.UUID) *NotifierUpdateOne {
+	nuo.mutation.AddTemplateIDs(ids...)
+	return nuo
+}
+
+// AddTemplates adds the "templates" edges to the NotificationTemplate entity.
+func (nuo *NotifierUpdateOne) AddTemplates(n ...*NotificationTemplate) *NotifierUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.AddTemplateIDs(ids...)
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the NotifierDelivery entity by IDs.
+func (nuo *NotifierUpdateOne) AddDeliveryIDs(ids ...uuid.UUID) *NotifierUpdateOne {
+	nuo.mutation.AddDeliveryIDs(ids...)
+	return nuo
+}
+
+// AddDeliveries adds the "deliveries" edges to the NotifierDelivery entity.
+func (nuo *NotifierUpdateOne) AddDeliveries(n ...*NotifierDelivery) *NotifierUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.AddDeliveryIDs(ids...)
+}
+
 // Mutation returns the NotifierMutation object of the builder.
 func (nuo *NotifierUpdateOne) Mutation() *NotifierMutation {
 	return nuo.mutation
@@ -396,6 +638,48 @@ func (nuo *NotifierUpdateOne) ClearUser() *NotifierUpdateOne {
 	return nuo
 }
 
+// ClearTemplates clears all "templates" edges to the NotificationTemplate entity.
+func (nuo *NotifierUpdateOne) ClearTemplates() *NotifierUpdateOne {
+	nuo.mutation.ClearTemplates()
+	return nuo
+}
+
+// RemoveTemplateIDs removes the "templates" edge to NotificationTemplate entities by IDs.
+func (nuo *NotifierUpdateOne) RemoveTemplateIDs(ids ...uuid.UUID) *NotifierUpdateOne {
+	nuo.mutation.RemoveTemplateIDs(ids...)
+	return nuo
+}
+
+// RemoveTemplates removes "templates" edges to NotificationTemplate entities.
+func (nuo *NotifierUpdateOne) RemoveTemplates(n ...*NotificationTemplate) *NotifierUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.RemoveTemplateIDs(ids...)
+}
+
+// ClearDeliveries clears all "deliveries" edges to the NotifierDelivery entity.
+func (nuo *NotifierUpdateOne) ClearDeliveries() *NotifierUpdateOne {
+	nuo.mutation.ClearDeliveries()
+	return nuo
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to NotifierDelivery entities by IDs.
+func (nuo *NotifierUpdateOne) RemoveDeliveryIDs(ids ...uuid.UUID) *NotifierUpdateOne {
+	nuo.mutation.RemoveDeliveryIDs(ids...)
+	return nuo
+}
+
+// RemoveDeliveries removes "deliveries" edges to NotifierDelivery entities.
+func (nuo *NotifierUpdateOne) RemoveDeliveries(n ...*NotifierDelivery) *NotifierUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.RemoveDeliveryIDs(ids...)
+}
+
 // Where appends a list predicates to the NotifierUpdate builder.
 func (nuo *NotifierUpdateOne) Where(ps ...predicate.Notifier) *NotifierUpdateOne {
 	nuo.mutation.Where(ps...)
@@ -507,6 +791,12 @@ func (nuo *NotifierUpdateOne) sqlSave(ctx context.Context) (_node *Notifier, err
 	if value, ok := nuo.mutation.IsActive(); ok {
 		_spec.SetField(notifier.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := nuo.mutation.FailureCount(); ok {
+		_spec.SetField(notifier.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := nuo.mutation.AddedFailureCount(); ok {
+		_spec.AddField(notifier.FieldFailureCount, field.TypeInt, value)
+	}
 	if nuo.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -565,6 +855,96 @@ func (nuo *NotifierUpdateOne) sqlSave(ctx context.Context) (_node *Notifier, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if nuo.mutation.TemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notifier.TemplatesTable,
+			Columns: []string{notifier.TemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RemovedTemplatesIDs(); len(nodes) > 0 && !nuo.mutation.TemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notifier.TemplatesTable,
+			Columns: []string{notifier.TemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.TemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notifier.TemplatesTable,
+			Columns: []string{notifier.TemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationtemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notifier.DeliveriesTable,
+			Columns: []string{notifier.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifierdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !nuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notifier.DeliveriesTable,
+			Columns: []string{notifier.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifierdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notifier.DeliveriesTable,
+			Columns: []string{notifier.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifierdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Notifier{config: nuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
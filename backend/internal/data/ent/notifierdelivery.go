@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifierdelivery"
+)
+
+// NotifierDelivery is the model entity for the NotifierDelivery schema.
+type NotifierDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// NotifierID holds the value of the "notifier_id" field.
+	NotifierID uuid.UUID `json:"notifier_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// Status holds the value of the "status" field.
+	Status notifierdelivery.Status `json:"status,omitempty"`
+	// Attempt holds the value of the "attempt" field.
+	Attempt int `json:"attempt,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NotifierDeliveryQuery when eager-loading is set.
+	Edges        NotifierDeliveryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// NotifierDeliveryEdges holds the relations/edges for other nodes in the graph.
+type NotifierDeliveryEdges struct {
+	// Notifier holds the value of the notifier edge.
+	Notifier *Notifier `json:"notifier,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// NotifierOrErr returns the Notifier value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotifierDeliveryEdges) NotifierOrErr() (*Notifier, error) {
+	if e.loadedTypes[0] {
+		if e.Notifier == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: notifier.Label}
+		}
+		return e.Notifier, nil
+	}
+	return nil, &NotLoadedError{edge: "notifier"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotifierDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notifierdelivery.FieldAttempt:
+			values[i] = new(sql.NullInt64)
+		case notifierdelivery.FieldType, notifierdelivery.FieldStatus, notifierdelivery.FieldError:
+			values[i] = new(sql.NullString)
+		case notifierdelivery.FieldCreatedAt, notifierdelivery.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case notifierdelivery.FieldID, notifierdelivery.FieldNotifierID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotifierDelivery fields.
+func (nd *NotifierDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notifierdelivery.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				nd.ID = *value
+			}
+		case notifierdelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				nd.CreatedAt = value.Time
+			}
+		case notifierdelivery.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				nd.UpdatedAt = value.Time
+			}
+		case notifierdelivery.FieldNotifierID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field notifier_id", values[i])
+			} else if value != nil {
+				nd.NotifierID = *value
+			}
+		case notifierdelivery.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				nd.Type = value.String
+			}
+		case notifierdelivery.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				nd.Status = notifierdelivery.Status(value.String)
+			}
+		case notifierdelivery.FieldAttempt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt", values[i])
+			} else if value.Valid {
+				nd.Attempt = int(value.Int64)
+			}
+		case notifierdelivery.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				nd.Error = value.String
+			}
+		default:
+			nd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NotifierDelivery.
+// This includes values selected through modifiers, order, etc.
+func (nd *NotifierDelivery) Value(name string) (ent.Value, error) {
+	return nd.selectValues.Get(name)
+}
+
+// QueryNotifier queries the "notifier" edge of the NotifierDelivery entity.
+func (nd *NotifierDelivery) QueryNotifier() *NotifierQuery {
+	return NewNotifierDeliveryClient(nd.config).QueryNotifier(nd)
+}
+
+// Update returns a builder for updating this NotifierDelivery.
+// Note that you need to call NotifierDelivery.Unwrap() before calling this method if this NotifierDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (nd *NotifierDelivery) Update() *NotifierDeliveryUpdateOne {
+	return NewNotifierDeliveryClient(nd.config).UpdateOne(nd)
+}
+
+// Unwrap unwraps the NotifierDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (nd *NotifierDelivery) Unwrap() *NotifierDelivery {
+	_tx, ok := nd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NotifierDelivery is not a transactional entity")
+	}
+	nd.config.driver = _tx.drv
+	return nd
+}
+
+// String implements the fmt.Stringer.
+func (nd *NotifierDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotifierDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", nd.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(nd.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(nd.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("notifier_id=")
+	builder.WriteString(fmt.Sprintf("%v", nd.NotifierID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(nd.Type)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", nd.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempt=")
+	builder.WriteString(fmt.Sprintf("%v", nd.Attempt))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(nd.Error)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotifierDeliveries is a parsable slice of NotifierDelivery.
+type NotifierDeliveries []*NotifierDelivery
@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notifierdelivery
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NotifierID applies equality check predicate on the "notifier_id" field. It's identical to NotifierIDEQ.
+func NotifierID(v uuid.UUID) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldEQ(FieldNotifierID, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldEQ(FieldType, v))
+}
+
+// Attempt applies equality check predicate on the "attempt" field. It's identical to AttemptEQ.
+func Attempt(v int) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldEQ(FieldAttempt, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NotifierIDEQ applies the EQ predicate on the "notifier_id" field.
+func NotifierIDEQ(v uuid.UUID) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldEQ(FieldNotifierID, v))
+}
+
+// NotifierIDNEQ applies the NEQ predicate on the "notifier_id" field.
+func NotifierIDNEQ(v uuid.UUID) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldNEQ(FieldNotifierID, v))
+}
+
+// NotifierIDIn applies the In predicate on the "notifier_id" field.
+func NotifierIDIn(vs ...uuid.UUID) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldIn(FieldNotifierID, vs...))
+}
+
+// NotifierIDNotIn applies the NotIn predicate on the "notifier_id" field.
+func NotifierIDNotIn(vs ...uuid.UUID) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldNotIn(FieldNotifierID, vs...))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldContainsFold(FieldType, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// AttemptEQ applies the EQ predicate on the "attempt" field.
+func AttemptEQ(v int) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldEQ(FieldAttempt, v))
+}
+
+// AttemptNEQ applies the NEQ predicate on the "attempt" field.
+func AttemptNEQ(v int) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldNEQ(FieldAttempt, v))
+}
+
+// AttemptIn applies the In predicate on the "attempt" field.
+func AttemptIn(vs ...int) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldIn(FieldAttempt, vs...))
+}
+
+// AttemptNotIn applies the NotIn predicate on the "attempt" field.
+func AttemptNotIn(vs ...int) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldNotIn(FieldAttempt, vs...))
+}
+
+// AttemptGT applies the GT predicate on the "attempt" field.
+func AttemptGT(v int) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldGT(FieldAttempt, v))
+}
+
+// AttemptGTE applies the GTE predicate on the "attempt" field.
+func AttemptGTE(v int) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldGTE(FieldAttempt, v))
+}
+
+// AttemptLT applies the LT predicate on the "attempt" field.
+func AttemptLT(v int) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldLT(FieldAttempt, v))
+}
+
+// AttemptLTE applies the LTE predicate on the "attempt" field.
+func AttemptLTE(v int) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldLTE(FieldAttempt, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.FieldContainsFold(FieldError, v))
+}
+
+// HasNotifier applies the HasEdge predicate on the "notifier" edge.
+func HasNotifier() predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, NotifierTable, NotifierColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotifierWith applies the HasEdge predicate on the "notifier" edge with a given conditions (other predicates).
+func HasNotifierWith(preds ...predicate.Notifier) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(func(s *sql.Selector) {
+		step := newNotifierStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NotifierDelivery) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NotifierDelivery) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NotifierDelivery) predicate.NotifierDelivery {
+	return predicate.NotifierDelivery(sql.NotPredicates(p))
+}
@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifierdelivery"
+)
+
+// NotifierDeliveryCreate is the builder for creating a NotifierDelivery entity.
+type NotifierDeliveryCreate struct {
+	config
+	mutation *NotifierDeliveryMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ndc *NotifierDeliveryCreate) SetCreatedAt(t time.Time) *NotifierDeliveryCreate {
+	ndc.mutation.SetCreatedAt(t)
+	return ndc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ndc *NotifierDeliveryCreate) SetNillableCreatedAt(t *time.Time) *NotifierDeliveryCreate {
+	if t != nil {
+		ndc.SetCreatedAt(*t)
+	}
+	return ndc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ndc *NotifierDeliveryCreate) SetUpdatedAt(t time.Time) *NotifierDeliveryCreate {
+	ndc.mutation.SetUpdatedAt(t)
+	return ndc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ndc *NotifierDeliveryCreate) SetNillableUpdatedAt(t *time.Time) *NotifierDeliveryCreate {
+	if t != nil {
+		ndc.SetUpdatedAt(*t)
+	}
+	return ndc
+}
+
+// SetNotifierID sets the "notifier_id" field.
+func (ndc *NotifierDeliveryCreate) SetNotifierID(u uuid.UUID) *NotifierDeliveryCreate {
+	ndc.mutation.SetNotifierID(u)
+	return ndc
+}
+
+// SetType sets the "type" field.
+func (ndc *NotifierDeliveryCreate) SetType(s string) *NotifierDeliveryCreate {
+	ndc.mutation.SetType(s)
+	return ndc
+}
+
+// SetStatus sets the "status" field.
+func (ndc *NotifierDeliveryCreate) SetStatus(n notifierdelivery.Status) *NotifierDeliveryCreate {
+	ndc.mutation.SetStatus(n)
+	return ndc
+}
+
+// SetAttempt sets the "attempt" field.
+func (ndc *NotifierDeliveryCreate) SetAttempt(i int) *NotifierDeliveryCreate {
+	ndc.mutation.SetAttempt(i)
+	return ndc
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (ndc *NotifierDeliveryCreate) SetNillableAttempt(i *int) *NotifierDeliveryCreate {
+	if i != nil {
+		ndc.SetAttempt(*i)
+	}
+	return ndc
+}
+
+// SetError sets the "error" field.
+func (ndc *NotifierDeliveryCreate) SetError(s string) *NotifierDeliveryCreate {
+	ndc.mutation.SetError(s)
+	return ndc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ndc *NotifierDeliveryCreate) SetNillableError(s *string) *NotifierDeliveryCreate {
+	if s != nil {
+		ndc.SetError(*s)
+	}
+	return ndc
+}
+
+// SetID sets the "id" field.
+func (ndc *NotifierDeliveryCreate) SetID(u uuid.UUID) *NotifierDeliveryCreate {
+	ndc.mutation.SetID(u)
+	return ndc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ndc *NotifierDeliveryCreate) SetNillableID(u *uuid.UUID) *NotifierDeliveryCreate {
+	if u != nil {
+		ndc.SetID(*u)
+	}
+	return ndc
+}
+
+// SetNotifier sets the "notifier" edge to the Notifier entity.
+func (ndc *NotifierDeliveryCreate) SetNotifier(n *Notifier) *NotifierDeliveryCreate {
+	return ndc.SetNotifierID(n.ID)
+}
+
+// Mutation returns the NotifierDeliveryMutation object of the builder.
+func (ndc *NotifierDeliveryCreate) Mutation() *NotifierDeliveryMutation {
+	return ndc.mutation
+}
+
+// Save creates the NotifierDelivery in the database.
+func (ndc *NotifierDeliveryCreate) Save(ctx context.Context) (*NotifierDelivery, error) {
+	ndc.defaults()
+	return withHooks(ctx, ndc.sqlSave, ndc.mutation, ndc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ndc *NotifierDeliveryCreate) SaveX(ctx context.Context) *NotifierDelivery {
+	v, err := ndc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ndc *NotifierDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := ndc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndc *NotifierDeliveryCreate) ExecX(ctx context.Context) {
+	if err := ndc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ndc *NotifierDeliveryCreate) defaults() {
+	if _, ok := ndc.mutation.CreatedAt(); !ok {
+		v := notifierdelivery.DefaultCreatedAt()
+		ndc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ndc.mutation.UpdatedAt(); !ok {
+		v := notifierdelivery.DefaultUpdatedAt()
+		ndc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ndc.mutation.Attempt(); !ok {
+		v := notifierdelivery.DefaultAttempt
+		ndc.mutation.SetAttempt(v)
+	}
+	if _, ok := ndc.mutation.ID(); !ok {
+		v := notifierdelivery.DefaultID()
+		ndc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ndc *NotifierDeliveryCreate) check() error {
+	if _, ok := ndc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NotifierDelivery.created_at"`)}
+	}
+	if _, ok := ndc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NotifierDelivery.updated_at"`)}
+	}
+	if _, ok := ndc.mutation.NotifierID(); !ok {
+		return &ValidationError{Name: "notifier_id", err: errors.New(`ent: missing required field "NotifierDelivery.notifier_id"`)}
+	}
+	if _, ok := ndc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "NotifierDelivery.type"`)}
+	}
+	if v, ok := ndc.mutation.GetType(); ok {
+		if err := notifierdelivery.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "NotifierDelivery.type": %w`, err)}
+		}
+	}
+	if _, ok := ndc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "NotifierDelivery.status"`)}
+	}
+	if v, ok := ndc.mutation.Status(); ok {
+		if err := notifierdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "NotifierDelivery.status": %w`, err)}
+		}
+	}
+	if _, ok := ndc.mutation.Attempt(); !ok {
+		return &ValidationError{Name: "attempt", err: errors.New(`ent: missing required field "NotifierDelivery.attempt"`)}
+	}
+	if v, ok := ndc.mutation.Error(); ok {
+		if err := notifierdelivery.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "NotifierDelivery.error": %w`, err)}
+		}
+	}
+	if _, ok := ndc.mutation.NotifierID(); !ok {
+		return &ValidationError{Name: "notifier", err: errors.New(`ent: missing required edge "NotifierDelivery.notifier"`)}
+	}
+	return nil
+}
+
+func (ndc *NotifierDeliveryCreate) sqlSave(ctx context.Context) (*NotifierDelivery, error) {
+	if err := ndc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ndc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ndc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ndc.mutation.id = &_node.ID
+	ndc.mutation.done = true
+	return _node, nil
+}
+
+func (ndc *NotifierDeliveryCreate) createSpec() (*NotifierDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotifierDelivery{config: ndc.config}
+		_spec = sqlgraph.NewCreateSpec(notifierdelivery.Table, sqlgraph.NewFieldSpec(notifierdelivery.FieldID, field.TypeUUID))
+	)
+	if id, ok := ndc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ndc.mutation.CreatedAt(); ok {
+		_spec.SetField(notifierdelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ndc.mutation.UpdatedAt(); ok {
+		_spec.SetField(notifierdelivery.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ndc.mutation.GetType(); ok {
+		_spec.SetField(notifierdelivery.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := ndc.mutation.Status(); ok {
+		_spec.SetField(notifierdelivery.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ndc.mutation.Attempt(); ok {
+		_spec.SetField(notifierdelivery.FieldAttempt, field.TypeInt, value)
+		_node.Attempt = value
+	}
+	if value, ok := ndc.mutation.Error(); ok {
+		_spec.SetField(notifierdelivery.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if nodes := ndc.mutation.NotifierIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notifierdelivery.NotifierTable,
+			Columns: []string{notifierdelivery.NotifierColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.NotifierID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NotifierDeliveryCreateBulk is the builder for creating many NotifierDelivery entities in bulk.
+type NotifierDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*NotifierDeliveryCreate
+}
+
+// Save creates the NotifierDelivery entities in the database.
+func (ndcb *NotifierDeliveryCreateBulk) Save(ctx context.Context) ([]*NotifierDelivery, error) {
+	if ndcb.err != nil {
+		return nil, ndcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ndcb.builders))
+	nodes := make([]*NotifierDelivery, len(ndcb.builders))
+	mutators := make([]Mutator, len(ndcb.builders))
+	for i := range ndcb.builders {
+		func(i int, root context.Context) {
+			builder := ndcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotifierDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ndcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ndcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ndcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ndcb *NotifierDeliveryCreateBulk) SaveX(ctx context.Context) []*NotifierDelivery {
+	v, err := ndcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ndcb *NotifierDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := ndcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndcb *NotifierDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := ndcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifierdelivery"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// NotifierDeliveryDelete is the builder for deleting a NotifierDelivery entity.
+type NotifierDeliveryDelete struct {
+	config
+	hooks    []Hook
+	mutation *NotifierDeliveryMutation
+}
+
+// Where appends a list predicates to the NotifierDeliveryDelete builder.
+func (ndd *NotifierDeliveryDelete) Where(ps ...predicate.NotifierDelivery) *NotifierDeliveryDelete {
+	ndd.mutation.Where(ps...)
+	return ndd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ndd *NotifierDeliveryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ndd.sqlExec, ndd.mutation, ndd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndd *NotifierDeliveryDelete) ExecX(ctx context.Context) int {
+	n, err := ndd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ndd *NotifierDeliveryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(notifierdelivery.Table, sqlgraph.NewFieldSpec(notifierdelivery.FieldID, field.TypeUUID))
+	if ps := ndd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ndd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ndd.mutation.done = true
+	return affected, err
+}
+
+// NotifierDeliveryDeleteOne is the builder for deleting a single NotifierDelivery entity.
+type NotifierDeliveryDeleteOne struct {
+	ndd *NotifierDeliveryDelete
+}
+
+// Where appends a list predicates to the NotifierDeliveryDelete builder.
+func (nddo *NotifierDeliveryDeleteOne) Where(ps ...predicate.NotifierDelivery) *NotifierDeliveryDeleteOne {
+	nddo.ndd.mutation.Where(ps...)
+	return nddo
+}
+
+// Exec executes the deletion query.
+func (nddo *NotifierDeliveryDeleteOne) Exec(ctx context.Context) error {
+	n, err := nddo.ndd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{notifierdelivery.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nddo *NotifierDeliveryDeleteOne) ExecX(ctx context.Context) {
+	if err := nddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifierdelivery"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// NotifierDeliveryQuery is the builder for querying NotifierDelivery entities.
+type NotifierDeliveryQuery struct {
+	config
+	ctx          *QueryContext
+	order        []notifierdelivery.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.NotifierDelivery
+	withNotifier *NotifierQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NotifierDeliveryQuery builder.
+func (ndq *NotifierDeliveryQuery) Where(ps ...predicate.NotifierDelivery) *NotifierDeliveryQuery {
+	ndq.predicates = append(ndq.predicates, ps...)
+	return ndq
+}
+
+// Limit the number of records to be returned by this query.
+func (ndq *NotifierDeliveryQuery) Limit(limit int) *NotifierDeliveryQuery {
+	ndq.ctx.Limit = &limit
+	return ndq
+}
+
+// Offset to start from.
+func (ndq *NotifierDeliveryQuery) Offset(offset int) *NotifierDeliveryQuery {
+	ndq.ctx.Offset = &offset
+	return ndq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ndq *NotifierDeliveryQuery) Unique(unique bool) *NotifierDeliveryQuery {
+	ndq.ctx.Unique = &unique
+	return ndq
+}
+
+// Order specifies how the records should be ordered.
+func (ndq *NotifierDeliveryQuery) Order(o ...notifierdelivery.OrderOption) *NotifierDeliveryQuery {
+	ndq.order = append(ndq.order, o...)
+	return ndq
+}
+
+// QueryNotifier chains the current query on the "notifier" edge.
+func (ndq *NotifierDeliveryQuery) QueryNotifier() *NotifierQuery {
+	query := (&NotifierClient{config: ndq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ndq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ndq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notifierdelivery.Table, notifierdelivery.FieldID, selector),
+			sqlgraph.To(notifier.Table, notifier.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notifierdelivery.NotifierTable, notifierdelivery.NotifierColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ndq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first NotifierDelivery entity from the query.
+// Returns a *NotFoundError when no NotifierDelivery was found.
+func (ndq *NotifierDeliveryQuery) First(ctx context.Context) (*NotifierDelivery, error) {
+	nodes, err := ndq.Limit(1).All(setContextOp(ctx, ndq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{notifierdelivery.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ndq *NotifierDeliveryQuery) FirstX(ctx context.Context) *NotifierDelivery {
+	node, err := ndq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first NotifierDelivery ID from the query.
+// Returns a *NotFoundError when no NotifierDelivery ID was found.
+func (ndq *NotifierDeliveryQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ndq.Limit(1).IDs(setContextOp(ctx, ndq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{notifierdelivery.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ndq *NotifierDeliveryQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ndq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single NotifierDelivery entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one NotifierDelivery entity is found.
+// Returns a *NotFoundError when no NotifierDelivery entities are found.
+func (ndq *NotifierDeliveryQuery) Only(ctx context.Context) (*NotifierDelivery, error) {
+	nodes, err := ndq.Limit(2).All(setContextOp(ctx, ndq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{notifierdelivery.Label}
+	default:
+		return nil, &NotSingularError{notifierdelivery.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ndq *NotifierDeliveryQuery) OnlyX(ctx context.Context) *NotifierDelivery {
+	node, err := ndq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only NotifierDelivery ID in the query.
+// Returns a *NotSingularError when more than one NotifierDelivery ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ndq *NotifierDeliveryQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ndq.Limit(2).IDs(setContextOp(ctx, ndq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{notifierdelivery.Label}
+	default:
+		err = &NotSingularError{notifierdelivery.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ndq *NotifierDeliveryQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ndq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of NotifierDeliveries.
+func (ndq *NotifierDeliveryQuery) All(ctx context.Context) ([]*NotifierDelivery, error) {
+	ctx = setContextOp(ctx, ndq.ctx, "All")
+	if err := ndq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*NotifierDelivery, *NotifierDeliveryQuery]()
+	return withInterceptors[[]*NotifierDelivery](ctx, ndq, qr, ndq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ndq *NotifierDeliveryQuery) AllX(ctx context.Context) []*NotifierDelivery {
+	nodes, err := ndq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of NotifierDelivery IDs.
+func (ndq *NotifierDeliveryQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if ndq.ctx.Unique == nil && ndq.path != nil {
+		ndq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ndq.ctx, "IDs")
+	if err = ndq.Select(notifierdelivery.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ndq *NotifierDeliveryQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ndq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ndq *NotifierDeliveryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ndq.ctx, "Count")
+	if err := ndq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ndq, querierCount[*NotifierDeliveryQuery](), ndq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ndq *NotifierDeliveryQuery) CountX(ctx context.Context) int {
+	count, err := ndq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ndq *NotifierDeliveryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ndq.ctx, "Exist")
+	switch _, err := ndq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ndq *NotifierDeliveryQuery) ExistX(ctx context.Context) bool {
+	exist, err := ndq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NotifierDeliveryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ndq *NotifierDeliveryQuery) Clone() *NotifierDeliveryQuery {
+	if ndq == nil {
+		return nil
+	}
+	return &NotifierDeliveryQuery{
+		config:       ndq.config,
+		ctx:          ndq.ctx.Clone(),
+		order:        append([]notifierdelivery.OrderOption{}, ndq.order...),
+		inters:       append([]Interceptor{}, ndq.inters...),
+		predicates:   append([]predicate.NotifierDelivery{}, ndq.predicates...),
+		withNotifier: ndq.withNotifier.Clone(),
+		// clone intermediate query.
+		sql:  ndq.sql.Clone(),
+		path: ndq.path,
+	}
+}
+
+// WithNotifier tells the query-builder to eager-load the nodes that are connected to
+// the "notifier" edge. The optional arguments are used to configure the query builder of the edge.
+func (ndq *NotifierDeliveryQuery) WithNotifier(opts ...func(*NotifierQuery)) *NotifierDeliveryQuery {
+	query := (&NotifierClient{config: ndq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ndq.withNotifier = query
+	return ndq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.NotifierDelivery.Query().
+//		GroupBy(notifierdelivery.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ndq *NotifierDeliveryQuery) GroupBy(field string, fields ...string) *NotifierDeliveryGroupBy {
+	ndq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &NotifierDeliveryGroupBy{build: ndq}
+	grbuild.flds = &ndq.ctx.Fields
+	grbuild.label = notifierdelivery.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.NotifierDelivery.Query().
+//		Select(notifierdelivery.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ndq *NotifierDeliveryQuery) Select(fields ...string) *NotifierDeliverySelect {
+	ndq.ctx.Fields = append(ndq.ctx.Fields, fields...)
+	sbuild := &NotifierDeliverySelect{NotifierDeliveryQuery: ndq}
+	sbuild.label = notifierdelivery.Label
+	sbuild.flds, sbuild.scan = &ndq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a NotifierDeliverySelect configured with the given aggregations.
+func (ndq *NotifierDeliveryQuery) Aggregate(fns ...AggregateFunc) *NotifierDeliverySelect {
+	return ndq.Select().Aggregate(fns...)
+}
+
+func (ndq *NotifierDeliveryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ndq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ndq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ndq.ctx.Fields {
+		if !notifierdelivery.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ndq.path != nil {
+		prev, err := ndq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ndq.sql = prev
+	}
+	return nil
+}
+
+func (ndq *NotifierDeliveryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*NotifierDelivery, error) {
+	var (
+		nodes       = []*NotifierDelivery{}
+		_spec       = ndq.querySpec()
+		loadedTypes = [1]bool{
+			ndq.withNotifier != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*NotifierDelivery).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &NotifierDelivery{config: ndq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ndq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ndq.withNotifier; query != nil {
+		if err := ndq.loadNotifier(ctx, query, nodes, nil,
+			func(n *NotifierDelivery, e *Notifier) { n.Edges.Notifier = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ndq *NotifierDeliveryQuery) loadNotifier(ctx context.Context, query *NotifierQuery, nodes []*NotifierDelivery, init func(*NotifierDelivery), assign func(*NotifierDelivery, *Notifier)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*NotifierDelivery)
+	for i := range nodes {
+		fk := nodes[i].NotifierID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(notifier.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "notifier_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ndq *NotifierDeliveryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ndq.querySpec()
+	_spec.Node.Columns = ndq.ctx.Fields
+	if len(ndq.ctx.Fields) > 0 {
+		_spec.Unique = ndq.ctx.Unique != nil && *ndq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ndq.driver, _spec)
+}
+
+func (ndq *NotifierDeliveryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(notifierdelivery.Table, notifierdelivery.Columns, sqlgraph.NewFieldSpec(notifierdelivery.FieldID, field.TypeUUID))
+	_spec.From = ndq.sql
+	if unique := ndq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ndq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ndq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notifierdelivery.FieldID)
+		for i := range fields {
+			if fields[i] != notifierdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ndq.withNotifier != nil {
+			_spec.Node.AddColumnOnce(notifierdelivery.FieldNotifierID)
+		}
+	}
+	if ps := ndq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ndq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ndq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ndq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ndq *NotifierDeliveryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ndq.driver.Dialect())
+	t1 := builder.Table(notifierdelivery.Table)
+	columns := ndq.ctx.Fields
+	if len(columns) == 0 {
+		columns = notifierdelivery.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ndq.sql != nil {
+		selector = ndq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ndq.ctx.Unique != nil && *ndq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ndq.predicates {
+		p(selector)
+	}
+	for _, p := range ndq.order {
+		p(selector)
+	}
+	if offset := ndq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ndq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// NotifierDeliveryGroupBy is the group-by builder for NotifierDelivery entities.
+type NotifierDeliveryGroupBy struct {
+	selector
+	build *NotifierDeliveryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ndgb *NotifierDeliveryGroupBy) Aggregate(fns ...AggregateFunc) *NotifierDeliveryGroupBy {
+	ndgb.fns = append(ndgb.fns, fns...)
+	return ndgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ndgb *NotifierDeliveryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ndgb.build.ctx, "GroupBy")
+	if err := ndgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NotifierDeliveryQuery, *NotifierDeliveryGroupBy](ctx, ndgb.build, ndgb, ndgb.build.inters, v)
+}
+
+func (ndgb *NotifierDeliveryGroupBy) sqlScan(ctx context.Context, root *NotifierDeliveryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ndgb.fns))
+	for _, fn := range ndgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ndgb.flds)+len(ndgb.fns))
+		for _, f := range *ndgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ndgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ndgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// NotifierDeliverySelect is the builder for selecting fields of NotifierDelivery entities.
+type NotifierDeliverySelect struct {
+	*NotifierDeliveryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (nds *NotifierDeliverySelect) Aggregate(fns ...AggregateFunc) *NotifierDeliverySelect {
+	nds.fns = append(nds.fns, fns...)
+	return nds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nds *NotifierDeliverySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nds.ctx, "Select")
+	if err := nds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NotifierDeliveryQuery, *NotifierDeliverySelect](ctx, nds.NotifierDeliveryQuery, nds, nds.inters, v)
+}
+
+func (nds *NotifierDeliverySelect) sqlScan(ctx context.Context, root *NotifierDeliveryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(nds.fns))
+	for _, fn := range nds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*nds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
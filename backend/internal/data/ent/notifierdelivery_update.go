@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifierdelivery"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// NotifierDeliveryUpdate is the builder for updating NotifierDelivery entities.
+type NotifierDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotifierDeliveryMutation
+}
+
+// Where appends a list predicates to the NotifierDeliveryUpdate builder.
+func (ndu *NotifierDeliveryUpdate) Where(ps ...predicate.NotifierDelivery) *NotifierDeliveryUpdate {
+	ndu.mutation.Where(ps...)
+	return ndu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ndu *NotifierDeliveryUpdate) SetUpdatedAt(t time.Time) *NotifierDeliveryUpdate {
+	ndu.mutation.SetUpdatedAt(t)
+	return ndu
+}
+
+// SetNotifierID sets the "notifier_id" field.
+func (ndu *NotifierDeliveryUpdate) SetNotifierID(u uuid.UUID) *NotifierDeliveryUpdate {
+	ndu.mutation.SetNotifierID(u)
+	return ndu
+}
+
+// SetNillableNotifierID sets the "notifier_id" field if the given value is not nil.
+func (ndu *NotifierDeliveryUpdate) SetNillableNotifierID(u *uuid.UUID) *NotifierDeliveryUpdate {
+	if u != nil {
+		ndu.SetNotifierID(*u)
+	}
+	return ndu
+}
+
+// SetType sets the "type" field.
+func (ndu *NotifierDeliveryUpdate) SetType(s string) *NotifierDeliveryUpdate {
+	ndu.mutation.SetType(s)
+	return ndu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ndu *NotifierDeliveryUpdate) SetNillableType(s *string) *NotifierDeliveryUpdate {
+	if s != nil {
+		ndu.SetType(*s)
+	}
+	return ndu
+}
+
+// SetStatus sets the "status" field.
+func (ndu *NotifierDeliveryUpdate) SetStatus(n notifierdelivery.Status) *NotifierDeliveryUpdate {
+	ndu.mutation.SetStatus(n)
+	return ndu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ndu *NotifierDeliveryUpdate) SetNillableStatus(n *notifierdelivery.Status) *NotifierDeliveryUpdate {
+	if n != nil {
+		ndu.SetStatus(*n)
+	}
+	return ndu
+}
+
+// SetAttempt sets the "attempt" field.
+func (ndu *NotifierDeliveryUpdate) SetAttempt(i int) *NotifierDeliveryUpdate {
+	ndu.mutation.ResetAttempt()
+	ndu.mutation.SetAttempt(i)
+	return ndu
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (ndu *NotifierDeliveryUpdate) SetNillableAttempt(i *int) *NotifierDeliveryUpdate {
+	if i != nil {
+		ndu.SetAttempt(*i)
+	}
+	return ndu
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (ndu *NotifierDeliveryUpdate) AddAttempt(i int) *NotifierDeliveryUpdate {
+	ndu.mutation.AddAttempt(i)
+	return ndu
+}
+
+// SetError sets the "error" field.
+func (ndu *NotifierDeliveryUpdate) SetError(s string) *NotifierDeliveryUpdate {
+	ndu.mutation.SetError(s)
+	return ndu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ndu *NotifierDeliveryUpdate) SetNillableError(s *string) *NotifierDeliveryUpdate {
+	if s != nil {
+		ndu.SetError(*s)
+	}
+	return ndu
+}
+
+// ClearError clears the value of the "error" field.
+func (ndu *NotifierDeliveryUpdate) ClearError() *NotifierDeliveryUpdate {
+	ndu.mutation.ClearError()
+	return ndu
+}
+
+// SetNotifier sets the "notifier" edge to the Notifier entity.
+func (ndu *NotifierDeliveryUpdate) SetNotifier(n *Notifier) *NotifierDeliveryUpdate {
+	return ndu.SetNotifierID(n.ID)
+}
+
+// Mutation returns the NotifierDeliveryMutation object of the builder.
+func (ndu *NotifierDeliveryUpdate) Mutation() *NotifierDeliveryMutation {
+	return ndu.mutation
+}
+
+// ClearNotifier clears the "notifier" edge to the Notifier entity.
+func (ndu *NotifierDeliveryUpdate) ClearNotifier() *NotifierDeliveryUpdate {
+	ndu.mutation.ClearNotifier()
+	return ndu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ndu *NotifierDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	ndu.defaults()
+	return withHooks(ctx, ndu.sqlSave, ndu.mutation, ndu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ndu *NotifierDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := ndu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ndu *NotifierDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := ndu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndu *NotifierDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := ndu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ndu *NotifierDeliveryUpdate) defaults() {
+	if _, ok := ndu.mutation.UpdatedAt(); !ok {
+		v := notifierdelivery.UpdateDefaultUpdatedAt()
+		ndu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ndu *NotifierDeliveryUpdate) check() error {
+	if v, ok := ndu.mutation.GetType(); ok {
+		if err := notifierdelivery.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "NotifierDelivery.type": %w`, err)}
+		}
+	}
+	if v, ok := ndu.mutation.Status(); ok {
+		if err := notifierdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "NotifierDelivery.status": %w`, err)}
+		}
+	}
+	if v, ok := ndu.mutation.Error(); ok {
+		if err := notifierdelivery.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "NotifierDelivery.error": %w`, err)}
+		}
+	}
+	if _, ok := ndu.mutation.NotifierID(); ndu.mutation.NotifierCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "NotifierDelivery.notifier"`)
+	}
+	return nil
+}
+
+func (ndu *NotifierDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ndu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notifierdelivery.Table, notifierdelivery.Columns, sqlgraph.NewFieldSpec(notifierdelivery.FieldID, field.TypeUUID))
+	if ps := ndu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ndu.mutation.UpdatedAt(); ok {
+		_spec.SetField(notifierdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ndu.mutation.GetType(); ok {
+		_spec.SetField(notifierdelivery.FieldType, field.TypeString, value)
+	}
+	if value, ok := ndu.mutation.Status(); ok {
+		_spec.SetField(notifierdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ndu.mutation.Attempt(); ok {
+		_spec.SetField(notifierdelivery.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := ndu.mutation.AddedAttempt(); ok {
+		_spec.AddField(notifierdelivery.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := ndu.mutation.Error(); ok {
+		_spec.SetField(notifierdelivery.FieldError, field.TypeString, value)
+	}
+	if ndu.mutation.ErrorCleared() {
+		_spec.ClearField(notifierdelivery.FieldError, field.TypeString)
+	}
+	if ndu.mutation.NotifierCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notifierdelivery.NotifierTable,
+			Columns: []string{notifierdelivery.NotifierColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ndu.mutation.NotifierIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notifierdelivery.NotifierTable,
+			Columns: []string{notifierdelivery.NotifierColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ndu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notifierdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ndu.mutation.done = true
+	return n, nil
+}
+
+// NotifierDeliveryUpdateOne is the builder for updating a single NotifierDelivery entity.
+type NotifierDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotifierDeliveryMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nduo *NotifierDeliveryUpdateOne) SetUpdatedAt(t time.Time) *NotifierDeliveryUpdateOne {
+	nduo.mutation.SetUpdatedAt(t)
+	return nduo
+}
+
+// SetNotifierID sets the "notifier_id" field.
+func (nduo *NotifierDeliveryUpdateOne) SetNotifierID(u uuid.UUID) *NotifierDeliveryUpdateOne {
+	nduo.mutation.SetNotifierID(u)
+	return nduo
+}
+
+// SetNillableNotifierID sets the "notifier_id" field if the given value is not nil.
+func (nduo *NotifierDeliveryUpdateOne) SetNillableNotifierID(u *uuid.UUID) *NotifierDeliveryUpdateOne {
+	if u != nil {
+		nduo.SetNotifierID(*u)
+	}
+	return nduo
+}
+
+// SetType sets the "type" field.
+func (nduo *NotifierDeliveryUpdateOne) SetType(s string) *NotifierDeliveryUpdateOne {
+	nduo.mutation.SetType(s)
+	return nduo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (nduo *NotifierDeliveryUpdateOne) SetNillableType(s *string) *NotifierDeliveryUpdateOne {
+	if s != nil {
+		nduo.SetType(*s)
+	}
+	return nduo
+}
+
+// SetStatus sets the "status" field.
+func (nduo *NotifierDeliveryUpdateOne) SetStatus(n notifierdelivery.Status) *NotifierDeliveryUpdateOne {
+	nduo.mutation.SetStatus(n)
+	return nduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (nduo *NotifierDeliveryUpdateOne) SetNillableStatus(n *notifierdelivery.Status) *NotifierDeliveryUpdateOne {
+	if n != nil {
+		nduo.SetStatus(*n)
+	}
+	return nduo
+}
+
+// SetAttempt sets the "attempt" field.
+func (nduo *NotifierDeliveryUpdateOne) SetAttempt(i int) *NotifierDeliveryUpdateOne {
+	nduo.mutation.ResetAttempt()
+	nduo.mutation.SetAttempt(i)
+	return nduo
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (nduo *NotifierDeliveryUpdateOne) SetNillableAttempt(i *int) *NotifierDeliveryUpdateOne {
+	if i != nil {
+		nduo.SetAttempt(*i)
+	}
+	return nduo
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (nduo *NotifierDeliveryUpdateOne) AddAttempt(i int) *NotifierDeliveryUpdateOne {
+	nduo.mutation.AddAttempt(i)
+	return nduo
+}
+
+// SetError sets the "error" field.
+func (nduo *NotifierDeliveryUpdateOne) SetError(s string) *NotifierDeliveryUpdateOne {
+	nduo.mutation.SetError(s)
+	return nduo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (nduo *NotifierDeliveryUpdateOne) SetNillableError(s *string) *NotifierDeliveryUpdateOne {
+	if s != nil {
+		nduo.SetError(*s)
+	}
+	return nduo
+}
+
+// ClearError clears the value of the "error" field.
+func (nduo *NotifierDeliveryUpdateOne) ClearError() *NotifierDeliveryUpdateOne {
+	nduo.mutation.ClearError()
+	return nduo
+}
+
+// SetNotifier sets the "notifier" edge to the Notifier entity.
+func (nduo *NotifierDeliveryUpdateOne) SetNotifier(n *Notifier) *NotifierDeliveryUpdateOne {
+	return nduo.SetNotifierID(n.ID)
+}
+
+// Mutation returns the NotifierDeliveryMutation object of the builder.
+func (nduo *NotifierDeliveryUpdateOne) Mutation() *NotifierDeliveryMutation {
+	return nduo.mutation
+}
+
+// ClearNotifier clears the "notifier" edge to the Notifier entity.
+func (nduo *NotifierDeliveryUpdateOne) ClearNotifier() *NotifierDeliveryUpdateOne {
+	nduo.mutation.ClearNotifier()
+	return nduo
+}
+
+// Where appends a list predicates to the NotifierDeliveryUpdate builder.
+func (nduo *NotifierDeliveryUpdateOne) Where(ps ...predicate.NotifierDelivery) *NotifierDeliveryUpdateOne {
+	nduo.mutation.Where(ps...)
+	return nduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nduo *NotifierDeliveryUpdateOne) Select(field string, fields ...string) *NotifierDeliveryUpdateOne {
+	nduo.fields = append([]string{field}, fields...)
+	return nduo
+}
+
+// Save executes the query and returns the updated NotifierDelivery entity.
+func (nduo *NotifierDeliveryUpdateOne) Save(ctx context.Context) (*NotifierDelivery, error) {
+	nduo.defaults()
+	return withHooks(ctx, nduo.sqlSave, nduo.mutation, nduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nduo *NotifierDeliveryUpdateOne) SaveX(ctx context.Context) *NotifierDelivery {
+	node, err := nduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nduo *NotifierDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := nduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nduo *NotifierDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := nduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nduo *NotifierDeliveryUpdateOne) defaults() {
+	if _, ok := nduo.mutation.UpdatedAt(); !ok {
+		v := notifierdelivery.UpdateDefaultUpdatedAt()
+		nduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nduo *NotifierDeliveryUpdateOne) check() error {
+	if v, ok := nduo.mutation.GetType(); ok {
+		if err := notifierdelivery.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "NotifierDelivery.type": %w`, err)}
+		}
+	}
+	if v, ok := nduo.mutation.Status(); ok {
+		if err := notifierdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "NotifierDelivery.status": %w`, err)}
+		}
+	}
+	if v, ok := nduo.mutation.Error(); ok {
+		if err := notifierdelivery.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "NotifierDelivery.error": %w`, err)}
+		}
+	}
+	if _, ok := nduo.mutation.NotifierID(); nduo.mutation.NotifierCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "NotifierDelivery.notifier"`)
+	}
+	return nil
+}
+
+func (nduo *NotifierDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *NotifierDelivery, err error) {
+	if err := nduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notifierdelivery.Table, notifierdelivery.Columns, sqlgraph.NewFieldSpec(notifierdelivery.FieldID, field.TypeUUID))
+	id, ok := nduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotifierDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notifierdelivery.FieldID)
+		for _, f := range fields {
+			if !notifierdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notifierdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(notifierdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := nduo.mutation.GetType(); ok {
+		_spec.SetField(notifierdelivery.FieldType, field.TypeString, value)
+	}
+	if value, ok := nduo.mutation.Status(); ok {
+		_spec.SetField(notifierdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := nduo.mutation.Attempt(); ok {
+		_spec.SetField(notifierdelivery.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := nduo.mutation.AddedAttempt(); ok {
+		_spec.AddField(notifierdelivery.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := nduo.mutation.Error(); ok {
+		_spec.SetField(notifierdelivery.FieldError, field.TypeString, value)
+	}
+	if nduo.mutation.ErrorCleared() {
+		_spec.ClearField(notifierdelivery.FieldError, field.TypeString)
+	}
+	if nduo.mutation.NotifierCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notifierdelivery.NotifierTable,
+			Columns: []string{notifierdelivery.NotifierColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nduo.mutation.NotifierIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notifierdelivery.NotifierTable,
+			Columns: []string{notifierdelivery.NotifierColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NotifierDelivery{config: nduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notifierdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nduo.mutation.done = true
+	return _node, nil
+}
@@ -7,18 +7,28 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/auditentry"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/document"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/groupinvitationtoken"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/itemfield"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationreminder"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationrule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationtemplate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifierdelivery"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/schema"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/usertoken"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/webauthncredential"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -48,6 +58,29 @@ func init() {
 	attachmentDescID := attachmentMixinFields0[0].Descriptor()
 	// attachment.DefaultID holds the default value on creation for the id field.
 	attachment.DefaultID = attachmentDescID.Default.(func() uuid.UUID)
+	auditentryMixin := schema.AuditEntry{}.Mixin()
+	auditentryMixinFields0 := auditentryMixin[0].Fields()
+	_ = auditentryMixinFields0
+	auditentryFields := schema.AuditEntry{}.Fields()
+	_ = auditentryFields
+	// auditentryDescCreatedAt is the schema descriptor for created_at field.
+	auditentryDescCreatedAt := auditentryMixinFields0[1].Descriptor()
+	// auditentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditentry.DefaultCreatedAt = auditentryDescCreatedAt.Default.(func() time.Time)
+	// auditentryDescUpdatedAt is the schema descriptor for updated_at field.
+	auditentryDescUpdatedAt := auditentryMixinFields0[2].Descriptor()
+	// auditentry.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	auditentry.DefaultUpdatedAt = auditentryDescUpdatedAt.Default.(func() time.Time)
+	// auditentry.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	auditentry.UpdateDefaultUpdatedAt = auditentryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// auditentryDescEntityName is the schema descriptor for entity_name field.
+	auditentryDescEntityName := auditentryFields[3].Descriptor()
+	// auditentry.EntityNameValidator is a validator for the "entity_name" field. It is called by the builders before save.
+	auditentry.EntityNameValidator = auditentryDescEntityName.Validators[0].(func(string) error)
+	// auditentryDescID is the schema descriptor for id field.
+	auditentryDescID := auditentryMixinFields0[0].Descriptor()
+	// auditentry.DefaultID holds the default value on creation for the id field.
+	auditentry.DefaultID = auditentryDescID.Default.(func() uuid.UUID)
 	authrolesFields := schema.AuthRoles{}.Fields()
 	_ = authrolesFields
 	authtokensMixin := schema.AuthTokens{}.Mixin()
@@ -69,6 +102,18 @@ func init() {
 	authtokensDescExpiresAt := authtokensFields[1].Descriptor()
 	// authtokens.DefaultExpiresAt holds the default value on creation for the expires_at field.
 	authtokens.DefaultExpiresAt = authtokensDescExpiresAt.Default.(func() time.Time)
+	// authtokensDescName is the schema descriptor for name field.
+	authtokensDescName := authtokensFields[2].Descriptor()
+	// authtokens.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	authtokens.NameValidator = authtokensDescName.Validators[0].(func(string) error)
+	// authtokensDescIPAddress is the schema descriptor for ip_address field.
+	authtokensDescIPAddress := authtokensFields[5].Descriptor()
+	// authtokens.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	authtokens.IPAddressValidator = authtokensDescIPAddress.Validators[0].(func(string) error)
+	// authtokensDescUserAgent is the schema descriptor for user_agent field.
+	authtokensDescUserAgent := authtokensFields[6].Descriptor()
+	// authtokens.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	authtokens.UserAgentValidator = authtokensDescUserAgent.Validators[0].(func(string) error)
 	// authtokensDescID is the schema descriptor for id field.
 	authtokensDescID := authtokensMixinFields0[0].Descriptor()
 	// authtokens.DefaultID holds the default value on creation for the id field.
@@ -165,6 +210,16 @@ func init() {
 	groupDescCurrency := groupFields[1].Descriptor()
 	// group.DefaultCurrency holds the default value on creation for the currency field.
 	group.DefaultCurrency = groupDescCurrency.Default.(string)
+	// groupDescTimezone is the schema descriptor for timezone field.
+	groupDescTimezone := groupFields[2].Descriptor()
+	// group.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	group.TimezoneValidator = groupDescTimezone.Validators[0].(func(string) error)
+	// groupDescNotifyTime is the schema descriptor for notify_time field.
+	groupDescNotifyTime := groupFields[3].Descriptor()
+	// group.DefaultNotifyTime holds the default value on creation for the notify_time field.
+	group.DefaultNotifyTime = groupDescNotifyTime.Default.(string)
+	// group.NotifyTimeValidator is a validator for the "notify_time" field. It is called by the builders before save.
+	group.NotifyTimeValidator = groupDescNotifyTime.Validators[0].(func(string) error)
 	// groupDescID is the schema descriptor for id field.
 	groupDescID := groupMixinFields0[0].Descriptor()
 	// group.DefaultID holds the default value on creation for the id field.
@@ -196,6 +251,25 @@ func init() {
 	groupinvitationtokenDescID := groupinvitationtokenMixinFields0[0].Descriptor()
 	// groupinvitationtoken.DefaultID holds the default value on creation for the id field.
 	groupinvitationtoken.DefaultID = groupinvitationtokenDescID.Default.(func() uuid.UUID)
+	groupmembershipMixin := schema.GroupMembership{}.Mixin()
+	groupmembershipMixinFields0 := groupmembershipMixin[0].Fields()
+	_ = groupmembershipMixinFields0
+	groupmembershipFields := schema.GroupMembership{}.Fields()
+	_ = groupmembershipFields
+	// groupmembershipDescCreatedAt is the schema descriptor for created_at field.
+	groupmembershipDescCreatedAt := groupmembershipMixinFields0[1].Descriptor()
+	// groupmembership.DefaultCreatedAt holds the default value on creation for the created_at field.
+	groupmembership.DefaultCreatedAt = groupmembershipDescCreatedAt.Default.(func() time.Time)
+	// groupmembershipDescUpdatedAt is the schema descriptor for updated_at field.
+	groupmembershipDescUpdatedAt := groupmembershipMixinFields0[2].Descriptor()
+	// groupmembership.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	groupmembership.DefaultUpdatedAt = groupmembershipDescUpdatedAt.Default.(func() time.Time)
+	// groupmembership.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	groupmembership.UpdateDefaultUpdatedAt = groupmembershipDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// groupmembershipDescID is the schema descriptor for id field.
+	groupmembershipDescID := groupmembershipMixinFields0[0].Descriptor()
+	// groupmembership.DefaultID holds the default value on creation for the id field.
+	groupmembership.DefaultID = groupmembershipDescID.Default.(func() uuid.UUID)
 	itemMixin := schema.Item{}.Mixin()
 	itemMixinFields0 := itemMixin[0].Fields()
 	_ = itemMixinFields0
@@ -485,6 +559,180 @@ func init() {
 	maintenanceentryDescID := maintenanceentryMixinFields0[0].Descriptor()
 	// maintenanceentry.DefaultID holds the default value on creation for the id field.
 	maintenanceentry.DefaultID = maintenanceentryDescID.Default.(func() uuid.UUID)
+	maintenancescheduleMixin := schema.MaintenanceSchedule{}.Mixin()
+	maintenancescheduleMixinFields0 := maintenancescheduleMixin[0].Fields()
+	_ = maintenancescheduleMixinFields0
+	maintenancescheduleFields := schema.MaintenanceSchedule{}.Fields()
+	_ = maintenancescheduleFields
+	// maintenancescheduleDescCreatedAt is the schema descriptor for created_at field.
+	maintenancescheduleDescCreatedAt := maintenancescheduleMixinFields0[1].Descriptor()
+	// maintenanceschedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	maintenanceschedule.DefaultCreatedAt = maintenancescheduleDescCreatedAt.Default.(func() time.Time)
+	// maintenancescheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	maintenancescheduleDescUpdatedAt := maintenancescheduleMixinFields0[2].Descriptor()
+	// maintenanceschedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	maintenanceschedule.DefaultUpdatedAt = maintenancescheduleDescUpdatedAt.Default.(func() time.Time)
+	// maintenanceschedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	maintenanceschedule.UpdateDefaultUpdatedAt = maintenancescheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// maintenancescheduleDescName is the schema descriptor for name field.
+	maintenancescheduleDescName := maintenancescheduleFields[1].Descriptor()
+	// maintenanceschedule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	maintenanceschedule.NameValidator = func() func(string) error {
+		validators := maintenancescheduleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// maintenancescheduleDescDescription is the schema descriptor for description field.
+	maintenancescheduleDescDescription := maintenancescheduleFields[2].Descriptor()
+	// maintenanceschedule.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	maintenanceschedule.DescriptionValidator = maintenancescheduleDescDescription.Validators[0].(func(string) error)
+	// maintenancescheduleDescRule is the schema descriptor for rule field.
+	maintenancescheduleDescRule := maintenancescheduleFields[3].Descriptor()
+	// maintenanceschedule.RuleValidator is a validator for the "rule" field. It is called by the builders before save.
+	maintenanceschedule.RuleValidator = func() func(string) error {
+		validators := maintenancescheduleDescRule.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(rule string) error {
+			for _, fn := range fns {
+				if err := fn(rule); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// maintenancescheduleDescNotifyDaysBefore is the schema descriptor for notify_days_before field.
+	maintenancescheduleDescNotifyDaysBefore := maintenancescheduleFields[4].Descriptor()
+	// maintenanceschedule.DefaultNotifyDaysBefore holds the default value on creation for the notify_days_before field.
+	maintenanceschedule.DefaultNotifyDaysBefore = maintenancescheduleDescNotifyDaysBefore.Default.(int)
+	// maintenanceschedule.NotifyDaysBeforeValidator is a validator for the "notify_days_before" field. It is called by the builders before save.
+	maintenanceschedule.NotifyDaysBeforeValidator = maintenancescheduleDescNotifyDaysBefore.Validators[0].(func(int) error)
+	// maintenancescheduleDescID is the schema descriptor for id field.
+	maintenancescheduleDescID := maintenancescheduleMixinFields0[0].Descriptor()
+	// maintenanceschedule.DefaultID holds the default value on creation for the id field.
+	maintenanceschedule.DefaultID = maintenancescheduleDescID.Default.(func() uuid.UUID)
+	notificationreminderMixin := schema.NotificationReminder{}.Mixin()
+	notificationreminderMixinFields0 := notificationreminderMixin[0].Fields()
+	_ = notificationreminderMixinFields0
+	notificationreminderFields := schema.NotificationReminder{}.Fields()
+	_ = notificationreminderFields
+	// notificationreminderDescCreatedAt is the schema descriptor for created_at field.
+	notificationreminderDescCreatedAt := notificationreminderMixinFields0[1].Descriptor()
+	// notificationreminder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationreminder.DefaultCreatedAt = notificationreminderDescCreatedAt.Default.(func() time.Time)
+	// notificationreminderDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationreminderDescUpdatedAt := notificationreminderMixinFields0[2].Descriptor()
+	// notificationreminder.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationreminder.DefaultUpdatedAt = notificationreminderDescUpdatedAt.Default.(func() time.Time)
+	// notificationreminder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationreminder.UpdateDefaultUpdatedAt = notificationreminderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationreminderDescTarget is the schema descriptor for target field.
+	notificationreminderDescTarget := notificationreminderFields[2].Descriptor()
+	// notificationreminder.TargetValidator is a validator for the "target" field. It is called by the builders before save.
+	notificationreminder.TargetValidator = notificationreminderDescTarget.Validators[0].(func(string) error)
+	// notificationreminderDescTitle is the schema descriptor for title field.
+	notificationreminderDescTitle := notificationreminderFields[3].Descriptor()
+	// notificationreminder.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	notificationreminder.TitleValidator = notificationreminderDescTitle.Validators[0].(func(string) error)
+	// notificationreminderDescID is the schema descriptor for id field.
+	notificationreminderDescID := notificationreminderMixinFields0[0].Descriptor()
+	// notificationreminder.DefaultID holds the default value on creation for the id field.
+	notificationreminder.DefaultID = notificationreminderDescID.Default.(func() uuid.UUID)
+	notificationruleMixin := schema.NotificationRule{}.Mixin()
+	notificationruleMixinFields0 := notificationruleMixin[0].Fields()
+	_ = notificationruleMixinFields0
+	notificationruleFields := schema.NotificationRule{}.Fields()
+	_ = notificationruleFields
+	// notificationruleDescCreatedAt is the schema descriptor for created_at field.
+	notificationruleDescCreatedAt := notificationruleMixinFields0[1].Descriptor()
+	// notificationrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationrule.DefaultCreatedAt = notificationruleDescCreatedAt.Default.(func() time.Time)
+	// notificationruleDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationruleDescUpdatedAt := notificationruleMixinFields0[2].Descriptor()
+	// notificationrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationrule.DefaultUpdatedAt = notificationruleDescUpdatedAt.Default.(func() time.Time)
+	// notificationrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationrule.UpdateDefaultUpdatedAt = notificationruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationruleDescName is the schema descriptor for name field.
+	notificationruleDescName := notificationruleFields[0].Descriptor()
+	// notificationrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	notificationrule.NameValidator = func() func(string) error {
+		validators := notificationruleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// notificationruleDescCustomField is the schema descriptor for custom_field field.
+	notificationruleDescCustomField := notificationruleFields[2].Descriptor()
+	// notificationrule.CustomFieldValidator is a validator for the "custom_field" field. It is called by the builders before save.
+	notificationrule.CustomFieldValidator = notificationruleDescCustomField.Validators[0].(func(string) error)
+	// notificationruleDescIsActive is the schema descriptor for is_active field.
+	notificationruleDescIsActive := notificationruleFields[4].Descriptor()
+	// notificationrule.DefaultIsActive holds the default value on creation for the is_active field.
+	notificationrule.DefaultIsActive = notificationruleDescIsActive.Default.(bool)
+	// notificationruleDescID is the schema descriptor for id field.
+	notificationruleDescID := notificationruleMixinFields0[0].Descriptor()
+	// notificationrule.DefaultID holds the default value on creation for the id field.
+	notificationrule.DefaultID = notificationruleDescID.Default.(func() uuid.UUID)
+	notificationtemplateMixin := schema.NotificationTemplate{}.Mixin()
+	notificationtemplateMixinFields0 := notificationtemplateMixin[0].Fields()
+	_ = notificationtemplateMixinFields0
+	notificationtemplateFields := schema.NotificationTemplate{}.Fields()
+	_ = notificationtemplateFields
+	// notificationtemplateDescCreatedAt is the schema descriptor for created_at field.
+	notificationtemplateDescCreatedAt := notificationtemplateMixinFields0[1].Descriptor()
+	// notificationtemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationtemplate.DefaultCreatedAt = notificationtemplateDescCreatedAt.Default.(func() time.Time)
+	// notificationtemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationtemplateDescUpdatedAt := notificationtemplateMixinFields0[2].Descriptor()
+	// notificationtemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationtemplate.DefaultUpdatedAt = notificationtemplateDescUpdatedAt.Default.(func() time.Time)
+	// notificationtemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationtemplate.UpdateDefaultUpdatedAt = notificationtemplateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationtemplateDescBody is the schema descriptor for body field.
+	notificationtemplateDescBody := notificationtemplateFields[2].Descriptor()
+	// notificationtemplate.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	notificationtemplate.BodyValidator = func() func(string) error {
+		validators := notificationtemplateDescBody.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(body string) error {
+			for _, fn := range fns {
+				if err := fn(body); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// notificationtemplateDescID is the schema descriptor for id field.
+	notificationtemplateDescID := notificationtemplateMixinFields0[0].Descriptor()
+	// notificationtemplate.DefaultID holds the default value on creation for the id field.
+	notificationtemplate.DefaultID = notificationtemplateDescID.Default.(func() uuid.UUID)
 	notifierMixin := schema.Notifier{}.Mixin()
 	notifierMixinFields0 := notifierMixin[0].Fields()
 	_ = notifierMixinFields0
@@ -540,10 +788,88 @@ func init() {
 	notifierDescIsActive := notifierFields[2].Descriptor()
 	// notifier.DefaultIsActive holds the default value on creation for the is_active field.
 	notifier.DefaultIsActive = notifierDescIsActive.Default.(bool)
+	// notifierDescFailureCount is the schema descriptor for failure_count field.
+	notifierDescFailureCount := notifierFields[3].Descriptor()
+	// notifier.DefaultFailureCount holds the default value on creation for the failure_count field.
+	notifier.DefaultFailureCount = notifierDescFailureCount.Default.(int)
 	// notifierDescID is the schema descriptor for id field.
 	notifierDescID := notifierMixinFields0[0].Descriptor()
 	// notifier.DefaultID holds the default value on creation for the id field.
 	notifier.DefaultID = notifierDescID.Default.(func() uuid.UUID)
+	notifierdeliveryMixin := schema.NotifierDelivery{}.Mixin()
+	notifierdeliveryMixinFields0 := notifierdeliveryMixin[0].Fields()
+	_ = notifierdeliveryMixinFields0
+	notifierdeliveryFields := schema.NotifierDelivery{}.Fields()
+	_ = notifierdeliveryFields
+	// notifierdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	notifierdeliveryDescCreatedAt := notifierdeliveryMixinFields0[1].Descriptor()
+	// notifierdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notifierdelivery.DefaultCreatedAt = notifierdeliveryDescCreatedAt.Default.(func() time.Time)
+	// notifierdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	notifierdeliveryDescUpdatedAt := notifierdeliveryMixinFields0[2].Descriptor()
+	// notifierdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notifierdelivery.DefaultUpdatedAt = notifierdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// notifierdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notifierdelivery.UpdateDefaultUpdatedAt = notifierdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notifierdeliveryDescType is the schema descriptor for type field.
+	notifierdeliveryDescType := notifierdeliveryFields[1].Descriptor()
+	// notifierdelivery.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	notifierdelivery.TypeValidator = notifierdeliveryDescType.Validators[0].(func(string) error)
+	// notifierdeliveryDescAttempt is the schema descriptor for attempt field.
+	notifierdeliveryDescAttempt := notifierdeliveryFields[3].Descriptor()
+	// notifierdelivery.DefaultAttempt holds the default value on creation for the attempt field.
+	notifierdelivery.DefaultAttempt = notifierdeliveryDescAttempt.Default.(int)
+	// notifierdeliveryDescError is the schema descriptor for error field.
+	notifierdeliveryDescError := notifierdeliveryFields[4].Descriptor()
+	// notifierdelivery.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	notifierdelivery.ErrorValidator = notifierdeliveryDescError.Validators[0].(func(string) error)
+	// notifierdeliveryDescID is the schema descriptor for id field.
+	notifierdeliveryDescID := notifierdeliveryMixinFields0[0].Descriptor()
+	// notifierdelivery.DefaultID holds the default value on creation for the id field.
+	notifierdelivery.DefaultID = notifierdeliveryDescID.Default.(func() uuid.UUID)
+	savedsearchMixin := schema.SavedSearch{}.Mixin()
+	savedsearchMixinFields0 := savedsearchMixin[0].Fields()
+	_ = savedsearchMixinFields0
+	savedsearchMixinFields1 := savedsearchMixin[1].Fields()
+	_ = savedsearchMixinFields1
+	savedsearchFields := schema.SavedSearch{}.Fields()
+	_ = savedsearchFields
+	// savedsearchDescCreatedAt is the schema descriptor for created_at field.
+	savedsearchDescCreatedAt := savedsearchMixinFields0[1].Descriptor()
+	// savedsearch.DefaultCreatedAt holds the default value on creation for the created_at field.
+	savedsearch.DefaultCreatedAt = savedsearchDescCreatedAt.Default.(func() time.Time)
+	// savedsearchDescUpdatedAt is the schema descriptor for updated_at field.
+	savedsearchDescUpdatedAt := savedsearchMixinFields0[2].Descriptor()
+	// savedsearch.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	savedsearch.DefaultUpdatedAt = savedsearchDescUpdatedAt.Default.(func() time.Time)
+	// savedsearch.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	savedsearch.UpdateDefaultUpdatedAt = savedsearchDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// savedsearchDescName is the schema descriptor for name field.
+	savedsearchDescName := savedsearchMixinFields1[0].Descriptor()
+	// savedsearch.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	savedsearch.NameValidator = func() func(string) error {
+		validators := savedsearchDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// savedsearchDescDescription is the schema descriptor for description field.
+	savedsearchDescDescription := savedsearchMixinFields1[1].Descriptor()
+	// savedsearch.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	savedsearch.DescriptionValidator = savedsearchDescDescription.Validators[0].(func(string) error)
+	// savedsearchDescID is the schema descriptor for id field.
+	savedsearchDescID := savedsearchMixinFields0[0].Descriptor()
+	// savedsearch.DefaultID holds the default value on creation for the id field.
+	savedsearch.DefaultID = savedsearchDescID.Default.(func() uuid.UUID)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -621,8 +947,92 @@ func init() {
 	userDescSuperuser := userFields[4].Descriptor()
 	// user.DefaultSuperuser holds the default value on creation for the superuser field.
 	user.DefaultSuperuser = userDescSuperuser.Default.(bool)
+	// userDescTotpSecret is the schema descriptor for totp_secret field.
+	userDescTotpSecret := userFields[7].Descriptor()
+	// user.TotpSecretValidator is a validator for the "totp_secret" field. It is called by the builders before save.
+	user.TotpSecretValidator = userDescTotpSecret.Validators[0].(func(string) error)
+	// userDescTotpEnabled is the schema descriptor for totp_enabled field.
+	userDescTotpEnabled := userFields[8].Descriptor()
+	// user.DefaultTotpEnabled holds the default value on creation for the totp_enabled field.
+	user.DefaultTotpEnabled = userDescTotpEnabled.Default.(bool)
+	// userDescTotpLastStep is the schema descriptor for totp_last_step field.
+	userDescTotpLastStep := userFields[9].Descriptor()
+	// user.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	user.DefaultTotpLastStep = userDescTotpLastStep.Default.(int64)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userMixinFields0[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	usertokenMixin := schema.UserToken{}.Mixin()
+	usertokenMixinFields0 := usertokenMixin[0].Fields()
+	_ = usertokenMixinFields0
+	usertokenFields := schema.UserToken{}.Fields()
+	_ = usertokenFields
+	// usertokenDescCreatedAt is the schema descriptor for created_at field.
+	usertokenDescCreatedAt := usertokenMixinFields0[1].Descriptor()
+	// usertoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usertoken.DefaultCreatedAt = usertokenDescCreatedAt.Default.(func() time.Time)
+	// usertokenDescUpdatedAt is the schema descriptor for updated_at field.
+	usertokenDescUpdatedAt := usertokenMixinFields0[2].Descriptor()
+	// usertoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	usertoken.DefaultUpdatedAt = usertokenDescUpdatedAt.Default.(func() time.Time)
+	// usertoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	usertoken.UpdateDefaultUpdatedAt = usertokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// usertokenDescAttempts is the schema descriptor for attempts field.
+	usertokenDescAttempts := usertokenFields[3].Descriptor()
+	// usertoken.DefaultAttempts holds the default value on creation for the attempts field.
+	usertoken.DefaultAttempts = usertokenDescAttempts.Default.(int)
+	// usertokenDescID is the schema descriptor for id field.
+	usertokenDescID := usertokenMixinFields0[0].Descriptor()
+	// usertoken.DefaultID holds the default value on creation for the id field.
+	usertoken.DefaultID = usertokenDescID.Default.(func() uuid.UUID)
+	webauthncredentialMixin := schema.WebAuthnCredential{}.Mixin()
+	webauthncredentialMixinFields0 := webauthncredentialMixin[0].Fields()
+	_ = webauthncredentialMixinFields0
+	webauthncredentialFields := schema.WebAuthnCredential{}.Fields()
+	_ = webauthncredentialFields
+	// webauthncredentialDescCreatedAt is the schema descriptor for created_at field.
+	webauthncredentialDescCreatedAt := webauthncredentialMixinFields0[1].Descriptor()
+	// webauthncredential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webauthncredential.DefaultCreatedAt = webauthncredentialDescCreatedAt.Default.(func() time.Time)
+	// webauthncredentialDescUpdatedAt is the schema descriptor for updated_at field.
+	webauthncredentialDescUpdatedAt := webauthncredentialMixinFields0[2].Descriptor()
+	// webauthncredential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webauthncredential.DefaultUpdatedAt = webauthncredentialDescUpdatedAt.Default.(func() time.Time)
+	// webauthncredential.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webauthncredential.UpdateDefaultUpdatedAt = webauthncredentialDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webauthncredentialDescName is the schema descriptor for name field.
+	webauthncredentialDescName := webauthncredentialFields[0].Descriptor()
+	// webauthncredential.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	webauthncredential.NameValidator = func() func(string) error {
+		validators := webauthncredentialDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webauthncredentialDescSignCount is the schema descriptor for sign_count field.
+	webauthncredentialDescSignCount := webauthncredentialFields[6].Descriptor()
+	// webauthncredential.DefaultSignCount holds the default value on creation for the sign_count field.
+	webauthncredential.DefaultSignCount = webauthncredentialDescSignCount.Default.(uint32)
+	// webauthncredentialDescBackupEligible is the schema descriptor for backup_eligible field.
+	webauthncredentialDescBackupEligible := webauthncredentialFields[7].Descriptor()
+	// webauthncredential.DefaultBackupEligible holds the default value on creation for the backup_eligible field.
+	webauthncredential.DefaultBackupEligible = webauthncredentialDescBackupEligible.Default.(bool)
+	// webauthncredentialDescBackupState is the schema descriptor for backup_state field.
+	webauthncredentialDescBackupState := webauthncredentialFields[8].Descriptor()
+	// webauthncredential.DefaultBackupState holds the default value on creation for the backup_state field.
+	webauthncredential.DefaultBackupState = webauthncredentialDescBackupState.Default.(bool)
+	// webauthncredentialDescID is the schema descriptor for id field.
+	webauthncredentialDescID := webauthncredentialMixinFields0[0].Descriptor()
+	// webauthncredential.DefaultID holds the default value on creation for the id field.
+	webauthncredential.DefaultID = webauthncredentialDescID.Default.(func() uuid.UUID)
 }
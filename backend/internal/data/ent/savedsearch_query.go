@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
+)
+
+// SavedSearchQuery is the builder for querying SavedSearch entities.
+type SavedSearchQuery struct {
+	config
+	ctx        *QueryContext
+	order      []savedsearch.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SavedSearch
+	withGroup  *GroupQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SavedSearchQuery builder.
+func (ssq *SavedSearchQuery) Where(ps ...predicate.SavedSearch) *SavedSearchQuery {
+	ssq.predicates = append(ssq.predicates, ps...)
+	return ssq
+}
+
+// Limit the number of records to be returned by this query.
+func (ssq *SavedSearchQuery) Limit(limit int) *SavedSearchQuery {
+	ssq.ctx.Limit = &limit
+	return ssq
+}
+
+// Offset to start from.
+func (ssq *SavedSearchQuery) Offset(offset int) *SavedSearchQuery {
+	ssq.ctx.Offset = &offset
+	return ssq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ssq *SavedSearchQuery) Unique(unique bool) *SavedSearchQuery {
+	ssq.ctx.Unique = &unique
+	return ssq
+}
+
+// Order specifies how the records should be ordered.
+func (ssq *SavedSearchQuery) Order(o ...savedsearch.OrderOption) *SavedSearchQuery {
+	ssq.order = append(ssq.order, o...)
+	return ssq
+}
+
+// QueryGroup chains the current query on the "group" edge.
+func (ssq *SavedSearchQuery) QueryGroup() *GroupQuery {
+	query := (&GroupClient{config: ssq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ssq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ssq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedsearch.Table, savedsearch.FieldID, selector),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedsearch.GroupTable, savedsearch.GroupColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ssq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SavedSearch entity from the query.
+// Returns a *NotFoundError when no SavedSearch was found.
+func (ssq *SavedSearchQuery) First(ctx context.Context) (*SavedSearch, error) {
+	nodes, err := ssq.Limit(1).All(setContextOp(ctx, ssq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{savedsearch.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ssq *SavedSearchQuery) FirstX(ctx context.Context) *SavedSearch {
+	node, err := ssq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SavedSearch ID from the query.
+// Returns a *NotFoundError when no SavedSearch ID was found.
+func (ssq *SavedSearchQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ssq.Limit(1).IDs(setContextOp(ctx, ssq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{savedsearch.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ssq *SavedSearchQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ssq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SavedSearch entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SavedSearch entity is found.
+// Returns a *NotFoundError when no SavedSearch entities are found.
+func (ssq *SavedSearchQuery) Only(ctx context.Context) (*SavedSearch, error) {
+	nodes, err := ssq.Limit(2).All(setContextOp(ctx, ssq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{savedsearch.Label}
+	default:
+		return nil, &NotSingularError{savedsearch.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ssq *SavedSearchQuery) OnlyX(ctx context.Context) *SavedSearch {
+	node, err := ssq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SavedSearch ID in the query.
+// Returns a *NotSingularError when more than one SavedSearch ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ssq *SavedSearchQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ssq.Limit(2).IDs(setContextOp(ctx, ssq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{savedsearch.Label}
+	default:
+		err = &NotSingularError{savedsearch.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ssq *SavedSearchQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ssq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SavedSearches.
+func (ssq *SavedSearchQuery) All(ctx context.Context) ([]*SavedSearch, error) {
+	ctx = setContextOp(ctx, ssq.ctx, "All")
+	if err := ssq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SavedSearch, *SavedSearchQuery]()
+	return withInterceptors[[]*SavedSearch](ctx, ssq, qr, ssq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ssq *SavedSearchQuery) AllX(ctx context.Context) []*SavedSearch {
+	nodes, err := ssq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SavedSearch IDs.
+func (ssq *SavedSearchQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if ssq.ctx.Unique == nil && ssq.path != nil {
+		ssq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ssq.ctx, "IDs")
+	if err = ssq.Select(savedsearch.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ssq *SavedSearchQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ssq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ssq *SavedSearchQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ssq.ctx, "Count")
+	if err := ssq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ssq, querierCount[*SavedSearchQuery](), ssq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ssq *SavedSearchQuery) CountX(ctx context.Context) int {
+	count, err := ssq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ssq *SavedSearchQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ssq.ctx, "Exist")
+	switch _, err := ssq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ssq *SavedSearchQuery) ExistX(ctx context.Context) bool {
+	exist, err := ssq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SavedSearchQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ssq *SavedSearchQuery) Clone() *SavedSearchQuery {
+	if ssq == nil {
+		return nil
+	}
+	return &SavedSearchQuery{
+		config:     ssq.config,
+		ctx:        ssq.ctx.Clone(),
+		order:      append([]savedsearch.OrderOption{}, ssq.order...),
+		inters:     append([]Interceptor{}, ssq.inters...),
+		predicates: append([]predicate.SavedSearch{}, ssq.predicates...),
+		withGroup:  ssq.withGroup.Clone(),
+		// clone intermediate query.
+		sql:  ssq.sql.Clone(),
+		path: ssq.path,
+	}
+}
+
+// WithGroup tells the query-builder to eager-load the nodes that are connected to
+// the "group" edge. The optional arguments are used to configure the query builder of the edge.
+func (ssq *SavedSearchQuery) WithGroup(opts ...func(*GroupQuery)) *SavedSearchQuery {
+	query := (&GroupClient{config: ssq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ssq.withGroup = query
+	return ssq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SavedSearch.Query().
+//		GroupBy(savedsearch.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ssq *SavedSearchQuery) GroupBy(field string, fields ...string) *SavedSearchGroupBy {
+	ssq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SavedSearchGroupBy{build: ssq}
+	grbuild.flds = &ssq.ctx.Fields
+	grbuild.label = savedsearch.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.SavedSearch.Query().
+//		Select(savedsearch.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ssq *SavedSearchQuery) Select(fields ...string) *SavedSearchSelect {
+	ssq.ctx.Fields = append(ssq.ctx.Fields, fields...)
+	sbuild := &SavedSearchSelect{SavedSearchQuery: ssq}
+	sbuild.label = savedsearch.Label
+	sbuild.flds, sbuild.scan = &ssq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SavedSearchSelect configured with the given aggregations.
+func (ssq *SavedSearchQuery) Aggregate(fns ...AggregateFunc) *SavedSearchSelect {
+	return ssq.Select().Aggregate(fns...)
+}
+
+func (ssq *SavedSearchQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ssq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ssq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ssq.ctx.Fields {
+		if !savedsearch.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ssq.path != nil {
+		prev, err := ssq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ssq.sql = prev
+	}
+	return nil
+}
+
+func (ssq *SavedSearchQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SavedSearch, error) {
+	var (
+		nodes       = []*SavedSearch{}
+		withFKs     = ssq.withFKs
+		_spec       = ssq.querySpec()
+		loadedTypes = [1]bool{
+			ssq.withGroup != nil,
+		}
+	)
+	if ssq.withGroup != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, savedsearch.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SavedSearch).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SavedSearch{config: ssq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ssq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ssq.withGroup; query != nil {
+		if err := ssq.loadGroup(ctx, query, nodes, nil,
+			func(n *SavedSearch, e *Group) { n.Edges.Group = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ssq *SavedSearchQuery) loadGroup(ctx context.Context, query *GroupQuery, nodes []*SavedSearch, init func(*SavedSearch), assign func(*SavedSearch, *Group)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*SavedSearch)
+	for i := range nodes {
+		if nodes[i].group_saved_searches == nil {
+			continue
+		}
+		fk := *nodes[i].group_saved_searches
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(group.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "group_saved_searches" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ssq *SavedSearchQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ssq.querySpec()
+	_spec.Node.Columns = ssq.ctx.Fields
+	if len(ssq.ctx.Fields) > 0 {
+		_spec.Unique = ssq.ctx.Unique != nil && *ssq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ssq.driver, _spec)
+}
+
+func (ssq *SavedSearchQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(savedsearch.Table, savedsearch.Columns, sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID))
+	_spec.From = ssq.sql
+	if unique := ssq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ssq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ssq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedsearch.FieldID)
+		for i := range fields {
+			if fields[i] != savedsearch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ssq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ssq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ssq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ssq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ssq *SavedSearchQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ssq.driver.Dialect())
+	t1 := builder.Table(savedsearch.Table)
+	columns := ssq.ctx.Fields
+	if len(columns) == 0 {
+		columns = savedsearch.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ssq.sql != nil {
+		selector = ssq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ssq.ctx.Unique != nil && *ssq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ssq.predicates {
+		p(selector)
+	}
+	for _, p := range ssq.order {
+		p(selector)
+	}
+	if offset := ssq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ssq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SavedSearchGroupBy is the group-by builder for SavedSearch entities.
+type SavedSearchGroupBy struct {
+	selector
+	build *SavedSearchQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ssgb *SavedSearchGroupBy) Aggregate(fns ...AggregateFunc) *SavedSearchGroupBy {
+	ssgb.fns = append(ssgb.fns, fns...)
+	return ssgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ssgb *SavedSearchGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ssgb.build.ctx, "GroupBy")
+	if err := ssgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SavedSearchQuery, *SavedSearchGroupBy](ctx, ssgb.build, ssgb, ssgb.build.inters, v)
+}
+
+func (ssgb *SavedSearchGroupBy) sqlScan(ctx context.Context, root *SavedSearchQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ssgb.fns))
+	for _, fn := range ssgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ssgb.flds)+len(ssgb.fns))
+		for _, f := range *ssgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ssgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ssgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SavedSearchSelect is the builder for selecting fields of SavedSearch entities.
+type SavedSearchSelect struct {
+	*SavedSearchQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sss *SavedSearchSelect) Aggregate(fns ...AggregateFunc) *SavedSearchSelect {
+	sss.fns = append(sss.fns, fns...)
+	return sss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sss *SavedSearchSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sss.ctx, "Select")
+	if err := sss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SavedSearchQuery, *SavedSearchSelect](ctx, sss.SavedSearchQuery, sss, sss.inters, v)
+}
+
+func (sss *SavedSearchSelect) sqlScan(ctx context.Context, root *SavedSearchQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sss.fns))
+	for _, fn := range sss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,513 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// SavedSearchUpdate is the builder for updating SavedSearch entities.
+type SavedSearchUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SavedSearchMutation
+}
+
+// Where appends a list predicates to the SavedSearchUpdate builder.
+func (ssu *SavedSearchUpdate) Where(ps ...predicate.SavedSearch) *SavedSearchUpdate {
+	ssu.mutation.Where(ps...)
+	return ssu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ssu *SavedSearchUpdate) SetUpdatedAt(t time.Time) *SavedSearchUpdate {
+	ssu.mutation.SetUpdatedAt(t)
+	return ssu
+}
+
+// SetName sets the "name" field.
+func (ssu *SavedSearchUpdate) SetName(s string) *SavedSearchUpdate {
+	ssu.mutation.SetName(s)
+	return ssu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ssu *SavedSearchUpdate) SetNillableName(s *string) *SavedSearchUpdate {
+	if s != nil {
+		ssu.SetName(*s)
+	}
+	return ssu
+}
+
+// SetDescription sets the "description" field.
+func (ssu *SavedSearchUpdate) SetDescription(s string) *SavedSearchUpdate {
+	ssu.mutation.SetDescription(s)
+	return ssu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ssu *SavedSearchUpdate) SetNillableDescription(s *string) *SavedSearchUpdate {
+	if s != nil {
+		ssu.SetDescription(*s)
+	}
+	return ssu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ssu *SavedSearchUpdate) ClearDescription() *SavedSearchUpdate {
+	ssu.mutation.ClearDescription()
+	return ssu
+}
+
+// SetQuery sets the "query" field.
+func (ssu *SavedSearchUpdate) SetQuery(tq types.SavedQuery) *SavedSearchUpdate {
+	ssu.mutation.SetQuery(tq)
+	return ssu
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (ssu *SavedSearchUpdate) SetNillableQuery(tq *types.SavedQuery) *SavedSearchUpdate {
+	if tq != nil {
+		ssu.SetQuery(*tq)
+	}
+	return ssu
+}
+
+// SetOrderBy sets the "order_by" field.
+func (ssu *SavedSearchUpdate) SetOrderBy(sb savedsearch.OrderBy) *SavedSearchUpdate {
+	ssu.mutation.SetOrderBy(sb)
+	return ssu
+}
+
+// SetNillableOrderBy sets the "order_by" field if the given value is not nil.
+func (ssu *SavedSearchUpdate) SetNillableOrderBy(sb *savedsearch.OrderBy) *SavedSearchUpdate {
+	if sb != nil {
+		ssu.SetOrderBy(*sb)
+	}
+	return ssu
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (ssu *SavedSearchUpdate) SetGroupID(id uuid.UUID) *SavedSearchUpdate {
+	ssu.mutation.SetGroupID(id)
+	return ssu
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (ssu *SavedSearchUpdate) SetGroup(g *Group) *SavedSearchUpdate {
+	return ssu.SetGroupID(g.ID)
+}
+
+// Mutation returns the SavedSearchMutation object of the builder.
+func (ssu *SavedSearchUpdate) Mutation() *SavedSearchMutation {
+	return ssu.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (ssu *SavedSearchUpdate) ClearGroup() *SavedSearchUpdate {
+	ssu.mutation.ClearGroup()
+	return ssu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ssu *SavedSearchUpdate) Save(ctx context.Context) (int, error) {
+	ssu.defaults()
+	return withHooks(ctx, ssu.sqlSave, ssu.mutation, ssu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssu *SavedSearchUpdate) SaveX(ctx context.Context) int {
+	affected, err := ssu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ssu *SavedSearchUpdate) Exec(ctx context.Context) error {
+	_, err := ssu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssu *SavedSearchUpdate) ExecX(ctx context.Context) {
+	if err := ssu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssu *SavedSearchUpdate) defaults() {
+	if _, ok := ssu.mutation.UpdatedAt(); !ok {
+		v := savedsearch.UpdateDefaultUpdatedAt()
+		ssu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssu *SavedSearchUpdate) check() error {
+	if v, ok := ssu.mutation.Name(); ok {
+		if err := savedsearch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SavedSearch.name": %w`, err)}
+		}
+	}
+	if v, ok := ssu.mutation.Description(); ok {
+		if err := savedsearch.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "SavedSearch.description": %w`, err)}
+		}
+	}
+	if v, ok := ssu.mutation.OrderBy(); ok {
+		if err := savedsearch.OrderByValidator(v); err != nil {
+			return &ValidationError{Name: "order_by", err: fmt.Errorf(`ent: validator failed for field "SavedSearch.order_by": %w`, err)}
+		}
+	}
+	if _, ok := ssu.mutation.GroupID(); ssu.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SavedSearch.group"`)
+	}
+	return nil
+}
+
+func (ssu *SavedSearchUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ssu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedsearch.Table, savedsearch.Columns, sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID))
+	if ps := ssu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssu.mutation.UpdatedAt(); ok {
+		_spec.SetField(savedsearch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ssu.mutation.Name(); ok {
+		_spec.SetField(savedsearch.FieldName, field.TypeString, value)
+	}
+	if value, ok := ssu.mutation.Description(); ok {
+		_spec.SetField(savedsearch.FieldDescription, field.TypeString, value)
+	}
+	if ssu.mutation.DescriptionCleared() {
+		_spec.ClearField(savedsearch.FieldDescription, field.TypeString)
+	}
+	if value, ok := ssu.mutation.Query(); ok {
+		_spec.SetField(savedsearch.FieldQuery, field.TypeJSON, value)
+	}
+	if value, ok := ssu.mutation.OrderBy(); ok {
+		_spec.SetField(savedsearch.FieldOrderBy, field.TypeEnum, value)
+	}
+	if ssu.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.GroupTable,
+			Columns: []string{savedsearch.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssu.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.GroupTable,
+			Columns: []string{savedsearch.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ssu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedsearch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ssu.mutation.done = true
+	return n, nil
+}
+
+// SavedSearchUpdateOne is the builder for updating a single SavedSearch entity.
+type SavedSearchUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SavedSearchMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ssuo *SavedSearchUpdateOne) SetUpdatedAt(t time.Time) *SavedSearchUpdateOne {
+	ssuo.mutation.SetUpdatedAt(t)
+	return ssuo
+}
+
+// SetName sets the "name" field.
+func (ssuo *SavedSearchUpdateOne) SetName(s string) *SavedSearchUpdateOne {
+	ssuo.mutation.SetName(s)
+	return ssuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ssuo *SavedSearchUpdateOne) SetNillableName(s *string) *SavedSearchUpdateOne {
+	if s != nil {
+		ssuo.SetName(*s)
+	}
+	return ssuo
+}
+
+// SetDescription sets the "description" field.
+func (ssuo *SavedSearchUpdateOne) SetDescription(s string) *SavedSearchUpdateOne {
+	ssuo.mutation.SetDescription(s)
+	return ssuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ssuo *SavedSearchUpdateOne) SetNillableDescription(s *string) *SavedSearchUpdateOne {
+	if s != nil {
+		ssuo.SetDescription(*s)
+	}
+	return ssuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ssuo *SavedSearchUpdateOne) ClearDescription() *SavedSearchUpdateOne {
+	ssuo.mutation.ClearDescription()
+	return ssuo
+}
+
+// SetQuery sets the "query" field.
+func (ssuo *SavedSearchUpdateOne) SetQuery(tq types.SavedQuery) *SavedSearchUpdateOne {
+	ssuo.mutation.SetQuery(tq)
+	return ssuo
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (ssuo *SavedSearchUpdateOne) SetNillableQuery(tq *types.SavedQuery) *SavedSearchUpdateOne {
+	if tq != nil {
+		ssuo.SetQuery(*tq)
+	}
+	return ssuo
+}
+
+// SetOrderBy sets the "order_by" field.
+func (ssuo *SavedSearchUpdateOne) SetOrderBy(sb savedsearch.OrderBy) *SavedSearchUpdateOne {
+	ssuo.mutation.SetOrderBy(sb)
+	return ssuo
+}
+
+// SetNillableOrderBy sets the "order_by" field if the given value is not nil.
+func (ssuo *SavedSearchUpdateOne) SetNillableOrderBy(sb *savedsearch.OrderBy) *SavedSearchUpdateOne {
+	if sb != nil {
+		ssuo.SetOrderBy(*sb)
+	}
+	return ssuo
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (ssuo *SavedSearchUpdateOne) SetGroupID(id uuid.UUID) *SavedSearchUpdateOne {
+	ssuo.mutation.SetGroupID(id)
+	return ssuo
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (ssuo *SavedSearchUpdateOne) SetGroup(g *Group) *SavedSearchUpdateOne {
+	return ssuo.SetGroupID(g.ID)
+}
+
+// Mutation returns the SavedSearchMutation object of the builder.
+func (ssuo *SavedSearchUpdateOne) Mutation() *SavedSearchMutation {
+	return ssuo.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (ssuo *SavedSearchUpdateOne) ClearGroup() *SavedSearchUpdateOne {
+	ssuo.mutation.ClearGroup()
+	return ssuo
+}
+
+// Where appends a list predicates to the SavedSearchUpdate builder.
+func (ssuo *SavedSearchUpdateOne) Where(ps ...predicate.SavedSearch) *SavedSearchUpdateOne {
+	ssuo.mutation.Where(ps...)
+	return ssuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ssuo *SavedSearchUpdateOne) Select(field string, fields ...string) *SavedSearchUpdateOne {
+	ssuo.fields = append([]string{field}, fields...)
+	return ssuo
+}
+
+// Save executes the query and returns the updated SavedSearch entity.
+func (ssuo *SavedSearchUpdateOne) Save(ctx context.Context) (*SavedSearch, error) {
+	ssuo.defaults()
+	return withHooks(ctx, ssuo.sqlSave, ssuo.mutation, ssuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssuo *SavedSearchUpdateOne) SaveX(ctx context.Context) *SavedSearch {
+	node, err := ssuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ssuo *SavedSearchUpdateOne) Exec(ctx context.Context) error {
+	_, err := ssuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssuo *SavedSearchUpdateOne) ExecX(ctx context.Context) {
+	if err := ssuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssuo *SavedSearchUpdateOne) defaults() {
+	if _, ok := ssuo.mutation.UpdatedAt(); !ok {
+		v := savedsearch.UpdateDefaultUpdatedAt()
+		ssuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssuo *SavedSearchUpdateOne) check() error {
+	if v, ok := ssuo.mutation.Name(); ok {
+		if err := savedsearch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SavedSearch.name": %w`, err)}
+		}
+	}
+	if v, ok := ssuo.mutation.Description(); ok {
+		if err := savedsearch.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "SavedSearch.description": %w`, err)}
+		}
+	}
+	if v, ok := ssuo.mutation.OrderBy(); ok {
+		if err := savedsearch.OrderByValidator(v); err != nil {
+			return &ValidationError{Name: "order_by", err: fmt.Errorf(`ent: validator failed for field "SavedSearch.order_by": %w`, err)}
+		}
+	}
+	if _, ok := ssuo.mutation.GroupID(); ssuo.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SavedSearch.group"`)
+	}
+	return nil
+}
+
+func (ssuo *SavedSearchUpdateOne) sqlSave(ctx context.Context) (_node *SavedSearch, err error) {
+	if err := ssuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedsearch.Table, savedsearch.Columns, sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID))
+	id, ok := ssuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SavedSearch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ssuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedsearch.FieldID)
+		for _, f := range fields {
+			if !savedsearch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != savedsearch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ssuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(savedsearch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ssuo.mutation.Name(); ok {
+		_spec.SetField(savedsearch.FieldName, field.TypeString, value)
+	}
+	if value, ok := ssuo.mutation.Description(); ok {
+		_spec.SetField(savedsearch.FieldDescription, field.TypeString, value)
+	}
+	if ssuo.mutation.DescriptionCleared() {
+		_spec.ClearField(savedsearch.FieldDescription, field.TypeString)
+	}
+	if value, ok := ssuo.mutation.Query(); ok {
+		_spec.SetField(savedsearch.FieldQuery, field.TypeJSON, value)
+	}
+	if value, ok := ssuo.mutation.OrderBy(); ok {
+		_spec.SetField(savedsearch.FieldOrderBy, field.TypeEnum, value)
+	}
+	if ssuo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.GroupTable,
+			Columns: []string{savedsearch.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssuo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.GroupTable,
+			Columns: []string{savedsearch.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SavedSearch{config: ssuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ssuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedsearch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ssuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// AuditEntry holds the schema definition for the AuditEntry entity. An entry
+// records a single change to an entity of a group and the user that made it.
+type AuditEntry struct {
+	ent.Schema
+}
+
+func (AuditEntry) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{
+			ref:   "audit_entries",
+			field: "group_id",
+		},
+	}
+}
+
+// Fields of the AuditEntry.
+func (AuditEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("action").
+			Values("create", "update", "delete", "restore", "purge"),
+		field.Enum("entity_type").
+			Values("item", "location", "label", "attachment"),
+		field.UUID("entity_id", uuid.UUID{}),
+		field.String("entity_name").
+			MaxLen(255).
+			Optional(),
+		// item_id is the item the entity belongs to. It is not an edge so
+		// that the history of an item outlives the item itself.
+		field.UUID("item_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.UUID("user_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.JSON("changes", []types.FieldChange{}).
+			Optional(),
+	}
+}
+
+// Edges of the AuditEntry.
+func (AuditEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("audit_entries").
+			Field("user_id").
+			Unique(),
+	}
+}
+
+func (AuditEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id", "created_at"),
+		index.Fields("item_id"),
+		index.Fields("entity_id"),
+	}
+}
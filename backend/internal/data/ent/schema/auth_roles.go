@@ -20,6 +20,8 @@ func (AuthRoles) Fields() []ent.Field {
 				"admin",       // can do everything - currently unused
 				"user",        // default login role
 				"attachments", // Read Attachments
+				"items_read",  // Read items, locations, labels and their attachments
+				"items_write", // Read and write items, locations, labels and their attachments
 			),
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
 )
 
@@ -29,6 +30,31 @@ func (AuthTokens) Fields() []ent.Field {
 			Unique(),
 		field.Time("expires_at").
 			Default(func() time.Time { return time.Now().Add(time.Hour * 24 * 7) }),
+		// name is only set for API keys, session tokens are unnamed.
+		field.String("name").
+			MaxLen(255).
+			Optional(),
+		// session_id links the attachment token of a session to its user token, the
+		// attachment token is revoked with the session.
+		field.UUID("session_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		// last_used_at, ip_address and user_agent describe the last request made with
+		// the token.
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.String("ip_address").
+			MaxLen(64).
+			Optional(),
+		field.String("user_agent").
+			MaxLen(512).
+			Optional(),
+		// group_id is the active group of a session, or the group of an API key. Sessions
+		// without a group use the group of the user.
+		field.UUID("group_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -38,6 +64,17 @@ func (AuthTokens) Edges() []ent.Edge {
 		edge.From("user", User.Type).
 			Ref("auth_tokens").
 			Unique(),
+		edge.To("attachment_tokens", AuthTokens.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}).
+			From("session").
+			Unique().
+			Field("session_id"),
+		edge.From("group", Group.Type).
+			Ref("auth_tokens").
+			Unique().
+			Field("group_id"),
 		edge.To("roles", AuthRoles.Type).
 			Unique().
 			Annotations(entsql.Annotation{
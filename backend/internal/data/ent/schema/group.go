@@ -29,6 +29,23 @@ func (Group) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("currency").
 			Default("usd"),
+		// timezone is the IANA name of the timezone of the group, the server timezone
+		// is used when it is empty.
+		field.String("timezone").
+			MaxLen(64).
+			Optional(),
+		// notify_time is the local time of day, formatted as 15:04, at which
+		// notifications are sent.
+		field.String("notify_time").
+			MaxLen(5).
+			Default("08:00"),
+		field.Enum("digest").
+			Values("none", "daily", "weekly").
+			Default("none"),
+		// notified_on is the local date notifications were last sent on.
+		field.Time("notified_on").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -43,12 +60,19 @@ func (Group) Edges() []ent.Edge {
 
 	return []ent.Edge{
 		owned("users", User.Type),
+		owned("memberships", GroupMembership.Type),
+		owned("auth_tokens", AuthTokens.Type),
 		owned("locations", Location.Type),
 		owned("items", Item.Type),
 		owned("labels", Label.Type),
 		owned("documents", Document.Type),
 		owned("invitation_tokens", GroupInvitationToken.Type),
 		owned("notifiers", Notifier.Type),
+		owned("audit_entries", AuditEntry.Type),
+		owned("saved_searches", SavedSearch.Type),
+		owned("notification_rules", NotificationRule.Type),
+		owned("notification_reminders", NotificationReminder.Type),
+		owned("notification_templates", NotificationTemplate.Type),
 		// $scaffold_edge
 	}
 }
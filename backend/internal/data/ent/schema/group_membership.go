@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// GroupMembership holds the schema definition for the GroupMembership entity. A membership
+// grants a user access to a group, the role decides what the user can do within it.
+type GroupMembership struct {
+	ent.Schema
+}
+
+func (GroupMembership) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		UserMixin{
+			ref:   "memberships",
+			field: "user_id",
+		},
+		GroupMixin{
+			ref:   "memberships",
+			field: "group_id",
+		},
+	}
+}
+
+// Fields of the GroupMembership.
+func (GroupMembership) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("role").
+			Default("editor").
+			Values(
+				"viewer", // can view the group's inventory and add maintenance entries
+				"editor", // can create, update and delete the group's inventory
+				"owner",  // can manage the group and its members
+			),
+	}
+}
+
+func (GroupMembership) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "group_id").
+			Unique(),
+	}
+}
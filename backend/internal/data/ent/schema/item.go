@@ -19,6 +19,7 @@ func (Item) Mixin() []ent.Mixin {
 		mixins.BaseMixin{},
 		mixins.DetailsMixin{},
 		GroupMixin{ref: "items"},
+		mixins.TrashMixin{},
 	}
 }
 
@@ -117,6 +118,7 @@ func (Item) Edges() []ent.Edge {
 			Unique(),
 		owned("fields", ItemField.Type),
 		owned("maintenance_entries", MaintenanceEntry.Type),
+		owned("maintenance_schedules", MaintenanceSchedule.Type),
 		owned("attachments", Attachment.Type),
 	}
 }
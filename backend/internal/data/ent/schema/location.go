@@ -17,6 +17,7 @@ func (Location) Mixin() []ent.Mixin {
 		mixins.BaseMixin{},
 		mixins.DetailsMixin{},
 		GroupMixin{ref: "locations"},
+		mixins.TrashMixin{},
 	}
 }
 
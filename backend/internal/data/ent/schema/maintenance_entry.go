@@ -33,6 +33,9 @@ func (MaintenanceEntry) Fields() []ent.Field {
 			Optional(),
 		field.Float("cost").
 			Default(0.0),
+		field.UUID("schedule_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -44,5 +47,9 @@ func (MaintenanceEntry) Edges() []ent.Edge {
 			Ref("maintenance_entries").
 			Required().
 			Unique(),
+		edge.From("schedule", MaintenanceSchedule.Type).
+			Field("schedule_id").
+			Ref("entries").
+			Unique(),
 	}
 }
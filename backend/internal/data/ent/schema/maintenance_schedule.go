@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// MaintenanceSchedule holds a recurrence rule for the maintenance of an item, the
+// scheduled entries it creates are linked back to the schedule.
+type MaintenanceSchedule struct {
+	ent.Schema
+}
+
+func (MaintenanceSchedule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+	}
+}
+
+func (MaintenanceSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("item_id", uuid.UUID{}),
+		field.String("name").
+			MaxLen(255).
+			NotEmpty(),
+		field.String("description").
+			MaxLen(2500).
+			Optional(),
+		field.String("rule").
+			MaxLen(255).
+			NotEmpty(),
+		field.Int("notify_days_before").
+			Default(0).
+			NonNegative(),
+	}
+}
+
+// Edges of the MaintenanceSchedule.
+func (MaintenanceSchedule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).
+			Field("item_id").
+			Ref("maintenance_schedules").
+			Required().
+			Unique(),
+		edge.To("entries", MaintenanceEntry.Type),
+	}
+}
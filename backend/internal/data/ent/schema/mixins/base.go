@@ -5,6 +5,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 )
@@ -40,3 +41,24 @@ func (DetailsMixin) Fields() []ent.Field {
 			Optional(),
 	}
 }
+
+// TrashMixin adds a deleted_at field to move entities to the trash instead of
+// deleting them. Trashed entities have a non-nil deleted_at and must be excluded
+// from queries.
+type TrashMixin struct {
+	mixin.Schema
+}
+
+func (TrashMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (TrashMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("deleted_at"),
+	}
+}
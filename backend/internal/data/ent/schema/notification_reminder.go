@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// NotificationReminder holds the schema definition for the NotificationReminder entity.
+// A reminder records that a notification rule fired for a date of an item so that the
+// same reminder is never sent twice.
+type NotificationReminder struct {
+	ent.Schema
+}
+
+func (NotificationReminder) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{
+			ref:   "notification_reminders",
+			field: "group_id",
+		},
+	}
+}
+
+// Fields of the NotificationReminder.
+func (NotificationReminder) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("rule_id", uuid.UUID{}),
+		// item_id is not an edge so that the reminder history outlives the item.
+		field.UUID("item_id", uuid.UUID{}),
+		// target identifies the date within the item, the maintenance entry or the
+		// custom field name.
+		field.String("target").
+			MaxLen(255),
+		field.String("title").
+			MaxLen(255),
+		field.Time("due_date"),
+		field.Int("lead_days"),
+	}
+}
+
+// Edges of the NotificationReminder.
+func (NotificationReminder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("rule", NotificationRule.Type).
+			Ref("reminders").
+			Field("rule_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (NotificationReminder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("rule_id", "item_id", "target", "due_date", "lead_days").
+			Unique(),
+		index.Fields("group_id", "created_at"),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// NotificationRule holds the schema definition for the NotificationRule entity. A rule
+// watches a date of the items of a group and sends reminders a number of days before it.
+type NotificationRule struct {
+	ent.Schema
+}
+
+func (NotificationRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{
+			ref:   "notification_rules",
+			field: "group_id",
+		},
+	}
+}
+
+// Fields of the NotificationRule.
+func (NotificationRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(255).
+			NotEmpty(),
+		field.Enum("kind").
+			Values("warranty", "maintenance", "field"),
+		// custom_field is the name of the custom field watched by rules of the field kind.
+		field.String("custom_field").
+			MaxLen(255).
+			Optional(),
+		field.JSON("lead_days", []int{}),
+		field.Bool("is_active").
+			Default(true),
+	}
+}
+
+// Edges of the NotificationRule.
+func (NotificationRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("reminders", NotificationReminder.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
+
+func (NotificationRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id", "is_active"),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// NotificationTemplate holds the schema definition for the NotificationTemplate entity.
+// A template overrides the message of a notification type for the whole group or, when
+// a notifier is set, for that notifier only.
+type NotificationTemplate struct {
+	ent.Schema
+}
+
+func (NotificationTemplate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{
+			ref:   "notification_templates",
+			field: "group_id",
+		},
+	}
+}
+
+// Fields of the NotificationTemplate.
+func (NotificationTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("type").
+			Values("maintenance", "reminder", "digest"),
+		field.UUID("notifier_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Text("body").
+			MaxLen(5000).
+			NotEmpty(),
+	}
+}
+
+// Edges of the NotificationTemplate.
+func (NotificationTemplate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("notifier", Notifier.Type).
+			Ref("templates").
+			Field("notifier_id").
+			Unique(),
+	}
+}
+
+func (NotificationTemplate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id", "type"),
+	}
+}
@@ -2,6 +2,8 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 
@@ -38,6 +40,24 @@ func (Notifier) Fields() []ent.Field {
 			NotEmpty(),
 		field.Bool("is_active").
 			Default(true),
+		// failure_count is the number of deliveries that failed in a row, the notifier
+		// is disabled once it reaches the limit.
+		field.Int("failure_count").
+			Default(0),
+	}
+}
+
+// Edges of the Notifier.
+func (Notifier) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("templates", NotificationTemplate.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("deliveries", NotifierDelivery.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
 	}
 }
 
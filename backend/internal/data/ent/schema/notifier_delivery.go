@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// NotifierDelivery holds the schema definition for the NotifierDelivery entity. Every
+// attempt to send a message to a notifier is recorded, including retries.
+type NotifierDelivery struct {
+	ent.Schema
+}
+
+func (NotifierDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+	}
+}
+
+// Fields of the NotifierDelivery.
+func (NotifierDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("notifier_id", uuid.UUID{}),
+		// type is the notification type that was sent.
+		field.String("type").
+			MaxLen(255),
+		field.Enum("status").
+			Values("success", "failed"),
+		field.Int("attempt").
+			Default(1),
+		field.String("error").
+			MaxLen(2000).
+			Optional(),
+	}
+}
+
+// Edges of the NotifierDelivery.
+func (NotifierDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("notifier", Notifier.Type).
+			Ref("deliveries").
+			Field("notifier_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (NotifierDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("notifier_id", "created_at"),
+	}
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// SavedSearch holds the schema definition for the SavedSearch entity.
+type SavedSearch struct {
+	ent.Schema
+}
+
+func (SavedSearch) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		mixins.DetailsMixin{},
+		GroupMixin{ref: "saved_searches"},
+	}
+}
+
+// Fields of the SavedSearch.
+func (SavedSearch) Fields() []ent.Field {
+	return []ent.Field{
+		field.JSON("query", types.SavedQuery{}),
+		field.Enum("order_by").
+			Values("name", "createdAt", "updatedAt").
+			Default("name"),
+	}
+}
@@ -15,6 +15,8 @@ type User struct {
 	ent.Schema
 }
 
+// The group of a user is the group new sessions start in, the groups the user can access
+// and the role within each of them are the memberships of the user.
 func (User) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.BaseMixin{},
@@ -40,17 +42,38 @@ func (User) Fields() []ent.Field {
 			Default(false),
 		field.Bool("superuser").
 			Default(false),
-		field.Enum("role").
-			Default("user").
-			Values("user", "owner"),
 		field.Time("activated_on").
 			Optional(),
+		// disabled_at is set when a superuser disabled the account, disabled users can not
+		// login and their tokens are rejected.
+		field.Time("disabled_at").
+			Optional().
+			Nillable(),
+		// totp_secret is set when the user starts the two-factor enrollment, it is only
+		// required at login once the enrollment is confirmed and totp_enabled is set.
+		field.String("totp_secret").
+			MaxLen(255).
+			Optional().
+			Sensitive(),
+		field.Bool("totp_enabled").
+			Default(false),
+		// totp_last_step is the time step of the last accepted code, codes can not be reused.
+		field.Int64("totp_last_step").
+			Default(0),
+		// totp_recovery_codes holds the hashes of the unused recovery codes.
+		field.Strings("totp_recovery_codes").
+			Optional().
+			Sensitive(),
 	}
 }
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
+		edge.To("memberships", GroupMembership.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
 		edge.To("auth_tokens", AuthTokens.Type).
 			Annotations(entsql.Annotation{
 				OnDelete: entsql.Cascade,
@@ -59,6 +82,18 @@ func (User) Edges() []ent.Edge {
 			Annotations(entsql.Annotation{
 				OnDelete: entsql.Cascade,
 			}),
+		edge.To("user_tokens", UserToken.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("webauthn_credentials", WebAuthnCredential.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("audit_entries", AuditEntry.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.SetNull,
+			}),
 	}
 }
 
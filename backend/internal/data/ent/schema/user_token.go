@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// UserToken holds the schema definition for the UserToken entity. User tokens are
+// single-use tokens sent by mail to reset a password or verify an email address, and
+// the tokens identifying a login waiting for its two-factor code.
+type UserToken struct {
+	ent.Schema
+}
+
+func (UserToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		UserMixin{
+			ref:   "user_tokens",
+			field: "user_id",
+		},
+	}
+}
+
+// Fields of the UserToken.
+func (UserToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("purpose").
+			Values(
+				"password_reset",
+				"email_verification",
+				"two_factor",
+			),
+		field.Bytes("token").
+			Unique(),
+		field.Time("expires_at"),
+		// attempts counts the failed attempts of two-factor logins.
+		field.Int("attempts").
+			Default(0),
+	}
+}
+
+func (UserToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "purpose"),
+	}
+}
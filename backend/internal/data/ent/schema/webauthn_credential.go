@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// WebAuthnCredential holds the schema definition for the WebAuthnCredential entity. A
+// credential is a passkey registered by a user to sign in without a password.
+type WebAuthnCredential struct {
+	ent.Schema
+}
+
+func (WebAuthnCredential) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		UserMixin{
+			ref:   "webauthn_credentials",
+			field: "user_id",
+		},
+	}
+}
+
+// Fields of the WebAuthnCredential.
+func (WebAuthnCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(255).
+			NotEmpty(),
+		field.Bytes("credential_id").
+			Unique(),
+		field.Bytes("public_key"),
+		field.String("attestation_type").
+			Optional(),
+		field.Strings("transports").
+			Optional(),
+		field.Bytes("aaguid").
+			Optional(),
+		// sign_count is the signature counter of the authenticator, a counter that does
+		// not increase indicates a cloned authenticator.
+		field.Uint32("sign_count").
+			Default(0),
+		field.Bool("backup_eligible").
+			Default(false),
+		field.Bool("backup_state").
+			Default(false),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+	}
+}
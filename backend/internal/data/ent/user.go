@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -33,10 +34,18 @@ type User struct {
 	IsSuperuser bool `json:"is_superuser,omitempty"`
 	// Superuser holds the value of the "superuser" field.
 	Superuser bool `json:"superuser,omitempty"`
-	// Role holds the value of the "role" field.
-	Role user.Role `json:"role,omitempty"`
 	// ActivatedOn holds the value of the "activated_on" field.
 	ActivatedOn time.Time `json:"activated_on,omitempty"`
+	// DisabledAt holds the value of the "disabled_at" field.
+	DisabledAt *time.Time `json:"disabled_at,omitempty"`
+	// TotpSecret holds the value of the "totp_secret" field.
+	TotpSecret string `json:"-"`
+	// TotpEnabled holds the value of the "totp_enabled" field.
+	TotpEnabled bool `json:"totp_enabled,omitempty"`
+	// TotpLastStep holds the value of the "totp_last_step" field.
+	TotpLastStep int64 `json:"totp_last_step,omitempty"`
+	// TotpRecoveryCodes holds the value of the "totp_recovery_codes" field.
+	TotpRecoveryCodes []string `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -48,13 +57,21 @@ type User struct {
 type UserEdges struct {
 	// Group holds the value of the group edge.
 	Group *Group `json:"group,omitempty"`
+	// Memberships holds the value of the memberships edge.
+	Memberships []*GroupMembership `json:"memberships,omitempty"`
 	// AuthTokens holds the value of the auth_tokens edge.
 	AuthTokens []*AuthTokens `json:"auth_tokens,omitempty"`
 	// Notifiers holds the value of the notifiers edge.
 	Notifiers []*Notifier `json:"notifiers,omitempty"`
+	// UserTokens holds the value of the user_tokens edge.
+	UserTokens []*UserToken `json:"user_tokens,omitempty"`
+	// WebauthnCredentials holds the value of the webauthn_credentials edge.
+	WebauthnCredentials []*WebAuthnCredential `json:"webauthn_credentials,omitempty"`
+	// AuditEntries holds the value of the audit_entries edge.
+	AuditEntries []*AuditEntry `json:"audit_entries,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [7]bool
 }
 
 // GroupOrErr returns the Group value or an error if the edge
@@ -70,10 +87,19 @@ func (e UserEdges) GroupOrErr() (*Group, error) {
 	return nil, &NotLoadedError{edge: "group"}
 }
 
+// MembershipsOrErr returns the Memberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MembershipsOrErr() ([]*GroupMembership, error) {
+	if e.loadedTypes[1] {
+		return e.Memberships, nil
+	}
+	return nil, &NotLoadedError{edge: "memberships"}
+}
+
 // AuthTokensOrErr returns the AuthTokens value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) AuthTokensOrErr() ([]*AuthTokens, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.AuthTokens, nil
 	}
 	return nil, &NotLoadedError{edge: "auth_tokens"}
@@ -82,22 +108,53 @@ func (e UserEdges) AuthTokensOrErr() ([]*AuthTokens, error) {
 // NotifiersOrErr returns the Notifiers value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) NotifiersOrErr() ([]*Notifier, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.Notifiers, nil
 	}
 	return nil, &NotLoadedError{edge: "notifiers"}
 }
 
+// UserTokensOrErr returns the UserTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) UserTokensOrErr() ([]*UserToken, error) {
+	if e.loadedTypes[4] {
+		return e.UserTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "user_tokens"}
+}
+
+// WebauthnCredentialsOrErr returns the WebauthnCredentials value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WebauthnCredentialsOrErr() ([]*WebAuthnCredential, error) {
+	if e.loadedTypes[5] {
+		return e.WebauthnCredentials, nil
+	}
+	return nil, &NotLoadedError{edge: "webauthn_credentials"}
+}
+
+// AuditEntriesOrErr returns the AuditEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AuditEntriesOrErr() ([]*AuditEntry, error) {
+	if e.loadedTypes[6] {
+		return e.AuditEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "audit_entries"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldIsSuperuser, user.FieldSuperuser:
+		case user.FieldTotpRecoveryCodes:
+			values[i] = new([]byte)
+		case user.FieldIsSuperuser, user.FieldSuperuser, user.FieldTotpEnabled:
 			values[i] = new(sql.NullBool)
-		case user.FieldName, user.FieldEmail, user.FieldPassword, user.FieldRole:
+		case user.FieldTotpLastStep:
+			values[i] = new(sql.NullInt64)
+		case user.FieldName, user.FieldEmail, user.FieldPassword, user.FieldTotpSecret:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldActivatedOn:
+		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldActivatedOn, user.FieldDisabledAt:
 			values[i] = new(sql.NullTime)
 		case user.FieldID:
 			values[i] = new(uuid.UUID)
@@ -166,18 +223,45 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Superuser = value.Bool
 			}
-		case user.FieldRole:
-			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field role", values[i])
-			} else if value.Valid {
-				u.Role = user.Role(value.String)
-			}
 		case user.FieldActivatedOn:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field activated_on", values[i])
 			} else if value.Valid {
 				u.ActivatedOn = value.Time
 			}
+		case user.FieldDisabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled_at", values[i])
+			} else if value.Valid {
+				u.DisabledAt = new(time.Time)
+				*u.DisabledAt = value.Time
+			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				u.TotpSecret = value.String
+			}
+		case user.FieldTotpEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_enabled", values[i])
+			} else if value.Valid {
+				u.TotpEnabled = value.Bool
+			}
+		case user.FieldTotpLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_last_step", values[i])
+			} else if value.Valid {
+				u.TotpLastStep = value.Int64
+			}
+		case user.FieldTotpRecoveryCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_recovery_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.TotpRecoveryCodes); err != nil {
+					return fmt.Errorf("unmarshal field totp_recovery_codes: %w", err)
+				}
+			}
 		case user.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field group_users", values[i])
@@ -203,6 +287,11 @@ func (u *User) QueryGroup() *GroupQuery {
 	return NewUserClient(u.config).QueryGroup(u)
 }
 
+// QueryMemberships queries the "memberships" edge of the User entity.
+func (u *User) QueryMemberships() *GroupMembershipQuery {
+	return NewUserClient(u.config).QueryMemberships(u)
+}
+
 // QueryAuthTokens queries the "auth_tokens" edge of the User entity.
 func (u *User) QueryAuthTokens() *AuthTokensQuery {
 	return NewUserClient(u.config).QueryAuthTokens(u)
@@ -213,6 +302,21 @@ func (u *User) QueryNotifiers() *NotifierQuery {
 	return NewUserClient(u.config).QueryNotifiers(u)
 }
 
+// QueryUserTokens queries the "user_tokens" edge of the User entity.
+func (u *User) QueryUserTokens() *UserTokenQuery {
+	return NewUserClient(u.config).QueryUserTokens(u)
+}
+
+// QueryWebauthnCredentials queries the "webauthn_credentials" edge of the User entity.
+func (u *User) QueryWebauthnCredentials() *WebAuthnCredentialQuery {
+	return NewUserClient(u.config).QueryWebauthnCredentials(u)
+}
+
+// QueryAuditEntries queries the "audit_entries" edge of the User entity.
+func (u *User) QueryAuditEntries() *AuditEntryQuery {
+	return NewUserClient(u.config).QueryAuditEntries(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -256,11 +360,23 @@ func (u *User) String() string {
 	builder.WriteString("superuser=")
 	builder.WriteString(fmt.Sprintf("%v", u.Superuser))
 	builder.WriteString(", ")
-	builder.WriteString("role=")
-	builder.WriteString(fmt.Sprintf("%v", u.Role))
-	builder.WriteString(", ")
 	builder.WriteString("activated_on=")
 	builder.WriteString(u.ActivatedOn.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := u.DisabledAt; v != nil {
+		builder.WriteString("disabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("totp_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("totp_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", u.TotpEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("totp_last_step=")
+	builder.WriteString(fmt.Sprintf("%v", u.TotpLastStep))
+	builder.WriteString(", ")
+	builder.WriteString("totp_recovery_codes=<sensitive>")
 	builder.WriteByte(')')
 	return builder.String()
 }
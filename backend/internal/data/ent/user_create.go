@@ -11,10 +11,14 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/auditentry"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/usertoken"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/webauthncredential"
 )
 
 // UserCreate is the builder for creating a User entity.
@@ -98,34 +102,82 @@ func (uc *UserCreate) SetNillableSuperuser(b *bool) *UserCreate {
 	return uc
 }
 
-// SetRole sets the "role" field.
-func (uc *UserCreate) SetRole(u user.Role) *UserCreate {
-	uc.mutation.SetRole(u)
+// SetActivatedOn sets the "activated_on" field.
+func (uc *UserCreate) SetActivatedOn(t time.Time) *UserCreate {
+	uc.mutation.SetActivatedOn(t)
 	return uc
 }
 
-// SetNillableRole sets the "role" field if the given value is not nil.
-func (uc *UserCreate) SetNillableRole(u *user.Role) *UserCreate {
-	if u != nil {
-		uc.SetRole(*u)
+// SetNillableActivatedOn sets the "activated_on" field if the given value is not nil.
+func (uc *UserCreate) SetNillableActivatedOn(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetActivatedOn(*t)
 	}
 	return uc
 }
 
-// SetActivatedOn sets the "activated_on" field.
-func (uc *UserCreate) SetActivatedOn(t time.Time) *UserCreate {
-	uc.mutation.SetActivatedOn(t)
+// SetDisabledAt sets the "disabled_at" field.
+func (uc *UserCreate) SetDisabledAt(t time.Time) *UserCreate {
+	uc.mutation.SetDisabledAt(t)
 	return uc
 }
 
-// SetNillableActivatedOn sets the "activated_on" field if the given value is not nil.
-func (uc *UserCreate) SetNillableActivatedOn(t *time.Time) *UserCreate {
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDisabledAt(t *time.Time) *UserCreate {
 	if t != nil {
-		uc.SetActivatedOn(*t)
+		uc.SetDisabledAt(*t)
+	}
+	return uc
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (uc *UserCreate) SetTotpSecret(s string) *UserCreate {
+	uc.mutation.SetTotpSecret(s)
+	return uc
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpSecret(s *string) *UserCreate {
+	if s != nil {
+		uc.SetTotpSecret(*s)
+	}
+	return uc
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (uc *UserCreate) SetTotpEnabled(b bool) *UserCreate {
+	uc.mutation.SetTotpEnabled(b)
+	return uc
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpEnabled(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetTotpEnabled(*b)
+	}
+	return uc
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (uc *UserCreate) SetTotpLastStep(i int64) *UserCreate {
+	uc.mutation.SetTotpLastStep(i)
+	return uc
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpLastStep(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetTotpLastStep(*i)
 	}
 	return uc
 }
 
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (uc *UserCreate) SetTotpRecoveryCodes(s []string) *UserCreate {
+	uc.mutation.SetTotpRecoveryCodes(s)
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(u uuid.UUID) *UserCreate {
 	uc.mutation.SetID(u)
@@ -151,6 +203,21 @@ func (uc *UserCreate) SetGroup(g *Group) *UserCreate {
 	return uc.SetGroupID(g.ID)
 }
 
+// AddMembershipIDs adds the "memberships" edge to the GroupMembership entity by IDs.
+func (uc *UserCreate) AddMembershipIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddMembershipIDs(ids...)
+	return uc
+}
+
+// AddMemberships adds the "memberships" edges to the GroupMembership entity.
+func (uc *UserCreate) AddMemberships(g ...*GroupMembership) *UserCreate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uc.AddMembershipIDs(ids...)
+}
+
 // AddAuthTokenIDs adds the "auth_tokens" edge to the AuthTokens entity by IDs.
 func (uc *UserCreate) AddAuthTokenIDs(ids ...uuid.UUID) *UserCreate {
 	uc.mutation.AddAuthTokenIDs(ids...)
@@ -181,6 +248,51 @@ func (uc *UserCreate) AddNotifiers(n ...*Notifier) *UserCreate {
 	return uc.AddNotifierIDs(ids...)
 }
 
+// AddUserTokenIDs adds the "user_tokens" edge to the UserToken entity by IDs.
+func (uc *UserCreate) AddUserTokenIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddUserTokenIDs(ids...)
+	return uc
+}
+
+// AddUserTokens adds the "user_tokens" edges to the UserToken entity.
+func (uc *UserCreate) AddUserTokens(u ...*UserToken) *UserCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddUserTokenIDs(ids...)
+}
+
+// AddWebauthnCredentialIDs adds the "webauthn_credentials" edge to the WebAuthnCredential entity by IDs.
+func (uc *UserCreate) AddWebauthnCredentialIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddWebauthnCredentialIDs(ids...)
+	return uc
+}
+
+// AddWebauthnCredentials adds the "webauthn_credentials" edges to the WebAuthnCredential entity.
+func (uc *UserCreate) AddWebauthnCredentials(w ...*WebAuthnCredential) *UserCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uc.AddWebauthnCredentialIDs(ids...)
+}
+
+// AddAuditEntryIDs adds the "audit_entries" edge to the AuditEntry entity by IDs.
+func (uc *UserCreate) AddAuditEntryIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddAuditEntryIDs(ids...)
+	return uc
+}
+
+// AddAuditEntries adds the "audit_entries" edges to the AuditEntry entity.
+func (uc *UserCreate) AddAuditEntries(a ...*AuditEntry) *UserCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAuditEntryIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -232,9 +344,13 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultSuperuser
 		uc.mutation.SetSuperuser(v)
 	}
-	if _, ok := uc.mutation.Role(); !ok {
-		v := user.DefaultRole
-		uc.mutation.SetRole(v)
+	if _, ok := uc.mutation.TotpEnabled(); !ok {
+		v := user.DefaultTotpEnabled
+		uc.mutation.SetTotpEnabled(v)
+	}
+	if _, ok := uc.mutation.TotpLastStep(); !ok {
+		v := user.DefaultTotpLastStep
+		uc.mutation.SetTotpLastStep(v)
 	}
 	if _, ok := uc.mutation.ID(); !ok {
 		v := user.DefaultID()
@@ -280,14 +396,17 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Superuser(); !ok {
 		return &ValidationError{Name: "superuser", err: errors.New(`ent: missing required field "User.superuser"`)}
 	}
-	if _, ok := uc.mutation.Role(); !ok {
-		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
-	}
-	if v, ok := uc.mutation.Role(); ok {
-		if err := user.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+	if v, ok := uc.mutation.TotpSecret(); ok {
+		if err := user.TotpSecretValidator(v); err != nil {
+			return &ValidationError{Name: "totp_secret", err: fmt.Errorf(`ent: validator failed for field "User.totp_secret": %w`, err)}
 		}
 	}
+	if _, ok := uc.mutation.TotpEnabled(); !ok {
+		return &ValidationError{Name: "totp_enabled", err: errors.New(`ent: missing required field "User.totp_enabled"`)}
+	}
+	if _, ok := uc.mutation.TotpLastStep(); !ok {
+		return &ValidationError{Name: "totp_last_step", err: errors.New(`ent: missing required field "User.totp_last_step"`)}
+	}
 	if _, ok := uc.mutation.GroupID(); !ok {
 		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "User.group"`)}
 	}
@@ -354,14 +473,30 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldSuperuser, field.TypeBool, value)
 		_node.Superuser = value
 	}
-	if value, ok := uc.mutation.Role(); ok {
-		_spec.SetField(user.FieldRole, field.TypeEnum, value)
-		_node.Role = value
-	}
 	if value, ok := uc.mutation.ActivatedOn(); ok {
 		_spec.SetField(user.FieldActivatedOn, field.TypeTime, value)
 		_node.ActivatedOn = value
 	}
+	if value, ok := uc.mutation.DisabledAt(); ok {
+		_spec.SetField(user.FieldDisabledAt, field.TypeTime, value)
+		_node.DisabledAt = &value
+	}
+	if value, ok := uc.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+		_node.TotpSecret = value
+	}
+	if value, ok := uc.mutation.TotpEnabled(); ok {
+		_spec.SetField(user.FieldTotpEnabled, field.TypeBool, value)
+		_node.TotpEnabled = value
+	}
+	if value, ok := uc.mutation.TotpLastStep(); ok {
+		_spec.SetField(user.FieldTotpLastStep, field.TypeInt64, value)
+		_node.TotpLastStep = value
+	}
+	if value, ok := uc.mutation.TotpRecoveryCodes(); ok {
+		_spec.SetField(user.FieldTotpRecoveryCodes, field.TypeJSON, value)
+		_node.TotpRecoveryCodes = value
+	}
 	if nodes := uc.mutation.GroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -379,6 +514,22 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_node.group_users = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := uc.mutation.AuthTokensIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -411,6 +562,54 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.UserTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserTokensTable,
+			Columns: []string{user.UserTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usertoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.WebauthnCredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebauthnCredentialsTable,
+			Columns: []string{user.WebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AuditEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditEntriesTable,
+			Columns: []string{user.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
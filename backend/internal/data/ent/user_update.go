@@ -10,13 +10,18 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/auditentry"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/usertoken"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/webauthncredential"
 )
 
 // UserUpdate is the builder for updating User entities.
@@ -108,20 +113,6 @@ func (uu *UserUpdate) SetNillableSuperuser(b *bool) *UserUpdate {
 	return uu
 }
 
-// SetRole sets the "role" field.
-func (uu *UserUpdate) SetRole(u user.Role) *UserUpdate {
-	uu.mutation.SetRole(u)
-	return uu
-}
-
-// SetNillableRole sets the "role" field if the given value is not nil.
-func (uu *UserUpdate) SetNillableRole(u *user.Role) *UserUpdate {
-	if u != nil {
-		uu.SetRole(*u)
-	}
-	return uu
-}
-
 // SetActivatedOn sets the "activated_on" field.
 func (uu *UserUpdate) SetActivatedOn(t time.Time) *UserUpdate {
 	uu.mutation.SetActivatedOn(t)
@@ -142,6 +133,99 @@ func (uu *UserUpdate) ClearActivatedOn() *UserUpdate {
 	return uu
 }
 
+// SetDisabledAt sets the "disabled_at" field.
+func (uu *UserUpdate) SetDisabledAt(t time.Time) *UserUpdate {
+	uu.mutation.SetDisabledAt(t)
+	return uu
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDisabledAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetDisabledAt(*t)
+	}
+	return uu
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (uu *UserUpdate) ClearDisabledAt() *UserUpdate {
+	uu.mutation.ClearDisabledAt()
+	return uu
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (uu *UserUpdate) SetTotpSecret(s string) *UserUpdate {
+	uu.mutation.SetTotpSecret(s)
+	return uu
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTotpSecret(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetTotpSecret(*s)
+	}
+	return uu
+}
+
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (uu *UserUpdate) ClearTotpSecret() *UserUpdate {
+	uu.mutation.ClearTotpSecret()
+	return uu
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (uu *UserUpdate) SetTotpEnabled(b bool) *UserUpdate {
+	uu.mutation.SetTotpEnabled(b)
+	return uu
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTotpEnabled(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetTotpEnabled(*b)
+	}
+	return uu
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (uu *UserUpdate) SetTotpLastStep(i int64) *UserUpdate {
+	uu.mutation.ResetTotpLastStep()
+	uu.mutation.SetTotpLastStep(i)
+	return uu
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTotpLastStep(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetTotpLastStep(*i)
+	}
+	return uu
+}
+
+// AddTotpLastStep adds i to the "totp_last_step" field.
+func (uu *UserUpdate) AddTotpLastStep(i int64) *UserUpdate {
+	uu.mutation.AddTotpLastStep(i)
+	return uu
+}
+
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (uu *UserUpdate) SetTotpRecoveryCodes(s []string) *UserUpdate {
+	uu.mutation.SetTotpRecoveryCodes(s)
+	return uu
+}
+
+// AppendTotpRecoveryCodes appends s to the "totp_recovery_codes" field.
+func (uu *UserUpdate) AppendTotpRecoveryCodes(s []string) *UserUpdate {
+	uu.mutation.AppendTotpRecoveryCodes(s)
+	return uu
+}
+
+// ClearTotpRecoveryCodes clears the value of the "totp_recovery_codes" field.
+func (uu *UserUpdate) ClearTotpRecoveryCodes() *UserUpdate {
+	uu.mutation.ClearTotpRecoveryCodes()
+	return uu
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (uu *UserUpdate) SetGroupID(id uuid.UUID) *UserUpdate {
 	uu.mutation.SetGroupID(id)
@@ -153,6 +237,21 @@ func (uu *UserUpdate) SetGroup(g *Group) *UserUpdate {
 	return uu.SetGroupID(g.ID)
 }
 
+// AddMembershipIDs adds the "memberships" edge to the GroupMembership entity by IDs.
+func (uu *UserUpdate) AddMembershipIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddMembershipIDs(ids...)
+	return uu
+}
+
+// AddMemberships adds the "memberships" edges to the GroupMembership entity.
+func (uu *UserUpdate) AddMemberships(g ...*GroupMembership) *UserUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.AddMembershipIDs(ids...)
+}
+
 // AddAuthTokenIDs adds the "auth_tokens" edge to the AuthTokens entity by IDs.
 func (uu *UserUpdate) AddAuthTokenIDs(ids ...uuid.UUID) *UserUpdate {
 	uu.mutation.AddAuthTokenIDs(ids...)
@@ -183,6 +282,51 @@ func (uu *UserUpdate) AddNotifiers(n ...*Notifier) *UserUpdate {
 	return uu.AddNotifierIDs(ids...)
 }
 
+// AddUserTokenIDs adds the "user_tokens" edge to the UserToken entity by IDs.
+func (uu *UserUpdate) AddUserTokenIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddUserTokenIDs(ids...)
+	return uu
+}
+
+// AddUserTokens adds the "user_tokens" edges to the UserToken entity.
+func (uu *UserUpdate) AddUserTokens(u ...*UserToken) *UserUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.AddUserTokenIDs(ids...)
+}
+
+// AddWebauthnCredentialIDs adds the "webauthn_credentials" edge to the WebAuthnCredential entity by IDs.
+func (uu *UserUpdate) AddWebauthnCredentialIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddWebauthnCredentialIDs(ids...)
+	return uu
+}
+
+// AddWebauthnCredentials adds the "webauthn_credentials" edges to the WebAuthnCredential entity.
+func (uu *UserUpdate) AddWebauthnCredentials(w ...*WebAuthnCredential) *UserUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uu.AddWebauthnCredentialIDs(ids...)
+}
+
+// AddAuditEntryIDs adds the "audit_entries" edge to the AuditEntry entity by IDs.
+func (uu *UserUpdate) AddAuditEntryIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddAuditEntryIDs(ids...)
+	return uu
+}
+
+// AddAuditEntries adds the "audit_entries" edges to the AuditEntry entity.
+func (uu *UserUpdate) AddAuditEntries(a ...*AuditEntry) *UserUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddAuditEntryIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -194,6 +338,27 @@ func (uu *UserUpdate) ClearGroup() *UserUpdate {
 	return uu
 }
 
+// ClearMemberships clears all "memberships" edges to the GroupMembership entity.
+func (uu *UserUpdate) ClearMemberships() *UserUpdate {
+	uu.mutation.ClearMemberships()
+	return uu
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to GroupMembership entities by IDs.
+func (uu *UserUpdate) RemoveMembershipIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveMembershipIDs(ids...)
+	return uu
+}
+
+// RemoveMemberships removes "memberships" edges to GroupMembership entities.
+func (uu *UserUpdate) RemoveMemberships(g ...*GroupMembership) *UserUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.RemoveMembershipIDs(ids...)
+}
+
 // ClearAuthTokens clears all "auth_tokens" edges to the AuthTokens entity.
 func (uu *UserUpdate) ClearAuthTokens() *UserUpdate {
 	uu.mutation.ClearAuthTokens()
@@ -236,6 +401,69 @@ func (uu *UserUpdate) RemoveNotifiers(n ...*Notifier) *UserUpdate {
 	return uu.RemoveNotifierIDs(ids...)
 }
 
+// ClearUserTokens clears all "user_tokens" edges to the UserToken entity.
+func (uu *UserUpdate) ClearUserTokens() *UserUpdate {
+	uu.mutation.ClearUserTokens()
+	return uu
+}
+
+// RemoveUserTokenIDs removes the "user_tokens" edge to UserToken entities by IDs.
+func (uu *UserUpdate) RemoveUserTokenIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveUserTokenIDs(ids...)
+	return uu
+}
+
+// RemoveUserTokens removes "user_tokens" edges to UserToken entities.
+func (uu *UserUpdate) RemoveUserTokens(u ...*UserToken) *UserUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.RemoveUserTokenIDs(ids...)
+}
+
+// ClearWebauthnCredentials clears all "webauthn_credentials" edges to the WebAuthnCredential entity.
+func (uu *UserUpdate) ClearWebauthnCredentials() *UserUpdate {
+	uu.mutation.ClearWebauthnCredentials()
+	return uu
+}
+
+// RemoveWebauthnCredentialIDs removes the "webauthn_credentials" edge to WebAuthnCredential entities by IDs.
+func (uu *UserUpdate) RemoveWebauthnCredentialIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveWebauthnCredentialIDs(ids...)
+	return uu
+}
+
+// RemoveWebauthnCredentials removes "webauthn_credentials" edges to WebAuthnCredential entities.
+func (uu *UserUpdate) RemoveWebauthnCredentials(w ...*WebAuthnCredential) *UserUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uu.RemoveWebauthnCredentialIDs(ids...)
+}
+
+// ClearAuditEntries clears all "audit_entries" edges to the AuditEntry entity.
+func (uu *UserUpdate) ClearAuditEntries() *UserUpdate {
+	uu.mutation.ClearAuditEntries()
+	return uu
+}
+
+// RemoveAuditEntryIDs removes the "audit_entries" edge to AuditEntry entities by IDs.
+func (uu *UserUpdate) RemoveAuditEntryIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveAuditEntryIDs(ids...)
+	return uu
+}
+
+// RemoveAuditEntries removes "audit_entries" edges to AuditEntry entities.
+func (uu *UserUpdate) RemoveAuditEntries(a ...*AuditEntry) *UserUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.RemoveAuditEntryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	uu.defaults()
@@ -289,9 +517,9 @@ func (uu *UserUpdate) check() error {
 			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "User.password": %w`, err)}
 		}
 	}
-	if v, ok := uu.mutation.Role(); ok {
-		if err := user.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+	if v, ok := uu.mutation.TotpSecret(); ok {
+		if err := user.TotpSecretValidator(v); err != nil {
+			return &ValidationError{Name: "totp_secret", err: fmt.Errorf(`ent: validator failed for field "User.totp_secret": %w`, err)}
 		}
 	}
 	if _, ok := uu.mutation.GroupID(); uu.mutation.GroupCleared() && !ok {
@@ -330,15 +558,44 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.Superuser(); ok {
 		_spec.SetField(user.FieldSuperuser, field.TypeBool, value)
 	}
-	if value, ok := uu.mutation.Role(); ok {
-		_spec.SetField(user.FieldRole, field.TypeEnum, value)
-	}
 	if value, ok := uu.mutation.ActivatedOn(); ok {
 		_spec.SetField(user.FieldActivatedOn, field.TypeTime, value)
 	}
 	if uu.mutation.ActivatedOnCleared() {
 		_spec.ClearField(user.FieldActivatedOn, field.TypeTime)
 	}
+	if value, ok := uu.mutation.DisabledAt(); ok {
+		_spec.SetField(user.FieldDisabledAt, field.TypeTime, value)
+	}
+	if uu.mutation.DisabledAtCleared() {
+		_spec.ClearField(user.FieldDisabledAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+	}
+	if uu.mutation.TotpSecretCleared() {
+		_spec.ClearField(user.FieldTotpSecret, field.TypeString)
+	}
+	if value, ok := uu.mutation.TotpEnabled(); ok {
+		_spec.SetField(user.FieldTotpEnabled, field.TypeBool, value)
+	}
+	if value, ok := uu.mutation.TotpLastStep(); ok {
+		_spec.SetField(user.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedTotpLastStep(); ok {
+		_spec.AddField(user.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.TotpRecoveryCodes(); ok {
+		_spec.SetField(user.FieldTotpRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := uu.mutation.AppendedTotpRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldTotpRecoveryCodes, value)
+		})
+	}
+	if uu.mutation.TotpRecoveryCodesCleared() {
+		_spec.ClearField(user.FieldTotpRecoveryCodes, field.TypeJSON)
+	}
 	if uu.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -368,6 +625,51 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !uu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if uu.mutation.AuthTokensCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -458,6 +760,141 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.UserTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserTokensTable,
+			Columns: []string{user.UserTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usertoken.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedUserTokensIDs(); len(nodes) > 0 && !uu.mutation.UserTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserTokensTable,
+			Columns: []string{user.UserTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usertoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.UserTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserTokensTable,
+			Columns: []string{user.UserTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usertoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.WebauthnCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebauthnCredentialsTable,
+			Columns: []string{user.WebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedWebauthnCredentialsIDs(); len(nodes) > 0 && !uu.mutation.WebauthnCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebauthnCredentialsTable,
+			Columns: []string{user.WebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.WebauthnCredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebauthnCredentialsTable,
+			Columns: []string{user.WebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.AuditEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditEntriesTable,
+			Columns: []string{user.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedAuditEntriesIDs(); len(nodes) > 0 && !uu.mutation.AuditEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditEntriesTable,
+			Columns: []string{user.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.AuditEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditEntriesTable,
+			Columns: []string{user.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -498,93 +935,172 @@ func (uuo *UserUpdateOne) SetNillableName(s *string) *UserUpdateOne {
 	return uuo
 }
 
-// SetEmail sets the "email" field.
-func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
-	uuo.mutation.SetEmail(s)
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
+	return uuo
+}
+
+// SetPassword sets the "password" field.
+func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
+	uuo.mutation.SetPassword(s)
+	return uuo
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePassword(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPassword(*s)
+	}
+	return uuo
+}
+
+// SetIsSuperuser sets the "is_superuser" field.
+func (uuo *UserUpdateOne) SetIsSuperuser(b bool) *UserUpdateOne {
+	uuo.mutation.SetIsSuperuser(b)
+	return uuo
+}
+
+// SetNillableIsSuperuser sets the "is_superuser" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableIsSuperuser(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetIsSuperuser(*b)
+	}
+	return uuo
+}
+
+// SetSuperuser sets the "superuser" field.
+func (uuo *UserUpdateOne) SetSuperuser(b bool) *UserUpdateOne {
+	uuo.mutation.SetSuperuser(b)
+	return uuo
+}
+
+// SetNillableSuperuser sets the "superuser" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSuperuser(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetSuperuser(*b)
+	}
+	return uuo
+}
+
+// SetActivatedOn sets the "activated_on" field.
+func (uuo *UserUpdateOne) SetActivatedOn(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetActivatedOn(t)
+	return uuo
+}
+
+// SetNillableActivatedOn sets the "activated_on" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableActivatedOn(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetActivatedOn(*t)
+	}
+	return uuo
+}
+
+// ClearActivatedOn clears the value of the "activated_on" field.
+func (uuo *UserUpdateOne) ClearActivatedOn() *UserUpdateOne {
+	uuo.mutation.ClearActivatedOn()
+	return uuo
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (uuo *UserUpdateOne) SetDisabledAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDisabledAt(t)
 	return uuo
 }
 
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetEmail(*s)
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDisabledAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDisabledAt(*t)
 	}
 	return uuo
 }
 
-// SetPassword sets the "password" field.
-func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
-	uuo.mutation.SetPassword(s)
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (uuo *UserUpdateOne) ClearDisabledAt() *UserUpdateOne {
+	uuo.mutation.ClearDisabledAt()
 	return uuo
 }
 
-// SetNillablePassword sets the "password" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillablePassword(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetPassword(*s)
-	}
+// SetTotpSecret sets the "totp_secret" field.
+func (uuo *UserUpdateOne) SetTotpSecret(s string) *UserUpdateOne {
+	uuo.mutation.SetTotpSecret(s)
 	return uuo
 }
 
-// SetIsSuperuser sets the "is_superuser" field.
-func (uuo *UserUpdateOne) SetIsSuperuser(b bool) *UserUpdateOne {
-	uuo.mutation.SetIsSuperuser(b)
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTotpSecret(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetTotpSecret(*s)
+	}
 	return uuo
 }
 
-// SetNillableIsSuperuser sets the "is_superuser" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableIsSuperuser(b *bool) *UserUpdateOne {
-	if b != nil {
-		uuo.SetIsSuperuser(*b)
-	}
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (uuo *UserUpdateOne) ClearTotpSecret() *UserUpdateOne {
+	uuo.mutation.ClearTotpSecret()
 	return uuo
 }
 
-// SetSuperuser sets the "superuser" field.
-func (uuo *UserUpdateOne) SetSuperuser(b bool) *UserUpdateOne {
-	uuo.mutation.SetSuperuser(b)
+// SetTotpEnabled sets the "totp_enabled" field.
+func (uuo *UserUpdateOne) SetTotpEnabled(b bool) *UserUpdateOne {
+	uuo.mutation.SetTotpEnabled(b)
 	return uuo
 }
 
-// SetNillableSuperuser sets the "superuser" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableSuperuser(b *bool) *UserUpdateOne {
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTotpEnabled(b *bool) *UserUpdateOne {
 	if b != nil {
-		uuo.SetSuperuser(*b)
+		uuo.SetTotpEnabled(*b)
 	}
 	return uuo
 }
 
-// SetRole sets the "role" field.
-func (uuo *UserUpdateOne) SetRole(u user.Role) *UserUpdateOne {
-	uuo.mutation.SetRole(u)
+// SetTotpLastStep sets the "totp_last_step" field.
+func (uuo *UserUpdateOne) SetTotpLastStep(i int64) *UserUpdateOne {
+	uuo.mutation.ResetTotpLastStep()
+	uuo.mutation.SetTotpLastStep(i)
 	return uuo
 }
 
-// SetNillableRole sets the "role" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableRole(u *user.Role) *UserUpdateOne {
-	if u != nil {
-		uuo.SetRole(*u)
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTotpLastStep(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetTotpLastStep(*i)
 	}
 	return uuo
 }
 
-// SetActivatedOn sets the "activated_on" field.
-func (uuo *UserUpdateOne) SetActivatedOn(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetActivatedOn(t)
+// AddTotpLastStep adds i to the "totp_last_step" field.
+func (uuo *UserUpdateOne) AddTotpLastStep(i int64) *UserUpdateOne {
+	uuo.mutation.AddTotpLastStep(i)
 	return uuo
 }
 
-// SetNillableActivatedOn sets the "activated_on" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableActivatedOn(t *time.Time) *UserUpdateOne {
-	if t != nil {
-		uuo.SetActivatedOn(*t)
-	}
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (uuo *UserUpdateOne) SetTotpRecoveryCodes(s []string) *UserUpdateOne {
+	uuo.mutation.SetTotpRecoveryCodes(s)
 	return uuo
 }
 
-// ClearActivatedOn clears the value of the "activated_on" field.
-func (uuo *UserUpdateOne) ClearActivatedOn() *UserUpdateOne {
-	uuo.mutation.ClearActivatedOn()
+// AppendTotpRecoveryCodes appends s to the "totp_recovery_codes" field.
+func (uuo *UserUpdateOne) AppendTotpRecoveryCodes(s []string) *UserUpdateOne {
+	uuo.mutation.AppendTotpRecoveryCodes(s)
+	return uuo
+}
+
+// ClearTotpRecoveryCodes clears the value of the "totp_recovery_codes" field.
+func (uuo *UserUpdateOne) ClearTotpRecoveryCodes() *UserUpdateOne {
+	uuo.mutation.ClearTotpRecoveryCodes()
 	return uuo
 }
 
@@ -599,6 +1115,21 @@ func (uuo *UserUpdateOne) SetGroup(g *Group) *UserUpdateOne {
 	return uuo.SetGroupID(g.ID)
 }
 
+// AddMembershipIDs adds the "memberships" edge to the GroupMembership entity by IDs.
+func (uuo *UserUpdateOne) AddMembershipIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddMembershipIDs(ids...)
+	return uuo
+}
+
+// AddMemberships adds the "memberships" edges to the GroupMembership entity.
+func (uuo *UserUpdateOne) AddMemberships(g ...*GroupMembership) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.AddMembershipIDs(ids...)
+}
+
 // AddAuthTokenIDs adds the "auth_tokens" edge to the AuthTokens entity by IDs.
 func (uuo *UserUpdateOne) AddAuthTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
 	uuo.mutation.AddAuthTokenIDs(ids...)
@@ -629,6 +1160,51 @@ func (uuo *UserUpdateOne) AddNotifiers(n ...*Notifier) *UserUpdateOne {
 	return uuo.AddNotifierIDs(ids...)
 }
 
+// AddUserTokenIDs adds the "user_tokens" edge to the UserToken entity by IDs.
+func (uuo *UserUpdateOne) AddUserTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddUserTokenIDs(ids...)
+	return uuo
+}
+
+// AddUserTokens adds the "user_tokens" edges to the UserToken entity.
+func (uuo *UserUpdateOne) AddUserTokens(u ...*UserToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.AddUserTokenIDs(ids...)
+}
+
+// AddWebauthnCredentialIDs adds the "webauthn_credentials" edge to the WebAuthnCredential entity by IDs.
+func (uuo *UserUpdateOne) AddWebauthnCredentialIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddWebauthnCredentialIDs(ids...)
+	return uuo
+}
+
+// AddWebauthnCredentials adds the "webauthn_credentials" edges to the WebAuthnCredential entity.
+func (uuo *UserUpdateOne) AddWebauthnCredentials(w ...*WebAuthnCredential) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uuo.AddWebauthnCredentialIDs(ids...)
+}
+
+// AddAuditEntryIDs adds the "audit_entries" edge to the AuditEntry entity by IDs.
+func (uuo *UserUpdateOne) AddAuditEntryIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddAuditEntryIDs(ids...)
+	return uuo
+}
+
+// AddAuditEntries adds the "audit_entries" edges to the AuditEntry entity.
+func (uuo *UserUpdateOne) AddAuditEntries(a ...*AuditEntry) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddAuditEntryIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -640,6 +1216,27 @@ func (uuo *UserUpdateOne) ClearGroup() *UserUpdateOne {
 	return uuo
 }
 
+// ClearMemberships clears all "memberships" edges to the GroupMembership entity.
+func (uuo *UserUpdateOne) ClearMemberships() *UserUpdateOne {
+	uuo.mutation.ClearMemberships()
+	return uuo
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to GroupMembership entities by IDs.
+func (uuo *UserUpdateOne) RemoveMembershipIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveMembershipIDs(ids...)
+	return uuo
+}
+
+// RemoveMemberships removes "memberships" edges to GroupMembership entities.
+func (uuo *UserUpdateOne) RemoveMemberships(g ...*GroupMembership) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.RemoveMembershipIDs(ids...)
+}
+
 // ClearAuthTokens clears all "auth_tokens" edges to the AuthTokens entity.
 func (uuo *UserUpdateOne) ClearAuthTokens() *UserUpdateOne {
 	uuo.mutation.ClearAuthTokens()
@@ -682,6 +1279,69 @@ func (uuo *UserUpdateOne) RemoveNotifiers(n ...*Notifier) *UserUpdateOne {
 	return uuo.RemoveNotifierIDs(ids...)
 }
 
+// ClearUserTokens clears all "user_tokens" edges to the UserToken entity.
+func (uuo *UserUpdateOne) ClearUserTokens() *UserUpdateOne {
+	uuo.mutation.ClearUserTokens()
+	return uuo
+}
+
+// RemoveUserTokenIDs removes the "user_tokens" edge to UserToken entities by IDs.
+func (uuo *UserUpdateOne) RemoveUserTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveUserTokenIDs(ids...)
+	return uuo
+}
+
+// RemoveUserTokens removes "user_tokens" edges to UserToken entities.
+func (uuo *UserUpdateOne) RemoveUserTokens(u ...*UserToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.RemoveUserTokenIDs(ids...)
+}
+
+// ClearWebauthnCredentials clears all "webauthn_credentials" edges to the WebAuthnCredential entity.
+func (uuo *UserUpdateOne) ClearWebauthnCredentials() *UserUpdateOne {
+	uuo.mutation.ClearWebauthnCredentials()
+	return uuo
+}
+
+// RemoveWebauthnCredentialIDs removes the "webauthn_credentials" edge to WebAuthnCredential entities by IDs.
+func (uuo *UserUpdateOne) RemoveWebauthnCredentialIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveWebauthnCredentialIDs(ids...)
+	return uuo
+}
+
+// RemoveWebauthnCredentials removes "webauthn_credentials" edges to WebAuthnCredential entities.
+func (uuo *UserUpdateOne) RemoveWebauthnCredentials(w ...*WebAuthnCredential) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uuo.RemoveWebauthnCredentialIDs(ids...)
+}
+
+// ClearAuditEntries clears all "audit_entries" edges to the AuditEntry entity.
+func (uuo *UserUpdateOne) ClearAuditEntries() *UserUpdateOne {
+	uuo.mutation.ClearAuditEntries()
+	return uuo
+}
+
+// RemoveAuditEntryIDs removes the "audit_entries" edge to AuditEntry entities by IDs.
+func (uuo *UserUpdateOne) RemoveAuditEntryIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveAuditEntryIDs(ids...)
+	return uuo
+}
+
+// RemoveAuditEntries removes "audit_entries" edges to AuditEntry entities.
+func (uuo *UserUpdateOne) RemoveAuditEntries(a ...*AuditEntry) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveAuditEntryIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	uuo.mutation.Where(ps...)
@@ -748,9 +1408,9 @@ func (uuo *UserUpdateOne) check() error {
 			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "User.password": %w`, err)}
 		}
 	}
-	if v, ok := uuo.mutation.Role(); ok {
-		if err := user.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+	if v, ok := uuo.mutation.TotpSecret(); ok {
+		if err := user.TotpSecretValidator(v); err != nil {
+			return &ValidationError{Name: "totp_secret", err: fmt.Errorf(`ent: validator failed for field "User.totp_secret": %w`, err)}
 		}
 	}
 	if _, ok := uuo.mutation.GroupID(); uuo.mutation.GroupCleared() && !ok {
@@ -806,15 +1466,44 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.Superuser(); ok {
 		_spec.SetField(user.FieldSuperuser, field.TypeBool, value)
 	}
-	if value, ok := uuo.mutation.Role(); ok {
-		_spec.SetField(user.FieldRole, field.TypeEnum, value)
-	}
 	if value, ok := uuo.mutation.ActivatedOn(); ok {
 		_spec.SetField(user.FieldActivatedOn, field.TypeTime, value)
 	}
 	if uuo.mutation.ActivatedOnCleared() {
 		_spec.ClearField(user.FieldActivatedOn, field.TypeTime)
 	}
+	if value, ok := uuo.mutation.DisabledAt(); ok {
+		_spec.SetField(user.FieldDisabledAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DisabledAtCleared() {
+		_spec.ClearField(user.FieldDisabledAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+	}
+	if uuo.mutation.TotpSecretCleared() {
+		_spec.ClearField(user.FieldTotpSecret, field.TypeString)
+	}
+	if value, ok := uuo.mutation.TotpEnabled(); ok {
+		_spec.SetField(user.FieldTotpEnabled, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.TotpLastStep(); ok {
+		_spec.SetField(user.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedTotpLastStep(); ok {
+		_spec.AddField(user.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.TotpRecoveryCodes(); ok {
+		_spec.SetField(user.FieldTotpRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := uuo.mutation.AppendedTotpRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldTotpRecoveryCodes, value)
+		})
+	}
+	if uuo.mutation.TotpRecoveryCodesCleared() {
+		_spec.ClearField(user.FieldTotpRecoveryCodes, field.TypeJSON)
+	}
 	if uuo.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -844,6 +1533,51 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !uuo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if uuo.mutation.AuthTokensCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -934,6 +1668,141 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.UserTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserTokensTable,
+			Columns: []string{user.UserTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usertoken.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedUserTokensIDs(); len(nodes) > 0 && !uuo.mutation.UserTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserTokensTable,
+			Columns: []string{user.UserTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usertoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.UserTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserTokensTable,
+			Columns: []string{user.UserTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usertoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.WebauthnCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebauthnCredentialsTable,
+			Columns: []string{user.WebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedWebauthnCredentialsIDs(); len(nodes) > 0 && !uuo.mutation.WebauthnCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebauthnCredentialsTable,
+			Columns: []string{user.WebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.WebauthnCredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebauthnCredentialsTable,
+			Columns: []string{user.WebauthnCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.AuditEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditEntriesTable,
+			Columns: []string{user.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedAuditEntriesIDs(); len(nodes) > 0 && !uuo.mutation.AuditEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditEntriesTable,
+			Columns: []string{user.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.AuditEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditEntriesTable,
+			Columns: []string{user.AuditEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
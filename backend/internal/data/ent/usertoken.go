@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/usertoken"
+)
+
+// UserToken is the model entity for the UserToken schema.
+type UserToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Purpose holds the value of the "purpose" field.
+	Purpose usertoken.Purpose `json:"purpose,omitempty"`
+	// Token holds the value of the "token" field.
+	Token []byte `json:"token,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserTokenQuery when eager-loading is set.
+	Edges        UserTokenEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UserTokenEdges holds the relations/edges for other nodes in the graph.
+type UserTokenEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserTokenEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usertoken.FieldToken:
+			values[i] = new([]byte)
+		case usertoken.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case usertoken.FieldPurpose:
+			values[i] = new(sql.NullString)
+		case usertoken.FieldCreatedAt, usertoken.FieldUpdatedAt, usertoken.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case usertoken.FieldID, usertoken.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserToken fields.
+func (ut *UserToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usertoken.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ut.ID = *value
+			}
+		case usertoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ut.CreatedAt = value.Time
+			}
+		case usertoken.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ut.UpdatedAt = value.Time
+			}
+		case usertoken.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				ut.UserID = *value
+			}
+		case usertoken.FieldPurpose:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field purpose", values[i])
+			} else if value.Valid {
+				ut.Purpose = usertoken.Purpose(value.String)
+			}
+		case usertoken.FieldToken:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value != nil {
+				ut.Token = *value
+			}
+		case usertoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ut.ExpiresAt = value.Time
+			}
+		case usertoken.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				ut.Attempts = int(value.Int64)
+			}
+		default:
+			ut.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserToken.
+// This includes values selected through modifiers, order, etc.
+func (ut *UserToken) Value(name string) (ent.Value, error) {
+	return ut.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the UserToken entity.
+func (ut *UserToken) QueryUser() *UserQuery {
+	return NewUserTokenClient(ut.config).QueryUser(ut)
+}
+
+// Update returns a builder for updating this UserToken.
+// Note that you need to call UserToken.Unwrap() before calling this method if this UserToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ut *UserToken) Update() *UserTokenUpdateOne {
+	return NewUserTokenClient(ut.config).UpdateOne(ut)
+}
+
+// Unwrap unwraps the UserToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ut *UserToken) Unwrap() *UserToken {
+	_tx, ok := ut.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserToken is not a transactional entity")
+	}
+	ut.config.driver = _tx.drv
+	return ut
+}
+
+// String implements the fmt.Stringer.
+func (ut *UserToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ut.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ut.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ut.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ut.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("purpose=")
+	builder.WriteString(fmt.Sprintf("%v", ut.Purpose))
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(fmt.Sprintf("%v", ut.Token))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ut.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", ut.Attempts))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserTokens is a parsable slice of UserToken.
+type UserTokens []*UserToken
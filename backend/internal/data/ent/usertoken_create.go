@@ -0,0 +1,355 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/usertoken"
+)
+
+// UserTokenCreate is the builder for creating a UserToken entity.
+type UserTokenCreate struct {
+	config
+	mutation *UserTokenMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (utc *UserTokenCreate) SetCreatedAt(t time.Time) *UserTokenCreate {
+	utc.mutation.SetCreatedAt(t)
+	return utc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (utc *UserTokenCreate) SetNillableCreatedAt(t *time.Time) *UserTokenCreate {
+	if t != nil {
+		utc.SetCreatedAt(*t)
+	}
+	return utc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (utc *UserTokenCreate) SetUpdatedAt(t time.Time) *UserTokenCreate {
+	utc.mutation.SetUpdatedAt(t)
+	return utc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (utc *UserTokenCreate) SetNillableUpdatedAt(t *time.Time) *UserTokenCreate {
+	if t != nil {
+		utc.SetUpdatedAt(*t)
+	}
+	return utc
+}
+
+// SetUserID sets the "user_id" field.
+func (utc *UserTokenCreate) SetUserID(u uuid.UUID) *UserTokenCreate {
+	utc.mutation.SetUserID(u)
+	return utc
+}
+
+// SetPurpose sets the "purpose" field.
+func (utc *UserTokenCreate) SetPurpose(u usertoken.Purpose) *UserTokenCreate {
+	utc.mutation.SetPurpose(u)
+	return utc
+}
+
+// SetToken sets the "token" field.
+func (utc *UserTokenCreate) SetToken(b []byte) *UserTokenCreate {
+	utc.mutation.SetToken(b)
+	return utc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (utc *UserTokenCreate) SetExpiresAt(t time.Time) *UserTokenCreate {
+	utc.mutation.SetExpiresAt(t)
+	return utc
+}
+
+// SetAttempts sets the "attempts" field.
+func (utc *UserTokenCreate) SetAttempts(i int) *UserTokenCreate {
+	utc.mutation.SetAttempts(i)
+	return utc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (utc *UserTokenCreate) SetNillableAttempts(i *int) *UserTokenCreate {
+	if i != nil {
+		utc.SetAttempts(*i)
+	}
+	return utc
+}
+
+// SetID sets the "id" field.
+func (utc *UserTokenCreate) SetID(u uuid.UUID) *UserTokenCreate {
+	utc.mutation.SetID(u)
+	return utc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (utc *UserTokenCreate) SetNillableID(u *uuid.UUID) *UserTokenCreate {
+	if u != nil {
+		utc.SetID(*u)
+	}
+	return utc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (utc *UserTokenCreate) SetUser(u *User) *UserTokenCreate {
+	return utc.SetUserID(u.ID)
+}
+
+// Mutation returns the UserTokenMutation object of the builder.
+func (utc *UserTokenCreate) Mutation() *UserTokenMutation {
+	return utc.mutation
+}
+
+// Save creates the UserToken in the database.
+func (utc *UserTokenCreate) Save(ctx context.Context) (*UserToken, error) {
+	utc.defaults()
+	return withHooks(ctx, utc.sqlSave, utc.mutation, utc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (utc *UserTokenCreate) SaveX(ctx context.Context) *UserToken {
+	v, err := utc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (utc *UserTokenCreate) Exec(ctx context.Context) error {
+	_, err := utc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utc *UserTokenCreate) ExecX(ctx context.Context) {
+	if err := utc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (utc *UserTokenCreate) defaults() {
+	if _, ok := utc.mutation.CreatedAt(); !ok {
+		v := usertoken.DefaultCreatedAt()
+		utc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := utc.mutation.UpdatedAt(); !ok {
+		v := usertoken.DefaultUpdatedAt()
+		utc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := utc.mutation.Attempts(); !ok {
+		v := usertoken.DefaultAttempts
+		utc.mutation.SetAttempts(v)
+	}
+	if _, ok := utc.mutation.ID(); !ok {
+		v := usertoken.DefaultID()
+		utc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (utc *UserTokenCreate) check() error {
+	if _, ok := utc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserToken.created_at"`)}
+	}
+	if _, ok := utc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserToken.updated_at"`)}
+	}
+	if _, ok := utc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserToken.user_id"`)}
+	}
+	if _, ok := utc.mutation.Purpose(); !ok {
+		return &ValidationError{Name: "purpose", err: errors.New(`ent: missing required field "UserToken.purpose"`)}
+	}
+	if v, ok := utc.mutation.Purpose(); ok {
+		if err := usertoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "UserToken.purpose": %w`, err)}
+		}
+	}
+	if _, ok := utc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "UserToken.token"`)}
+	}
+	if _, ok := utc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "UserToken.expires_at"`)}
+	}
+	if _, ok := utc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "UserToken.attempts"`)}
+	}
+	if _, ok := utc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserToken.user"`)}
+	}
+	return nil
+}
+
+func (utc *UserTokenCreate) sqlSave(ctx context.Context) (*UserToken, error) {
+	if err := utc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := utc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, utc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	utc.mutation.id = &_node.ID
+	utc.mutation.done = true
+	return _node, nil
+}
+
+func (utc *UserTokenCreate) createSpec() (*UserToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserToken{config: utc.config}
+		_spec = sqlgraph.NewCreateSpec(usertoken.Table, sqlgraph.NewFieldSpec(usertoken.FieldID, field.TypeUUID))
+	)
+	if id, ok := utc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := utc.mutation.CreatedAt(); ok {
+		_spec.SetField(usertoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := utc.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertoken.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := utc.mutation.Purpose(); ok {
+		_spec.SetField(usertoken.FieldPurpose, field.TypeEnum, value)
+		_node.Purpose = value
+	}
+	if value, ok := utc.mutation.Token(); ok {
+		_spec.SetField(usertoken.FieldToken, field.TypeBytes, value)
+		_node.Token = value
+	}
+	if value, ok := utc.mutation.ExpiresAt(); ok {
+		_spec.SetField(usertoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := utc.mutation.Attempts(); ok {
+		_spec.SetField(usertoken.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if nodes := utc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usertoken.UserTable,
+			Columns: []string{usertoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UserTokenCreateBulk is the builder for creating many UserToken entities in bulk.
+type UserTokenCreateBulk struct {
+	config
+	err      error
+	builders []*UserTokenCreate
+}
+
+// Save creates the UserToken entities in the database.
+func (utcb *UserTokenCreateBulk) Save(ctx context.Context) ([]*UserToken, error) {
+	if utcb.err != nil {
+		return nil, utcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(utcb.builders))
+	nodes := make([]*UserToken, len(utcb.builders))
+	mutators := make([]Mutator, len(utcb.builders))
+	for i := range utcb.builders {
+		func(i int, root context.Context) {
+			builder := utcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, utcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, utcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, utcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (utcb *UserTokenCreateBulk) SaveX(ctx context.Context) []*UserToken {
+	v, err := utcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (utcb *UserTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := utcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utcb *UserTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := utcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/usertoken"
+)
+
+// UserTokenQuery is the builder for querying UserToken entities.
+type UserTokenQuery struct {
+	config
+	ctx        *QueryContext
+	order      []usertoken.OrderOption
+	inters     []Interceptor
+	predicates []predicate.UserToken
+	withUser   *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UserTokenQuery builder.
+func (utq *UserTokenQuery) Where(ps ...predicate.UserToken) *UserTokenQuery {
+	utq.predicates = append(utq.predicates, ps...)
+	return utq
+}
+
+// Limit the number of records to be returned by this query.
+func (utq *UserTokenQuery) Limit(limit int) *UserTokenQuery {
+	utq.ctx.Limit = &limit
+	return utq
+}
+
+// Offset to start from.
+func (utq *UserTokenQuery) Offset(offset int) *UserTokenQuery {
+	utq.ctx.Offset = &offset
+	return utq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (utq *UserTokenQuery) Unique(unique bool) *UserTokenQuery {
+	utq.ctx.Unique = &unique
+	return utq
+}
+
+// Order specifies how the records should be ordered.
+func (utq *UserTokenQuery) Order(o ...usertoken.OrderOption) *UserTokenQuery {
+	utq.order = append(utq.order, o...)
+	return utq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (utq *UserTokenQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: utq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := utq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := utq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(usertoken.Table, usertoken.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, usertoken.UserTable, usertoken.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(utq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first UserToken entity from the query.
+// Returns a *NotFoundError when no UserToken was found.
+func (utq *UserTokenQuery) First(ctx context.Context) (*UserToken, error) {
+	nodes, err := utq.Limit(1).All(setContextOp(ctx, utq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{usertoken.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (utq *UserTokenQuery) FirstX(ctx context.Context) *UserToken {
+	node, err := utq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UserToken ID from the query.
+// Returns a *NotFoundError when no UserToken ID was found.
+func (utq *UserTokenQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = utq.Limit(1).IDs(setContextOp(ctx, utq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{usertoken.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (utq *UserTokenQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := utq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UserToken entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UserToken entity is found.
+// Returns a *NotFoundError when no UserToken entities are found.
+func (utq *UserTokenQuery) Only(ctx context.Context) (*UserToken, error) {
+	nodes, err := utq.Limit(2).All(setContextOp(ctx, utq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{usertoken.Label}
+	default:
+		return nil, &NotSingularError{usertoken.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (utq *UserTokenQuery) OnlyX(ctx context.Context) *UserToken {
+	node, err := utq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UserToken ID in the query.
+// Returns a *NotSingularError when more than one UserToken ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (utq *UserTokenQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = utq.Limit(2).IDs(setContextOp(ctx, utq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{usertoken.Label}
+	default:
+		err = &NotSingularError{usertoken.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (utq *UserTokenQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := utq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UserTokens.
+func (utq *UserTokenQuery) All(ctx context.Context) ([]*UserToken, error) {
+	ctx = setContextOp(ctx, utq.ctx, "All")
+	if err := utq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UserToken, *UserTokenQuery]()
+	return withInterceptors[[]*UserToken](ctx, utq, qr, utq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (utq *UserTokenQuery) AllX(ctx context.Context) []*UserToken {
+	nodes, err := utq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UserToken IDs.
+func (utq *UserTokenQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if utq.ctx.Unique == nil && utq.path != nil {
+		utq.Unique(true)
+	}
+	ctx = setContextOp(ctx, utq.ctx, "IDs")
+	if err = utq.Select(usertoken.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (utq *UserTokenQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := utq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (utq *UserTokenQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, utq.ctx, "Count")
+	if err := utq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, utq, querierCount[*UserTokenQuery](), utq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (utq *UserTokenQuery) CountX(ctx context.Context) int {
+	count, err := utq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (utq *UserTokenQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, utq.ctx, "Exist")
+	switch _, err := utq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (utq *UserTokenQuery) ExistX(ctx context.Context) bool {
+	exist, err := utq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UserTokenQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (utq *UserTokenQuery) Clone() *UserTokenQuery {
+	if utq == nil {
+		return nil
+	}
+	return &UserTokenQuery{
+		config:     utq.config,
+		ctx:        utq.ctx.Clone(),
+		order:      append([]usertoken.OrderOption{}, utq.order...),
+		inters:     append([]Interceptor{}, utq.inters...),
+		predicates: append([]predicate.UserToken{}, utq.predicates...),
+		withUser:   utq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  utq.sql.Clone(),
+		path: utq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (utq *UserTokenQuery) WithUser(opts ...func(*UserQuery)) *UserTokenQuery {
+	query := (&UserClient{config: utq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	utq.withUser = query
+	return utq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UserToken.Query().
+//		GroupBy(usertoken.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (utq *UserTokenQuery) GroupBy(field string, fields ...string) *UserTokenGroupBy {
+	utq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserTokenGroupBy{build: utq}
+	grbuild.flds = &utq.ctx.Fields
+	grbuild.label = usertoken.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.UserToken.Query().
+//		Select(usertoken.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (utq *UserTokenQuery) Select(fields ...string) *UserTokenSelect {
+	utq.ctx.Fields = append(utq.ctx.Fields, fields...)
+	sbuild := &UserTokenSelect{UserTokenQuery: utq}
+	sbuild.label = usertoken.Label
+	sbuild.flds, sbuild.scan = &utq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UserTokenSelect configured with the given aggregations.
+func (utq *UserTokenQuery) Aggregate(fns ...AggregateFunc) *UserTokenSelect {
+	return utq.Select().Aggregate(fns...)
+}
+
+func (utq *UserTokenQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range utq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, utq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range utq.ctx.Fields {
+		if !usertoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if utq.path != nil {
+		prev, err := utq.path(ctx)
+		if err != nil {
+			return err
+		}
+		utq.sql = prev
+	}
+	return nil
+}
+
+func (utq *UserTokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserToken, error) {
+	var (
+		nodes       = []*UserToken{}
+		_spec       = utq.querySpec()
+		loadedTypes = [1]bool{
+			utq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UserToken).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UserToken{config: utq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, utq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := utq.withUser; query != nil {
+		if err := utq.loadUser(ctx, query, nodes, nil,
+			func(n *UserToken, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (utq *UserTokenQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*UserToken, init func(*UserToken), assign func(*UserToken, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*UserToken)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (utq *UserTokenQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := utq.querySpec()
+	_spec.Node.Columns = utq.ctx.Fields
+	if len(utq.ctx.Fields) > 0 {
+		_spec.Unique = utq.ctx.Unique != nil && *utq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, utq.driver, _spec)
+}
+
+func (utq *UserTokenQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(usertoken.Table, usertoken.Columns, sqlgraph.NewFieldSpec(usertoken.FieldID, field.TypeUUID))
+	_spec.From = utq.sql
+	if unique := utq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if utq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := utq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usertoken.FieldID)
+		for i := range fields {
+			if fields[i] != usertoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if utq.withUser != nil {
+			_spec.Node.AddColumnOnce(usertoken.FieldUserID)
+		}
+	}
+	if ps := utq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := utq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := utq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := utq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (utq *UserTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(utq.driver.Dialect())
+	t1 := builder.Table(usertoken.Table)
+	columns := utq.ctx.Fields
+	if len(columns) == 0 {
+		columns = usertoken.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if utq.sql != nil {
+		selector = utq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if utq.ctx.Unique != nil && *utq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range utq.predicates {
+		p(selector)
+	}
+	for _, p := range utq.order {
+		p(selector)
+	}
+	if offset := utq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := utq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// UserTokenGroupBy is the group-by builder for UserToken entities.
+type UserTokenGroupBy struct {
+	selector
+	build *UserTokenQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (utgb *UserTokenGroupBy) Aggregate(fns ...AggregateFunc) *UserTokenGroupBy {
+	utgb.fns = append(utgb.fns, fns...)
+	return utgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (utgb *UserTokenGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, utgb.build.ctx, "GroupBy")
+	if err := utgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserTokenQuery, *UserTokenGroupBy](ctx, utgb.build, utgb, utgb.build.inters, v)
+}
+
+func (utgb *UserTokenGroupBy) sqlScan(ctx context.Context, root *UserTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(utgb.fns))
+	for _, fn := range utgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*utgb.flds)+len(utgb.fns))
+		for _, f := range *utgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*utgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := utgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UserTokenSelect is the builder for selecting fields of UserToken entities.
+type UserTokenSelect struct {
+	*UserTokenQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (uts *UserTokenSelect) Aggregate(fns ...AggregateFunc) *UserTokenSelect {
+	uts.fns = append(uts.fns, fns...)
+	return uts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uts *UserTokenSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, uts.ctx, "Select")
+	if err := uts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserTokenQuery, *UserTokenSelect](ctx, uts.UserTokenQuery, uts, uts.inters, v)
+}
+
+func (uts *UserTokenSelect) sqlScan(ctx context.Context, root *UserTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(uts.fns))
+	for _, fn := range uts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*uts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,494 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/usertoken"
+)
+
+// UserTokenUpdate is the builder for updating UserToken entities.
+type UserTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserTokenMutation
+}
+
+// Where appends a list predicates to the UserTokenUpdate builder.
+func (utu *UserTokenUpdate) Where(ps ...predicate.UserToken) *UserTokenUpdate {
+	utu.mutation.Where(ps...)
+	return utu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (utu *UserTokenUpdate) SetUpdatedAt(t time.Time) *UserTokenUpdate {
+	utu.mutation.SetUpdatedAt(t)
+	return utu
+}
+
+// SetUserID sets the "user_id" field.
+func (utu *UserTokenUpdate) SetUserID(u uuid.UUID) *UserTokenUpdate {
+	utu.mutation.SetUserID(u)
+	return utu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (utu *UserTokenUpdate) SetNillableUserID(u *uuid.UUID) *UserTokenUpdate {
+	if u != nil {
+		utu.SetUserID(*u)
+	}
+	return utu
+}
+
+// SetPurpose sets the "purpose" field.
+func (utu *UserTokenUpdate) SetPurpose(u usertoken.Purpose) *UserTokenUpdate {
+	utu.mutation.SetPurpose(u)
+	return utu
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (utu *UserTokenUpdate) SetNillablePurpose(u *usertoken.Purpose) *UserTokenUpdate {
+	if u != nil {
+		utu.SetPurpose(*u)
+	}
+	return utu
+}
+
+// SetToken sets the "token" field.
+func (utu *UserTokenUpdate) SetToken(b []byte) *UserTokenUpdate {
+	utu.mutation.SetToken(b)
+	return utu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (utu *UserTokenUpdate) SetExpiresAt(t time.Time) *UserTokenUpdate {
+	utu.mutation.SetExpiresAt(t)
+	return utu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (utu *UserTokenUpdate) SetNillableExpiresAt(t *time.Time) *UserTokenUpdate {
+	if t != nil {
+		utu.SetExpiresAt(*t)
+	}
+	return utu
+}
+
+// SetAttempts sets the "attempts" field.
+func (utu *UserTokenUpdate) SetAttempts(i int) *UserTokenUpdate {
+	utu.mutation.ResetAttempts()
+	utu.mutation.SetAttempts(i)
+	return utu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (utu *UserTokenUpdate) SetNillableAttempts(i *int) *UserTokenUpdate {
+	if i != nil {
+		utu.SetAttempts(*i)
+	}
+	return utu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (utu *UserTokenUpdate) AddAttempts(i int) *UserTokenUpdate {
+	utu.mutation.AddAttempts(i)
+	return utu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (utu *UserTokenUpdate) SetUser(u *User) *UserTokenUpdate {
+	return utu.SetUserID(u.ID)
+}
+
+// Mutation returns the UserTokenMutation object of the builder.
+func (utu *UserTokenUpdate) Mutation() *UserTokenMutation {
+	return utu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (utu *UserTokenUpdate) ClearUser() *UserTokenUpdate {
+	utu.mutation.ClearUser()
+	return utu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (utu *UserTokenUpdate) Save(ctx context.Context) (int, error) {
+	utu.defaults()
+	return withHooks(ctx, utu.sqlSave, utu.mutation, utu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (utu *UserTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := utu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (utu *UserTokenUpdate) Exec(ctx context.Context) error {
+	_, err := utu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utu *UserTokenUpdate) ExecX(ctx context.Context) {
+	if err := utu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (utu *UserTokenUpdate) defaults() {
+	if _, ok := utu.mutation.UpdatedAt(); !ok {
+		v := usertoken.UpdateDefaultUpdatedAt()
+		utu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (utu *UserTokenUpdate) check() error {
+	if v, ok := utu.mutation.Purpose(); ok {
+		if err := usertoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "UserToken.purpose": %w`, err)}
+		}
+	}
+	if _, ok := utu.mutation.UserID(); utu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserToken.user"`)
+	}
+	return nil
+}
+
+func (utu *UserTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := utu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usertoken.Table, usertoken.Columns, sqlgraph.NewFieldSpec(usertoken.FieldID, field.TypeUUID))
+	if ps := utu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := utu.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := utu.mutation.Purpose(); ok {
+		_spec.SetField(usertoken.FieldPurpose, field.TypeEnum, value)
+	}
+	if value, ok := utu.mutation.Token(); ok {
+		_spec.SetField(usertoken.FieldToken, field.TypeBytes, value)
+	}
+	if value, ok := utu.mutation.ExpiresAt(); ok {
+		_spec.SetField(usertoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := utu.mutation.Attempts(); ok {
+		_spec.SetField(usertoken.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := utu.mutation.AddedAttempts(); ok {
+		_spec.AddField(usertoken.FieldAttempts, field.TypeInt, value)
+	}
+	if utu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usertoken.UserTable,
+			Columns: []string{usertoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := utu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usertoken.UserTable,
+			Columns: []string{usertoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, utu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usertoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	utu.mutation.done = true
+	return n, nil
+}
+
+// UserTokenUpdateOne is the builder for updating a single UserToken entity.
+type UserTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserTokenMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (utuo *UserTokenUpdateOne) SetUpdatedAt(t time.Time) *UserTokenUpdateOne {
+	utuo.mutation.SetUpdatedAt(t)
+	return utuo
+}
+
+// SetUserID sets the "user_id" field.
+func (utuo *UserTokenUpdateOne) SetUserID(u uuid.UUID) *UserTokenUpdateOne {
+	utuo.mutation.SetUserID(u)
+	return utuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (utuo *UserTokenUpdateOne) SetNillableUserID(u *uuid.UUID) *UserTokenUpdateOne {
+	if u != nil {
+		utuo.SetUserID(*u)
+	}
+	return utuo
+}
+
+// SetPurpose sets the "purpose" field.
+func (utuo *UserTokenUpdateOne) SetPurpose(u usertoken.Purpose) *UserTokenUpdateOne {
+	utuo.mutation.SetPurpose(u)
+	return utuo
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (utuo *UserTokenUpdateOne) SetNillablePurpose(u *usertoken.Purpose) *UserTokenUpdateOne {
+	if u != nil {
+		utuo.SetPurpose(*u)
+	}
+	return utuo
+}
+
+// SetToken sets the "token" field.
+func (utuo *UserTokenUpdateOne) SetToken(b []byte) *UserTokenUpdateOne {
+	utuo.mutation.SetToken(b)
+	return utuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (utuo *UserTokenUpdateOne) SetExpiresAt(t time.Time) *UserTokenUpdateOne {
+	utuo.mutation.SetExpiresAt(t)
+	return utuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (utuo *UserTokenUpdateOne) SetNillableExpiresAt(t *time.Time) *UserTokenUpdateOne {
+	if t != nil {
+		utuo.SetExpiresAt(*t)
+	}
+	return utuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (utuo *UserTokenUpdateOne) SetAttempts(i int) *UserTokenUpdateOne {
+	utuo.mutation.ResetAttempts()
+	utuo.mutation.SetAttempts(i)
+	return utuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (utuo *UserTokenUpdateOne) SetNillableAttempts(i *int) *UserTokenUpdateOne {
+	if i != nil {
+		utuo.SetAttempts(*i)
+	}
+	return utuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (utuo *UserTokenUpdateOne) AddAttempts(i int) *UserTokenUpdateOne {
+	utuo.mutation.AddAttempts(i)
+	return utuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (utuo *UserTokenUpdateOne) SetUser(u *User) *UserTokenUpdateOne {
+	return utuo.SetUserID(u.ID)
+}
+
+// Mutation returns the UserTokenMutation object of the builder.
+func (utuo *UserTokenUpdateOne) Mutation() *UserTokenMutation {
+	return utuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (utuo *UserTokenUpdateOne) ClearUser() *UserTokenUpdateOne {
+	utuo.mutation.ClearUser()
+	return utuo
+}
+
+// Where appends a list predicates to the UserTokenUpdate builder.
+func (utuo *UserTokenUpdateOne) Where(ps ...predicate.UserToken) *UserTokenUpdateOne {
+	utuo.mutation.Where(ps...)
+	return utuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (utuo *UserTokenUpdateOne) Select(field string, fields ...string) *UserTokenUpdateOne {
+	utuo.fields = append([]string{field}, fields...)
+	return utuo
+}
+
+// Save executes the query and returns the updated UserToken entity.
+func (utuo *UserTokenUpdateOne) Save(ctx context.Context) (*UserToken, error) {
+	utuo.defaults()
+	return withHooks(ctx, utuo.sqlSave, utuo.mutation, utuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (utuo *UserTokenUpdateOne) SaveX(ctx context.Context) *UserToken {
+	node, err := utuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (utuo *UserTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := utuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utuo *UserTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := utuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (utuo *UserTokenUpdateOne) defaults() {
+	if _, ok := utuo.mutation.UpdatedAt(); !ok {
+		v := usertoken.UpdateDefaultUpdatedAt()
+		utuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (utuo *UserTokenUpdateOne) check() error {
+	if v, ok := utuo.mutation.Purpose(); ok {
+		if err := usertoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "UserToken.purpose": %w`, err)}
+		}
+	}
+	if _, ok := utuo.mutation.UserID(); utuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserToken.user"`)
+	}
+	return nil
+}
+
+func (utuo *UserTokenUpdateOne) sqlSave(ctx context.Context) (_node *UserToken, err error) {
+	if err := utuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usertoken.Table, usertoken.Columns, sqlgraph.NewFieldSpec(usertoken.FieldID, field.TypeUUID))
+	id, ok := utuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := utuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usertoken.FieldID)
+		for _, f := range fields {
+			if !usertoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usertoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := utuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := utuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := utuo.mutation.Purpose(); ok {
+		_spec.SetField(usertoken.FieldPurpose, field.TypeEnum, value)
+	}
+	if value, ok := utuo.mutation.Token(); ok {
+		_spec.SetField(usertoken.FieldToken, field.TypeBytes, value)
+	}
+	if value, ok := utuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(usertoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := utuo.mutation.Attempts(); ok {
+		_spec.SetField(usertoken.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := utuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(usertoken.FieldAttempts, field.TypeInt, value)
+	}
+	if utuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usertoken.UserTable,
+			Columns: []string{usertoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := utuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usertoken.UserTable,
+			Columns: []string{usertoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &UserToken{config: utuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, utuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usertoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	utuo.mutation.done = true
+	return _node, nil
+}
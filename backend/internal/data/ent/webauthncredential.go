@@ -0,0 +1,282 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/webauthncredential"
+)
+
+// WebAuthnCredential is the model entity for the WebAuthnCredential schema.
+type WebAuthnCredential struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// CredentialID holds the value of the "credential_id" field.
+	CredentialID []byte `json:"credential_id,omitempty"`
+	// PublicKey holds the value of the "public_key" field.
+	PublicKey []byte `json:"public_key,omitempty"`
+	// AttestationType holds the value of the "attestation_type" field.
+	AttestationType string `json:"attestation_type,omitempty"`
+	// Transports holds the value of the "transports" field.
+	Transports []string `json:"transports,omitempty"`
+	// Aaguid holds the value of the "aaguid" field.
+	Aaguid []byte `json:"aaguid,omitempty"`
+	// SignCount holds the value of the "sign_count" field.
+	SignCount uint32 `json:"sign_count,omitempty"`
+	// BackupEligible holds the value of the "backup_eligible" field.
+	BackupEligible bool `json:"backup_eligible,omitempty"`
+	// BackupState holds the value of the "backup_state" field.
+	BackupState bool `json:"backup_state,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebAuthnCredentialQuery when eager-loading is set.
+	Edges        WebAuthnCredentialEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WebAuthnCredentialEdges holds the relations/edges for other nodes in the graph.
+type WebAuthnCredentialEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebAuthnCredentialEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebAuthnCredential) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webauthncredential.FieldCredentialID, webauthncredential.FieldPublicKey, webauthncredential.FieldTransports, webauthncredential.FieldAaguid:
+			values[i] = new([]byte)
+		case webauthncredential.FieldBackupEligible, webauthncredential.FieldBackupState:
+			values[i] = new(sql.NullBool)
+		case webauthncredential.FieldSignCount:
+			values[i] = new(sql.NullInt64)
+		case webauthncredential.FieldName, webauthncredential.FieldAttestationType:
+			values[i] = new(sql.NullString)
+		case webauthncredential.FieldCreatedAt, webauthncredential.FieldUpdatedAt, webauthncredential.FieldLastUsedAt:
+			values[i] = new(sql.NullTime)
+		case webauthncredential.FieldID, webauthncredential.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebAuthnCredential fields.
+func (wac *WebAuthnCredential) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webauthncredential.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				wac.ID = *value
+			}
+		case webauthncredential.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wac.CreatedAt = value.Time
+			}
+		case webauthncredential.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				wac.UpdatedAt = value.Time
+			}
+		case webauthncredential.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				wac.UserID = *value
+			}
+		case webauthncredential.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				wac.Name = value.String
+			}
+		case webauthncredential.FieldCredentialID:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_id", values[i])
+			} else if value != nil {
+				wac.CredentialID = *value
+			}
+		case webauthncredential.FieldPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value != nil {
+				wac.PublicKey = *value
+			}
+		case webauthncredential.FieldAttestationType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attestation_type", values[i])
+			} else if value.Valid {
+				wac.AttestationType = value.String
+			}
+		case webauthncredential.FieldTransports:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field transports", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wac.Transports); err != nil {
+					return fmt.Errorf("unmarshal field transports: %w", err)
+				}
+			}
+		case webauthncredential.FieldAaguid:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field aaguid", values[i])
+			} else if value != nil {
+				wac.Aaguid = *value
+			}
+		case webauthncredential.FieldSignCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sign_count", values[i])
+			} else if value.Valid {
+				wac.SignCount = uint32(value.Int64)
+			}
+		case webauthncredential.FieldBackupEligible:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field backup_eligible", values[i])
+			} else if value.Valid {
+				wac.BackupEligible = value.Bool
+			}
+		case webauthncredential.FieldBackupState:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field backup_state", values[i])
+			} else if value.Valid {
+				wac.BackupState = value.Bool
+			}
+		case webauthncredential.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				wac.LastUsedAt = new(time.Time)
+				*wac.LastUsedAt = value.Time
+			}
+		default:
+			wac.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebAuthnCredential.
+// This includes values selected through modifiers, order, etc.
+func (wac *WebAuthnCredential) Value(name string) (ent.Value, error) {
+	return wac.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the WebAuthnCredential entity.
+func (wac *WebAuthnCredential) QueryUser() *UserQuery {
+	return NewWebAuthnCredentialClient(wac.config).QueryUser(wac)
+}
+
+// Update returns a builder for updating this WebAuthnCredential.
+// Note that you need to call WebAuthnCredential.Unwrap() before calling this method if this WebAuthnCredential
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wac *WebAuthnCredential) Update() *WebAuthnCredentialUpdateOne {
+	return NewWebAuthnCredentialClient(wac.config).UpdateOne(wac)
+}
+
+// Unwrap unwraps the WebAuthnCredential entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wac *WebAuthnCredential) Unwrap() *WebAuthnCredential {
+	_tx, ok := wac.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebAuthnCredential is not a transactional entity")
+	}
+	wac.config.driver = _tx.drv
+	return wac
+}
+
+// String implements the fmt.Stringer.
+func (wac *WebAuthnCredential) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebAuthnCredential(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wac.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(wac.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(wac.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", wac.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(wac.Name)
+	builder.WriteString(", ")
+	builder.WriteString("credential_id=")
+	builder.WriteString(fmt.Sprintf("%v", wac.CredentialID))
+	builder.WriteString(", ")
+	builder.WriteString("public_key=")
+	builder.WriteString(fmt.Sprintf("%v", wac.PublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("attestation_type=")
+	builder.WriteString(wac.AttestationType)
+	builder.WriteString(", ")
+	builder.WriteString("transports=")
+	builder.WriteString(fmt.Sprintf("%v", wac.Transports))
+	builder.WriteString(", ")
+	builder.WriteString("aaguid=")
+	builder.WriteString(fmt.Sprintf("%v", wac.Aaguid))
+	builder.WriteString(", ")
+	builder.WriteString("sign_count=")
+	builder.WriteString(fmt.Sprintf("%v", wac.SignCount))
+	builder.WriteString(", ")
+	builder.WriteString("backup_eligible=")
+	builder.WriteString(fmt.Sprintf("%v", wac.BackupEligible))
+	builder.WriteString(", ")
+	builder.WriteString("backup_state=")
+	builder.WriteString(fmt.Sprintf("%v", wac.BackupState))
+	builder.WriteString(", ")
+	if v := wac.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebAuthnCredentials is a parsable slice of WebAuthnCredential.
+type WebAuthnCredentials []*WebAuthnCredential
@@ -0,0 +1,645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package webauthncredential
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldName, v))
+}
+
+// CredentialID applies equality check predicate on the "credential_id" field. It's identical to CredentialIDEQ.
+func CredentialID(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldCredentialID, v))
+}
+
+// PublicKey applies equality check predicate on the "public_key" field. It's identical to PublicKeyEQ.
+func PublicKey(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// AttestationType applies equality check predicate on the "attestation_type" field. It's identical to AttestationTypeEQ.
+func AttestationType(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldAttestationType, v))
+}
+
+// Aaguid applies equality check predicate on the "aaguid" field. It's identical to AaguidEQ.
+func Aaguid(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldAaguid, v))
+}
+
+// SignCount applies equality check predicate on the "sign_count" field. It's identical to SignCountEQ.
+func SignCount(v uint32) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldSignCount, v))
+}
+
+// BackupEligible applies equality check predicate on the "backup_eligible" field. It's identical to BackupEligibleEQ.
+func BackupEligible(v bool) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldBackupEligible, v))
+}
+
+// BackupState applies equality check predicate on the "backup_state" field. It's identical to BackupStateEQ.
+func BackupState(v bool) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldBackupState, v))
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldContainsFold(FieldName, v))
+}
+
+// CredentialIDEQ applies the EQ predicate on the "credential_id" field.
+func CredentialIDEQ(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldCredentialID, v))
+}
+
+// CredentialIDNEQ applies the NEQ predicate on the "credential_id" field.
+func CredentialIDNEQ(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNEQ(FieldCredentialID, v))
+}
+
+// CredentialIDIn applies the In predicate on the "credential_id" field.
+func CredentialIDIn(vs ...[]byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldIn(FieldCredentialID, vs...))
+}
+
+// CredentialIDNotIn applies the NotIn predicate on the "credential_id" field.
+func CredentialIDNotIn(vs ...[]byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNotIn(FieldCredentialID, vs...))
+}
+
+// CredentialIDGT applies the GT predicate on the "credential_id" field.
+func CredentialIDGT(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGT(FieldCredentialID, v))
+}
+
+// CredentialIDGTE applies the GTE predicate on the "credential_id" field.
+func CredentialIDGTE(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGTE(FieldCredentialID, v))
+}
+
+// CredentialIDLT applies the LT predicate on the "credential_id" field.
+func CredentialIDLT(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLT(FieldCredentialID, v))
+}
+
+// CredentialIDLTE applies the LTE predicate on the "credential_id" field.
+func CredentialIDLTE(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLTE(FieldCredentialID, v))
+}
+
+// PublicKeyEQ applies the EQ predicate on the "public_key" field.
+func PublicKeyEQ(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// PublicKeyNEQ applies the NEQ predicate on the "public_key" field.
+func PublicKeyNEQ(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNEQ(FieldPublicKey, v))
+}
+
+// PublicKeyIn applies the In predicate on the "public_key" field.
+func PublicKeyIn(vs ...[]byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyNotIn applies the NotIn predicate on the "public_key" field.
+func PublicKeyNotIn(vs ...[]byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNotIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyGT applies the GT predicate on the "public_key" field.
+func PublicKeyGT(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGT(FieldPublicKey, v))
+}
+
+// PublicKeyGTE applies the GTE predicate on the "public_key" field.
+func PublicKeyGTE(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGTE(FieldPublicKey, v))
+}
+
+// PublicKeyLT applies the LT predicate on the "public_key" field.
+func PublicKeyLT(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLT(FieldPublicKey, v))
+}
+
+// PublicKeyLTE applies the LTE predicate on the "public_key" field.
+func PublicKeyLTE(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLTE(FieldPublicKey, v))
+}
+
+// AttestationTypeEQ applies the EQ predicate on the "attestation_type" field.
+func AttestationTypeEQ(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldAttestationType, v))
+}
+
+// AttestationTypeNEQ applies the NEQ predicate on the "attestation_type" field.
+func AttestationTypeNEQ(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNEQ(FieldAttestationType, v))
+}
+
+// AttestationTypeIn applies the In predicate on the "attestation_type" field.
+func AttestationTypeIn(vs ...string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldIn(FieldAttestationType, vs...))
+}
+
+// AttestationTypeNotIn applies the NotIn predicate on the "attestation_type" field.
+func AttestationTypeNotIn(vs ...string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNotIn(FieldAttestationType, vs...))
+}
+
+// AttestationTypeGT applies the GT predicate on the "attestation_type" field.
+func AttestationTypeGT(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGT(FieldAttestationType, v))
+}
+
+// AttestationTypeGTE applies the GTE predicate on the "attestation_type" field.
+func AttestationTypeGTE(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGTE(FieldAttestationType, v))
+}
+
+// AttestationTypeLT applies the LT predicate on the "attestation_type" field.
+func AttestationTypeLT(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLT(FieldAttestationType, v))
+}
+
+// AttestationTypeLTE applies the LTE predicate on the "attestation_type" field.
+func AttestationTypeLTE(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLTE(FieldAttestationType, v))
+}
+
+// AttestationTypeContains applies the Contains predicate on the "attestation_type" field.
+func AttestationTypeContains(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldContains(FieldAttestationType, v))
+}
+
+// AttestationTypeHasPrefix applies the HasPrefix predicate on the "attestation_type" field.
+func AttestationTypeHasPrefix(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldHasPrefix(FieldAttestationType, v))
+}
+
+// AttestationTypeHasSuffix applies the HasSuffix predicate on the "attestation_type" field.
+func AttestationTypeHasSuffix(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldHasSuffix(FieldAttestationType, v))
+}
+
+// AttestationTypeIsNil applies the IsNil predicate on the "attestation_type" field.
+func AttestationTypeIsNil() predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldIsNull(FieldAttestationType))
+}
+
+// AttestationTypeNotNil applies the NotNil predicate on the "attestation_type" field.
+func AttestationTypeNotNil() predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNotNull(FieldAttestationType))
+}
+
+// AttestationTypeEqualFold applies the EqualFold predicate on the "attestation_type" field.
+func AttestationTypeEqualFold(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEqualFold(FieldAttestationType, v))
+}
+
+// AttestationTypeContainsFold applies the ContainsFold predicate on the "attestation_type" field.
+func AttestationTypeContainsFold(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldContainsFold(FieldAttestationType, v))
+}
+
+// TransportsIsNil applies the IsNil predicate on the "transports" field.
+func TransportsIsNil() predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldIsNull(FieldTransports))
+}
+
+// TransportsNotNil applies the NotNil predicate on the "transports" field.
+func TransportsNotNil() predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNotNull(FieldTransports))
+}
+
+// AaguidEQ applies the EQ predicate on the "aaguid" field.
+func AaguidEQ(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldAaguid, v))
+}
+
+// AaguidNEQ applies the NEQ predicate on the "aaguid" field.
+func AaguidNEQ(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNEQ(FieldAaguid, v))
+}
+
+// AaguidIn applies the In predicate on the "aaguid" field.
+func AaguidIn(vs ...[]byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldIn(FieldAaguid, vs...))
+}
+
+// AaguidNotIn applies the NotIn predicate on the "aaguid" field.
+func AaguidNotIn(vs ...[]byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNotIn(FieldAaguid, vs...))
+}
+
+// AaguidGT applies the GT predicate on the "aaguid" field.
+func AaguidGT(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGT(FieldAaguid, v))
+}
+
+// AaguidGTE applies the GTE predicate on the "aaguid" field.
+func AaguidGTE(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGTE(FieldAaguid, v))
+}
+
+// AaguidLT applies the LT predicate on the "aaguid" field.
+func AaguidLT(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLT(FieldAaguid, v))
+}
+
+// AaguidLTE applies the LTE predicate on the "aaguid" field.
+func AaguidLTE(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLTE(FieldAaguid, v))
+}
+
+// AaguidIsNil applies the IsNil predicate on the "aaguid" field.
+func AaguidIsNil() predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldIsNull(FieldAaguid))
+}
+
+// AaguidNotNil applies the NotNil predicate on the "aaguid" field.
+func AaguidNotNil() predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNotNull(FieldAaguid))
+}
+
+// SignCountEQ applies the EQ predicate on the "sign_count" field.
+func SignCountEQ(v uint32) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldSignCount, v))
+}
+
+// SignCountNEQ applies the NEQ predicate on the "sign_count" field.
+func SignCountNEQ(v uint32) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNEQ(FieldSignCount, v))
+}
+
+// SignCountIn applies the In predicate on the "sign_count" field.
+func SignCountIn(vs ...uint32) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldIn(FieldSignCount, vs...))
+}
+
+// SignCountNotIn applies the NotIn predicate on the "sign_count" field.
+func SignCountNotIn(vs ...uint32) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNotIn(FieldSignCount, vs...))
+}
+
+// SignCountGT applies the GT predicate on the "sign_count" field.
+func SignCountGT(v uint32) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGT(FieldSignCount, v))
+}
+
+// SignCountGTE applies the GTE predicate on the "sign_count" field.
+func SignCountGTE(v uint32) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGTE(FieldSignCount, v))
+}
+
+// SignCountLT applies the LT predicate on the "sign_count" field.
+func SignCountLT(v uint32) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLT(FieldSignCount, v))
+}
+
+// SignCountLTE applies the LTE predicate on the "sign_count" field.
+func SignCountLTE(v uint32) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLTE(FieldSignCount, v))
+}
+
+// BackupEligibleEQ applies the EQ predicate on the "backup_eligible" field.
+func BackupEligibleEQ(v bool) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldBackupEligible, v))
+}
+
+// BackupEligibleNEQ applies the NEQ predicate on the "backup_eligible" field.
+func BackupEligibleNEQ(v bool) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNEQ(FieldBackupEligible, v))
+}
+
+// BackupStateEQ applies the EQ predicate on the "backup_state" field.
+func BackupStateEQ(v bool) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldBackupState, v))
+}
+
+// BackupStateNEQ applies the NEQ predicate on the "backup_state" field.
+func BackupStateNEQ(v bool) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNEQ(FieldBackupState, v))
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNotIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldGTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldLTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "last_used_at" field.
+func LastUsedAtIsNil() predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldIsNull(FieldLastUsedAt))
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "last_used_at" field.
+func LastUsedAtNotNil() predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.FieldNotNull(FieldLastUsedAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WebAuthnCredential) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WebAuthnCredential) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WebAuthnCredential) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(sql.NotPredicates(p))
+}
@@ -3,25 +3,27 @@ package migrations
 
 import (
 	"embed"
+	"fmt"
 	"os"
 	"path"
 )
 
-//go:embed all:migrations
+//go:embed all:sqlite3 all:postgres
 var Files embed.FS
 
-// Write writes the embedded migrations to a temporary directory.
-// It returns an error and a cleanup function. The cleanup function
-// should be called when the migrations are no longer needed.
-func Write(temp string) error {
+// Write writes the embedded migrations for the given dialect to a temporary directory.
+// The dialect must match one of the migration directories (e.g. "sqlite3", "postgres").
+// The caller is responsible for removing the directory when the migrations are no
+// longer needed.
+func Write(temp string, dialect string) error {
 	err := os.MkdirAll(temp, 0o755)
 	if err != nil {
 		return err
 	}
 
-	fsDir, err := Files.ReadDir("migrations")
+	fsDir, err := Files.ReadDir(dialect)
 	if err != nil {
-		return err
+		return fmt.Errorf("no migrations found for dialect %q: %w", dialect, err)
 	}
 
 	for _, f := range fsDir {
@@ -29,7 +31,7 @@ func Write(temp string) error {
 			continue
 		}
 
-		b, err := Files.ReadFile(path.Join("migrations", f.Name()))
+		b, err := Files.ReadFile(path.Join(dialect, f.Name()))
 		if err != nil {
 			return err
 		}
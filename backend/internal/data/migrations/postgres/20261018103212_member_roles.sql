@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE "users" ALTER COLUMN "role" SET DEFAULT 'editor';
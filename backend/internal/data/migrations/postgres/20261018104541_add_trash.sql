@@ -0,0 +1,8 @@
+-- Modify "items" table
+ALTER TABLE "items" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "item_deleted_at" to table: "items"
+CREATE INDEX "item_deleted_at" ON "items" ("deleted_at");
+-- Modify "locations" table
+ALTER TABLE "locations" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "location_deleted_at" to table: "locations"
+CREATE INDEX "location_deleted_at" ON "locations" ("deleted_at");
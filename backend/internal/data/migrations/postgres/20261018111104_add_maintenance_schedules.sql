@@ -0,0 +1,4 @@
+-- Create "maintenance_schedules" table
+CREATE TABLE "maintenance_schedules" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "name" character varying NOT NULL, "description" character varying NULL, "rule" character varying NOT NULL, "notify_days_before" bigint NOT NULL DEFAULT 0, "item_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "maintenance_schedules_items_maintenance_schedules" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON DELETE CASCADE);
+-- Modify "maintenance_entries" table
+ALTER TABLE "maintenance_entries" ADD COLUMN "schedule_id" uuid NULL, ADD CONSTRAINT "maintenance_entries_maintenance_schedules_entries" FOREIGN KEY ("schedule_id") REFERENCES "maintenance_schedules" ("id") ON DELETE SET NULL;
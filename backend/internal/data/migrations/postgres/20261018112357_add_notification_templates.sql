@@ -0,0 +1,4 @@
+-- Create "notification_templates" table
+CREATE TABLE "notification_templates" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "type" character varying NOT NULL, "body" character varying NOT NULL, "group_id" uuid NOT NULL, "notifier_id" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "notification_templates_groups_notification_templates" FOREIGN KEY ("group_id") REFERENCES "groups" ("id") ON DELETE CASCADE, CONSTRAINT "notification_templates_notifiers_templates" FOREIGN KEY ("notifier_id") REFERENCES "notifiers" ("id") ON DELETE CASCADE);
+-- Create index "notificationtemplate_group_id_type" to table: "notification_templates"
+CREATE INDEX "notificationtemplate_group_id_type" ON "notification_templates" ("group_id", "type");
@@ -0,0 +1,6 @@
+-- Modify "notifiers" table
+ALTER TABLE "notifiers" ADD COLUMN "failure_count" bigint NOT NULL DEFAULT 0;
+-- Create "notifier_deliveries" table
+CREATE TABLE "notifier_deliveries" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "type" character varying NOT NULL, "status" character varying NOT NULL, "attempt" bigint NOT NULL DEFAULT 1, "error" character varying NULL, "notifier_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "notifier_deliveries_notifiers_deliveries" FOREIGN KEY ("notifier_id") REFERENCES "notifiers" ("id") ON DELETE CASCADE);
+-- Create index "notifierdelivery_notifier_id_created_at" to table: "notifier_deliveries"
+CREATE INDEX "notifierdelivery_notifier_id_created_at" ON "notifier_deliveries" ("notifier_id", "created_at");
@@ -0,0 +1,2 @@
+-- Modify "groups" table
+ALTER TABLE "groups" ADD COLUMN "timezone" character varying NULL, ADD COLUMN "notify_time" character varying NOT NULL DEFAULT '08:00', ADD COLUMN "digest" character varying NOT NULL DEFAULT 'none', ADD COLUMN "notified_on" timestamptz NULL;
@@ -0,0 +1,4 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "totp_secret" character varying NULL, ADD COLUMN "totp_enabled" boolean NOT NULL DEFAULT false, ADD COLUMN "totp_last_step" bigint NOT NULL DEFAULT 0, ADD COLUMN "totp_recovery_codes" jsonb NULL;
+-- Modify "user_tokens" table
+ALTER TABLE "user_tokens" ADD COLUMN "attempts" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+-- Create "web_authn_credentials" table
+CREATE TABLE "web_authn_credentials" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "name" character varying NOT NULL, "credential_id" bytea NOT NULL, "public_key" bytea NOT NULL, "attestation_type" character varying NULL, "transports" jsonb NULL, "aaguid" bytea NULL, "sign_count" integer NOT NULL DEFAULT 0, "backup_eligible" boolean NOT NULL DEFAULT false, "backup_state" boolean NOT NULL DEFAULT false, "last_used_at" timestamptz NULL, "user_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "web_authn_credentials_users_webauthn_credentials" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE);
+-- Create index "web_authn_credentials_credential_id_key" to table: "web_authn_credentials"
+CREATE UNIQUE INDEX "web_authn_credentials_credential_id_key" ON "web_authn_credentials" ("credential_id");
@@ -0,0 +1,2 @@
+-- Modify "auth_tokens" table
+ALTER TABLE "auth_tokens" ADD COLUMN "last_used_at" timestamptz NULL, ADD COLUMN "ip_address" character varying NULL, ADD COLUMN "user_agent" character varying NULL, ADD COLUMN "session_id" uuid NULL, ADD CONSTRAINT "auth_tokens_auth_tokens_attachment_tokens" FOREIGN KEY ("session_id") REFERENCES "auth_tokens" ("id") ON DELETE CASCADE;
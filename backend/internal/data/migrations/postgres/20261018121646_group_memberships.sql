@@ -0,0 +1,10 @@
+-- Modify "auth_tokens" table
+ALTER TABLE "auth_tokens" ADD COLUMN "group_id" uuid NULL, ADD CONSTRAINT "auth_tokens_groups_auth_tokens" FOREIGN KEY ("group_id") REFERENCES "groups" ("id") ON DELETE CASCADE;
+-- Create "group_memberships" table
+CREATE TABLE "group_memberships" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "role" character varying NOT NULL DEFAULT 'editor', "group_id" uuid NOT NULL, "user_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "group_memberships_groups_memberships" FOREIGN KEY ("group_id") REFERENCES "groups" ("id") ON DELETE CASCADE, CONSTRAINT "group_memberships_users_memberships" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE);
+-- Create index "groupmembership_user_id_group_id" to table: "group_memberships"
+CREATE UNIQUE INDEX "groupmembership_user_id_group_id" ON "group_memberships" ("user_id", "group_id");
+-- Copy the group and role of every user to their membership
+INSERT INTO "group_memberships" ("id", "created_at", "updated_at", "role", "group_id", "user_id") SELECT "id", "created_at", "updated_at", CASE "role" WHEN 'user' THEN 'editor' ELSE "role" END, "group_users", "id" FROM "users";
+-- Modify "users" table
+ALTER TABLE "users" DROP COLUMN "role";
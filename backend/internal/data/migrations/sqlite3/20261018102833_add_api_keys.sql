@@ -0,0 +1,2 @@
+-- Add column "name" to table: "auth_tokens"
+ALTER TABLE `auth_tokens` ADD COLUMN `name` text NULL;
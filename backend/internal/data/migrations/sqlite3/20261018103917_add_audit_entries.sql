@@ -0,0 +1,8 @@
+-- Create "audit_entries" table
+CREATE TABLE `audit_entries` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `action` text NOT NULL, `entity_type` text NOT NULL, `entity_id` uuid NOT NULL, `entity_name` text NULL, `item_id` uuid NULL, `changes` json NULL, `group_id` uuid NOT NULL, `user_id` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `audit_entries_groups_audit_entries` FOREIGN KEY (`group_id`) REFERENCES `groups` (`id`) ON DELETE CASCADE, CONSTRAINT `audit_entries_users_audit_entries` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE SET NULL);
+-- Create index "auditentry_group_id_created_at" to table: "audit_entries"
+CREATE INDEX `auditentry_group_id_created_at` ON `audit_entries` (`group_id`, `created_at`);
+-- Create index "auditentry_item_id" to table: "audit_entries"
+CREATE INDEX `auditentry_item_id` ON `audit_entries` (`item_id`);
+-- Create index "auditentry_entity_id" to table: "audit_entries"
+CREATE INDEX `auditentry_entity_id` ON `audit_entries` (`entity_id`);
@@ -0,0 +1,2 @@
+-- Create "saved_searches" table
+CREATE TABLE `saved_searches` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `name` text NOT NULL, `description` text NULL, `query` json NOT NULL, `order_by` text NOT NULL DEFAULT ('name'), `group_saved_searches` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `saved_searches_groups_saved_searches` FOREIGN KEY (`group_saved_searches`) REFERENCES `groups` (`id`) ON DELETE CASCADE);
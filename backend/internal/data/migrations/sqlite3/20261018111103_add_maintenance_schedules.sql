@@ -0,0 +1,14 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_maintenance_entries" table
+CREATE TABLE `new_maintenance_entries` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `date` datetime NULL, `scheduled_date` datetime NULL, `name` text NOT NULL, `description` text NULL, `cost` real NOT NULL DEFAULT (0), `item_id` uuid NOT NULL, `schedule_id` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `maintenance_entries_items_maintenance_entries` FOREIGN KEY (`item_id`) REFERENCES `items` (`id`) ON DELETE CASCADE, CONSTRAINT `maintenance_entries_maintenance_schedules_entries` FOREIGN KEY (`schedule_id`) REFERENCES `maintenance_schedules` (`id`) ON DELETE SET NULL);
+-- Copy rows from old table "maintenance_entries" to new temporary table "new_maintenance_entries"
+INSERT INTO `new_maintenance_entries` (`id`, `created_at`, `updated_at`, `date`, `scheduled_date`, `name`, `description`, `cost`, `item_id`) SELECT `id`, `created_at`, `updated_at`, `date`, `scheduled_date`, `name`, `description`, `cost`, `item_id` FROM `maintenance_entries`;
+-- Drop "maintenance_entries" table after copying rows
+DROP TABLE `maintenance_entries`;
+-- Rename temporary table "new_maintenance_entries" to "maintenance_entries"
+ALTER TABLE `new_maintenance_entries` RENAME TO `maintenance_entries`;
+-- Create "maintenance_schedules" table
+CREATE TABLE `maintenance_schedules` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `name` text NOT NULL, `description` text NULL, `rule` text NOT NULL, `notify_days_before` integer NOT NULL DEFAULT (0), `item_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `maintenance_schedules_items_maintenance_schedules` FOREIGN KEY (`item_id`) REFERENCES `items` (`id`) ON DELETE CASCADE);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
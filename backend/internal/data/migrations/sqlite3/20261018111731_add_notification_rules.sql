@@ -0,0 +1,10 @@
+-- Create "notification_reminders" table
+CREATE TABLE `notification_reminders` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `item_id` uuid NOT NULL, `target` text NOT NULL, `title` text NOT NULL, `due_date` datetime NOT NULL, `lead_days` integer NOT NULL, `group_id` uuid NOT NULL, `rule_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `notification_reminders_groups_notification_reminders` FOREIGN KEY (`group_id`) REFERENCES `groups` (`id`) ON DELETE CASCADE, CONSTRAINT `notification_reminders_notification_rules_reminders` FOREIGN KEY (`rule_id`) REFERENCES `notification_rules` (`id`) ON DELETE CASCADE);
+-- Create index "notificationreminder_rule_id_item_id_target_due_date_lead_days" to table: "notification_reminders"
+CREATE UNIQUE INDEX `notificationreminder_rule_id_item_id_target_due_date_lead_days` ON `notification_reminders` (`rule_id`, `item_id`, `target`, `due_date`, `lead_days`);
+-- Create index "notificationreminder_group_id_created_at" to table: "notification_reminders"
+CREATE INDEX `notificationreminder_group_id_created_at` ON `notification_reminders` (`group_id`, `created_at`);
+-- Create "notification_rules" table
+CREATE TABLE `notification_rules` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `name` text NOT NULL, `kind` text NOT NULL, `custom_field` text NULL, `lead_days` json NOT NULL, `is_active` bool NOT NULL DEFAULT (true), `group_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `notification_rules_groups_notification_rules` FOREIGN KEY (`group_id`) REFERENCES `groups` (`id`) ON DELETE CASCADE);
+-- Create index "notificationrule_group_id_is_active" to table: "notification_rules"
+CREATE INDEX `notificationrule_group_id_is_active` ON `notification_rules` (`group_id`, `is_active`);
@@ -0,0 +1,24 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_notifiers" table
+CREATE TABLE `new_notifiers` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `name` text NOT NULL, `url` text NOT NULL, `is_active` bool NOT NULL DEFAULT (true), `failure_count` integer NOT NULL DEFAULT (0), `group_id` uuid NOT NULL, `user_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `notifiers_groups_notifiers` FOREIGN KEY (`group_id`) REFERENCES `groups` (`id`) ON DELETE CASCADE, CONSTRAINT `notifiers_users_notifiers` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE);
+-- Copy rows from old table "notifiers" to new temporary table "new_notifiers"
+INSERT INTO `new_notifiers` (`id`, `created_at`, `updated_at`, `name`, `url`, `is_active`, `group_id`, `user_id`) SELECT `id`, `created_at`, `updated_at`, `name`, `url`, `is_active`, `group_id`, `user_id` FROM `notifiers`;
+-- Drop "notifiers" table after copying rows
+DROP TABLE `notifiers`;
+-- Rename temporary table "new_notifiers" to "notifiers"
+ALTER TABLE `new_notifiers` RENAME TO `notifiers`;
+-- Create index "notifier_user_id" to table: "notifiers"
+CREATE INDEX `notifier_user_id` ON `notifiers` (`user_id`);
+-- Create index "notifier_user_id_is_active" to table: "notifiers"
+CREATE INDEX `notifier_user_id_is_active` ON `notifiers` (`user_id`, `is_active`);
+-- Create index "notifier_group_id" to table: "notifiers"
+CREATE INDEX `notifier_group_id` ON `notifiers` (`group_id`);
+-- Create index "notifier_group_id_is_active" to table: "notifiers"
+CREATE INDEX `notifier_group_id_is_active` ON `notifiers` (`group_id`, `is_active`);
+-- Create "notifier_deliveries" table
+CREATE TABLE `notifier_deliveries` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `type` text NOT NULL, `status` text NOT NULL, `attempt` integer NOT NULL DEFAULT (1), `error` text NULL, `notifier_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `notifier_deliveries_notifiers_deliveries` FOREIGN KEY (`notifier_id`) REFERENCES `notifiers` (`id`) ON DELETE CASCADE);
+-- Create index "notifierdelivery_notifier_id_created_at" to table: "notifier_deliveries"
+CREATE INDEX `notifierdelivery_notifier_id_created_at` ON `notifier_deliveries` (`notifier_id`, `created_at`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
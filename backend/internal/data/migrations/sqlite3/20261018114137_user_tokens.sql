@@ -0,0 +1,8 @@
+-- Create "user_tokens" table
+CREATE TABLE `user_tokens` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `purpose` text NOT NULL, `token` blob NOT NULL, `expires_at` datetime NOT NULL, `user_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `user_tokens_users_user_tokens` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE);
+-- Create index "user_tokens_token_key" to table: "user_tokens"
+CREATE UNIQUE INDEX `user_tokens_token_key` ON `user_tokens` (`token`);
+-- Create index "usertoken_user_id_purpose" to table: "user_tokens"
+CREATE INDEX `usertoken_user_id_purpose` ON `user_tokens` (`user_id`, `purpose`);
+-- Accounts created before email verification are treated as verified
+UPDATE users SET activated_on = created_at WHERE activated_on IS NULL;
@@ -0,0 +1,26 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_users" table
+CREATE TABLE `new_users` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `name` text NOT NULL, `email` text NOT NULL, `password` text NOT NULL, `is_superuser` bool NOT NULL DEFAULT (false), `superuser` bool NOT NULL DEFAULT (false), `role` text NOT NULL DEFAULT ('editor'), `activated_on` datetime NULL, `totp_secret` text NULL, `totp_enabled` bool NOT NULL DEFAULT (false), `totp_last_step` integer NOT NULL DEFAULT (0), `totp_recovery_codes` json NULL, `group_users` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `users_groups_users` FOREIGN KEY (`group_users`) REFERENCES `groups` (`id`) ON DELETE CASCADE);
+-- Copy rows from old table "users" to new temporary table "new_users"
+INSERT INTO `new_users` (`id`, `created_at`, `updated_at`, `name`, `email`, `password`, `is_superuser`, `superuser`, `role`, `activated_on`, `group_users`) SELECT `id`, `created_at`, `updated_at`, `name`, `email`, `password`, `is_superuser`, `superuser`, `role`, `activated_on`, `group_users` FROM `users`;
+-- Drop "users" table after copying rows
+DROP TABLE `users`;
+-- Rename temporary table "new_users" to "users"
+ALTER TABLE `new_users` RENAME TO `users`;
+-- Create index "users_email_key" to table: "users"
+CREATE UNIQUE INDEX `users_email_key` ON `users` (`email`);
+-- Create "new_user_tokens" table
+CREATE TABLE `new_user_tokens` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `purpose` text NOT NULL, `token` blob NOT NULL, `expires_at` datetime NOT NULL, `attempts` integer NOT NULL DEFAULT (0), `user_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `user_tokens_users_user_tokens` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE);
+-- Copy rows from old table "user_tokens" to new temporary table "new_user_tokens"
+INSERT INTO `new_user_tokens` (`id`, `created_at`, `updated_at`, `purpose`, `token`, `expires_at`, `user_id`) SELECT `id`, `created_at`, `updated_at`, `purpose`, `token`, `expires_at`, `user_id` FROM `user_tokens`;
+-- Drop "user_tokens" table after copying rows
+DROP TABLE `user_tokens`;
+-- Rename temporary table "new_user_tokens" to "user_tokens"
+ALTER TABLE `new_user_tokens` RENAME TO `user_tokens`;
+-- Create index "user_tokens_token_key" to table: "user_tokens"
+CREATE UNIQUE INDEX `user_tokens_token_key` ON `user_tokens` (`token`);
+-- Create index "usertoken_user_id_purpose" to table: "user_tokens"
+CREATE INDEX `usertoken_user_id_purpose` ON `user_tokens` (`user_id`, `purpose`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
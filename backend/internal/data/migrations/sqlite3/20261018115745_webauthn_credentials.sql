@@ -0,0 +1,4 @@
+-- Create "web_authn_credentials" table
+CREATE TABLE `web_authn_credentials` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `name` text NOT NULL, `credential_id` blob NOT NULL, `public_key` blob NOT NULL, `attestation_type` text NULL, `transports` json NULL, `aaguid` blob NULL, `sign_count` integer NOT NULL DEFAULT (0), `backup_eligible` bool NOT NULL DEFAULT (false), `backup_state` bool NOT NULL DEFAULT (false), `last_used_at` datetime NULL, `user_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `web_authn_credentials_users_webauthn_credentials` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE);
+-- Create index "web_authn_credentials_credential_id_key" to table: "web_authn_credentials"
+CREATE UNIQUE INDEX `web_authn_credentials_credential_id_key` ON `web_authn_credentials` (`credential_id`);
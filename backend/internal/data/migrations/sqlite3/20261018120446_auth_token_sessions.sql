@@ -0,0 +1,16 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_auth_tokens" table
+CREATE TABLE `new_auth_tokens` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `token` blob NOT NULL, `expires_at` datetime NOT NULL, `name` text NULL, `last_used_at` datetime NULL, `ip_address` text NULL, `user_agent` text NULL, `session_id` uuid NULL, `user_auth_tokens` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `auth_tokens_auth_tokens_attachment_tokens` FOREIGN KEY (`session_id`) REFERENCES `auth_tokens` (`id`) ON DELETE CASCADE, CONSTRAINT `auth_tokens_users_auth_tokens` FOREIGN KEY (`user_auth_tokens`) REFERENCES `users` (`id`) ON DELETE CASCADE);
+-- Copy rows from old table "auth_tokens" to new temporary table "new_auth_tokens"
+INSERT INTO `new_auth_tokens` (`id`, `created_at`, `updated_at`, `token`, `expires_at`, `name`, `user_auth_tokens`) SELECT `id`, `created_at`, `updated_at`, `token`, `expires_at`, `name`, `user_auth_tokens` FROM `auth_tokens`;
+-- Drop "auth_tokens" table after copying rows
+DROP TABLE `auth_tokens`;
+-- Rename temporary table "new_auth_tokens" to "auth_tokens"
+ALTER TABLE `new_auth_tokens` RENAME TO `auth_tokens`;
+-- Create index "auth_tokens_token_key" to table: "auth_tokens"
+CREATE UNIQUE INDEX `auth_tokens_token_key` ON `auth_tokens` (`token`);
+-- Create index "authtokens_token" to table: "auth_tokens"
+CREATE INDEX `authtokens_token` ON `auth_tokens` (`token`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
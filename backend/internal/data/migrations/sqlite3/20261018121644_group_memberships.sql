@@ -0,0 +1,32 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "group_memberships" table
+CREATE TABLE `group_memberships` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `role` text NOT NULL DEFAULT ('editor'), `group_id` uuid NOT NULL, `user_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `group_memberships_groups_memberships` FOREIGN KEY (`group_id`) REFERENCES `groups` (`id`) ON DELETE CASCADE, CONSTRAINT `group_memberships_users_memberships` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE);
+-- Create index "groupmembership_user_id_group_id" to table: "group_memberships"
+CREATE UNIQUE INDEX `groupmembership_user_id_group_id` ON `group_memberships` (`user_id`, `group_id`);
+-- Copy the group and role of every user to their membership
+INSERT INTO `group_memberships` (`id`, `created_at`, `updated_at`, `role`, `group_id`, `user_id`) SELECT `id`, `created_at`, `updated_at`, CASE `role` WHEN 'user' THEN 'editor' ELSE `role` END, `group_users`, `id` FROM `users`;
+-- Create "new_users" table
+CREATE TABLE `new_users` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `name` text NOT NULL, `email` text NOT NULL, `password` text NOT NULL, `is_superuser` bool NOT NULL DEFAULT (false), `superuser` bool NOT NULL DEFAULT (false), `activated_on` datetime NULL, `totp_secret` text NULL, `totp_enabled` bool NOT NULL DEFAULT (false), `totp_last_step` integer NOT NULL DEFAULT (0), `totp_recovery_codes` json NULL, `group_users` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `users_groups_users` FOREIGN KEY (`group_users`) REFERENCES `groups` (`id`) ON DELETE CASCADE);
+-- Copy rows from old table "users" to new temporary table "new_users"
+INSERT INTO `new_users` (`id`, `created_at`, `updated_at`, `name`, `email`, `password`, `is_superuser`, `superuser`, `activated_on`, `totp_secret`, `totp_enabled`, `totp_last_step`, `totp_recovery_codes`, `group_users`) SELECT `id`, `created_at`, `updated_at`, `name`, `email`, `password`, `is_superuser`, `superuser`, `activated_on`, `totp_secret`, `totp_enabled`, `totp_last_step`, `totp_recovery_codes`, `group_users` FROM `users`;
+-- Drop "users" table after copying rows
+DROP TABLE `users`;
+-- Rename temporary table "new_users" to "users"
+ALTER TABLE `new_users` RENAME TO `users`;
+-- Create index "users_email_key" to table: "users"
+CREATE UNIQUE INDEX `users_email_key` ON `users` (`email`);
+-- Create "new_auth_tokens" table
+CREATE TABLE `new_auth_tokens` (`id` uuid NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `token` blob NOT NULL, `expires_at` datetime NOT NULL, `name` text NULL, `last_used_at` datetime NULL, `ip_address` text NULL, `user_agent` text NULL, `session_id` uuid NULL, `group_id` uuid NULL, `user_auth_tokens` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `auth_tokens_auth_tokens_attachment_tokens` FOREIGN KEY (`session_id`) REFERENCES `auth_tokens` (`id`) ON DELETE CASCADE, CONSTRAINT `auth_tokens_groups_auth_tokens` FOREIGN KEY (`group_id`) REFERENCES `groups` (`id`) ON DELETE CASCADE, CONSTRAINT `auth_tokens_users_auth_tokens` FOREIGN KEY (`user_auth_tokens`) REFERENCES `users` (`id`) ON DELETE CASCADE);
+-- Copy rows from old table "auth_tokens" to new temporary table "new_auth_tokens"
+INSERT INTO `new_auth_tokens` (`id`, `created_at`, `updated_at`, `token`, `expires_at`, `name`, `last_used_at`, `ip_address`, `user_agent`, `session_id`, `user_auth_tokens`) SELECT `id`, `created_at`, `updated_at`, `token`, `expires_at`, `name`, `last_used_at`, `ip_address`, `user_agent`, `session_id`, `user_auth_tokens` FROM `auth_tokens`;
+-- Drop "auth_tokens" table after copying rows
+DROP TABLE `auth_tokens`;
+-- Rename temporary table "new_auth_tokens" to "auth_tokens"
+ALTER TABLE `new_auth_tokens` RENAME TO `auth_tokens`;
+-- Create index "auth_tokens_token_key" to table: "auth_tokens"
+CREATE UNIQUE INDEX `auth_tokens_token_key` ON `auth_tokens` (`token`);
+-- Create index "authtokens_token" to table: "auth_tokens"
+CREATE INDEX `authtokens_token` ON `auth_tokens` (`token`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
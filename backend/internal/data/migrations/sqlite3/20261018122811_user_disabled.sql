@@ -0,0 +1,2 @@
+-- Add column "disabled_at" to table: "users"
+ALTER TABLE `users` ADD COLUMN `disabled_at` datetime NULL;
@@ -0,0 +1,125 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+)
+
+// backfill copies existing data into the schema of a newer version. The server migrates
+// with ent's auto migration, which only changes the schema, so data has to be moved in
+// code. A backfill runs once, when its marker table does not exist yet.
+type backfill struct {
+	name string
+	// marker is a table added in the same version, its absence marks a database from
+	// before the version.
+	marker string
+	// before runs on the old schema and saves the data of columns the migration drops.
+	// SQLite rebuilds a table on most changes and only copies the columns of the new
+	// schema, so dropped columns can not be read after the migration.
+	before []string
+	// after runs on the new schema.
+	after []string
+}
+
+var backfills = []backfill{
+	{
+		name:   "verified accounts",
+		marker: "user_tokens",
+		after: []string{
+			// Accounts created before email verification are treated as verified
+			"UPDATE users SET activated_on = created_at WHERE activated_on IS NULL",
+		},
+	},
+	{
+		name:   "group memberships",
+		marker: "group_memberships",
+		before: []string{
+			"CREATE TABLE IF NOT EXISTS upgrade_user_roles AS SELECT id, role FROM users",
+		},
+		after: []string{
+			// Copy the group and role of every user to their membership
+			`INSERT INTO group_memberships (id, created_at, updated_at, role, group_id, user_id)
+SELECT u.id, u.created_at, u.updated_at, CASE r.role WHEN 'user' THEN 'editor' ELSE r.role END, u.group_users, u.id
+FROM users u JOIN upgrade_user_roles r ON r.id = u.id
+WHERE NOT EXISTS (SELECT 1 FROM group_memberships m WHERE m.user_id = u.id)`,
+			"DROP TABLE upgrade_user_roles",
+		},
+	},
+}
+
+// Upgrade creates or migrates the schema of the database and backfills the data of
+// databases from older versions.
+func Upgrade(ctx context.Context, c *ent.Client, opts ...schema.MigrateOption) error {
+	db := c.Sql()
+
+	// A new database has nothing to backfill.
+	exists, err := tableExists(ctx, db, c.Dialect(), "users")
+	if err != nil {
+		return err
+	}
+
+	var pending []backfill
+	if exists {
+		for _, b := range backfills {
+			done, err := tableExists(ctx, db, c.Dialect(), b.marker)
+			if err != nil {
+				return err
+			}
+
+			if !done {
+				pending = append(pending, b)
+			}
+		}
+	}
+
+	err = exec(ctx, db, pending, func(b backfill) []string { return b.before })
+	if err != nil {
+		return err
+	}
+
+	err = c.Schema.Create(ctx, opts...)
+	if err != nil {
+		return err
+	}
+
+	return exec(ctx, db, pending, func(b backfill) []string { return b.after })
+}
+
+// exec runs the statements of the backfills in a single transaction.
+func exec(ctx context.Context, db *sql.DB, backfills []backfill, stmts func(backfill) []string) error {
+	if len(backfills) == 0 {
+		return nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, b := range backfills {
+		for _, stmt := range stmts(b) {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return fmt.Errorf("backfill %s: %w", b.name, err)
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+func tableExists(ctx context.Context, db *sql.DB, d, table string) (bool, error) {
+	query := "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?"
+	if d == dialect.Postgres {
+		query = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = $1"
+	}
+
+	var n int
+	err := db.QueryRowContext(ctx, query, table).Scan(&n)
+	return n > 0, err
+}
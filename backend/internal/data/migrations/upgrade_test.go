@@ -0,0 +1,153 @@
+package migrations
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// options are the migrate options of the server.
+var options = []schema.MigrateOption{
+	schema.WithDropColumn(true),
+	schema.WithDropIndex(true),
+}
+
+// oldDatabase returns a SQLite database with the schema of the migrations before the
+// given version.
+func oldDatabase(t *testing.T, before string) *ent.Client {
+	t.Helper()
+
+	c, err := ent.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = c.Close() })
+
+	files, err := Files.ReadDir("sqlite3")
+	require.NoError(t, err)
+
+	for _, f := range files {
+		if path.Ext(f.Name()) != ".sql" || f.Name() >= before {
+			continue
+		}
+
+		stmts, err := Files.ReadFile(path.Join("sqlite3", f.Name()))
+		require.NoError(t, err)
+
+		_, err = c.Sql().Exec(string(stmts))
+		require.NoError(t, err, f.Name())
+	}
+
+	return c
+}
+
+func TestUpgrade_VerifiedAccounts(t *testing.T) {
+	ctx := context.Background()
+	c := oldDatabase(t, "20261018114137")
+
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	groupID, userID := uuid.New(), uuid.New()
+
+	_, err := c.Sql().ExecContext(ctx, "INSERT INTO groups (id, created_at, updated_at, name) VALUES (?, ?, ?, 'Home')", groupID, created, created)
+	require.NoError(t, err)
+
+	_, err = c.Sql().ExecContext(ctx, `INSERT INTO users (id, created_at, updated_at, name, email, password, role, group_users)
+VALUES (?, ?, ?, 'Jane', 'jane@example.com', 'hash', 'owner', ?)`, userID, created, created, groupID)
+	require.NoError(t, err)
+
+	require.NoError(t, Upgrade(ctx, c, options...))
+
+	usr, err := c.User.Get(ctx, userID)
+	require.NoError(t, err)
+	assert.True(t, created.Equal(usr.ActivatedOn))
+
+	m, err := c.GroupMembership.Query().Where(groupmembership.UserID(userID)).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, groupmembership.RoleOwner, m.Role)
+
+	// The backfill only runs once, accounts registered later stay unverified.
+	later, err := c.User.Create().
+		SetName("John").
+		SetEmail("john@example.com").
+		SetPassword("hash").
+		SetGroupID(groupID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, Upgrade(ctx, c, options...))
+
+	later, err = c.User.Query().Where(user.ID(later.ID)).Only(ctx)
+	require.NoError(t, err)
+	assert.True(t, later.ActivatedOn.IsZero())
+}
+
+func TestUpgrade_GroupMemberships(t *testing.T) {
+	ctx := context.Background()
+	c := oldDatabase(t, "20261018121644")
+
+	now := time.Now().UTC()
+	groupID := uuid.New()
+
+	_, err := c.Sql().ExecContext(ctx, "INSERT INTO groups (id, created_at, updated_at, name) VALUES (?, ?, ?, 'Home')", groupID, now, now)
+	require.NoError(t, err)
+
+	roles := map[string]string{
+		"owner":  "owner",
+		"user":   "editor",
+		"viewer": "viewer",
+	}
+
+	users := make(map[uuid.UUID]string, len(roles))
+	for old, want := range roles {
+		id := uuid.New()
+		users[id] = want
+
+		_, err = c.Sql().ExecContext(ctx, `INSERT INTO users (id, created_at, updated_at, name, email, password, role, activated_on, group_users)
+VALUES (?, ?, ?, ?, ?, 'hash', ?, ?, ?)`, id, now, now, old, old+"@example.com", old, now, groupID)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, Upgrade(ctx, c, options...))
+
+	for id, want := range users {
+		m, err := c.GroupMembership.Query().
+			Where(groupmembership.UserID(id), groupmembership.GroupID(groupID)).
+			Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, want, m.Role.String())
+	}
+
+	// The role column is dropped once the memberships are copied.
+	_, err = c.Sql().ExecContext(ctx, "SELECT role FROM users")
+	require.Error(t, err)
+
+	exists, err := tableExists(ctx, c.Sql(), c.Dialect(), "upgrade_user_roles")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.NoError(t, Upgrade(ctx, c, options...))
+
+	n, err := c.GroupMembership.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, len(users), n)
+}
+
+func TestUpgrade_NewDatabase(t *testing.T) {
+	c, err := ent.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = c.Close() })
+
+	require.NoError(t, Upgrade(context.Background(), c, options...))
+
+	n, err := c.User.Query().Count(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
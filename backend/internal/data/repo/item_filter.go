@@ -0,0 +1,425 @@
+package repo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemfield"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// ItemFilter is a parsed item filter expression, see ParseItemFilter. The zero value
+// matches all items.
+type ItemFilter struct {
+	predicates      []predicate.Item
+	includeArchived bool
+}
+
+// ItemFilterError is returned by ParseItemFilter when the expression is invalid.
+type ItemFilterError struct {
+	Term   string
+	Reason string
+}
+
+func (e *ItemFilterError) Error() string {
+	return fmt.Sprintf("%q: %s", e.Term, e.Reason)
+}
+
+// ParseItemFilter parses a filter expression into an ItemFilter. An expression is a list
+// of whitespace separated terms that must all match, a term is negated by prefixing it
+// with '-'. Values containing spaces can be quoted.
+//
+//	drill                  name, description, serial, model, manufacturer or notes contain "drill"
+//	archived, insured      the flag is set, archived items are only returned when asked for
+//	name:drill             text column contains the value (name, description, manufacturer, model, serial, notes)
+//	name=drill             text column equals the value, ignoring case
+//	label:electronics      a label or the location name contains the value, '=' for an exact match
+//	price>100              numeric column comparison (price, soldPrice, quantity, asset)
+//	warranty<2027-01-01    date column comparison (purchased, warranty, sold, created, updated)
+//	field:color=red        custom field comparison, numbers can be compared with > >= < <=
+//	field:color            the custom field is set
+//
+// Supported operators are ':' '=' '!=' '>' '>=' '<' '<='.
+func ParseItemFilter(s string) (ItemFilter, error) {
+	var (
+		f  ItemFilter
+		sc = filterScanner{s: []rune(s)}
+	)
+
+	for {
+		sc.skipSpace()
+		if sc.done() {
+			return f, nil
+		}
+
+		start := sc.pos
+		p, err := sc.term(&f)
+		if err != nil {
+			err.Term = strings.TrimSpace(string(sc.s[start:sc.untilSpace()]))
+			return ItemFilter{}, err
+		}
+
+		f.predicates = append(f.predicates, p)
+	}
+}
+
+// filterOps are the supported operators, longest first so that '>=' wins over '>'.
+var filterOps = []string{">=", "<=", "!=", ":", "=", ">", "<"}
+
+type filterScanner struct {
+	s   []rune
+	pos int
+}
+
+func (sc *filterScanner) done() bool {
+	return sc.pos >= len(sc.s)
+}
+
+func (sc *filterScanner) skipSpace() {
+	for !sc.done() && unicode.IsSpace(sc.s[sc.pos]) {
+		sc.pos++
+	}
+}
+
+func (sc *filterScanner) untilSpace() int {
+	end := sc.pos
+	for end < len(sc.s) && !unicode.IsSpace(sc.s[end]) {
+		end++
+	}
+	return end
+}
+
+func (sc *filterScanner) op() string {
+	rest := string(sc.s[sc.pos:])
+	for _, op := range filterOps {
+		if strings.HasPrefix(rest, op) {
+			sc.pos += len([]rune(op))
+			return op
+		}
+	}
+	return ""
+}
+
+// word reads a quoted string or an unquoted run of characters up to a space, or up to an
+// operator when key is set.
+func (sc *filterScanner) word(key bool) (string, bool, *ItemFilterError) {
+	if !sc.done() && sc.s[sc.pos] == '"' {
+		end := sc.pos + 1
+		for end < len(sc.s) && sc.s[end] != '"' {
+			end++
+		}
+
+		if end >= len(sc.s) {
+			sc.pos = end
+			return "", true, &ItemFilterError{Reason: "missing closing quote"}
+		}
+
+		w := string(sc.s[sc.pos+1 : end])
+		sc.pos = end + 1
+		return w, true, nil
+	}
+
+	start := sc.pos
+	for !sc.done() {
+		r := sc.s[sc.pos]
+		if unicode.IsSpace(r) || key && strings.ContainsRune(":=!<>", r) {
+			break
+		}
+		sc.pos++
+	}
+
+	return string(sc.s[start:sc.pos]), false, nil
+}
+
+func (sc *filterScanner) term(f *ItemFilter) (predicate.Item, *ItemFilterError) {
+	negate := false
+	if sc.s[sc.pos] == '-' {
+		negate = true
+		sc.pos++
+	}
+
+	key, quoted, err := sc.word(true)
+	if err != nil {
+		return nil, err
+	}
+
+	op := sc.op()
+
+	var p predicate.Item
+	switch {
+	case op == "" && key == "":
+		return nil, &ItemFilterError{Reason: "expected a search term"}
+	case op == "":
+		p = filterBareWord(f, key, quoted, negate)
+	case key == "":
+		return nil, &ItemFilterError{Reason: "expected a key before '" + op + "'"}
+	case strings.EqualFold(key, "field"):
+		p, err = sc.fieldTerm()
+	default:
+		var value string
+		value, _, err = sc.word(false)
+		if err != nil {
+			return nil, err
+		}
+
+		if value == "" {
+			return nil, &ItemFilterError{Reason: "missing value"}
+		}
+
+		p, err = filterKeyTerm(strings.ToLower(key), op, value)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !sc.done() && !unicode.IsSpace(sc.s[sc.pos]) {
+		return nil, &ItemFilterError{Reason: "unexpected '" + string(sc.s[sc.pos]) + "'"}
+	}
+
+	if negate {
+		p = item.Not(p)
+	}
+
+	return p, nil
+}
+
+func filterBareWord(f *ItemFilter, word string, quoted, negate bool) predicate.Item {
+	if !quoted {
+		switch strings.ToLower(word) {
+		case "archived":
+			f.includeArchived = f.includeArchived || !negate
+			return item.Archived(true)
+		case "insured":
+			return item.Insured(true)
+		}
+	}
+
+	return item.Or(
+		item.NameContainsFold(word),
+		item.DescriptionContainsFold(word),
+		item.SerialNumberContainsFold(word),
+		item.ModelNumberContainsFold(word),
+		item.ManufacturerContainsFold(word),
+		item.NotesContainsFold(word),
+	)
+}
+
+var (
+	filterTextColumns = map[string]string{
+		"name":         item.FieldName,
+		"description":  item.FieldDescription,
+		"manufacturer": item.FieldManufacturer,
+		"model":        item.FieldModelNumber,
+		"serial":       item.FieldSerialNumber,
+		"notes":        item.FieldNotes,
+	}
+
+	filterNumberColumns = map[string]string{
+		"price":     item.FieldPurchasePrice,
+		"soldprice": item.FieldSoldPrice,
+		"quantity":  item.FieldQuantity,
+		"asset":     item.FieldAssetID,
+	}
+
+	filterDateColumns = map[string]string{
+		"purchased": item.FieldPurchaseTime,
+		"warranty":  item.FieldWarrantyExpires,
+		"sold":      item.FieldSoldTime,
+		"created":   item.FieldCreatedAt,
+		"updated":   item.FieldUpdatedAt,
+	}
+)
+
+func filterKeyTerm(key, op, value string) (predicate.Item, *ItemFilterError) {
+	if col, ok := filterTextColumns[key]; ok {
+		p, err := filterText(col, op, value)
+		if err != nil {
+			return nil, err
+		}
+		return filterNot(op, predicate.Item(p)), nil
+	}
+
+	if col, ok := filterNumberColumns[key]; ok {
+		var (
+			v   any
+			err error
+		)
+
+		switch key {
+		case "asset":
+			aid, ok := ParseAssetID(value)
+			if !ok {
+				return nil, &ItemFilterError{Reason: "expected an asset ID"}
+			}
+			v = aid.Int()
+		case "quantity":
+			v, err = strconv.Atoi(value)
+		default:
+			v, err = strconv.ParseFloat(value, 64)
+		}
+
+		if err != nil {
+			return nil, &ItemFilterError{Reason: "expected a number"}
+		}
+
+		return filterCompare(col, op, v), nil
+	}
+
+	if col, ok := filterDateColumns[key]; ok {
+		day, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, &ItemFilterError{Reason: "expected a date formatted as YYYY-MM-DD"}
+		}
+
+		return filterDate(col, op, day), nil
+	}
+
+	switch key {
+	case "label":
+		p, err := filterText(label.FieldName, op, value)
+		if err != nil {
+			return nil, err
+		}
+		return filterNot(op, item.HasLabelWith(predicate.Label(p))), nil
+	case "location":
+		p, err := filterText(location.FieldName, op, value)
+		if err != nil {
+			return nil, err
+		}
+		return filterNot(op, item.HasLocationWith(predicate.Location(p))), nil
+	}
+
+	return nil, &ItemFilterError{Reason: "unknown key '" + key + "'"}
+}
+
+// filterText matches a text column, ':' is a substring match and '=' an exact match
+// ignoring case. The returned predicate can be converted to the predicate of any entity.
+func filterText(col, op, value string) (func(*sql.Selector), *ItemFilterError) {
+	switch op {
+	case ":":
+		return sql.FieldContainsFold(col, value), nil
+	case "=", "!=":
+		// '!=' is applied by the caller with filterNot so items without neighbors are included
+		return sql.FieldEqualFold(col, value), nil
+	}
+
+	return nil, &ItemFilterError{Reason: "'" + op + "' is not supported for text"}
+}
+
+// filterEdge negates edge predicates for '!=', meaning that no neighbor matches.
+func filterNot(op string, p predicate.Item) predicate.Item {
+	if op == "!=" {
+		return item.Not(p)
+	}
+	return p
+}
+
+func filterCompare(col, op string, v any) predicate.Item {
+	switch op {
+	case "!=":
+		return predicate.Item(sql.FieldNEQ(col, v))
+	case ">":
+		return predicate.Item(sql.FieldGT(col, v))
+	case ">=":
+		return predicate.Item(sql.FieldGTE(col, v))
+	case "<":
+		return predicate.Item(sql.FieldLT(col, v))
+	case "<=":
+		return predicate.Item(sql.FieldLTE(col, v))
+	default:
+		return predicate.Item(sql.FieldEQ(col, v))
+	}
+}
+
+// filterDate compares a date column by whole days. Unset dates are stored as the zero
+// time and never match.
+func filterDate(col, op string, day time.Time) predicate.Item {
+	next := day.AddDate(0, 0, 1)
+
+	var p predicate.Item
+	switch op {
+	case ">":
+		p = predicate.Item(sql.FieldGTE(col, next))
+	case ">=":
+		p = predicate.Item(sql.FieldGTE(col, day))
+	case "<":
+		p = predicate.Item(sql.FieldLT(col, day))
+	case "<=":
+		p = predicate.Item(sql.FieldLT(col, next))
+	case "!=":
+		p = item.Not(item.And(
+			predicate.Item(sql.FieldGTE(col, day)),
+			predicate.Item(sql.FieldLT(col, next)),
+		))
+	default:
+		p = item.And(
+			predicate.Item(sql.FieldGTE(col, day)),
+			predicate.Item(sql.FieldLT(col, next)),
+		)
+	}
+
+	return item.And(predicate.Item(sql.FieldGT(col, time.Time{})), p)
+}
+
+// fieldTerm parses the remainder of a 'field:name<op>value' term.
+func (sc *filterScanner) fieldTerm() (predicate.Item, *ItemFilterError) {
+	name, _, err := sc.word(true)
+	if err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		return nil, &ItemFilterError{Reason: "missing field name"}
+	}
+
+	nameP := itemfield.NameEqualFold(name)
+
+	op := sc.op()
+	if op == "" {
+		return item.HasFieldsWith(nameP), nil
+	}
+
+	value, _, err := sc.word(false)
+	if err != nil {
+		return nil, err
+	}
+
+	if value == "" {
+		return nil, &ItemFilterError{Reason: "missing value"}
+	}
+
+	n, nErr := strconv.Atoi(value)
+
+	switch op {
+	case ":":
+		return item.HasFieldsWith(nameP, itemfield.TextValueContainsFold(value)), nil
+	case "=", "!=":
+		matches := []predicate.ItemField{itemfield.TextValueEqualFold(value)}
+		if nErr == nil {
+			matches = append(matches, itemfield.And(itemfield.TypeEQ(itemfield.TypeNumber), itemfield.NumberValue(n)))
+		}
+		if b, err := strconv.ParseBool(value); err == nil {
+			matches = append(matches, itemfield.And(itemfield.TypeEQ(itemfield.TypeBoolean), itemfield.BooleanValue(b)))
+		}
+
+		return filterNot(op, item.HasFieldsWith(nameP, itemfield.Or(matches...))), nil
+	}
+
+	if nErr != nil {
+		return nil, &ItemFilterError{Reason: "expected a number"}
+	}
+
+	return item.HasFieldsWith(
+		nameP,
+		itemfield.TypeEQ(itemfield.TypeNumber),
+		predicate.ItemField(filterCompare(itemfield.FieldNumberValue, op, n)),
+	), nil
+}
@@ -0,0 +1,168 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseItemFilter_Errors(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   string
+	}{
+		{"price>abc", `"price>abc": expected a number`},
+		{"color:red", `"color:red": unknown key 'color'`},
+		{"warranty<2027", `"warranty<2027": expected a date formatted as YYYY-MM-DD`},
+		{"drill >5", `">5": expected a key before '>'`},
+		{"label:", `"label:": missing value`},
+		{`label:"power tools`, `"label:\"power tools": missing closing quote`},
+		{"price<>5", `"price<>5": expected a number`},
+		{"name>drill", `"name>drill": '>' is not supported for text`},
+		{"field:=red", `"field:=red": missing field name`},
+		{"field:size>big", `"field:size>big": expected a number`},
+		{`name:"a"b`, `"name:\"a\"b": unexpected 'b'`},
+		{"asset:abc", `"asset:abc": expected an asset ID`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			_, err := ParseItemFilter(tt.filter)
+			require.Error(t, err)
+			assert.Equal(t, tt.want, err.Error())
+		})
+	}
+}
+
+func TestItemsRepository_QueryByGroup_Filter(t *testing.T) {
+	ctx := context.Background()
+
+	loc, err := tRepos.Locations.Create(ctx, tGroup.ID, LocationCreate{Name: "Filter Shelf"})
+	require.NoError(t, err)
+
+	lbl, err := tRepos.Labels.Create(ctx, tGroup.ID, LabelCreate{Name: "Electronics"})
+	require.NoError(t, err)
+
+	type spec struct {
+		name     string
+		price    float64
+		warranty time.Time
+		archived bool
+		labels   []uuid.UUID
+		fields   []ItemField
+	}
+
+	specs := []spec{
+		{
+			name:     "Television",
+			price:    899,
+			warranty: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC),
+			labels:   []uuid.UUID{lbl.ID},
+			fields: []ItemField{
+				{Type: "text", Name: "Color", TextValue: "Red"},
+				{Type: "number", Name: "Inches", NumberValue: 55},
+			},
+		},
+		{
+			name:     "Radio",
+			price:    40,
+			warranty: time.Date(2028, 1, 1, 0, 0, 0, 0, time.UTC),
+			labels:   []uuid.UUID{lbl.ID},
+			fields: []ItemField{
+				{Type: "text", Name: "Color", TextValue: "Black"},
+			},
+		},
+		{
+			name:     "Old Phone",
+			price:    250,
+			archived: true,
+			labels:   []uuid.UUID{lbl.ID},
+		},
+		{
+			name:  "Hammer",
+			price: 25,
+			fields: []ItemField{
+				{Type: "number", Name: "Inches", NumberValue: 12},
+			},
+		},
+	}
+
+	ids := make(map[string]uuid.UUID, len(specs))
+	for _, s := range specs {
+		itm, err := tRepos.Items.Create(ctx, tGroup.ID, ItemCreate{Name: s.name, LocationID: loc.ID})
+		require.NoError(t, err)
+
+		_, err = tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+			ID:              itm.ID,
+			Name:            s.name,
+			LocationID:      loc.ID,
+			Quantity:        1,
+			PurchasePrice:   s.price,
+			WarrantyExpires: types.Date(s.warranty),
+			Archived:        s.archived,
+			LabelIDs:        s.labels,
+			Fields:          s.fields,
+		})
+		require.NoError(t, err)
+
+		ids[s.name] = itm.ID
+	}
+
+	t.Cleanup(func() {
+		for _, id := range ids {
+			_ = tRepos.Items.Delete(context.Background(), id)
+		}
+		_ = tRepos.Labels.delete(context.Background(), lbl.ID)
+		_ = tRepos.Locations.delete(context.Background(), loc.ID)
+	})
+
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{"", []string{"Hammer", "Radio", "Television"}},
+		{"price>100", []string{"Television"}},
+		{"price>=40 price<=899", []string{"Radio", "Television"}},
+		{"label:electronics", []string{"Radio", "Television"}},
+		{"-label=electronics", []string{"Hammer"}},
+		{"label:electronics archived", []string{"Old Phone"}},
+		{"label:electronics -archived", []string{"Radio", "Television"}},
+		{"warranty<2027-01-01", []string{"Television"}},
+		{"warranty>2026-05-01", []string{"Radio"}},
+		{"warranty:2026-05-01", []string{"Television"}},
+		{"field:color=red", []string{"Television"}},
+		{"field:color!=red", []string{"Hammer", "Radio"}},
+		{`field:"color"`, []string{"Radio", "Television"}},
+		{"field:inches>20", []string{"Television"}},
+		{"field:inches=12", []string{"Hammer"}},
+		{"tele", []string{"Television"}},
+		{"name=radio", []string{"Radio"}},
+		{`name:"old phone" archived`, []string{"Old Phone"}},
+		{`location:"filter shelf" price<30`, []string{"Hammer"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			f, err := ParseItemFilter(tt.filter)
+			require.NoError(t, err)
+
+			res, err := tRepos.Items.QueryByGroup(ctx, tGroup.ID, ItemQuery{
+				LocationIDs: []uuid.UUID{loc.ID},
+				Filter:      f,
+			})
+			require.NoError(t, err)
+
+			names := make([]string, len(res.Items))
+			for i, it := range res.Items {
+				names[i] = it.Name
+			}
+
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
@@ -2,13 +2,17 @@ package repo
 
 import (
 	"context"
+	"database/sql"
 	"log"
 	"os"
 	"testing"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/hay-kot/homebox/backend/internal/core/services/reporting/eventbus"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/hay-kot/homebox/backend/pkgs/faker"
+	_ "github.com/jackc/pgx/v5/stdlib"
 	_ "github.com/mattn/go-sqlite3"
 )
 
@@ -39,10 +43,26 @@ func bootstrap() {
 	}
 }
 
+// testClient opens the database used by the test suite. By default an in-memory SQLite
+// database is used, setting HBOX_TEST_POSTGRES_URL to the url of an empty PostgreSQL
+// database runs the suite against Postgres instead.
+func testClient() (*ent.Client, error) {
+	if url := os.Getenv("HBOX_TEST_POSTGRES_URL"); url != "" {
+		db, err := sql.Open("pgx", url)
+		if err != nil {
+			return nil, err
+		}
+
+		return ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db))), nil
+	}
+
+	return ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+}
+
 func TestMain(m *testing.M) {
-	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	client, err := testClient()
 	if err != nil {
-		log.Fatalf("failed opening connection to sqlite: %v", err)
+		log.Fatalf("failed opening connection to database: %v", err)
 	}
 
 	go func() {
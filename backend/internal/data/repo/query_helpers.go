@@ -1,11 +1,65 @@
 package repo
 
-import "time"
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
 
 func sqliteDateFormat(t time.Time) string {
 	return t.Format("2006-01-02 15:04:05")
 }
 
+// dateParam returns the value to bind when comparing a time against a datetime column in a
+// raw query. SQLite stores datetimes as text so the time is formatted, while Postgres compares
+// timestamps natively.
+func dateParam(d string, t time.Time) any {
+	if d == dialect.Postgres {
+		return t
+	}
+
+	return sqliteDateFormat(t)
+}
+
+// rebind rewrites the '?' bind parameters used by the raw queries into the positional
+// '$1', '$2', ... form expected by Postgres. A '?' inside a quoted string literal or
+// identifier is kept as is. Queries for other dialects are returned as is.
+func rebind(d string, query string) string {
+	if d != dialect.Postgres {
+		return query
+	}
+
+	var (
+		bldr  strings.Builder
+		n     int
+		quote rune
+	)
+
+	bldr.Grow(len(query) + 10)
+	for _, r := range query {
+		switch {
+		case quote != 0:
+			// An escaped quote is doubled, it closes and reopens the quoted section.
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '?':
+			n++
+			bldr.WriteByte('$')
+			bldr.WriteString(strconv.Itoa(n))
+			continue
+		}
+
+		bldr.WriteRune(r)
+	}
+
+	return bldr.String()
+}
+
 // orDefault returns the value of the pointer if it is not nil, otherwise it returns the default value
 //
 // This is used for nullable or potentially nullable fields (or aggregates) in the database when running
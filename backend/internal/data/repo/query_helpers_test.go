@@ -0,0 +1,56 @@
+package repo
+
+import (
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRebind(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect string
+		query   string
+		want    string
+	}{
+		{
+			name:    "sqlite",
+			dialect: dialect.SQLite,
+			query:   "SELECT * FROM items WHERE group_items = ? AND created_at > ?",
+			want:    "SELECT * FROM items WHERE group_items = ? AND created_at > ?",
+		},
+		{
+			name:    "postgres",
+			dialect: dialect.Postgres,
+			query:   "SELECT * FROM items WHERE group_items = ? AND created_at > ?",
+			want:    "SELECT * FROM items WHERE group_items = $1 AND created_at > $2",
+		},
+		{
+			name:    "postgres string literals",
+			dialect: dialect.Postgres,
+			query:   "SELECT 'why?', 'it''s ?' FROM items WHERE name = ? AND notes <> '?'",
+			want:    "SELECT 'why?', 'it''s ?' FROM items WHERE name = $1 AND notes <> '?'",
+		},
+		{
+			name:    "postgres quoted identifiers",
+			dialect: dialect.Postgres,
+			query:   `SELECT "what?" FROM items WHERE id = ?`,
+			want:    `SELECT "what?" FROM items WHERE id = $1`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, rebind(tt.dialect, tt.query))
+		})
+	}
+}
+
+func TestDateParam(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, "2024-03-01 12:30:00", dateParam(dialect.SQLite, now))
+	assert.Equal(t, now, dateParam(dialect.Postgres, now))
+}
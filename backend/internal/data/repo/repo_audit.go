@@ -0,0 +1,330 @@
+package repo
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/auditentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemfield"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// AuditRepository provides read access to the audit log of a group. Entries are
+// written by the repositories of the audited entities as they are mutated.
+type AuditRepository struct {
+	db *ent.Client
+}
+
+type (
+	AuditQuery struct {
+		Page       int       `json:"page"       schema:"page"`
+		PageSize   int       `json:"pageSize"   schema:"pageSize"`
+		Action     string    `json:"action"     schema:"action"     validate:"omitempty,oneof=create update delete restore purge"`
+		EntityType string    `json:"entityType" schema:"entityType" validate:"omitempty,oneof=item location label attachment"`
+		EntityID   uuid.UUID `json:"entityId"   schema:"entityId"`
+		ItemID     uuid.UUID `json:"itemId"     schema:"itemId"`
+		UserID     uuid.UUID `json:"userId"     schema:"userId"`
+		Since      time.Time `json:"since"      schema:"since"`
+		Until      time.Time `json:"until"      schema:"until"`
+	}
+
+	AuditUser struct {
+		ID   uuid.UUID `json:"id"`
+		Name string    `json:"name"`
+	}
+
+	AuditEntryOut struct {
+		ID         uuid.UUID           `json:"id"`
+		CreatedAt  time.Time           `json:"createdAt"`
+		Action     string              `json:"action"`
+		EntityType string              `json:"entityType"`
+		EntityID   uuid.UUID           `json:"entityId"`
+		EntityName string              `json:"entityName"`
+		ItemID     *uuid.UUID          `json:"itemId,omitempty" extensions:"x-nullable,x-omitempty"`
+		User       *AuditUser          `json:"user,omitempty"   extensions:"x-nullable,x-omitempty"`
+		Changes    []types.FieldChange `json:"changes"`
+	}
+)
+
+func mapAuditEntryOut(e *ent.AuditEntry) AuditEntryOut {
+	var usr *AuditUser
+	if e.Edges.User != nil {
+		usr = &AuditUser{
+			ID:   e.Edges.User.ID,
+			Name: e.Edges.User.Name,
+		}
+	}
+
+	changes := e.Changes
+	if changes == nil {
+		changes = []types.FieldChange{}
+	}
+
+	return AuditEntryOut{
+		ID:         e.ID,
+		CreatedAt:  e.CreatedAt,
+		Action:     e.Action.String(),
+		EntityType: e.EntityType.String(),
+		EntityID:   e.EntityID,
+		EntityName: e.EntityName,
+		ItemID:     e.ItemID,
+		User:       usr,
+		Changes:    changes,
+	}
+}
+
+// QueryByGroup returns the audit entries of the group matching the query, newest first.
+func (r *AuditRepository) QueryByGroup(ctx context.Context, GID uuid.UUID, q AuditQuery) (PaginationResult[AuditEntryOut], error) {
+	qb := r.db.AuditEntry.Query().Where(auditentry.GroupID(GID))
+
+	if q.Action != "" {
+		qb = qb.Where(auditentry.ActionEQ(auditentry.Action(q.Action)))
+	}
+
+	if q.EntityType != "" {
+		qb = qb.Where(auditentry.EntityTypeEQ(auditentry.EntityType(q.EntityType)))
+	}
+
+	if q.EntityID != uuid.Nil {
+		qb = qb.Where(auditentry.EntityID(q.EntityID))
+	}
+
+	if q.ItemID != uuid.Nil {
+		qb = qb.Where(auditentry.ItemID(q.ItemID))
+	}
+
+	if q.UserID != uuid.Nil {
+		qb = qb.Where(auditentry.UserID(q.UserID))
+	}
+
+	if !q.Since.IsZero() {
+		qb = qb.Where(auditentry.CreatedAtGTE(q.Since))
+	}
+
+	if !q.Until.IsZero() {
+		qb = qb.Where(auditentry.CreatedAtLTE(q.Until))
+	}
+
+	count, err := qb.Count(ctx)
+	if err != nil {
+		return PaginationResult[AuditEntryOut]{}, err
+	}
+
+	if q.Page <= 0 {
+		q.Page = 1
+	}
+
+	if q.PageSize <= 0 || q.PageSize > 100 {
+		q.PageSize = 50
+	}
+
+	entries, err := qb.
+		WithUser().
+		Order(ent.Desc(auditentry.FieldCreatedAt)).
+		Offset(calculateOffset(q.Page, q.PageSize)).
+		Limit(q.PageSize).
+		All(ctx)
+	if err != nil {
+		return PaginationResult[AuditEntryOut]{}, err
+	}
+
+	return PaginationResult[AuditEntryOut]{
+		Page:     q.Page,
+		PageSize: q.PageSize,
+		Total:    count,
+		Items:    mapEach(entries, mapAuditEntryOut),
+	}, nil
+}
+
+type actorCtxKey struct{}
+
+// WithActor returns a copy of ctx that attributes the changes made with it to
+// the user. Changes made without an actor are recorded as system changes.
+func WithActor(ctx context.Context, UID uuid.UUID) context.Context {
+	return context.WithValue(ctx, actorCtxKey{}, UID)
+}
+
+func actorFromCtx(ctx context.Context) *uuid.UUID {
+	if v, ok := ctx.Value(actorCtxKey{}).(uuid.UUID); ok && v != uuid.Nil {
+		return &v
+	}
+	return nil
+}
+
+// auditSnapshot is a flat representation of the audited fields of an entity,
+// two snapshots are compared to get the changes of a mutation.
+type auditSnapshot map[string]any
+
+type auditRecord struct {
+	GID        uuid.UUID
+	Action     auditentry.Action
+	EntityType auditentry.EntityType
+	EntityID   uuid.UUID
+	EntityName string
+	ItemID     *uuid.UUID
+	From       auditSnapshot
+	To         auditSnapshot
+}
+
+// recordAudit writes an entry to the audit log. Updates that don't change any
+// audited field are not recorded.
+func recordAudit(ctx context.Context, db *ent.Client, rec auditRecord) error {
+	changes := diffSnapshots(rec.From, rec.To)
+	if rec.Action == auditentry.ActionUpdate && len(changes) == 0 {
+		return nil
+	}
+
+	return db.AuditEntry.Create().
+		SetGroupID(rec.GID).
+		SetAction(rec.Action).
+		SetEntityType(rec.EntityType).
+		SetEntityID(rec.EntityID).
+		SetEntityName(truncate(rec.EntityName, 255)).
+		SetNillableItemID(rec.ItemID).
+		SetNillableUserID(actorFromCtx(ctx)).
+		SetChanges(changes).
+		Exec(ctx)
+}
+
+// diffSnapshots returns the changed fields between two snapshots sorted by name.
+// Either snapshot may be nil for created or deleted entities, in which case
+// only the fields that are set are returned.
+func diffSnapshots(from, to auditSnapshot) []types.FieldChange {
+	keys := make(map[string]struct{}, len(from)+len(to))
+	for k := range from {
+		keys[k] = struct{}{}
+	}
+	for k := range to {
+		keys[k] = struct{}{}
+	}
+
+	names := make([]string, 0, len(keys))
+	for k := range keys {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	isZero := func(v any) bool {
+		return v == nil || reflect.ValueOf(v).IsZero()
+	}
+
+	changes := []types.FieldChange{}
+	for _, k := range names {
+		a, b := from[k], to[k]
+		if isZero(a) && isZero(b) || reflect.DeepEqual(a, b) {
+			continue
+		}
+
+		if isZero(a) {
+			a = nil
+		}
+		if isZero(b) {
+			b = nil
+		}
+
+		changes = append(changes, types.FieldChange{Field: k, From: a, To: b})
+	}
+
+	return changes
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
+
+func itemSnapshot(it *ent.Item) auditSnapshot {
+	s := auditSnapshot{
+		"name":             it.Name,
+		"description":      it.Description,
+		"quantity":         it.Quantity,
+		"insured":          it.Insured,
+		"archived":         it.Archived,
+		"assetId":          AssetID(it.AssetID).String(),
+		"serialNumber":     it.SerialNumber,
+		"modelNumber":      it.ModelNumber,
+		"manufacturer":     it.Manufacturer,
+		"lifetimeWarranty": it.LifetimeWarranty,
+		"warrantyExpires":  types.DateFromTime(it.WarrantyExpires).String(),
+		"warrantyDetails":  it.WarrantyDetails,
+		"purchaseTime":     types.DateFromTime(it.PurchaseTime).String(),
+		"purchaseFrom":     it.PurchaseFrom,
+		"purchasePrice":    it.PurchasePrice,
+		"soldTime":         types.DateFromTime(it.SoldTime).String(),
+		"soldTo":           it.SoldTo,
+		"soldPrice":        it.SoldPrice,
+		"soldNotes":        it.SoldNotes,
+		"notes":            it.Notes,
+	}
+
+	if it.Edges.Location != nil {
+		s["location"] = it.Edges.Location.Name
+	}
+
+	if it.Edges.Parent != nil {
+		s["parent"] = it.Edges.Parent.Name
+	}
+
+	var labels []string
+	for _, l := range it.Edges.Label {
+		labels = append(labels, l.Name)
+	}
+	sort.Strings(labels)
+	s["labels"] = labels
+
+	for _, f := range it.Edges.Fields {
+		var v any
+		switch f.Type {
+		case itemfield.TypeNumber:
+			v = f.NumberValue
+		case itemfield.TypeBoolean:
+			v = f.BooleanValue
+		default:
+			v = f.TextValue
+		}
+
+		s["fields."+f.Name] = v
+	}
+
+	return s
+}
+
+func locationSnapshot(loc *ent.Location) auditSnapshot {
+	s := auditSnapshot{
+		"name":        loc.Name,
+		"description": loc.Description,
+	}
+
+	if loc.Edges.Parent != nil {
+		s["parent"] = loc.Edges.Parent.Name
+	}
+
+	return s
+}
+
+func labelSnapshot(l *ent.Label) auditSnapshot {
+	return auditSnapshot{
+		"name":        l.Name,
+		"description": l.Description,
+		"color":       l.Color,
+	}
+}
+
+func attachmentSnapshot(a *ent.Attachment) auditSnapshot {
+	s := auditSnapshot{
+		"type":    a.Type.String(),
+		"primary": a.Primary,
+	}
+
+	if a.Edges.Document != nil {
+		s["title"] = a.Edges.Document.Title
+	}
+
+	return s
+}
@@ -0,0 +1,185 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditRepository_ItemHistory(t *testing.T) {
+	ctx := WithActor(context.Background(), tUser.ID)
+
+	loc := useLocations(t, 1)[0]
+	doc := useDocs(t, 1)[0]
+
+	itm, err := tRepos.Items.Create(ctx, tGroup.ID, ItemCreate{
+		Name:       "Drill",
+		LocationID: loc.ID,
+	})
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.UpdateByGroup(ctx, tGroup.ID, ItemUpdate{
+		ID:            itm.ID,
+		Name:          "Cordless Drill",
+		LocationID:    loc.ID,
+		Quantity:      itm.Quantity,
+		PurchasePrice: 99.5,
+	})
+	require.NoError(t, err)
+
+	qty := 3
+	err = tRepos.Items.Patch(ctx, tGroup.ID, itm.ID, ItemPatch{ID: itm.ID, Quantity: &qty})
+	require.NoError(t, err)
+
+	_, err = tRepos.Attachments.Create(ctx, itm.ID, doc.ID, attachment.TypeManual)
+	require.NoError(t, err)
+
+	err = tRepos.Items.DeleteByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+
+	// History must outlive the item
+	history, err := tRepos.Audit.QueryByGroup(context.Background(), tGroup.ID, AuditQuery{ItemID: itm.ID})
+	require.NoError(t, err)
+	require.Equal(t, 5, history.Total)
+
+	entries := history.Items
+	for _, e := range entries {
+		require.NotNil(t, e.User)
+		assert.Equal(t, tUser.ID, e.User.ID)
+		assert.Equal(t, itm.ID, *e.ItemID)
+	}
+
+	assert.Equal(t, "delete", entries[0].Action)
+	assert.Equal(t, "item", entries[0].EntityType)
+
+	assert.Equal(t, "create", entries[1].Action)
+	assert.Equal(t, "attachment", entries[1].EntityType)
+	assert.Equal(t, doc.Title, entries[1].EntityName)
+
+	assert.Equal(t, "update", entries[2].Action)
+	assert.Equal(t, []types.FieldChange{
+		{Field: "quantity", From: float64(1), To: float64(3)},
+	}, entries[2].Changes)
+
+	assert.Equal(t, "update", entries[3].Action)
+	assert.Equal(t, []types.FieldChange{
+		{Field: "name", From: "Drill", To: "Cordless Drill"},
+		{Field: "purchasePrice", From: nil, To: 99.5},
+	}, entries[3].Changes)
+
+	assert.Equal(t, "create", entries[4].Action)
+	assert.Equal(t, "Drill", entries[4].EntityName)
+	assert.Contains(t, entries[4].Changes, types.FieldChange{Field: "location", From: nil, To: loc.Name})
+}
+
+func TestAuditRepository_SkipsNoopUpdates(t *testing.T) {
+	itm := useItems(t, 1)[0]
+
+	_, err := tRepos.Items.UpdateByGroup(context.Background(), tGroup.ID, ItemUpdate{
+		ID:          itm.ID,
+		Name:        itm.Name,
+		Description: itm.Description,
+		LocationID:  itm.Location.ID,
+		Quantity:    itm.Quantity,
+	})
+	require.NoError(t, err)
+
+	history, err := tRepos.Audit.QueryByGroup(context.Background(), tGroup.ID, AuditQuery{ItemID: itm.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, history.Total)
+	assert.Equal(t, "create", history.Items[0].Action)
+}
+
+func TestAuditRepository_QueryByGroup_Filters(t *testing.T) {
+	ctx := WithActor(context.Background(), tUser.ID)
+
+	lbl, err := tRepos.Labels.Create(ctx, tGroup.ID, labelFactory())
+	require.NoError(t, err)
+
+	_, err = tRepos.Labels.UpdateByGroup(ctx, tGroup.ID, LabelUpdate{ID: lbl.ID, Name: "Renamed"})
+	require.NoError(t, err)
+
+	// Changes without an actor are recorded as system changes
+	err = tRepos.Labels.DeleteByGroup(context.Background(), tGroup.ID, lbl.ID)
+	require.NoError(t, err)
+
+	all, err := tRepos.Audit.QueryByGroup(context.Background(), tGroup.ID, AuditQuery{EntityID: lbl.ID})
+	require.NoError(t, err)
+	require.Equal(t, 3, all.Total)
+	assert.Nil(t, all.Items[0].User)
+
+	updates, err := tRepos.Audit.QueryByGroup(context.Background(), tGroup.ID, AuditQuery{
+		EntityID: lbl.ID,
+		Action:   "update",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, updates.Total)
+	assert.Equal(t, "Renamed", updates.Items[0].EntityName)
+
+	byUser, err := tRepos.Audit.QueryByGroup(context.Background(), tGroup.ID, AuditQuery{
+		EntityID: lbl.ID,
+		UserID:   tUser.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, byUser.Total)
+
+	labels, err := tRepos.Audit.QueryByGroup(context.Background(), tGroup.ID, AuditQuery{EntityType: "label"})
+	require.NoError(t, err)
+	for _, e := range labels.Items {
+		assert.Equal(t, "label", e.EntityType)
+	}
+
+	other, err := tRepos.Audit.QueryByGroup(context.Background(), tGroup.ID, AuditQuery{
+		EntityID: lbl.ID,
+		Since:    all.Items[0].CreatedAt.Add(1),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, other.Total)
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	tests := []struct {
+		name string
+		from auditSnapshot
+		to   auditSnapshot
+		want []types.FieldChange
+	}{
+		{
+			name: "create skips zero values",
+			from: nil,
+			to:   auditSnapshot{"name": "Drill", "notes": "", "labels": []string(nil)},
+			want: []types.FieldChange{{Field: "name", From: nil, To: "Drill"}},
+		},
+		{
+			name: "delete",
+			from: auditSnapshot{"name": "Drill", "quantity": 0},
+			to:   nil,
+			want: []types.FieldChange{{Field: "name", From: "Drill", To: nil}},
+		},
+		{
+			name: "update",
+			from: auditSnapshot{"name": "Drill", "labels": []string{"a"}, "fields.color": "red"},
+			to:   auditSnapshot{"name": "Drill", "labels": []string{"a", "b"}},
+			want: []types.FieldChange{
+				{Field: "fields.color", From: "red", To: nil},
+				{Field: "labels", From: []string{"a"}, To: []string{"a", "b"}},
+			},
+		},
+		{
+			name: "no changes",
+			from: auditSnapshot{"name": "Drill", "labels": []string{"a"}},
+			to:   auditSnapshot{"name": "Drill", "labels": []string{"a"}},
+			want: []types.FieldChange{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, diffSnapshots(tt.from, tt.to))
+		})
+	}
+}
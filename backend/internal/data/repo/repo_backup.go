@@ -0,0 +1,947 @@
+package repo
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/document"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemfield"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationreminder"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationrule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationtemplate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// BackupRepository exports everything in a group and restores it as a new group. Audit
+// entries, notifier deliveries, invitations and tokens are not part of a backup.
+type BackupRepository struct {
+	db     *ent.Client
+	docs   *DocumentRepository
+	search *SearchRepository
+}
+
+// GroupBackup is the content of a group. The IDs are the IDs of the exported group, they
+// are only used to link the entities of the backup and are replaced on restore.
+type (
+	GroupBackup struct {
+		Group                 BackupGroup                  `json:"group"`
+		Members               []BackupMember               `json:"members"`
+		Labels                []BackupLabel                `json:"labels"`
+		Locations             []BackupLocation             `json:"locations"`
+		Items                 []BackupItem                 `json:"items"`
+		Documents             []BackupDocument             `json:"documents"`
+		MaintenanceSchedules  []BackupMaintenanceSchedule  `json:"maintenanceSchedules"`
+		MaintenanceEntries    []BackupMaintenanceEntry     `json:"maintenanceEntries"`
+		Notifiers             []BackupNotifier             `json:"notifiers"`
+		NotificationRules     []BackupNotificationRule     `json:"notificationRules"`
+		NotificationTemplates []BackupNotificationTemplate `json:"notificationTemplates"`
+		NotificationReminders []BackupNotificationReminder `json:"notificationReminders"`
+		SavedSearches         []BackupSavedSearch          `json:"savedSearches"`
+	}
+
+	BackupGroup struct {
+		Name       string    `json:"name"`
+		Currency   string    `json:"currency"`
+		Timezone   string    `json:"timezone"`
+		NotifyTime string    `json:"notifyTime"`
+		Digest     string    `json:"digest"`
+		CreatedAt  time.Time `json:"createdAt"`
+	}
+
+	// BackupMember is a member of the group, accounts and credentials are not part of a
+	// backup.
+	BackupMember struct {
+		Email string `json:"email"`
+		Name  string `json:"name"`
+		Role  string `json:"role"`
+	}
+
+	BackupLabel struct {
+		ID          uuid.UUID `json:"id"`
+		Name        string    `json:"name"`
+		Description string    `json:"description"`
+		Color       string    `json:"color"`
+		CreatedAt   time.Time `json:"createdAt"`
+		UpdatedAt   time.Time `json:"updatedAt"`
+	}
+
+	BackupLocation struct {
+		ID          uuid.UUID  `json:"id"`
+		ParentID    *uuid.UUID `json:"parentId"`
+		Name        string     `json:"name"`
+		Description string     `json:"description"`
+		CreatedAt   time.Time  `json:"createdAt"`
+		UpdatedAt   time.Time  `json:"updatedAt"`
+		DeletedAt   *time.Time `json:"deletedAt"`
+	}
+
+	BackupItem struct {
+		ID               uuid.UUID          `json:"id"`
+		ParentID         *uuid.UUID         `json:"parentId"`
+		LocationID       *uuid.UUID         `json:"locationId"`
+		LabelIDs         []uuid.UUID        `json:"labelIds"`
+		Name             string             `json:"name"`
+		Description      string             `json:"description"`
+		ImportRef        string             `json:"importRef"`
+		Notes            string             `json:"notes"`
+		Quantity         int                `json:"quantity"`
+		Insured          bool               `json:"insured"`
+		Archived         bool               `json:"archived"`
+		AssetID          int                `json:"assetId"`
+		SerialNumber     string             `json:"serialNumber"`
+		ModelNumber      string             `json:"modelNumber"`
+		Manufacturer     string             `json:"manufacturer"`
+		LifetimeWarranty bool               `json:"lifetimeWarranty"`
+		WarrantyExpires  time.Time          `json:"warrantyExpires"`
+		WarrantyDetails  string             `json:"warrantyDetails"`
+		PurchaseTime     time.Time          `json:"purchaseTime"`
+		PurchaseFrom     string             `json:"purchaseFrom"`
+		PurchasePrice    float64            `json:"purchasePrice"`
+		SoldTime         time.Time          `json:"soldTime"`
+		SoldTo           string             `json:"soldTo"`
+		SoldPrice        float64            `json:"soldPrice"`
+		SoldNotes        string             `json:"soldNotes"`
+		Fields           []BackupItemField  `json:"fields"`
+		Attachments      []BackupAttachment `json:"attachments"`
+		CreatedAt        time.Time          `json:"createdAt"`
+		UpdatedAt        time.Time          `json:"updatedAt"`
+		DeletedAt        *time.Time         `json:"deletedAt"`
+	}
+
+	BackupItemField struct {
+		Name         string    `json:"name"`
+		Description  string    `json:"description"`
+		Type         string    `json:"type"`
+		TextValue    string    `json:"textValue"`
+		NumberValue  int       `json:"numberValue"`
+		BooleanValue bool      `json:"booleanValue"`
+		TimeValue    time.Time `json:"timeValue"`
+	}
+
+	BackupAttachment struct {
+		DocumentID uuid.UUID `json:"documentId"`
+		Type       string    `json:"type"`
+		Primary    bool      `json:"primary"`
+		CreatedAt  time.Time `json:"createdAt"`
+	}
+
+	// BackupDocument is an attachment file. Path is the path of the document when
+	// exporting, File is the name of the file within the backup archive.
+	BackupDocument struct {
+		ID        uuid.UUID `json:"id"`
+		Title     string    `json:"title"`
+		File      string    `json:"file"`
+		Path      string    `json:"-"`
+		CreatedAt time.Time `json:"createdAt"`
+	}
+
+	BackupMaintenanceSchedule struct {
+		ID               uuid.UUID `json:"id"`
+		ItemID           uuid.UUID `json:"itemId"`
+		Name             string    `json:"name"`
+		Description      string    `json:"description"`
+		Rule             string    `json:"rule"`
+		NotifyDaysBefore int       `json:"notifyDaysBefore"`
+		CreatedAt        time.Time `json:"createdAt"`
+	}
+
+	BackupMaintenanceEntry struct {
+		ID            uuid.UUID  `json:"id"`
+		ItemID        uuid.UUID  `json:"itemId"`
+		ScheduleID    *uuid.UUID `json:"scheduleId"`
+		Name          string     `json:"name"`
+		Description   string     `json:"description"`
+		Date          time.Time  `json:"date"`
+		ScheduledDate time.Time  `json:"scheduledDate"`
+		Cost          float64    `json:"cost"`
+		CreatedAt     time.Time  `json:"createdAt"`
+	}
+
+	// BackupNotifier contains the URL of the notifier, which usually includes credentials
+	// of the notification service. Only the notifiers of the user creating the backup are
+	// exported.
+	BackupNotifier struct {
+		ID        uuid.UUID `json:"id"`
+		UserEmail string    `json:"userEmail"`
+		Name      string    `json:"name"`
+		URL       string    `json:"url"`
+		IsActive  bool      `json:"isActive"`
+	}
+
+	BackupNotificationRule struct {
+		ID          uuid.UUID `json:"id"`
+		Name        string    `json:"name"`
+		Kind        string    `json:"kind"`
+		CustomField string    `json:"customField"`
+		LeadDays    []int     `json:"leadDays"`
+		IsActive    bool      `json:"isActive"`
+	}
+
+	BackupNotificationTemplate struct {
+		Type       string     `json:"type"`
+		NotifierID *uuid.UUID `json:"notifierId"`
+		Body       string     `json:"body"`
+	}
+
+	// BackupNotificationReminder records a reminder that was already sent, restoring
+	// them prevents the reminders from being sent again.
+	BackupNotificationReminder struct {
+		RuleID    uuid.UUID `json:"ruleId"`
+		ItemID    uuid.UUID `json:"itemId"`
+		Target    string    `json:"target"`
+		Title     string    `json:"title"`
+		DueDate   time.Time `json:"dueDate"`
+		LeadDays  int       `json:"leadDays"`
+		CreatedAt time.Time `json:"createdAt"`
+	}
+
+	BackupSavedSearch struct {
+		Name        string           `json:"name"`
+		Description string           `json:"description"`
+		Query       types.SavedQuery `json:"query"`
+		OrderBy     string           `json:"orderBy"`
+	}
+
+	// GroupRestore is the result of a restore. Members of the backup without an account
+	// in the instance are not restored and listed in MissingMembers.
+	GroupRestore struct {
+		Group          Group    `json:"group"`
+		MissingMembers []string `json:"missingMembers"`
+	}
+)
+
+// Export returns the content of the group. Notifiers hold the credentials of the user they
+// belong to, only the notifiers of userID and their templates are exported.
+func (r *BackupRepository) Export(ctx context.Context, GID, userID uuid.UUID) (GroupBackup, error) {
+	g, err := r.db.Group.Get(ctx, GID)
+	if err != nil {
+		return GroupBackup{}, err
+	}
+
+	b := GroupBackup{
+		Group: BackupGroup{
+			Name:       g.Name,
+			Currency:   g.Currency,
+			Timezone:   g.Timezone,
+			NotifyTime: g.NotifyTime,
+			Digest:     g.Digest.String(),
+			CreatedAt:  g.CreatedAt,
+		},
+	}
+
+	members, err := r.db.GroupMembership.Query().
+		Where(groupmembership.GroupID(GID)).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return GroupBackup{}, err
+	}
+
+	b.Members = mapEach(members, func(m *ent.GroupMembership) BackupMember {
+		return BackupMember{
+			Email: m.Edges.User.Email,
+			Name:  m.Edges.User.Name,
+			Role:  m.Role.String(),
+		}
+	})
+
+	labels, err := r.db.Label.Query().
+		Where(label.HasGroupWith(group.ID(GID))).
+		All(ctx)
+	if err != nil {
+		return GroupBackup{}, err
+	}
+
+	b.Labels = mapEach(labels, func(l *ent.Label) BackupLabel {
+		return BackupLabel{
+			ID:          l.ID,
+			Name:        l.Name,
+			Description: l.Description,
+			Color:       l.Color,
+			CreatedAt:   l.CreatedAt,
+			UpdatedAt:   l.UpdatedAt,
+		}
+	})
+
+	locations, err := r.db.Location.Query().
+		Where(location.HasGroupWith(group.ID(GID))).
+		WithParent().
+		All(ctx)
+	if err != nil {
+		return GroupBackup{}, err
+	}
+
+	b.Locations = mapEach(locations, func(l *ent.Location) BackupLocation {
+		out := BackupLocation{
+			ID:          l.ID,
+			Name:        l.Name,
+			Description: l.Description,
+			CreatedAt:   l.CreatedAt,
+			UpdatedAt:   l.UpdatedAt,
+			DeletedAt:   l.DeletedAt,
+		}
+		if l.Edges.Parent != nil {
+			out.ParentID = &l.Edges.Parent.ID
+		}
+		return out
+	})
+
+	items, err := r.db.Item.Query().
+		Where(item.HasGroupWith(group.ID(GID))).
+		WithParent().
+		WithLocation().
+		WithLabel().
+		WithFields().
+		WithAttachments(func(q *ent.AttachmentQuery) {
+			q.WithDocument()
+		}).
+		All(ctx)
+	if err != nil {
+		return GroupBackup{}, err
+	}
+
+	b.Items = mapEach(items, func(it *ent.Item) BackupItem {
+		out := BackupItem{
+			ID:               it.ID,
+			Name:             it.Name,
+			Description:      it.Description,
+			ImportRef:        it.ImportRef,
+			Notes:            it.Notes,
+			Quantity:         it.Quantity,
+			Insured:          it.Insured,
+			Archived:         it.Archived,
+			AssetID:          it.AssetID,
+			SerialNumber:     it.SerialNumber,
+			ModelNumber:      it.ModelNumber,
+			Manufacturer:     it.Manufacturer,
+			LifetimeWarranty: it.LifetimeWarranty,
+			WarrantyExpires:  it.WarrantyExpires,
+			WarrantyDetails:  it.WarrantyDetails,
+			PurchaseTime:     it.PurchaseTime,
+			PurchaseFrom:     it.PurchaseFrom,
+			PurchasePrice:    it.PurchasePrice,
+			SoldTime:         it.SoldTime,
+			SoldTo:           it.SoldTo,
+			SoldPrice:        it.SoldPrice,
+			SoldNotes:        it.SoldNotes,
+			CreatedAt:        it.CreatedAt,
+			UpdatedAt:        it.UpdatedAt,
+			DeletedAt:        it.DeletedAt,
+			LabelIDs: mapEach(it.Edges.Label, func(l *ent.Label) uuid.UUID {
+				return l.ID
+			}),
+			Fields: mapEach(it.Edges.Fields, func(f *ent.ItemField) BackupItemField {
+				return BackupItemField{
+					Name:         f.Name,
+					Description:  f.Description,
+					Type:         f.Type.String(),
+					TextValue:    f.TextValue,
+					NumberValue:  f.NumberValue,
+					BooleanValue: f.BooleanValue,
+					TimeValue:    f.TimeValue,
+				}
+			}),
+			Attachments: mapEach(it.Edges.Attachments, func(a *ent.Attachment) BackupAttachment {
+				return BackupAttachment{
+					DocumentID: a.Edges.Document.ID,
+					Type:       a.Type.String(),
+					Primary:    a.Primary,
+					CreatedAt:  a.CreatedAt,
+				}
+			}),
+		}
+
+		if it.Edges.Parent != nil {
+			out.ParentID = &it.Edges.Parent.ID
+		}
+		if it.Edges.Location != nil {
+			out.LocationID = &it.Edges.Location.ID
+		}
+
+		return out
+	})
+
+	docs, err := r.db.Document.Query().
+		Where(document.HasGroupWith(group.ID(GID))).
+		All(ctx)
+	if err != nil {
+		return GroupBackup{}, err
+	}
+
+	b.Documents = mapEach(docs, func(d *ent.Document) BackupDocument {
+		return BackupDocument{
+			ID:        d.ID,
+			Title:     d.Title,
+			Path:      d.Path,
+			CreatedAt: d.CreatedAt,
+		}
+	})
+
+	schedules, err := r.db.MaintenanceSchedule.Query().
+		Where(maintenanceschedule.HasItemWith(item.HasGroupWith(group.ID(GID)))).
+		All(ctx)
+	if err != nil {
+		return GroupBackup{}, err
+	}
+
+	b.MaintenanceSchedules = mapEach(schedules, func(s *ent.MaintenanceSchedule) BackupMaintenanceSchedule {
+		return BackupMaintenanceSchedule{
+			ID:               s.ID,
+			ItemID:           s.ItemID,
+			Name:             s.Name,
+			Description:      s.Description,
+			Rule:             s.Rule,
+			NotifyDaysBefore: s.NotifyDaysBefore,
+			CreatedAt:        s.CreatedAt,
+		}
+	})
+
+	entries, err := r.db.MaintenanceEntry.Query().
+		Where(maintenanceentry.HasItemWith(item.HasGroupWith(group.ID(GID)))).
+		All(ctx)
+	if err != nil {
+		return GroupBackup{}, err
+	}
+
+	b.MaintenanceEntries = mapEach(entries, func(e *ent.MaintenanceEntry) BackupMaintenanceEntry {
+		return BackupMaintenanceEntry{
+			ID:            e.ID,
+			ItemID:        e.ItemID,
+			ScheduleID:    e.ScheduleID,
+			Name:          e.Name,
+			Description:   e.Description,
+			Date:          e.Date,
+			ScheduledDate: e.ScheduledDate,
+			Cost:          e.Cost,
+			CreatedAt:     e.CreatedAt,
+		}
+	})
+
+	notifiers, err := r.db.Notifier.Query().
+		Where(
+			notifier.GroupID(GID),
+			notifier.UserID(userID),
+		).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return GroupBackup{}, err
+	}
+
+	b.Notifiers = mapEach(notifiers, func(n *ent.Notifier) BackupNotifier {
+		return BackupNotifier{
+			ID:        n.ID,
+			UserEmail: n.Edges.User.Email,
+			Name:      n.Name,
+			URL:       n.URL,
+			IsActive:  n.IsActive,
+		}
+	})
+
+	rules, err := r.db.NotificationRule.Query().
+		Where(notificationrule.GroupID(GID)).
+		All(ctx)
+	if err != nil {
+		return GroupBackup{}, err
+	}
+
+	b.NotificationRules = mapEach(rules, func(n *ent.NotificationRule) BackupNotificationRule {
+		return BackupNotificationRule{
+			ID:          n.ID,
+			Name:        n.Name,
+			Kind:        n.Kind.String(),
+			CustomField: n.CustomField,
+			LeadDays:    n.LeadDays,
+			IsActive:    n.IsActive,
+		}
+	})
+
+	templates, err := r.db.NotificationTemplate.Query().
+		Where(
+			notificationtemplate.GroupID(GID),
+			notificationtemplate.Or(
+				notificationtemplate.NotifierIDIsNil(),
+				notificationtemplate.HasNotifierWith(notifier.UserID(userID)),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return GroupBackup{}, err
+	}
+
+	b.NotificationTemplates = mapEach(templates, func(n *ent.NotificationTemplate) BackupNotificationTemplate {
+		return BackupNotificationTemplate{
+			Type:       n.Type.String(),
+			NotifierID: n.NotifierID,
+			Body:       n.Body,
+		}
+	})
+
+	reminders, err := r.db.NotificationReminder.Query().
+		Where(notificationreminder.GroupID(GID)).
+		All(ctx)
+	if err != nil {
+		return GroupBackup{}, err
+	}
+
+	b.NotificationReminders = mapEach(reminders, func(n *ent.NotificationReminder) BackupNotificationReminder {
+		return BackupNotificationReminder{
+			RuleID:    n.RuleID,
+			ItemID:    n.ItemID,
+			Target:    n.Target,
+			Title:     n.Title,
+			DueDate:   n.DueDate,
+			LeadDays:  n.LeadDays,
+			CreatedAt: n.CreatedAt,
+		}
+	})
+
+	searches, err := r.db.SavedSearch.Query().
+		Where(savedsearch.HasGroupWith(group.ID(GID))).
+		All(ctx)
+	if err != nil {
+		return GroupBackup{}, err
+	}
+
+	b.SavedSearches = mapEach(searches, func(s *ent.SavedSearch) BackupSavedSearch {
+		return BackupSavedSearch{
+			Name:        s.Name,
+			Description: s.Description,
+			Query:       s.Query,
+			OrderBy:     s.OrderBy.String(),
+		}
+	})
+
+	return b, nil
+}
+
+// idMap replaces the IDs of a backup with new IDs. IDs that are not part of the backup
+// are mapped to uuid.Nil.
+type idMap map[uuid.UUID]uuid.UUID
+
+func (m idMap) add(old uuid.UUID) uuid.UUID {
+	id := uuid.New()
+	m[old] = id
+	return id
+}
+
+func (m idMap) ptr(old *uuid.UUID) *uuid.UUID {
+	if old == nil {
+		return nil
+	}
+
+	id, ok := m[*old]
+	if !ok {
+		return nil
+	}
+
+	return &id
+}
+
+func (m idMap) each(old []uuid.UUID) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(old))
+	for _, o := range old {
+		if id, ok := m[o]; ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// Restore creates a new group from the backup with new IDs, the owner becomes the owner
+// of the group. Members are added when an account with their email address exists,
+// notifiers of other users belong to the owner. The content of a document file is read
+// with open, the files are removed again when the restore fails.
+func (r *BackupRepository) Restore(ctx context.Context, b GroupBackup, ownerID uuid.UUID, open func(file string) (io.ReadCloser, error)) (out GroupRestore, err error) {
+	var written []string
+	defer func() {
+		if err != nil {
+			for _, key := range written {
+				_ = r.docs.RemoveFile(ctx, key)
+			}
+		}
+	}()
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return GroupRestore{}, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	g, err := tx.Group.Create().
+		SetName(b.Group.Name).
+		SetCurrency(b.Group.Currency).
+		SetTimezone(b.Group.Timezone).
+		SetNotifyTime(b.Group.NotifyTime).
+		SetDigest(group.Digest(b.Group.Digest)).
+		SetCreatedAt(b.Group.CreatedAt).
+		Save(ctx)
+	if err != nil {
+		return GroupRestore{}, err
+	}
+
+	err = tx.GroupMembership.Create().
+		SetUserID(ownerID).
+		SetGroupID(g.ID).
+		SetRole(groupmembership.RoleOwner).
+		Exec(ctx)
+	if err != nil {
+		return GroupRestore{}, err
+	}
+
+	// users maps the email addresses of the members to their accounts.
+	users := map[string]uuid.UUID{}
+	for _, m := range b.Members {
+		usr, err := tx.User.Query().
+			Where(user.EmailEqualFold(m.Email)).
+			Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			out.MissingMembers = append(out.MissingMembers, m.Email)
+			continue
+		case err != nil:
+			return GroupRestore{}, err
+		}
+
+		users[m.Email] = usr.ID
+		if usr.ID == ownerID {
+			continue
+		}
+
+		err = tx.GroupMembership.Create().
+			SetUserID(usr.ID).
+			SetGroupID(g.ID).
+			SetRole(groupmembership.Role(m.Role)).
+			Exec(ctx)
+		if err != nil {
+			return GroupRestore{}, err
+		}
+	}
+
+	ids := idMap{}
+
+	for _, l := range b.Labels {
+		err = tx.Label.Create().
+			SetID(ids.add(l.ID)).
+			SetGroupID(g.ID).
+			SetName(l.Name).
+			SetDescription(l.Description).
+			SetColor(l.Color).
+			SetCreatedAt(l.CreatedAt).
+			SetUpdatedAt(l.UpdatedAt).
+			Exec(ctx)
+		if err != nil {
+			return GroupRestore{}, err
+		}
+	}
+
+	// Locations and items are created before their parents are set, a parent may come
+	// after its children in the backup.
+	for _, l := range b.Locations {
+		err = tx.Location.Create().
+			SetID(ids.add(l.ID)).
+			SetGroupID(g.ID).
+			SetName(l.Name).
+			SetDescription(l.Description).
+			SetCreatedAt(l.CreatedAt).
+			SetUpdatedAt(l.UpdatedAt).
+			SetNillableDeletedAt(l.DeletedAt).
+			Exec(ctx)
+		if err != nil {
+			return GroupRestore{}, err
+		}
+	}
+
+	for _, l := range b.Locations {
+		if parent := ids.ptr(l.ParentID); parent != nil {
+			err = tx.Location.UpdateOneID(ids[l.ID]).
+				SetParentID(*parent).
+				SetUpdatedAt(l.UpdatedAt).
+				Exec(ctx)
+			if err != nil {
+				return GroupRestore{}, err
+			}
+		}
+	}
+
+	for _, d := range b.Documents {
+		key, err := r.restoreFile(ctx, g.ID, d, open)
+		if err != nil {
+			return GroupRestore{}, err
+		}
+		written = append(written, key)
+
+		err = tx.Document.Create().
+			SetID(ids.add(d.ID)).
+			SetGroupID(g.ID).
+			SetTitle(d.Title).
+			SetPath(key).
+			SetCreatedAt(d.CreatedAt).
+			Exec(ctx)
+		if err != nil {
+			return GroupRestore{}, err
+		}
+	}
+
+	for _, it := range b.Items {
+		err = tx.Item.Create().
+			SetID(ids.add(it.ID)).
+			SetGroupID(g.ID).
+			SetNillableLocationID(ids.ptr(it.LocationID)).
+			AddLabelIDs(ids.each(it.LabelIDs)...).
+			SetName(it.Name).
+			SetDescription(it.Description).
+			SetImportRef(it.ImportRef).
+			SetNotes(it.Notes).
+			SetQuantity(it.Quantity).
+			SetInsured(it.Insured).
+			SetArchived(it.Archived).
+			SetAssetID(it.AssetID).
+			SetSerialNumber(it.SerialNumber).
+			SetModelNumber(it.ModelNumber).
+			SetManufacturer(it.Manufacturer).
+			SetLifetimeWarranty(it.LifetimeWarranty).
+			SetWarrantyExpires(it.WarrantyExpires).
+			SetWarrantyDetails(it.WarrantyDetails).
+			SetPurchaseTime(it.PurchaseTime).
+			SetPurchaseFrom(it.PurchaseFrom).
+			SetPurchasePrice(it.PurchasePrice).
+			SetSoldTime(it.SoldTime).
+			SetSoldTo(it.SoldTo).
+			SetSoldPrice(it.SoldPrice).
+			SetSoldNotes(it.SoldNotes).
+			SetCreatedAt(it.CreatedAt).
+			SetUpdatedAt(it.UpdatedAt).
+			SetNillableDeletedAt(it.DeletedAt).
+			Exec(ctx)
+		if err != nil {
+			return GroupRestore{}, err
+		}
+
+		for _, f := range it.Fields {
+			err = tx.ItemField.Create().
+				SetItemID(ids[it.ID]).
+				SetName(f.Name).
+				SetDescription(f.Description).
+				SetType(itemfield.Type(f.Type)).
+				SetTextValue(f.TextValue).
+				SetNumberValue(f.NumberValue).
+				SetBooleanValue(f.BooleanValue).
+				SetTimeValue(f.TimeValue).
+				Exec(ctx)
+			if err != nil {
+				return GroupRestore{}, err
+			}
+		}
+
+		for _, a := range it.Attachments {
+			docID, ok := ids[a.DocumentID]
+			if !ok {
+				continue
+			}
+
+			err = tx.Attachment.Create().
+				SetItemID(ids[it.ID]).
+				SetDocumentID(docID).
+				SetType(attachment.Type(a.Type)).
+				SetPrimary(a.Primary).
+				SetCreatedAt(a.CreatedAt).
+				Exec(ctx)
+			if err != nil {
+				return GroupRestore{}, err
+			}
+		}
+	}
+
+	for _, it := range b.Items {
+		if parent := ids.ptr(it.ParentID); parent != nil {
+			err = tx.Item.UpdateOneID(ids[it.ID]).
+				SetParentID(*parent).
+				SetUpdatedAt(it.UpdatedAt).
+				Exec(ctx)
+			if err != nil {
+				return GroupRestore{}, err
+			}
+		}
+	}
+
+	for _, s := range b.MaintenanceSchedules {
+		itemID, ok := ids[s.ItemID]
+		if !ok {
+			continue
+		}
+
+		err = tx.MaintenanceSchedule.Create().
+			SetID(ids.add(s.ID)).
+			SetItemID(itemID).
+			SetName(s.Name).
+			SetDescription(s.Description).
+			SetRule(s.Rule).
+			SetNotifyDaysBefore(s.NotifyDaysBefore).
+			SetCreatedAt(s.CreatedAt).
+			Exec(ctx)
+		if err != nil {
+			return GroupRestore{}, err
+		}
+	}
+
+	for _, e := range b.MaintenanceEntries {
+		itemID, ok := ids[e.ItemID]
+		if !ok {
+			continue
+		}
+
+		err = tx.MaintenanceEntry.Create().
+			SetID(ids.add(e.ID)).
+			SetItemID(itemID).
+			SetNillableScheduleID(ids.ptr(e.ScheduleID)).
+			SetName(e.Name).
+			SetDescription(e.Description).
+			SetDate(e.Date).
+			SetScheduledDate(e.ScheduledDate).
+			SetCost(e.Cost).
+			SetCreatedAt(e.CreatedAt).
+			Exec(ctx)
+		if err != nil {
+			return GroupRestore{}, err
+		}
+	}
+
+	for _, n := range b.Notifiers {
+		userID, ok := users[n.UserEmail]
+		if !ok {
+			userID = ownerID
+		}
+
+		err = tx.Notifier.Create().
+			SetID(ids.add(n.ID)).
+			SetGroupID(g.ID).
+			SetUserID(userID).
+			SetName(n.Name).
+			SetURL(n.URL).
+			SetIsActive(n.IsActive).
+			Exec(ctx)
+		if err != nil {
+			return GroupRestore{}, err
+		}
+	}
+
+	for _, n := range b.NotificationRules {
+		err = tx.NotificationRule.Create().
+			SetID(ids.add(n.ID)).
+			SetGroupID(g.ID).
+			SetName(n.Name).
+			SetKind(notificationrule.Kind(n.Kind)).
+			SetCustomField(n.CustomField).
+			SetLeadDays(n.LeadDays).
+			SetIsActive(n.IsActive).
+			Exec(ctx)
+		if err != nil {
+			return GroupRestore{}, err
+		}
+	}
+
+	for _, n := range b.NotificationTemplates {
+		// Templates of notifiers that are not part of the backup are skipped, they
+		// would otherwise replace the template of the group.
+		notifierID := ids.ptr(n.NotifierID)
+		if n.NotifierID != nil && notifierID == nil {
+			continue
+		}
+
+		err = tx.NotificationTemplate.Create().
+			SetGroupID(g.ID).
+			SetType(notificationtemplate.Type(n.Type)).
+			SetNillableNotifierID(notifierID).
+			SetBody(n.Body).
+			Exec(ctx)
+		if err != nil {
+			return GroupRestore{}, err
+		}
+	}
+
+	for _, n := range b.NotificationReminders {
+		ruleID, ok := ids[n.RuleID]
+		if !ok {
+			continue
+		}
+
+		// The target of a maintenance reminder is the ID of the maintenance entry.
+		target := n.Target
+		if id, err := uuid.Parse(target); err == nil {
+			if mapped, ok := ids[id]; ok {
+				target = mapped.String()
+			}
+		}
+
+		err = tx.NotificationReminder.Create().
+			SetGroupID(g.ID).
+			SetRuleID(ruleID).
+			SetItemID(ids[n.ItemID]).
+			SetTarget(target).
+			SetTitle(n.Title).
+			SetDueDate(n.DueDate).
+			SetLeadDays(n.LeadDays).
+			SetCreatedAt(n.CreatedAt).
+			Exec(ctx)
+		if err != nil {
+			return GroupRestore{}, err
+		}
+	}
+
+	for _, s := range b.SavedSearches {
+		q := s.Query
+		q.LocationIDs = ids.each(q.LocationIDs)
+		q.LabelIDs = ids.each(q.LabelIDs)
+		q.ParentIDs = ids.each(q.ParentIDs)
+
+		err = tx.SavedSearch.Create().
+			SetGroupID(g.ID).
+			SetName(s.Name).
+			SetDescription(s.Description).
+			SetQuery(q).
+			SetOrderBy(savedsearch.OrderBy(s.OrderBy)).
+			Exec(ctx)
+		if err != nil {
+			return GroupRestore{}, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return GroupRestore{}, err
+	}
+
+	err = r.search.indexWhere(ctx, item.HasGroupWith(group.ID(g.ID)))
+	if err != nil {
+		return GroupRestore{}, err
+	}
+
+	out.Group = NewGroupRepository(r.db).groupMapper.Map(g)
+	return out, nil
+}
+
+func (r *BackupRepository) restoreFile(ctx context.Context, GID uuid.UUID, d BackupDocument, open func(file string) (io.ReadCloser, error)) (string, error) {
+	f, err := open(d.File)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+
+	return r.docs.write(ctx, GID, filepath.Ext(d.Title), f)
+}
@@ -4,21 +4,29 @@ import (
 	"context"
 	"errors"
 	"io"
-	"os"
+	"path"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/document"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
-	"github.com/hay-kot/homebox/backend/pkgs/pathlib"
+	"github.com/hay-kot/homebox/backend/pkgs/blobstore"
 )
 
 var ErrInvalidDocExtension = errors.New("invalid document extension")
 
+// DocumentRepository stores the document files in a blob store, the path of a document
+// is its key in the blob store.
 type DocumentRepository struct {
 	db  *ent.Client
 	dir string
+	// blobs stores the document files, presign is how long presigned download URLs are
+	// valid. Files are streamed when presign is 0 or the store can not presign URLs.
+	blobs   blobstore.Store
+	presign time.Duration
 }
 
 type (
@@ -47,8 +55,15 @@ var (
 	mapDocumentOutEachErr = mapTEachErrFunc(mapDocumentOut)
 )
 
-func (r *DocumentRepository) path(gid uuid.UUID, ext string) string {
-	return pathlib.Safe(filepath.Join(r.dir, gid.String(), "documents", uuid.NewString()+ext))
+// key returns the key of the document file in the blob store. Documents created before
+// the blob store hold the path of the file on disk, the key is the path relative to the
+// storage directory.
+func (r *DocumentRepository) key(docPath string) string {
+	if rel, err := filepath.Rel(r.dir, docPath); err == nil && !strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(rel)
+	}
+
+	return docPath
 }
 
 func (r *DocumentRepository) GetAll(ctx context.Context, gid uuid.UUID) ([]DocumentOut, error) {
@@ -63,26 +78,95 @@ func (r *DocumentRepository) Get(ctx context.Context, id uuid.UUID) (DocumentOut
 	return mapDocumentOutErr(r.db.Document.Get(ctx, id))
 }
 
-func (r *DocumentRepository) Create(ctx context.Context, gid uuid.UUID, doc DocumentCreate) (DocumentOut, error) {
-	ext := filepath.Ext(doc.Title)
-	if ext == "" {
-		return DocumentOut{}, ErrInvalidDocExtension
+// write stores the content in a new file of the group and returns its key.
+func (r *DocumentRepository) write(ctx context.Context, gid uuid.UUID, ext string, content io.Reader) (string, error) {
+	key := path.Join(gid.String(), "documents", uuid.NewString()+ext)
+	return key, r.blobs.Put(ctx, key, content)
+}
+
+// Open returns the content of the document file with the path, the caller has to close
+// it. The reader implements io.ReadSeeker when the file is stored on disk.
+func (r *DocumentRepository) Open(ctx context.Context, docPath string) (io.ReadCloser, error) {
+	return r.blobs.Get(ctx, r.key(docPath))
+}
+
+// DownloadURL returns a presigned URL to download the document file with the path. An
+// empty URL is returned when the files are not downloaded from the blob store directly.
+func (r *DocumentRepository) DownloadURL(ctx context.Context, docPath string) (string, error) {
+	p, ok := r.blobs.(blobstore.Presigner)
+	if !ok || r.presign <= 0 {
+		return "", nil
 	}
 
-	path := r.path(gid, ext)
+	return p.PresignGet(ctx, r.key(docPath), r.presign)
+}
+
+// RemoveFile removes the document file with the path, the document is not changed.
+func (r *DocumentRepository) RemoveFile(ctx context.Context, docPath string) error {
+	return r.blobs.Delete(ctx, r.key(docPath))
+}
+
+// DocumentCopyResult is the result of copying the document files to another blob store,
+// Missing holds the keys of documents without a file.
+type DocumentCopyResult struct {
+	Copied  int      `json:"copied"`
+	Missing []string `json:"missing"`
+}
 
-	parent := filepath.Dir(path)
-	err := os.MkdirAll(parent, 0o755)
+// CopyAll copies the files of all documents to the blob store dst. Documents without a
+// file are skipped. Paths of documents created before the blob store are replaced with
+// their keys.
+func (r *DocumentRepository) CopyAll(ctx context.Context, dst blobstore.Store) (DocumentCopyResult, error) {
+	docs, err := r.db.Document.Query().
+		Order(ent.Asc(document.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
-		return DocumentOut{}, err
+		return DocumentCopyResult{}, err
+	}
+
+	var out DocumentCopyResult
+	for _, doc := range docs {
+		key := r.key(doc.Path)
+
+		err = r.copyFile(ctx, dst, key)
+		switch {
+		case errors.Is(err, blobstore.ErrNotFound):
+			out.Missing = append(out.Missing, key)
+			continue
+		case err != nil:
+			return out, err
+		}
+
+		if key != doc.Path {
+			err = r.db.Document.UpdateOneID(doc.ID).SetPath(key).Exec(ctx)
+			if err != nil {
+				return out, err
+			}
+		}
+
+		out.Copied++
 	}
 
-	f, err := os.Create(path)
+	return out, nil
+}
+
+func (r *DocumentRepository) copyFile(ctx context.Context, dst blobstore.Store, key string) error {
+	rc, err := r.blobs.Get(ctx, key)
 	if err != nil {
-		return DocumentOut{}, err
+		return err
+	}
+	defer func() { _ = rc.Close() }()
+
+	return dst.Put(ctx, key, rc)
+}
+
+func (r *DocumentRepository) Create(ctx context.Context, gid uuid.UUID, doc DocumentCreate) (DocumentOut, error) {
+	ext := filepath.Ext(doc.Title)
+	if ext == "" {
+		return DocumentOut{}, ErrInvalidDocExtension
 	}
 
-	_, err = io.Copy(f, doc.Content)
+	key, err := r.write(ctx, gid, ext, doc.Content)
 	if err != nil {
 		return DocumentOut{}, err
 	}
@@ -90,7 +174,7 @@ func (r *DocumentRepository) Create(ctx context.Context, gid uuid.UUID, doc Docu
 	return mapDocumentOutErr(r.db.Document.Create().
 		SetGroupID(gid).
 		SetTitle(doc.Title).
-		SetPath(path).
+		SetPath(key).
 		Save(ctx),
 	)
 }
@@ -107,7 +191,7 @@ func (r *DocumentRepository) Delete(ctx context.Context, id uuid.UUID) error {
 		return err
 	}
 
-	err = os.Remove(doc.Path)
+	err = r.RemoveFile(ctx, doc.Path)
 	if err != nil {
 		return err
 	}
@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"path"
 	"path/filepath"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/pkgs/blobstore"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -47,8 +50,9 @@ func useDocs(t *testing.T, num int) []DocumentOut {
 func TestDocumentRepository_CreateUpdateDelete(t *testing.T) {
 	temp := t.TempDir()
 	r := DocumentRepository{
-		db:  tClient,
-		dir: temp,
+		db:    tClient,
+		dir:   temp,
+		blobs: blobstore.NewLocal(temp),
 	}
 
 	type args struct {
@@ -83,11 +87,11 @@ func TestDocumentRepository_CreateUpdateDelete(t *testing.T) {
 			got, err := r.Create(tt.args.ctx, tt.args.gid, tt.args.doc)
 			require.NoError(t, err)
 			assert.Equal(t, tt.title, got.Title)
-			assert.Equal(t, fmt.Sprintf("%s/%s/documents", temp, tt.args.gid), filepath.Dir(got.Path))
+			assert.Equal(t, fmt.Sprintf("%s/documents", tt.args.gid), path.Dir(got.Path))
 
 			ensureRead := func() {
 				// Read Document
-				bts, err := os.ReadFile(got.Path)
+				bts, err := os.ReadFile(filepath.Join(temp, got.Path))
 				require.NoError(t, err)
 				assert.Equal(t, tt.content, string(bts))
 			}
@@ -104,8 +108,50 @@ func TestDocumentRepository_CreateUpdateDelete(t *testing.T) {
 			err = r.Delete(tt.args.ctx, got.ID)
 			require.NoError(t, err)
 
-			_, err = os.Stat(got.Path)
+			_, err = os.Stat(filepath.Join(temp, got.Path))
 			require.Error(t, err)
 		})
 	}
 }
+
+func TestDocumentRepository_CopyAll(t *testing.T) {
+	temp := t.TempDir()
+	r := DocumentRepository{
+		db:    tClient,
+		dir:   temp,
+		blobs: blobstore.NewLocal(temp),
+	}
+
+	doc, err := r.Create(context.Background(), tGroup.ID, DocumentCreate{
+		Title:   "manual.txt",
+		Content: bytes.NewReader([]byte("manual")),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = r.Delete(context.Background(), doc.ID)
+	})
+
+	// Documents created before the blob store hold the path of the file on disk.
+	key := doc.Path
+	err = tClient.Document.UpdateOneID(doc.ID).SetPath(filepath.Join(temp, key)).Exec(context.Background())
+	require.NoError(t, err)
+
+	dst := blobstore.NewLocal(t.TempDir())
+
+	out, err := r.CopyAll(context.Background(), dst)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, out.Copied, 1)
+	assert.NotContains(t, out.Missing, key)
+
+	got, err := r.Get(context.Background(), doc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, key, got.Path)
+
+	rc, err := dst.Get(context.Background(), key)
+	require.NoError(t, err)
+	defer func() { _ = rc.Close() }()
+
+	bts, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "manual", string(bts))
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
 )
 
 type GroupRepository struct {
@@ -23,13 +24,22 @@ type GroupRepository struct {
 
 func NewGroupRepository(db *ent.Client) *GroupRepository {
 	gmap := func(g *ent.Group) Group {
-		return Group{
-			ID:        g.ID,
-			Name:      g.Name,
-			CreatedAt: g.CreatedAt,
-			UpdatedAt: g.UpdatedAt,
-			Currency:  strings.ToUpper(g.Currency),
+		out := Group{
+			ID:         g.ID,
+			Name:       g.Name,
+			CreatedAt:  g.CreatedAt,
+			UpdatedAt:  g.UpdatedAt,
+			Currency:   strings.ToUpper(g.Currency),
+			Timezone:   g.Timezone,
+			NotifyTime: g.NotifyTime,
+			Digest:     g.Digest.String(),
 		}
+
+		if g.NotifiedOn != nil {
+			out.NotifiedOn = types.DateFromTime(*g.NotifiedOn)
+		}
+
+		return out
 	}
 
 	imap := func(i *ent.GroupInvitationToken) GroupInvitation {
@@ -55,11 +65,21 @@ type (
 		CreatedAt time.Time `json:"createdAt,omitempty"`
 		UpdatedAt time.Time `json:"updatedAt,omitempty"`
 		Currency  string    `json:"currency,omitempty"`
+
+		Timezone   string     `json:"timezone"`
+		NotifyTime string     `json:"notifyTime"`
+		Digest     string     `json:"digest"`
+		NotifiedOn types.Date `json:"-"`
 	}
 
 	GroupUpdate struct {
 		Name     string `json:"name"`
 		Currency string `json:"currency"`
+
+		// Timezone, NotifyTime and Digest are left unchanged when omitted.
+		Timezone   *string `json:"timezone"   validate:"omitempty,timezone"                extensions:"x-nullable"`
+		NotifyTime *string `json:"notifyTime" validate:"omitempty,datetime=15:04"          extensions:"x-nullable"`
+		Digest     *string `json:"digest"     validate:"omitempty,oneof=none daily weekly" extensions:"x-nullable"`
 	}
 
 	GroupInvitationCreate struct {
@@ -84,6 +104,18 @@ type (
 		TotalWithWarranty int     `json:"totalWithWarranty"`
 	}
 
+	// GroupUsage summarizes the size of a group for the administration of the instance.
+	GroupUsage struct {
+		ID               uuid.UUID `json:"id"`
+		Name             string    `json:"name"`
+		CreatedAt        time.Time `json:"createdAt"`
+		TotalUsers       int       `json:"totalUsers"`
+		TotalItems       int       `json:"totalItems"`
+		TotalLocations   int       `json:"totalLocations"`
+		TotalLabels      int       `json:"totalLabels"`
+		TotalAttachments int       `json:"totalAttachments"`
+	}
+
 	ValueOverTimeEntry struct {
 		Date  time.Time `json:"date"`
 		Value float64   `json:"value"`
@@ -105,21 +137,86 @@ type (
 	}
 )
 
+// Location returns the timezone of the group, falling back to the server timezone when
+// none is set or it is unknown.
+func (g Group) Location() *time.Location {
+	if g.Timezone == "" {
+		return time.Local
+	}
+
+	loc, err := time.LoadLocation(g.Timezone)
+	if err != nil {
+		return time.Local
+	}
+
+	return loc
+}
+
+// groupLocation returns the timezone of the group, see Group.Location.
+func groupLocation(g *ent.Group) *time.Location {
+	if g == nil {
+		return time.Local
+	}
+	return Group{Timezone: g.Timezone}.Location()
+}
+
 func (r *GroupRepository) GetAllGroups(ctx context.Context) ([]Group, error) {
 	return r.groupMapper.MapEachErr(r.db.Group.Query().All(ctx))
 }
 
+// GetAllUsage returns the usage of all groups of the instance ordered by name. Items
+// and locations in the trash are not counted.
+func (r *GroupRepository) GetAllUsage(ctx context.Context) ([]GroupUsage, error) {
+	q := `
+		SELECT
+			groups.id,
+			groups.name,
+			groups.created_at,
+			(SELECT COUNT(*) FROM group_memberships WHERE group_id = groups.id) AS total_users,
+			(SELECT COUNT(*) FROM items WHERE group_items = groups.id AND items.deleted_at IS NULL) AS total_items,
+			(SELECT COUNT(*) FROM locations WHERE group_locations = groups.id AND locations.deleted_at IS NULL) AS total_locations,
+			(SELECT COUNT(*) FROM labels WHERE group_labels = groups.id) AS total_labels,
+			(SELECT COUNT(*) FROM documents WHERE group_documents = groups.id) AS total_attachments
+		FROM
+			groups
+		ORDER BY
+			groups.name ASC
+`
+
+	rows, err := r.db.Sql().QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	list := []GroupUsage{}
+	for rows.Next() {
+		var g GroupUsage
+
+		err := rows.Scan(&g.ID, &g.Name, &g.CreatedAt, &g.TotalUsers, &g.TotalItems, &g.TotalLocations, &g.TotalLabels, &g.TotalAttachments)
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, g)
+	}
+
+	return list, rows.Err()
+}
+
 func (r *GroupRepository) StatsLocationsByPurchasePrice(ctx context.Context, GID uuid.UUID) ([]TotalsByOrganizer, error) {
 	var v []TotalsByOrganizer
 
 	err := r.db.Location.Query().
 		Where(
 			location.HasGroupWith(group.ID(GID)),
+			location.DeletedAtIsNil(),
 		).
 		GroupBy(location.FieldID, location.FieldName).
 		Aggregate(func(sq *sql.Selector) string {
 			t := sql.Table(item.Table)
 			sq.Join(t).On(sq.C(location.FieldID), t.C(item.LocationColumn))
+			sq.Where(sql.IsNull(t.C(item.FieldDeletedAt)))
 
 			return sql.As(sql.Sum(t.C(item.FieldPurchasePrice)), "total")
 		}).
@@ -146,6 +243,7 @@ func (r *GroupRepository) StatsLabelsByPurchasePrice(ctx context.Context, GID uu
 
 			sq.Join(jt).On(sq.C(label.FieldID), jt.C(label.ItemsPrimaryKey[0]))
 			sq.Join(itemTable).On(jt.C(label.ItemsPrimaryKey[1]), itemTable.C(item.FieldID))
+			sq.Where(sql.IsNull(itemTable.C(item.FieldDeletedAt)))
 
 			return sql.As(sql.Sum(itemTable.C(item.FieldPurchasePrice)), "total")
 		}).
@@ -165,11 +263,13 @@ func (r *GroupRepository) StatsPurchasePrice(ctx context.Context, GID uuid.UUID,
 			FROM   items
 			WHERE  group_items = ?
 				AND items.archived = false
+				AND items.deleted_at IS NULL
 				AND items.created_at < ?) AS price_at_start,
 		(SELECT Sum(purchase_price)
 			FROM   items
 			WHERE  group_items = ?
 				AND items.archived = false
+				AND items.deleted_at IS NULL
 				AND items.created_at < ?) AS price_at_end
 `
 	stats := ValueOverTime{
@@ -180,7 +280,8 @@ func (r *GroupRepository) StatsPurchasePrice(ctx context.Context, GID uuid.UUID,
 	var maybeStart *float64
 	var maybeEnd *float64
 
-	row := r.db.Sql().QueryRowContext(ctx, q, GID, sqliteDateFormat(start), GID, sqliteDateFormat(end))
+	d := r.db.Dialect()
+	row := r.db.Sql().QueryRowContext(ctx, rebind(d, q), GID, dateParam(d, start), GID, dateParam(d, end))
 	err := row.Scan(&maybeStart, &maybeEnd)
 	if err != nil {
 		return nil, err
@@ -202,6 +303,7 @@ func (r *GroupRepository) StatsPurchasePrice(ctx context.Context, GID uuid.UUID,
 			item.CreatedAtGTE(start),
 			item.CreatedAtLTE(end),
 			item.Archived(false),
+			item.DeletedAtIsNil(),
 		).
 		Select(
 			item.FieldName,
@@ -229,20 +331,21 @@ func (r *GroupRepository) StatsPurchasePrice(ctx context.Context, GID uuid.UUID,
 func (r *GroupRepository) StatsGroup(ctx context.Context, GID uuid.UUID) (GroupStatistics, error) {
 	q := `
 		SELECT
-			(SELECT COUNT(*) FROM users WHERE group_users = ?) AS total_users,
-			(SELECT COUNT(*) FROM items WHERE group_items = ? AND items.archived = false) AS total_items,
-			(SELECT COUNT(*) FROM locations WHERE group_locations = ?) AS total_locations,
+			(SELECT COUNT(*) FROM group_memberships WHERE group_id = ?) AS total_users,
+			(SELECT COUNT(*) FROM items WHERE group_items = ? AND items.archived = false AND items.deleted_at IS NULL) AS total_items,
+			(SELECT COUNT(*) FROM locations WHERE group_locations = ? AND locations.deleted_at IS NULL) AS total_locations,
 			(SELECT COUNT(*) FROM labels WHERE group_labels = ?) AS total_labels,
-			(SELECT SUM(purchase_price*quantity) FROM items WHERE group_items = ? AND items.archived = false) AS total_item_price,
+			(SELECT SUM(purchase_price*quantity) FROM items WHERE group_items = ? AND items.archived = false AND items.deleted_at IS NULL) AS total_item_price,
 			(SELECT COUNT(*)
 				FROM items
 					WHERE group_items = ?
 					AND items.archived = false
-					AND (items.lifetime_warranty = true OR items.warranty_expires > date())
+					AND items.deleted_at IS NULL
+					AND (items.lifetime_warranty = true OR items.warranty_expires > CURRENT_DATE)
 				) AS total_with_warranty
 `
 	var stats GroupStatistics
-	row := r.db.Sql().QueryRowContext(ctx, q, GID, GID, GID, GID, GID, GID)
+	row := r.db.Sql().QueryRowContext(ctx, rebind(r.db.Dialect(), q), GID, GID, GID, GID, GID, GID)
 
 	var maybeTotalItemPrice *float64
 	var maybeTotalWithWarranty *int
@@ -265,14 +368,34 @@ func (r *GroupRepository) GroupCreate(ctx context.Context, name string) (Group,
 }
 
 func (r *GroupRepository) GroupUpdate(ctx context.Context, ID uuid.UUID, data GroupUpdate) (Group, error) {
-	entity, err := r.db.Group.UpdateOneID(ID).
+	q := r.db.Group.UpdateOneID(ID).
 		SetName(data.Name).
-		SetCurrency(strings.ToLower(data.Currency)).
-		Save(ctx)
+		SetCurrency(strings.ToLower(data.Currency))
+
+	if data.Timezone != nil {
+		q.SetTimezone(*data.Timezone)
+	}
+
+	if data.NotifyTime != nil {
+		q.SetNotifyTime(*data.NotifyTime)
+	}
+
+	if data.Digest != nil {
+		q.SetDigest(group.Digest(*data.Digest))
+	}
+
+	entity, err := q.Save(ctx)
 
 	return r.groupMapper.MapErr(entity, err)
 }
 
+// GroupNotified records the local date the notifications of the group were sent on.
+func (r *GroupRepository) GroupNotified(ctx context.Context, ID uuid.UUID, day types.Date) error {
+	return r.db.Group.UpdateOneID(ID).
+		SetNotifiedOn(day.Time()).
+		Exec(ctx)
+}
+
 func (r *GroupRepository) GroupByID(ctx context.Context, id uuid.UUID) (Group, error) {
 	return r.groupMapper.MapErr(r.db.Group.Get(ctx, id))
 }
@@ -298,6 +421,39 @@ func (r *GroupRepository) InvitationCreate(ctx context.Context, groupID uuid.UUI
 	return r.InvitationGet(ctx, entity.Token)
 }
 
+// InvitationGetAll returns the invitations of the group that can still be used, ordered
+// by their expiry.
+func (r *GroupRepository) InvitationGetAll(ctx context.Context, GID uuid.UUID) ([]GroupInvitation, error) {
+	return r.invitationMapper.MapEachErr(r.db.GroupInvitationToken.Query().
+		Where(
+			groupinvitationtoken.HasGroupWith(group.ID(GID)),
+			groupinvitationtoken.ExpiresAtGTE(time.Now()),
+			groupinvitationtoken.UsesGT(0),
+		).
+		WithGroup().
+		Order(ent.Asc(groupinvitationtoken.FieldExpiresAt)).
+		All(ctx))
+}
+
+// InvitationDelete revokes an invitation of the group.
+func (r *GroupRepository) InvitationDelete(ctx context.Context, GID, ID uuid.UUID) error {
+	n, err := r.db.GroupInvitationToken.Delete().
+		Where(
+			groupinvitationtoken.ID(ID),
+			groupinvitationtoken.HasGroupWith(group.ID(GID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return ent.NewNotFoundError(groupinvitationtoken.Label)
+	}
+
+	return nil
+}
+
 func (r *GroupRepository) InvitationUpdate(ctx context.Context, id uuid.UUID, uses int) error {
 	_, err := r.db.GroupInvitationToken.UpdateOneID(id).SetUses(uses).Save(ctx)
 	return err
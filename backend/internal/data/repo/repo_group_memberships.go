@@ -0,0 +1,207 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
+)
+
+// GroupMembershipRepository stores the groups users belong to and their role within
+// each of them.
+type GroupMembershipRepository struct {
+	db *ent.Client
+}
+
+type (
+	GroupMembershipOut struct {
+		GroupID   uuid.UUID `json:"groupId"`
+		GroupName string    `json:"groupName"`
+		Role      string    `json:"role"`
+		CreatedAt time.Time `json:"createdAt"`
+		// Active is set for the group the session making the request is acting in.
+		Active bool `json:"active"`
+	}
+)
+
+var (
+	mapGroupMembershipOutErr = mapTErrFunc(mapGroupMembershipOut)
+	mapGroupMembershipsOut   = mapTEachErrFunc(mapGroupMembershipOut)
+)
+
+func mapGroupMembershipOut(m *ent.GroupMembership) GroupMembershipOut {
+	return GroupMembershipOut{
+		GroupID:   m.GroupID,
+		GroupName: m.Edges.Group.Name,
+		Role:      m.Role.String(),
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// GetAll returns the memberships of the user ordered by the name of their group.
+func (r *GroupMembershipRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]GroupMembershipOut, error) {
+	return mapGroupMembershipsOut(r.db.GroupMembership.Query().
+		Where(groupmembership.UserID(userID)).
+		WithGroup().
+		Order(groupmembership.ByGroupField(group.FieldName)).
+		All(ctx))
+}
+
+func (r *GroupMembershipRepository) GetOne(ctx context.Context, userID, GID uuid.UUID) (GroupMembershipOut, error) {
+	return mapGroupMembershipOutErr(r.db.GroupMembership.Query().
+		Where(
+			groupmembership.UserID(userID),
+			groupmembership.GroupID(GID),
+		).
+		WithGroup().
+		Only(ctx))
+}
+
+// Create adds the user to the group with the role.
+func (r *GroupMembershipRepository) Create(ctx context.Context, userID, GID uuid.UUID, role groupmembership.Role) (GroupMembershipOut, error) {
+	_, err := r.db.GroupMembership.Create().
+		SetUserID(userID).
+		SetGroupID(GID).
+		SetRole(role).
+		Save(ctx)
+	if err != nil {
+		return GroupMembershipOut{}, err
+	}
+
+	return r.GetOne(ctx, userID, GID)
+}
+
+// UpdateRole changes the role of a member of the group.
+func (r *GroupMembershipRepository) UpdateRole(ctx context.Context, GID, userID uuid.UUID, role groupmembership.Role) error {
+	n, err := r.db.GroupMembership.Update().
+		Where(
+			groupmembership.UserID(userID),
+			groupmembership.GroupID(GID),
+		).
+		SetRole(role).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return ent.NewNotFoundError(groupmembership.Label)
+	}
+
+	return nil
+}
+
+// TransferOwnership makes the member the owner of the group, the previous owner becomes an
+// editor of the group.
+func (r *GroupMembershipRepository) TransferOwnership(ctx context.Context, GID, fromID, toID uuid.UUID) error {
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, change := range []struct {
+		userID uuid.UUID
+		role   groupmembership.Role
+	}{
+		{toID, groupmembership.RoleOwner},
+		{fromID, groupmembership.RoleEditor},
+	} {
+		n, err := tx.GroupMembership.Update().
+			Where(
+				groupmembership.UserID(change.userID),
+				groupmembership.GroupID(GID),
+			).
+			SetRole(change.role).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		if n == 0 {
+			return ent.NewNotFoundError(groupmembership.Label)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Remove removes the user from the group. API keys of the user for the group are deleted
+// and sessions acting in the group fall back to the default group of the user. When the
+// group is the default group of the user another group of the user becomes the default,
+// the user must be a member of another group.
+func (r *GroupMembershipRepository) Remove(ctx context.Context, GID, userID uuid.UUID) error {
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	n, err := tx.GroupMembership.Delete().
+		Where(
+			groupmembership.UserID(userID),
+			groupmembership.GroupID(GID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return ent.NewNotFoundError(groupmembership.Label)
+	}
+
+	_, err = tx.AuthTokens.Delete().
+		Where(
+			authtokens.HasUserWith(user.ID(userID)),
+			authtokens.GroupID(GID),
+			authtokens.NameNEQ(""),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.AuthTokens.Update().
+		Where(
+			authtokens.HasUserWith(user.ID(userID)),
+			authtokens.GroupID(GID),
+		).
+		ClearGroupID().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	usr, err := tx.User.Query().
+		Where(user.ID(userID)).
+		WithGroup().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	if usr.Edges.Group.ID == GID {
+		other, err := tx.GroupMembership.Query().
+			Where(groupmembership.UserID(userID)).
+			Order(groupmembership.ByCreatedAt()).
+			First(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = tx.User.UpdateOneID(userID).
+			SetGroupID(other.GroupID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -45,3 +47,9 @@ func Test_Group_GroupStatistics(t *testing.T) {
 	assert.Equal(t, 1, stats.TotalUsers)
 	assert.Equal(t, 1, stats.TotalLocations)
 }
+
+func Test_Group_InvitationDelete_NotFound(t *testing.T) {
+	err := tRepos.Groups.InvitationDelete(context.Background(), tGroup.ID, uuid.New())
+	require.True(t, ent.IsNotFound(err))
+	assert.Equal(t, "ent: group_invitation_token not found", err.Error())
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/auditentry"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 )
 
@@ -51,6 +52,46 @@ func ToItemAttachment(attachment *ent.Attachment) ItemAttachment {
 	}
 }
 
+// recordAudit records a change of an attachment in the audit log of the group that
+// owns the attachment's item.
+func (r *AttachmentRepo) recordAudit(ctx context.Context, action auditentry.Action, from, to *ent.Attachment) error {
+	rec := auditRecord{
+		Action:     action,
+		EntityType: auditentry.EntityTypeAttachment,
+	}
+
+	for _, a := range []*ent.Attachment{from, to} {
+		if a == nil {
+			continue
+		}
+
+		itm, err := r.db.Item.Query().
+			Where(item.ID(a.Edges.Item.ID)).
+			WithGroup().
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		rec.GID = itm.Edges.Group.ID
+		rec.EntityID = a.ID
+		rec.ItemID = &itm.ID
+		if a.Edges.Document != nil {
+			rec.EntityName = a.Edges.Document.Title
+		}
+	}
+
+	if from != nil {
+		rec.From = attachmentSnapshot(from)
+	}
+
+	if to != nil {
+		rec.To = attachmentSnapshot(to)
+	}
+
+	return recordAudit(ctx, r.db, rec)
+}
+
 func (r *AttachmentRepo) Create(ctx context.Context, itemID, docID uuid.UUID, typ attachment.Type) (*ent.Attachment, error) {
 	bldr := r.db.Attachment.Create().
 		SetType(typ).
@@ -75,7 +116,22 @@ func (r *AttachmentRepo) Create(ctx context.Context, itemID, docID uuid.UUID, ty
 		}
 	}
 
-	return bldr.Save(ctx)
+	created, err := bldr.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := r.Get(ctx, created.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.recordAudit(ctx, auditentry.ActionCreate, nil, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
 }
 
 func (r *AttachmentRepo) Get(ctx context.Context, id uuid.UUID) (*ent.Attachment, error) {
@@ -91,6 +147,11 @@ func (r *AttachmentRepo) Update(ctx context.Context, itemID uuid.UUID, data *Ite
 	// TODO: execute within Tx
 	typ := attachment.Type(data.Type)
 
+	before, err := r.Get(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
 	bldr := r.db.Attachment.UpdateOneID(itemID).
 		SetType(typ)
 
@@ -118,9 +179,29 @@ func (r *AttachmentRepo) Update(ctx context.Context, itemID uuid.UUID, data *Ite
 		return nil, err
 	}
 
-	return r.Get(ctx, itm.ID)
+	after, err := r.Get(ctx, itm.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.recordAudit(ctx, auditentry.ActionUpdate, before, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return after, nil
 }
 
 func (r *AttachmentRepo) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.Attachment.DeleteOneID(id).Exec(ctx)
+	before, err := r.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = r.db.Attachment.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.recordAudit(ctx, auditentry.ActionDelete, before, nil)
 }
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/hay-kot/homebox/backend/internal/core/services/reporting/eventbus"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/auditentry"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/itemfield"
@@ -18,9 +20,14 @@ import (
 	"github.com/hay-kot/homebox/backend/internal/data/types"
 )
 
+// ErrLocationTrashed is returned when restoring an item or location whose
+// location is still in the trash.
+var ErrLocationTrashed = errors.New("location is in the trash")
+
 type ItemsRepository struct {
-	db  *ent.Client
-	bus *eventbus.EventBus
+	db     *ent.Client
+	bus    *eventbus.EventBus
+	search *SearchRepository
 }
 
 type (
@@ -41,6 +48,7 @@ type (
 		IncludeArchived bool         `json:"includeArchived"`
 		Fields          []FieldQuery `json:"fields"`
 		OrderBy         string       `json:"orderBy"`
+		Filter          ItemFilter   `json:"-"`
 	}
 
 	ItemField struct {
@@ -131,6 +139,11 @@ type (
 		ImageID *uuid.UUID `json:"imageId,omitempty"`
 	}
 
+	TrashItem struct {
+		ItemSummary
+		DeletedAt time.Time `json:"deletedAt"`
+	}
+
 	ItemOut struct {
 		Parent *ItemSummary `json:"parent,omitempty" extensions:"x-nullable,x-omitempty"`
 		ItemSummary
@@ -282,6 +295,46 @@ func (e *ItemsRepository) publishMutationEvent(GID uuid.UUID) {
 	}
 }
 
+// auditItem loads the item with the edges required for its audit snapshot.
+func (e *ItemsRepository) auditItem(ctx context.Context, where ...predicate.Item) (*ent.Item, error) {
+	return e.db.Item.Query().
+		Where(where...).
+		WithGroup().
+		WithLocation().
+		WithParent().
+		WithLabel().
+		WithFields().
+		Only(ctx)
+}
+
+func (e *ItemsRepository) recordAudit(ctx context.Context, action auditentry.Action, from, to *ent.Item) error {
+	rec := auditRecord{
+		Action:     action,
+		EntityType: auditentry.EntityTypeItem,
+	}
+
+	for _, it := range []*ent.Item{from, to} {
+		if it == nil {
+			continue
+		}
+
+		rec.GID = it.Edges.Group.ID
+		rec.EntityID = it.ID
+		rec.EntityName = it.Name
+		rec.ItemID = &it.ID
+	}
+
+	if from != nil {
+		rec.From = itemSnapshot(from)
+	}
+
+	if to != nil {
+		rec.To = itemSnapshot(to)
+	}
+
+	return recordAudit(ctx, e.db, rec)
+}
+
 func (e *ItemsRepository) getOne(ctx context.Context, where ...predicate.Item) (ItemOut, error) {
 	q := e.db.Item.Query().Where(where...)
 
@@ -305,27 +358,80 @@ func (e *ItemsRepository) GetOne(ctx context.Context, id uuid.UUID) (ItemOut, er
 }
 
 func (e *ItemsRepository) CheckRef(ctx context.Context, GID uuid.UUID, ref string) (bool, error) {
-	q := e.db.Item.Query().Where(item.HasGroupWith(group.ID(GID)))
+	q := e.db.Item.Query().Where(item.HasGroupWith(group.ID(GID)), item.DeletedAtIsNil())
 	return q.Where(item.ImportRef(ref)).Exist(ctx)
 }
 
 func (e *ItemsRepository) GetByRef(ctx context.Context, GID uuid.UUID, ref string) (ItemOut, error) {
-	return e.getOne(ctx, item.ImportRef(ref), item.HasGroupWith(group.ID(GID)))
+	return e.getOne(ctx, item.ImportRef(ref), item.HasGroupWith(group.ID(GID)), item.DeletedAtIsNil())
 }
 
 // GetOneByGroup returns a single item by ID. If the item does not exist, an error is returned.
 // GetOneByGroup ensures that the item belongs to a specific group.
 func (e *ItemsRepository) GetOneByGroup(ctx context.Context, gid, id uuid.UUID) (ItemOut, error) {
-	return e.getOne(ctx, item.ID(id), item.HasGroupWith(group.ID(gid)))
+	return e.getOne(ctx, item.ID(id), item.HasGroupWith(group.ID(gid)), item.DeletedAtIsNil())
 }
 
 // QueryByGroup returns a list of items that belong to a specific group based on the provided query.
 func (e *ItemsRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q ItemQuery) (PaginationResult[ItemSummary], error) {
+	qb := e.queryByGroup(gid, q)
+
+	count, err := qb.Count(ctx)
+	if err != nil {
+		return PaginationResult[ItemSummary]{}, err
+	}
+
+	// Order
+	switch q.OrderBy {
+	case "createdAt":
+		qb = qb.Order(ent.Desc(item.FieldCreatedAt))
+	case "updatedAt":
+		qb = qb.Order(ent.Desc(item.FieldUpdatedAt))
+	default: // "name"
+		qb = qb.Order(ent.Asc(item.FieldName))
+	}
+
+	qb = qb.
+		WithLabel().
+		WithLocation().
+		WithAttachments(func(aq *ent.AttachmentQuery) {
+			aq.Where(
+				attachment.Primary(true),
+			).
+				WithDocument()
+		})
+
+	if q.Page != -1 || q.PageSize != -1 {
+		qb = qb.
+			Offset(calculateOffset(q.Page, q.PageSize)).
+			Limit(q.PageSize)
+	}
+
+	items, err := mapItemsSummaryErr(qb.All(ctx))
+	if err != nil {
+		return PaginationResult[ItemSummary]{}, err
+	}
+
+	return PaginationResult[ItemSummary]{
+		Page:     q.Page,
+		PageSize: q.PageSize,
+		Total:    count,
+		Items:    items,
+	}, nil
+}
+
+// CountByGroup returns the number of items matching the query.
+func (e *ItemsRepository) CountByGroup(ctx context.Context, gid uuid.UUID, q ItemQuery) (int, error) {
+	return e.queryByGroup(gid, q).Count(ctx)
+}
+
+func (e *ItemsRepository) queryByGroup(gid uuid.UUID, q ItemQuery) *ent.ItemQuery {
 	qb := e.db.Item.Query().Where(
 		item.HasGroupWith(group.ID(gid)),
+		item.DeletedAtIsNil(),
 	)
 
-	if q.IncludeArchived {
+	if q.IncludeArchived || q.Filter.includeArchived {
 		qb = qb.Where(
 			item.Or(
 				item.Archived(true),
@@ -403,48 +509,11 @@ func (e *ItemsRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q Ite
 		qb = qb.Where(item.And(andPredicates...))
 	}
 
-	count, err := qb.Count(ctx)
-	if err != nil {
-		return PaginationResult[ItemSummary]{}, err
+	if len(q.Filter.predicates) > 0 {
+		qb = qb.Where(q.Filter.predicates...)
 	}
 
-	// Order
-	switch q.OrderBy {
-	case "createdAt":
-		qb = qb.Order(ent.Desc(item.FieldCreatedAt))
-	case "updatedAt":
-		qb = qb.Order(ent.Desc(item.FieldUpdatedAt))
-	default: // "name"
-		qb = qb.Order(ent.Asc(item.FieldName))
-	}
-
-	qb = qb.
-		WithLabel().
-		WithLocation().
-		WithAttachments(func(aq *ent.AttachmentQuery) {
-			aq.Where(
-				attachment.Primary(true),
-			).
-				WithDocument()
-		})
-
-	if q.Page != -1 || q.PageSize != -1 {
-		qb = qb.
-			Offset(calculateOffset(q.Page, q.PageSize)).
-			Limit(q.PageSize)
-	}
-
-	items, err := mapItemsSummaryErr(qb.All(ctx))
-	if err != nil {
-		return PaginationResult[ItemSummary]{}, err
-	}
-
-	return PaginationResult[ItemSummary]{
-		Page:     q.Page,
-		PageSize: q.PageSize,
-		Total:    count,
-		Items:    items,
-	}, nil
+	return qb
 }
 
 // QueryByAssetID returns items by asset ID. If the item does not exist, an error is returned.
@@ -452,6 +521,7 @@ func (e *ItemsRepository) QueryByAssetID(ctx context.Context, gid uuid.UUID, ass
 	qb := e.db.Item.Query().Where(
 		item.HasGroupWith(group.ID(gid)),
 		item.AssetID(int(assetID)),
+		item.DeletedAtIsNil(),
 	)
 
 	if page != -1 || pageSize != -1 {
@@ -483,7 +553,7 @@ func (e *ItemsRepository) QueryByAssetID(ctx context.Context, gid uuid.UUID, ass
 // GetAll returns all the items in the database with the Labels and Locations eager loaded.
 func (e *ItemsRepository) GetAll(ctx context.Context, gid uuid.UUID) ([]ItemOut, error) {
 	return mapItemsOutErr(e.db.Item.Query().
-		Where(item.HasGroupWith(group.ID(gid))).
+		Where(item.HasGroupWith(group.ID(gid)), item.DeletedAtIsNil()).
 		WithLabel().
 		WithLocation().
 		WithFields().
@@ -494,6 +564,7 @@ func (e *ItemsRepository) GetAllZeroAssetID(ctx context.Context, GID uuid.UUID)
 	q := e.db.Item.Query().Where(
 		item.HasGroupWith(group.ID(GID)),
 		item.AssetID(0),
+		item.DeletedAtIsNil(),
 	).Order(
 		ent.Asc(item.FieldCreatedAt),
 	)
@@ -526,7 +597,11 @@ func (e *ItemsRepository) SetAssetID(ctx context.Context, GID uuid.UUID, ID uuid
 	)
 
 	_, err := q.SetAssetID(int(assetID)).Save(ctx)
-	return err
+	if err != nil {
+		return err
+	}
+
+	return e.search.index(ctx, ID)
 }
 
 func (e *ItemsRepository) Create(ctx context.Context, gid uuid.UUID, data ItemCreate) (ItemOut, error) {
@@ -547,27 +622,72 @@ func (e *ItemsRepository) Create(ctx context.Context, gid uuid.UUID, data ItemCr
 		return ItemOut{}, err
 	}
 
+	created, err := e.auditItem(ctx, item.ID(result.ID))
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	err = e.recordAudit(ctx, auditentry.ActionCreate, nil, created)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	err = e.search.index(ctx, result.ID)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
 	e.publishMutationEvent(gid)
 	return e.GetOne(ctx, result.ID)
 }
 
+// Delete permanently deletes the item. See DeleteByGroup to move an item to the trash.
 func (e *ItemsRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	err := e.db.Item.DeleteOneID(id).Exec(ctx)
+	before, err := e.auditItem(ctx, item.ID(id))
+	if err != nil {
+		return err
+	}
+
+	err = e.db.Item.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = e.recordAudit(ctx, auditentry.ActionPurge, before, nil)
 	if err != nil {
 		return err
 	}
 
-	e.publishMutationEvent(id)
+	err = e.search.index(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	e.publishMutationEvent(before.Edges.Group.ID)
 	return nil
 }
 
+// DeleteByGroup moves the item to the trash. Trashed items are excluded from all queries
+// until they are restored, or permanently deleted by PurgeTrash.
 func (e *ItemsRepository) DeleteByGroup(ctx context.Context, gid, id uuid.UUID) error {
-	_, err := e.db.Item.
-		Delete().
-		Where(
-			item.ID(id),
-			item.HasGroupWith(group.ID(gid)),
-		).Exec(ctx)
+	before, err := e.auditItem(ctx, item.ID(id), item.HasGroupWith(group.ID(gid)), item.DeletedAtIsNil())
+	if err != nil {
+		return err
+	}
+
+	err = e.db.Item.UpdateOneID(id).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = e.recordAudit(ctx, auditentry.ActionDelete, before, nil)
+	if err != nil {
+		return err
+	}
+
+	err = e.search.index(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -576,7 +696,106 @@ func (e *ItemsRepository) DeleteByGroup(ctx context.Context, gid, id uuid.UUID)
 	return err
 }
 
+// Restore moves the item out of the trash. Items can't be restored while their
+// location is in the trash.
+func (e *ItemsRepository) Restore(ctx context.Context, GID, ID uuid.UUID) (ItemOut, error) {
+	trashed, err := e.auditItem(ctx, item.ID(ID), item.HasGroupWith(group.ID(GID)), item.DeletedAtNotNil())
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	if loc := trashed.Edges.Location; loc != nil && loc.DeletedAt != nil {
+		return ItemOut{}, ErrLocationTrashed
+	}
+
+	err = e.db.Item.UpdateOneID(ID).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	err = e.recordAudit(ctx, auditentry.ActionRestore, nil, trashed)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	err = e.search.index(ctx, ID)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	e.publishMutationEvent(GID)
+	return e.GetOne(ctx, ID)
+}
+
+// GetTrash returns the items of the group that are in the trash, most recently deleted first.
+func (e *ItemsRepository) GetTrash(ctx context.Context, GID uuid.UUID) ([]TrashItem, error) {
+	items, err := e.db.Item.Query().
+		Where(
+			item.HasGroupWith(group.ID(GID)),
+			item.DeletedAtNotNil(),
+		).
+		Order(ent.Desc(item.FieldDeletedAt)).
+		WithLabel().
+		WithLocation().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEach(items, func(it *ent.Item) TrashItem {
+		return TrashItem{
+			ItemSummary: mapItemSummary(it),
+			DeletedAt:   *it.DeletedAt,
+		}
+	}), nil
+}
+
+// PurgeTrash permanently deletes all items that were moved to the trash before the given time.
+func (e *ItemsRepository) PurgeTrash(ctx context.Context, before time.Time) (int, error) {
+	items, err := e.db.Item.Query().
+		Where(item.DeletedAtLT(before)).
+		WithGroup().
+		WithLocation().
+		WithParent().
+		WithLabel().
+		WithFields().
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, it := range items {
+		err = e.db.Item.DeleteOneID(it.ID).Exec(ctx)
+		if err != nil {
+			return purged, err
+		}
+
+		err = e.recordAudit(ctx, auditentry.ActionPurge, it, nil)
+		if err != nil {
+			return purged, err
+		}
+
+		// The document only cascades with the item when foreign keys are enforced.
+		err = e.search.index(ctx, it.ID)
+		if err != nil {
+			return purged, err
+		}
+
+		purged++
+	}
+
+	return purged, nil
+}
+
 func (e *ItemsRepository) UpdateByGroup(ctx context.Context, GID uuid.UUID, data ItemUpdate) (ItemOut, error) {
+	before, err := e.auditItem(ctx, item.ID(data.ID), item.HasGroupWith(group.ID(GID)), item.DeletedAtIsNil())
+	if err != nil {
+		return ItemOut{}, err
+	}
+
 	q := e.db.Item.Update().Where(item.ID(data.ID), item.HasGroupWith(group.ID(GID))).
 		SetName(data.Name).
 		SetDescription(data.Description).
@@ -688,6 +907,21 @@ func (e *ItemsRepository) UpdateByGroup(ctx context.Context, GID uuid.UUID, data
 		}
 	}
 
+	after, err := e.auditItem(ctx, item.ID(data.ID))
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	err = e.recordAudit(ctx, auditentry.ActionUpdate, before, after)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
+	err = e.search.index(ctx, data.ID)
+	if err != nil {
+		return ItemOut{}, err
+	}
+
 	e.publishMutationEvent(GID)
 	return e.GetOne(ctx, data.ID)
 }
@@ -713,6 +947,11 @@ func (e *ItemsRepository) GetAllZeroImportRef(ctx context.Context, GID uuid.UUID
 }
 
 func (e *ItemsRepository) Patch(ctx context.Context, GID, ID uuid.UUID, data ItemPatch) error {
+	before, err := e.auditItem(ctx, item.ID(ID), item.HasGroupWith(group.ID(GID)), item.DeletedAtIsNil())
+	if err != nil {
+		return err
+	}
+
 	q := e.db.Item.Update().
 		Where(
 			item.ID(ID),
@@ -727,8 +966,28 @@ func (e *ItemsRepository) Patch(ctx context.Context, GID, ID uuid.UUID, data Ite
 		q.SetQuantity(*data.Quantity)
 	}
 
+	err = q.Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	after, err := e.auditItem(ctx, item.ID(ID))
+	if err != nil {
+		return err
+	}
+
+	err = e.recordAudit(ctx, auditentry.ActionUpdate, before, after)
+	if err != nil {
+		return err
+	}
+
+	err = e.search.index(ctx, ID)
+	if err != nil {
+		return err
+	}
+
 	e.publishMutationEvent(GID)
-	return q.Exec(ctx)
+	return nil
 }
 
 func (e *ItemsRepository) GetAllCustomFieldValues(ctx context.Context, GID uuid.UUID, name string) ([]string, error) {
@@ -741,6 +1000,7 @@ func (e *ItemsRepository) GetAllCustomFieldValues(ctx context.Context, GID uuid.
 	err := e.db.Item.Query().
 		Where(
 			item.HasGroupWith(group.ID(GID)),
+			item.DeletedAtIsNil(),
 		).
 		QueryFields().
 		Where(
@@ -771,6 +1031,7 @@ func (e *ItemsRepository) GetAllCustomFieldNames(ctx context.Context, GID uuid.U
 	err := e.db.Item.Query().
 		Where(
 			item.HasGroupWith(group.ID(GID)),
+			item.DeletedAtIsNil(),
 		).
 		QueryFields().
 		Unique(true).
@@ -317,3 +317,104 @@ func TestItemRepository_GetAllCustomFields(t *testing.T) {
 		assert.ElementsMatch(t, values[:1], results)
 	}
 }
+
+func TestItemsRepository_Patch(t *testing.T) {
+	entity := useItems(t, 1)[0]
+	ctx := context.Background()
+
+	// A stale index is refreshed by the patch
+	_, err := tClient.Sql().ExecContext(ctx, rebind(tClient.Dialect(), "DELETE FROM item_search WHERE item_id = ?"), entity.ID)
+	require.NoError(t, err)
+
+	qty := 7
+	err = tRepos.Items.Patch(ctx, tGroup.ID, entity.ID, ItemPatch{ID: entity.ID, Quantity: &qty})
+	require.NoError(t, err)
+
+	got, err := tRepos.Items.GetOne(ctx, entity.ID)
+	require.NoError(t, err)
+	assert.Equal(t, qty, got.Quantity)
+
+	assert.Equal(t, 1, searchRows(t, entity.ID))
+}
+
+func TestItemsRepository_DeleteByGroup_Trash(t *testing.T) {
+	entity := useItems(t, 1)[0]
+	ctx := context.Background()
+
+	err := tRepos.Items.DeleteByGroup(ctx, tGroup.ID, entity.ID)
+	require.NoError(t, err)
+
+	// Trashed items are hidden from queries
+	_, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, entity.ID)
+	require.Error(t, err)
+
+	results, err := tRepos.Items.QueryByGroup(ctx, tGroup.ID, ItemQuery{Search: entity.Name})
+	require.NoError(t, err)
+	assert.Empty(t, results.Items)
+
+	trash, err := tRepos.Items.GetTrash(ctx, tGroup.ID)
+	require.NoError(t, err)
+	require.Len(t, trash, 1)
+	assert.Equal(t, entity.ID, trash[0].ID)
+	assert.False(t, trash[0].DeletedAt.IsZero())
+
+	// Deleting a trashed item again is not allowed
+	err = tRepos.Items.DeleteByGroup(ctx, tGroup.ID, entity.ID)
+	require.Error(t, err)
+
+	restored, err := tRepos.Items.Restore(ctx, tGroup.ID, entity.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.Name, restored.Name)
+
+	_, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, entity.ID)
+	require.NoError(t, err)
+
+	trash, err = tRepos.Items.GetTrash(ctx, tGroup.ID)
+	require.NoError(t, err)
+	assert.Empty(t, trash)
+}
+
+// searchRows returns the number of documents of the item in the search index.
+func searchRows(t *testing.T, ID uuid.UUID) int {
+	t.Helper()
+
+	var n int
+	err := tClient.Sql().QueryRowContext(context.Background(), rebind(tClient.Dialect(), "SELECT COUNT(*) FROM item_search WHERE item_id = ?"), ID).Scan(&n)
+	require.NoError(t, err)
+	return n
+}
+
+func TestItemsRepository_PurgeTrash(t *testing.T) {
+	entities := useItems(t, 2)
+	ctx := context.Background()
+
+	err := tRepos.Items.DeleteByGroup(ctx, tGroup.ID, entities[0].ID)
+	require.NoError(t, err)
+
+	// A document left over from before the item was trashed
+	_, err = tClient.Sql().ExecContext(ctx, rebind(tClient.Dialect(), "INSERT INTO item_search (item_id, group_id) VALUES (?, ?)"), entities[0].ID, tGroup.ID)
+	require.NoError(t, err)
+
+	// Only items trashed before the cutoff are purged
+	purged, err := tRepos.Items.PurgeTrash(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 0, purged)
+
+	purged, err = tRepos.Items.PurgeTrash(ctx, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+
+	_, err = tRepos.Items.GetOne(ctx, entities[0].ID)
+	require.Error(t, err)
+
+	_, err = tRepos.Items.GetOne(ctx, entities[1].ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, searchRows(t, entities[0].ID))
+	assert.Equal(t, 1, searchRows(t, entities[1].ID))
+
+	history, err := tRepos.Audit.QueryByGroup(ctx, tGroup.ID, AuditQuery{ItemID: entities[0].ID})
+	require.NoError(t, err)
+	require.NotEmpty(t, history.Items)
+	assert.Equal(t, "purge", history.Items[0].Action)
+}
@@ -7,14 +7,17 @@ import (
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/core/services/reporting/eventbus"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/auditentry"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/label"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
 )
 
 type LabelRepository struct {
-	db  *ent.Client
-	bus *eventbus.EventBus
+	db     *ent.Client
+	bus    *eventbus.EventBus
+	search *SearchRepository
 }
 
 type (
@@ -71,6 +74,26 @@ func (r *LabelRepository) publishMutationEvent(GID uuid.UUID) {
 	}
 }
 
+func (r *LabelRepository) recordAudit(ctx context.Context, GID uuid.UUID, action auditentry.Action, from, to *ent.Label) error {
+	rec := auditRecord{
+		GID:        GID,
+		Action:     action,
+		EntityType: auditentry.EntityTypeLabel,
+	}
+
+	if from != nil {
+		rec.EntityID, rec.EntityName = from.ID, from.Name
+		rec.From = labelSnapshot(from)
+	}
+
+	if to != nil {
+		rec.EntityID, rec.EntityName = to.ID, to.Name
+		rec.To = labelSnapshot(to)
+	}
+
+	return recordAudit(ctx, r.db, rec)
+}
+
 func (r *LabelRepository) getOne(ctx context.Context, where ...predicate.Label) (LabelOut, error) {
 	return mapLabelOutErr(r.db.Label.Query().
 		Where(where...).
@@ -107,6 +130,11 @@ func (r *LabelRepository) Create(ctx context.Context, groupID uuid.UUID, data La
 		return LabelOut{}, err
 	}
 
+	err = r.recordAudit(ctx, groupID, auditentry.ActionCreate, nil, label)
+	if err != nil {
+		return LabelOut{}, err
+	}
+
 	label.Edges.Group = &ent.Group{ID: groupID} // bootstrap group ID
 	r.publishMutationEvent(groupID)
 	return mapLabelOut(label), err
@@ -126,11 +154,33 @@ func (r *LabelRepository) update(ctx context.Context, data LabelUpdate, where ..
 }
 
 func (r *LabelRepository) UpdateByGroup(ctx context.Context, GID uuid.UUID, data LabelUpdate) (LabelOut, error) {
-	_, err := r.update(ctx, data, label.ID(data.ID), label.HasGroupWith(group.ID(GID)))
+	before, err := r.db.Label.Query().Where(label.ID(data.ID), label.HasGroupWith(group.ID(GID))).Only(ctx)
+	if err != nil {
+		return LabelOut{}, err
+	}
+
+	_, err = r.update(ctx, data, label.ID(data.ID), label.HasGroupWith(group.ID(GID)))
+	if err != nil {
+		return LabelOut{}, err
+	}
+
+	after, err := r.db.Label.Get(ctx, data.ID)
+	if err != nil {
+		return LabelOut{}, err
+	}
+
+	err = r.recordAudit(ctx, GID, auditentry.ActionUpdate, before, after)
 	if err != nil {
 		return LabelOut{}, err
 	}
 
+	if before.Name != after.Name {
+		err = r.search.indexWhere(ctx, item.HasLabelWith(label.ID(data.ID)))
+		if err != nil {
+			return LabelOut{}, err
+		}
+	}
+
 	r.publishMutationEvent(GID)
 	return r.GetOne(ctx, data.ID)
 }
@@ -142,7 +192,17 @@ func (r *LabelRepository) delete(ctx context.Context, id uuid.UUID) error {
 }
 
 func (r *LabelRepository) DeleteByGroup(ctx context.Context, gid, id uuid.UUID) error {
-	_, err := r.db.Label.Delete().
+	before, err := r.db.Label.Query().Where(label.ID(id), label.HasGroupWith(group.ID(gid))).Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	itemIDs, err := r.db.Item.Query().Where(item.HasLabelWith(label.ID(id))).IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Label.Delete().
 		Where(
 			label.ID(id),
 			label.HasGroupWith(group.ID(gid)),
@@ -151,6 +211,16 @@ func (r *LabelRepository) DeleteByGroup(ctx context.Context, gid, id uuid.UUID)
 		return err
 	}
 
+	err = r.recordAudit(ctx, gid, auditentry.ActionDelete, before, nil)
+	if err != nil {
+		return err
+	}
+
+	err = r.search.index(ctx, itemIDs...)
+	if err != nil {
+		return err
+	}
+
 	r.publishMutationEvent(gid)
 
 	return nil
@@ -8,14 +8,17 @@ import (
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/core/services/reporting/eventbus"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/auditentry"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/location"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
 )
 
 type LocationRepository struct {
-	db  *ent.Client
-	bus *eventbus.EventBus
+	db     *ent.Client
+	bus    *eventbus.EventBus
+	search *SearchRepository
 }
 
 type (
@@ -45,6 +48,11 @@ type (
 		ItemCount int `json:"itemCount"`
 	}
 
+	TrashLocation struct {
+		LocationSummary
+		DeletedAt time.Time `json:"deletedAt"`
+	}
+
 	LocationOut struct {
 		Parent *LocationSummary `json:"parent,omitempty"`
 		LocationSummary
@@ -95,6 +103,34 @@ func (r *LocationRepository) publishMutationEvent(GID uuid.UUID) {
 	}
 }
 
+// auditLocation loads the location with the edges required for its audit snapshot.
+func (r *LocationRepository) auditLocation(ctx context.Context, where ...predicate.Location) (*ent.Location, error) {
+	return r.db.Location.Query().
+		Where(where...).
+		WithParent().
+		Only(ctx)
+}
+
+func (r *LocationRepository) recordAudit(ctx context.Context, GID uuid.UUID, action auditentry.Action, from, to *ent.Location) error {
+	rec := auditRecord{
+		GID:        GID,
+		Action:     action,
+		EntityType: auditentry.EntityTypeLocation,
+	}
+
+	if from != nil {
+		rec.EntityID, rec.EntityName = from.ID, from.Name
+		rec.From = locationSnapshot(from)
+	}
+
+	if to != nil {
+		rec.EntityID, rec.EntityName = to.ID, to.Name
+		rec.To = locationSnapshot(to)
+	}
+
+	return recordAudit(ctx, r.db, rec)
+}
+
 type LocationQuery struct {
 	FilterChildren bool `json:"filterChildren" schema:"filterChildren"`
 }
@@ -110,17 +146,19 @@ func (r *LocationRepository) GetAll(ctx context.Context, GID uuid.UUID, filter L
 			updated_at,
 			(
 				SELECT
-					SUM(items.quantity)
+					CAST(SUM(items.quantity) AS INTEGER)
 				FROM
 					items
 				WHERE
 					items.location_items = locations.id
 					AND items.archived = false
+					AND items.deleted_at IS NULL
 			) as item_count
 		FROM
 			locations
 		WHERE
-			locations.group_locations = ? {{ FILTER_CHILDREN }}
+			locations.group_locations = ?
+			AND locations.deleted_at IS NULL {{ FILTER_CHILDREN }}
 		ORDER BY
 			locations.name ASC
 `
@@ -131,7 +169,7 @@ func (r *LocationRepository) GetAll(ctx context.Context, GID uuid.UUID, filter L
 		query = strings.Replace(query, "{{ FILTER_CHILDREN }}", "", 1)
 	}
 
-	rows, err := r.db.Sql().QueryContext(ctx, query, GID)
+	rows, err := r.db.Sql().QueryContext(ctx, rebind(r.db.Dialect(), query), GID)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +201,9 @@ func (r *LocationRepository) getOne(ctx context.Context, where ...predicate.Loca
 		Where(where...).
 		WithGroup().
 		WithParent().
-		WithChildren().
+		WithChildren(func(lq *ent.LocationQuery) {
+			lq.Where(location.DeletedAtIsNil())
+		}).
 		Only(ctx))
 }
 
@@ -172,7 +212,7 @@ func (r *LocationRepository) Get(ctx context.Context, ID uuid.UUID) (LocationOut
 }
 
 func (r *LocationRepository) GetOneByGroup(ctx context.Context, GID, ID uuid.UUID) (LocationOut, error) {
-	return r.getOne(ctx, location.ID(ID), location.HasGroupWith(group.ID(GID)))
+	return r.getOne(ctx, location.ID(ID), location.HasGroupWith(group.ID(GID)), location.DeletedAtIsNil())
 }
 
 func (r *LocationRepository) Create(ctx context.Context, GID uuid.UUID, data LocationCreate) (LocationOut, error) {
@@ -185,14 +225,24 @@ func (r *LocationRepository) Create(ctx context.Context, GID uuid.UUID, data Loc
 		q.SetParentID(data.ParentID)
 	}
 
-	location, err := q.Save(ctx)
+	loc, err := q.Save(ctx)
 	if err != nil {
 		return LocationOut{}, err
 	}
 
-	location.Edges.Group = &ent.Group{ID: GID} // bootstrap group ID
+	created, err := r.auditLocation(ctx, location.ID(loc.ID))
+	if err != nil {
+		return LocationOut{}, err
+	}
+
+	err = r.recordAudit(ctx, GID, auditentry.ActionCreate, nil, created)
+	if err != nil {
+		return LocationOut{}, err
+	}
+
+	loc.Edges.Group = &ent.Group{ID: GID} // bootstrap group ID
 	r.publishMutationEvent(GID)
-	return mapLocationOut(location), nil
+	return mapLocationOut(loc), nil
 }
 
 func (r *LocationRepository) update(ctx context.Context, data LocationUpdate, where ...predicate.Location) (LocationOut, error) {
@@ -216,11 +266,33 @@ func (r *LocationRepository) update(ctx context.Context, data LocationUpdate, wh
 }
 
 func (r *LocationRepository) UpdateByGroup(ctx context.Context, GID, ID uuid.UUID, data LocationUpdate) (LocationOut, error) {
+	before, err := r.auditLocation(ctx, location.ID(ID), location.HasGroupWith(group.ID(GID)), location.DeletedAtIsNil())
+	if err != nil {
+		return LocationOut{}, err
+	}
+
 	v, err := r.update(ctx, data, location.ID(ID), location.HasGroupWith(group.ID(GID)))
 	if err != nil {
 		return LocationOut{}, err
 	}
 
+	after, err := r.auditLocation(ctx, location.ID(ID))
+	if err != nil {
+		return LocationOut{}, err
+	}
+
+	err = r.recordAudit(ctx, GID, auditentry.ActionUpdate, before, after)
+	if err != nil {
+		return LocationOut{}, err
+	}
+
+	if before.Name != after.Name {
+		err = r.search.indexWhere(ctx, item.HasLocationWith(location.ID(ID)))
+		if err != nil {
+			return LocationOut{}, err
+		}
+	}
+
 	r.publishMutationEvent(GID)
 	return v, err
 }
@@ -231,16 +303,183 @@ func (r *LocationRepository) delete(ctx context.Context, ID uuid.UUID) error {
 	return r.db.Location.DeleteOneID(ID).Exec(ctx)
 }
 
+// subtree returns the IDs of the location and its descendants matching the predicates.
+func (r *LocationRepository) subtree(ctx context.Context, ID uuid.UUID, where ...predicate.Location) ([]uuid.UUID, error) {
+	ids := []uuid.UUID{ID}
+	frontier := []uuid.UUID{ID}
+
+	for len(frontier) > 0 {
+		children, err := r.db.Location.Query().
+			Where(location.HasParentWith(location.IDIn(frontier...))).
+			Where(where...).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, children...)
+		frontier = children
+	}
+
+	return ids, nil
+}
+
+// DeleteByGroup moves the location, its child locations and all the items within them
+// to the trash. Trashed locations are excluded from all queries until they are restored,
+// or permanently deleted by PurgeTrash.
 func (r *LocationRepository) DeleteByGroup(ctx context.Context, GID, ID uuid.UUID) error {
-	_, err := r.db.Location.Delete().Where(location.ID(ID), location.HasGroupWith(group.ID(GID))).Exec(ctx)
+	before, err := r.auditLocation(ctx, location.ID(ID), location.HasGroupWith(group.ID(GID)), location.DeletedAtIsNil())
+	if err != nil {
+		return err
+	}
+
+	ids, err := r.subtree(ctx, ID, location.DeletedAtIsNil())
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	err = r.db.Location.Update().
+		Where(location.IDIn(ids...)).
+		SetDeletedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = r.db.Item.Update().
+		Where(
+			item.HasLocationWith(location.IDIn(ids...)),
+			item.DeletedAtIsNil(),
+		).
+		SetDeletedAt(now).
+		Exec(ctx)
 	if err != nil {
 		return err
 	}
+
+	err = r.recordAudit(ctx, GID, auditentry.ActionDelete, before, nil)
+	if err != nil {
+		return err
+	}
+
+	err = r.search.indexWhere(ctx, item.HasLocationWith(location.IDIn(ids...)))
+	if err != nil {
+		return err
+	}
+
 	r.publishMutationEvent(GID)
 
 	return err
 }
 
+// Restore moves the location out of the trash along with the child locations and items
+// that were moved to the trash with it. Locations can't be restored while their parent
+// location is in the trash.
+func (r *LocationRepository) Restore(ctx context.Context, GID, ID uuid.UUID) (LocationOut, error) {
+	trashed, err := r.auditLocation(ctx, location.ID(ID), location.HasGroupWith(group.ID(GID)), location.DeletedAtNotNil())
+	if err != nil {
+		return LocationOut{}, err
+	}
+
+	if trashed.Edges.Parent != nil && trashed.Edges.Parent.DeletedAt != nil {
+		return LocationOut{}, ErrLocationTrashed
+	}
+
+	// Everything trashed together with the location has the same or a later deleted_at
+	// timestamp, anything trashed before it stays in the trash.
+	deletedAt := *trashed.DeletedAt
+
+	ids, err := r.subtree(ctx, ID, location.DeletedAtGTE(deletedAt))
+	if err != nil {
+		return LocationOut{}, err
+	}
+
+	err = r.db.Location.Update().
+		Where(location.IDIn(ids...)).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		return LocationOut{}, err
+	}
+
+	err = r.db.Item.Update().
+		Where(
+			item.HasLocationWith(location.IDIn(ids...)),
+			item.DeletedAtGTE(deletedAt),
+		).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		return LocationOut{}, err
+	}
+
+	err = r.recordAudit(ctx, GID, auditentry.ActionRestore, nil, trashed)
+	if err != nil {
+		return LocationOut{}, err
+	}
+
+	err = r.search.indexWhere(ctx, item.HasLocationWith(location.IDIn(ids...)))
+	if err != nil {
+		return LocationOut{}, err
+	}
+
+	r.publishMutationEvent(GID)
+	return r.Get(ctx, ID)
+}
+
+// GetTrash returns the locations of the group that are in the trash, most recently deleted first.
+func (r *LocationRepository) GetTrash(ctx context.Context, GID uuid.UUID) ([]TrashLocation, error) {
+	locations, err := r.db.Location.Query().
+		Where(
+			location.HasGroupWith(group.ID(GID)),
+			location.DeletedAtNotNil(),
+		).
+		Order(ent.Desc(location.FieldDeletedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEach(locations, func(loc *ent.Location) TrashLocation {
+		return TrashLocation{
+			LocationSummary: mapLocationSummary(loc),
+			DeletedAt:       *loc.DeletedAt,
+		}
+	}), nil
+}
+
+// PurgeTrash permanently deletes all locations that were moved to the trash before the
+// given time. The items within them are deleted as well.
+func (r *LocationRepository) PurgeTrash(ctx context.Context, before time.Time) (int, error) {
+	locations, err := r.db.Location.Query().
+		Where(location.DeletedAtLT(before)).
+		WithGroup().
+		WithParent().
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, loc := range locations {
+		err = r.delete(ctx, loc.ID)
+		if err != nil {
+			return purged, err
+		}
+
+		err = r.recordAudit(ctx, loc.Edges.Group.ID, auditentry.ActionPurge, loc, nil)
+		if err != nil {
+			return purged, err
+		}
+
+		purged++
+	}
+
+	return purged, nil
+}
+
 type TreeItem struct {
 	ID       uuid.UUID   `json:"id"`
 	Name     string      `json:"name"`
@@ -277,8 +516,8 @@ func (r *LocationRepository) PathForLoc(ctx context.Context, GID, locID uuid.UUI
 	query := `WITH RECURSIVE location_path AS (
 		SELECT id, name, location_children
 		FROM locations
-		WHERE id = ? -- Replace ? with the ID of the item's location
-		AND group_locations = ? -- Replace ? with the ID of the group
+		WHERE id = ? -- the ID of the item's location
+		AND group_locations = ? -- the ID of the group
 
 		UNION ALL
 
@@ -290,7 +529,7 @@ func (r *LocationRepository) PathForLoc(ctx context.Context, GID, locID uuid.UUI
 	  SELECT id, name
 	  FROM location_path`
 
-	rows, err := r.db.Sql().QueryContext(ctx, query, locID, GID)
+	rows, err := r.db.Sql().QueryContext(ctx, rebind(r.db.Dialect(), query), locID, GID)
 	if err != nil {
 		return nil, err
 	}
@@ -332,6 +571,7 @@ func (r *LocationRepository) Tree(ctx context.Context, GID uuid.UUID, tq TreeQue
 			FROM    locations
 			WHERE   location_children IS NULL
 			AND     group_locations = ?
+			AND     deleted_at IS NULL
 
 			UNION ALL
 			SELECT  c.id,
@@ -342,7 +582,8 @@ func (r *LocationRepository) Tree(ctx context.Context, GID uuid.UUID, tq TreeQue
 			FROM   locations c
 			JOIN   location_tree p
 			ON     c.location_children = p.id
-			WHERE  level < 10 -- prevent infinite loop & excessive recursion
+			WHERE  c.deleted_at IS NULL
+			AND    level < 10 -- prevent infinite loop & excessive recursion
 		){{ WITH_ITEMS }}
 
 		SELECT   id,
@@ -373,6 +614,7 @@ func (r *LocationRepository) Tree(ctx context.Context, GID uuid.UUID, tq TreeQue
 					'item' AS node_type
 			FROM    items
 			WHERE   item_children IS NULL
+			AND     deleted_at IS NULL
 			AND     location_items IN (SELECT id FROM location_tree)
 
 			UNION ALL
@@ -386,6 +628,7 @@ func (r *LocationRepository) Tree(ctx context.Context, GID uuid.UUID, tq TreeQue
 			JOIN    item_tree p
 			ON      c.item_children = p.id
 			WHERE   c.item_children IS NOT NULL
+			AND     c.deleted_at IS NULL
 			AND     level < 10 -- prevent infinite loop & excessive recursion
 		)`
 
@@ -402,7 +645,7 @@ func (r *LocationRepository) Tree(ctx context.Context, GID uuid.UUID, tq TreeQue
 		query = strings.ReplaceAll(query, "{{ WITH_ITEMS_FROM }}", "")
 	}
 
-	rows, err := r.db.Sql().QueryContext(ctx, query, GID)
+	rows, err := r.db.Sql().QueryContext(ctx, rebind(r.db.Dialect(), query), GID)
 	if err != nil {
 		return nil, err
 	}
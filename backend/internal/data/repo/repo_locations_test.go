@@ -291,3 +291,105 @@ func TestConvertLocationsToTree(t *testing.T) {
 		})
 	}
 }
+
+func TestLocationRepository_DeleteByGroup_Trash(t *testing.T) {
+	ctx := context.Background()
+	locs := useLocations(t, 2)
+
+	// locs[1] -> locs[0]
+	_, err := tRepos.Locations.UpdateByGroup(ctx, tGroup.ID, locs[0].ID, LocationUpdate{
+		ID:       locs[0].ID,
+		ParentID: locs[1].ID,
+		Name:     locs[0].Name,
+	})
+	require.NoError(t, err)
+
+	itm, err := tRepos.Items.Create(ctx, tGroup.ID, ItemCreate{
+		Name:       fk.Str(10),
+		LocationID: locs[0].ID,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = tRepos.Items.Delete(context.Background(), itm.ID)
+	})
+
+	err = tRepos.Locations.DeleteByGroup(ctx, tGroup.ID, locs[1].ID)
+	require.NoError(t, err)
+
+	// Child locations and their items are trashed with the location
+	_, err = tRepos.Locations.GetOneByGroup(ctx, tGroup.ID, locs[0].ID)
+	require.Error(t, err)
+
+	_, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.Error(t, err)
+
+	tree, err := tRepos.Locations.Tree(ctx, tGroup.ID, TreeQuery{WithItems: true})
+	require.NoError(t, err)
+	for _, node := range tree {
+		assert.NotEqual(t, locs[1].ID, node.ID)
+	}
+
+	all, err := tRepos.Locations.GetAll(ctx, tGroup.ID, LocationQuery{})
+	require.NoError(t, err)
+	for _, loc := range all {
+		assert.NotContains(t, []uuid.UUID{locs[0].ID, locs[1].ID}, loc.ID)
+	}
+
+	trash, err := tRepos.Locations.GetTrash(ctx, tGroup.ID)
+	require.NoError(t, err)
+	assert.Len(t, trash, 2)
+
+	// Children can't be restored while their parent is in the trash
+	_, err = tRepos.Locations.Restore(ctx, tGroup.ID, locs[0].ID)
+	require.ErrorIs(t, err, ErrLocationTrashed)
+
+	_, err = tRepos.Items.Restore(ctx, tGroup.ID, itm.ID)
+	require.ErrorIs(t, err, ErrLocationTrashed)
+
+	_, err = tRepos.Locations.Restore(ctx, tGroup.ID, locs[1].ID)
+	require.NoError(t, err)
+
+	_, err = tRepos.Locations.GetOneByGroup(ctx, tGroup.ID, locs[0].ID)
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, itm.ID)
+	require.NoError(t, err)
+}
+
+func TestLocationRepository_Restore_KeepsEarlierTrash(t *testing.T) {
+	ctx := context.Background()
+	loc := useLocations(t, 1)[0]
+
+	items := make([]ItemOut, 2)
+	for i := range items {
+		itm, err := tRepos.Items.Create(ctx, tGroup.ID, ItemCreate{
+			Name:       fk.Str(10),
+			LocationID: loc.ID,
+		})
+		require.NoError(t, err)
+		items[i] = itm
+	}
+
+	t.Cleanup(func() {
+		for _, itm := range items {
+			_ = tRepos.Items.Delete(context.Background(), itm.ID)
+		}
+	})
+
+	// Trashed on its own before the location
+	err := tRepos.Items.DeleteByGroup(ctx, tGroup.ID, items[0].ID)
+	require.NoError(t, err)
+
+	err = tRepos.Locations.DeleteByGroup(ctx, tGroup.ID, loc.ID)
+	require.NoError(t, err)
+
+	_, err = tRepos.Locations.Restore(ctx, tGroup.ID, loc.ID)
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, items[0].ID)
+	require.Error(t, err)
+
+	_, err = tRepos.Items.GetOneByGroup(ctx, tGroup.ID, items[1].ID)
+	require.NoError(t, err)
+}
@@ -5,19 +5,23 @@ import (
 	"errors"
 	"time"
 
+	"entgo.io/ent/dialect"
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
 	"github.com/hay-kot/homebox/backend/internal/data/types"
+	"github.com/hay-kot/homebox/backend/pkgs/recurrence"
 )
 
 // MaintenanceEntryRepository is a repository for maintenance entries that are
 // associated with an item in the database. An entry represents a maintenance event
 // that has been performed on an item.
 type MaintenanceEntryRepository struct {
-	db *ent.Client
+	db     *ent.Client
+	search *SearchRepository
 }
 
 type MaintenanceEntryCreate struct {
@@ -58,6 +62,17 @@ type (
 		Name          string     `json:"name"`
 		Description   string     `json:"description"`
 		Cost          float64    `json:"cost,string"`
+		ScheduleID    *uuid.UUID `json:"scheduleId,omitempty" extensions:"x-nullable"`
+	}
+
+	// MaintenanceEntryWithDetails is a maintenance entry together with the item it
+	// belongs to and the location of the item.
+	MaintenanceEntryWithDetails struct {
+		MaintenanceEntry
+		ItemID       uuid.UUID `json:"itemId"`
+		ItemName     string    `json:"itemName"`
+		LocationID   uuid.UUID `json:"locationId"`
+		LocationName string    `json:"locationName"`
 	}
 
 	MaintenanceLog struct {
@@ -69,8 +84,9 @@ type (
 )
 
 var (
-	mapMaintenanceEntryErr  = mapTErrFunc(mapMaintenanceEntry)
-	mapEachMaintenanceEntry = mapTEachFunc(mapMaintenanceEntry)
+	mapMaintenanceEntryErr             = mapTErrFunc(mapMaintenanceEntry)
+	mapEachMaintenanceEntry            = mapTEachFunc(mapMaintenanceEntry)
+	mapEachMaintenanceEntryWithDetails = mapTEachFunc(mapMaintenanceEntryWithDetails)
 )
 
 func mapMaintenanceEntry(entry *ent.MaintenanceEntry) MaintenanceEntry {
@@ -81,28 +97,92 @@ func mapMaintenanceEntry(entry *ent.MaintenanceEntry) MaintenanceEntry {
 		Name:          entry.Name,
 		Description:   entry.Description,
 		Cost:          entry.Cost,
+		ScheduleID:    entry.ScheduleID,
+	}
+}
+
+func mapMaintenanceEntryWithDetails(entry *ent.MaintenanceEntry) MaintenanceEntryWithDetails {
+	out := MaintenanceEntryWithDetails{
+		MaintenanceEntry: mapMaintenanceEntry(entry),
+		ItemID:           entry.ItemID,
+	}
+
+	if entry.Edges.Item != nil {
+		out.ItemName = entry.Edges.Item.Name
+
+		if loc := entry.Edges.Item.Edges.Location; loc != nil {
+			out.LocationID = loc.ID
+			out.LocationName = loc.Name
+		}
 	}
+
+	return out
+}
+
+func withItemLocation(q *ent.ItemQuery) {
+	q.WithLocation()
 }
 
-func (r *MaintenanceEntryRepository) GetScheduled(ctx context.Context, GID uuid.UUID, dt types.Date) ([]MaintenanceEntry, error) {
+func (r *MaintenanceEntryRepository) GetScheduled(ctx context.Context, GID uuid.UUID, dt types.Date) ([]MaintenanceEntryWithDetails, error) {
+	return r.GetScheduledBetween(ctx, GID, dt, types.DateFromTime(dt.Time().AddDate(0, 0, 1)))
+}
+
+// GetScheduledBetween returns the open entries scheduled from start up to, but not
+// including, end ordered by their scheduled date.
+func (r *MaintenanceEntryRepository) GetScheduledBetween(ctx context.Context, GID uuid.UUID, start, end types.Date) ([]MaintenanceEntryWithDetails, error) {
 	entries, err := r.db.MaintenanceEntry.Query().
 		Where(
 			maintenanceentry.HasItemWith(
 				item.HasGroupWith(group.ID(GID)),
+				item.DeletedAtIsNil(),
 			),
-			maintenanceentry.ScheduledDate(dt.Time()),
+			maintenanceentry.ScheduledDateGTE(start.Time()),
+			maintenanceentry.ScheduledDateLT(end.Time()),
 			maintenanceentry.Or(
 				maintenanceentry.DateIsNil(),
 				maintenanceentry.DateEQ(time.Time{}),
 			),
 		).
+		WithItem(withItemLocation).
+		Order(ent.Asc(maintenanceentry.FieldScheduledDate)).
 		All(ctx)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return mapEachMaintenanceEntry(entries), nil
+	return mapEachMaintenanceEntryWithDetails(entries), nil
+}
+
+// GetReminders returns the open entries of schedules that notify ahead of time and are
+// due exactly the configured number of days after the given date.
+func (r *MaintenanceEntryRepository) GetReminders(ctx context.Context, GID uuid.UUID, dt types.Date) ([]MaintenanceEntryWithDetails, error) {
+	entries, err := r.db.MaintenanceEntry.Query().
+		Where(
+			maintenanceentry.HasItemWith(
+				item.HasGroupWith(group.ID(GID)),
+				item.DeletedAtIsNil(),
+			),
+			maintenanceentry.HasScheduleWith(maintenanceschedule.NotifyDaysBeforeGT(0)),
+			maintenanceEntryOpen(),
+			maintenanceentry.ScheduledDateGT(dt.Time()),
+		).
+		WithSchedule().
+		WithItem(withItemLocation).
+		Order(ent.Asc(maintenanceentry.FieldScheduledDate)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	due := make([]*ent.MaintenanceEntry, 0, len(entries))
+	for _, e := range entries {
+		if e.ScheduledDate.Equal(dt.Time().AddDate(0, 0, e.Edges.Schedule.NotifyDaysBefore)) {
+			due = append(due, e)
+		}
+	}
+
+	return mapEachMaintenanceEntryWithDetails(due), nil
 }
 
 func (r *MaintenanceEntryRepository) Create(ctx context.Context, itemID uuid.UUID, input MaintenanceEntryCreate) (MaintenanceEntry, error) {
@@ -114,11 +194,20 @@ func (r *MaintenanceEntryRepository) Create(ctx context.Context, itemID uuid.UUI
 		SetDescription(input.Description).
 		SetCost(input.Cost).
 		Save(ctx)
+	if err != nil {
+		return MaintenanceEntry{}, err
+	}
 
+	err = r.search.index(ctx, itemID)
 	return mapMaintenanceEntryErr(item, err)
 }
 
 func (r *MaintenanceEntryRepository) Update(ctx context.Context, ID uuid.UUID, input MaintenanceEntryUpdate) (MaintenanceEntry, error) {
+	before, err := r.db.MaintenanceEntry.Get(ctx, ID)
+	if err != nil {
+		return MaintenanceEntry{}, err
+	}
+
 	item, err := r.db.MaintenanceEntry.UpdateOneID(ID).
 		SetDate(input.CompletedDate.Time()).
 		SetScheduledDate(input.ScheduledDate.Time()).
@@ -126,10 +215,50 @@ func (r *MaintenanceEntryRepository) Update(ctx context.Context, ID uuid.UUID, i
 		SetDescription(input.Description).
 		SetCost(input.Cost).
 		Save(ctx)
+	if err != nil {
+		return MaintenanceEntry{}, err
+	}
 
+	if item.ScheduleID != nil && before.Date.IsZero() && !item.Date.IsZero() {
+		err = r.scheduleNext(ctx, *item.ScheduleID, item.Date)
+		if err != nil {
+			return MaintenanceEntry{}, err
+		}
+	}
+
+	err = r.search.index(ctx, item.ItemID)
 	return mapMaintenanceEntryErr(item, err)
 }
 
+// scheduleNext creates the next open entry of a schedule after one of its entries was
+// completed, nothing is created while the schedule still has an open entry.
+func (r *MaintenanceEntryRepository) scheduleNext(ctx context.Context, scheduleID uuid.UUID, completed time.Time) error {
+	schedule, err := r.db.MaintenanceSchedule.Get(ctx, scheduleID)
+	if err != nil {
+		return err
+	}
+
+	open, err := r.db.MaintenanceEntry.Query().
+		Where(maintenanceentry.ScheduleID(scheduleID), maintenanceEntryOpen()).
+		Exist(ctx)
+	if err != nil || open {
+		return err
+	}
+
+	rule, err := recurrence.Parse(schedule.Rule)
+	if err != nil {
+		return err
+	}
+
+	return r.db.MaintenanceEntry.Create().
+		SetItemID(schedule.ItemID).
+		SetScheduleID(schedule.ID).
+		SetName(schedule.Name).
+		SetDescription(schedule.Description).
+		SetScheduledDate(rule.Next(completed)).
+		Exec(ctx)
+}
+
 type MaintenanceLogQuery struct {
 	Completed bool `json:"completed" schema:"completed"`
 	Scheduled bool `json:"scheduled" schema:"scheduled"`
@@ -174,6 +303,13 @@ func (r *MaintenanceEntryRepository) GetLog(ctx context.Context, groupID, itemID
 	var maybeTotal *float64
 	var maybeAverage *float64
 
+	d := r.db.Dialect()
+
+	monthYear := "strftime('%m-%Y', date)"
+	if d == dialect.Postgres {
+		monthYear = "to_char(date, 'MM-YYYY')"
+	}
+
 	statement := `
 SELECT
   SUM(cost_total) AS total_of_totals,
@@ -181,7 +317,7 @@ SELECT
 FROM
   (
     SELECT
-      strftime('%m-%Y', date) AS my,
+      ` + monthYear + ` AS my,
       SUM(cost) AS cost_total
     FROM
       maintenance_entries
@@ -189,9 +325,9 @@ FROM
       item_id = ?
     GROUP BY
       my
-  )`
+  ) AS monthly_costs`
 
-	row := r.db.Sql().QueryRowContext(ctx, statement, itemID)
+	row := r.db.Sql().QueryRowContext(ctx, rebind(d, statement), itemID)
 	err = row.Scan(&maybeTotal, &maybeAverage)
 	if err != nil {
 		return MaintenanceLog{}, err
@@ -203,5 +339,15 @@ FROM
 }
 
 func (r *MaintenanceEntryRepository) Delete(ctx context.Context, ID uuid.UUID) error {
-	return r.db.MaintenanceEntry.DeleteOneID(ID).Exec(ctx)
+	entry, err := r.db.MaintenanceEntry.Get(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	err = r.db.MaintenanceEntry.DeleteOneID(ID).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.search.index(ctx, entry.ItemID)
 }
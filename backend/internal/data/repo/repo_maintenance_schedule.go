@@ -0,0 +1,303 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceschedule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+	"github.com/hay-kot/homebox/backend/pkgs/recurrence"
+)
+
+// MaintenanceScheduleRepository is a repository for recurring maintenance of an item.
+// A schedule always keeps a single open maintenance entry for the next due date,
+// completing that entry creates the next one counted from the completion date.
+type MaintenanceScheduleRepository struct {
+	db     *ent.Client
+	search *SearchRepository
+}
+
+type (
+	MaintenanceScheduleCreate struct {
+		Name             string     `json:"name"             validate:"required,max=255"`
+		Description      string     `json:"description"      validate:"max=2500"`
+		Rule             string     `json:"rule"             validate:"required,recurrence"`
+		StartDate        types.Date `json:"startDate"`
+		NotifyDaysBefore int        `json:"notifyDaysBefore" validate:"min=0,max=365"`
+	}
+
+	MaintenanceScheduleUpdate struct {
+		Name             string     `json:"name"             validate:"required,max=255"`
+		Description      string     `json:"description"      validate:"max=2500"`
+		Rule             string     `json:"rule"             validate:"required,recurrence"`
+		NextDue          types.Date `json:"nextDue"`
+		NotifyDaysBefore int        `json:"notifyDaysBefore" validate:"min=0,max=365"`
+	}
+
+	MaintenanceSchedule struct {
+		ID               uuid.UUID  `json:"id"`
+		ItemID           uuid.UUID  `json:"itemId"`
+		Name             string     `json:"name"`
+		Description      string     `json:"description"`
+		Rule             string     `json:"rule"`
+		NotifyDaysBefore int        `json:"notifyDaysBefore"`
+		LastCompleted    types.Date `json:"lastCompleted"`
+		NextDue          types.Date `json:"nextDue"`
+		CreatedAt        time.Time  `json:"createdAt"`
+		UpdatedAt        time.Time  `json:"updatedAt"`
+	}
+)
+
+var (
+	mapMaintenanceScheduleErr  = mapTErrFunc(mapMaintenanceSchedule)
+	mapEachMaintenanceSchedule = mapTEachErrFunc(mapMaintenanceSchedule)
+)
+
+func mapMaintenanceSchedule(s *ent.MaintenanceSchedule) MaintenanceSchedule {
+	out := MaintenanceSchedule{
+		ID:               s.ID,
+		ItemID:           s.ItemID,
+		Name:             s.Name,
+		Description:      s.Description,
+		Rule:             s.Rule,
+		NotifyDaysBefore: s.NotifyDaysBefore,
+		CreatedAt:        s.CreatedAt,
+		UpdatedAt:        s.UpdatedAt,
+	}
+
+	for _, e := range s.Edges.Entries {
+		switch {
+		case !e.Date.IsZero():
+			if e.Date.After(out.LastCompleted.Time()) {
+				out.LastCompleted = types.Date(e.Date)
+			}
+		case !e.ScheduledDate.IsZero():
+			if out.NextDue.Time().IsZero() || e.ScheduledDate.Before(out.NextDue.Time()) {
+				out.NextDue = types.Date(e.ScheduledDate)
+			}
+		}
+	}
+
+	return out
+}
+
+// maintenanceEntryOpen matches entries that are scheduled but not completed yet.
+func maintenanceEntryOpen() predicate.MaintenanceEntry {
+	return maintenanceentry.And(
+		maintenanceentry.Or(
+			maintenanceentry.DateIsNil(),
+			maintenanceentry.DateEQ(time.Time{}),
+		),
+		maintenanceentry.ScheduledDateNotNil(),
+		maintenanceentry.ScheduledDateNEQ(time.Time{}),
+	)
+}
+
+func (r *MaintenanceScheduleRepository) byGroup(GID uuid.UUID) predicate.MaintenanceSchedule {
+	return maintenanceschedule.HasItemWith(
+		item.HasGroupWith(group.ID(GID)),
+		item.DeletedAtIsNil(),
+	)
+}
+
+func (r *MaintenanceScheduleRepository) GetAll(ctx context.Context, GID, itemID uuid.UUID) ([]MaintenanceSchedule, error) {
+	return mapEachMaintenanceSchedule(r.db.MaintenanceSchedule.Query().
+		Where(
+			maintenanceschedule.ItemID(itemID),
+			r.byGroup(GID),
+		).
+		WithEntries().
+		Order(ent.Asc(maintenanceschedule.FieldName)).
+		All(ctx),
+	)
+}
+
+func (r *MaintenanceScheduleRepository) GetOneByGroup(ctx context.Context, GID, ID uuid.UUID) (MaintenanceSchedule, error) {
+	return mapMaintenanceScheduleErr(r.db.MaintenanceSchedule.Query().
+		Where(
+			maintenanceschedule.ID(ID),
+			r.byGroup(GID),
+		).
+		WithEntries().
+		Only(ctx),
+	)
+}
+
+// Create adds a schedule to the item and creates its first open entry, the first entry is
+// due on the start date or today, in the timezone of the group, when no start date is given.
+func (r *MaintenanceScheduleRepository) Create(ctx context.Context, GID, itemID uuid.UUID, data MaintenanceScheduleCreate) (MaintenanceSchedule, error) {
+	rule, err := recurrence.Parse(data.Rule)
+	if err != nil {
+		return MaintenanceSchedule{}, err
+	}
+
+	itm, err := r.db.Item.Query().
+		Where(item.ID(itemID), item.HasGroupWith(group.ID(GID)), item.DeletedAtIsNil()).
+		WithGroup().
+		Only(ctx)
+	if err != nil {
+		return MaintenanceSchedule{}, err
+	}
+
+	start := data.StartDate
+	if start.Time().IsZero() {
+		start = types.Today(groupLocation(itm.Edges.Group))
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return MaintenanceSchedule{}, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	schedule, err := tx.MaintenanceSchedule.Create().
+		SetItemID(itemID).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetRule(rule.String()).
+		SetNotifyDaysBefore(data.NotifyDaysBefore).
+		Save(ctx)
+	if err != nil {
+		return MaintenanceSchedule{}, err
+	}
+
+	_, err = tx.MaintenanceEntry.Create().
+		SetItemID(itemID).
+		SetScheduleID(schedule.ID).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetScheduledDate(start.Time()).
+		Save(ctx)
+	if err != nil {
+		return MaintenanceSchedule{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return MaintenanceSchedule{}, err
+	}
+
+	err = r.search.index(ctx, itemID)
+	if err != nil {
+		return MaintenanceSchedule{}, err
+	}
+
+	return r.GetOneByGroup(ctx, GID, schedule.ID)
+}
+
+// UpdateByGroup updates the schedule and its open entry. When the rule changes the open
+// entry is moved to the next occurrence after the last completion, an explicit NextDue
+// date takes precedence and reopens a schedule without an open entry.
+func (r *MaintenanceScheduleRepository) UpdateByGroup(ctx context.Context, GID, ID uuid.UUID, data MaintenanceScheduleUpdate) (MaintenanceSchedule, error) {
+	rule, err := recurrence.Parse(data.Rule)
+	if err != nil {
+		return MaintenanceSchedule{}, err
+	}
+
+	before, err := r.GetOneByGroup(ctx, GID, ID)
+	if err != nil {
+		return MaintenanceSchedule{}, err
+	}
+
+	nextDue := data.NextDue.Time()
+	if nextDue.IsZero() && rule.String() != before.Rule && !before.LastCompleted.Time().IsZero() {
+		nextDue = rule.Next(before.LastCompleted.Time())
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return MaintenanceSchedule{}, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.MaintenanceSchedule.UpdateOneID(ID).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetRule(rule.String()).
+		SetNotifyDaysBefore(data.NotifyDaysBefore).
+		Save(ctx)
+	if err != nil {
+		return MaintenanceSchedule{}, err
+	}
+
+	q := tx.MaintenanceEntry.Update().
+		Where(maintenanceentry.ScheduleID(ID), maintenanceEntryOpen()).
+		SetName(data.Name).
+		SetDescription(data.Description)
+
+	if !nextDue.IsZero() {
+		q.SetScheduledDate(nextDue)
+	}
+
+	n, err := q.Save(ctx)
+	if err != nil {
+		return MaintenanceSchedule{}, err
+	}
+
+	if n == 0 && !data.NextDue.Time().IsZero() {
+		_, err = tx.MaintenanceEntry.Create().
+			SetItemID(before.ItemID).
+			SetScheduleID(ID).
+			SetName(data.Name).
+			SetDescription(data.Description).
+			SetScheduledDate(data.NextDue.Time()).
+			Save(ctx)
+		if err != nil {
+			return MaintenanceSchedule{}, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return MaintenanceSchedule{}, err
+	}
+
+	err = r.search.index(ctx, before.ItemID)
+	if err != nil {
+		return MaintenanceSchedule{}, err
+	}
+
+	return r.GetOneByGroup(ctx, GID, ID)
+}
+
+// DeleteByGroup removes the schedule and its open entry, completed entries are kept in
+// the maintenance log.
+func (r *MaintenanceScheduleRepository) DeleteByGroup(ctx context.Context, GID, ID uuid.UUID) error {
+	schedule, err := r.db.MaintenanceSchedule.Query().
+		Where(maintenanceschedule.ID(ID), r.byGroup(GID)).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.MaintenanceEntry.Delete().
+		Where(maintenanceentry.ScheduleID(ID), maintenanceEntryOpen()).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.MaintenanceSchedule.DeleteOneID(ID).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return r.search.index(ctx, schedule.ItemID)
+}
@@ -0,0 +1,100 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceScheduleRepository(t *testing.T) {
+	ctx := context.Background()
+	item := useItems(t, 1)[0]
+
+	schedule, err := tRepos.MaintSchedules.Create(ctx, tGroup.ID, item.ID, MaintenanceScheduleCreate{
+		Name:             "Replace filter",
+		Rule:             "every 90 days",
+		StartDate:        types.DateFromString("2024-01-01"),
+		NotifyDaysBefore: 7,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "FREQ=DAILY;INTERVAL=90", schedule.Rule)
+	assert.Equal(t, "2024-01-01", schedule.NextDue.String())
+	assert.True(t, schedule.LastCompleted.Time().IsZero())
+
+	log, err := tRepos.MaintEntry.GetLog(ctx, tGroup.ID, item.ID, MaintenanceLogQuery{Scheduled: true})
+	require.NoError(t, err)
+	require.Len(t, log.Entries, 1)
+	require.NotNil(t, log.Entries[0].ScheduleID)
+	assert.Equal(t, schedule.ID, *log.Entries[0].ScheduleID)
+
+	// Reminders are sent the configured number of days before the due date
+	due, err := tRepos.MaintEntry.GetReminders(ctx, tGroup.ID, types.DateFromString("2023-12-25"))
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, "Replace filter", due[0].Name)
+	assert.Equal(t, item.ID, due[0].ItemID)
+	assert.Equal(t, item.Name, due[0].ItemName)
+
+	due, err = tRepos.MaintEntry.GetReminders(ctx, tGroup.ID, types.DateFromString("2023-12-24"))
+	require.NoError(t, err)
+	assert.Empty(t, due)
+
+	// Completing the entry schedules the next one from the completion date
+	_, err = tRepos.MaintEntry.Update(ctx, log.Entries[0].ID, MaintenanceEntryUpdate{
+		CompletedDate: types.DateFromString("2024-01-10"),
+		ScheduledDate: log.Entries[0].ScheduledDate,
+		Name:          "Replace filter",
+	})
+	require.NoError(t, err)
+
+	schedule, err = tRepos.MaintSchedules.GetOneByGroup(ctx, tGroup.ID, schedule.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-10", schedule.LastCompleted.String())
+	assert.Equal(t, "2024-04-09", schedule.NextDue.String())
+
+	// Changing the rule moves the open entry
+	schedule, err = tRepos.MaintSchedules.UpdateByGroup(ctx, tGroup.ID, schedule.ID, MaintenanceScheduleUpdate{
+		Name: "Replace air filter",
+		Rule: "monthly",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-02-10", schedule.NextDue.String())
+
+	schedule, err = tRepos.MaintSchedules.UpdateByGroup(ctx, tGroup.ID, schedule.ID, MaintenanceScheduleUpdate{
+		Name:    "Replace air filter",
+		Rule:    "monthly",
+		NextDue: types.DateFromString("2024-03-01"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-01", schedule.NextDue.String())
+
+	log, err = tRepos.MaintEntry.GetLog(ctx, tGroup.ID, item.ID, MaintenanceLogQuery{Scheduled: true})
+	require.NoError(t, err)
+	require.Len(t, log.Entries, 1)
+	assert.Equal(t, "Replace air filter", log.Entries[0].Name)
+
+	// Schedules are scoped to the group of the item
+	_, err = tRepos.MaintSchedules.GetOneByGroup(ctx, uuid.New(), schedule.ID)
+	require.Error(t, err)
+
+	_, err = tRepos.MaintSchedules.Create(ctx, uuid.New(), item.ID, MaintenanceScheduleCreate{Name: "Other", Rule: "weekly"})
+	require.Error(t, err)
+
+	// Deleting the schedule keeps the completed entries
+	err = tRepos.MaintSchedules.DeleteByGroup(ctx, tGroup.ID, schedule.ID)
+	require.NoError(t, err)
+
+	log, err = tRepos.MaintEntry.GetLog(ctx, tGroup.ID, item.ID, MaintenanceLogQuery{})
+	require.NoError(t, err)
+	require.Len(t, log.Entries, 1)
+	assert.Equal(t, "2024-01-10", log.Entries[0].CompletedDate.String())
+	assert.Nil(t, log.Entries[0].ScheduleID)
+
+	all, err := tRepos.MaintSchedules.GetAll(ctx, tGroup.ID, item.ID)
+	require.NoError(t, err)
+	assert.Empty(t, all)
+}
@@ -0,0 +1,440 @@
+package repo
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemfield"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationreminder"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationrule"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// NotificationRuleRepository stores the notification rules of a group and evaluates
+// them against the dates of its items. Every reminder that is sent is recorded so
+// that it is never sent twice.
+type NotificationRuleRepository struct {
+	db *ent.Client
+}
+
+type (
+	NotificationRuleCreate struct {
+		Name      string `json:"name"      validate:"required,min=1,max=255"`
+		Kind      string `json:"kind"      validate:"required,oneof=warranty maintenance field"`
+		FieldName string `json:"fieldName" validate:"required_if=Kind field,max=255"`
+		LeadDays  []int  `json:"leadDays"  validate:"required,min=1,max=10,dive,min=0,max=365"`
+		IsActive  bool   `json:"isActive"`
+	}
+
+	NotificationRuleUpdate struct {
+		Name      string `json:"name"      validate:"required,min=1,max=255"`
+		Kind      string `json:"kind"      validate:"required,oneof=warranty maintenance field"`
+		FieldName string `json:"fieldName" validate:"required_if=Kind field,max=255"`
+		LeadDays  []int  `json:"leadDays"  validate:"required,min=1,max=10,dive,min=0,max=365"`
+		IsActive  bool   `json:"isActive"`
+	}
+
+	NotificationRuleOut struct {
+		ID        uuid.UUID `json:"id"`
+		Name      string    `json:"name"`
+		Kind      string    `json:"kind"`
+		FieldName string    `json:"fieldName"`
+		LeadDays  []int     `json:"leadDays"`
+		IsActive  bool      `json:"isActive"`
+		CreatedAt time.Time `json:"createdAt"`
+		UpdatedAt time.Time `json:"updatedAt"`
+	}
+
+	// NotificationReminder is a reminder of a rule for a date of an item, either due to
+	// be sent or already sent.
+	NotificationReminder struct {
+		RuleID       uuid.UUID  `json:"ruleId"`
+		RuleName     string     `json:"ruleName"`
+		Kind         string     `json:"kind"`
+		ItemID       uuid.UUID  `json:"itemId"`
+		ItemName     string     `json:"itemName"`
+		LocationID   uuid.UUID  `json:"locationId"`
+		LocationName string     `json:"locationName"`
+		Target       string     `json:"target"`
+		Title        string     `json:"title"`
+		DueDate      types.Date `json:"dueDate"`
+		LeadDays     int        `json:"leadDays"`
+		DaysLeft     int        `json:"daysLeft"`
+	}
+
+	NotificationReminderOut struct {
+		ID       uuid.UUID  `json:"id"`
+		RuleID   uuid.UUID  `json:"ruleId"`
+		ItemID   uuid.UUID  `json:"itemId"`
+		Target   string     `json:"target"`
+		Title    string     `json:"title"`
+		DueDate  types.Date `json:"dueDate"`
+		LeadDays int        `json:"leadDays"`
+		SentAt   time.Time  `json:"sentAt"`
+	}
+
+	NotificationReminderQuery struct {
+		Page     int `json:"page"     schema:"page"`
+		PageSize int `json:"pageSize" schema:"pageSize"`
+	}
+)
+
+var (
+	mapNotificationRuleOutErr = mapTErrFunc(mapNotificationRuleOut)
+	mapNotificationRulesOut   = mapTEachErrFunc(mapNotificationRuleOut)
+	mapNotificationReminders  = mapTEachFunc(mapNotificationReminderOut)
+)
+
+func mapNotificationRuleOut(r *ent.NotificationRule) NotificationRuleOut {
+	return NotificationRuleOut{
+		ID:        r.ID,
+		Name:      r.Name,
+		Kind:      r.Kind.String(),
+		FieldName: r.CustomField,
+		LeadDays:  r.LeadDays,
+		IsActive:  r.IsActive,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+func mapNotificationReminderOut(r *ent.NotificationReminder) NotificationReminderOut {
+	return NotificationReminderOut{
+		ID:       r.ID,
+		RuleID:   r.RuleID,
+		ItemID:   r.ItemID,
+		Target:   r.Target,
+		Title:    r.Title,
+		DueDate:  types.Date(r.DueDate),
+		LeadDays: r.LeadDays,
+		SentAt:   r.CreatedAt,
+	}
+}
+
+// normalizeLeadDays sorts the lead days from the earliest reminder to the latest and
+// removes duplicates.
+func normalizeLeadDays(days []int) []int {
+	out := slices.Clone(days)
+	slices.Sort(out)
+	out = slices.Compact(out)
+	slices.Reverse(out)
+	return out
+}
+
+func (r *NotificationRuleRepository) GetAll(ctx context.Context, GID uuid.UUID) ([]NotificationRuleOut, error) {
+	return mapNotificationRulesOut(r.db.NotificationRule.Query().
+		Where(notificationrule.GroupID(GID)).
+		Order(ent.Asc(notificationrule.FieldName)).
+		All(ctx),
+	)
+}
+
+func (r *NotificationRuleRepository) GetOneByGroup(ctx context.Context, GID, ID uuid.UUID) (NotificationRuleOut, error) {
+	return mapNotificationRuleOutErr(r.db.NotificationRule.Query().
+		Where(notificationrule.GroupID(GID), notificationrule.ID(ID)).
+		Only(ctx),
+	)
+}
+
+func (r *NotificationRuleRepository) Create(ctx context.Context, GID uuid.UUID, data NotificationRuleCreate) (NotificationRuleOut, error) {
+	return mapNotificationRuleOutErr(r.db.NotificationRule.Create().
+		SetGroupID(GID).
+		SetName(data.Name).
+		SetKind(notificationrule.Kind(data.Kind)).
+		SetCustomField(data.FieldName).
+		SetLeadDays(normalizeLeadDays(data.LeadDays)).
+		SetIsActive(data.IsActive).
+		Save(ctx),
+	)
+}
+
+func (r *NotificationRuleRepository) UpdateByGroup(ctx context.Context, GID, ID uuid.UUID, data NotificationRuleUpdate) (NotificationRuleOut, error) {
+	rule, err := r.db.NotificationRule.Query().
+		Where(notificationrule.GroupID(GID), notificationrule.ID(ID)).
+		Only(ctx)
+	if err != nil {
+		return NotificationRuleOut{}, err
+	}
+
+	return mapNotificationRuleOutErr(rule.Update().
+		SetName(data.Name).
+		SetKind(notificationrule.Kind(data.Kind)).
+		SetCustomField(data.FieldName).
+		SetLeadDays(normalizeLeadDays(data.LeadDays)).
+		SetIsActive(data.IsActive).
+		Save(ctx),
+	)
+}
+
+func (r *NotificationRuleRepository) DeleteByGroup(ctx context.Context, GID, ID uuid.UUID) error {
+	rule, err := r.db.NotificationRule.Query().
+		Where(notificationrule.GroupID(GID), notificationrule.ID(ID)).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.db.NotificationRule.DeleteOne(rule).Exec(ctx)
+}
+
+// GetReminders returns the reminders that were sent for the group, newest first.
+func (r *NotificationRuleRepository) GetReminders(ctx context.Context, GID uuid.UUID, q NotificationReminderQuery) (PaginationResult[NotificationReminderOut], error) {
+	if q.Page <= 0 {
+		q.Page = 1
+	}
+
+	if q.PageSize <= 0 || q.PageSize > 100 {
+		q.PageSize = 50
+	}
+
+	qb := r.db.NotificationReminder.Query().
+		Where(notificationreminder.GroupID(GID))
+
+	count, err := qb.Count(ctx)
+	if err != nil {
+		return PaginationResult[NotificationReminderOut]{}, err
+	}
+
+	reminders, err := qb.
+		Order(ent.Desc(notificationreminder.FieldCreatedAt)).
+		Offset(calculateOffset(q.Page, q.PageSize)).
+		Limit(q.PageSize).
+		All(ctx)
+	if err != nil {
+		return PaginationResult[NotificationReminderOut]{}, err
+	}
+
+	return PaginationResult[NotificationReminderOut]{
+		Page:     q.Page,
+		PageSize: q.PageSize,
+		Total:    count,
+		Items:    mapNotificationReminders(reminders),
+	}, nil
+}
+
+// reminderTarget is a date of an item watched by a rule.
+type reminderTarget struct {
+	item   *ent.Item
+	target string
+	title  string
+	due    time.Time
+}
+
+// Pending evaluates the active rules of the group and returns the reminders that are due
+// on the given day and were not sent yet. For every date the latest lead time that has
+// been reached is used, so a date that enters the window late only gets one reminder
+// instead of all the earlier ones at once.
+func (r *NotificationRuleRepository) Pending(ctx context.Context, GID uuid.UUID, today types.Date) ([]NotificationReminder, error) {
+	rules, err := r.db.NotificationRule.Query().
+		Where(notificationrule.GroupID(GID), notificationrule.IsActive(true)).
+		Order(ent.Asc(notificationrule.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start := today.Time()
+
+	var pending []NotificationReminder
+	for _, rule := range rules {
+		if len(rule.LeadDays) == 0 {
+			continue
+		}
+
+		end := start.AddDate(0, 0, slices.Max(rule.LeadDays)+1)
+
+		targets, err := r.targets(ctx, GID, rule, start, end)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(targets) == 0 {
+			continue
+		}
+
+		sent, err := r.db.NotificationReminder.Query().
+			Where(
+				notificationreminder.RuleID(rule.ID),
+				notificationreminder.DueDateGTE(start),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		type key struct {
+			itemID uuid.UUID
+			target string
+			due    string
+			lead   int
+		}
+
+		seen := make(map[key]struct{}, len(sent))
+		for _, s := range sent {
+			seen[key{s.ItemID, s.Target, types.DateFromTime(s.DueDate).String(), s.LeadDays}] = struct{}{}
+		}
+
+		for _, t := range targets {
+			due := types.DateFromTime(t.due)
+			daysLeft := int(due.Time().Sub(start).Hours() / 24)
+
+			lead := -1
+			for _, l := range rule.LeadDays {
+				if l >= daysLeft {
+					lead = l
+				}
+			}
+
+			if lead < 0 {
+				continue
+			}
+
+			if _, ok := seen[key{t.item.ID, t.target, due.String(), lead}]; ok {
+				continue
+			}
+
+			rem := NotificationReminder{
+				RuleID:   rule.ID,
+				RuleName: rule.Name,
+				Kind:     rule.Kind.String(),
+				ItemID:   t.item.ID,
+				ItemName: t.item.Name,
+				Target:   t.target,
+				Title:    t.title,
+				DueDate:  due,
+				LeadDays: lead,
+				DaysLeft: daysLeft,
+			}
+
+			if loc := t.item.Edges.Location; loc != nil {
+				rem.LocationID = loc.ID
+				rem.LocationName = loc.Name
+			}
+
+			pending = append(pending, rem)
+		}
+	}
+
+	return pending, nil
+}
+
+// targets returns the dates watched by the rule that fall within [start, end).
+func (r *NotificationRuleRepository) targets(ctx context.Context, GID uuid.UUID, rule *ent.NotificationRule, start, end time.Time) ([]reminderTarget, error) {
+	itemInGroup := []predicate.Item{
+		item.HasGroupWith(group.ID(GID)),
+		item.DeletedAtIsNil(),
+		item.Archived(false),
+	}
+
+	var targets []reminderTarget
+
+	switch rule.Kind {
+	case notificationrule.KindWarranty:
+		items, err := r.db.Item.Query().
+			Where(itemInGroup...).
+			Where(
+				item.LifetimeWarranty(false),
+				item.WarrantyExpiresGTE(start),
+				item.WarrantyExpiresLT(end),
+			).
+			WithLocation().
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, it := range items {
+			targets = append(targets, reminderTarget{
+				item:   it,
+				target: "warranty",
+				title:  "Warranty expires",
+				due:    it.WarrantyExpires,
+			})
+		}
+
+	case notificationrule.KindMaintenance:
+		entries, err := r.db.MaintenanceEntry.Query().
+			Where(
+				maintenanceentry.HasItemWith(itemInGroup...),
+				maintenanceEntryOpen(),
+				maintenanceentry.ScheduledDateGTE(start),
+				maintenanceentry.ScheduledDateLT(end),
+			).
+			WithItem(withItemLocation).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range entries {
+			targets = append(targets, reminderTarget{
+				item:   e.Edges.Item,
+				target: e.ID.String(),
+				title:  e.Name,
+				due:    e.ScheduledDate,
+			})
+		}
+
+	case notificationrule.KindField:
+		// Dates are read from time fields and from text fields holding a date, text
+		// fields have to be parsed so the window is applied after loading them.
+		fields, err := r.db.ItemField.Query().
+			Where(
+				itemfield.HasItemWith(itemInGroup...),
+				itemfield.NameEqualFold(rule.CustomField),
+				itemfield.TypeIn(itemfield.TypeTime, itemfield.TypeText),
+			).
+			WithItem(withItemLocation).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, f := range fields {
+			due := f.TimeValue
+			if f.Type == itemfield.TypeText {
+				due = types.DateFromString(f.TextValue).Time()
+			}
+
+			if due.IsZero() || due.Before(start) || !due.Before(end) {
+				continue
+			}
+
+			targets = append(targets, reminderTarget{
+				item:   f.Edges.Item,
+				target: "field:" + f.Name,
+				title:  f.Name,
+				due:    due,
+			})
+		}
+	}
+
+	return targets, nil
+}
+
+// Record stores the reminders as sent, reminders that were already recorded are skipped.
+func (r *NotificationRuleRepository) Record(ctx context.Context, GID uuid.UUID, reminders []NotificationReminder) error {
+	for _, rem := range reminders {
+		err := r.db.NotificationReminder.Create().
+			SetGroupID(GID).
+			SetRuleID(rem.RuleID).
+			SetItemID(rem.ItemID).
+			SetTarget(rem.Target).
+			SetTitle(truncate(rem.Title, 255)).
+			SetDueDate(rem.DueDate.Time()).
+			SetLeadDays(rem.LeadDays).
+			Exec(ctx)
+		if err != nil && !ent.IsConstraintError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
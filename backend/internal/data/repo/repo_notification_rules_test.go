@@ -0,0 +1,129 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationRuleRepository_Pending(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "reminders-"+fk.Str(6))
+	require.NoError(t, err)
+
+	loc, err := tRepos.Locations.Create(ctx, g.ID, LocationCreate{Name: "Office"})
+	require.NoError(t, err)
+
+	laptop, err := tRepos.Items.Create(ctx, g.ID, ItemCreate{Name: "Laptop", LocationID: loc.ID})
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.UpdateByGroup(ctx, g.ID, ItemUpdate{
+		ID:              laptop.ID,
+		Name:            laptop.Name,
+		LocationID:      loc.ID,
+		Quantity:        1,
+		WarrantyExpires: types.DateFromString("2030-01-31"),
+		Fields: []ItemField{
+			{Type: "text", Name: "Calibration", TextValue: "2030-01-03"},
+		},
+	})
+	require.NoError(t, err)
+
+	printer, err := tRepos.Items.Create(ctx, g.ID, ItemCreate{Name: "Printer", LocationID: loc.ID})
+	require.NoError(t, err)
+
+	_, err = tRepos.Items.UpdateByGroup(ctx, g.ID, ItemUpdate{
+		ID:              printer.ID,
+		Name:            printer.Name,
+		LocationID:      loc.ID,
+		Quantity:        1,
+		WarrantyExpires: types.DateFromString("2030-01-06"),
+	})
+	require.NoError(t, err)
+
+	_, err = tRepos.MaintEntry.Create(ctx, printer.ID, MaintenanceEntryCreate{
+		ScheduledDate: types.DateFromString("2030-01-02"),
+		Name:          "Replace toner",
+	})
+	require.NoError(t, err)
+
+	rules := []NotificationRuleCreate{
+		{Name: "Warranty", Kind: "warranty", LeadDays: []int{1, 30, 7, 7}, IsActive: true},
+		{Name: "Calibration", Kind: "field", FieldName: "calibration", LeadDays: []int{7}, IsActive: true},
+		{Name: "Maintenance", Kind: "maintenance", LeadDays: []int{0}, IsActive: true},
+		{Name: "Disabled", Kind: "warranty", LeadDays: []int{60}, IsActive: false},
+	}
+
+	for _, r := range rules {
+		_, err := tRepos.NotificationRules.Create(ctx, g.ID, r)
+		require.NoError(t, err)
+	}
+
+	all, err := tRepos.NotificationRules.GetAll(ctx, g.ID)
+	require.NoError(t, err)
+	require.Len(t, all, 4)
+	assert.Equal(t, []int{7}, all[0].LeadDays) // Calibration
+	assert.Equal(t, []int{30, 7, 1}, all[3].LeadDays)
+
+	type reminder struct {
+		Item     string
+		Title    string
+		LeadDays int
+		DaysLeft int
+	}
+
+	pending := func(day string) []reminder {
+		t.Helper()
+		res, err := tRepos.NotificationRules.Pending(ctx, g.ID, types.DateFromString(day))
+		require.NoError(t, err)
+
+		out := make([]reminder, len(res))
+		for i, r := range res {
+			assert.Equal(t, loc.ID, r.LocationID)
+			assert.Equal(t, "Office", r.LocationName)
+			out[i] = reminder{r.ItemName, r.Title, r.LeadDays, r.DaysLeft}
+		}
+
+		err = tRepos.NotificationRules.Record(ctx, g.ID, res)
+		require.NoError(t, err)
+		return out
+	}
+
+	// Each date gets the latest lead time that has been reached
+	assert.ElementsMatch(t, []reminder{
+		{"Laptop", "Calibration", 7, 2},
+		{"Laptop", "Warranty expires", 30, 30},
+		{"Printer", "Warranty expires", 7, 5},
+	}, pending("2030-01-01"))
+
+	// Reminders are not sent twice
+	assert.ElementsMatch(t, []reminder{
+		{"Printer", "Replace toner", 0, 0},
+	}, pending("2030-01-02"))
+
+	assert.Empty(t, pending("2030-01-02"))
+
+	assert.ElementsMatch(t, []reminder{
+		{"Printer", "Warranty expires", 1, 1},
+	}, pending("2030-01-05"))
+
+	assert.ElementsMatch(t, []reminder{
+		{"Laptop", "Warranty expires", 7, 6},
+	}, pending("2030-01-25"))
+
+	sent, err := tRepos.NotificationRules.GetReminders(ctx, g.ID, NotificationReminderQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, 6, sent.Total)
+
+	// Deleting a rule removes its history
+	err = tRepos.NotificationRules.DeleteByGroup(ctx, g.ID, all[3].ID)
+	require.NoError(t, err)
+
+	sent, err = tRepos.NotificationRules.GetReminders(ctx, g.ID, NotificationReminderQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, sent.Total)
+}
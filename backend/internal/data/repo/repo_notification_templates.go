@@ -0,0 +1,172 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notificationtemplate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+)
+
+// ErrNotificationTemplateExists is returned when creating a second template for the
+// same type and notifier.
+var ErrNotificationTemplateExists = errors.New("a template for this type and notifier already exists")
+
+// NotificationTemplateRepository stores the message templates of a group. A template
+// without a notifier applies to every notifier of the group that has no template of
+// its own.
+type NotificationTemplateRepository struct {
+	db *ent.Client
+}
+
+type (
+	NotificationTemplateCreate struct {
+		Type       string     `json:"type"       validate:"required,oneof=maintenance reminder digest"`
+		NotifierID *uuid.UUID `json:"notifierId" extensions:"x-nullable"`
+		Body       string     `json:"body"       validate:"required,max=5000"`
+	}
+
+	NotificationTemplateUpdate struct {
+		Body string `json:"body" validate:"required,max=5000"`
+	}
+
+	NotificationTemplateOut struct {
+		ID         uuid.UUID  `json:"id"`
+		Type       string     `json:"type"`
+		NotifierID *uuid.UUID `json:"notifierId" extensions:"x-nullable"`
+		Body       string     `json:"body"`
+		CreatedAt  time.Time  `json:"createdAt"`
+		UpdatedAt  time.Time  `json:"updatedAt"`
+	}
+)
+
+var (
+	mapNotificationTemplateOutErr = mapTErrFunc(mapNotificationTemplateOut)
+	mapNotificationTemplatesOut   = mapTEachErrFunc(mapNotificationTemplateOut)
+)
+
+func mapNotificationTemplateOut(t *ent.NotificationTemplate) NotificationTemplateOut {
+	return NotificationTemplateOut{
+		ID:         t.ID,
+		Type:       t.Type.String(),
+		NotifierID: t.NotifierID,
+		Body:       t.Body,
+		CreatedAt:  t.CreatedAt,
+		UpdatedAt:  t.UpdatedAt,
+	}
+}
+
+func (r *NotificationTemplateRepository) GetAll(ctx context.Context, GID uuid.UUID) ([]NotificationTemplateOut, error) {
+	return mapNotificationTemplatesOut(r.db.NotificationTemplate.Query().
+		Where(notificationtemplate.GroupID(GID)).
+		Order(ent.Asc(notificationtemplate.FieldType), ent.Asc(notificationtemplate.FieldCreatedAt)).
+		All(ctx),
+	)
+}
+
+func (r *NotificationTemplateRepository) GetOneByGroup(ctx context.Context, GID, ID uuid.UUID) (NotificationTemplateOut, error) {
+	return mapNotificationTemplateOutErr(r.db.NotificationTemplate.Query().
+		Where(notificationtemplate.ID(ID), notificationtemplate.GroupID(GID)).
+		Only(ctx),
+	)
+}
+
+// Resolve returns the template body used for a notifier, the template of the notifier
+// takes precedence over the template of the group. An empty body is returned when
+// neither exists.
+func (r *NotificationTemplateRepository) Resolve(ctx context.Context, GID, notifierID uuid.UUID, typ string) (string, error) {
+	templates, err := r.db.NotificationTemplate.Query().
+		Where(
+			notificationtemplate.GroupID(GID),
+			notificationtemplate.TypeEQ(notificationtemplate.Type(typ)),
+			notificationtemplate.Or(
+				notificationtemplate.NotifierIDIsNil(),
+				notificationtemplate.NotifierID(notifierID),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	body := ""
+	for _, t := range templates {
+		if t.NotifierID != nil {
+			return t.Body, nil
+		}
+		body = t.Body
+	}
+
+	return body, nil
+}
+
+func (r *NotificationTemplateRepository) Create(ctx context.Context, GID uuid.UUID, data NotificationTemplateCreate) (NotificationTemplateOut, error) {
+	typ := notificationtemplate.Type(data.Type)
+
+	exists := r.db.NotificationTemplate.Query().
+		Where(
+			notificationtemplate.GroupID(GID),
+			notificationtemplate.TypeEQ(typ),
+			notificationtemplate.NotifierIDIsNil(),
+		)
+
+	if data.NotifierID != nil {
+		// Only notifiers of the group can be given a template.
+		_, err := r.db.Notifier.Query().
+			Where(notifier.ID(*data.NotifierID), notifier.GroupID(GID)).
+			Only(ctx)
+		if err != nil {
+			return NotificationTemplateOut{}, err
+		}
+
+		exists = r.db.NotificationTemplate.Query().
+			Where(
+				notificationtemplate.GroupID(GID),
+				notificationtemplate.TypeEQ(typ),
+				notificationtemplate.NotifierID(*data.NotifierID),
+			)
+	}
+
+	found, err := exists.Exist(ctx)
+	if err != nil {
+		return NotificationTemplateOut{}, err
+	}
+
+	if found {
+		return NotificationTemplateOut{}, ErrNotificationTemplateExists
+	}
+
+	return mapNotificationTemplateOutErr(r.db.NotificationTemplate.Create().
+		SetGroupID(GID).
+		SetType(typ).
+		SetNillableNotifierID(data.NotifierID).
+		SetBody(data.Body).
+		Save(ctx),
+	)
+}
+
+func (r *NotificationTemplateRepository) UpdateByGroup(ctx context.Context, GID, ID uuid.UUID, data NotificationTemplateUpdate) (NotificationTemplateOut, error) {
+	_, err := r.db.NotificationTemplate.Update().
+		Where(notificationtemplate.ID(ID), notificationtemplate.GroupID(GID)).
+		SetBody(data.Body).
+		Save(ctx)
+	if err != nil {
+		return NotificationTemplateOut{}, err
+	}
+
+	return r.GetOneByGroup(ctx, GID, ID)
+}
+
+func (r *NotificationTemplateRepository) DeleteByGroup(ctx context.Context, GID, ID uuid.UUID) error {
+	t, err := r.db.NotificationTemplate.Query().
+		Where(notificationtemplate.ID(ID), notificationtemplate.GroupID(GID)).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.db.NotificationTemplate.DeleteOne(t).Exec(ctx)
+}
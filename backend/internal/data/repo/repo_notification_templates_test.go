@@ -0,0 +1,82 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationTemplateRepository(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "templates-"+fk.Str(6))
+	require.NoError(t, err)
+
+	user := userFactory()
+	user.GroupID = g.ID
+	u, err := tRepos.Users.Create(ctx, user)
+	require.NoError(t, err)
+
+	n, err := tRepos.Notifiers.Create(ctx, g.ID, u.ID, NotifierCreate{Name: "Chat", URL: "generic://example.com", IsActive: true})
+	require.NoError(t, err)
+
+	other, err := tRepos.Notifiers.Create(ctx, tGroup.ID, u.ID, NotifierCreate{Name: "Other", URL: "generic://example.com", IsActive: true})
+	require.NoError(t, err)
+
+	body, err := tRepos.NotificationTemplates.Resolve(ctx, g.ID, n.ID, "maintenance")
+	require.NoError(t, err)
+	assert.Empty(t, body)
+
+	groupTmpl, err := tRepos.NotificationTemplates.Create(ctx, g.ID, NotificationTemplateCreate{Type: "maintenance", Body: "group"})
+	require.NoError(t, err)
+	assert.Nil(t, groupTmpl.NotifierID)
+
+	_, err = tRepos.NotificationTemplates.Create(ctx, g.ID, NotificationTemplateCreate{Type: "maintenance", Body: "again"})
+	require.ErrorIs(t, err, ErrNotificationTemplateExists)
+
+	notifierTmpl, err := tRepos.NotificationTemplates.Create(ctx, g.ID, NotificationTemplateCreate{Type: "maintenance", NotifierID: &n.ID, Body: "notifier"})
+	require.NoError(t, err)
+	require.NotNil(t, notifierTmpl.NotifierID)
+	assert.Equal(t, n.ID, *notifierTmpl.NotifierID)
+
+	_, err = tRepos.NotificationTemplates.Create(ctx, g.ID, NotificationTemplateCreate{Type: "maintenance", NotifierID: &other.ID, Body: "other"})
+	require.True(t, ent.IsNotFound(err))
+
+	body, err = tRepos.NotificationTemplates.Resolve(ctx, g.ID, n.ID, "maintenance")
+	require.NoError(t, err)
+	assert.Equal(t, "notifier", body)
+
+	body, err = tRepos.NotificationTemplates.Resolve(ctx, g.ID, other.ID, "maintenance")
+	require.NoError(t, err)
+	assert.Equal(t, "group", body)
+
+	body, err = tRepos.NotificationTemplates.Resolve(ctx, g.ID, n.ID, "reminder")
+	require.NoError(t, err)
+	assert.Empty(t, body)
+
+	updated, err := tRepos.NotificationTemplates.UpdateByGroup(ctx, g.ID, groupTmpl.ID, NotificationTemplateUpdate{Body: "changed"})
+	require.NoError(t, err)
+	assert.Equal(t, "changed", updated.Body)
+
+	_, err = tRepos.NotificationTemplates.UpdateByGroup(ctx, tGroup.ID, groupTmpl.ID, NotificationTemplateUpdate{Body: "changed"})
+	require.True(t, ent.IsNotFound(err))
+
+	all, err := tRepos.NotificationTemplates.GetAll(ctx, g.ID)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	// Deleting the notifier removes its templates.
+	require.NoError(t, tRepos.Notifiers.Delete(ctx, u.ID, n.ID))
+
+	all, err = tRepos.NotificationTemplates.GetAll(ctx, g.ID)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+
+	require.NoError(t, tRepos.NotificationTemplates.DeleteByGroup(ctx, g.ID, groupTmpl.ID))
+
+	err = tRepos.NotificationTemplates.DeleteByGroup(ctx, g.ID, groupTmpl.ID)
+	require.True(t, ent.IsNotFound(err))
+}
@@ -2,11 +2,13 @@ package repo
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/notifier"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/notifierdelivery"
 )
 
 type NotifierRepository struct {
@@ -25,9 +27,10 @@ func NewNotifierRepository(db *ent.Client) *NotifierRepository {
 				CreatedAt: n.CreatedAt,
 				UpdatedAt: n.UpdatedAt,
 
-				Name:     n.Name,
-				IsActive: n.IsActive,
-				URL:      n.URL,
+				Name:         n.Name,
+				IsActive:     n.IsActive,
+				FailureCount: n.FailureCount,
+				URL:          n.URL,
 			}
 		},
 	}
@@ -53,12 +56,49 @@ type (
 		CreatedAt time.Time `json:"createdAt"`
 		UpdatedAt time.Time `json:"updatedAt"`
 
-		Name     string `json:"name"`
-		IsActive bool   `json:"isActive"`
-		URL      string `json:"-"` // URL field is not exposed to the client
+		Name         string `json:"name"`
+		IsActive     bool   `json:"isActive"`
+		FailureCount int    `json:"failureCount"`
+		URL          string `json:"-"` // URL field is not exposed to the client
+	}
+
+	NotifierDeliveryCreate struct {
+		NotifierID uuid.UUID
+		Type       string
+		Attempt    int
+		Error      string
+	}
+
+	NotifierDeliveryOut struct {
+		ID         uuid.UUID `json:"id"`
+		NotifierID uuid.UUID `json:"notifierId"`
+		Type       string    `json:"type"`
+		Status     string    `json:"status"`
+		Attempt    int       `json:"attempt"`
+		Error      string    `json:"error"`
+		CreatedAt  time.Time `json:"createdAt"`
+	}
+
+	NotifierDeliveryQuery struct {
+		Page     int `json:"page"     schema:"page"`
+		PageSize int `json:"pageSize" schema:"pageSize"`
 	}
 )
 
+var mapNotifierDeliveries = mapTEachFunc(mapNotifierDeliveryOut)
+
+func mapNotifierDeliveryOut(d *ent.NotifierDelivery) NotifierDeliveryOut {
+	return NotifierDeliveryOut{
+		ID:         d.ID,
+		NotifierID: d.NotifierID,
+		Type:       d.Type,
+		Status:     d.Status.String(),
+		Attempt:    d.Attempt,
+		Error:      d.Error,
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
 func (r *NotifierRepository) GetByUser(ctx context.Context, userID uuid.UUID) ([]NotifierOut, error) {
 	notifier, err := r.db.Notifier.Query().
 		Where(notifier.UserID(userID)).
@@ -109,6 +149,11 @@ func (r *NotifierRepository) Update(ctx context.Context, userID uuid.UUID, id uu
 		q.SetURL(*input.URL)
 	}
 
+	if input.IsActive {
+		// Enabling a notifier again gives it a fresh start.
+		q.SetFailureCount(0)
+	}
+
 	notifier, err := q.Save(ctx)
 
 	return r.mapper.MapErr(notifier, err)
@@ -118,3 +163,92 @@ func (r *NotifierRepository) Delete(ctx context.Context, userID uuid.UUID, ID uu
 	_, err := r.db.Notifier.Delete().Where(notifier.UserID(userID), notifier.ID(ID)).Exec(ctx)
 	return err
 }
+
+// RecordDelivery stores an attempt to send a message to the notifier, the attempt failed
+// when it has an error.
+func (r *NotifierRepository) RecordDelivery(ctx context.Context, data NotifierDeliveryCreate) error {
+	status := notifierdelivery.StatusSuccess
+	if data.Error != "" {
+		status = notifierdelivery.StatusFailed
+	}
+
+	if len(data.Error) > 2000 {
+		data.Error = strings.ToValidUTF8(data.Error[:2000], "")
+	}
+
+	return r.db.NotifierDelivery.Create().
+		SetNotifierID(data.NotifierID).
+		SetType(data.Type).
+		SetStatus(status).
+		SetAttempt(data.Attempt).
+		SetError(data.Error).
+		Exec(ctx)
+}
+
+// ReportDelivery updates the failure count of the notifier after a delivery gave up or
+// succeeded. The notifier is disabled once maxFailures deliveries failed in a row.
+func (r *NotifierRepository) ReportDelivery(ctx context.Context, ID uuid.UUID, ok bool, maxFailures int) (NotifierOut, error) {
+	if ok {
+		return r.mapper.MapErr(r.db.Notifier.UpdateOneID(ID).
+			SetFailureCount(0).
+			Save(ctx),
+		)
+	}
+
+	n, err := r.db.Notifier.UpdateOneID(ID).
+		AddFailureCount(1).
+		Save(ctx)
+	if err != nil {
+		return NotifierOut{}, err
+	}
+
+	if n.IsActive && n.FailureCount >= maxFailures {
+		n, err = n.Update().
+			SetIsActive(false).
+			Save(ctx)
+	}
+
+	return r.mapper.MapErr(n, err)
+}
+
+// GetDeliveries returns the delivery history of a notifier of the user, latest first.
+func (r *NotifierRepository) GetDeliveries(ctx context.Context, userID, ID uuid.UUID, q NotifierDeliveryQuery) (PaginationResult[NotifierDeliveryOut], error) {
+	_, err := r.db.Notifier.Query().
+		Where(notifier.ID(ID), notifier.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		return PaginationResult[NotifierDeliveryOut]{}, err
+	}
+
+	if q.Page <= 0 {
+		q.Page = 1
+	}
+
+	if q.PageSize <= 0 || q.PageSize > 100 {
+		q.PageSize = 50
+	}
+
+	qb := r.db.NotifierDelivery.Query().
+		Where(notifierdelivery.NotifierID(ID))
+
+	count, err := qb.Count(ctx)
+	if err != nil {
+		return PaginationResult[NotifierDeliveryOut]{}, err
+	}
+
+	deliveries, err := qb.
+		Order(ent.Desc(notifierdelivery.FieldCreatedAt)).
+		Offset(calculateOffset(q.Page, q.PageSize)).
+		Limit(q.PageSize).
+		All(ctx)
+	if err != nil {
+		return PaginationResult[NotifierDeliveryOut]{}, err
+	}
+
+	return PaginationResult[NotifierDeliveryOut]{
+		Page:     q.Page,
+		PageSize: q.PageSize,
+		Total:    count,
+		Items:    mapNotifierDeliveries(deliveries),
+	}, nil
+}
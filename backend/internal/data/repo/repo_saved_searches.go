@@ -0,0 +1,225 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/savedsearch"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+)
+
+// SavedSearchRepository stores item queries of a group so they can be evaluated
+// again later.
+type SavedSearchRepository struct {
+	db    *ent.Client
+	items *ItemsRepository
+}
+
+type (
+	SavedSearchCreate struct {
+		Name        string           `json:"name"        validate:"required,min=1,max=255"`
+		Description string           `json:"description" validate:"max=1000"`
+		Query       types.SavedQuery `json:"query"`
+		OrderBy     string           `json:"orderBy"     validate:"omitempty,oneof=name createdAt updatedAt"`
+	}
+
+	SavedSearchUpdate struct {
+		ID          uuid.UUID        `json:"id"`
+		Name        string           `json:"name"        validate:"required,min=1,max=255"`
+		Description string           `json:"description" validate:"max=1000"`
+		Query       types.SavedQuery `json:"query"`
+		OrderBy     string           `json:"orderBy"     validate:"omitempty,oneof=name createdAt updatedAt"`
+	}
+
+	SavedSearchOut struct {
+		ID          uuid.UUID        `json:"id"`
+		Name        string           `json:"name"`
+		Description string           `json:"description"`
+		Query       types.SavedQuery `json:"query"`
+		OrderBy     string           `json:"orderBy"`
+		CreatedAt   time.Time        `json:"createdAt"`
+		UpdatedAt   time.Time        `json:"updatedAt"`
+
+		// ItemCount is the number of items currently matching the query, it is
+		// only set when requested.
+		ItemCount *int `json:"itemCount,omitempty" extensions:"x-nullable"`
+	}
+
+	SavedSearchListQuery struct {
+		WithCount bool `json:"withCount" schema:"withCount"`
+	}
+
+	SavedSearchItemsQuery struct {
+		Page     int `json:"page"     schema:"page"`
+		PageSize int `json:"pageSize" schema:"pageSize"`
+	}
+)
+
+var (
+	mapSavedSearchOutErr = mapTErrFunc(mapSavedSearchOut)
+	mapSavedSearchesOut  = mapTEachErrFunc(mapSavedSearchOut)
+)
+
+func mapSavedSearchOut(s *ent.SavedSearch) SavedSearchOut {
+	return SavedSearchOut{
+		ID:          s.ID,
+		Name:        s.Name,
+		Description: s.Description,
+		Query:       s.Query,
+		OrderBy:     s.OrderBy.String(),
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
+
+// savedItemQuery converts a saved query into the query used by the items repository,
+// the filter expression is parsed again so that an invalid filter is reported
+// instead of silently matching everything.
+func savedItemQuery(q types.SavedQuery, orderBy string) (ItemQuery, error) {
+	f, err := ParseItemFilter(q.Filter)
+	if err != nil {
+		return ItemQuery{}, err
+	}
+
+	fields := make([]FieldQuery, len(q.Fields))
+	for i, fq := range q.Fields {
+		fields[i] = FieldQuery{Name: fq.Name, Value: fq.Value}
+	}
+
+	return ItemQuery{
+		Search:          q.Search,
+		LocationIDs:     q.LocationIDs,
+		LabelIDs:        q.LabelIDs,
+		ParentItemIDs:   q.ParentIDs,
+		IncludeArchived: q.IncludeArchived,
+		Fields:          fields,
+		OrderBy:         orderBy,
+		Filter:          f,
+	}, nil
+}
+
+func savedSearchOrderBy(s string) savedsearch.OrderBy {
+	if s == "" {
+		return savedsearch.DefaultOrderBy
+	}
+	return savedsearch.OrderBy(s)
+}
+
+func (r *SavedSearchRepository) getOne(ctx context.Context, where ...predicate.SavedSearch) (SavedSearchOut, error) {
+	return mapSavedSearchOutErr(r.db.SavedSearch.Query().
+		Where(where...).
+		Only(ctx),
+	)
+}
+
+func (r *SavedSearchRepository) GetOneByGroup(ctx context.Context, GID, ID uuid.UUID) (SavedSearchOut, error) {
+	return r.getOne(ctx, savedsearch.ID(ID), savedsearch.HasGroupWith(group.ID(GID)))
+}
+
+// GetAll returns the saved searches of the group, when withCount is set the number
+// of matching items is evaluated for each of them.
+func (r *SavedSearchRepository) GetAll(ctx context.Context, GID uuid.UUID, withCount bool) ([]SavedSearchOut, error) {
+	searches, err := mapSavedSearchesOut(r.db.SavedSearch.Query().
+		Where(savedsearch.HasGroupWith(group.ID(GID))).
+		Order(ent.Asc(savedsearch.FieldName)).
+		All(ctx),
+	)
+	if err != nil || !withCount {
+		return searches, err
+	}
+
+	for i := range searches {
+		q, err := savedItemQuery(searches[i].Query, searches[i].OrderBy)
+		if err != nil {
+			// A filter that no longer parses can't match anything, leave the count
+			// empty rather than failing the whole list.
+			continue
+		}
+
+		n, err := r.items.CountByGroup(ctx, GID, q)
+		if err != nil {
+			return nil, err
+		}
+
+		searches[i].ItemCount = &n
+	}
+
+	return searches, nil
+}
+
+func (r *SavedSearchRepository) Create(ctx context.Context, GID uuid.UUID, data SavedSearchCreate) (SavedSearchOut, error) {
+	if _, err := ParseItemFilter(data.Query.Filter); err != nil {
+		return SavedSearchOut{}, err
+	}
+
+	return mapSavedSearchOutErr(r.db.SavedSearch.Create().
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetQuery(data.Query).
+		SetOrderBy(savedSearchOrderBy(data.OrderBy)).
+		SetGroupID(GID).
+		Save(ctx),
+	)
+}
+
+func (r *SavedSearchRepository) UpdateByGroup(ctx context.Context, GID uuid.UUID, data SavedSearchUpdate) (SavedSearchOut, error) {
+	if _, err := ParseItemFilter(data.Query.Filter); err != nil {
+		return SavedSearchOut{}, err
+	}
+
+	s, err := r.db.SavedSearch.Query().
+		Where(savedsearch.ID(data.ID), savedsearch.HasGroupWith(group.ID(GID))).
+		Only(ctx)
+	if err != nil {
+		return SavedSearchOut{}, err
+	}
+
+	return mapSavedSearchOutErr(s.Update().
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetQuery(data.Query).
+		SetOrderBy(savedSearchOrderBy(data.OrderBy)).
+		Save(ctx),
+	)
+}
+
+func (r *SavedSearchRepository) DeleteByGroup(ctx context.Context, GID, ID uuid.UUID) error {
+	s, err := r.db.SavedSearch.Query().
+		Where(savedsearch.ID(ID), savedsearch.HasGroupWith(group.ID(GID))).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.db.SavedSearch.DeleteOne(s).Exec(ctx)
+}
+
+// Evaluate runs the saved search and returns a page of the matching items in the
+// saved sort order.
+func (r *SavedSearchRepository) Evaluate(ctx context.Context, GID, ID uuid.UUID, page, pageSize int) (PaginationResult[ItemSummary], error) {
+	s, err := r.GetOneByGroup(ctx, GID, ID)
+	if err != nil {
+		return PaginationResult[ItemSummary]{}, err
+	}
+
+	q, err := savedItemQuery(s.Query, s.OrderBy)
+	if err != nil {
+		return PaginationResult[ItemSummary]{}, err
+	}
+
+	if page <= 0 {
+		page = 1
+	}
+
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 50
+	}
+
+	q.Page, q.PageSize = page, pageSize
+
+	return r.items.QueryByGroup(ctx, GID, q)
+}
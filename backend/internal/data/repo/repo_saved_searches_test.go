@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavedSearchRepository(t *testing.T) {
+	ctx := context.Background()
+
+	loc, err := tRepos.Locations.Create(ctx, tGroup.ID, LocationCreate{Name: "Saved Search Shelf"})
+	require.NoError(t, err)
+
+	names := []string{"Alpha Lamp", "Beta Lamp", "Gamma Chair"}
+	ids := make([]uuid.UUID, len(names))
+	for i, name := range names {
+		itm, err := tRepos.Items.Create(ctx, tGroup.ID, ItemCreate{Name: name, LocationID: loc.ID})
+		require.NoError(t, err)
+		ids[i] = itm.ID
+	}
+
+	t.Cleanup(func() {
+		for _, id := range ids {
+			_ = tRepos.Items.Delete(context.Background(), id)
+		}
+		_ = tRepos.Locations.delete(context.Background(), loc.ID)
+	})
+
+	saved, err := tRepos.SavedSearches.Create(ctx, tGroup.ID, SavedSearchCreate{
+		Name: "Lamps",
+		Query: types.SavedQuery{
+			Filter:      "name:lamp",
+			LocationIDs: []uuid.UUID{loc.ID},
+		},
+		OrderBy: "createdAt",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "createdAt", saved.OrderBy)
+
+	// Items are returned in the saved order, newest first
+	res, err := tRepos.SavedSearches.Evaluate(ctx, tGroup.ID, saved.ID, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, res.Total)
+	require.Len(t, res.Items, 1)
+	assert.Equal(t, ids[1], res.Items[0].ID)
+
+	all, err := tRepos.SavedSearches.GetAll(ctx, tGroup.ID, false)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Nil(t, all[0].ItemCount)
+
+	// Counts follow changes to the items
+	err = tRepos.Items.DeleteByGroup(ctx, tGroup.ID, ids[0])
+	require.NoError(t, err)
+
+	all, err = tRepos.SavedSearches.GetAll(ctx, tGroup.ID, true)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.NotNil(t, all[0].ItemCount)
+	assert.Equal(t, 1, *all[0].ItemCount)
+
+	// Invalid filters are rejected
+	_, err = tRepos.SavedSearches.UpdateByGroup(ctx, tGroup.ID, SavedSearchUpdate{
+		ID:    saved.ID,
+		Name:  "Lamps",
+		Query: types.SavedQuery{Filter: "price>abc"},
+	})
+	var ferr *ItemFilterError
+	require.ErrorAs(t, err, &ferr)
+
+	updated, err := tRepos.SavedSearches.UpdateByGroup(ctx, tGroup.ID, SavedSearchUpdate{
+		ID:    saved.ID,
+		Name:  "Chairs",
+		Query: types.SavedQuery{Search: "chair", LocationIDs: []uuid.UUID{loc.ID}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "name", updated.OrderBy)
+
+	res, err = tRepos.SavedSearches.Evaluate(ctx, tGroup.ID, saved.ID, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, res.Items, 1)
+	assert.Equal(t, ids[2], res.Items[0].ID)
+
+	// Saved searches are scoped to the group
+	_, err = tRepos.SavedSearches.GetOneByGroup(ctx, uuid.New(), saved.ID)
+	require.Error(t, err)
+
+	err = tRepos.SavedSearches.DeleteByGroup(ctx, uuid.New(), saved.ID)
+	require.Error(t, err)
+
+	err = tRepos.SavedSearches.DeleteByGroup(ctx, tGroup.ID, saved.ID)
+	require.NoError(t, err)
+
+	all, err = tRepos.SavedSearches.GetAll(ctx, tGroup.ID, false)
+	require.NoError(t, err)
+	assert.Empty(t, all)
+}
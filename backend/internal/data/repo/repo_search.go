@@ -0,0 +1,773 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"html"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"entgo.io/ent/dialect"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/attachment"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/item"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/itemfield"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+)
+
+// SearchRepository maintains a full-text index of the items and their custom fields,
+// labels, location and maintenance entries. The other repositories update the index
+// as they mutate items, it can be rebuilt from scratch with Rebuild.
+//
+// The indexed documents are stored in the item_search table which is managed outside
+// of the ent migrations. On SQLite the table is backed by an FTS5 index, on other
+// databases (or SQLite builds without FTS5) documents are matched with LIKE and ranked
+// in memory.
+type SearchRepository struct {
+	db *ent.Client
+
+	mu      sync.Mutex
+	backend searchBackend
+}
+
+type (
+	SearchQuery struct {
+		Q        string `json:"q"        schema:"q"        validate:"required,max=255"`
+		Page     int    `json:"page"     schema:"page"`
+		PageSize int    `json:"pageSize" schema:"pageSize"`
+	}
+
+	SearchHighlight struct {
+		Field   string `json:"field"`
+		Snippet string `json:"snippet"`
+	}
+
+	SearchResult struct {
+		Item       ItemSummary       `json:"item"`
+		Rank       float64           `json:"rank"`
+		Highlights []SearchHighlight `json:"highlights"`
+	}
+)
+
+// searchColumns are the indexed columns of a document in order of their weight when
+// ranking results.
+var searchColumns = []struct {
+	name   string
+	weight float64
+}{
+	{"name", 10},
+	{"identifiers", 6},
+	{"description", 4},
+	{"labels", 3},
+	{"fields", 3},
+	{"location", 2},
+	{"notes", 2},
+	{"maintenance", 1},
+}
+
+const (
+	searchMarkStart = "<mark>"
+	searchMarkEnd   = "</mark>"
+	// ftsMarkStart and ftsMarkEnd delimit matches in FTS5 snippets, they are replaced
+	// with the HTML marks once the snippet is escaped.
+	ftsMarkStart    = "\x02"
+	ftsMarkEnd      = "\x03"
+	searchEllipsis  = "…"
+	searchMaxTerms  = 10
+	searchBatchSize = 500
+)
+
+type searchDocument struct {
+	ItemID  uuid.UUID
+	GroupID uuid.UUID
+	Values  []string // one per searchColumns
+}
+
+type searchHit struct {
+	ItemID     uuid.UUID
+	Rank       float64
+	Highlights []SearchHighlight
+}
+
+// searchBackend matches and ranks the documents of the item_search table.
+type searchBackend interface {
+	search(ctx context.Context, GID uuid.UUID, terms []string, limit, offset int) ([]searchHit, int, error)
+}
+
+func searchColumnList(prefix string) string {
+	cols := make([]string, len(searchColumns))
+	for i, c := range searchColumns {
+		cols[i] = prefix + c.name
+	}
+	return strings.Join(cols, ", ")
+}
+
+// init creates the item_search table and selects the backend on first use.
+func (r *SearchRepository) init(ctx context.Context) (searchBackend, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.backend != nil {
+		return r.backend, nil
+	}
+
+	d := r.db.Dialect()
+
+	key := "item_id uuid PRIMARY KEY"
+	if d != dialect.Postgres {
+		// SQLite needs a stable integer rowid to link the FTS5 index to the documents
+		key = "id INTEGER PRIMARY KEY, item_id uuid NOT NULL UNIQUE"
+	}
+
+	cols := make([]string, len(searchColumns))
+	for i, c := range searchColumns {
+		cols[i] = c.name + " text NOT NULL DEFAULT ''"
+	}
+
+	stmts := []string{
+		`CREATE TABLE IF NOT EXISTS item_search (
+  ` + key + ` REFERENCES items (id) ON DELETE CASCADE,
+  group_id uuid NOT NULL,
+  ` + strings.Join(cols, ",\n  ") + `
+)`,
+		"CREATE INDEX IF NOT EXISTS item_search_group_id ON item_search (group_id)",
+	}
+
+	for _, stmt := range stmts {
+		if _, err := r.db.Sql().ExecContext(ctx, stmt); err != nil {
+			return nil, err
+		}
+	}
+
+	var backend searchBackend = &tableSearch{db: r.db}
+	if d == dialect.SQLite {
+		fts := &ftsSearch{db: r.db}
+		err := fts.setup(ctx)
+		switch {
+		case err == nil:
+			backend = fts
+		case !strings.Contains(err.Error(), "no such module"):
+			return nil, err
+		}
+	}
+
+	r.backend = backend
+	return backend, nil
+}
+
+// Search returns the items of the group matching all the words of the query, best
+// matches first. Words match on prefix, the highlights are escaped as HTML and the
+// matched words are wrapped in <mark> tags.
+func (r *SearchRepository) Search(ctx context.Context, GID uuid.UUID, q SearchQuery) (PaginationResult[SearchResult], error) {
+	if q.Page <= 0 {
+		q.Page = 1
+	}
+
+	if q.PageSize <= 0 || q.PageSize > 100 {
+		q.PageSize = 50
+	}
+
+	result := PaginationResult[SearchResult]{
+		Page:     q.Page,
+		PageSize: q.PageSize,
+		Items:    []SearchResult{},
+	}
+
+	terms := searchTerms(q.Q)
+	if len(terms) == 0 {
+		return result, nil
+	}
+
+	backend, err := r.init(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	hits, total, err := backend.search(ctx, GID, terms, q.PageSize, calculateOffset(q.Page, q.PageSize))
+	if err != nil {
+		return result, err
+	}
+
+	result.Total = total
+	if len(hits) == 0 {
+		return result, nil
+	}
+
+	ids := make([]uuid.UUID, len(hits))
+	for i, h := range hits {
+		ids[i] = h.ItemID
+	}
+
+	items, err := r.db.Item.Query().
+		Where(
+			item.IDIn(ids...),
+			item.HasGroupWith(group.ID(GID)),
+			item.DeletedAtIsNil(),
+		).
+		WithLabel().
+		WithLocation().
+		WithAttachments(func(aq *ent.AttachmentQuery) {
+			aq.Where(
+				attachment.Primary(true),
+			).
+				WithDocument()
+		}).
+		All(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	byID := make(map[uuid.UUID]*ent.Item, len(items))
+	for _, it := range items {
+		byID[it.ID] = it
+	}
+
+	for _, h := range hits {
+		it, ok := byID[h.ItemID]
+		if !ok {
+			continue
+		}
+
+		result.Items = append(result.Items, SearchResult{
+			Item:       mapItemSummary(it),
+			Rank:       h.Rank,
+			Highlights: h.Highlights,
+		})
+	}
+
+	return result, nil
+}
+
+// Sync rebuilds the index when the number of indexed documents doesn't match the number
+// of items, for example when the index was just created. It returns the number of
+// documents that were indexed.
+func (r *SearchRepository) Sync(ctx context.Context) (int, error) {
+	if _, err := r.init(ctx); err != nil {
+		return 0, err
+	}
+
+	var indexed int
+	err := r.db.Sql().QueryRowContext(ctx, "SELECT COUNT(*) FROM item_search").Scan(&indexed)
+	if err != nil {
+		return 0, err
+	}
+
+	live, err := r.db.Item.Query().Where(item.DeletedAtIsNil()).Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if indexed == live {
+		return 0, nil
+	}
+
+	return r.Rebuild(ctx)
+}
+
+// Rebuild clears the index and indexes all items again. It returns the number of
+// documents that were indexed.
+func (r *SearchRepository) Rebuild(ctx context.Context) (int, error) {
+	if _, err := r.init(ctx); err != nil {
+		return 0, err
+	}
+
+	_, err := r.db.Sql().ExecContext(ctx, "DELETE FROM item_search")
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for {
+		ids, err := r.db.Item.Query().
+			Where(item.DeletedAtIsNil()).
+			Order(ent.Asc(item.FieldID)).
+			Offset(total).
+			Limit(searchBatchSize).
+			IDs(ctx)
+		if err != nil {
+			return total, err
+		}
+
+		if err := r.index(ctx, ids...); err != nil {
+			return total, err
+		}
+
+		total += len(ids)
+		if len(ids) < searchBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// indexWhere updates the documents of the items matching the predicates.
+func (r *SearchRepository) indexWhere(ctx context.Context, where ...predicate.Item) error {
+	ids, err := r.db.Item.Query().Where(where...).IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.index(ctx, ids...)
+}
+
+// index updates the documents of the items. Documents of items that no longer exist,
+// or are in the trash, are removed from the index.
+func (r *SearchRepository) index(ctx context.Context, IDs ...uuid.UUID) error {
+	if len(IDs) == 0 {
+		return nil
+	}
+
+	if _, err := r.init(ctx); err != nil {
+		return err
+	}
+
+	for start := 0; start < len(IDs); start += searchBatchSize {
+		end := min(start+searchBatchSize, len(IDs))
+
+		err := r.indexBatch(ctx, IDs[start:end])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SearchRepository) indexBatch(ctx context.Context, IDs []uuid.UUID) error {
+	items, err := r.db.Item.Query().
+		Where(
+			item.IDIn(IDs...),
+			item.DeletedAtIsNil(),
+		).
+		WithGroup().
+		WithLabel().
+		WithLocation().
+		WithFields().
+		WithMaintenanceEntries().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	d := r.db.Dialect()
+
+	updates := make([]string, len(searchColumns))
+	for i, c := range searchColumns {
+		updates[i] = c.name + " = excluded." + c.name
+	}
+
+	upsert := rebind(d, `INSERT INTO item_search (item_id, group_id, `+searchColumnList("")+`)
+VALUES (?, ?`+strings.Repeat(", ?", len(searchColumns))+`)
+ON CONFLICT (item_id) DO UPDATE SET group_id = excluded.group_id, `+strings.Join(updates, ", "))
+
+	tx, err := r.db.Sql().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	indexed := make(map[uuid.UUID]struct{}, len(items))
+	for _, it := range items {
+		doc := searchDocumentFor(it)
+
+		args := make([]any, 0, len(doc.Values)+2)
+		args = append(args, doc.ItemID, doc.GroupID)
+		for _, v := range doc.Values {
+			args = append(args, v)
+		}
+
+		if _, err := tx.ExecContext(ctx, upsert, args...); err != nil {
+			return err
+		}
+
+		indexed[it.ID] = struct{}{}
+	}
+
+	var removed []any
+	for _, id := range IDs {
+		if _, ok := indexed[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+
+	if len(removed) > 0 {
+		stmt := "DELETE FROM item_search WHERE item_id IN (?" + strings.Repeat(", ?", len(removed)-1) + ")"
+		if _, err := tx.ExecContext(ctx, rebind(d, stmt), removed...); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func searchDocumentFor(it *ent.Item) searchDocument {
+	join := func(sep string, values ...string) string {
+		out := make([]string, 0, len(values))
+		for _, v := range values {
+			if v = strings.TrimSpace(v); v != "" {
+				out = append(out, v)
+			}
+		}
+		return strings.Join(out, sep)
+	}
+
+	var assetID string
+	if it.AssetID > 0 {
+		assetID = AssetID(it.AssetID).String()
+	}
+
+	labels := make([]string, len(it.Edges.Label))
+	for i, l := range it.Edges.Label {
+		labels[i] = l.Name
+	}
+	sort.Strings(labels)
+
+	fields := make([]string, len(it.Edges.Fields))
+	for i, f := range it.Edges.Fields {
+		var v string
+		switch f.Type {
+		case itemfield.TypeNumber:
+			v = strconv.Itoa(f.NumberValue)
+		case itemfield.TypeBoolean:
+			v = strconv.FormatBool(f.BooleanValue)
+		default:
+			v = f.TextValue
+		}
+
+		fields[i] = f.Name + ": " + v
+	}
+
+	maintenance := make([]string, len(it.Edges.MaintenanceEntries))
+	for i, m := range it.Edges.MaintenanceEntries {
+		maintenance[i] = join(" ", m.Name, m.Description)
+	}
+
+	var loc string
+	if it.Edges.Location != nil {
+		loc = it.Edges.Location.Name
+	}
+
+	values := map[string]string{
+		"name":        it.Name,
+		"identifiers": join(" ", assetID, it.SerialNumber, it.ModelNumber, it.Manufacturer),
+		"description": it.Description,
+		"labels":      join(" ", labels...),
+		"fields":      join("\n", fields...),
+		"location":    loc,
+		"notes":       join("\n", it.Notes, it.WarrantyDetails, it.PurchaseFrom, it.SoldTo, it.SoldNotes),
+		"maintenance": join("\n", maintenance...),
+	}
+
+	doc := searchDocument{
+		ItemID:  it.ID,
+		GroupID: it.Edges.Group.ID,
+		Values:  make([]string, len(searchColumns)),
+	}
+
+	for i, c := range searchColumns {
+		doc.Values[i] = values[c.name]
+	}
+
+	return doc
+}
+
+// searchTerms splits the query into lower case words, punctuation is ignored.
+func searchTerms(q string) []string {
+	words := strings.FieldsFunc(strings.ToLower(q), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	seen := make(map[string]struct{}, len(words))
+	terms := make([]string, 0, len(words))
+	for _, w := range words {
+		if _, ok := seen[w]; ok {
+			continue
+		}
+
+		seen[w] = struct{}{}
+		terms = append(terms, w)
+
+		if len(terms) == searchMaxTerms {
+			break
+		}
+	}
+
+	return terms
+}
+
+// ftsSearch matches documents using an SQLite FTS5 index that is kept in sync with
+// the item_search table by triggers.
+type ftsSearch struct {
+	db *ent.Client
+}
+
+func (s *ftsSearch) setup(ctx context.Context) error {
+	cols := searchColumnList("")
+	oldCols := searchColumnList("old.")
+	newCols := searchColumnList("new.")
+
+	var exists int
+	err := s.db.Sql().
+		QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'item_search_fts'").
+		Scan(&exists)
+	if err != nil {
+		return err
+	}
+
+	stmts := []string{
+		`CREATE VIRTUAL TABLE IF NOT EXISTS item_search_fts USING fts5(
+  ` + cols + `,
+  content = 'item_search',
+  content_rowid = 'id',
+  tokenize = 'unicode61 remove_diacritics 2'
+)`,
+		`CREATE TRIGGER IF NOT EXISTS item_search_ai AFTER INSERT ON item_search BEGIN
+  INSERT INTO item_search_fts (rowid, ` + cols + `) VALUES (new.id, ` + newCols + `);
+END`,
+		`CREATE TRIGGER IF NOT EXISTS item_search_ad AFTER DELETE ON item_search BEGIN
+  INSERT INTO item_search_fts (item_search_fts, rowid, ` + cols + `) VALUES ('delete', old.id, ` + oldCols + `);
+END`,
+		`CREATE TRIGGER IF NOT EXISTS item_search_au AFTER UPDATE ON item_search BEGIN
+  INSERT INTO item_search_fts (item_search_fts, rowid, ` + cols + `) VALUES ('delete', old.id, ` + oldCols + `);
+  INSERT INTO item_search_fts (rowid, ` + cols + `) VALUES (new.id, ` + newCols + `);
+END`,
+	}
+
+	// Documents indexed before the FTS5 index existed need to be added to it
+	if exists == 0 {
+		stmts = append(stmts, "INSERT INTO item_search_fts (item_search_fts) VALUES ('rebuild')")
+	}
+
+	for _, stmt := range stmts {
+		if _, err := s.db.Sql().ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *ftsSearch) search(ctx context.Context, GID uuid.UUID, terms []string, limit, offset int) ([]searchHit, int, error) {
+	quoted := make([]string, len(terms))
+	for i, t := range terms {
+		quoted[i] = `"` + strings.ReplaceAll(t, `"`, `""`) + `"*`
+	}
+	match := strings.Join(quoted, " ")
+
+	var total int
+	err := s.db.Sql().QueryRowContext(ctx, `
+SELECT COUNT(*)
+FROM item_search_fts
+JOIN item_search s ON s.id = item_search_fts.rowid
+WHERE item_search_fts MATCH ? AND s.group_id = ?`, match, GID).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	weights := make([]string, len(searchColumns))
+	snippets := make([]string, len(searchColumns))
+	for i, c := range searchColumns {
+		weights[i] = strconv.FormatFloat(c.weight, 'f', -1, 64)
+		snippets[i] = "snippet(item_search_fts, " + strconv.Itoa(i) + ", char(2), char(3), '" + searchEllipsis + "', 16)"
+	}
+
+	rows, err := s.db.Sql().QueryContext(ctx, `
+SELECT s.item_id, bm25(item_search_fts, `+strings.Join(weights, ", ")+`) AS rank, `+strings.Join(snippets, ", ")+`
+FROM item_search_fts
+JOIN item_search s ON s.id = item_search_fts.rowid
+WHERE item_search_fts MATCH ? AND s.group_id = ?
+ORDER BY rank
+LIMIT ? OFFSET ?`, match, GID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var hits []searchHit
+	for rows.Next() {
+		var (
+			hit      searchHit
+			rank     float64
+			snippets = make([]sql.NullString, len(searchColumns))
+		)
+
+		dest := []any{&hit.ItemID, &rank}
+		for i := range snippets {
+			dest = append(dest, &snippets[i])
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, 0, err
+		}
+
+		// bm25 scores better matches lower
+		hit.Rank = -rank
+		hit.Highlights = []SearchHighlight{}
+		for i, snip := range snippets {
+			if strings.Contains(snip.String, ftsMarkStart) {
+				hit.Highlights = append(hit.Highlights, SearchHighlight{
+					Field:   searchColumns[i].name,
+					Snippet: markSnippet(snip.String),
+				})
+			}
+		}
+
+		hits = append(hits, hit)
+	}
+
+	return hits, total, rows.Err()
+}
+
+// tableSearch matches documents with LIKE and ranks them in memory. It is used when
+// FTS5 is not available.
+type tableSearch struct {
+	db *ent.Client
+}
+
+func (s *tableSearch) search(ctx context.Context, GID uuid.UUID, terms []string, limit, offset int) ([]searchHit, int, error) {
+	escape := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+	conds := make([]string, 0, len(terms))
+	args := []any{GID}
+	for _, t := range terms {
+		ors := make([]string, len(searchColumns))
+		for i, c := range searchColumns {
+			ors[i] = "LOWER(" + c.name + `) LIKE ? ESCAPE '\'`
+			args = append(args, "%"+escape.Replace(t)+"%")
+		}
+		conds = append(conds, "("+strings.Join(ors, " OR ")+")")
+	}
+
+	query := "SELECT item_id, " + searchColumnList("") + " FROM item_search WHERE group_id = ? AND " + strings.Join(conds, " AND ")
+
+	rows, err := s.db.Sql().QueryContext(ctx, rebind(s.db.Dialect(), query), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	type match struct {
+		hit  searchHit
+		name string
+	}
+
+	var matches []match
+	for rows.Next() {
+		var (
+			id     uuid.UUID
+			values = make([]string, len(searchColumns))
+		)
+
+		dest := []any{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, 0, err
+		}
+
+		m := match{
+			hit:  searchHit{ItemID: id, Highlights: []SearchHighlight{}},
+			name: values[0],
+		}
+
+		for i, c := range searchColumns {
+			snip, n := highlightTerms(values[i], terms)
+			if n == 0 {
+				continue
+			}
+
+			m.hit.Rank += c.weight * float64(n)
+			m.hit.Highlights = append(m.hit.Highlights, SearchHighlight{Field: c.name, Snippet: snip})
+		}
+
+		matches = append(matches, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].hit.Rank != matches[j].hit.Rank {
+			return matches[i].hit.Rank > matches[j].hit.Rank
+		}
+		return matches[i].name < matches[j].name
+	})
+
+	total := len(matches)
+	if offset >= total {
+		return nil, total, nil
+	}
+
+	matches = matches[offset:min(offset+limit, total)]
+
+	hits := make([]searchHit, len(matches))
+	for i, m := range matches {
+		hits[i] = m.hit
+	}
+
+	return hits, total, nil
+}
+
+// markSnippet escapes the text of an FTS5 snippet as HTML and replaces the match
+// delimiters with <mark> tags.
+func markSnippet(snip string) string {
+	snip = html.EscapeString(snip)
+	return strings.NewReplacer(ftsMarkStart, searchMarkStart, ftsMarkEnd, searchMarkEnd).Replace(snip)
+}
+
+// highlightTerms marks the words of the text containing any of the terms and returns a
+// snippet of up to 16 words around the first match escaped as HTML, along with the number of terms that
+// matched.
+func highlightTerms(text string, terms []string) (string, int) {
+	words := strings.Fields(text)
+
+	found := make(map[string]struct{}, len(terms))
+	first := -1
+	for i, w := range words {
+		lw := strings.ToLower(w)
+
+		marked := false
+		for _, t := range terms {
+			if strings.Contains(lw, t) {
+				found[t] = struct{}{}
+				marked = true
+			}
+		}
+
+		words[i] = html.EscapeString(w)
+		if marked {
+			words[i] = searchMarkStart + words[i] + searchMarkEnd
+			if first == -1 {
+				first = i
+			}
+		}
+	}
+
+	if first == -1 {
+		return "", 0
+	}
+
+	start := max(0, first-5)
+	end := min(len(words), start+16)
+
+	snip := strings.Join(words[start:end], " ")
+	if start > 0 {
+		snip = searchEllipsis + snip
+	}
+	if end < len(words) {
+		snip += searchEllipsis
+	}
+
+	return snip, len(found)
+}
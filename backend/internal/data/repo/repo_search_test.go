@@ -0,0 +1,212 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+)
+
+// testSearch runs the search scenario against the repositories, the test database of
+// the package uses a SQLite build without FTS5 so the in memory ranking is used by
+// default.
+func testSearch(t *testing.T, repos *AllRepos, GID uuid.UUID) {
+	t.Helper()
+	ctx := context.Background()
+
+	loc, err := repos.Locations.Create(ctx, GID, LocationCreate{Name: "Garage Shelf"})
+	require.NoError(t, err)
+
+	lbl, err := repos.Labels.Create(ctx, GID, LabelCreate{Name: "Power Tools"})
+	require.NoError(t, err)
+
+	drill, err := repos.Items.Create(ctx, GID, ItemCreate{
+		Name:        "Cordless Drill",
+		Description: "18V brushless drill driver",
+		LocationID:  loc.ID,
+		LabelIDs:    []uuid.UUID{lbl.ID},
+	})
+	require.NoError(t, err)
+
+	_, err = repos.Items.UpdateByGroup(ctx, GID, ItemUpdate{
+		ID:           drill.ID,
+		Name:         drill.Name,
+		Description:  drill.Description,
+		LocationID:   loc.ID,
+		LabelIDs:     []uuid.UUID{lbl.ID},
+		Quantity:     1,
+		SerialNumber: "SN-48213",
+		Notes:        "Keep the batteries charged",
+		Fields: []ItemField{
+			{Type: "text", Name: "Chuck", TextValue: "keyless 13mm"},
+		},
+	})
+	require.NoError(t, err)
+
+	drawer, err := repos.Locations.Create(ctx, GID, LocationCreate{Name: "Drawer"})
+	require.NoError(t, err)
+
+	bits, err := repos.Items.Create(ctx, GID, ItemCreate{
+		Name:        "Bit Set",
+		Description: "Titanium bits for the cordless drill",
+		LocationID:  drawer.ID,
+	})
+	require.NoError(t, err)
+
+	_, err = repos.MaintEntry.Create(ctx, bits.ID, MaintenanceEntryCreate{
+		CompletedDate: types.DateFromTime(bits.CreatedAt),
+		Name:          "Sharpened",
+		Description:   "Sharpened the worn spade bits",
+	})
+	require.NoError(t, err)
+
+	search := func(q string) PaginationResult[SearchResult] {
+		t.Helper()
+		res, err := repos.Search.Search(ctx, GID, SearchQuery{Q: q})
+		require.NoError(t, err)
+		return res
+	}
+
+	fields := func(r SearchResult) []string {
+		out := make([]string, len(r.Highlights))
+		for i, h := range r.Highlights {
+			out[i] = h.Field
+		}
+		return out
+	}
+
+	// Name matches rank above description matches
+	res := search("cordless drill")
+	require.Equal(t, 2, res.Total)
+	assert.Equal(t, drill.ID, res.Items[0].Item.ID)
+	assert.Equal(t, bits.ID, res.Items[1].Item.ID)
+	assert.Greater(t, res.Items[0].Rank, res.Items[1].Rank)
+	assert.Contains(t, res.Items[0].Highlights, SearchHighlight{Field: "name", Snippet: "<mark>Cordless</mark> <mark>Drill</mark>"})
+
+	// Words match on prefix
+	res = search("cordl")
+	assert.Equal(t, 2, res.Total)
+
+	tests := []struct {
+		q     string
+		item  uuid.UUID
+		field string
+	}{
+		{"48213", drill.ID, "identifiers"},
+		{"keyless", drill.ID, "fields"},
+		{"power", drill.ID, "labels"},
+		{"garage", drill.ID, "location"},
+		{"batteries", drill.ID, "notes"},
+		{"spade", bits.ID, "maintenance"},
+	}
+
+	for _, tt := range tests {
+		res := search(tt.q)
+		require.Equal(t, 1, res.Total, tt.q)
+		assert.Equal(t, tt.item, res.Items[0].Item.ID, tt.q)
+		assert.Equal(t, []string{tt.field}, fields(res.Items[0]), tt.q)
+	}
+
+	// All words must match
+	assert.Equal(t, 0, search("titanium keyless").Total)
+	assert.Equal(t, 1, search("titanium drill").Total)
+
+	// Renaming a label or location updates the index
+	_, err = repos.Labels.UpdateByGroup(ctx, GID, LabelUpdate{ID: lbl.ID, Name: "Workshop"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, search("power").Total)
+	assert.Equal(t, 1, search("workshop").Total)
+
+	// Trashed items are removed from the index until restored
+	err = repos.Items.DeleteByGroup(ctx, GID, drill.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, search("drill").Total)
+
+	_, err = repos.Items.Restore(ctx, GID, drill.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, search("drill").Total)
+
+	err = repos.Items.Delete(ctx, bits.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, search("spade").Total)
+
+	// Rebuilding indexes the same documents
+	n, err := repos.Search.Rebuild(ctx)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, n, 1)
+	assert.Equal(t, 1, search("drill").Total)
+
+	// Other groups can't see the items
+	res, err = repos.Search.Search(ctx, uuid.New(), SearchQuery{Q: "drill"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, res.Total)
+
+	// Queries without words return nothing
+	assert.Equal(t, 0, search("%_ !").Total)
+
+	// Highlights are escaped as HTML apart from the marks
+	_, err = repos.Items.Create(ctx, GID, ItemCreate{
+		Name:       "<script>alert(1)</script> Flashlight",
+		LocationID: loc.ID,
+	})
+	require.NoError(t, err)
+
+	res = search("flashlight")
+	require.Equal(t, 1, res.Total)
+	assert.Equal(t, []SearchHighlight{
+		{Field: "name", Snippet: "&lt;script&gt;alert(1)&lt;/script&gt; <mark>Flashlight</mark>"},
+	}, res.Items[0].Highlights)
+}
+
+func TestSearchRepository_Search(t *testing.T) {
+	g, err := tRepos.Groups.GroupCreate(context.Background(), "search-"+fk.Str(6))
+	require.NoError(t, err)
+
+	testSearch(t, tRepos, g.ID)
+
+	_, ok := tRepos.Search.backend.(*tableSearch)
+	assert.True(t, ok, "expected the in memory ranking to be used without FTS5")
+}
+
+func TestSearchRepository_Search_FTS5(t *testing.T) {
+	db, err := sql.Open("sqlite", "file:search?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	require.NoError(t, err)
+
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { _ = client.Close() })
+
+	err = client.Schema.Create(context.Background())
+	require.NoError(t, err)
+
+	repos := New(client, nil, t.TempDir())
+
+	g, err := repos.Groups.GroupCreate(context.Background(), "search")
+	require.NoError(t, err)
+
+	testSearch(t, repos, g.ID)
+
+	_, ok := repos.Search.backend.(*ftsSearch)
+	assert.True(t, ok, "expected the FTS5 index to be used")
+}
+
+func TestSearchTerms(t *testing.T) {
+	assert.Equal(t, []string{"cordless", "drill", "18v"}, searchTerms(`Cordless "drill" 18V, drill*`))
+	assert.Empty(t, searchTerms(" %_- "))
+}
+
+func TestHighlightTerms(t *testing.T) {
+	snip, n := highlightTerms("a b c d e f g h i j k l m n o p q r s t", []string{"h"})
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "…c d e f g <mark>h</mark> i j k l m n o p q r…", snip)
+
+	_, n = highlightTerms("nothing here", []string{"drill"})
+	assert.Equal(t, 0, n)
+}
@@ -0,0 +1,30 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+)
+
+// SnapshotRepository copies the database while the server is running.
+type SnapshotRepository struct {
+	db *ent.Client
+}
+
+// Supported reports if the database can be copied with VacuumInto.
+func (r *SnapshotRepository) Supported() bool {
+	return r.db.Dialect() == dialect.SQLite
+}
+
+// VacuumInto writes a consistent copy of the SQLite database to path, the file must not
+// exist. Other connections can keep reading and writing while the copy is written.
+func (r *SnapshotRepository) VacuumInto(ctx context.Context, path string) error {
+	if !r.Supported() {
+		return fmt.Errorf("snapshots are not supported by the %s driver", r.db.Dialect())
+	}
+
+	_, err := r.db.Sql().ExecContext(ctx, "VACUUM INTO ?", path)
+	return err
+}
@@ -1,6 +1,7 @@
 package repo
 
 import (
+	"bytes"
 	"context"
 	"time"
 
@@ -8,6 +9,9 @@ import (
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authroles"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/predicate"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
 	"github.com/hay-kot/homebox/backend/pkgs/hasher"
 	"github.com/hay-kot/homebox/backend/pkgs/set"
 )
@@ -21,32 +25,110 @@ type (
 		TokenHash []byte    `json:"token"`
 		UserID    uuid.UUID `json:"userId"`
 		ExpiresAt time.Time `json:"expiresAt"`
+		// Name is only set for API keys
+		Name string `json:"name"`
+		// SessionID links an attachment token to the user token of its session.
+		SessionID uuid.UUID `json:"sessionId"`
+		// GroupID binds the token to a group, tokens without a group act in the
+		// default group of the user.
+		GroupID uuid.UUID `json:"groupId"`
 	}
 
 	UserAuthToken struct {
 		UserAuthTokenCreate
+		ID        uuid.UUID `json:"id"`
 		CreatedAt time.Time `json:"createdAt"`
 	}
+
+	APIKeyCreate struct {
+		Name      string     `json:"name"      validate:"required,min=1,max=255"`
+		Scope     string     `json:"scope"     validate:"required,oneof=items_read items_write attachments"`
+		ExpiresAt *time.Time `json:"expiresAt" extensions:"x-nullable"`
+	}
+
+	APIKeyOut struct {
+		ID        uuid.UUID `json:"id"`
+		Name      string    `json:"name"`
+		Scope     string    `json:"scope"`
+		CreatedAt time.Time `json:"createdAt"`
+		ExpiresAt time.Time `json:"expiresAt"`
+	}
+
+	// UserSessionOut is a login of a user, Current is set for the session of the request.
+	UserSessionOut struct {
+		ID         uuid.UUID  `json:"id"`
+		CreatedAt  time.Time  `json:"createdAt"`
+		ExpiresAt  time.Time  `json:"expiresAt"`
+		LastUsedAt *time.Time `json:"lastUsedAt" extensions:"x-nullable"`
+		IPAddress  string     `json:"ipAddress"`
+		UserAgent  string     `json:"userAgent"`
+		Current    bool       `json:"current"`
+	}
 )
 
+func mapAPIKeyOut(token *ent.AuthTokens) APIKeyOut {
+	var scope string
+	if token.Edges.Roles != nil {
+		scope = token.Edges.Roles.Role.String()
+	}
+
+	return APIKeyOut{
+		ID:        token.ID,
+		Name:      token.Name,
+		Scope:     scope,
+		CreatedAt: token.CreatedAt,
+		ExpiresAt: token.ExpiresAt,
+	}
+}
+
 func (u UserAuthToken) IsExpired() bool {
 	return u.ExpiresAt.Before(time.Now())
 }
 
-// GetUserFromToken get's a user from a token
+// GetUserFromToken get's a user from a token, the user is mapped in the group of the token.
+// Sessions whose group the user is no longer a member of fall back to the default group
+// of the user, API keys become invalid. Tokens of disabled users are not found.
 func (r *TokenRepository) GetUserFromToken(ctx context.Context, token []byte) (UserOut, error) {
-	user, err := r.db.AuthTokens.Query().
+	dbToken, err := r.db.AuthTokens.Query().
 		Where(authtokens.Token(token)).
 		Where(authtokens.ExpiresAtGTE(time.Now())).
-		WithUser().
-		QueryUser().
-		WithGroup().
+		Where(authtokens.HasUserWith(user.DisabledAtIsNil())).
+		WithUser(func(q *ent.UserQuery) {
+			withUserGroups(q)
+		}).
 		Only(ctx)
 	if err != nil {
 		return UserOut{}, err
 	}
 
-	return mapUserOut(user), nil
+	usr := dbToken.Edges.User
+	if usr == nil {
+		return UserOut{}, ent.NewNotFoundError(user.Label)
+	}
+
+	if dbToken.GroupID != nil {
+		if m := membershipOf(usr, *dbToken.GroupID); m != nil {
+			return mapUserOutInGroup(usr, m), nil
+		}
+
+		if dbToken.Name != "" {
+			return UserOut{}, ent.NewNotFoundError(groupmembership.Label)
+		}
+	}
+
+	return mapUserOut(usr), nil
+}
+
+// SetGroup changes the group of the session of the token, the attachment token of the
+// session is changed as well.
+func (r *TokenRepository) SetGroup(ctx context.Context, token []byte, GID uuid.UUID) error {
+	return r.db.AuthTokens.Update().
+		Where(authtokens.Or(
+			authtokens.Token(token),
+			authtokens.HasSessionWith(authtokens.Token(token)),
+		)).
+		SetGroupID(GID).
+		Exec(ctx)
 }
 
 func (r *TokenRepository) GetRoles(ctx context.Context, token string) (*set.Set[string], error) {
@@ -73,11 +155,24 @@ func (r *TokenRepository) GetRoles(ctx context.Context, token string) (*set.Set[
 
 // CreateToken Creates a token for a user
 func (r *TokenRepository) CreateToken(ctx context.Context, createToken UserAuthTokenCreate, roles ...authroles.Role) (UserAuthToken, error) {
-	dbToken, err := r.db.AuthTokens.Create().
+	q := r.db.AuthTokens.Create().
 		SetToken(createToken.TokenHash).
 		SetUserID(createToken.UserID).
-		SetExpiresAt(createToken.ExpiresAt).
-		Save(ctx)
+		SetExpiresAt(createToken.ExpiresAt)
+
+	if createToken.Name != "" {
+		q.SetName(createToken.Name)
+	}
+
+	if createToken.SessionID != uuid.Nil {
+		q.SetSessionID(createToken.SessionID)
+	}
+
+	if createToken.GroupID != uuid.Nil {
+		q.SetGroupID(createToken.GroupID)
+	}
+
+	dbToken, err := q.Save(ctx)
 	if err != nil {
 		return UserAuthToken{}, err
 	}
@@ -97,11 +192,76 @@ func (r *TokenRepository) CreateToken(ctx context.Context, createToken UserAuthT
 			TokenHash: dbToken.Token,
 			UserID:    createToken.UserID,
 			ExpiresAt: dbToken.ExpiresAt,
+			Name:      dbToken.Name,
+			SessionID: createToken.SessionID,
+			GroupID:   createToken.GroupID,
 		},
+		ID:        dbToken.ID,
 		CreatedAt: dbToken.CreatedAt,
 	}, nil
 }
 
+// GetAPIKeys returns the API keys of a user, session tokens are not included.
+func (r *TokenRepository) GetAPIKeys(ctx context.Context, userID uuid.UUID) ([]APIKeyOut, error) {
+	tokens, err := r.db.AuthTokens.Query().
+		Where(
+			authtokens.HasUserWith(user.ID(userID)),
+			authtokens.NameNotNil(),
+		).
+		WithRoles().
+		Order(ent.Asc(authtokens.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]APIKeyOut, len(tokens))
+	for i, t := range tokens {
+		out[i] = mapAPIKeyOut(t)
+	}
+
+	return out, nil
+}
+
+// GetAPIKey returns a single API key of a user.
+func (r *TokenRepository) GetAPIKey(ctx context.Context, userID, ID uuid.UUID) (APIKeyOut, error) {
+	token, err := r.db.AuthTokens.Query().
+		Where(
+			authtokens.ID(ID),
+			authtokens.HasUserWith(user.ID(userID)),
+			authtokens.NameNotNil(),
+		).
+		WithRoles().
+		Only(ctx)
+	if err != nil {
+		return APIKeyOut{}, err
+	}
+
+	return mapAPIKeyOut(token), nil
+}
+
+// DeleteAPIKey revokes an API key of a user.
+func (r *TokenRepository) DeleteAPIKey(ctx context.Context, userID, ID uuid.UUID) error {
+	_, err := r.db.AuthTokens.Delete().
+		Where(
+			authtokens.ID(ID),
+			authtokens.HasUserWith(user.ID(userID)),
+			authtokens.NameNotNil(),
+		).
+		Exec(ctx)
+	return err
+}
+
+// DeleteAPIKeys revokes all API keys of a user.
+func (r *TokenRepository) DeleteAPIKeys(ctx context.Context, userID uuid.UUID) (int, error) {
+	return r.db.AuthTokens.Delete().
+		Where(
+			authtokens.HasUserWith(user.ID(userID)),
+			authtokens.NameNotNil(),
+		).
+		Exec(ctx)
+}
+
 // DeleteToken remove a single token from the database - equivalent to revoke or logout
 func (r *TokenRepository) DeleteToken(ctx context.Context, token []byte) error {
 	_, err := r.db.AuthTokens.Delete().Where(authtokens.Token(token)).Exec(ctx)
@@ -122,3 +282,133 @@ func (r *TokenRepository) DeleteAll(ctx context.Context) (int, error) {
 	amount, err := r.db.AuthTokens.Delete().Exec(ctx)
 	return amount, err
 }
+
+// DeleteSessions revokes all session tokens of a user, API keys are kept.
+func (r *TokenRepository) DeleteSessions(ctx context.Context, userID uuid.UUID) (int, error) {
+	return r.db.AuthTokens.Delete().
+		Where(
+			authtokens.HasUserWith(user.ID(userID)),
+			authtokens.NameIsNil(),
+		).
+		Exec(ctx)
+}
+
+// userSessions matches the user tokens of the sessions of a user, API keys and the
+// attachment tokens of the sessions are not included.
+func userSessions(userID uuid.UUID) predicate.AuthTokens {
+	return authtokens.And(
+		authtokens.HasUserWith(user.ID(userID)),
+		authtokens.NameIsNil(),
+		authtokens.HasRolesWith(authroles.RoleEQ(authroles.RoleUser)),
+	)
+}
+
+// GetSessions returns the sessions of a user that have not expired, most recently used
+// first. The session of the current token hash is marked as current.
+func (r *TokenRepository) GetSessions(ctx context.Context, userID uuid.UUID, current []byte) ([]UserSessionOut, error) {
+	tokens, err := r.db.AuthTokens.Query().
+		Where(
+			userSessions(userID),
+			authtokens.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(authtokens.FieldLastUsedAt), ent.Desc(authtokens.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]UserSessionOut, len(tokens))
+	for i, t := range tokens {
+		out[i] = UserSessionOut{
+			ID:         t.ID,
+			CreatedAt:  t.CreatedAt,
+			ExpiresAt:  t.ExpiresAt,
+			LastUsedAt: t.LastUsedAt,
+			IPAddress:  t.IPAddress,
+			UserAgent:  t.UserAgent,
+			Current:    bytes.Equal(t.Token, current),
+		}
+	}
+
+	return out, nil
+}
+
+// RecordUse stores the time, address and user agent of a request made with the token. The
+// token is only updated when the client changed or the last use is older than interval,
+// so that not every request writes to the database.
+func (r *TokenRepository) RecordUse(ctx context.Context, token []byte, ipAddress, userAgent string, interval time.Duration) error {
+	t, err := r.db.AuthTokens.Query().
+		Where(authtokens.Token(token)).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if t.LastUsedAt != nil && now.Sub(*t.LastUsedAt) < interval && t.IPAddress == ipAddress && t.UserAgent == userAgent {
+		return nil
+	}
+
+	return r.db.AuthTokens.UpdateOneID(t.ID).
+		SetLastUsedAt(now).
+		SetIPAddress(truncate(ipAddress, 64)).
+		SetUserAgent(truncate(userAgent, 512)).
+		Exec(ctx)
+}
+
+// DeleteSession revokes a session of a user along with its attachment token.
+func (r *TokenRepository) DeleteSession(ctx context.Context, userID, ID uuid.UUID) error {
+	n, err := r.db.AuthTokens.Delete().
+		Where(
+			authtokens.ID(ID),
+			userSessions(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return ent.NewNotFoundError(authtokens.Label)
+	}
+
+	// Attachment tokens are removed by the foreign key, databases created without
+	// foreign key enforcement are cleaned up here.
+	_, err = r.db.AuthTokens.Delete().
+		Where(authtokens.SessionID(ID)).
+		Exec(ctx)
+	return err
+}
+
+// DeleteOtherSessions revokes all sessions of a user except the session of the token
+// hash and returns the number of revoked sessions, API keys are kept.
+func (r *TokenRepository) DeleteOtherSessions(ctx context.Context, userID uuid.UUID, keep []byte) (int, error) {
+	n, err := r.db.AuthTokens.Delete().
+		Where(
+			userSessions(userID),
+			authtokens.Not(authtokens.Token(keep)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Removes the attachment tokens of the revoked sessions left by databases without
+	// foreign key enforcement and those created before tokens were linked to sessions.
+	_, err = r.db.AuthTokens.Delete().
+		Where(
+			authtokens.HasUserWith(user.ID(userID)),
+			authtokens.NameIsNil(),
+			authtokens.Not(authtokens.Token(keep)),
+			authtokens.Or(
+				authtokens.SessionIDIsNil(),
+				authtokens.Not(authtokens.HasSessionWith(authtokens.Token(keep))),
+			),
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
@@ -5,6 +5,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/authroles"
 	"github.com/hay-kot/homebox/backend/pkgs/hasher"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -132,3 +135,91 @@ func TestAuthTokenRepo_PurgeExpiredTokens(t *testing.T) {
 	_, err = tRepos.AuthTokens.DeleteAll(ctx)
 	require.NoError(t, err)
 }
+
+func TestAuthTokenRepo_APIKeys(t *testing.T) {
+	ctx := context.Background()
+
+	userOut, err := tRepos.Users.Create(ctx, userFactory())
+	require.NoError(t, err)
+
+	// Session tokens are not API keys
+	_, err = tRepos.AuthTokens.CreateToken(ctx, UserAuthTokenCreate{
+		TokenHash: hasher.GenerateToken().Hash,
+		ExpiresAt: time.Now().Add(time.Hour),
+		UserID:    userOut.ID,
+	}, authroles.RoleUser)
+	require.NoError(t, err)
+
+	key, err := tRepos.AuthTokens.CreateToken(ctx, UserAuthTokenCreate{
+		TokenHash: hasher.GenerateToken().Hash,
+		ExpiresAt: time.Now().Add(time.Hour),
+		UserID:    userOut.ID,
+		Name:      "cron",
+	}, authroles.RoleItemsRead)
+	require.NoError(t, err)
+
+	keys, err := tRepos.AuthTokens.GetAPIKeys(ctx, userOut.ID)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, key.ID, keys[0].ID)
+	assert.Equal(t, "cron", keys[0].Name)
+	assert.Equal(t, authroles.RoleItemsRead.String(), keys[0].Scope)
+
+	// Other users cannot revoke the key
+	require.NoError(t, tRepos.AuthTokens.DeleteAPIKey(ctx, tUser.ID, key.ID))
+	_, err = tRepos.AuthTokens.GetAPIKey(ctx, userOut.ID, key.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, tRepos.AuthTokens.DeleteAPIKey(ctx, userOut.ID, key.ID))
+	keys, err = tRepos.AuthTokens.GetAPIKeys(ctx, userOut.ID)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	// Cleanup
+	require.NoError(t, tRepos.Users.Delete(ctx, userOut.ID))
+}
+
+func TestAuthTokenRepo_RecordUse(t *testing.T) {
+	ctx := context.Background()
+
+	userOut, err := tRepos.Users.Create(ctx, userFactory())
+	require.NoError(t, err)
+
+	generatedToken := hasher.GenerateToken()
+	_, err = tRepos.AuthTokens.CreateToken(ctx, UserAuthTokenCreate{
+		TokenHash: generatedToken.Hash,
+		ExpiresAt: time.Now().Add(time.Hour),
+		UserID:    userOut.ID,
+	}, authroles.RoleUser)
+	require.NoError(t, err)
+
+	session := func() UserSessionOut {
+		sessions, err := tRepos.AuthTokens.GetSessions(ctx, userOut.ID, generatedToken.Hash)
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		assert.True(t, sessions[0].Current)
+		return sessions[0]
+	}
+
+	assert.Nil(t, session().LastUsedAt)
+
+	require.NoError(t, tRepos.AuthTokens.RecordUse(ctx, generatedToken.Hash, "192.0.2.1", "Firefox", time.Hour))
+	first := session()
+	require.NotNil(t, first.LastUsedAt)
+	assert.Equal(t, "192.0.2.1", first.IPAddress)
+
+	// Requests of the same client within the interval are not recorded.
+	require.NoError(t, tRepos.AuthTokens.RecordUse(ctx, generatedToken.Hash, "192.0.2.1", "Firefox", time.Hour))
+	assert.Equal(t, *first.LastUsedAt, *session().LastUsedAt)
+
+	require.NoError(t, tRepos.AuthTokens.RecordUse(ctx, generatedToken.Hash, "192.0.2.2", "Firefox", time.Hour))
+	assert.Equal(t, "192.0.2.2", session().IPAddress)
+
+	require.NoError(t, tRepos.Users.Delete(ctx, userOut.ID))
+}
+
+func TestAuthTokenRepo_DeleteSession_NotFound(t *testing.T) {
+	err := tRepos.AuthTokens.DeleteSession(context.Background(), tUser.ID, uuid.New())
+	require.True(t, ent.IsNotFound(err))
+	assert.Equal(t, "ent: auth_tokens not found", err.Error())
+}
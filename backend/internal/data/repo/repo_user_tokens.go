@@ -0,0 +1,133 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/usertoken"
+)
+
+// UserTokenRepository stores the single-use tokens mailed to users to reset their
+// password or verify their email address. Only the hash of a token is stored.
+type UserTokenRepository struct {
+	db *ent.Client
+}
+
+type (
+	UserTokenCreate struct {
+		UserID    uuid.UUID
+		Purpose   usertoken.Purpose
+		TokenHash []byte
+		ExpiresAt time.Time
+	}
+
+	UserTokenOut struct {
+		ID       uuid.UUID
+		UserID   uuid.UUID
+		Attempts int
+	}
+)
+
+// Create stores a new token and removes the previous tokens of the user with the
+// same purpose, so that only the most recently mailed token can be used.
+func (r *UserTokenRepository) Create(ctx context.Context, data UserTokenCreate) error {
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.UserToken.Delete().
+		Where(usertoken.UserID(data.UserID), usertoken.PurposeEQ(data.Purpose)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.UserToken.Create().
+		SetUserID(data.UserID).
+		SetPurpose(data.Purpose).
+		SetToken(data.TokenHash).
+		SetExpiresAt(data.ExpiresAt).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Get returns a token that has not expired without consuming it.
+func (r *UserTokenRepository) Get(ctx context.Context, purpose usertoken.Purpose, tokenHash []byte) (UserTokenOut, error) {
+	token, err := r.db.UserToken.Query().
+		Where(
+			usertoken.Token(tokenHash),
+			usertoken.PurposeEQ(purpose),
+			usertoken.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+	if err != nil {
+		return UserTokenOut{}, err
+	}
+
+	return UserTokenOut{
+		ID:       token.ID,
+		UserID:   token.UserID,
+		Attempts: token.Attempts,
+	}, nil
+}
+
+// RecordFailure counts a failed attempt of the token, the token is deleted once it
+// reaches maxAttempts.
+func (r *UserTokenRepository) RecordFailure(ctx context.Context, ID uuid.UUID, maxAttempts int) error {
+	token, err := r.db.UserToken.UpdateOneID(ID).
+		AddAttempts(1).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if token.Attempts >= maxAttempts {
+		return r.db.UserToken.DeleteOneID(ID).Exec(ctx)
+	}
+
+	return nil
+}
+
+// Consume deletes the token and returns the user it was issued to. An *ent.NotFoundError
+// is returned when the token does not exist, has expired or has a different purpose.
+func (r *UserTokenRepository) Consume(ctx context.Context, purpose usertoken.Purpose, tokenHash []byte) (uuid.UUID, error) {
+	token, err := r.db.UserToken.Query().
+		Where(
+			usertoken.Token(tokenHash),
+			usertoken.PurposeEQ(purpose),
+			usertoken.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// Deleting by ID makes sure that a token used concurrently is only consumed once.
+	n, err := r.db.UserToken.Delete().
+		Where(usertoken.ID(token.ID)).
+		Exec(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if n == 0 {
+		return uuid.Nil, ent.NewNotFoundError(usertoken.Label)
+	}
+
+	return token.UserID, nil
+}
+
+// PurgeExpired removes all expired tokens.
+func (r *UserTokenRepository) PurgeExpired(ctx context.Context) (int, error) {
+	return r.db.UserToken.Delete().
+		Where(usertoken.ExpiresAtLTE(time.Now())).
+		Exec(ctx)
+}
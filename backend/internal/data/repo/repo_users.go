@@ -2,9 +2,14 @@ package repo
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/authtokens"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/group"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/groupmembership"
 	"github.com/hay-kot/homebox/backend/internal/data/ent/user"
 )
 
@@ -23,6 +28,8 @@ type (
 		IsSuperuser bool      `json:"isSuperuser"`
 		GroupID     uuid.UUID `json:"groupID"`
 		IsOwner     bool      `json:"isOwner"`
+		// Activated marks the email address as verified when the user is created.
+		Activated bool `json:"-"`
 	}
 
 	UserUpdate struct {
@@ -31,14 +38,50 @@ type (
 	}
 
 	UserOut struct {
-		ID           uuid.UUID `json:"id"`
-		Name         string    `json:"name"`
-		Email        string    `json:"email"`
-		IsSuperuser  bool      `json:"isSuperuser"`
+		ID          uuid.UUID `json:"id"`
+		Name        string    `json:"name"`
+		Email       string    `json:"email"`
+		IsSuperuser bool      `json:"isSuperuser"`
+		// GroupID is the active group of the user, Role and IsOwner describe the
+		// membership of the user in it.
 		GroupID      uuid.UUID `json:"groupId"`
 		GroupName    string    `json:"groupName"`
 		PasswordHash string    `json:"-"`
 		IsOwner      bool      `json:"isOwner"`
+		Role         string    `json:"role"`
+		// EmailVerified is true once the user verified their email address.
+		EmailVerified bool `json:"emailVerified"`
+		// TwoFactorEnabled is true when the login requires a TOTP or recovery code.
+		TwoFactorEnabled bool `json:"twoFactorEnabled"`
+		// Disabled is true when a superuser disabled the account.
+		Disabled bool `json:"disabled"`
+	}
+
+	// UserUsage describes an account for the administration of the instance.
+	UserUsage struct {
+		ID               uuid.UUID            `json:"id"`
+		Name             string               `json:"name"`
+		Email            string               `json:"email"`
+		IsSuperuser      bool                 `json:"isSuperuser"`
+		EmailVerified    bool                 `json:"emailVerified"`
+		TwoFactorEnabled bool                 `json:"twoFactorEnabled"`
+		CreatedAt        time.Time            `json:"createdAt"`
+		DisabledAt       *time.Time           `json:"disabledAt"   extensions:"x-nullable"`
+		Groups           []GroupMembershipOut `json:"groups"`
+		// Sessions is the number of active sessions, LastActiveAt the time of the last
+		// request made with any token of the user.
+		Sessions     int        `json:"sessions"`
+		APIKeys      int        `json:"apiKeys"`
+		LastActiveAt *time.Time `json:"lastActiveAt" extensions:"x-nullable"`
+	}
+
+	// UserTwoFactor is the two-factor authentication state of a user, Secret is set
+	// during enrollment before Enabled.
+	UserTwoFactor struct {
+		Enabled       bool
+		Secret        string
+		LastStep      int64
+		RecoveryCodes []string
 	}
 )
 
@@ -47,53 +90,148 @@ var (
 	mapUsersOutErr = mapTEachErrFunc(mapUserOut)
 )
 
-func mapUserOut(user *ent.User) UserOut {
-	return UserOut{
-		ID:           user.ID,
-		Name:         user.Name,
-		Email:        user.Email,
-		IsSuperuser:  user.IsSuperuser,
-		GroupID:      user.Edges.Group.ID,
-		GroupName:    user.Edges.Group.Name,
-		PasswordHash: user.Password,
-		IsOwner:      user.Role == "owner",
+// withUserGroups loads the default group and the memberships of the users, they are
+// required to map a user.
+func withUserGroups(q *ent.UserQuery) *ent.UserQuery {
+	return q.
+		WithGroup().
+		WithMemberships(func(mq *ent.GroupMembershipQuery) {
+			mq.WithGroup()
+		})
+}
+
+// membershipOf returns the membership of the user in the group, or nil when the user is
+// not a member of it.
+func membershipOf(usr *ent.User, GID uuid.UUID) *ent.GroupMembership {
+	for _, m := range usr.Edges.Memberships {
+		if m.GroupID == GID {
+			return m
+		}
+	}
+	return nil
+}
+
+// mapUserOut maps the user within their default group.
+func mapUserOut(usr *ent.User) UserOut {
+	return mapUserOutInGroup(usr, membershipOf(usr, usr.Edges.Group.ID))
+}
+
+// mapUserOutInGroup maps the user as a member of the group of the membership. Without a
+// membership the user is mapped in their default group without a role.
+func mapUserOutInGroup(usr *ent.User, m *ent.GroupMembership) UserOut {
+	out := UserOut{
+		ID:               usr.ID,
+		Name:             usr.Name,
+		Email:            usr.Email,
+		IsSuperuser:      usr.IsSuperuser,
+		GroupID:          usr.Edges.Group.ID,
+		GroupName:        usr.Edges.Group.Name,
+		PasswordHash:     usr.Password,
+		EmailVerified:    !usr.ActivatedOn.IsZero(),
+		TwoFactorEnabled: usr.TotpEnabled,
+		Disabled:         usr.DisabledAt != nil,
 	}
+
+	if m != nil {
+		out.GroupID = m.GroupID
+		out.GroupName = m.Edges.Group.Name
+		out.Role = m.Role.String()
+		out.IsOwner = m.Role == groupmembership.RoleOwner
+	}
+
+	return out
 }
 
 func (r *UserRepository) GetOneID(ctx context.Context, ID uuid.UUID) (UserOut, error) {
-	return mapUserOutErr(r.db.User.Query().
+	return mapUserOutErr(withUserGroups(r.db.User.Query()).
 		Where(user.ID(ID)).
-		WithGroup().
 		Only(ctx))
 }
 
+// GetMember returns the user as a member of the group, a NotFoundError is returned when
+// the user is not a member of the group.
+func (r *UserRepository) GetMember(ctx context.Context, GID, ID uuid.UUID) (UserOut, error) {
+	usr, err := withUserGroups(r.db.User.Query()).
+		Where(
+			user.ID(ID),
+			user.HasMembershipsWith(groupmembership.GroupID(GID)),
+		).
+		Only(ctx)
+	if err != nil {
+		return UserOut{}, err
+	}
+
+	return mapUserOutInGroup(usr, membershipOf(usr, GID)), nil
+}
+
+// GetMembers returns the members of the group ordered by name, mapped as members of the
+// group.
+func (r *UserRepository) GetMembers(ctx context.Context, GID uuid.UUID) ([]UserOut, error) {
+	users, err := withUserGroups(r.db.User.Query()).
+		Where(user.HasMembershipsWith(groupmembership.GroupID(GID))).
+		Order(ent.Asc(user.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEach(users, func(usr *ent.User) UserOut {
+		return mapUserOutInGroup(usr, membershipOf(usr, GID))
+	}), nil
+}
+
 func (r *UserRepository) GetOneEmail(ctx context.Context, email string) (UserOut, error) {
-	return mapUserOutErr(r.db.User.Query().
+	return mapUserOutErr(withUserGroups(r.db.User.Query()).
 		Where(user.EmailEqualFold(email)).
-		WithGroup().
 		Only(ctx),
 	)
 }
 
 func (r *UserRepository) GetAll(ctx context.Context) ([]UserOut, error) {
-	return mapUsersOutErr(r.db.User.Query().WithGroup().All(ctx))
+	return mapUsersOutErr(withUserGroups(r.db.User.Query()).All(ctx))
 }
 
+// Create creates the user as a member of the group, the group is the default group of
+// the user.
 func (r *UserRepository) Create(ctx context.Context, usr UserCreate) (UserOut, error) {
-	role := user.RoleUser
+	role := groupmembership.RoleEditor
 	if usr.IsOwner {
-		role = user.RoleOwner
+		role = groupmembership.RoleOwner
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return UserOut{}, err
 	}
+	defer func() { _ = tx.Rollback() }()
 
-	entUser, err := r.db.User.
+	q := tx.User.
 		Create().
 		SetName(usr.Name).
 		SetEmail(usr.Email).
 		SetPassword(usr.Password).
 		SetIsSuperuser(usr.IsSuperuser).
+		SetGroupID(usr.GroupID)
+
+	if usr.Activated {
+		q.SetActivatedOn(time.Now())
+	}
+
+	entUser, err := q.Save(ctx)
+	if err != nil {
+		return UserOut{}, err
+	}
+
+	err = tx.GroupMembership.Create().
+		SetUserID(entUser.ID).
 		SetGroupID(usr.GroupID).
 		SetRole(role).
-		Save(ctx)
+		Exec(ctx)
+	if err != nil {
+		return UserOut{}, err
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return UserOut{}, err
 	}
@@ -133,3 +271,163 @@ func (r *UserRepository) GetSuperusers(ctx context.Context) ([]*ent.User, error)
 func (r *UserRepository) ChangePassword(ctx context.Context, UID uuid.UUID, pw string) error {
 	return r.db.User.UpdateOneID(UID).SetPassword(pw).Exec(ctx)
 }
+
+// Activate marks the email address of the user as verified, the activation date of
+// users that are already activated is kept.
+func (r *UserRepository) Activate(ctx context.Context, ID uuid.UUID) error {
+	return r.db.User.Update().
+		Where(user.ID(ID), user.ActivatedOnIsNil()).
+		SetActivatedOn(time.Now()).
+		Exec(ctx)
+}
+
+func (r *UserRepository) GetTwoFactor(ctx context.Context, ID uuid.UUID) (UserTwoFactor, error) {
+	usr, err := r.db.User.Get(ctx, ID)
+	if err != nil {
+		return UserTwoFactor{}, err
+	}
+
+	return UserTwoFactor{
+		Enabled:       usr.TotpEnabled,
+		Secret:        usr.TotpSecret,
+		LastStep:      usr.TotpLastStep,
+		RecoveryCodes: usr.TotpRecoveryCodes,
+	}, nil
+}
+
+// SetTwoFactorSecret starts the two-factor enrollment of a user, two-factor authentication
+// stays disabled until EnableTwoFactor is called.
+func (r *UserRepository) SetTwoFactorSecret(ctx context.Context, ID uuid.UUID, secret string) error {
+	return r.db.User.UpdateOneID(ID).
+		SetTotpSecret(secret).
+		SetTotpEnabled(false).
+		SetTotpLastStep(0).
+		ClearTotpRecoveryCodes().
+		Exec(ctx)
+}
+
+// EnableTwoFactor completes the enrollment, step is the time step of the code used to
+// confirm the enrollment and recoveryCodes are the hashes of the recovery codes.
+func (r *UserRepository) EnableTwoFactor(ctx context.Context, ID uuid.UUID, step int64, recoveryCodes []string) error {
+	return r.db.User.UpdateOneID(ID).
+		SetTotpEnabled(true).
+		SetTotpLastStep(step).
+		SetTotpRecoveryCodes(recoveryCodes).
+		Exec(ctx)
+}
+
+func (r *UserRepository) DisableTwoFactor(ctx context.Context, ID uuid.UUID) error {
+	return r.db.User.UpdateOneID(ID).
+		ClearTotpSecret().
+		SetTotpEnabled(false).
+		SetTotpLastStep(0).
+		ClearTotpRecoveryCodes().
+		Exec(ctx)
+}
+
+// SetRecoveryCodes replaces the recovery code hashes of a user.
+func (r *UserRepository) SetRecoveryCodes(ctx context.Context, ID uuid.UUID, recoveryCodes []string) error {
+	return r.db.User.UpdateOneID(ID).
+		SetTotpRecoveryCodes(recoveryCodes).
+		Exec(ctx)
+}
+
+// UseTOTPStep records the time step of an accepted code. False is returned when a code of
+// the same or a later step was used before, so every code is accepted only once.
+func (r *UserRepository) UseTOTPStep(ctx context.Context, ID uuid.UUID, step int64) (bool, error) {
+	n, err := r.db.User.Update().
+		Where(user.ID(ID), user.TotpLastStepLT(step)).
+		SetTotpLastStep(step).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return n == 1, nil
+}
+
+// GetAllUsage returns all accounts of the instance ordered by email address.
+func (r *UserRepository) GetAllUsage(ctx context.Context) ([]UserUsage, error) {
+	users, err := r.db.User.Query().
+		WithMemberships(func(q *ent.GroupMembershipQuery) {
+			q.WithGroup().Order(groupmembership.ByGroupField(group.FieldName))
+		}).
+		WithAuthTokens(func(q *ent.AuthTokensQuery) {
+			q.Where(authtokens.ExpiresAtGTE(time.Now()))
+		}).
+		Order(ent.Asc(user.FieldEmail)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapEach(users, func(usr *ent.User) UserUsage {
+		out := UserUsage{
+			ID:               usr.ID,
+			Name:             usr.Name,
+			Email:            usr.Email,
+			IsSuperuser:      usr.IsSuperuser,
+			EmailVerified:    !usr.ActivatedOn.IsZero(),
+			TwoFactorEnabled: usr.TotpEnabled,
+			CreatedAt:        usr.CreatedAt,
+			DisabledAt:       usr.DisabledAt,
+			Groups:           mapEach(usr.Edges.Memberships, mapGroupMembershipOut),
+		}
+
+		for _, t := range usr.Edges.AuthTokens {
+			switch {
+			case t.Name != "":
+				out.APIKeys++
+			case t.SessionID == nil:
+				out.Sessions++
+			}
+
+			if t.LastUsedAt != nil && (out.LastActiveAt == nil || t.LastUsedAt.After(*out.LastActiveAt)) {
+				out.LastActiveAt = t.LastUsedAt
+			}
+		}
+
+		return out
+	}), nil
+}
+
+// SetDisabled disables or enables the account of the user.
+func (r *UserRepository) SetDisabled(ctx context.Context, ID uuid.UUID, disabled bool) error {
+	q := r.db.User.UpdateOneID(ID)
+	if disabled {
+		q.SetDisabledAt(time.Now())
+	} else {
+		q.ClearDisabledAt()
+	}
+
+	return q.Exec(ctx)
+}
+
+// UseRecoveryCode removes the recovery code hash from the user. False is returned when the
+// user has no such recovery code.
+func (r *UserRepository) UseRecoveryCode(ctx context.Context, ID uuid.UUID, hash string) (bool, error) {
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	usr, err := tx.User.Get(ctx, ID)
+	if err != nil {
+		return false, err
+	}
+
+	idx := slices.Index(usr.TotpRecoveryCodes, hash)
+	if idx == -1 {
+		return false, nil
+	}
+
+	err = tx.User.UpdateOneID(ID).
+		SetTotpRecoveryCodes(slices.Delete(usr.TotpRecoveryCodes, idx, idx+1)).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
@@ -0,0 +1,176 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/internal/data/ent/webauthncredential"
+)
+
+// WebAuthnCredentialRepository stores the passkeys users registered to sign in without
+// a password.
+type WebAuthnCredentialRepository struct {
+	db *ent.Client
+}
+
+type (
+	// WebAuthnKey is the key material of a credential used to verify assertions.
+	WebAuthnKey struct {
+		CredentialID    []byte
+		PublicKey       []byte
+		AttestationType string
+		Transports      []string
+		AAGUID          []byte
+		SignCount       uint32
+		BackupEligible  bool
+		BackupState     bool
+	}
+
+	WebAuthnCredentialCreate struct {
+		Name string
+		Key  WebAuthnKey
+	}
+
+	WebAuthnCredentialUpdate struct {
+		Name string `json:"name" validate:"required,max=255"`
+	}
+
+	WebAuthnCredentialOut struct {
+		ID         uuid.UUID  `json:"id"`
+		Name       string     `json:"name"`
+		CreatedAt  time.Time  `json:"createdAt"`
+		LastUsedAt *time.Time `json:"lastUsedAt" extensions:"x-nullable"`
+	}
+
+	// WebAuthnCredential is a credential with its key material, it is never returned
+	// to clients.
+	WebAuthnCredential struct {
+		ID     uuid.UUID
+		UserID uuid.UUID
+		Key    WebAuthnKey
+	}
+)
+
+var (
+	mapWebAuthnCredentialOutErr = mapTErrFunc(mapWebAuthnCredentialOut)
+	mapWebAuthnCredentialsOut   = mapTEachErrFunc(mapWebAuthnCredentialOut)
+	mapWebAuthnCredentialErr    = mapTErrFunc(mapWebAuthnCredential)
+	mapWebAuthnCredentials      = mapTEachErrFunc(mapWebAuthnCredential)
+)
+
+func mapWebAuthnCredentialOut(c *ent.WebAuthnCredential) WebAuthnCredentialOut {
+	return WebAuthnCredentialOut{
+		ID:         c.ID,
+		Name:       c.Name,
+		CreatedAt:  c.CreatedAt,
+		LastUsedAt: c.LastUsedAt,
+	}
+}
+
+func mapWebAuthnCredential(c *ent.WebAuthnCredential) WebAuthnCredential {
+	return WebAuthnCredential{
+		ID:     c.ID,
+		UserID: c.UserID,
+		Key: WebAuthnKey{
+			CredentialID:    c.CredentialID,
+			PublicKey:       c.PublicKey,
+			AttestationType: c.AttestationType,
+			Transports:      c.Transports,
+			AAGUID:          c.Aaguid,
+			SignCount:       c.SignCount,
+			BackupEligible:  c.BackupEligible,
+			BackupState:     c.BackupState,
+		},
+	}
+}
+
+// GetAll returns the credentials of a user, most recently registered first.
+func (r *WebAuthnCredentialRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]WebAuthnCredentialOut, error) {
+	return mapWebAuthnCredentialsOut(r.db.WebAuthnCredential.Query().
+		Where(webauthncredential.UserID(userID)).
+		Order(ent.Desc(webauthncredential.FieldCreatedAt)).
+		All(ctx),
+	)
+}
+
+// GetKeys returns the credentials of a user including their key material.
+func (r *WebAuthnCredentialRepository) GetKeys(ctx context.Context, userID uuid.UUID) ([]WebAuthnCredential, error) {
+	return mapWebAuthnCredentials(r.db.WebAuthnCredential.Query().
+		Where(webauthncredential.UserID(userID)).
+		All(ctx),
+	)
+}
+
+// GetByCredentialID returns the credential with the ID assigned by the authenticator.
+func (r *WebAuthnCredentialRepository) GetByCredentialID(ctx context.Context, credentialID []byte) (WebAuthnCredential, error) {
+	return mapWebAuthnCredentialErr(r.db.WebAuthnCredential.Query().
+		Where(webauthncredential.CredentialID(credentialID)).
+		Only(ctx),
+	)
+}
+
+func (r *WebAuthnCredentialRepository) Create(ctx context.Context, userID uuid.UUID, data WebAuthnCredentialCreate) (WebAuthnCredentialOut, error) {
+	q := r.db.WebAuthnCredential.Create().
+		SetUserID(userID).
+		SetName(data.Name).
+		SetCredentialID(data.Key.CredentialID).
+		SetPublicKey(data.Key.PublicKey).
+		SetAttestationType(data.Key.AttestationType).
+		SetSignCount(data.Key.SignCount).
+		SetBackupEligible(data.Key.BackupEligible).
+		SetBackupState(data.Key.BackupState)
+
+	if len(data.Key.Transports) > 0 {
+		q.SetTransports(data.Key.Transports)
+	}
+
+	if len(data.Key.AAGUID) > 0 {
+		q.SetAaguid(data.Key.AAGUID)
+	}
+
+	return mapWebAuthnCredentialOutErr(q.Save(ctx))
+}
+
+// RecordUse stores the signature counter and backup state reported by the authenticator
+// with the last successful login.
+func (r *WebAuthnCredentialRepository) RecordUse(ctx context.Context, ID uuid.UUID, signCount uint32, backupState bool) error {
+	return r.db.WebAuthnCredential.UpdateOneID(ID).
+		SetSignCount(signCount).
+		SetBackupState(backupState).
+		SetLastUsedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (r *WebAuthnCredentialRepository) Rename(ctx context.Context, userID, ID uuid.UUID, data WebAuthnCredentialUpdate) (WebAuthnCredentialOut, error) {
+	n, err := r.db.WebAuthnCredential.Update().
+		Where(webauthncredential.ID(ID), webauthncredential.UserID(userID)).
+		SetName(data.Name).
+		Save(ctx)
+	if err != nil {
+		return WebAuthnCredentialOut{}, err
+	}
+
+	if n == 0 {
+		return WebAuthnCredentialOut{}, ent.NewNotFoundError(webauthncredential.Label)
+	}
+
+	return mapWebAuthnCredentialOutErr(r.db.WebAuthnCredential.Get(ctx, ID))
+}
+
+// Delete revokes a credential of a user.
+func (r *WebAuthnCredentialRepository) Delete(ctx context.Context, userID, ID uuid.UUID) error {
+	n, err := r.db.WebAuthnCredential.Delete().
+		Where(webauthncredential.ID(ID), webauthncredential.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return ent.NewNotFoundError(webauthncredential.Label)
+	}
+
+	return nil
+}
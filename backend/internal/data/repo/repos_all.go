@@ -2,35 +2,95 @@
 package repo
 
 import (
+	"time"
+
 	"github.com/hay-kot/homebox/backend/internal/core/services/reporting/eventbus"
 	"github.com/hay-kot/homebox/backend/internal/data/ent"
+	"github.com/hay-kot/homebox/backend/pkgs/blobstore"
 )
 
 // AllRepos is a container for all the repository interfaces
 type AllRepos struct {
-	Users       *UserRepository
-	AuthTokens  *TokenRepository
-	Groups      *GroupRepository
-	Locations   *LocationRepository
-	Labels      *LabelRepository
-	Items       *ItemsRepository
-	Docs        *DocumentRepository
-	Attachments *AttachmentRepo
-	MaintEntry  *MaintenanceEntryRepository
-	Notifiers   *NotifierRepository
+	Users                 *UserRepository
+	AuthTokens            *TokenRepository
+	UserTokens            *UserTokenRepository
+	WebAuthnCredentials   *WebAuthnCredentialRepository
+	Groups                *GroupRepository
+	Memberships           *GroupMembershipRepository
+	Locations             *LocationRepository
+	Labels                *LabelRepository
+	Items                 *ItemsRepository
+	Docs                  *DocumentRepository
+	Attachments           *AttachmentRepo
+	MaintEntry            *MaintenanceEntryRepository
+	MaintSchedules        *MaintenanceScheduleRepository
+	Notifiers             *NotifierRepository
+	NotificationRules     *NotificationRuleRepository
+	NotificationTemplates *NotificationTemplateRepository
+	Audit                 *AuditRepository
+	Search                *SearchRepository
+	SavedSearches         *SavedSearchRepository
+	Backups               *BackupRepository
+	Snapshots             *SnapshotRepository
+}
+
+type OptionsFunc func(*options)
+
+type options struct {
+	blobs   blobstore.Store
+	presign time.Duration
 }
 
-func New(db *ent.Client, bus *eventbus.EventBus, root string) *AllRepos {
+// WithBlobStore sets the store for document files, by default they are stored in the
+// root directory.
+func WithBlobStore(v blobstore.Store) OptionsFunc {
+	return func(o *options) {
+		o.blobs = v
+	}
+}
+
+// WithPresignedDownloads lets clients download document files from the blob store with
+// URLs valid for v, when the blob store supports presigned URLs.
+func WithPresignedDownloads(v time.Duration) OptionsFunc {
+	return func(o *options) {
+		o.presign = v
+	}
+}
+
+func New(db *ent.Client, bus *eventbus.EventBus, root string, opts ...OptionsFunc) *AllRepos {
+	options := &options{
+		blobs: blobstore.NewLocal(root),
+	}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	search := &SearchRepository{db: db}
+	items := &ItemsRepository{db, bus, search}
+	docs := &DocumentRepository{db, root, options.blobs, options.presign}
+
 	return &AllRepos{
-		Users:       &UserRepository{db},
-		AuthTokens:  &TokenRepository{db},
-		Groups:      NewGroupRepository(db),
-		Locations:   &LocationRepository{db, bus},
-		Labels:      &LabelRepository{db, bus},
-		Items:       &ItemsRepository{db, bus},
-		Docs:        &DocumentRepository{db, root},
-		Attachments: &AttachmentRepo{db},
-		MaintEntry:  &MaintenanceEntryRepository{db},
-		Notifiers:   NewNotifierRepository(db),
+		Users:                 &UserRepository{db},
+		AuthTokens:            &TokenRepository{db},
+		UserTokens:            &UserTokenRepository{db},
+		WebAuthnCredentials:   &WebAuthnCredentialRepository{db},
+		Groups:                NewGroupRepository(db),
+		Memberships:           &GroupMembershipRepository{db},
+		Locations:             &LocationRepository{db, bus, search},
+		Labels:                &LabelRepository{db, bus, search},
+		Items:                 items,
+		Docs:                  docs,
+		Attachments:           &AttachmentRepo{db},
+		MaintEntry:            &MaintenanceEntryRepository{db, search},
+		MaintSchedules:        &MaintenanceScheduleRepository{db, search},
+		Notifiers:             NewNotifierRepository(db),
+		NotificationRules:     &NotificationRuleRepository{db},
+		NotificationTemplates: &NotificationTemplateRepository{db},
+		Audit:                 &AuditRepository{db},
+		Search:                search,
+		SavedSearches:         &SavedSearchRepository{db, items},
+		Backups:               &BackupRepository{db, docs, search},
+		Snapshots:             &SnapshotRepository{db},
 	}
 }
@@ -0,0 +1,9 @@
+package types
+
+// FieldChange describes the change of a single field of an entity, where From and
+// To hold the JSON representation of the previous and current values.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
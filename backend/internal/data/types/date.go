@@ -29,6 +29,12 @@ func DateFromTime(t time.Time) Date {
 	return Date(dateOnlyTime)
 }
 
+// Today returns the current date in the given location. Dates are stored without
+// timezone, so "today" depends on the timezone it is observed from.
+func Today(loc *time.Location) Date {
+	return DateFromTime(time.Now().In(loc))
+}
+
 // DateFromString returns a Date type from a string by parsing the
 // string into a time.Time type and then stripping the time and
 // timezone information.
@@ -0,0 +1,21 @@
+package types
+
+import "github.com/google/uuid"
+
+// SavedQuery is the definition of a saved item search, it mirrors the item query
+// parameters of the items endpoint.
+type SavedQuery struct {
+	Search          string            `json:"search,omitempty"`
+	Filter          string            `json:"filter,omitempty"`
+	LocationIDs     []uuid.UUID       `json:"locationIds,omitempty"`
+	LabelIDs        []uuid.UUID       `json:"labelIds,omitempty"`
+	ParentIDs       []uuid.UUID       `json:"parentIds,omitempty"`
+	Fields          []SavedQueryField `json:"fields,omitempty"`
+	IncludeArchived bool              `json:"includeArchived,omitempty"`
+}
+
+// SavedQueryField is a custom field name and value that items must match.
+type SavedQueryField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
@@ -18,20 +18,30 @@ const (
 
 type Config struct {
 	conf.Version
-	Mode    string     `yaml:"mode"    conf:"default:development"` // development or production
-	Web     WebConfig  `yaml:"web"`
-	Storage Storage    `yaml:"storage"`
-	Log     LoggerConf `yaml:"logger"`
-	Mailer  MailerConf `yaml:"mailer"`
-	Demo    bool       `yaml:"demo"`
-	Debug   DebugConf  `yaml:"debug"`
-	Options Options    `yaml:"options"`
+	Mode      string        `yaml:"mode"       conf:"default:development"` // development or production
+	Web       WebConfig     `yaml:"web"`
+	Storage   Storage       `yaml:"storage"`
+	Log       LoggerConf    `yaml:"logger"`
+	Mailer    MailerConf    `yaml:"mailer"`
+	OIDC      OIDCConf      `yaml:"oidc"`
+	RateLimit RateLimitConf `yaml:"rate_limit"`
+	Backup    BackupConf    `yaml:"backup"`
+	Demo      bool          `yaml:"demo"`
+	Debug     DebugConf     `yaml:"debug"`
+	Options   Options       `yaml:"options"`
+	// Args holds the command and its arguments, the server is started without a command.
+	Args conf.Args
 }
 
 type Options struct {
-	AllowRegistration    bool   `yaml:"disable_registration"    conf:"default:true"`
-	AutoIncrementAssetID bool   `yaml:"auto_increment_asset_id" conf:"default:true"`
-	CurrencyConfig       string `yaml:"currencies"`
+	AllowRegistration    bool          `yaml:"disable_registration"    conf:"default:true"`
+	AutoIncrementAssetID bool          `yaml:"auto_increment_asset_id" conf:"default:true"`
+	CurrencyConfig       string        `yaml:"currencies"`
+	TrashRetention       time.Duration `yaml:"trash_retention"         conf:"default:720h"`
+	BaseURL              string        `yaml:"base_url"`
+	// RequireEmailVerification blocks the login of new users until they verified their
+	// email address, a mailer must be configured.
+	RequireEmailVerification bool `yaml:"require_email_verification" conf:"default:false"`
 }
 
 type DebugConf struct {
@@ -46,6 +56,11 @@ type WebConfig struct {
 	ReadTimeout   time.Duration `yaml:"read_timeout"    conf:"default:10s"`
 	WriteTimeout  time.Duration `yaml:"write_timeout"   conf:"default:10s"`
 	IdleTimeout   time.Duration `yaml:"idle_timeout"    conf:"default:30s"`
+	// TrustedProxies are the addresses and CIDR ranges of reverse proxies whose
+	// X-Forwarded-For and X-Real-IP headers are used as the client address. The headers
+	// of other clients are ignored, the per address login and rate limits would be
+	// bypassed otherwise.
+	TrustedProxies []string `yaml:"trusted_proxies"`
 }
 
 // New parses the CLI/Config file and returns a Config struct. If the file argument is an empty string, the
@@ -0,0 +1,13 @@
+package config
+
+import "time"
+
+// BackupConf configures the snapshots of the SQLite database and the storage directory,
+// snapshots are taken every Interval when Dir is set. The newest snapshot of each of the
+// last KeepDaily days and KeepWeekly weeks is kept.
+type BackupConf struct {
+	Dir        string        `yaml:"dir"`
+	Interval   time.Duration `yaml:"interval"    conf:"default:24h"`
+	KeepDaily  int           `yaml:"keep_daily"  conf:"default:7"`
+	KeepWeekly int           `yaml:"keep_weekly" conf:"default:4"`
+}
@@ -1,11 +1,38 @@
 package config
 
+import "time"
+
+const (
+	DriverSqlite3  = "sqlite3"
+	DriverPostgres = "postgres"
+)
+
 const (
-	DriverSqlite3 = "sqlite3"
+	BlobLocal = "local"
+	BlobS3    = "s3"
 )
 
 type Storage struct {
 	// Data is the path to the root directory
-	Data      string `yaml:"data"       conf:"default:./.data"`
-	SqliteURL string `yaml:"sqlite-url" conf:"default:./.data/homebox.db?_pragma=busy_timeout=999&_pragma=journal_mode=WAL&_fk=1"`
+	Data        string `yaml:"data"         conf:"default:./.data"`
+	Driver      string `yaml:"driver"       conf:"default:sqlite3"`
+	SqliteURL   string `yaml:"sqlite-url"   conf:"default:./.data/homebox.db?_pragma=busy_timeout=999&_pragma=journal_mode=WAL&_fk=1"`
+	PostgresURL string `yaml:"postgres-url"`
+	// Blob is where attachment files are stored, local stores them in the Data directory
+	// and s3 in an S3 compatible object store.
+	Blob string `yaml:"blob" conf:"default:local"`
+	S3   S3Conf `yaml:"s3"`
+}
+
+// S3Conf configures the S3 compatible object store for attachment files. PathStyle is
+// required by most self-hosted stores like MinIO. Downloads are redirected to presigned
+// URLs valid for PresignExpiry, with 0 they are streamed through Homebox.
+type S3Conf struct {
+	Endpoint        string        `yaml:"endpoint"`
+	Region          string        `yaml:"region"            conf:"default:us-east-1"`
+	Bucket          string        `yaml:"bucket"`
+	AccessKeyID     string        `yaml:"access_key_id"`
+	SecretAccessKey string        `yaml:"secret_access_key"`
+	PathStyle       bool          `yaml:"path_style"        conf:"default:false"`
+	PresignExpiry   time.Duration `yaml:"presign_expiry"    conf:"default:0s"`
 }
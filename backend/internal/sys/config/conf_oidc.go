@@ -0,0 +1,23 @@
+package config
+
+// OIDCConf configures the OpenID Connect login provider. The RedirectURL must point to
+// the callback route of the API, e.g. https://homebox.example.com/api/v1/users/login/oidc/callback
+// Users are matched by email, the ID token must contain the email_verified claim set to true.
+type OIDCConf struct {
+	Enabled      bool     `yaml:"enabled"       conf:"default:false"`
+	IssuerURL    string   `yaml:"issuer_url"`
+	ClientID     string   `yaml:"client_id"`
+	ClientSecret string   `yaml:"client_secret"`
+	RedirectURL  string   `yaml:"redirect_url"`
+	Scopes       []string `yaml:"scopes"        conf:"default:openid;profile;email"`
+	EmailClaim   string   `yaml:"email_claim"   conf:"default:email"`
+	NameClaim    string   `yaml:"name_claim"    conf:"default:name"`
+	// GroupID is the group that new users are added to when they sign in without an
+	// invitation. When empty, new users get their own group if registration is allowed.
+	GroupID string `yaml:"group_id"`
+}
+
+// Ready is a simple check to ensure that the required provider settings are set.
+func (oc *OIDCConf) Ready() bool {
+	return oc.IssuerURL != "" && oc.ClientID != "" && oc.RedirectURL != ""
+}
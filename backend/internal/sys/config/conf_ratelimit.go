@@ -0,0 +1,34 @@
+package config
+
+import "time"
+
+// RateLimitConf configures the throttling of failed logins and of requests that change
+// data. The limits are kept in memory and are reset when the server restarts.
+type RateLimitConf struct {
+	Login LoginLimitConf `yaml:"login"`
+	API   APILimitConf   `yaml:"api"`
+}
+
+// LoginLimitConf configures the delays and lockouts after failed password logins. Failures
+// are counted per account and per IP address, after FreeAttempts failures every further
+// attempt is delayed by BaseDelay, doubled with each failure up to MaxDelay. An account
+// or IP address that reaches its maximum is locked out for LockoutDuration. Failures are
+// forgotten after Window without a failure.
+type LoginLimitConf struct {
+	Enabled         bool          `yaml:"enabled"          conf:"default:true"`
+	FreeAttempts    int           `yaml:"free_attempts"    conf:"default:3"`
+	BaseDelay       time.Duration `yaml:"base_delay"       conf:"default:1s"`
+	MaxDelay        time.Duration `yaml:"max_delay"        conf:"default:30s"`
+	AccountAttempts int           `yaml:"account_attempts" conf:"default:10"`
+	IPAttempts      int           `yaml:"ip_attempts"      conf:"default:50"`
+	LockoutDuration time.Duration `yaml:"lockout_duration" conf:"default:15m"`
+	Window          time.Duration `yaml:"window"           conf:"default:1h"`
+}
+
+// APILimitConf configures a token bucket per user, or per IP address for anonymous
+// requests, for all requests that change data. Burst requests are allowed at once and
+// Rate requests per second on average. A rate of 0 disables the limit.
+type APILimitConf struct {
+	Rate  float64 `yaml:"rate"  conf:"default:10"`
+	Burst int     `yaml:"burst" conf:"default:50"`
+}
@@ -21,6 +21,22 @@ func NewUnauthorizedError() error {
 	return &UnauthorizedError{}
 }
 
+type ForbiddenError struct {
+}
+
+func (err *ForbiddenError) Error() string {
+	return "forbidden"
+}
+
+func IsForbiddenError(err error) bool {
+	var re *ForbiddenError
+	return errors.As(err, &re)
+}
+
+func NewForbiddenError() error {
+	return &ForbiddenError{}
+}
+
 type InvalidRouteKeyError struct {
 	key string
 }
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/hay-kot/homebox/backend/pkgs/recurrence"
 )
 
 var validate *validator.Validate
@@ -53,6 +54,15 @@ func init() { // nolint
 	if err != nil {
 		panic(err)
 	}
+
+	err = validate.RegisterValidation("recurrence", func(fl validator.FieldLevel) bool {
+		_, err := recurrence.Parse(fl.Field().String())
+		return err == nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
 }
 
 // Check a struct for validation errors and returns any errors the occur. This
@@ -1,6 +1,7 @@
 package mid
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/go-chi/chi/v5/middleware"
@@ -36,6 +37,11 @@ func Errors(log zerolog.Logger) errchain.ErrorHandler {
 					resp = ErrorResponse{
 						Error: "unauthorized",
 					}
+				case validate.IsForbiddenError(err):
+					code = http.StatusForbidden
+					resp = ErrorResponse{
+						Error: "forbidden",
+					}
 				case validate.IsInvalidRouteKeyError(err):
 					code = http.StatusBadRequest
 					resp = ErrorResponse{
@@ -44,7 +50,8 @@ func Errors(log zerolog.Logger) errchain.ErrorHandler {
 				case validate.IsFieldError(err):
 					code = http.StatusUnprocessableEntity
 
-					fieldErrors := err.(validate.FieldErrors) // nolint
+					var fieldErrors validate.FieldErrors
+					errors.As(err, &fieldErrors)
 					resp.Error = "Validation Error"
 					resp.Fields = map[string]string{}
 
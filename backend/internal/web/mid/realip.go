@@ -0,0 +1,111 @@
+package mid
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// ParseProxies parses the addresses and CIDR ranges of trusted reverse proxies.
+func ParseProxies(proxies []string) ([]netip.Prefix, error) {
+	out := make([]netip.Prefix, 0, len(proxies))
+	for _, p := range proxies {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+
+		if strings.Contains(p, "/") {
+			prefix, err := netip.ParsePrefix(p)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", p, err)
+			}
+			out = append(out, prefix.Masked())
+			continue
+		}
+
+		addr, err := netip.ParseAddr(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", p, err)
+		}
+		addr = addr.Unmap()
+		out = append(out, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+
+	return out, nil
+}
+
+// RealIP sets the remote address of requests sent by a trusted proxy to the client
+// address from the X-Forwarded-For or X-Real-IP header. The forwarding headers of
+// requests from any other address are ignored, as clients can set them to anything.
+func RealIP(trusted []netip.Prefix) func(http.Handler) http.Handler {
+	isTrusted := func(addr netip.Addr) bool {
+		for _, p := range trusted {
+			if p.Contains(addr) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			peer, err := netip.ParseAddr(ClientIP(r))
+			if err != nil || !isTrusted(peer.Unmap()) {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			if ip, ok := forwardedIP(r, isTrusted); ok {
+				r.RemoteAddr = ip.String()
+			}
+
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+// forwardedIP returns the client address of a request forwarded by a trusted proxy.
+// X-Forwarded-For is read from the right, the first address that is not a trusted
+// proxy itself is the client, the addresses left of it can be set by the client.
+func forwardedIP(r *http.Request, isTrusted func(netip.Addr) bool) (netip.Addr, bool) {
+	var hops []string
+	for _, v := range r.Header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(v, ",")...)
+	}
+
+	var client netip.Addr
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+
+		client = addr.Unmap()
+		if !isTrusted(client) {
+			return client, true
+		}
+	}
+
+	if client.IsValid() {
+		return client, true
+	}
+
+	addr, err := netip.ParseAddr(strings.TrimSpace(r.Header.Get("X-Real-IP")))
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
+
+// ClientIP returns the address of the client without the port. Behind a trusted proxy
+// the address is set from the forwarding headers by RealIP.
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
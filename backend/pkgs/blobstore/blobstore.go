@@ -0,0 +1,40 @@
+// Package blobstore stores files by key in a local directory or in an S3 compatible
+// object store. Keys are slash separated paths like "group/documents/file.jpg".
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"time"
+)
+
+var (
+	ErrNotFound   = errors.New("blob not found")
+	ErrInvalidKey = errors.New("invalid blob key")
+)
+
+// Store stores blobs by key.
+type Store interface {
+	// Put stores the content of r under key, replacing an existing blob.
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Get returns the content of the blob, ErrNotFound is returned when the blob does not
+	// exist. The caller has to close the reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the blob, deleting a blob that does not exist is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// Presigner is implemented by stores that can hand out URLs to download a blob without
+// credentials.
+type Presigner interface {
+	// PresignGet returns a URL to download the blob that is valid for expires.
+	PresignGet(ctx context.Context, key string, expires time.Duration) (string, error)
+}
+
+// validKey reports if the key is a relative slash separated path without "." or ".."
+// elements.
+func validKey(key string) bool {
+	return fs.ValidPath(key) && key != "."
+}
@@ -0,0 +1,57 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var tCtx = context.Background()
+
+// testStore runs the behavior every Store implementation shares.
+func testStore(t *testing.T, s Store) {
+	t.Helper()
+
+	read := func(key string) string {
+		t.Helper()
+
+		rc, err := s.Get(tCtx, key)
+		require.NoError(t, err)
+		defer func() { _ = rc.Close() }()
+
+		bts, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		return string(bts)
+	}
+
+	key := "group/documents/file.txt"
+
+	_, err := s.Get(tCtx, key)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, s.Put(tCtx, key, strings.NewReader("first")))
+	assert.Equal(t, "first", read(key))
+
+	require.NoError(t, s.Put(tCtx, key, strings.NewReader("second")))
+	assert.Equal(t, "second", read(key))
+
+	require.NoError(t, s.Delete(tCtx, key))
+	_, err = s.Get(tCtx, key)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// Deleting a missing blob is not an error.
+	require.NoError(t, s.Delete(tCtx, key))
+
+	for _, invalid := range []string{"", ".", "../file.txt", "/file.txt", "group/../file.txt"} {
+		err = s.Put(tCtx, invalid, strings.NewReader("invalid"))
+		assert.ErrorIs(t, err, ErrInvalidKey, invalid)
+	}
+}
+
+func TestLocal(t *testing.T) {
+	testStore(t, NewLocal(t.TempDir()))
+}
@@ -0,0 +1,93 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Local stores blobs as files below a directory, the key is the path of the file
+// relative to the directory.
+type Local struct {
+	dir string
+}
+
+func NewLocal(dir string) *Local {
+	return &Local{dir: dir}
+}
+
+func (l *Local) path(key string) (string, error) {
+	if !validKey(key) {
+		return "", ErrInvalidKey
+	}
+
+	return filepath.Join(l.dir, filepath.FromSlash(key)), nil
+}
+
+// Put writes the content to a temporary file that replaces the blob once it is
+// complete, readers never see a partial blob.
+func (l *Local) Put(ctx context.Context, key string, r io.Reader) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), ".blob-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+
+	_, err = io.Copy(f, r)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// Get returns the opened file, it implements io.ReadSeeker.
+func (l *Local) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (l *Local) Delete(ctx context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// S3Config configures an S3 compatible object store like AWS S3 or MinIO.
+type S3Config struct {
+	// Endpoint is the URL of the object store, for example https://s3.eu-west-1.amazonaws.com
+	// or http://minio:9000.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// PathStyle addresses the bucket as the first path element of the URL instead of as a
+	// subdomain of the endpoint, most self-hosted stores require it.
+	PathStyle bool
+}
+
+// S3 stores blobs as objects in a bucket of an S3 compatible object store.
+type S3 struct {
+	endpoint  *url.URL
+	bucket    string
+	pathStyle bool
+	signer    signer
+	client    *http.Client
+	now       func() time.Time
+}
+
+// S3Error is an error response of the object store.
+type S3Error struct {
+	StatusCode int
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+}
+
+func (e *S3Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("s3: status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("s3: %s: %s", e.Code, e.Message)
+}
+
+func NewS3(conf S3Config) (*S3, error) {
+	endpoint, err := url.Parse(conf.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid s3 endpoint: %w", err)
+	}
+
+	if endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", conf.Endpoint)
+	}
+
+	if conf.Bucket == "" {
+		return nil, errors.New("s3 bucket is required")
+	}
+
+	region := conf.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	return &S3{
+		endpoint:  endpoint,
+		bucket:    conf.Bucket,
+		pathStyle: conf.PathStyle,
+		signer: signer{
+			accessKeyID:     conf.AccessKeyID,
+			secretAccessKey: conf.SecretAccessKey,
+			region:          region,
+		},
+		client: http.DefaultClient,
+		now:    time.Now,
+	}, nil
+}
+
+func (s *S3) objectURL(key string) (*url.URL, error) {
+	if !validKey(key) {
+		return nil, ErrInvalidKey
+	}
+
+	u := *s.endpoint
+	base := strings.TrimSuffix(u.Path, "/")
+
+	if s.pathStyle {
+		u.Path = base + "/" + s.bucket + "/" + key
+	} else {
+		u.Host = s.bucket + "." + u.Host
+		u.Path = base + "/" + key
+	}
+
+	u.RawPath = ""
+	return &u, nil
+}
+
+func (s *S3) do(ctx context.Context, method, key string, body io.ReadSeeker, size int64, payloadHash string) (*http.Response, error) {
+	u, err := s.objectURL(key)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Body = io.NopCloser(body)
+		req.ContentLength = size
+		req.GetBody = func() (io.ReadCloser, error) {
+			_, err := body.Seek(0, io.SeekStart)
+			return io.NopCloser(body), err
+		}
+	}
+
+	s.signer.sign(req, payloadHash, s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 300 {
+		defer func() { _ = resp.Body.Close() }()
+
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, ErrNotFound
+		}
+
+		s3err := &S3Error{StatusCode: resp.StatusCode}
+		_ = xml.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(s3err)
+		return nil, s3err
+	}
+
+	return resp, nil
+}
+
+// Put buffers the content in a temporary file, the object store needs the size and the
+// hash of the content before it is sent.
+func (s *S3) Put(ctx context.Context, key string, r io.Reader) error {
+	f, err := os.CreateTemp("", "homebox-blob-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	h := sha256.New()
+	size, err := io.Copy(io.MultiWriter(f, h), r)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(ctx, http.MethodPut, key, f, size, hex.EncodeToString(h.Sum(nil)))
+	if err != nil {
+		return err
+	}
+
+	return resp.Body.Close()
+}
+
+// Get streams the object from the object store.
+func (s *S3) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil, 0, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+func (s *S3) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil, 0, emptyPayloadHash)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	return resp.Body.Close()
+}
+
+func (s *S3) PresignGet(ctx context.Context, key string, expires time.Duration) (string, error) {
+	u, err := s.objectURL(key)
+	if err != nil {
+		return "", err
+	}
+
+	s.signer.presign(http.MethodGet, u, expires, s.now())
+	return u.String(), nil
+}
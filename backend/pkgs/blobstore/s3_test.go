@@ -0,0 +1,255 @@
+package blobstore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3 is a minimal S3 compatible object store for path style requests. Like MinIO it
+// rejects requests with invalid signatures and payload hashes.
+type fakeS3 struct {
+	signer signer
+	bucket string
+
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func newFakeS3(t *testing.T, bucket string) (*fakeS3, *httptest.Server) {
+	t.Helper()
+
+	f := &fakeS3{
+		signer: signer{
+			accessKeyID:     "minio",
+			secretAccessKey: "minio-secret",
+			region:          "us-east-1",
+		},
+		bucket:  bucket,
+		objects: map[string][]byte{},
+	}
+
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+
+	return f, srv
+}
+
+func (f *fakeS3) error(w http.ResponseWriter, status int, code string) {
+	w.WriteHeader(status)
+	_, _ = fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, code)
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !f.authorized(r) {
+		f.error(w, http.StatusForbidden, "SignatureDoesNotMatch")
+		return
+	}
+
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+f.bucket+"/")
+	if !ok {
+		f.error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			f.error(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+
+		sum := sha256.Sum256(body)
+		if hex.EncodeToString(sum[:]) != r.Header.Get("X-Amz-Content-Sha256") {
+			f.error(w, http.StatusBadRequest, "XAmzContentSHA256Mismatch")
+			return
+		}
+
+		f.objects[key] = body
+	case http.MethodGet:
+		body, ok := f.objects[key]
+		if !ok {
+			f.error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+
+		_, _ = w.Write(body)
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		f.error(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+// authorized recomputes the signature of the request from the headers or the query of
+// a presigned URL.
+func (f *fakeS3) authorized(r *http.Request) bool {
+	q := r.URL.Query()
+
+	if sig := q.Get("X-Amz-Signature"); sig != "" {
+		now, err := time.Parse(sigDateLayout, q.Get("X-Amz-Date"))
+		if err != nil {
+			return false
+		}
+
+		var expires int
+		_, _ = fmt.Sscan(q.Get("X-Amz-Expires"), &expires)
+		if time.Since(now) > time.Duration(expires)*time.Second {
+			return false
+		}
+
+		q.Del("X-Amz-Signature")
+		canonicalRequest := strings.Join([]string{
+			r.Method,
+			canonicalURI(r.URL),
+			canonicalQuery(q),
+			"host:" + r.Host + "\n",
+			"host",
+			unsignedPayload,
+		}, "\n")
+
+		return sig == f.signer.signature(now, canonicalRequest)
+	}
+
+	auth := r.Header.Get("Authorization")
+
+	var signedHeaders, sig string
+	for _, part := range strings.Split(strings.TrimPrefix(auth, sigAlgorithm+" "), ",") {
+		k, v, _ := strings.Cut(part, "=")
+		switch k {
+		case "SignedHeaders":
+			signedHeaders = v
+		case "Signature":
+			sig = v
+		}
+	}
+
+	now, err := time.Parse(sigDateLayout, r.Header.Get("X-Amz-Date"))
+	if err != nil || signedHeaders == "" {
+		return false
+	}
+
+	var headers strings.Builder
+	for _, name := range strings.Split(signedHeaders, ";") {
+		v := r.Header.Get(name)
+		if name == "host" {
+			v = r.Host
+		}
+		headers.WriteString(name + ":" + v + "\n")
+	}
+
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		canonicalURI(r.URL),
+		canonicalQuery(q),
+		headers.String(),
+		signedHeaders,
+		r.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+
+	return sig == f.signer.signature(now, canonicalRequest)
+}
+
+func newTestS3(t *testing.T) (*S3, *fakeS3) {
+	t.Helper()
+
+	fake, srv := newFakeS3(t, "homebox")
+
+	s, err := NewS3(S3Config{
+		Endpoint:        srv.URL,
+		Bucket:          "homebox",
+		AccessKeyID:     fake.signer.accessKeyID,
+		SecretAccessKey: fake.signer.secretAccessKey,
+		PathStyle:       true,
+	})
+	require.NoError(t, err)
+
+	return s, fake
+}
+
+func TestS3(t *testing.T) {
+	s, _ := newTestS3(t)
+	testStore(t, s)
+}
+
+func TestS3_InvalidCredentials(t *testing.T) {
+	_, srv := newFakeS3(t, "homebox")
+
+	s, err := NewS3(S3Config{
+		Endpoint:        srv.URL,
+		Bucket:          "homebox",
+		AccessKeyID:     "minio",
+		SecretAccessKey: "wrong",
+		PathStyle:       true,
+	})
+	require.NoError(t, err)
+
+	err = s.Put(tCtx, "a.txt", strings.NewReader("a"))
+
+	var s3err *S3Error
+	require.ErrorAs(t, err, &s3err)
+	assert.Equal(t, http.StatusForbidden, s3err.StatusCode)
+	assert.Equal(t, "SignatureDoesNotMatch", s3err.Code)
+}
+
+func TestS3_PresignGet(t *testing.T) {
+	s, fake := newTestS3(t)
+
+	key := "group/documents/a b+c.txt"
+	require.NoError(t, s.Put(tCtx, key, strings.NewReader("presigned")))
+	assert.Contains(t, fake.objects, key)
+
+	u, err := s.PresignGet(tCtx, key, time.Minute)
+	require.NoError(t, err)
+
+	resp, err := http.Get(u)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "presigned", string(body))
+
+	// Expired URLs are rejected.
+	s.now = func() time.Time { return time.Now().Add(-time.Hour) }
+	u, err = s.PresignGet(tCtx, key, time.Minute)
+	require.NoError(t, err)
+
+	resp, err = http.Get(u)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestS3_ObjectURL(t *testing.T) {
+	s, err := NewS3(S3Config{Endpoint: "https://s3.eu-west-1.amazonaws.com", Bucket: "homebox"})
+	require.NoError(t, err)
+
+	u, err := s.objectURL("group/documents/file.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, "https://homebox.s3.eu-west-1.amazonaws.com/group/documents/file.jpg", u.String())
+
+	s.pathStyle = true
+	u, err = s.objectURL("group/documents/file.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, "https://s3.eu-west-1.amazonaws.com/homebox/group/documents/file.jpg", u.String())
+
+	_, err = s.objectURL("../file.jpg")
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
@@ -0,0 +1,186 @@
+package blobstore
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AWS Signature Version 4, see
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-authenticating-requests.html
+
+const (
+	sigAlgorithm     = "AWS4-HMAC-SHA256"
+	sigDateLayout    = "20060102T150405Z"
+	unsignedPayload  = "UNSIGNED-PAYLOAD"
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+type signer struct {
+	accessKeyID     string
+	secretAccessKey string
+	region          string
+}
+
+func (s signer) scope(now time.Time) string {
+	return now.Format("20060102") + "/" + s.region + "/s3/aws4_request"
+}
+
+func (s signer) key(now time.Time) []byte {
+	k := hmacSHA256([]byte("AWS4"+s.secretAccessKey), now.Format("20060102"))
+	k = hmacSHA256(k, s.region)
+	k = hmacSHA256(k, "s3")
+	return hmacSHA256(k, "aws4_request")
+}
+
+func (s signer) signature(now time.Time, canonicalRequest string) string {
+	sum := sha256.Sum256([]byte(canonicalRequest))
+
+	stringToSign := strings.Join([]string{
+		sigAlgorithm,
+		now.Format(sigDateLayout),
+		s.scope(now),
+		hex.EncodeToString(sum[:]),
+	}, "\n")
+
+	return hex.EncodeToString(hmacSHA256(s.key(now), stringToSign))
+}
+
+// sign adds the Authorization header to the request. The host and all headers set on
+// the request are signed, payloadHash is the hex encoded SHA256 of the body.
+func (s signer) sign(req *http.Request, payloadHash string, now time.Time) {
+	now = now.UTC()
+	req.Header.Set("X-Amz-Date", now.Format(sigDateLayout))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI(req.URL),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	req.Header.Set("Authorization", sigAlgorithm+
+		" Credential="+s.accessKeyID+"/"+s.scope(now)+
+		",SignedHeaders="+signedHeaders+
+		",Signature="+s.signature(now, canonicalRequest))
+}
+
+// presign adds the query parameters of a presigned URL to u, only the host is signed.
+func (s signer) presign(method string, u *url.URL, expires time.Duration, now time.Time) {
+	now = now.UTC()
+
+	q := u.Query()
+	q.Set("X-Amz-Algorithm", sigAlgorithm)
+	q.Set("X-Amz-Credential", s.accessKeyID+"/"+s.scope(now))
+	q.Set("X-Amz-Date", now.Format(sigDateLayout))
+	q.Set("X-Amz-Expires", strconv.Itoa(int(expires.Seconds())))
+	q.Set("X-Amz-SignedHeaders", "host")
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		canonicalURI(u),
+		canonicalQuery(q),
+		"host:" + u.Host + "\n",
+		"host",
+		unsignedPayload,
+	}, "\n")
+
+	u.RawQuery = canonicalQuery(q) + "&X-Amz-Signature=" + s.signature(now, canonicalRequest)
+}
+
+func canonicalURI(u *url.URL) string {
+	p := u.EscapedPath()
+	if p == "" {
+		return "/"
+	}
+
+	// Unescape and escape each segment again with the encoding of S3, which differs
+	// from the encoding of net/url for some characters.
+	segments := strings.Split(p, "/")
+	for i, seg := range segments {
+		if v, err := url.PathUnescape(seg); err == nil {
+			seg = v
+		}
+		segments[i] = uriEncode(seg)
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func canonicalQuery(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values := append([]string(nil), q[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, uriEncode(k)+"="+uriEncode(v))
+		}
+	}
+
+	return strings.Join(parts, "&")
+}
+
+// uriEncode encodes every byte except the unreserved characters A-Z, a-z, 0-9, '-',
+// '.', '_' and '~'.
+func uriEncode(s string) string {
+	const hexDigits = "0123456789ABCDEF"
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '.', c == '_', c == '~':
+			b.WriteByte(c)
+		default:
+			b.WriteByte('%')
+			b.WriteByte(hexDigits[c>>4])
+			b.WriteByte(hexDigits[c&15])
+		}
+	}
+
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
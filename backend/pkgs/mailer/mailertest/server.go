@@ -0,0 +1,206 @@
+// Package mailertest provides a local SMTP sink that records the mails sent to it, it
+// is meant to test code sending mails through the mailer package.
+package mailertest
+
+import (
+	"encoding/base64"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+
+	"github.com/hay-kot/homebox/backend/pkgs/mailer"
+)
+
+// Message is a mail received by the Server.
+type Message struct {
+	From    string
+	To      []string
+	Subject string
+	// Body is the decoded body of the mail.
+	Body string
+}
+
+// Server is an SMTP server that accepts any credentials and keeps all mails in memory.
+type Server struct {
+	ln net.Listener
+	wg sync.WaitGroup
+
+	mu       sync.Mutex
+	messages []Message
+}
+
+// NewServer starts a server listening on a random port of the loopback interface.
+func NewServer() (*Server, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{ln: ln}
+
+	s.wg.Add(1)
+	go s.serve()
+
+	return s, nil
+}
+
+// Mailer returns a mailer sending to the server.
+func (s *Server) Mailer() *mailer.Mailer {
+	addr := s.ln.Addr().(*net.TCPAddr)
+
+	return &mailer.Mailer{
+		Host:     addr.IP.String(),
+		Port:     addr.Port,
+		Username: "homebox",
+		Password: "homebox",
+		From:     "homebox@example.com",
+	}
+}
+
+// Messages returns the mails received so far.
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make([]Message, len(s.messages))
+	copy(out, s.messages)
+	return out
+}
+
+// Reset removes all received mails.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages = nil
+}
+
+// Close stops the server and waits for open connections to finish.
+func (s *Server) Close() error {
+	err := s.ln.Close()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			s.handle(textproto.NewConn(conn))
+		}()
+	}
+}
+
+func (s *Server) handle(c *textproto.Conn) {
+	var msg Message
+
+	reply := func(code int, text string) bool {
+		return c.PrintfLine("%d %s", code, text) == nil
+	}
+
+	if !reply(220, "localhost ESMTP mailertest") {
+		return
+	}
+
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+
+		cmd, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(cmd) {
+		case "EHLO", "HELO":
+			err = c.PrintfLine("250-localhost")
+			if err == nil {
+				err = c.PrintfLine("250 AUTH PLAIN")
+			}
+		case "AUTH":
+			err = c.PrintfLine("235 authenticated")
+		case "MAIL":
+			msg = Message{From: address(arg)}
+			err = c.PrintfLine("250 ok")
+		case "RCPT":
+			msg.To = append(msg.To, address(arg))
+			err = c.PrintfLine("250 ok")
+		case "DATA":
+			if !reply(354, "end data with <CR><LF>.<CR><LF>") {
+				return
+			}
+
+			data, rerr := io.ReadAll(c.DotReader())
+			if rerr != nil {
+				return
+			}
+
+			perr := parse(&msg, string(data))
+			if perr != nil {
+				err = c.PrintfLine("554 %s", perr.Error())
+				break
+			}
+
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+
+			err = c.PrintfLine("250 ok")
+		case "RSET", "NOOP":
+			err = c.PrintfLine("250 ok")
+		case "QUIT":
+			_ = c.PrintfLine("221 bye")
+			return
+		default:
+			err = c.PrintfLine("502 command not implemented")
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+// address returns the address of a MAIL FROM or RCPT TO argument.
+func address(arg string) string {
+	_, addr, _ := strings.Cut(arg, ":")
+	addr, _, _ = strings.Cut(strings.TrimSpace(addr), " ")
+	return strings.Trim(addr, "<>")
+}
+
+func parse(msg *Message, data string) error {
+	m, err := mail.ReadMessage(strings.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	msg.Subject, err = new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	if err != nil {
+		return err
+	}
+
+	var body io.Reader = m.Body
+	if strings.EqualFold(m.Header.Get("Content-Transfer-Encoding"), "base64") {
+		body = base64.NewDecoder(base64.StdEncoding, m.Body)
+	}
+
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	msg.Body = string(b)
+	return nil
+}
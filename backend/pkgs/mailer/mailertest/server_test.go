@@ -0,0 +1,42 @@
+package mailertest
+
+import (
+	"testing"
+
+	"github.com/hay-kot/homebox/backend/pkgs/mailer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Send(t *testing.T) {
+	t.Parallel()
+
+	srv, err := NewServer()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = srv.Close() })
+
+	m := srv.Mailer()
+	require.True(t, m.Ready())
+
+	msg := mailer.NewMessageBuilder().
+		SetBody("<p>Hello Wörld!</p>").
+		SetSubject("Grüße").
+		SetTo("John Doe", "john@doe.com").
+		SetFrom("Homebox", m.From).
+		Build()
+
+	require.NoError(t, m.Send(msg))
+	require.NoError(t, m.Send(msg))
+
+	messages := srv.Messages()
+	require.Len(t, messages, 2)
+
+	got := messages[0]
+	assert.Equal(t, "homebox@example.com", got.From)
+	assert.Equal(t, []string{"john@doe.com"}, got.To)
+	assert.Equal(t, "Grüße", got.Subject)
+	assert.Equal(t, "<p>Hello Wörld!</p>", got.Body)
+
+	srv.Reset()
+	assert.Empty(t, srv.Messages())
+}
@@ -9,6 +9,12 @@ import (
 //go:embed templates/welcome.html
 var templatesWelcome string
 
+//go:embed templates/password_reset.html
+var templatesPasswordReset string
+
+//go:embed templates/verify_email.html
+var templatesVerifyEmail string
+
 type TemplateDefaults struct {
 	CompanyName        string
 	CompanyAddress     string
@@ -59,3 +65,15 @@ func render(tpl string, data TemplateProps) (string, error) {
 func RenderWelcome() (string, error) {
 	return render(templatesWelcome, DefaultTemplateData())
 }
+
+// RenderPasswordReset renders the password reset mail. The data requires the Name of the
+// user, the URL of the reset page and the time until the link Expires.
+func RenderPasswordReset(data TemplateProps) (string, error) {
+	return render(templatesPasswordReset, data)
+}
+
+// RenderVerifyEmail renders the email verification mail. The data requires the Name of
+// the user, the URL of the verification page and the time until the link Expires.
+func RenderVerifyEmail(data TemplateProps) (string, error) {
+	return render(templatesVerifyEmail, data)
+}
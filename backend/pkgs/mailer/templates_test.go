@@ -0,0 +1,40 @@
+package mailer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderTemplates(t *testing.T) {
+	t.Parallel()
+
+	renderers := map[string]func(TemplateProps) (string, error){
+		"password reset": RenderPasswordReset,
+		"verify email":   RenderVerifyEmail,
+	}
+
+	for name, render := range renderers {
+		t.Run(name, func(t *testing.T) {
+			data := TemplateProps{
+				Defaults: TemplateDefaults{
+					CompanyName: "Homebox",
+					CompanyURL:  "https://homebox.example.com",
+				},
+				Data: map[string]string{},
+			}
+			data.Set("Name", "<John>")
+			data.Set("URL", "https://homebox.example.com/verify?token=abc")
+			data.Set("Expires", "1 hour")
+
+			body, err := render(data)
+			require.NoError(t, err)
+
+			assert.Contains(t, body, "Hi &lt;John&gt;,")
+			assert.Contains(t, body, `href="https://homebox.example.com/verify?token=abc"`)
+			assert.Contains(t, body, "1 hour")
+			assert.NotContains(t, body, "Unsubscribe")
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Package ratelimit provides in-memory limits for requests and failed attempts that are
+// tracked per key, such as a user or an IP address.
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// LimitError is returned when a key is over its limit, the request may be retried after
+// the given duration.
+type LimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("too many requests, retry in %s", e.RetryAfter.Round(time.Second))
+}
+
+// RetryAfterSeconds returns the value of the Retry-After header for the error, it is
+// rounded up to whole seconds.
+func (e *LimitError) RetryAfterSeconds() int {
+	return int(math.Ceil(e.RetryAfter.Seconds()))
+}
+
+// sweepEvery is the number of calls after which idle keys are removed.
+const sweepEvery = 1024
+
+// Limiter is a token bucket per key. Every key starts with a full bucket of burst
+// tokens, a request takes a token and tokens are refilled at rate per second.
+type Limiter struct {
+	rate  float64
+	burst float64
+	now   func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	calls   int
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns a limiter that allows burst requests at once and rate requests per
+// second on average. A rate or burst of zero disables the limiter, nil is returned.
+func NewLimiter(rate float64, burst int) *Limiter {
+	if rate <= 0 || burst <= 0 {
+		return nil
+	}
+
+	return &Limiter{
+		rate:    rate,
+		burst:   float64(burst),
+		now:     time.Now,
+		buckets: map[string]*bucket{},
+	}
+}
+
+// Allow takes a token from the bucket of the key. A *LimitError is returned when the
+// bucket is empty. A nil limiter allows all requests.
+func (l *Limiter) Allow(key string) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = l.refill(b, now)
+	b.last = now
+
+	if b.tokens < 1 {
+		wait := (1 - b.tokens) / l.rate
+		return &LimitError{RetryAfter: time.Duration(wait * float64(time.Second))}
+	}
+
+	b.tokens--
+	return nil
+}
+
+func (l *Limiter) refill(b *bucket, now time.Time) float64 {
+	return math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+}
+
+// sweep removes buckets that are full again, they are the same as a new bucket.
+func (l *Limiter) sweep(now time.Time) {
+	l.calls++
+	if l.calls < sweepEvery {
+		return
+	}
+
+	l.calls = 0
+	for k, b := range l.buckets {
+		if l.refill(b, now) >= l.burst {
+			delete(l.buckets, k)
+		}
+	}
+}
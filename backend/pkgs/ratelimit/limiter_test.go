@@ -0,0 +1,69 @@
+package ratelimit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type clock struct {
+	t time.Time
+}
+
+func (c *clock) now() time.Time {
+	return c.t
+}
+
+func (c *clock) advance(d time.Duration) {
+	c.t = c.t.Add(d)
+}
+
+func newClock() *clock {
+	return &clock{t: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func TestNewLimiter_Disabled(t *testing.T) {
+	t.Parallel()
+
+	l := NewLimiter(0, 10)
+	assert.Nil(t, l)
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, l.Allow("key"))
+	}
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	t.Parallel()
+
+	c := newClock()
+	l := NewLimiter(2, 3)
+	l.now = c.now
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, l.Allow("a"))
+	}
+
+	err := l.Allow("a")
+	var limitErr *LimitError
+	require.True(t, errors.As(err, &limitErr))
+	assert.Equal(t, 500*time.Millisecond, limitErr.RetryAfter)
+	assert.Equal(t, 1, limitErr.RetryAfterSeconds())
+
+	// Other keys have their own bucket.
+	require.NoError(t, l.Allow("b"))
+
+	// Tokens are refilled at the rate and never exceed the burst.
+	c.advance(500 * time.Millisecond)
+	require.NoError(t, l.Allow("a"))
+	require.Error(t, l.Allow("a"))
+
+	c.advance(time.Hour)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, l.Allow("a"))
+	}
+	require.Error(t, l.Allow("a"))
+}
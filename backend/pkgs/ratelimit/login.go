@@ -0,0 +1,84 @@
+package ratelimit
+
+import (
+	"strings"
+	"time"
+)
+
+// LockoutKind is the kind of key a lockout applies to.
+type LockoutKind string
+
+const (
+	LockoutAccount LockoutKind = "account"
+	LockoutIP      LockoutKind = "ip"
+)
+
+// Lockout describes an account or IP address that was locked out after too many failed
+// logins.
+type Lockout struct {
+	Kind     LockoutKind
+	Key      string
+	Failures int
+	Until    time.Time
+}
+
+// LoginGuard throttles failed logins per account and per IP address. Each login has to
+// be started with Attempt and finished with Succeed when the credentials were valid.
+type LoginGuard struct {
+	accounts *Tracker
+	ips      *Tracker
+}
+
+// NewLoginGuard returns a guard with separate policies for accounts and IP addresses,
+// onLockout is called for every lockout and may be nil.
+func NewLoginGuard(account, ip Policy, onLockout func(Lockout)) *LoginGuard {
+	notify := func(kind LockoutKind) func(string, int, time.Time) {
+		return func(key string, failures int, until time.Time) {
+			if onLockout != nil {
+				onLockout(Lockout{Kind: kind, Key: key, Failures: failures, Until: until})
+			}
+		}
+	}
+
+	return &LoginGuard{
+		accounts: NewTracker(account, notify(LockoutAccount)),
+		ips:      NewTracker(ip, notify(LockoutIP)),
+	}
+}
+
+// Attempt records a login to the account from the IP address. A *LimitError is returned
+// when either of them has to wait or is locked out. A nil guard allows all logins.
+func (g *LoginGuard) Attempt(account, ip string) error {
+	if g == nil {
+		return nil
+	}
+
+	err := g.ips.Attempt(ip)
+	if err != nil {
+		return err
+	}
+
+	err = g.accounts.Attempt(normalizeAccount(account))
+	if err != nil {
+		g.ips.Forgive(ip)
+		return err
+	}
+
+	return nil
+}
+
+// Succeed clears the failures of the account and forgives the attempt of the IP
+// address. Earlier failures of the IP address are kept, a single valid account must not
+// reset the limit for guessing the passwords of others.
+func (g *LoginGuard) Succeed(account, ip string) {
+	if g == nil {
+		return
+	}
+
+	g.accounts.Reset(normalizeAccount(account))
+	g.ips.Forgive(ip)
+}
+
+func normalizeAccount(account string) string {
+	return strings.ToLower(strings.TrimSpace(account))
+}
@@ -0,0 +1,151 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Policy configures how a Tracker slows down and locks out a key after failed attempts.
+type Policy struct {
+	// FreeAttempts is the number of failures after which attempts are delayed.
+	FreeAttempts int
+	// BaseDelay is the delay after the first failure beyond the free attempts, it doubles
+	// with every further failure up to MaxDelay.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// MaxAttempts is the number of failures after which the key is locked out for the
+	// Lockout duration. Zero disables the lockout.
+	MaxAttempts int
+	Lockout     time.Duration
+	// Window is the time after the last failure at which the failures of a key are
+	// forgotten.
+	Window time.Duration
+}
+
+// Delay returns the time an attempt has to wait after the last of the given number of
+// failures.
+func (p Policy) Delay(failures int) time.Duration {
+	n := failures - p.FreeAttempts
+	if n <= 0 || p.BaseDelay <= 0 {
+		return 0
+	}
+
+	d := p.BaseDelay
+	for i := 1; i < n && d < p.MaxDelay; i++ {
+		d *= 2
+	}
+
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return d
+}
+
+// Tracker counts failed attempts per key. Every attempt is counted as a failure until it
+// is forgiven, this way concurrent attempts can not get around the delays.
+type Tracker struct {
+	policy    Policy
+	onLockout func(key string, failures int, until time.Time)
+	now       func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*entry
+	calls   int
+}
+
+type entry struct {
+	failures int
+	last     time.Time
+	locked   time.Time
+}
+
+// NewTracker returns a tracker for the policy, onLockout is called when a key gets
+// locked out and may be nil.
+func NewTracker(p Policy, onLockout func(key string, failures int, until time.Time)) *Tracker {
+	return &Tracker{
+		policy:    p,
+		onLockout: onLockout,
+		now:       time.Now,
+		entries:   map[string]*entry{},
+	}
+}
+
+// Attempt records an attempt for the key. A *LimitError is returned and the attempt is
+// not recorded while the key is locked out or has to wait after its previous failures.
+func (t *Tracker) Attempt(key string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	t.sweep(now)
+
+	e := t.entries[key]
+	if e == nil || t.expired(e, now) {
+		e = &entry{}
+		t.entries[key] = e
+	}
+
+	if now.Before(e.locked) {
+		return &LimitError{RetryAfter: e.locked.Sub(now)}
+	}
+
+	if next := e.last.Add(t.policy.Delay(e.failures)); now.Before(next) {
+		return &LimitError{RetryAfter: next.Sub(now)}
+	}
+
+	if t.policy.MaxAttempts > 0 && e.failures >= t.policy.MaxAttempts {
+		failures := e.failures
+
+		e.failures = 0
+		e.last = now
+		e.locked = now.Add(t.policy.Lockout)
+
+		if t.onLockout != nil {
+			t.onLockout(key, failures, e.locked)
+		}
+
+		return &LimitError{RetryAfter: t.policy.Lockout}
+	}
+
+	e.failures++
+	e.last = now
+	return nil
+}
+
+// Forgive removes the last attempt of the key from its failures.
+func (t *Tracker) Forgive(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if e := t.entries[key]; e != nil && e.failures > 0 {
+		e.failures--
+	}
+}
+
+// Reset forgets all failures of the key, a lockout stays in place.
+func (t *Tracker) Reset(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if e := t.entries[key]; e != nil {
+		e.failures = 0
+	}
+}
+
+func (t *Tracker) expired(e *entry, now time.Time) bool {
+	return !now.Before(e.locked) && now.Sub(e.last) > t.policy.Window
+}
+
+func (t *Tracker) sweep(now time.Time) {
+	t.calls++
+	if t.calls < sweepEvery {
+		return
+	}
+
+	t.calls = 0
+	for k, e := range t.entries {
+		if t.expired(e, now) {
+			delete(t.entries, k)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package ratelimit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPolicy = Policy{
+	FreeAttempts: 2,
+	BaseDelay:    time.Second,
+	MaxDelay:     4 * time.Second,
+	MaxAttempts:  6,
+	Lockout:      15 * time.Minute,
+	Window:       time.Hour,
+}
+
+func retryAfter(t *testing.T, err error) time.Duration {
+	t.Helper()
+
+	var limitErr *LimitError
+	require.True(t, errors.As(err, &limitErr), "expected limit error, got %v", err)
+	return limitErr.RetryAfter
+}
+
+func TestPolicy_Delay(t *testing.T) {
+	t.Parallel()
+
+	want := []time.Duration{0, 0, 0, time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second, 4 * time.Second}
+	for failures, d := range want {
+		assert.Equal(t, d, testPolicy.Delay(failures), "failures: %d", failures)
+	}
+}
+
+func TestTracker_ProgressiveDelayAndLockout(t *testing.T) {
+	t.Parallel()
+
+	var locked []string
+
+	c := newClock()
+	tr := NewTracker(testPolicy, func(key string, failures int, until time.Time) {
+		assert.Equal(t, 6, failures)
+		assert.Equal(t, c.now().Add(15*time.Minute), until)
+		locked = append(locked, key)
+	})
+	tr.now = c.now
+
+	// The free attempts are not delayed.
+	require.NoError(t, tr.Attempt("a"))
+	require.NoError(t, tr.Attempt("a"))
+	require.NoError(t, tr.Attempt("a"))
+
+	// Every further failure doubles the delay, blocked attempts are not counted.
+	assert.Equal(t, time.Second, retryAfter(t, tr.Attempt("a")))
+	assert.Equal(t, time.Second, retryAfter(t, tr.Attempt("a")))
+	c.advance(time.Second)
+	require.NoError(t, tr.Attempt("a"))
+
+	assert.Equal(t, 2*time.Second, retryAfter(t, tr.Attempt("a")))
+	c.advance(2 * time.Second)
+	require.NoError(t, tr.Attempt("a"))
+
+	c.advance(4 * time.Second)
+	require.NoError(t, tr.Attempt("a"))
+
+	// Other keys are not affected.
+	require.NoError(t, tr.Attempt("b"))
+
+	c.advance(4 * time.Second)
+	assert.Equal(t, 15*time.Minute, retryAfter(t, tr.Attempt("a")))
+	assert.Equal(t, []string{"a"}, locked)
+
+	c.advance(10 * time.Minute)
+	assert.Equal(t, 5*time.Minute, retryAfter(t, tr.Attempt("a")))
+
+	// The failures are cleared after the lockout.
+	c.advance(5 * time.Minute)
+	require.NoError(t, tr.Attempt("a"))
+	require.NoError(t, tr.Attempt("a"))
+	assert.Equal(t, []string{"a"}, locked)
+}
+
+func TestTracker_ForgiveAndReset(t *testing.T) {
+	t.Parallel()
+
+	c := newClock()
+	tr := NewTracker(testPolicy, nil)
+	tr.now = c.now
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, tr.Attempt("a"))
+	}
+
+	tr.Forgive("a")
+	require.NoError(t, tr.Attempt("a"))
+	require.Error(t, tr.Attempt("a"))
+
+	tr.Reset("a")
+	require.NoError(t, tr.Attempt("a"))
+}
+
+func TestTracker_Window(t *testing.T) {
+	t.Parallel()
+
+	c := newClock()
+	tr := NewTracker(testPolicy, nil)
+	tr.now = c.now
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, tr.Attempt("a"))
+	}
+	require.Error(t, tr.Attempt("a"))
+
+	c.advance(time.Hour + time.Second)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, tr.Attempt("a"))
+	}
+}
+
+func TestLoginGuard(t *testing.T) {
+	t.Parallel()
+
+	var lockouts []Lockout
+
+	account := Policy{MaxAttempts: 2, Lockout: time.Minute, Window: time.Hour}
+	ip := Policy{MaxAttempts: 3, Lockout: time.Minute, Window: time.Hour}
+
+	g := NewLoginGuard(account, ip, func(l Lockout) {
+		lockouts = append(lockouts, l)
+	})
+
+	require.NoError(t, g.Attempt("User@example.com", "192.0.2.1"))
+	require.NoError(t, g.Attempt(" user@example.com", "192.0.2.1"))
+
+	// The account is locked, the attempt does not count for the IP address.
+	require.Error(t, g.Attempt("user@example.com", "192.0.2.2"))
+	require.Len(t, lockouts, 1)
+	assert.Equal(t, LockoutAccount, lockouts[0].Kind)
+	assert.Equal(t, "user@example.com", lockouts[0].Key)
+
+	// A successful login keeps the failures of the IP address.
+	require.NoError(t, g.Attempt("other@example.com", "192.0.2.1"))
+	g.Succeed("other@example.com", "192.0.2.1")
+
+	require.NoError(t, g.Attempt("third@example.com", "192.0.2.1"))
+	require.Error(t, g.Attempt("fourth@example.com", "192.0.2.1"))
+	require.Len(t, lockouts, 2)
+	assert.Equal(t, LockoutIP, lockouts[1].Kind)
+	assert.Equal(t, "192.0.2.1", lockouts[1].Key)
+
+	var nilGuard *LoginGuard
+	require.NoError(t, nilGuard.Attempt("user@example.com", "192.0.2.1"))
+	nilGuard.Succeed("user@example.com", "192.0.2.1")
+}
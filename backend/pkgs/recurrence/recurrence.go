@@ -0,0 +1,188 @@
+// Package recurrence parses simple recurrence rules and calculates the next occurrence.
+//
+// Rules can be written either as a small subset of the iCalendar RRULE format or in plain
+// English:
+//
+//	FREQ=MONTHLY;INTERVAL=3
+//	RRULE:FREQ=DAILY;INTERVAL=90
+//	every 90 days
+//	every 3 months
+//	weekly
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Freq is the unit of a recurrence rule.
+type Freq string
+
+const (
+	Daily   Freq = "DAILY"
+	Weekly  Freq = "WEEKLY"
+	Monthly Freq = "MONTHLY"
+	Yearly  Freq = "YEARLY"
+)
+
+// MaxInterval is the largest supported interval.
+const MaxInterval = 1000
+
+var ErrEmptyRule = errors.New("empty recurrence rule")
+
+// Rule repeats every Interval units of Freq.
+type Rule struct {
+	Freq     Freq
+	Interval int
+}
+
+// Parse parses a rule in either RRULE or plain English form.
+func Parse(s string) (Rule, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Rule{}, ErrEmptyRule
+	}
+
+	upper := strings.ToUpper(s)
+	if strings.HasPrefix(upper, "RRULE:") || strings.Contains(upper, "FREQ=") {
+		return parseRRule(strings.TrimPrefix(upper, "RRULE:"))
+	}
+
+	return parseText(strings.ToLower(s))
+}
+
+func parseRRule(s string) (Rule, error) {
+	r := Rule{Interval: 1}
+
+	for _, part := range strings.Split(s, ";") {
+		if part == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Rule{}, fmt.Errorf("invalid rule part %q", part)
+		}
+
+		switch key {
+		case "FREQ":
+			f := Freq(value)
+			switch f {
+			case Daily, Weekly, Monthly, Yearly:
+				r.Freq = f
+			default:
+				return Rule{}, fmt.Errorf("unsupported frequency %q", value)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return Rule{}, fmt.Errorf("invalid interval %q", value)
+			}
+			r.Interval = n
+		default:
+			return Rule{}, fmt.Errorf("unsupported rule part %q", key)
+		}
+	}
+
+	if r.Freq == "" {
+		return Rule{}, errors.New("missing FREQ")
+	}
+
+	return r, r.validate()
+}
+
+var textUnits = map[string]Freq{
+	"day":    Daily,
+	"days":   Daily,
+	"week":   Weekly,
+	"weeks":  Weekly,
+	"month":  Monthly,
+	"months": Monthly,
+	"year":   Yearly,
+	"years":  Yearly,
+}
+
+var textShorthands = map[string]Rule{
+	"daily":     {Daily, 1},
+	"weekly":    {Weekly, 1},
+	"monthly":   {Monthly, 1},
+	"quarterly": {Monthly, 3},
+	"yearly":    {Yearly, 1},
+	"annually":  {Yearly, 1},
+}
+
+func parseText(s string) (Rule, error) {
+	if r, ok := textShorthands[s]; ok {
+		return r, nil
+	}
+
+	words := strings.Fields(s)
+	if len(words) > 0 && words[0] == "every" {
+		words = words[1:]
+	}
+
+	r := Rule{Interval: 1}
+
+	switch len(words) {
+	case 1:
+	case 2:
+		n, err := strconv.Atoi(words[0])
+		if err != nil {
+			return Rule{}, fmt.Errorf("invalid interval %q", words[0])
+		}
+		r.Interval = n
+		words = words[1:]
+	default:
+		return Rule{}, fmt.Errorf("invalid recurrence rule %q", s)
+	}
+
+	f, ok := textUnits[words[0]]
+	if !ok {
+		return Rule{}, fmt.Errorf("unknown unit %q", words[0])
+	}
+	r.Freq = f
+
+	return r, r.validate()
+}
+
+func (r Rule) validate() error {
+	if r.Interval < 1 || r.Interval > MaxInterval {
+		return fmt.Errorf("interval must be between 1 and %d", MaxInterval)
+	}
+	return nil
+}
+
+// String returns the rule in RRULE form, parsing the result yields the same rule.
+func (r Rule) String() string {
+	return "FREQ=" + string(r.Freq) + ";INTERVAL=" + strconv.Itoa(r.Interval)
+}
+
+// Next returns the occurrence after t. Monthly and yearly rules are clamped to the end
+// of the month, so a rule repeating every month from January 31 is next due on the last
+// day of February.
+func (r Rule) Next(t time.Time) time.Time {
+	switch r.Freq {
+	case Daily:
+		return t.AddDate(0, 0, r.Interval)
+	case Weekly:
+		return t.AddDate(0, 0, 7*r.Interval)
+	case Monthly:
+		return addMonths(t, r.Interval)
+	case Yearly:
+		return addMonths(t, 12*r.Interval)
+	}
+
+	return t
+}
+
+func addMonths(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+
+	first := time.Date(y, m+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	last := first.AddDate(0, 1, -1).Day()
+
+	return first.AddDate(0, 0, min(d, last)-1)
+}
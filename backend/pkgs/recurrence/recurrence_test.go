@@ -0,0 +1,93 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Rule
+	}{
+		{"FREQ=MONTHLY;INTERVAL=3", Rule{Monthly, 3}},
+		{"RRULE:FREQ=DAILY;INTERVAL=90", Rule{Daily, 90}},
+		{"freq=weekly", Rule{Weekly, 1}},
+		{"every 90 days", Rule{Daily, 90}},
+		{"Every 3 Months", Rule{Monthly, 3}},
+		{"every week", Rule{Weekly, 1}},
+		{"2 years", Rule{Yearly, 2}},
+		{"quarterly", Rule{Monthly, 3}},
+		{"daily", Rule{Daily, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := Parse(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			again, err := Parse(got.String())
+			require.NoError(t, err)
+			assert.Equal(t, got, again)
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []string{
+		"",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;COUNT=3",
+		"FREQ=DAILY;INTERVAL=0",
+		"INTERVAL=2",
+		"every 0 days",
+		"every -1 days",
+		"every 3 fortnights",
+		"every other day",
+		"every 1001 days",
+	}
+
+	for _, tt := range tests {
+		t.Run(tt, func(t *testing.T) {
+			_, err := Parse(tt)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRule_Next(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		rule string
+		from time.Time
+		want time.Time
+	}{
+		{"every 90 days", date(2024, 1, 1), date(2024, 3, 31)},
+		{"every 2 weeks", date(2024, 12, 25), date(2025, 1, 8)},
+		{"every 3 months", date(2024, 1, 15), date(2024, 4, 15)},
+		{"monthly", date(2024, 1, 31), date(2024, 2, 29)},
+		{"monthly", date(2023, 1, 31), date(2023, 2, 28)},
+		{"every 13 months", date(2024, 12, 31), date(2026, 1, 31)},
+		{"yearly", date(2024, 2, 29), date(2025, 2, 28)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			assert.Equal(t, tt.want, mustParse(t, tt.rule).Next(tt.from))
+		})
+	}
+}
+
+func mustParse(t *testing.T, s string) Rule {
+	t.Helper()
+	r, err := Parse(s)
+	require.NoError(t, err)
+	return r
+}
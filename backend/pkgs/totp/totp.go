@@ -0,0 +1,115 @@
+// Package totp implements time-based one-time passwords as described in RFC 6238. Codes
+// use the parameters supported by all authenticator apps: HMAC-SHA1, 6 digits and a
+// period of 30 seconds.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Digits is the length of a code.
+	Digits = 6
+	// Period is the time a code is valid for.
+	Period = 30 * time.Second
+	// Skew is the number of periods before and after the current one that are accepted
+	// to allow for clock drift.
+	Skew = 1
+
+	secretSize = 20
+	// modulo is 10^Digits
+	modulo = 1_000_000
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewSecret returns a random base32 encoded secret.
+func NewSecret() (string, error) {
+	b := make([]byte, secretSize)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return encoding.EncodeToString(b), nil
+}
+
+// Step returns the time step of t, the number of periods since the Unix epoch.
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period/time.Second)
+}
+
+// Code returns the code of the secret for a time step. Authenticator apps only support
+// HMAC-SHA1, so SHA1 is used despite its weaknesses as a plain hash.
+func Code(secret string, step int64) (string, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", fmt.Errorf("invalid secret: %w", err)
+	}
+
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation, RFC 4226 section 5.3
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", Digits, value%modulo), nil
+}
+
+// Verify reports whether the code is valid for the secret at t. The time step of the
+// matching code is returned, callers should store it and reject codes of the same or an
+// earlier step so that a code can only be used once.
+func Verify(secret, code string, t time.Time) (int64, bool) {
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if len(code) != Digits {
+		return 0, false
+	}
+
+	now := Step(t)
+	for step := now - Skew; step <= now+Skew; step++ {
+		expected, err := Code(secret, step)
+		if err != nil {
+			return 0, false
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// URL returns the otpauth:// provisioning URL of the secret, authenticator apps enroll
+// the secret by scanning it as a QR code.
+func URL(issuer, account, secret string) string {
+	q := url.Values{}
+	q.Set("secret", secret)
+	q.Set("issuer", issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(Digits))
+	q.Set("period", fmt.Sprint(int(Period/time.Second)))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: q.Encode(),
+	}
+
+	return u.String()
+}
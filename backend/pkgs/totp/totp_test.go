@@ -0,0 +1,95 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the base32 encoding of the SHA1 test key of RFC 6238, "12345678901234567890".
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode_RFC6238(t *testing.T) {
+	t.Parallel()
+
+	// The test vectors of RFC 6238 appendix B use 8 digits, a 6 digit code is the
+	// last 6 digits of the same value.
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		code, err := Code(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, code, tt.unix)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1111111111, 0)
+	step := Step(now)
+
+	code, err := Code(rfcSecret, step)
+	require.NoError(t, err)
+
+	got, ok := Verify(rfcSecret, code, now)
+	assert.True(t, ok)
+	assert.Equal(t, step, got)
+
+	// Codes of the neighbouring periods are accepted for clock drift.
+	got, ok = Verify(rfcSecret, code, now.Add(Period))
+	assert.True(t, ok)
+	assert.Equal(t, step, got)
+
+	_, ok = Verify(rfcSecret, code, now.Add(2*Period))
+	assert.False(t, ok)
+
+	_, ok = Verify(rfcSecret, "000000", now)
+	assert.False(t, ok)
+
+	_, ok = Verify(rfcSecret, "", now)
+	assert.False(t, ok)
+
+	_, ok = Verify("not base32!", code, now)
+	assert.False(t, ok)
+}
+
+func TestNewSecret(t *testing.T) {
+	t.Parallel()
+
+	a, err := NewSecret()
+	require.NoError(t, err)
+	b, err := NewSecret()
+	require.NoError(t, err)
+
+	assert.Len(t, a, 32)
+	assert.NotEqual(t, a, b)
+
+	_, err = Code(a, 1)
+	require.NoError(t, err)
+}
+
+func TestURL(t *testing.T) {
+	t.Parallel()
+
+	u := URL("Homebox", "john@example.com", rfcSecret)
+
+	assert.True(t, strings.HasPrefix(u, "otpauth://totp/Homebox:john@example.com?"), u)
+	assert.Contains(t, u, "secret="+rfcSecret)
+	assert.Contains(t, u, "issuer=Homebox")
+	assert.Contains(t, u, "digits=6")
+	assert.Contains(t, u, "period=30")
+}